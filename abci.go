@@ -91,6 +91,9 @@ type CheckResult struct {
 	// GasPayment is the total fees for this tx (or other source of payment)
 	//TODO: Implement when tendermint implements this properly
 	GasPayment int64
+	// Tags, if present, will be used by tendermint to index the transaction
+	// in the mempool
+	Tags []common.KVPair
 }
 
 // NewCheck sets the gas used and the response data but no more info
@@ -108,6 +111,7 @@ func (c CheckResult) ToABCI() abci.ResponseCheckTx {
 		Data:      c.Data,
 		Log:       c.Log,
 		GasWanted: c.GasAllocated,
+		Tags:      c.Tags,
 	}
 }
 
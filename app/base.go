@@ -1,11 +1,17 @@
 package app
 
 import (
+	"fmt"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+// SimulatePath is the query path used to simulate the execution of a
+// transaction. Query data must be a serialized transaction.
+const SimulatePath = "/app/simulate"
+
 // BaseApp adds DeliverTx, CheckTx, and BeginBlock
 // handlers to the storage and query functionality of StoreApp
 type BaseApp struct {
@@ -69,6 +75,61 @@ func (b BaseApp) CheckTx(txBytes []byte) abci.ResponseCheckTx {
 	return weave.CheckOrError(res, err, b.debug)
 }
 
+// Query - ABCI - handles transaction simulation and passes all other queries
+// to the StoreApp
+func (b BaseApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	if req.Path == SimulatePath {
+		return b.simulate(req.Data)
+	}
+	return b.StoreApp.Query(req)
+}
+
+// simulate runs the transaction through the Deliver stack using a cache of
+// the committed state that is always discarded. On success the query value
+// is the serialized abci.ResponseDeliverTx, containing the result data, log,
+// gas used and tags.
+func (b BaseApp) simulate(txBytes []byte) abci.ResponseQuery {
+	info, err := b.store.CommitInfo()
+	if err != nil {
+		return queryError(err)
+	}
+	res, err := b.simulateDeliver(txBytes)
+	if err != nil {
+		code, log := errors.ABCIInfo(err, b.debug)
+		return abci.ResponseQuery{
+			Code:   code,
+			Log:    fmt.Sprintf("cannot simulate tx: %s", log),
+			Height: info.Version,
+		}
+	}
+	abciRes := res.ToABCI()
+	raw, err := abciRes.Marshal()
+	if err != nil {
+		return queryError(errors.Wrap(err, "marshal result"))
+	}
+	return abci.ResponseQuery{
+		Value:  raw,
+		Height: info.Version,
+	}
+}
+
+func (b BaseApp) simulateDeliver(txBytes []byte) (_ *weave.DeliverResult, err error) {
+	defer errors.Recover(&err)
+
+	tx, err := b.loadTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	ctx := weave.WithLogInfo(b.BlockContext(),
+		"call", "simulate",
+		"path", weave.GetPath(tx))
+
+	// Changes are never written to the committed store.
+	db := b.store.committed.CacheWrap()
+	defer db.Discard()
+	return b.handler.Deliver(ctx, db, tx)
+}
+
 // BeginBlock - ABCI
 func (b BaseApp) BeginBlock(req abci.RequestBeginBlock) abci.ResponseBeginBlock {
 	// default: set the context properly
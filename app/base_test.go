@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+func TestSimulate(t *testing.T) {
+	decoder := func(raw []byte) (weave.Tx, error) {
+		if string(raw) == "invalid" {
+			return nil, errors.Wrap(errors.ErrInput, "cannot decode")
+		}
+		return &weavetest.Tx{}, nil
+	}
+
+	cases := map[string]struct {
+		Handler  weave.Handler
+		Data     []byte
+		WantCode uint32
+		WantRes  abci.ResponseDeliverTx
+	}{
+		"success": {
+			Handler: &simulateHandler{
+				res: weave.DeliverResult{
+					Data:    []byte("data"),
+					Log:     "log",
+					GasUsed: 42,
+					Tags:    []common.KVPair{{Key: []byte("key"), Value: []byte("value")}},
+				},
+			},
+			Data: []byte("tx"),
+			WantRes: abci.ResponseDeliverTx{
+				Data:    []byte("data"),
+				Log:     "log",
+				GasUsed: 42,
+				Tags:    []common.KVPair{{Key: []byte("key"), Value: []byte("value")}},
+			},
+		},
+		"handler failure": {
+			Handler:  &simulateHandler{err: errors.ErrAmount},
+			Data:     []byte("tx"),
+			WantCode: errors.ErrAmount.ABCICode(),
+		},
+		"invalid transaction": {
+			Handler:  &simulateHandler{},
+			Data:     []byte("invalid"),
+			WantCode: errors.ErrInput.ABCICode(),
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			store := NewStoreApp("dummy", iavl.MockCommitStore(), weave.NewQueryRouter(), context.Background())
+			app := NewBaseApp(store, decoder, tc.Handler, nil, false)
+
+			resp := app.Query(abci.RequestQuery{Path: SimulatePath, Data: tc.Data})
+			assert.Equal(t, tc.WantCode, resp.Code)
+			if tc.WantCode == 0 {
+				var res abci.ResponseDeliverTx
+				assert.Nil(t, res.Unmarshal(resp.Value))
+				assert.Equal(t, tc.WantRes.Data, res.Data)
+				assert.Equal(t, tc.WantRes.Log, res.Log)
+				assert.Equal(t, tc.WantRes.GasUsed, res.GasUsed)
+				assert.Equal(t, len(tc.WantRes.Tags), len(res.Tags))
+				for i, tag := range tc.WantRes.Tags {
+					assert.Equal(t, tag.Key, res.Tags[i].Key)
+					assert.Equal(t, tag.Value, res.Tags[i].Value)
+				}
+			}
+
+			// Simulation must not change the state.
+			for _, db := range []weave.ReadOnlyKVStore{app.DeliverStore(), app.CheckStore()} {
+				if ok, err := db.Has([]byte("simulated")); err != nil || ok {
+					t.Fatalf("simulation changed the state: %v, %v", ok, err)
+				}
+			}
+		})
+	}
+}
+
+func TestSimulatePassesOtherQueries(t *testing.T) {
+	store := NewStoreApp("dummy", iavl.MockCommitStore(), weave.NewQueryRouter(), context.Background())
+	app := NewBaseApp(store, nil, &simulateHandler{}, nil, false)
+	resp := app.Query(abci.RequestQuery{Path: "/unknown"})
+	assert.Equal(t, errors.ErrNotFound.ABCICode(), resp.Code)
+}
+
+// simulateHandler writes to the store and returns the configured result.
+type simulateHandler struct {
+	res weave.DeliverResult
+	err error
+}
+
+func (h *simulateHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	return &weave.CheckResult{}, nil
+}
+
+func (h *simulateHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	if err := db.Set([]byte("simulated"), []byte("1")); err != nil {
+		return nil, err
+	}
+	if h.err != nil {
+		return nil, h.err
+	}
+	res := h.res
+	return &res, nil
+}
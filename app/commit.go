@@ -56,6 +56,16 @@ func (cs *CommitStore) Commit() (weave.CommitID, error) {
 	return res, nil
 }
 
+// Compact reclaims the disk space of released data if the underlying store
+// supports compaction.
+func (cs *CommitStore) Compact() error {
+	c, ok := cs.committed.(weave.CompactableKVStore)
+	if !ok {
+		return errors.Wrap(errors.ErrHuman, "store does not support compaction")
+	}
+	return c.Compact()
+}
+
 // CheckStore returns a store implementation that must be used during the
 // checking phase.
 func (cs *CommitStore) CheckStore() weave.CacheableKVStore {
@@ -20,17 +20,40 @@ var isPath = regexp.MustCompile(`^[a-zA-Z0-9_/]+$`).MatchString
 // maybe take code from here?
 // https://github.com/julienschmidt/httprouter
 // https://github.com/julienschmidt/httprouter/blob/master/tree.go
+//
+// Router also implements weave.HookRegistry. Hooks are called in order of
+// registration. If any hook is registered for a message path, the hooks and
+// the handler are processed using a cache wrapped store, so that all changes
+// are discarded if any of them fails.
+//
+// A handler can be registered for a message path only on chains with an ID
+// matching a pattern, see HandleChain. This allows a single binary to serve
+// multiple networks that support slightly different sets of messages.
 type Router struct {
-	routes map[string]weave.Handler
+	routes      map[string]weave.Handler
+	chainRoutes map[string][]chainRoute
+	preHooks    map[string][]weave.PreHook
+	postHooks   map[string][]weave.PostHook
+}
+
+// chainRoute is a handler registered for chains with an ID matching the
+// pattern.
+type chainRoute struct {
+	pattern *regexp.Regexp
+	handler weave.Handler
 }
 
 var _ weave.Registry = (*Router)(nil)
+var _ weave.HookRegistry = (*Router)(nil)
 var _ weave.Handler = (*Router)(nil)
 
 // NewRouter returns a new empty router instance.
 func NewRouter() *Router {
 	return &Router{
-		routes: make(map[string]weave.Handler),
+		routes:      make(map[string]weave.Handler),
+		chainRoutes: make(map[string][]chainRoute),
+		preHooks:    make(map[string][]weave.PreHook),
+		postHooks:   make(map[string][]weave.PostHook),
 	}
 }
 
@@ -46,10 +69,81 @@ func (r *Router) Handle(m weave.Msg, h weave.Handler) {
 	r.routes[path] = h
 }
 
-// handler returns the registered Handler for this path. If no path is found,
-// returns a noSuchPath Handler.  This method always returns a non-nil Handler.
-func (r *Router) handler(m weave.Msg) weave.Handler {
+// HandleChain registers a handler for the message path that is used only when
+// the chain ID matches given regular expression. Use anchors (^ and $) to
+// match the whole chain ID.
+//
+// Handlers registered for a chain take precedence over those registered using
+// Handle. If the chain ID matches more than one pattern, the handler that was
+// registered first is used.
+func (r *Router) HandleChain(chainPattern string, m weave.Msg, h weave.Handler) {
+	path := m.Path()
+	if !isPath(path) {
+		panic(fmt.Sprintf("invalid path: %T: %s", m, path))
+	}
+	pattern, err := regexp.Compile(chainPattern)
+	if err != nil {
+		panic(fmt.Sprintf("invalid chain pattern: %q: %s", chainPattern, err))
+	}
+	for _, cr := range r.chainRoutes[path] {
+		if cr.pattern.String() == chainPattern {
+			panic(fmt.Sprintf("re-registering route: %T: %s: %s", m, chainPattern, path))
+		}
+	}
+	r.chainRoutes[path] = append(r.chainRoutes[path], chainRoute{
+		pattern: pattern,
+		handler: h,
+	})
+}
+
+// ChainRegistry returns a registry that registers all handlers with the
+// router only for chains with an ID matching given regular expression. This
+// allows to use any extension RegisterRoutes function for a subset of
+// networks.
+func ChainRegistry(chainPattern string, r *Router) weave.Registry {
+	return &chainRegistry{pattern: chainPattern, router: r}
+}
+
+type chainRegistry struct {
+	pattern string
+	router  *Router
+}
+
+func (r *chainRegistry) Handle(m weave.Msg, h weave.Handler) {
+	r.router.HandleChain(r.pattern, m, h)
+}
+
+// PreHook implements weave.HookRegistry interface.
+func (r *Router) PreHook(m weave.Msg, h weave.PreHook) {
+	path := m.Path()
+	if !isPath(path) {
+		panic(fmt.Sprintf("invalid path: %T: %s", m, path))
+	}
+	r.preHooks[path] = append(r.preHooks[path], h)
+}
+
+// PostHook implements weave.HookRegistry interface.
+func (r *Router) PostHook(m weave.Msg, h weave.PostHook) {
+	path := m.Path()
+	if !isPath(path) {
+		panic(fmt.Sprintf("invalid path: %T: %s", m, path))
+	}
+	r.postHooks[path] = append(r.postHooks[path], h)
+}
+
+// handler returns the registered Handler for this path and the chain ID from
+// the context. If no path is found, returns a noSuchPath Handler.  This method
+// always returns a non-nil Handler.
+func (r *Router) handler(ctx weave.Context, m weave.Msg) weave.Handler {
 	path := m.Path()
+	if routes := r.chainRoutes[path]; len(routes) != 0 {
+		chainID := weave.GetChainID(ctx)
+		for _, cr := range routes {
+			if cr.pattern.MatchString(chainID) {
+				return cr.handler
+			}
+		}
+	}
 	if h, ok := r.routes[path]; ok {
 		return h
 	}
@@ -62,8 +156,28 @@ func (r *Router) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*we
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot load msg")
 	}
-	h := r.handler(msg)
-	return h.Check(ctx, store, tx)
+	h := r.handler(ctx, msg)
+
+	pre := r.preHooks[msg.Path()]
+	if len(pre) == 0 {
+		return h.Check(ctx, store, tx)
+	}
+	cache, db := cacheWrap(store)
+	for _, hook := range pre {
+		if err := hook(ctx, db, tx); err != nil {
+			discard(cache)
+			return nil, errors.Wrap(err, "pre hook")
+		}
+	}
+	res, err := h.Check(ctx, db, tx)
+	if err != nil {
+		discard(cache)
+		return nil, err
+	}
+	if err := write(cache); err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 // Deliver dispatches to the proper handler based on path
@@ -72,8 +186,60 @@ func (r *Router) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot load msg")
 	}
-	h := r.handler(msg)
-	return h.Deliver(ctx, store, tx)
+	h := r.handler(ctx, msg)
+
+	pre, post := r.preHooks[msg.Path()], r.postHooks[msg.Path()]
+	if len(pre) == 0 && len(post) == 0 {
+		return h.Deliver(ctx, store, tx)
+	}
+	cache, db := cacheWrap(store)
+	for _, hook := range pre {
+		if err := hook(ctx, db, tx); err != nil {
+			discard(cache)
+			return nil, errors.Wrap(err, "pre hook")
+		}
+	}
+	res, err := h.Deliver(ctx, db, tx)
+	if err != nil {
+		discard(cache)
+		return nil, err
+	}
+	for _, hook := range post {
+		if err := hook(ctx, db, tx, res); err != nil {
+			discard(cache)
+			return nil, errors.Wrap(err, "post hook")
+		}
+	}
+	if err := write(cache); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// cacheWrap returns a cache of the given store. A store that cannot be cached
+// is returned as it is together with a nil cache.
+func cacheWrap(store weave.KVStore) (weave.KVCacheWrap, weave.KVStore) {
+	if cstore, ok := store.(weave.CacheableKVStore); ok {
+		cache := cstore.CacheWrap()
+		return cache, cache
+	}
+	return nil, store
+}
+
+func discard(cache weave.KVCacheWrap) {
+	if cache != nil {
+		cache.Discard()
+	}
+}
+
+func write(cache weave.KVCacheWrap) error {
+	if cache == nil {
+		return nil
+	}
+	if err := cache.Write(); err != nil {
+		return errors.Wrap(err, "write cache")
+	}
+	return nil
 }
 
 // notFoundHandler always returns ErrNotFound error regardless of the arguments
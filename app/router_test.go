@@ -4,7 +4,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
 )
@@ -55,3 +57,211 @@ func TestRegisteringMessageHandlerTwice(t *testing.T) {
 		r.Handle(&weavetest.Msg{RoutePath: "test/msg"}, &weavetest.Handler{})
 	})
 }
+
+func TestRouterHooks(t *testing.T) {
+	msg := &weavetest.Msg{RoutePath: "test/hooked"}
+	tx := &weavetest.Tx{Msg: msg}
+
+	var calls []string
+	pre := func(name string, err error) weave.PreHook {
+		return func(ctx weave.Context, db weave.KVStore, tx weave.Tx) error {
+			calls = append(calls, name)
+			if err := db.Set([]byte(name), []byte("1")); err != nil {
+				return err
+			}
+			return err
+		}
+	}
+	post := func(name string, err error) weave.PostHook {
+		return func(ctx weave.Context, db weave.KVStore, tx weave.Tx, res *weave.DeliverResult) error {
+			calls = append(calls, name)
+			res.Log += name
+			if err := db.Set([]byte(name), []byte("1")); err != nil {
+				return err
+			}
+			return err
+		}
+	}
+
+	cases := map[string]struct {
+		Pre            []weave.PreHook
+		Post           []weave.PostHook
+		Handler        *weavetest.Handler
+		WantCheckErr   *errors.Error
+		WantDeliverErr *errors.Error
+		WantCalls      []string
+		WantLog        string
+		WantKeys       []string
+	}{
+		"hooks are called in order around the handler": {
+			Pre:       []weave.PreHook{pre("pre1", nil), pre("pre2", nil)},
+			Post:      []weave.PostHook{post("post1", nil), post("post2", nil)},
+			Handler:   &weavetest.Handler{},
+			WantCalls: []string{"pre1", "pre2", "pre1", "pre2", "post1", "post2"},
+			WantLog:   "post1post2",
+			WantKeys:  []string{"pre1", "pre2", "post1", "post2"},
+		},
+		"pre hook failure aborts processing": {
+			Pre:            []weave.PreHook{pre("pre1", errors.ErrState)},
+			Post:           []weave.PostHook{post("post1", nil)},
+			Handler:        &weavetest.Handler{},
+			WantCheckErr:   errors.ErrState,
+			WantDeliverErr: errors.ErrState,
+			WantCalls:      []string{"pre1", "pre1"},
+		},
+		"post hook failure discards all changes": {
+			Pre:            []weave.PreHook{pre("pre1", nil)},
+			Post:           []weave.PostHook{post("post1", errors.ErrState)},
+			Handler:        &weavetest.Handler{},
+			WantDeliverErr: errors.ErrState,
+			WantCalls:      []string{"pre1", "pre1", "post1"},
+		},
+		"post hooks are not called when the handler fails": {
+			Post:           []weave.PostHook{post("post1", nil)},
+			Handler:        &weavetest.Handler{DeliverErr: errors.ErrAmount},
+			WantDeliverErr: errors.ErrAmount,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			calls = nil
+			r := NewRouter()
+			r.Handle(msg, tc.Handler)
+			for _, h := range tc.Pre {
+				r.PreHook(msg, h)
+			}
+			for _, h := range tc.Post {
+				r.PostHook(msg, h)
+			}
+
+			// Check changes are never persisted in this test.
+			if _, err := r.Check(context.TODO(), store.MemStore(), tx); !tc.WantCheckErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+
+			db := store.MemStore()
+			res, err := r.Deliver(context.TODO(), db, tx)
+			if !tc.WantDeliverErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+			if err == nil {
+				assert.Equal(t, tc.WantLog, res.Log)
+			}
+			assert.Equal(t, tc.WantCalls, calls)
+
+			for _, key := range []string{"pre1", "pre2", "post1", "post2"} {
+				want := false
+				for _, k := range tc.WantKeys {
+					want = want || k == key
+				}
+				if ok, err := db.Has([]byte(key)); err != nil || ok != want {
+					t.Fatalf("key %q: want stored %v, got %v (%v)", key, want, ok, err)
+				}
+			}
+		})
+	}
+}
+
+func TestRegisteringHookWithInvalidPath(t *testing.T) {
+	r := NewRouter()
+	assert.Panics(t, func() {
+		r.PreHook(&weavetest.Msg{RoutePath: ": "}, nil)
+	})
+	assert.Panics(t, func() {
+		r.PostHook(&weavetest.Msg{RoutePath: ": "}, nil)
+	})
+}
+
+func TestRouterChainRoutes(t *testing.T) {
+	var (
+		msg     = &weavetest.Msg{RoutePath: "test/msg"}
+		other   = &weavetest.Msg{RoutePath: "test/other"}
+		def     = &weavetest.Handler{}
+		mainnet = &weavetest.Handler{}
+		testnet = &weavetest.Handler{}
+	)
+
+	r := NewRouter()
+	r.Handle(msg, def)
+	r.HandleChain(`^mainnet-\d+$`, msg, mainnet)
+	ChainRegistry(`^testnet-`, r).Handle(msg, testnet)
+	ChainRegistry(`^testnet-`, r).Handle(other, testnet)
+
+	cases := map[string]struct {
+		ChainID     string
+		Msg         weave.Msg
+		WantHandler *weavetest.Handler
+		WantErr     *errors.Error
+	}{
+		"default handler": {
+			ChainID:     "devnet-1",
+			Msg:         msg,
+			WantHandler: def,
+		},
+		"mainnet handler": {
+			ChainID:     "mainnet-2",
+			Msg:         msg,
+			WantHandler: mainnet,
+		},
+		"pattern must match": {
+			ChainID:     "mainnet-x",
+			Msg:         msg,
+			WantHandler: def,
+		},
+		"testnet handler": {
+			ChainID:     "testnet-jan",
+			Msg:         msg,
+			WantHandler: testnet,
+		},
+		"message enabled on testnet only": {
+			ChainID:     "testnet-feb",
+			Msg:         other,
+			WantHandler: testnet,
+		},
+		"message not enabled": {
+			ChainID: "mainnet-2",
+			Msg:     other,
+			WantErr: errors.ErrNotFound,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			ctx := weave.WithChainID(context.Background(), tc.ChainID)
+			tx := &weavetest.Tx{Msg: tc.Msg}
+
+			handlers := []*weavetest.Handler{def, mainnet, testnet}
+			before := make([]int, len(handlers))
+			for i, h := range handlers {
+				before[i] = h.CallCount()
+			}
+
+			if _, err := r.Check(ctx, nil, tx); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected check error: %s", err)
+			}
+			if _, err := r.Deliver(ctx, nil, tx); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %s", err)
+			}
+
+			for i, h := range handlers {
+				want := before[i]
+				if h == tc.WantHandler {
+					want += 2
+				}
+				assert.Equal(t, want, h.CallCount())
+			}
+		})
+	}
+}
+
+func TestRegisteringChainHandlerTwice(t *testing.T) {
+	r := NewRouter()
+	r.HandleChain(`^mainnet$`, &weavetest.Msg{RoutePath: "test/msg"}, &weavetest.Handler{})
+	r.HandleChain(`^testnet$`, &weavetest.Msg{RoutePath: "test/msg"}, &weavetest.Handler{})
+	assert.Panics(t, func() {
+		r.HandleChain(`^mainnet$`, &weavetest.Msg{RoutePath: "test/msg"}, &weavetest.Handler{})
+	})
+	assert.Panics(t, func() {
+		r.HandleChain(`(`, &weavetest.Msg{RoutePath: "test/msg"}, &weavetest.Handler{})
+	})
+}
@@ -1,13 +1,16 @@
 package app
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
@@ -199,7 +202,8 @@ Query gets data from the app store.
 A query request has the following elements:
 * Path - the type of query
 * Data - what to query, interpreted based on Path
-* Height - the block height to query (if 0 most recent)
+* Height - the block height to query (if 0 most recent). Past
+  heights can be queried unless they were pruned
 * Prove - if true, also return a proof
 
 Path may be "/", "/<bucket>", or "/<bucket>/<index>"
@@ -210,6 +214,11 @@ Key and Value in Results are always serialized ResultSet
 objects, able to support 0 to N values. They must be the
 same size. This makes things a little more difficult for
 simple queries, but provides a consistent interface.
+
+A proof can be returned for queries that read a single key,
+for example a bucket query without a modifier, or a range of
+keys, for example a bucket prefix query. It proves that the
+result matches the state of the returned height.
 */
 func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuery) {
 
@@ -223,23 +232,11 @@ func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuer
 		return
 	}
 
-	// TODO: support historical queries by getting old read-only
-	// height := reqQuery.Height
-	// if height == 0 {
-	// 	withProof := s.CommittedHeight() - 1
-	// 	if tree.Tree.VersionExists(uint64(withProof)) {
-	// 		height = withProof
-	// 	} else {
-	// 		height = s.CommittedHeight()
-	// 	}
-	// }
-	info, err := s.store.CommitInfo()
+	db, ps, height, err := s.queryStore(reqQuery.Height)
 	if err != nil {
 		return queryError(err)
 	}
-	resQuery.Height = info.Version
-	// TODO: better version handling!
-	db := s.store.committed.CacheWrap()
+	resQuery.Height = height
 
 	// make the query
 	models, err := qh.Query(db, mod, reqQuery.Data)
@@ -257,19 +254,102 @@ func (s *StoreApp) Query(reqQuery abci.RequestQuery) (resQuery abci.ResponseQuer
 		return queryError(err)
 	}
 
-	// TODO: support proofs given this info....
-	// if reqQuery.Prove {
-	//  value, proof, err := tree.GetVersionedWithProof(key, height)
-	//  if err != nil {
-	//      resQuery.Log = err.Error()
-	//      break
-	//  }
-	//  resQuery.Value = value
-	//  resQuery.Proof = proof.Bytes()
-
+	if reqQuery.Prove {
+		resQuery.Proof, err = prove(ps, qh, mod, reqQuery.Data, models)
+		if err != nil {
+			return queryError(err)
+		}
+	}
 	return resQuery
 }
 
+// queryStore returns the state at given height that a query is run against,
+// together with the store that can prove it, if any. A zero height means the
+// latest committed state. Past heights can be queried as long as the store
+// keeps their versions.
+func (s *StoreApp) queryStore(height int64) (weave.ReadOnlyKVStore, weave.ProvableKVStore, int64, error) {
+	info, err := s.store.CommitInfo()
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	if height == 0 || height == info.Version {
+		ps, _ := s.store.committed.(weave.ProvableKVStore)
+		return s.store.committed.CacheWrap(), ps, info.Version, nil
+	}
+	if height < 0 || height > info.Version {
+		return nil, nil, 0, errors.Wrapf(errors.ErrInput, "height %d is not committed", height)
+	}
+	vs, ok := s.store.committed.(weave.VersionedKVStore)
+	if !ok {
+		return nil, nil, 0, errors.Wrap(errors.ErrHuman, "store does not support historical queries")
+	}
+	db, err := vs.ReadVersion(height)
+	if err != nil {
+		return nil, nil, 0, errors.Wrapf(err, "height %d", height)
+	}
+	ps, _ := db.(weave.ProvableKVStore)
+	return db, ps, height, nil
+}
+
+// prove returns a merkle proof of the query result. Queries that read a
+// single database key are proven by the presence of the returned value or,
+// if nothing was found, by the absence of the key. Queries that read a range
+// of keys are proven by a range proof of all returned values.
+func prove(ps weave.ProvableKVStore, qh weave.QueryHandler, mod string, data []byte, models []weave.Model) (*merkle.Proof, error) {
+	if ps == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "store does not support proofs")
+	}
+	if rqh, ok := qh.(weave.ProvableRangeQueryHandler); ok {
+		switch start, end, err := rqh.QueryRange(mod, data); {
+		case err == nil:
+			return proveRange(ps, start, end, models)
+		case !errors.ErrInput.Is(err):
+			return nil, errors.Wrap(err, "query range")
+		}
+	}
+	pqh, ok := qh.(weave.ProvableQueryHandler)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrInput, "query cannot be proven")
+	}
+	key, err := pqh.QueryKey(mod, data)
+	if err != nil {
+		return nil, errors.Wrap(err, "query key")
+	}
+	value, proof, err := ps.GetWithProof(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "proof")
+	}
+	// Proof must be of the same state that the query was run against.
+	switch {
+	case len(models) > 1:
+		return nil, errors.Wrap(errors.ErrInput, "query cannot be proven")
+	case len(models) == 1 && !bytes.Equal(models[0].Value, value):
+		return nil, errors.Wrap(errors.ErrState, "proven value does not match the result")
+	case len(models) == 0 && value != nil:
+		return nil, errors.Wrap(errors.ErrState, "proven value does not match the result")
+	}
+	return proof, nil
+}
+
+// proveRange returns a merkle proof that models are all values stored in
+// range [start, end).
+func proveRange(ps weave.ProvableKVStore, start, end []byte, models []weave.Model) (*merkle.Proof, error) {
+	keys, values, proof, err := ps.GetRangeWithProof(start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "proof")
+	}
+	// Proof must be of the same state that the query was run against.
+	if len(keys) != len(models) {
+		return nil, errors.Wrap(errors.ErrState, "proven values do not match the result")
+	}
+	for i, m := range models {
+		if !bytes.Equal(m.Key, keys[i]) || !bytes.Equal(m.Value, values[i]) {
+			return nil, errors.Wrap(errors.ErrState, "proven values do not match the result")
+		}
+	}
+	return proof, nil
+}
+
 // splitPath splits out the real path along with the query
 // modifier (everything after the ?)
 func splitPath(path string) (string, string) {
@@ -306,6 +386,17 @@ func (s *StoreApp) Commit() (res abci.ResponseCommit) {
 	return abci.ResponseCommit{Data: commitID.Hash}
 }
 
+// Compact reclaims the disk space of released data, for example of pruned
+// versions. It is safe to call it while the application is running.
+func (s *StoreApp) Compact() error {
+	start := time.Now()
+	if err := s.store.Compact(); err != nil {
+		return errors.Wrap(err, "compact")
+	}
+	s.logger.Info("Store compacted", "duration", time.Since(start))
+	return nil
+}
+
 // InitChain implements ABCI
 // Note: in tendermint 0.17, the genesis file is passed
 // in here, we should use this to trigger reading the genesis now
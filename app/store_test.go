@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store/iavl"
 	"github.com/iov-one/weave/weavetest/assert"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -55,3 +56,198 @@ func TestAddValChange(t *testing.T) {
 		assert.Equal(t, diff, weave.ValidatorUpdatesFromABCI(res.ValidatorUpdates).ValidatorUpdates)
 	})
 }
+
+func TestQueryWithProof(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	qr.Register("/provable", provableQueryHandler{})
+	qr.Register("/plain", plainQueryHandler{})
+	app := NewStoreApp("dummy", iavl.MockCommitStore(), qr, context.Background())
+
+	assert.Nil(t, app.DeliverStore().Set([]byte("p:alice"), []byte("1")))
+	assert.Nil(t, app.DeliverStore().Set([]byte("p:alex"), []byte("2")))
+	assert.Nil(t, app.DeliverStore().Set([]byte("p:bob"), []byte("3")))
+	appHash := app.Commit().Data
+
+	cases := map[string]struct {
+		Path       string
+		Data       []byte
+		WantCode   uint32
+		WantModels []weave.Model
+	}{
+		"proof of presence": {
+			Path:       "/provable",
+			Data:       []byte("alice"),
+			WantModels: []weave.Model{weave.Pair([]byte("p:alice"), []byte("1"))},
+		},
+		"proof of absence": {
+			Path: "/provable",
+			Data: []byte("charlie"),
+		},
+		"prefix query proof": {
+			Path: "/provable?prefix",
+			Data: []byte("al"),
+			WantModels: []weave.Model{
+				weave.Pair([]byte("p:alex"), []byte("2")),
+				weave.Pair([]byte("p:alice"), []byte("1")),
+			},
+		},
+		"empty prefix query proof": {
+			Path: "/provable?prefix",
+			Data: []byte("c"),
+		},
+		"handler does not support proofs": {
+			Path:     "/plain",
+			Data:     []byte("alice"),
+			WantCode: errors.ErrInput.ABCICode(),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			res := app.Query(abci.RequestQuery{Path: tc.Path, Data: tc.Data, Prove: true})
+			if res.Code != tc.WantCode {
+				t.Fatalf("want %d code, got %d: %s", tc.WantCode, res.Code, res.Log)
+			}
+			if tc.WantCode != 0 {
+				return
+			}
+			path, mod := splitPath(tc.Path)
+			if path != "/provable" {
+				t.Fatalf("unexpected path: %s", path)
+			}
+			if mod == weave.PrefixQueryMod {
+				start, end, err := provableQueryHandler{}.QueryRange(mod, tc.Data)
+				assert.Nil(t, err)
+				var keys, values [][]byte
+				for _, m := range tc.WantModels {
+					keys = append(keys, m.Key)
+					values = append(values, m.Value)
+				}
+				assert.Nil(t, iavl.VerifyRangeProof(res.Proof, appHash, start, end, keys, values))
+				return
+			}
+			key := append([]byte("p:"), tc.Data...)
+			var value []byte
+			if len(tc.WantModels) != 0 {
+				value = tc.WantModels[0].Value
+			}
+			assert.Nil(t, iavl.VerifyProof(res.Proof, appHash, key, value))
+		})
+	}
+}
+
+func TestHistoricalQuery(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	qr.Register("/provable", provableQueryHandler{})
+	app := NewStoreApp("dummy", iavl.MockCommitStore(), qr, context.Background())
+
+	assert.Nil(t, app.DeliverStore().Set([]byte("p:alice"), []byte("1")))
+	first := app.Commit().Data
+	assert.Nil(t, app.DeliverStore().Set([]byte("p:alice"), []byte("2")))
+	second := app.Commit().Data
+
+	cases := map[string]struct {
+		Height     int64
+		WantCode   uint32
+		WantHeight int64
+		WantHash   []byte
+		WantValue  []byte
+	}{
+		"latest height": {
+			Height:     0,
+			WantHeight: 2,
+			WantHash:   second,
+			WantValue:  []byte("2"),
+		},
+		"latest height explicitly": {
+			Height:     2,
+			WantHeight: 2,
+			WantHash:   second,
+			WantValue:  []byte("2"),
+		},
+		"past height": {
+			Height:     1,
+			WantHeight: 1,
+			WantHash:   first,
+			WantValue:  []byte("1"),
+		},
+		"future height": {
+			Height:   3,
+			WantCode: errors.ErrInput.ABCICode(),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			res := app.Query(abci.RequestQuery{Path: "/provable", Data: []byte("alice"), Height: tc.Height, Prove: true})
+			if res.Code != tc.WantCode {
+				t.Fatalf("want %d code, got %d: %s", tc.WantCode, res.Code, res.Log)
+			}
+			if tc.WantCode != 0 {
+				return
+			}
+			assert.Equal(t, tc.WantHeight, res.Height)
+			var values ResultSet
+			assert.Nil(t, values.Unmarshal(res.Value))
+			assert.Equal(t, [][]byte{tc.WantValue}, values.Results)
+			assert.Nil(t, iavl.VerifyProof(res.Proof, tc.WantHash, []byte("p:alice"), tc.WantValue))
+		})
+	}
+}
+
+type provableQueryHandler struct{}
+
+func (h provableQueryHandler) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod == weave.PrefixQueryMod {
+		start, end, err := h.QueryRange(mod, data)
+		if err != nil {
+			return nil, err
+		}
+		it, err := db.Iterator(start, end)
+		if err != nil {
+			return nil, err
+		}
+		defer it.Release()
+		var models []weave.Model
+		for {
+			key, val, err := it.Next()
+			if errors.ErrIteratorDone.Is(err) {
+				return models, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			models = append(models, weave.Pair(key, val))
+		}
+	}
+	key := append([]byte("p:"), data...)
+	val, err := db.Get(key)
+	if err != nil || val == nil {
+		return nil, err
+	}
+	return []weave.Model{weave.Pair(key, val)}, nil
+}
+
+func (provableQueryHandler) QueryKey(mod string, data []byte) ([]byte, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrap(errors.ErrInput, "cannot prove")
+	}
+	return append([]byte("p:"), data...), nil
+}
+
+func (provableQueryHandler) QueryRange(mod string, data []byte) ([]byte, []byte, error) {
+	if mod != weave.PrefixQueryMod {
+		return nil, nil, errors.Wrap(errors.ErrInput, "cannot prove")
+	}
+	start := append([]byte("p:"), data...)
+	end := append([]byte("p:"), data...)
+	end[len(end)-1]++
+	return start, end, nil
+}
+
+// plainQueryHandler does not implement weave.ProvableQueryHandler.
+type plainQueryHandler struct{}
+
+func (plainQueryHandler) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	return provableQueryHandler{}.Query(db, mod, data)
+}
@@ -0,0 +1,101 @@
+/*
+Package verify allows to check the results of ABCI queries returned by an
+untrusted node.
+
+A query must be made with the Prove flag set. The result is verified against
+the app hash of the queried state. The app hash of the state at height H is
+part of the header of the block at height H+1, so it can be trusted as long as
+block headers are verified, for example by a light client.
+*/
+package verify
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store/iavl"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// Key verifies the response of a key query that read given database key. It
+// returns the proven result, which is empty if the key does not exist.
+func Key(res abci.ResponseQuery, appHash, key []byte) ([]weave.Model, error) {
+	models, err := models(res)
+	if err != nil {
+		return nil, err
+	}
+	var value []byte
+	switch len(models) {
+	case 0:
+	case 1:
+		if !bytes.Equal(models[0].Key, key) {
+			return nil, errors.Wrap(errors.ErrUnauthorized, "result key does not match")
+		}
+		value = models[0].Value
+	default:
+		return nil, errors.Wrap(errors.ErrUnauthorized, "more than one result")
+	}
+	if err := iavl.VerifyProof(res.Proof, appHash, key, value); err != nil {
+		return nil, errors.Wrap(err, "invalid proof")
+	}
+	return models, nil
+}
+
+// Prefix verifies the response of a prefix query that read all database keys
+// starting with given prefix. It returns the proven result.
+func Prefix(res abci.ResponseQuery, appHash, prefix []byte) ([]weave.Model, error) {
+	return Range(res, appHash, prefix, prefixEnd(prefix))
+}
+
+// Range verifies the response of a query that read all database keys in range
+// [start, end). A nil end means that the range has no upper limit. It returns
+// the proven result.
+func Range(res abci.ResponseQuery, appHash, start, end []byte) ([]weave.Model, error) {
+	models, err := models(res)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([][]byte, len(models))
+	values := make([][]byte, len(models))
+	for i, m := range models {
+		keys[i], values[i] = m.Key, m.Value
+	}
+	if err := iavl.VerifyRangeProof(res.Proof, appHash, start, end, keys, values); err != nil {
+		return nil, errors.Wrap(err, "invalid proof")
+	}
+	return models, nil
+}
+
+// models decodes the result of a successful query.
+func models(res abci.ResponseQuery) ([]weave.Model, error) {
+	if res.IsErr() {
+		return nil, errors.ABCIError(res.Code, res.Log)
+	}
+	var keys, values app.ResultSet
+	if err := keys.Unmarshal(res.Key); err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "cannot decode keys")
+	}
+	if err := values.Unmarshal(res.Value); err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "cannot decode values")
+	}
+	models, err := app.JoinResults(&keys, &values)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	return models, nil
+}
+
+// prefixEnd returns the end of the range of keys read by a prefix query. It
+// must match the range used by the orm package.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end
+		}
+	}
+	return nil
+}
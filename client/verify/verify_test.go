@@ -0,0 +1,129 @@
+package verify
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+func TestVerify(t *testing.T) {
+	commit := iavl.MockCommitStore()
+	db := commit.CacheWrap()
+	assert.Nil(t, db.Set([]byte("p:alice"), []byte("1")))
+	assert.Nil(t, db.Set([]byte("p:alex"), []byte("2")))
+	assert.Nil(t, db.Set([]byte("p:bob"), []byte("3")))
+	assert.Nil(t, db.Write())
+	id, err := commit.Commit()
+	assert.Nil(t, err)
+
+	alice := weave.Pair([]byte("p:alice"), []byte("1"))
+	alex := weave.Pair([]byte("p:alex"), []byte("2"))
+
+	_, aliceProof, err := commit.GetWithProof([]byte("p:alice"))
+	assert.Nil(t, err)
+	_, charlieProof, err := commit.GetWithProof([]byte("p:charlie"))
+	assert.Nil(t, err)
+	_, _, alProof, err := commit.GetRangeWithProof([]byte("p:al"), []byte("p:am"))
+	assert.Nil(t, err)
+
+	cases := map[string]struct {
+		Res        abci.ResponseQuery
+		Verify     func(abci.ResponseQuery) ([]weave.Model, error)
+		WantErr    *errors.Error
+		WantModels []weave.Model
+	}{
+		"key present": {
+			Res:        response(t, aliceProof, alice),
+			Verify:     func(res abci.ResponseQuery) ([]weave.Model, error) { return Key(res, id.Hash, []byte("p:alice")) },
+			WantModels: []weave.Model{alice},
+		},
+		"key absent": {
+			Res:    response(t, charlieProof),
+			Verify: func(res abci.ResponseQuery) ([]weave.Model, error) { return Key(res, id.Hash, []byte("p:charlie")) },
+		},
+		"key hidden": {
+			Res:     response(t, aliceProof),
+			Verify:  func(res abci.ResponseQuery) ([]weave.Model, error) { return Key(res, id.Hash, []byte("p:alice")) },
+			WantErr: errors.ErrUnauthorized,
+		},
+		"key with a modified value": {
+			Res:     response(t, aliceProof, weave.Pair([]byte("p:alice"), []byte("2"))),
+			Verify:  func(res abci.ResponseQuery) ([]weave.Model, error) { return Key(res, id.Hash, []byte("p:alice")) },
+			WantErr: errors.ErrUnauthorized,
+		},
+		"key of another query": {
+			Res:     response(t, aliceProof, alice),
+			Verify:  func(res abci.ResponseQuery) ([]weave.Model, error) { return Key(res, id.Hash, []byte("p:alex")) },
+			WantErr: errors.ErrUnauthorized,
+		},
+		"prefix": {
+			Res:        response(t, alProof, alex, alice),
+			Verify:     func(res abci.ResponseQuery) ([]weave.Model, error) { return Prefix(res, id.Hash, []byte("p:al")) },
+			WantModels: []weave.Model{alex, alice},
+		},
+		"prefix with an omitted result": {
+			Res:     response(t, alProof, alice),
+			Verify:  func(res abci.ResponseQuery) ([]weave.Model, error) { return Prefix(res, id.Hash, []byte("p:al")) },
+			WantErr: errors.ErrUnauthorized,
+		},
+		"prefix with a wrong app hash": {
+			Res: response(t, alProof, alex, alice),
+			Verify: func(res abci.ResponseQuery) ([]weave.Model, error) {
+				return Prefix(res, []byte("another hash"), []byte("p:al"))
+			},
+			WantErr: errors.ErrUnauthorized,
+		},
+		"failed query": {
+			Res:     abci.ResponseQuery{Code: errors.ErrNotFound.ABCICode(), Log: "not found"},
+			Verify:  func(res abci.ResponseQuery) ([]weave.Model, error) { return Key(res, id.Hash, []byte("p:alice")) },
+			WantErr: errors.ErrNotFound,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			models, err := tc.Verify(tc.Res)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.WantErr == nil {
+				assert.Equal(t, len(tc.WantModels), len(models))
+				for i, m := range tc.WantModels {
+					assert.Equal(t, m, models[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPrefixEnd(t *testing.T) {
+	cases := map[string]struct {
+		Prefix []byte
+		Want   []byte
+	}{
+		"normal":       {[]byte{1, 3, 4}, []byte{1, 3, 5}},
+		"empty":        {nil, nil},
+		"roll-over":    {[]byte{17, 28, 255}, []byte{17, 29, 0}},
+		"no range end": {[]byte{255, 255}, nil},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			assert.Equal(t, tc.Want, prefixEnd(tc.Prefix))
+		})
+	}
+}
+
+func response(t testing.TB, proof *merkle.Proof, models ...weave.Model) abci.ResponseQuery {
+	t.Helper()
+	keys, err := app.ResultsFromKeys(models).Marshal()
+	assert.Nil(t, err)
+	values, err := app.ResultsFromValues(models).Marshal()
+	assert.Nil(t, err)
+	return abci.ResponseQuery{Key: keys, Value: values, Proof: proof}
+}
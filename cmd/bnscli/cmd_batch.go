@@ -8,12 +8,14 @@ import (
 
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/cmd/bnsd/x/username"
+	xbatch "github.com/iov-one/weave/x/batch"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/sigs"
 	"github.com/iov-one/weave/x/validators"
 )
 
@@ -27,9 +29,15 @@ original transactions (ie signatures) are being dropped.
 		`)
 		fl.PrintDefaults()
 	}
+	var (
+		continueOnErrFl = fl.Bool("continue-on-error", false, "Process all messages even if some of them fail. By default the whole batch fails.")
+	)
 	fl.Parse(args)
 
 	var batch bnsd.ExecuteBatchMsg
+	if *continueOnErrFl {
+		batch.Mode = xbatch.Mode_ContinueOnError
+	}
 	for {
 		tx, _, err := readTx(input)
 		if err != nil {
@@ -150,6 +158,162 @@ original transactions (ie signatures) are being dropped.
 					MsgfeeSetMsgFeeMsg: msg,
 				},
 			})
+		case *cash.MultiSendMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashMultiSendMsg{
+					CashMultiSendMsg: msg,
+				},
+			})
+		case *cash.BurnMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashBurnMsg{
+					CashBurnMsg: msg,
+				},
+			})
+		case *cash.MintMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashMintMsg{
+					CashMintMsg: msg,
+				},
+			})
+		case *cash.ApproveMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashApproveMsg{
+					CashApproveMsg: msg,
+				},
+			})
+		case *cash.TransferFromMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashTransferFromMsg{
+					CashTransferFromMsg: msg,
+				},
+			})
+		case *cash.CreateVestingMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashCreateVestingMsg{
+					CashCreateVestingMsg: msg,
+				},
+			})
+		case *cash.ReleaseVestingMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashReleaseVestingMsg{
+					CashReleaseVestingMsg: msg,
+				},
+			})
+		case *cash.FreezeAccountMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashFreezeAccountMsg{
+					CashFreezeAccountMsg: msg,
+				},
+			})
+		case *cash.UnfreezeAccountMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashUnfreezeAccountMsg{
+					CashUnfreezeAccountMsg: msg,
+				},
+			})
+		case *cash.GrantFeeMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashGrantFeeMsg{
+					CashGrantFeeMsg: msg,
+				},
+			})
+		case *cash.ScheduleSendMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashScheduleSendMsg{
+					CashScheduleSendMsg: msg,
+				},
+			})
+		case *cash.CancelScheduledSendMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashCancelScheduledSendMsg{
+					CashCancelScheduledSendMsg: msg,
+				},
+			})
+		case *cash.SetWalletNameMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_CashSetWalletNameMsg{
+					CashSetWalletNameMsg: msg,
+				},
+			})
+		case *escrow.ReleaseMilestoneMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_EscrowReleaseMilestoneMsg{
+					EscrowReleaseMilestoneMsg: msg,
+				},
+			})
+		case *escrow.ApproveMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_EscrowApproveMsg{
+					EscrowApproveMsg: msg,
+				},
+			})
+		case *escrow.UpdateEscrowTimeoutMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_EscrowUpdateEscrowTimeoutMsg{
+					EscrowUpdateEscrowTimeoutMsg: msg,
+				},
+			})
+		case *escrow.RaiseDisputeMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_EscrowRaiseDisputeMsg{
+					EscrowRaiseDisputeMsg: msg,
+				},
+			})
+		case *escrow.ResolveDisputeMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_EscrowResolveDisputeMsg{
+					EscrowResolveDisputeMsg: msg,
+				},
+			})
+		case *escrow.UpdateEscrowPartiesMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_EscrowUpdateEscrowPartiesMsg{
+					EscrowUpdateEscrowPartiesMsg: msg,
+				},
+			})
+		case *multisig.CreateProposalMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_MultisigCreateProposalMsg{
+					MultisigCreateProposalMsg: msg,
+				},
+			})
+		case *multisig.ApproveProposalMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_MultisigApproveProposalMsg{
+					MultisigApproveProposalMsg: msg,
+				},
+			})
+		case *multisig.ApplyUpdateMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_MultisigApplyUpdateMsg{
+					MultisigApplyUpdateMsg: msg,
+				},
+			})
+		case *multisig.VetoUpdateMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_MultisigVetoUpdateMsg{
+					MultisigVetoUpdateMsg: msg,
+				},
+			})
+		case *multisig.ExpireProposalMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_MultisigExpireProposalMsg{
+					MultisigExpireProposalMsg: msg,
+				},
+			})
+		case *sigs.RotateKeyMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_SigsRotateKeyMsg{
+					SigsRotateKeyMsg: msg,
+				},
+			})
+		case *validators.SetBLSKeyMsg:
+			batch.Messages = append(batch.Messages, bnsd.ExecuteBatchMsg_Union{
+				Sum: &bnsd.ExecuteBatchMsg_Union_ValidatorsSetBlsKeyMsg{
+					ValidatorsSetBlsKeyMsg: msg,
+				},
+			})
 
 		case nil:
 			return errors.New("transaction without a message")
@@ -189,6 +353,33 @@ username.ChangeTokenTargetsMsg username_change_token_targets_msg = 63;
 distribution.CreateMsg distribution_create_msg = 66;
 distribution.DistributeMsg distribution_msg = 67;
 distribution.ResetMsg distribution_reset_msg = 68;
+msgfee.SetMsgFeeMsg msgfee_set_msg_fee_msg = 80;
+cash.MultiSendMsg cash_multi_send_msg = 81;
+cash.BurnMsg cash_burn_msg = 82;
+cash.MintMsg cash_mint_msg = 83;
+cash.ApproveMsg cash_approve_msg = 84;
+cash.TransferFromMsg cash_transfer_from_msg = 85;
+cash.CreateVestingMsg cash_create_vesting_msg = 86;
+cash.ReleaseVestingMsg cash_release_vesting_msg = 87;
+cash.FreezeAccountMsg cash_freeze_account_msg = 88;
+cash.UnfreezeAccountMsg cash_unfreeze_account_msg = 89;
+cash.GrantFeeMsg cash_grant_fee_msg = 90;
+cash.ScheduleSendMsg cash_schedule_send_msg = 91;
+cash.CancelScheduledSendMsg cash_cancel_scheduled_send_msg = 92;
+cash.SetWalletNameMsg cash_set_wallet_name_msg = 94;
+escrow.ReleaseMilestoneMsg escrow_release_milestone_msg = 95;
+escrow.ApproveMsg escrow_approve_msg = 96;
+escrow.UpdateEscrowTimeoutMsg escrow_update_escrow_timeout_msg = 97;
+escrow.RaiseDisputeMsg escrow_raise_dispute_msg = 98;
+escrow.ResolveDisputeMsg escrow_resolve_dispute_msg = 99;
+escrow.UpdateEscrowPartiesMsg escrow_update_escrow_parties_msg = 100;
+multisig.CreateProposalMsg multisig_create_proposal_msg = 101;
+multisig.ApproveProposalMsg multisig_approve_proposal_msg = 102;
+multisig.ApplyUpdateMsg multisig_apply_update_msg = 103;
+multisig.VetoUpdateMsg multisig_veto_update_msg = 104;
+multisig.ExpireProposalMsg multisig_expire_proposal_msg = 105;
+sigs.RotateKeyMsg sigs_rotate_key_msg = 106;
+validators.SetBLSKeyMsg validators_set_bls_key_msg = 107;
 "
 
 while read -r m; do
@@ -2,20 +2,14 @@ package main
 
 import (
 	"bytes"
-	"encoding/base64"
-	"encoding/hex"
-	"encoding/json"
-	"io"
-	"net/http"
-	"net/http/httptest"
 	"testing"
 
 	"github.com/iov-one/weave"
-	"github.com/iov-one/weave/app"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/weavetest/tmmock"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/msgfee"
 )
@@ -154,7 +148,7 @@ func TestCmdWithFeeHappyPathDefaultAmount(t *testing.T) {
 			args := []string{
 				// Instead of providing an amount, rely on what is configured
 				// for the network.
-				"-tm", tm.URL,
+				"-tm", tm.URL(),
 			}
 			if err := cmdWithFee(&input, &output, args); err != nil {
 				t.Fatalf("cannot attach a fee to transaction: %s", err)
@@ -169,121 +163,42 @@ func TestCmdWithFeeHappyPathDefaultAmount(t *testing.T) {
 	}
 }
 
-type abciQueryRequest struct {
-	ID     string `json:"id"`
-	Method string `json:"method"`
-	Params struct {
-		Data string `json:"data"` // hex-encoded
-		Path string `json:"path"`
-	} `json:"params"`
-}
-
-// newCashConfTendermintServer returns an HTTP server that can respond to an
-// HTTP json-rpc request with given configuration.
+// newCashConfTendermintServer returns a mock Tendermint server that can
+// respond to queries with given configuration.
 func newCashConfTendermintServer(
 	t *testing.T,
 	conf cash.Configuration,
 	msgfees map[string]coin.Coin,
-) *httptest.Server {
+) *tmmock.Server {
 	t.Helper()
 
-	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/" {
-			t.Fatalf("unexpected tendermint request: %s", r.URL)
-		}
-
-		defer r.Body.Close()
-		decoder := json.NewDecoder(r.Body)
-		var req abciQueryRequest
-		err := decoder.Decode(&req)
-		assert.Nil(t, err)
-		assert.Equal(t, "abci_query", req.Method)
-		assert.Equal(t, "/", req.Params.Path)
-
-		raw, err := hex.DecodeString(req.Params.Data)
-		assert.Nil(t, err)
-
-		if bytes.Equal(raw, []byte("_c:cash")) {
-			io.WriteString(w, tmResponse(t, raw, &conf, req.ID))
-			return
-		}
+	tm := tmmock.New()
 
-		if bytes.HasPrefix(raw, []byte("schema:msgfee")) {
-			pkg := "schema:msgfee"
-			cnt := raw[len(pkg):]
-			if bytes.Equal(cnt, []byte{0, 0, 0, 1}) {
-				schema := &migration.Schema{
-					Metadata: &weave.Metadata{Schema: 1},
-					Pkg:      pkg,
-					Version:  1,
-				}
-				io.WriteString(w, tmResponse(t, raw, schema, req.ID))
-			} else {
-				io.WriteString(w, tmEmptyResponse(t, req.ID))
-			}
-			return
-		}
-
-		if bytes.HasPrefix(raw, []byte("msgfee:")) {
-			path := string(raw[len("msgfee:"):])
-			fee, ok := msgfees[path]
-			if !ok {
-				io.WriteString(w, tmEmptyResponse(t, req.ID))
-				return
-			}
-			io.WriteString(w, tmResponse(t, raw, &msgfee.MsgFee{
-				Metadata: &weave.Metadata{Schema: 1},
-				MsgPath:  path,
-				Fee:      fee,
-			}, req.ID))
-			return
-		}
+	confKey := []byte("_c:cash")
+	tm.SetQuery("/", confKey, mustModel(t, confKey, &conf))
 
-		t.Fatalf("unexpected tendermint request: %X", raw)
+	schemaKey := []byte("schema:msgfee\x00\x00\x00\x01")
+	tm.SetQuery("/", schemaKey, mustModel(t, schemaKey, &migration.Schema{
+		Metadata: &weave.Metadata{Schema: 1},
+		Pkg:      "msgfee",
+		Version:  1,
 	}))
-}
-
-// tmResponse returns a tenderming HTTP response for a configuration query.
-// Returned response does not contain "key" or "height" information.
-func tmResponse(t testing.TB, key []byte, payload interface{ Marshal() ([]byte, error) }, clientID string) string {
-	value, err := payload.Marshal()
-	assert.Nil(t, err)
-
-	model := []weave.Model{{Key: key, Value: value}}
-
-	keySet, err := app.ResultsFromKeys(model).Marshal()
-	assert.Nil(t, err)
-	encKey := base64.StdEncoding.EncodeToString(keySet)
 
-	valSet, err := app.ResultsFromValues(model).Marshal()
-	assert.Nil(t, err)
-	encVal := base64.StdEncoding.EncodeToString(valSet)
-
-	return `{
-	  "jsonrpc": "2.0",
-	  "id": "` + clientID + `",
-	  "result": {
-	    "response": {
-	      "key": "` + encKey + `",
-	      "value": "` + encVal + `"
-	    }
-	  }
-	}`
+	for path, fee := range msgfees {
+		feeKey := []byte("msgfee:" + path)
+		tm.SetQuery("/", feeKey, mustModel(t, feeKey, &msgfee.MsgFee{
+			Metadata: &weave.Metadata{Schema: 1},
+			MsgPath:  path,
+			Fee:      fee,
+		}))
+	}
+	return tm
 }
 
-func tmEmptyResponse(t testing.TB, clientID string) string {
-	set, err := (&app.ResultSet{}).Marshal()
+// mustModel returns a model with given key and serialized payload as the
+// value.
+func mustModel(t testing.TB, key []byte, payload interface{ Marshal() ([]byte, error) }) weave.Model {
+	value, err := payload.Marshal()
 	assert.Nil(t, err)
-	enc := base64.StdEncoding.EncodeToString(set)
-
-	return `{
-	  "jsonrpc": "2.0",
-	  "id": "` + clientID + `",
-	  "result": {
-	    "response": {
-	      "key": "` + enc + `",
-	      "value": "` + enc + `"
-	    }
-	  }
-	}`
+	return weave.Model{Key: key, Value: value}
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/feature"
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
@@ -88,6 +89,86 @@ transaction (ie signatures) are being dropped.
 		option.Option = &bnsd.ProposalOptions_MsgfeeSetMsgFeeMsg{
 			MsgfeeSetMsgFeeMsg: msg,
 		}
+	case *cash.MultiSendMsg:
+		option.Option = &bnsd.ProposalOptions_CashMultiSendMsg{
+			CashMultiSendMsg: msg,
+		}
+	case *cash.BurnMsg:
+		option.Option = &bnsd.ProposalOptions_CashBurnMsg{
+			CashBurnMsg: msg,
+		}
+	case *cash.MintMsg:
+		option.Option = &bnsd.ProposalOptions_CashMintMsg{
+			CashMintMsg: msg,
+		}
+	case *cash.ApproveMsg:
+		option.Option = &bnsd.ProposalOptions_CashApproveMsg{
+			CashApproveMsg: msg,
+		}
+	case *cash.TransferFromMsg:
+		option.Option = &bnsd.ProposalOptions_CashTransferFromMsg{
+			CashTransferFromMsg: msg,
+		}
+	case *cash.CreateVestingMsg:
+		option.Option = &bnsd.ProposalOptions_CashCreateVestingMsg{
+			CashCreateVestingMsg: msg,
+		}
+	case *cash.ReleaseVestingMsg:
+		option.Option = &bnsd.ProposalOptions_CashReleaseVestingMsg{
+			CashReleaseVestingMsg: msg,
+		}
+	case *cash.FreezeAccountMsg:
+		option.Option = &bnsd.ProposalOptions_CashFreezeAccountMsg{
+			CashFreezeAccountMsg: msg,
+		}
+	case *cash.UnfreezeAccountMsg:
+		option.Option = &bnsd.ProposalOptions_CashUnfreezeAccountMsg{
+			CashUnfreezeAccountMsg: msg,
+		}
+	case *cash.GrantFeeMsg:
+		option.Option = &bnsd.ProposalOptions_CashGrantFeeMsg{
+			CashGrantFeeMsg: msg,
+		}
+	case *cash.ScheduleSendMsg:
+		option.Option = &bnsd.ProposalOptions_CashScheduleSendMsg{
+			CashScheduleSendMsg: msg,
+		}
+	case *cash.CancelScheduledSendMsg:
+		option.Option = &bnsd.ProposalOptions_CashCancelScheduledSendMsg{
+			CashCancelScheduledSendMsg: msg,
+		}
+	case *cash.SetWalletNameMsg:
+		option.Option = &bnsd.ProposalOptions_CashSetWalletNameMsg{
+			CashSetWalletNameMsg: msg,
+		}
+	case *escrow.ReleaseMilestoneMsg:
+		option.Option = &bnsd.ProposalOptions_EscrowReleaseMilestoneMsg{
+			EscrowReleaseMilestoneMsg: msg,
+		}
+	case *escrow.ApproveMsg:
+		option.Option = &bnsd.ProposalOptions_EscrowApproveMsg{
+			EscrowApproveMsg: msg,
+		}
+	case *escrow.UpdateEscrowTimeoutMsg:
+		option.Option = &bnsd.ProposalOptions_EscrowUpdateEscrowTimeoutMsg{
+			EscrowUpdateEscrowTimeoutMsg: msg,
+		}
+	case *escrow.RaiseDisputeMsg:
+		option.Option = &bnsd.ProposalOptions_EscrowRaiseDisputeMsg{
+			EscrowRaiseDisputeMsg: msg,
+		}
+	case *escrow.ResolveDisputeMsg:
+		option.Option = &bnsd.ProposalOptions_EscrowResolveDisputeMsg{
+			EscrowResolveDisputeMsg: msg,
+		}
+	case *escrow.UpdateEscrowPartiesMsg:
+		option.Option = &bnsd.ProposalOptions_EscrowUpdateEscrowPartiesMsg{
+			EscrowUpdateEscrowPartiesMsg: msg,
+		}
+	case *validators.SetBLSKeyMsg:
+		option.Option = &bnsd.ProposalOptions_ValidatorsSetBlsKeyMsg{
+			ValidatorsSetBlsKeyMsg: msg,
+		}
 	case *bnsd.ExecuteBatchMsg:
 		msgs, err := msg.MsgList()
 		if err != nil {
@@ -219,6 +300,26 @@ transaction (ie signatures) are being dropped.
 		option.Option = &bnsd.ProposalOptions_MigrationUpgradeSchemaMsg{
 			MigrationUpgradeSchemaMsg: msg,
 		}
+	case *migration.DowngradeSchemaMsg:
+		option.Option = &bnsd.ProposalOptions_MigrationDowngradeSchemaMsg{
+			MigrationDowngradeSchemaMsg: msg,
+		}
+	case *msgfee.UpdateConfigurationMsg:
+		option.Option = &bnsd.ProposalOptions_MsgfeeUpdateConfigurationMsg{
+			MsgfeeUpdateConfigurationMsg: msg,
+		}
+	case *feature.ScheduleFeatureMsg:
+		option.Option = &bnsd.ProposalOptions_FeatureScheduleFeatureMsg{
+			FeatureScheduleFeatureMsg: msg,
+		}
+	case *feature.UpdateConfigurationMsg:
+		option.Option = &bnsd.ProposalOptions_FeatureUpdateConfigurationMsg{
+			FeatureUpdateConfigurationMsg: msg,
+		}
+	case *migration.MigrateBucketMsg:
+		option.Option = &bnsd.ProposalOptions_MigrationMigrateBucketMsg{
+			MigrationMigrateBucketMsg: msg,
+		}
 	case *gov.UpdateElectorateMsg:
 		option.Option = &bnsd.ProposalOptions_GovUpdateElectorateMsg{
 			GovUpdateElectorateMsg: msg,
@@ -310,6 +411,7 @@ var supportedVoteOptions = map[string]gov.VoteOption{
 	"yes":     gov.VoteOption_Yes,
 	"no":      gov.VoteOption_No,
 	"abstain": gov.VoteOption_Abstain,
+	"veto":    gov.VoteOption_NoWithVeto,
 }
 
 // cmdVote is the cli command create a vote for a proposal
@@ -324,7 +426,7 @@ Vote on a governance proposal.
 	var (
 		id         = flSeq(fl, "proposal-id", "", "The ID of the proposal to vote for.")
 		voterFl    = flHex(fl, "voter", "", "Optional address of a voter. If not provided the main signer will be used.")
-		selectedFl = fl.String("select", "", "Supported options are: yes, no, abstain")
+		selectedFl = fl.String("select", "", "Supported options are: yes, no, abstain, veto")
 	)
 	fl.Parse(args)
 	if len(*id) == 0 {
@@ -354,6 +456,86 @@ Vote on a governance proposal.
 	return err
 }
 
+// cmdDelegateVote is the cli command to delegate the voting weight of an
+// elector to another address.
+func cmdDelegateVote(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Delegate the voting weight of an elector to another address. The delegatee vote
+is counted with the delegator weight for all proposals of the electorate that
+the delegator does not vote for.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		electorateFl = flSeq(fl, "electorate-id", "", "The ID of the electorate the delegation is declared for.")
+		delegatorFl  = flHex(fl, "delegator", "", "Optional address of a delegator. If not provided the main signer will be used.")
+		delegateeFl  = flHex(fl, "delegatee", "", "Address of the delegatee.")
+	)
+	fl.Parse(args)
+	if len(*electorateFl) == 0 {
+		flagDie("the electorate id must not be empty")
+	}
+	if len(*delegatorFl) != 0 {
+		if err := weave.Address(*delegatorFl).Validate(); err != nil {
+			flagDie("invalid delegator address: %q", err)
+		}
+	}
+	if err := weave.Address(*delegateeFl).Validate(); err != nil {
+		flagDie("invalid delegatee address: %q", err)
+	}
+
+	govTx := &bnsd.Tx{
+		Sum: &bnsd.Tx_GovDelegateVoteMsg{
+			GovDelegateVoteMsg: &gov.DelegateVoteMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: []byte(*electorateFl),
+				Delegator:    weave.Address(*delegatorFl),
+				Delegatee:    weave.Address(*delegateeFl),
+			},
+		},
+	}
+	_, err := writeTx(output, govTx)
+	return err
+}
+
+// cmdRevokeDelegation is the cli command to revoke a vote delegation.
+func cmdRevokeDelegation(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Revoke the vote delegation of an elector.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		electorateFl = flSeq(fl, "electorate-id", "", "The ID of the electorate the delegation was declared for.")
+		delegatorFl  = flHex(fl, "delegator", "", "Optional address of a delegator. If not provided the main signer will be used.")
+	)
+	fl.Parse(args)
+	if len(*electorateFl) == 0 {
+		flagDie("the electorate id must not be empty")
+	}
+	if len(*delegatorFl) != 0 {
+		if err := weave.Address(*delegatorFl).Validate(); err != nil {
+			flagDie("invalid delegator address: %q", err)
+		}
+	}
+
+	govTx := &bnsd.Tx{
+		Sum: &bnsd.Tx_GovRevokeDelegationMsg{
+			GovRevokeDelegationMsg: &gov.RevokeDelegationMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: []byte(*electorateFl),
+				Delegator:    weave.Address(*delegatorFl),
+			},
+		},
+	}
+	_, err := writeTx(output, govTx)
+	return err
+}
+
 func cmdTextResolution(input io.Reader, output io.Writer, args []string) error {
 	fl := flag.NewFlagSet("", flag.ExitOnError)
 	fl.Usage = func() {
@@ -382,6 +564,60 @@ Text resolution creates a human readable gov proposal payload. To be used with '
 	return err
 }
 
+func cmdTextProposal(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a signaling only text proposal transaction. The full proposal document
+is referenced by its hash and/or URL. An accepted text proposal is never
+executed.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		titleFl = fl.String("title", "", "The proposal title.")
+		descFl  = fl.String("description", "", "A short summary of the proposal document.")
+		hashFl  = flHex(fl, "document-hash", "", "Hex encoded hash of the full proposal document.")
+		urlFl   = fl.String("document-url", "", "URL of the full proposal document.")
+		startFl = flTime(fl, "start", inOneHour, "Start time as 'YYYY-MM-DD HH:MM' in UTC. If not provided, an arbitrary time in the future is used.")
+		eRuleFl = flSeq(fl, "electionrule", "", "The ID of the election rule to be used.")
+	)
+	fl.Parse(args)
+
+	msg := &gov.TextProposalMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		DocumentHash: []byte(*hashFl),
+		DocumentURL:  *urlFl,
+	}
+	if err := msg.Validate(); err != nil {
+		flagDie("invalid text proposal: %s", err)
+	}
+	option := bnsd.ProposalOptions{
+		Option: &bnsd.ProposalOptions_GovTextProposalMsg{
+			GovTextProposalMsg: msg,
+		},
+	}
+	rawOption, err := option.Marshal()
+	if err != nil {
+		return fmt.Errorf("cannot serialize %T option: %s", option, err)
+	}
+
+	propTx := &bnsd.Tx{
+		Sum: &bnsd.Tx_GovCreateProposalMsg{
+			GovCreateProposalMsg: &gov.CreateProposalMsg{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          *titleFl,
+				Description:    *descFl,
+				StartTime:      startFl.UnixTime(),
+				ElectionRuleID: *eRuleFl,
+				RawOption:      rawOption,
+			},
+		},
+	}
+	_, err = writeTx(output, propTx)
+	return err
+}
+
 func cmdUpdateElectorate(input io.Reader, output io.Writer, args []string) error {
 	fl := flag.NewFlagSet("", flag.ExitOnError)
 	fl.Usage = func() {
@@ -453,6 +689,12 @@ Reads a transaction from the input and attaches the provided elector address, we
 	return nil
 }
 
+var supportedWeightCurves = map[string]gov.WeightCurve{
+	"linear":    gov.WeightCurve_Linear,
+	"quadratic": gov.WeightCurve_Quadratic,
+	"capped":    gov.WeightCurve_Capped,
+}
+
 func cmdUpdateElectionRule(input io.Reader, output io.Writer, args []string) error {
 	fl := flag.NewFlagSet("", flag.ExitOnError)
 	fl.Usage = func() {
@@ -467,6 +709,9 @@ Creates a new version for an existing election rule. The new version is used for
 		numeratorFl   = fl.Int("threshold-numerator", 0, "The top number of the fraction.")
 		denominatorFl = fl.Uint("threshold-denominator", 0, "The bottom number of the fraction")
 		quorumFl      = flFraction(fl, "quorum", "", "New quorum fraction in format <numerator>/<denominator>. Zero quorum deletes the value.")
+		vetoFl        = flFraction(fl, "veto-threshold", "", "New veto threshold fraction in format <numerator>/<denominator>. Vetoing is disabled if not set.")
+		curveFl       = fl.String("weight-curve", "linear", "Vote weight curve. Supported options are: linear, quadratic, capped")
+		capFl         = fl.Uint("weight-cap", 0, "Maximum vote weight of a single elector. Required by the capped weight curve only.")
 	)
 	fl.Parse(args)
 	if len(*id) == 0 {
@@ -486,6 +731,14 @@ Creates a new version for an existing election rule. The new version is used for
 		// If fraction value was provided, set it.
 		quorum = frac
 	}
+	var veto *gov.Fraction
+	if frac := vetoFl.Fraction(); frac != nil && frac.Numerator != 0 {
+		veto = frac
+	}
+	curve, ok := supportedWeightCurves[*curveFl]
+	if !ok {
+		flagDie("unknown weight curve: %q", *curveFl)
+	}
 
 	govTx := &bnsd.Tx{
 		Sum: &bnsd.Tx_GovUpdateElectionRuleMsg{
@@ -495,6 +748,9 @@ Creates a new version for an existing election rule. The new version is used for
 				VotingPeriod:   weave.AsUnixDuration(time.Duration(*durationFl) * time.Second),
 				Threshold:      fraction,
 				Quorum:         quorum,
+				VetoThreshold:  veto,
+				WeightCurve:    curve,
+				WeightCap:      uint32(*capFl),
 			},
 		},
 	}
@@ -109,6 +109,43 @@ func TestCmdAsProposalWithTextResolution(t *testing.T) {
 	assert.Equal(t, "myTestResolution", submsg.Resolution)
 }
 
+func TestCmdTextProposal(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-title", "a title",
+		"-description", "a description",
+		"-document-hash", "a1b2c3",
+		"-document-url", "https://example.com/proposal.pdf",
+		"-electionrule", "1",
+	}
+	if err := cmdTextProposal(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new proposal transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot read created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*gov.CreateProposalMsg)
+
+	assert.Equal(t, msg.Title, "a title")
+	assert.Equal(t, msg.Description, "a description")
+	assert.Equal(t, msg.ElectionRuleID, sequenceID(1))
+
+	var options bnsd.ProposalOptions
+	if err := options.Unmarshal(msg.RawOption); err != nil {
+		t.Fatalf("cannot unmarshal submessage: %s", err)
+	}
+	submsg := options.GetGovTextProposalMsg()
+	assert.Equal(t, fromHex(t, "a1b2c3"), submsg.DocumentHash)
+	assert.Equal(t, "https://example.com/proposal.pdf", submsg.DocumentURL)
+}
+
 func TestCmdDeleteProposalHappyPath(t *testing.T) {
 	var output bytes.Buffer
 	args := []string{
@@ -159,6 +196,57 @@ func TestCmdVoteHappyPath(t *testing.T) {
 	assert.Equal(t, gov.VoteOption_Yes, msg.Selected)
 }
 
+func TestCmdDelegateVoteHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-electorate-id", "3",
+		"-delegatee", "b1ca7e78f74423ae01da3b51e676934d9105f282",
+	}
+	if err := cmdDelegateVote(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new delegation transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot read created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*gov.DelegateVoteMsg)
+
+	assert.Equal(t, sequenceID(3), msg.ElectorateID)
+	assert.Equal(t, 0, len(msg.Delegator))
+	assert.Equal(t, fromHex(t, "b1ca7e78f74423ae01da3b51e676934d9105f282"), []byte(msg.Delegatee))
+}
+
+func TestCmdRevokeDelegationHappyPath(t *testing.T) {
+	var output bytes.Buffer
+	args := []string{
+		"-electorate-id", "3",
+		"-delegator", "b1ca7e78f74423ae01da3b51e676934d9105f282",
+	}
+	if err := cmdRevokeDelegation(nil, &output, args); err != nil {
+		t.Fatalf("cannot create a new revocation transaction: %s", err)
+	}
+
+	tx, _, err := readTx(&output)
+	if err != nil {
+		t.Fatalf("cannot read created transaction: %s", err)
+	}
+
+	txmsg, err := tx.GetMsg()
+	if err != nil {
+		t.Fatalf("cannot get transaction message: %s", err)
+	}
+	msg := txmsg.(*gov.RevokeDelegationMsg)
+
+	assert.Equal(t, sequenceID(3), msg.ElectorateID)
+	assert.Equal(t, fromHex(t, "b1ca7e78f74423ae01da3b51e676934d9105f282"), []byte(msg.Delegator))
+}
+
 func TestCmdTextResolutionHappyPath(t *testing.T) {
 	var output bytes.Buffer
 	args := []string{
@@ -247,6 +335,9 @@ func TestCmdUpdateElectionRuleHappyPath(t *testing.T) {
 		"-voting-period", "86400",
 		"-threshold-numerator", "2",
 		"-threshold-denominator", "3",
+		"-veto-threshold", "1/3",
+		"-weight-curve", "capped",
+		"-weight-cap", "7",
 	}
 	if err := cmdUpdateElectionRule(nil, &output, args); err != nil {
 		t.Fatalf("cannot create a transaction: %s", err)
@@ -267,4 +358,7 @@ func TestCmdUpdateElectionRuleHappyPath(t *testing.T) {
 	assert.Equal(t, 24*time.Hour, msg.VotingPeriod.Duration())
 	assert.Equal(t, uint32(2), msg.Threshold.Numerator)
 	assert.Equal(t, uint32(3), msg.Threshold.Denominator)
+	assert.Equal(t, &gov.Fraction{Numerator: 1, Denominator: 3}, msg.VetoThreshold)
+	assert.Equal(t, gov.WeightCurve_Capped, msg.WeightCurve)
+	assert.Equal(t, uint32(7), msg.WeightCap)
 }
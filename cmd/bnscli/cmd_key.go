@@ -1,19 +1,14 @@
 package main
 
 import (
-	"bytes"
-	"crypto/sha256"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"os"
-	"strings"
 
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/crypto/bech32"
-	"github.com/stellar/go/exp/crypto/derivation"
 	"github.com/tyler-smith/go-bip39"
 	"golang.org/x/crypto/ed25519"
 )
@@ -26,16 +21,29 @@ Read mnemonic and generate a new private key.
 
 When successful a new file with binary content containing private key is
 created. This command fails if the private key file already exists.
+
+When -encrypt is used, the private key is encrypted with the passphrase
+provided via the BNSCLI_PRIV_KEY_PASSPHRASE environment variable.
 `)
 		fl.PrintDefaults()
 	}
 	var (
 		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
 			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
-		pathFl = fl.String("path", "m/44'/234'/0'", "Derivation path as described in BIP-44.")
+		pathFl    = fl.String("path", "m/44'/234'/0'", "Derivation path as described in BIP-44.")
+		encryptFl = fl.Bool("encrypt", false, "Encrypt the private key with a passphrase.")
 	)
 	fl.Parse(args)
 
+	var passphrase string
+	if *encryptFl {
+		p, ok := os.LookupEnv("BNSCLI_PRIV_KEY_PASSPHRASE")
+		if !ok || p == "" {
+			return errors.New("BNSCLI_PRIV_KEY_PASSPHRASE environment variable must be set to encrypt the private key")
+		}
+		passphrase = p
+	}
+
 	if _, err := os.Stat(*keyPathFl); !os.IsNotExist(err) {
 		// Do not allow to overwrite already existing private key. User
 		// must manually delete it first to ensure we do not delete
@@ -52,6 +60,16 @@ created. This command fails if the private key file already exists.
 	if err != nil {
 		return fmt.Errorf("cannot generate key: %s", err)
 	}
+	raw := []byte(priv)
+	if *encryptFl {
+		key := &crypto.PrivateKey{
+			Priv: &crypto.PrivateKey_Ed25519{Ed25519: priv},
+		}
+		raw, err = crypto.EncryptPrivateKey(key, []byte(passphrase))
+		if err != nil {
+			return fmt.Errorf("cannot encrypt private key: %s", err)
+		}
+	}
 
 	fd, err := os.OpenFile(*keyPathFl, os.O_CREATE|os.O_WRONLY, 0400)
 	if err != nil {
@@ -59,7 +77,7 @@ created. This command fails if the private key file already exists.
 	}
 	defer fd.Close()
 
-	if _, err := fd.Write(priv); err != nil {
+	if _, err := fd.Write(raw); err != nil {
 		return fmt.Errorf("cannot write private key: %s", err)
 	}
 	if err := fd.Close(); err != nil {
@@ -71,48 +89,11 @@ created. This command fails if the private key file already exists.
 // keygen returns a private key generated using given mnemonic and derivation
 // path.
 func keygen(mnemonic, derivationPath string) (ed25519.PrivateKey, error) {
-	if err := validateMnemonic(string(mnemonic)); err != nil {
-		return nil, fmt.Errorf("invalid mnemonic: %s", err)
-	}
-
-	// We do not allow for passphrase.
-	seed := bip39.NewSeed(string(mnemonic), "")
-
-	key, err := derivation.DeriveForPath(derivationPath, seed)
+	key, err := crypto.DeriveEd25519(mnemonic, derivationPath)
 	if err != nil {
-		return nil, fmt.Errorf("cannot deriviate master key from seed: %s", err)
-	}
-
-	_, priv, err := ed25519.GenerateKey(bytes.NewReader(key.Key))
-	if err != nil {
-		return nil, fmt.Errorf("cannot generate ed25519 private key: %s", err)
-	}
-	return priv, nil
-}
-
-// isMnemonicValid returns true if given mnemonic string is valid. Whitespaces
-// are relevant.
-//
-// Use this instead of bip39.IsMnemonicValid because this function ensures the
-// checksum consistency. bip39.IsMnemonicValid does not test the checksum. It
-// also ignores whitespaces.
-//
-// This function ensures that the mnemonic is a single space separated list of
-// words as this is important during seed creation.
-func validateMnemonic(mnemonic string) error {
-	// A lazy way to check that words are exactly single space separated.
-	expected := strings.Join(strings.Fields(mnemonic), " ")
-	if mnemonic != expected {
-		return errors.New("whitespace violation")
-	}
-
-	// Entropy generation does base validation of checking if words are
-	// valid and in the right amount. It also tests the checksum.
-	if _, err := bip39.EntropyFromMnemonic(mnemonic); err != nil {
-		return fmt.Errorf("entropy: %s", err)
+		return nil, err
 	}
-
-	return nil
+	return ed25519.PrivateKey(key.GetEd25519()), nil
 }
 
 func cmdKeyaddr(input io.Reader, output io.Writer, args []string) error {
@@ -125,27 +106,17 @@ Print out a hex-address associated with your private key.
 	}
 	var (
 		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
-			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
+			"Path to the private key file or URL of the remote signer that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it. Use BNSCLI_PRIV_KEY_PASSPHRASE environment variable to provide the passphrase of an encrypted private key.")
 		bechPrefixFl = fl.String("bp", "iov", "Bech32 prefix.")
 	)
 	fl.Parse(args)
 
-	raw, err := ioutil.ReadFile(*keyPathFl)
+	key, err := loadSigner(*keyPathFl)
 	if err != nil {
-		return fmt.Errorf("cannot read private key file: %s", err)
-	}
-
-	if len(raw) != ed25519.PrivateKeySize {
-		return fmt.Errorf("invalid private key length: %d", len(raw))
-	}
-
-	key := &crypto.PrivateKey{
-		Priv: &crypto.PrivateKey_Ed25519{
-			Ed25519: raw,
-		},
+		return fmt.Errorf("cannot load private key: %s", err)
 	}
 
-	bech, err := toBech32(*bechPrefixFl, key.PublicKey().GetEd25519())
+	bech, err := toBech32(*bechPrefixFl, key.PublicKey())
 	if err != nil {
 		return fmt.Errorf("cannot generate bech32 address format: %s", err)
 	}
@@ -157,10 +128,8 @@ Print out a hex-address associated with your private key.
 
 // toBech32 computes the bech32 address representation as described in
 // https://github.com/iov-one/iov-core/blob/8846fed17443766a9ad9c908c3d7fc9d205e02ef/docs/address-derivation-v1.md#deriving-addresses-from-keypairs
-func toBech32(prefix string, pubkey []byte) ([]byte, error) {
-	data := append([]byte("sigs/ed25519/"), pubkey...)
-	hash := sha256.Sum256(data)
-	bech, err := bech32.Encode(prefix, hash[:20])
+func toBech32(prefix string, pubkey *crypto.PublicKey) ([]byte, error) {
+	bech, err := bech32.Encode(prefix, pubkey.Address())
 	if err != nil {
 		return nil, fmt.Errorf("cannot compute bech32: %s", err)
 	}
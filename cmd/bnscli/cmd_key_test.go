@@ -3,6 +3,7 @@ package main
 import (
 	"testing"
 
+	"github.com/iov-one/weave/crypto"
 	"golang.org/x/crypto/ed25519"
 )
 
@@ -25,7 +26,10 @@ func TestKeygen(t *testing.T) {
 			if err != nil {
 				t.Fatalf("cannot generate key: %s", err)
 			}
-			b, err := toBech32("tiov", priv.Public().(ed25519.PublicKey))
+			pub := &crypto.PublicKey{
+				Pub: &crypto.PublicKey_Ed25519{Ed25519: priv.Public().(ed25519.PublicKey)},
+			}
+			b, err := toBech32("tiov", pub)
 			if err != nil {
 				t.Fatalf("cannot serialize to bech32: %s", err)
 			}
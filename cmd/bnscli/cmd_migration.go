@@ -0,0 +1,40 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/migration"
+)
+
+func cmdMigrateBucket(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), `
+Create a transaction for migrating the next chunk of entities stored in a
+bucket to the current schema version. Transaction must be signed by the
+migration administrator.
+
+Submit this transaction repeatedly until the migration of the bucket is done.
+		`)
+		fl.PrintDefaults()
+	}
+	var (
+		bucketFl = fl.String("bucket", "", "Name of the bucket that is migrated.")
+	)
+	fl.Parse(args)
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_MigrationMigrateBucketMsg{
+			MigrationMigrateBucketMsg: &migration.MigrateBucketMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Bucket:   *bucketFl,
+			},
+		},
+	}
+	_, err := writeTx(output, tx)
+	return err
+}
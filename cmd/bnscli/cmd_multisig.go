@@ -27,6 +27,9 @@ participants must be done by another command.
 		updateFl              = flSeq(fl, "update", "", "If a multisig contract ID is provided, a multisig contract update instead of creation message is created.")
 		activationThresholdFl = fl.Uint("activation", 0, "Activation threshold value. Must be greater than 0.")
 		adminThresholdFl      = fl.Uint("admin", 0, "Admin threshold value. Must be greater than 0.")
+		updateDelayFl         = fl.Duration("update-delay", 0, "Time after which a contract update takes effect. During this time any participant can veto the update. Zero value means updates take effect immediately.")
+		proposalTTLFl         = fl.Duration("proposal-ttl", 0, "Time after which a proposal that was not executed expires. Zero value means proposals never expire.")
+		approvalTTLFl         = fl.Duration("approval-ttl", 0, "Time after which an approval of a proposal expires and must be given again. Zero value means approvals never expire.")
 	)
 	fl.Parse(args)
 
@@ -47,6 +50,9 @@ participants must be done by another command.
 					ContractID:          *updateFl,
 					ActivationThreshold: multisig.Weight(*activationThresholdFl),
 					AdminThreshold:      multisig.Weight(*adminThresholdFl),
+					UpdateDelay:         weave.AsUnixDuration(*updateDelayFl),
+					ProposalTTL:         weave.AsUnixDuration(*proposalTTLFl),
+					ApprovalTTL:         weave.AsUnixDuration(*approvalTTLFl),
 				},
 			},
 		}
@@ -57,6 +63,9 @@ participants must be done by another command.
 					Metadata:            &weave.Metadata{Schema: 1},
 					ActivationThreshold: multisig.Weight(*activationThresholdFl),
 					AdminThreshold:      multisig.Weight(*adminThresholdFl),
+					UpdateDelay:         weave.AsUnixDuration(*updateDelayFl),
+					ProposalTTL:         weave.AsUnixDuration(*proposalTTLFl),
+					ApprovalTTL:         weave.AsUnixDuration(*approvalTTLFl),
 				},
 			},
 		}
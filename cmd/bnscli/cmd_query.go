@@ -16,6 +16,7 @@ import (
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/cmd/bnsd/x/username"
+	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/distribution"
@@ -38,6 +39,7 @@ Execute a ABCI query and print JSON encoded result.
 		pathFl        = fl.String("path", "", "Path to be queried. Must be one of the supported.")
 		dataFl        = fl.String("data", "", "individual query data. Format depends on the queried entity. Use 'id/version' for electoraterules, electorates")
 		prefixQueryFl = fl.Bool("prefix", false, "If true, use prefix queries instead of the exact match with provided data.")
+		reverseFl     = fl.Bool("reverse", false, "If true, use a prefix query that returns the results in reverse order, latest first.")
 	)
 	fl.Parse(args)
 
@@ -58,7 +60,10 @@ Execute a ABCI query and print JSON encoded result.
 		}
 	}
 	queryPath := *pathFl
-	if *prefixQueryFl || *dataFl == "" {
+	switch {
+	case *reverseFl:
+		queryPath += "?" + weave.ReversePrefixQueryMod
+	case *prefixQueryFl || *dataFl == "":
 		queryPath += "?" + weave.PrefixQueryMod
 	}
 
@@ -122,6 +127,16 @@ var queries = map[string]struct {
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
+	"/proposals/status/page": {
+		newObj: func() model { return &extendedProposal{} },
+		decKey: sequenceKey,
+		encID:  proposalStatusID,
+	},
+	"/proposals/electorate/page": {
+		newObj: func() model { return &extendedProposal{} },
+		decKey: sequenceKey,
+		encID:  electorateProposalsID,
+	},
 	"/electionrules": {
 		newObj: func() model { return &gov.ElectionRule{} },
 		decKey: refKey,
@@ -167,11 +182,86 @@ var queries = map[string]struct {
 		decKey: rawKey,
 		encID:  addressID,
 	},
+	"/wallets/name": {
+		newObj: func() model { return &cash.Set{} },
+		decKey: rawKey,
+		encID:  stringID,
+	},
+	"/holders": {
+		newObj: func() model { return &cash.Set{} },
+		decKey: rawKey,
+		encID:  holderID,
+	},
+	"/supply": {
+		newObj: func() model { return &cash.Supply{} },
+		decKey: rawKey,
+		encID:  stringID,
+	},
+	"/allowances": {
+		newObj: func() model { return &cash.Allowance{} },
+		decKey: rawKey,
+		encID:  addressID,
+	},
+	"/allowances/spender": {
+		newObj: func() model { return &cash.Allowance{} },
+		decKey: rawKey,
+		encID:  addressID,
+	},
+	"/vestings": {
+		newObj: func() model { return &cash.Vesting{} },
+		decKey: sequenceKey,
+		encID:  numericID,
+	},
+	"/vestings/beneficiary": {
+		newObj: func() model { return &cash.Vesting{} },
+		decKey: sequenceKey,
+		encID:  addressID,
+	},
+	"/frozen": {
+		newObj: func() model { return &cash.FrozenAccount{} },
+		decKey: rawKey,
+		encID:  addressID,
+	},
+	"/feegrants": {
+		newObj: func() model { return &cash.FeeGrant{} },
+		decKey: rawKey,
+		encID:  addressID,
+	},
+	"/feegrants/grantee": {
+		newObj: func() model { return &cash.FeeGrant{} },
+		decKey: rawKey,
+		encID:  addressID,
+	},
+	"/scheduledsends": {
+		newObj: func() model { return &cash.ScheduledSend{} },
+		decKey: sequenceKey,
+		encID:  numericID,
+	},
+	"/scheduledsends/source": {
+		newObj: func() model { return &cash.ScheduledSend{} },
+		decKey: sequenceKey,
+		encID:  addressID,
+	},
 	"/escrows": {
 		newObj: func() model { return &escrow.Escrow{} },
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
+	"/escrows/source/page": {
+		newObj: func() model { return &escrow.Escrow{} },
+		decKey: sequenceKey,
+		encID:  partyEscrowsID,
+	},
+	"/escrows/destination/page": {
+		newObj: func() model { return &escrow.Escrow{} },
+		decKey: sequenceKey,
+		encID:  partyEscrowsID,
+	},
+	"/escrows/arbiter/page": {
+		newObj: func() model { return &escrow.Escrow{} },
+		decKey: sequenceKey,
+		encID:  partyEscrowsID,
+	},
 	"/revenues": {
 		newObj: func() model { return &distribution.Revenue{} },
 		decKey: sequenceKey,
@@ -182,6 +272,16 @@ var queries = map[string]struct {
 		decKey: sequenceKey,
 		encID:  numericID,
 	},
+	"/contracts/participant": {
+		newObj: func() model { return &multisig.Contract{} },
+		decKey: sequenceKey,
+		encID:  addressID,
+	},
+	"/migrations": {
+		newObj: func() model { return &migration.Schema{} },
+		decKey: schemaKey,
+		encID:  stringID,
+	},
 }
 
 // model is an entity used by weave to store data. This interface is
@@ -229,6 +329,79 @@ func addressID(s string) ([]byte, error) {
 	return weave.ParseAddress(s)
 }
 
+func stringID(s string) ([]byte, error) {
+	return []byte(s), nil
+}
+
+// holderID encodes the holders query data. Use "<ticker>" to request the
+// first page or "<ticker>:<address>" to request the page following the wallet
+// with given address.
+func holderID(s string) ([]byte, error) {
+	chunks := strings.SplitN(s, ":", 2)
+	if len(chunks) == 1 {
+		return []byte(s), nil
+	}
+	addr, err := weave.ParseAddress(chunks[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %s", err)
+	}
+	return append([]byte(chunks[0]+":"), addr...), nil
+}
+
+// partyEscrowsID encodes the party escrows query data. Use "<address>" to
+// request the first page or "<address>:<escrow ID>" to request the page
+// following the escrow with given ID.
+func partyEscrowsID(s string) ([]byte, error) {
+	chunks := strings.SplitN(s, ":", 2)
+	addr, err := weave.ParseAddress(chunks[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %s", err)
+	}
+	if len(chunks) == 1 {
+		return addr, nil
+	}
+	id, err := numericID(chunks[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid escrow ID: %s", err)
+	}
+	return append(addr, id...), nil
+}
+
+// proposalStatusID encodes the proposals by status query data. Use
+// "<status>" to request the first page or "<status>:<proposal ID>" to request
+// the page following the proposal with given ID.
+func proposalStatusID(s string) ([]byte, error) {
+	chunks := strings.SplitN(s, ":", 2)
+	if len(chunks) == 1 {
+		return []byte(s), nil
+	}
+	id, err := numericID(chunks[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid proposal ID: %s", err)
+	}
+	return append([]byte(chunks[0]+":"), id...), nil
+}
+
+// electorateProposalsID encodes the proposals by electorate query data. Use
+// "<electorate ID>" to request the first page or
+// "<electorate ID>:<proposal ID>" to request the page following the proposal
+// with given ID.
+func electorateProposalsID(s string) ([]byte, error) {
+	chunks := strings.SplitN(s, ":", 2)
+	electorateID, err := numericID(chunks[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid electorate ID: %s", err)
+	}
+	if len(chunks) == 1 {
+		return electorateID, nil
+	}
+	id, err := numericID(chunks[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid proposal ID: %s", err)
+	}
+	return append(append(electorateID, ':'), id...), nil
+}
+
 func refKey(raw []byte) (string, error) {
 	// Skip the prefix, being the characters before : (including separator)
 	val := raw[bytes.Index(raw, []byte(":"))+1:]
@@ -262,6 +435,18 @@ func sequenceKey(raw []byte) (string, error) {
 	return fmt.Sprint(int64(n)), nil
 }
 
+// schemaKey decodes the key of a schema entity into the name of the package
+// that the schema version is declared for.
+func schemaKey(raw []byte) (string, error) {
+	// Skip the prefix, being the characters before : (including separator)
+	key := raw[bytes.Index(raw, []byte(":"))+1:]
+	// Schema version is encoded using 4 bytes.
+	if len(key) < 4 {
+		return "", fmt.Errorf("invalid schema key length: %d", len(key))
+	}
+	return string(key[:len(key)-4]), nil
+}
+
 func rawKey(raw []byte) (string, error) {
 	return hex.EncodeToString(raw), nil
 }
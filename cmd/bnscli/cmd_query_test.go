@@ -0,0 +1,36 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/weavetest/tmmock"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func TestCmdQueryWallet(t *testing.T) {
+	addr := fromHex(t, "b1ca7e78f74423ae01da3b51e676934d9105f282")
+	wallet := &cash.Set{
+		Metadata: &weave.Metadata{Schema: 1},
+		Coins:    []*coin.Coin{coin.NewCoinp(5, 0, "IOV")},
+		Name:     "alice",
+	}
+
+	tm := tmmock.New()
+	defer tm.Close()
+	tm.SetQuery("/wallets", addr, mustModel(t, addr, wallet))
+
+	var output bytes.Buffer
+	args := []string{
+		"-tm", tm.URL(),
+		"-path", "/wallets",
+		"-data", "b1ca7e78f74423ae01da3b51e676934d9105f282",
+	}
+	if err := cmdQuery(nil, &output, args); err != nil {
+		t.Fatalf("cannot query: %s", err)
+	}
+	assert.Golden(t, "query_wallet", output.Bytes())
+}
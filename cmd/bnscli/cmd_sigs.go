@@ -9,7 +9,10 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/x/sigs"
@@ -34,14 +37,24 @@ content.
 		tmAddrFl = fl.String("tm", env("BNSCLI_TM_ADDR", "https://bns.NETWORK.iov.one:443"),
 			"Tendermint node address. Use proper NETWORK name. You can use BNSCLI_TM_ADDR environment variable to set it.")
 		keyPathFl = fl.String("key", env("BNSCLI_PRIV_KEY", os.Getenv("HOME")+"/.bnsd.priv.key"),
-			"Path to the private key file that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it.")
+			"Path to the private key file or URL of the remote signer that transaction should be signed with. You can use BNSCLI_PRIV_KEY environment variable to set it. Use BNSCLI_PRIV_KEY_PASSPHRASE environment variable to provide the passphrase of an encrypted private key.")
+		validForFl = fl.Duration("valid-for", 0,
+			"Time after which the signature is no longer valid, counting from now. Zero value means the signature does not expire.")
+		validUntilHeightFl = fl.Int64("valid-until-height", 0,
+			"Block height after which the signature is no longer valid. Zero value means the signature does not expire.")
 	)
 	fl.Parse(args)
 
+	if *validForFl < 0 {
+		flagDie("valid for duration must not be negative")
+	}
+	if *validUntilHeightFl < 0 {
+		flagDie("valid until height must not be negative")
+	}
 	if *keyPathFl == "" {
 		return errors.New("private key is required")
 	}
-	key, err := decodePrivateKey(*keyPathFl)
+	key, err := loadSigner(*keyPathFl)
 	if err != nil {
 		return fmt.Errorf("cannot load private key: %s", err)
 	}
@@ -61,7 +74,11 @@ content.
 	if seq, err := aNonce.Next(); err != nil {
 		return fmt.Errorf("cannot get the next sequence number: %s", err)
 	} else {
-		sig, err := sigs.SignTx(key, tx, genesis.ChainID, seq)
+		var validUntil weave.UnixTime
+		if *validForFl != 0 {
+			validUntil = weave.AsUnixTime(time.Now().Add(*validForFl))
+		}
+		sig, err := sigs.SignExpiringTx(key, tx, genesis.ChainID, seq, validUntil, *validUntilHeightFl)
 		if err != nil {
 			return fmt.Errorf("cannot sign transaction: %s", err)
 		}
@@ -72,11 +89,30 @@ content.
 	return err
 }
 
-func decodePrivateKey(filepath string) (*crypto.PrivateKey, error) {
-	data, err := ioutil.ReadFile(filepath)
+// loadSigner returns a signer for given private key location. Location can be
+// either an URL of a remote signer or a path to a private key file. Private
+// key file can be encrypted, in which case the passphrase must be provided via
+// the BNSCLI_PRIV_KEY_PASSPHRASE environment variable.
+func loadSigner(location string) (crypto.Signer, error) {
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		return crypto.NewRemoteSigner(location, nil)
+	}
+	data, err := ioutil.ReadFile(location)
 	if err != nil {
-		return nil, fmt.Errorf("cannot read %q file: %s", filepath, err)
+		return nil, fmt.Errorf("cannot read %q file: %s", location, err)
 	}
+	if crypto.IsEncryptedKey(data) {
+		passphrase, ok := os.LookupEnv("BNSCLI_PRIV_KEY_PASSPHRASE")
+		if !ok {
+			return nil, errors.New("private key is encrypted, BNSCLI_PRIV_KEY_PASSPHRASE environment variable must be set")
+		}
+		return crypto.DecryptPrivateKey(data, []byte(passphrase))
+	}
+	return decodePrivateKey(data)
+}
+
+// decodePrivateKey returns an ed25519 private key stored as raw bytes.
+func decodePrivateKey(data []byte) (*crypto.PrivateKey, error) {
 	if len(data) != 64 {
 		return nil, errors.New("invalid key length")
 	}
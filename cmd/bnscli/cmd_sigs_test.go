@@ -6,6 +6,7 @@ import (
 	"flag"
 	"io"
 	"io/ioutil"
+	"os"
 	"testing"
 
 	"github.com/iov-one/weave"
@@ -70,6 +71,63 @@ func TestCmdSignTransactionHappyPath(t *testing.T) {
 	}
 }
 
+func TestLoadSigner(t *testing.T) {
+	raw := fromHex(t, privKeyHex)
+	want := &crypto.PrivateKey{
+		Priv: &crypto.PrivateKey_Ed25519{Ed25519: raw},
+	}
+	encrypted, err := crypto.EncryptPrivateKey(want, []byte("secret"))
+	assert.Nil(t, err)
+
+	rawFile := mustCreateFile(t, bytes.NewReader(raw))
+	encryptedFile := mustCreateFile(t, bytes.NewReader(encrypted))
+	invalidFile := mustCreateFile(t, bytes.NewReader(raw[:32]))
+
+	cases := map[string]struct {
+		path       string
+		passphrase string
+		wantErr    bool
+	}{
+		"raw private key": {
+			path: rawFile,
+		},
+		"encrypted private key": {
+			path:       encryptedFile,
+			passphrase: "secret",
+		},
+		"encrypted private key without passphrase": {
+			path:    encryptedFile,
+			wantErr: true,
+		},
+		"encrypted private key with invalid passphrase": {
+			path:       encryptedFile,
+			passphrase: "invalid",
+			wantErr:    true,
+		},
+		"invalid private key": {
+			path:    invalidFile,
+			wantErr: true,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if tc.passphrase != "" {
+				os.Setenv("BNSCLI_PRIV_KEY_PASSPHRASE", tc.passphrase)
+				defer os.Unsetenv("BNSCLI_PRIV_KEY_PASSPHRASE")
+			}
+			signer, err := loadSigner(tc.path)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("want an error")
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, want.PublicKey().Address(), signer.PublicKey().Address())
+		})
+	}
+}
+
 var logRequestFl = flag.Bool("logrequest", false, "Log all requests send to tendermint mock server. This is useful when writing new test. Use curl to send the same request to a real tendermint node and record the response.")
 
 func mustCreateFile(t testing.TB, r io.Reader) string {
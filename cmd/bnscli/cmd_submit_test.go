@@ -6,9 +6,11 @@ import (
 
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/cmd/bnsd/client"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/weavetest/txbuild"
 	"github.com/iov-one/weave/x/batch"
 	"github.com/iov-one/weave/x/cash"
 )
@@ -60,6 +62,40 @@ func TestCmdSubmitTxHappyPath(t *testing.T) {
 	}
 }
 
+// TestCmdSubmitSignedTx submits a transaction that was signed using the
+// expected sequence, without using the bnscli sign command.
+func TestCmdSubmitSignedTx(t *testing.T) {
+	genesis, err := fetchGenesis(tmURL)
+	assert.Nil(t, err)
+
+	key, err := decodePrivateKey(fromHex(t, privKeyHex))
+	assert.Nil(t, err)
+	signer := &txbuild.Signer{Key: key}
+	bnsClient := client.NewClient(client.NewHTTPConnection(tmURL))
+	signer.Seq, err = client.NewNonce(bnsClient, signer.Address()).Next()
+	assert.Nil(t, err)
+
+	tx := txbuild.New(genesis.ChainID, &bnsd.Tx{}).
+		Msg(&cash.SendMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Source:      signer.Address(),
+			Destination: weavetest.NewCondition().Address(),
+			Amount:      coin.NewCoinp(1, 0, "IOV"),
+		}).
+		Fee(signer.Address(), coin.NewCoin(0, 100000000, "IOV")).
+		Sign(signer).
+		MustBuild(t)
+
+	var input bytes.Buffer
+	if _, err := writeTx(&input, tx.(*bnsd.Tx)); err != nil {
+		t.Fatalf("cannot marshal transaction: %s", err)
+	}
+	var output bytes.Buffer
+	if err := cmdSubmitTransaction(&input, &output, []string{"-tm", tmURL}); err != nil {
+		t.Fatalf("cannot submit the transaction: %s", err)
+	}
+}
+
 func TestSubmitTxResponse(t *testing.T) {
 	fmts := map[string]func([]byte) (string, error){
 		"mymsg":      fmtSequence,
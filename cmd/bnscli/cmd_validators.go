@@ -67,3 +67,54 @@ func cmdSetValidators(input io.Reader, output io.Writer, args []string) error {
 	_, err = writeTx(output, &tx)
 	return err
 }
+
+func cmdSetBLSKey(input io.Reader, output io.Writer, args []string) error {
+	fl := flag.NewFlagSet("", flag.ExitOnError)
+	fl.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), `
+Create a transaction that registers a BLS12-381 public key of a validator.
+The validator must be a member of the current validator set.
+`)
+		fl.PrintDefaults()
+	}
+	var (
+		validatorFl = fl.String("validator", "", "Base64 encoded, ed25519 consensus public key of the validator.")
+		pubKeyFl    = fl.String("pubkey", "", "Base64 encoded, compressed BLS12-381 public key.")
+		proofFl     = fl.String("proof", "", "Base64 encoded proof of possession of the BLS12-381 private key.")
+	)
+	fl.Parse(args)
+
+	validator, err := base64.StdEncoding.DecodeString(*validatorFl)
+	if err != nil {
+		return fmt.Errorf("cannot base64 decode validator public key: %s", err)
+	}
+	pubkey, err := base64.StdEncoding.DecodeString(*pubKeyFl)
+	if err != nil {
+		return fmt.Errorf("cannot base64 decode BLS12-381 public key: %s", err)
+	}
+	proof, err := base64.StdEncoding.DecodeString(*proofFl)
+	if err != nil {
+		return fmt.Errorf("cannot base64 decode proof of possession: %s", err)
+	}
+
+	msg := validators.SetBLSKeyMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Validator: weave.PubKey{
+			Type: "ed25519",
+			Data: validator,
+		},
+		Pubkey:            pubkey,
+		ProofOfPossession: proof,
+	}
+	if err := msg.Validate(); err != nil {
+		return fmt.Errorf("given data produce an invalid message: %s", err)
+	}
+
+	tx := &bnsd.Tx{
+		Sum: &bnsd.Tx_ValidatorsSetBlsKeyMsg{
+			ValidatorsSetBlsKeyMsg: &msg,
+		},
+	}
+	_, err = writeTx(output, tx)
+	return err
+}
@@ -2,10 +2,12 @@ package main
 
 import (
 	"bytes"
+	"encoding/base64"
 	"testing"
 
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/x/validators"
 )
 
@@ -104,3 +106,82 @@ func TestCmdSetValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestCmdSetBLSKey(t *testing.T) {
+	key := crypto.GenPrivKeyBls12381()
+	proof, err := crypto.SignBLS12381ProofOfPossession(key)
+	if err != nil {
+		t.Fatalf("cannot create proof of possession: %s", err)
+	}
+	validator := base64.StdEncoding.EncodeToString(make([]byte, 32))
+	pubkey := base64.StdEncoding.EncodeToString(key.PublicKey().GetBls12381())
+	pop := base64.StdEncoding.EncodeToString(proof.GetBls12381())
+
+	cases := map[string]struct {
+		Args    []string
+		WantErr bool
+	}{
+		"valid key": {
+			Args: []string{
+				"-validator", validator,
+				"-pubkey", pubkey,
+				"-proof", pop,
+			},
+		},
+		"invalid validator key": {
+			Args: []string{
+				"-validator", "j4JRVstX",
+				"-pubkey", pubkey,
+				"-proof", pop,
+			},
+			WantErr: true,
+		},
+		"missing proof": {
+			Args: []string{
+				"-validator", validator,
+				"-pubkey", pubkey,
+			},
+			WantErr: true,
+		},
+		"invalid pubkey": {
+			Args: []string{
+				"-validator", validator,
+				"-pubkey", "NOT-A-BASE64-ENCODED-VALUE",
+				"-proof", pop,
+			},
+			WantErr: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var output bytes.Buffer
+			err := cmdSetBLSKey(nil, &output, tc.Args)
+			if tc.WantErr {
+				if err == nil {
+					t.Fatal("expected an error, but the call was successful")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: cmd failed: %s", err)
+			}
+
+			tx, _, err := readTx(&output)
+			if err != nil {
+				t.Fatalf("cannot read created transaction: %s", err)
+			}
+			msg, err := tx.GetMsg()
+			if err != nil {
+				t.Fatalf("cannot get transaction message: %s", err)
+			}
+			m, ok := msg.(*validators.SetBLSKeyMsg)
+			if !ok {
+				t.Fatalf("unexpected message: %T", msg)
+			}
+			if !bytes.Equal(m.Pubkey, key.PublicKey().GetBls12381()) {
+				t.Fatal("unexpected BLS public key")
+			}
+		})
+	}
+}
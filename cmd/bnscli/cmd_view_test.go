@@ -6,6 +6,7 @@ import (
 
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/gov"
 )
@@ -30,24 +31,7 @@ func TestCmdTransactionViewHappyPath(t *testing.T) {
 		t.Fatalf("cannot view a transaction: %s", err)
 	}
 
-	const want = `{
-	"Sum": {
-		"CashSendMsg": {
-			"metadata": {
-				"schema": 1
-			},
-			"memo": "a memo",
-			"ref": "MTIz"
-		}
-	}
-}`
-	got := output.String()
-
-	if want != got {
-		t.Logf("want: %s", want)
-		t.Logf(" got: %s", got)
-		t.Fatal("unexpected view result")
-	}
+	assert.Golden(t, "view_cash_send", output.Bytes())
 }
 
 func TestCmdTransactionViewWithTextResolution(t *testing.T) {
@@ -62,16 +46,5 @@ func TestCmdTransactionViewWithTextResolution(t *testing.T) {
 	if err := cmdTransactionView(input, &output, nil); err != nil {
 		t.Fatalf("cannot view a transaction: %s", err)
 	}
-	got := output.String()
-	const want = `{
-	"metadata": {
-		"schema": 1
-	},
-	"resolution": "myTestResolution"
-}`
-	if want != got {
-		t.Logf("want: %s", want)
-		t.Logf(" got: %s", got)
-		t.Fatal("unexpected view result")
-	}
+	assert.Golden(t, "view_text_resolution", output.Bytes())
 }
@@ -39,9 +39,11 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"as-proposal":               cmdAsProposal,
 	"as-sequence":               cmdAsSequence,
 	"del-proposal":              cmdDelProposal,
+	"delegate-vote":             cmdDelegateVote,
 	"from-sequence":             cmdFromSequence,
 	"keyaddr":                   cmdKeyaddr,
 	"keygen":                    cmdKeygen,
+	"migrate-bucket":            cmdMigrateBucket,
 	"mnemonic":                  cmdMnemonic,
 	"multisig":                  cmdMultisig,
 	"query":                     cmdQuery,
@@ -49,11 +51,14 @@ var commands = map[string]func(input io.Reader, output io.Writer, args []string)
 	"release-escrow":            cmdReleaseEscrow,
 	"reset-revenue":             cmdResetRevenue,
 	"resolve-username":          cmdResolveUsername,
+	"revoke-delegation":         cmdRevokeDelegation,
 	"send-tokens":               cmdSendTokens,
+	"set-bls-key":               cmdSetBLSKey,
 	"set-msgfee":                cmdSetMsgFee,
 	"set-validators":            cmdSetValidators,
 	"sign":                      cmdSignTransaction,
 	"submit":                    cmdSubmitTransaction,
+	"text-proposal":             cmdTextProposal,
 	"text-resolution":           cmdTextResolution,
 	"update-election-rule":      cmdUpdateElectionRule,
 	"update-electorate":         cmdUpdateElectorate,
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
@@ -27,6 +28,8 @@ import (
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/feature"
+	"github.com/iov-one/weave/x/gas"
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
@@ -41,24 +44,36 @@ func Authenticator() x.Authenticator {
 	return x.ChainAuth(sigs.Authenticate{}, multisig.Authenticate{})
 }
 
+// sequenceWindow is the size of the signature sequence window. It allows
+// clients to broadcast several transactions concurrently. Changing it
+// changes which transactions are valid, so it must be the same on all nodes.
+const sequenceWindow = 16
+
 // Chain returns a chain of decorators, to handle authentication,
-// fees, logging, and recovery
-func Chain(authFn x.Authenticator, minFee coin.Coin) app.Decorators {
+// fees, rate limiting, logging, and recovery. Rate limiting is disabled if
+// rateLimit is zero. Check deadline is disabled if txDeadline is zero.
+func Chain(authFn x.Authenticator, minFee coin.Coin, rateLimit int, rateLimitWindow int64, txDeadline time.Duration) app.Decorators {
 	// ctrl can be initialized with any implementation, but must be used
 	// consistently everywhere.
 	var ctrl cash.Controller = cash.NewController(cash.NewBucket())
 
 	return app.ChainDecorators(
 		utils.NewLogging(),
+		utils.NewMetrics(),
 		utils.NewRecovery(),
+		utils.NewDeadline(txDeadline),
 		utils.NewKeyTagger(),
+		cash.NewTransferTagger(),
 		// on CheckTx, bad tx don't affect state
 		utils.NewSavepoint().OnCheck(),
-		sigs.NewDecorator(),
+		sigs.NewDecorator().WithSequenceWindow(sequenceWindow),
 		multisig.NewDecorator(authFn),
+		utils.NewRateLimit(authFn, rateLimit, rateLimitWindow),
 		// cash.NewDynamicFeeDecorator embeds utils.NewSavepoint().OnDeliver()
 		cash.NewDynamicFeeDecorator(authFn, ctrl),
+		gas.NewDecorator(),
 		msgfee.NewAntispamFeeDecorator(minFee),
+		msgfee.NewPathAntispamFeeDecorator(),
 		msgfee.NewFeeDecorator(),
 		batch.NewDecorator(),
 		utils.NewActionTagger(),
@@ -77,8 +92,9 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 
 	migration.RegisterRoutes(r, authFn)
 	cash.RegisterRoutes(r, authFn, ctrl)
-	escrow.RegisterRoutes(r, authFn, ctrl)
-	multisig.RegisterRoutes(r, authFn)
+	cash.RegisterScheduleRoutes(r, authFn, ctrl, scheduler)
+	escrow.RegisterRoutes(r, authFn, ctrl, scheduler, username.NewTokenMover())
+	multisig.RegisterRoutes(r, authFn, decodeProposalOptions, multisigProposalExecutor(ctrl), scheduler)
 	//TODO: Possibly revisit passing the bucket later to have more control over types?
 	// or implement a check
 	currency.RegisterRoutes(r, authFn, issuer)
@@ -86,9 +102,11 @@ func Router(authFn x.Authenticator, issuer weave.Address) *app.Router {
 	distribution.RegisterRoutes(r, authFn, ctrl)
 	sigs.RegisterRoutes(r, authFn)
 	aswap.RegisterRoutes(r, authFn, ctrl)
-	gov.RegisterRoutes(r, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl), scheduler)
+	gov.RegisterRoutes(r, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl), scheduler, ctrl)
 	username.RegisterRoutes(r, authFn)
 	msgfee.RegisterRoutes(r, authFn)
+	gas.RegisterRoutes(r, authFn)
+	feature.RegisterRoutes(r, authFn)
 	return r
 }
 
@@ -118,9 +136,9 @@ func QueryRouter(minFee coin.Coin) weave.QueryRouter {
 
 // Stack wires up a standard router with a standard decorator
 // chain. This can be passed into BaseApp.
-func Stack(issuer weave.Address, minFee coin.Coin) weave.Handler {
+func Stack(issuer weave.Address, minFee coin.Coin, rateLimit int, rateLimitWindow int64, txDeadline time.Duration) weave.Handler {
 	authFn := Authenticator()
-	return Chain(authFn, minFee).WithHandler(Router(authFn, issuer))
+	return Chain(authFn, minFee, rateLimit, rateLimitWindow, txDeadline).WithHandler(Router(authFn, issuer))
 }
 
 // CronStack wires up a standard router with a cron specific decorator chain.
@@ -134,15 +152,19 @@ func CronStack() weave.Handler {
 	authFn := cron.Authenticator{}
 
 	// Cron is using custom router as not the same handlers are registered.
-	gov.RegisterCronRoutes(rt, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl))
+	gov.RegisterCronRoutes(rt, authFn, decodeProposalOptions, proposalOptionsExecutor(ctrl), ctrl)
+	cash.RegisterCronRoutes(rt, authFn, ctrl)
 	distribution.RegisterRoutes(rt, authFn, ctrl)
-	escrow.RegisterRoutes(rt, authFn, ctrl)
+	escrow.RegisterRoutes(rt, authFn, ctrl, cron.NewScheduler(CronTaskMarshaler), username.NewTokenMover())
 	aswap.RegisterRoutes(rt, authFn, ctrl)
+	multisig.RegisterCronRoutes(rt)
 
 	decorators := app.ChainDecorators(
 		utils.NewLogging(),
+		utils.NewMetrics(),
 		utils.NewRecovery(),
 		utils.NewKeyTagger(),
+		cash.NewTransferTagger(),
 		utils.NewActionTagger(),
 		// No fee decorators.
 	)
@@ -160,7 +182,7 @@ func Application(
 	options *server.Options,
 ) (app.BaseApp, error) {
 	ctx := context.Background()
-	kv, err := CommitKVStore(dbPath)
+	kv, err := CommitKVStore(dbPath, options.DB)
 	if err != nil {
 		return app.BaseApp{}, errors.Wrap(err, "cannot create store")
 	}
@@ -171,11 +193,13 @@ func Application(
 }
 
 // CommitKVStore returns an initialized KVStore that persists
-// the data to the named path.
-func CommitKVStore(dbPath string) (weave.CommitKVStore, error) {
+// the data to the named path, using the database backend configured
+// by given options.
+func CommitKVStore(dbPath string, opts iavl.Options) (weave.CommitKVStore, error) {
 	// memory backed case, just for testing
-	if dbPath == "" {
-		return iavl.MockCommitStore(), nil
+	if dbPath == "" || opts.Backend == iavl.MemDBBackend {
+		opts.Backend = iavl.MemDBBackend
+		return iavl.NewCommitStoreWithOptions("", "", opts)
 	}
 
 	// Expand the path fully
@@ -190,5 +214,5 @@ func CommitKVStore(dbPath string) (weave.CommitKVStore, error) {
 	// Split the database name into it's components (dir, name)
 	dir := filepath.Dir(path)
 	name := filepath.Base(path)
-	return iavl.NewCommitStore(dir, name), nil
+	return iavl.NewCommitStoreWithOptions(dir, name, opts)
 }
@@ -2,6 +2,7 @@ package bnsd_test
 
 import (
 	"encoding/hex"
+	"fmt"
 	"sort"
 	"strings"
 	"testing"
@@ -13,7 +14,9 @@ import (
 	"github.com/iov-one/weave/cmd/bnsd/app/testdata/fixtures"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/weavetest/txbuild"
 	"github.com/iov-one/weave/x/batch"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/multisig"
@@ -45,8 +48,10 @@ func TestApp(t *testing.T) {
 	addr2 := pk2.PublicKey().Address()
 	dres := sendToken(t, myApp, appFixture.ChainID, 2, []Signer{{pk, 0}}, addr, addr2, 2000, "ETH", "Have a great trip!")
 
-	// ensure 4 keys for all accounts that are modified by a transaction
-	assert.Equal(t, 5, len(dres.Tags))
+	// ensure 4 keys for all accounts that are modified by a transaction,
+	// following the action tag and the tags of the fee and the send
+	// transfers
+	assert.Equal(t, 15, len(dres.Tags))
 	feeDistAddr := weave.NewCondition("dist", "revenue", []byte{0, 0, 0, 0, 0, 0, 0, 1}).Address()
 	wantKeys := []string{
 		"action",
@@ -63,13 +68,13 @@ func TestApp(t *testing.T) {
 		assert.Equal(t, true, found)
 	}
 
-	// first tag is the action tagger, following are key tagger
+	// first tag is the action tagger, last are key tagger
 	assert.Equal(t, []string{"cash/send", "s", "s", "s", "s"}, []string{
 		string(dres.Tags[0].Value),
-		string(dres.Tags[1].Value),
-		string(dres.Tags[2].Value),
-		string(dres.Tags[3].Value),
-		string(dres.Tags[4].Value),
+		string(dres.Tags[11].Value),
+		string(dres.Tags[12].Value),
+		string(dres.Tags[13].Value),
+		string(dres.Tags[14].Value),
 	})
 
 	// Query for fees stored
@@ -161,6 +166,73 @@ func TestApp(t *testing.T) {
 	sendBatch(t, myApp, chainID, 9, []Signer{{pk, 5}}, addr, batchAddr, 20, "ETH", "And the cash keeps flowing")
 }
 
+func TestAppSequentialTransactions(t *testing.T) {
+	appFixture := fixtures.NewApp()
+	chain := weavetest.NewChain(t, appFixture.Build(), appFixture.ChainID)
+
+	sender := &txbuild.Signer{Key: appFixture.GenesisKey}
+	receiver := txbuild.NewSigner()
+	builder := txbuild.New(appFixture.ChainID, &bnsd.Tx{}).
+		Fee(sender.Address(), coin.NewCoin(1, 0, "FRNK")).
+		Sign(sender)
+
+	// Each transaction must be signed with the next sequence value.
+	for i := 0; i < 3; i++ {
+		tx := builder.Msg(&cash.SendMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Source:      sender.Address(),
+			Destination: receiver.Address(),
+			Amount:      coin.NewCoinp(100, 0, "ETH"),
+		}).MustBuild(t)
+		res := chain.Deliver(tx)
+		assert.Nil(t, res.Err(0))
+	}
+	assert.Equal(t, int64(3), sender.Seq)
+
+	var wallet cash.Set
+	assert.Equal(t, true, chain.Load("/wallets", receiver.Address(), &wallet))
+	assert.Equal(t, coin.Coins{coin.NewCoinp(300, 0, "ETH")}, coin.Coins(wallet.Coins))
+}
+
+func TestAppTransferWithGas(t *testing.T) {
+	appFixture := fixtures.NewApp()
+	chain := weavetest.NewChain(t, appFixture.Build(), appFixture.ChainID)
+
+	sender := &txbuild.Signer{Key: appFixture.GenesisKey}
+	receiver := txbuild.NewSigner()
+	tx := txbuild.New(appFixture.ChainID, &bnsd.Tx{}).
+		Fee(sender.Address(), coin.NewCoin(1, 0, "FRNK")).
+		Sign(sender).
+		Msg(&cash.SendMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Source:      sender.Address(),
+			Destination: receiver.Address(),
+			Amount:      coin.NewCoinp(100, 0, "ETH"),
+		}).MustBuild(t)
+
+	// The fixture gas configuration enables metering of the whole stack.
+	res := chain.Commit(weavetest.Block{Height: 5, Txs: []weave.Tx{tx}})
+	assert.Nil(t, res.Err(0))
+	assert.Equal(t, true, res.Txs[0].GasUsed > 0)
+
+	// The fee is moved first.
+	wantTags := map[string]string{
+		"transfer.1.sender":    sender.Address().String(),
+		"transfer.1.recipient": receiver.Address().String(),
+		"transfer.1.amount":    "100",
+		"transfer.1.ticker":    "ETH",
+	}
+	for key, value := range wantTags {
+		if !res.HasTag([]byte(key), []byte(value)) {
+			t.Errorf("missing %s=%s tag", key, value)
+		}
+	}
+
+	var wallet cash.Set
+	assert.Equal(t, true, chain.Load("/wallets", receiver.Address(), &wallet))
+	assert.Equal(t, int64(5), wallet.CreationHeight)
+}
+
 func tagsAsString(pairs []common.KVPair) string {
 	r := make([]string, len(pairs))
 	for i, v := range pairs {
@@ -246,7 +318,7 @@ func sendBatch(t *testing.T, baseApp abci.Application, chainID string, height in
 	// make sure the key tags are only present once (not once per item)
 	// action tag should be present for each message (important if different types)
 	feeDistAddr := weave.NewCondition("dist", "revenue", []byte{0, 0, 0, 0, 0, 0, 0, 1}).Address()
-	if len(dres.Tags) != 19 {
+	if len(dres.Tags) != 83 {
 		t.Fatalf("%v", len(dres.Tags))
 	}
 	// we need to sort the db keys for consistent ordering
@@ -258,7 +330,16 @@ func sendBatch(t *testing.T, baseApp abci.Application, chainID string, height in
 		toHex("cash:") + feeDistAddr.String(), // fee destination
 	}
 	sort.Strings(wantKeys)
-	// all the action tagger for batch are before the key tagger
+	// the fee and each message of the batch is a transfer
+	var transferKeys []string
+	for i := 0; i <= batch.MaxBatchMessages; i++ {
+		for _, name := range []string{cash.TransferSenderTag, cash.TransferRecipientTag, cash.TransferAmountTag, cash.TransferTickerTag} {
+			transferKeys = append(transferKeys, fmt.Sprintf("transfer.%d.%s", i, name))
+		}
+	}
+	wantKeys = append(transferKeys, wantKeys...)
+	// all the action tagger for batch are before the transfer and the key
+	// tagger
 	wantKeys = append([]string{
 		"action",
 		"action",
@@ -11,10 +11,12 @@ import (
 	username "github.com/iov-one/weave/cmd/bnsd/x/username"
 	migration "github.com/iov-one/weave/migration"
 	aswap "github.com/iov-one/weave/x/aswap"
+	batch "github.com/iov-one/weave/x/batch"
 	cash "github.com/iov-one/weave/x/cash"
 	currency "github.com/iov-one/weave/x/currency"
 	distribution "github.com/iov-one/weave/x/distribution"
 	escrow "github.com/iov-one/weave/x/escrow"
+	feature "github.com/iov-one/weave/x/feature"
 	gov "github.com/iov-one/weave/x/gov"
 	msgfee "github.com/iov-one/weave/x/msgfee"
 	multisig "github.com/iov-one/weave/x/multisig"
@@ -82,6 +84,39 @@ type Tx struct {
 	//	*Tx_GovUpdateElectorateMsg
 	//	*Tx_GovUpdateElectionRuleMsg
 	//	*Tx_MsgfeeSetMsgFeeMsg
+	//	*Tx_CashMultiSendMsg
+	//	*Tx_CashBurnMsg
+	//	*Tx_CashMintMsg
+	//	*Tx_CashApproveMsg
+	//	*Tx_CashTransferFromMsg
+	//	*Tx_CashCreateVestingMsg
+	//	*Tx_CashReleaseVestingMsg
+	//	*Tx_CashFreezeAccountMsg
+	//	*Tx_CashUnfreezeAccountMsg
+	//	*Tx_CashGrantFeeMsg
+	//	*Tx_CashScheduleSendMsg
+	//	*Tx_CashCancelScheduledSendMsg
+	//	*Tx_CashSetWalletNameMsg
+	//	*Tx_EscrowReleaseMilestoneMsg
+	//	*Tx_EscrowApproveMsg
+	//	*Tx_EscrowUpdateEscrowTimeoutMsg
+	//	*Tx_EscrowRaiseDisputeMsg
+	//	*Tx_EscrowResolveDisputeMsg
+	//	*Tx_EscrowUpdateEscrowPartiesMsg
+	//	*Tx_MultisigCreateProposalMsg
+	//	*Tx_MultisigApproveProposalMsg
+	//	*Tx_MultisigApplyUpdateMsg
+	//	*Tx_MultisigVetoUpdateMsg
+	//	*Tx_MultisigExpireProposalMsg
+	//	*Tx_SigsRotateKeyMsg
+	//	*Tx_ValidatorsSetBlsKeyMsg
+	//	*Tx_GovDelegateVoteMsg
+	//	*Tx_GovRevokeDelegationMsg
+	//	*Tx_MigrationMigrateBucketMsg
+	//	*Tx_MigrationDowngradeSchemaMsg
+	//	*Tx_MsgfeeUpdateConfigurationMsg
+	//	*Tx_FeatureScheduleFeatureMsg
+	//	*Tx_FeatureUpdateConfigurationMsg
 	Sum isTx_Sum `protobuf_oneof:"sum"`
 }
 
@@ -202,6 +237,105 @@ type Tx_GovUpdateElectionRuleMsg struct {
 type Tx_MsgfeeSetMsgFeeMsg struct {
 	MsgfeeSetMsgFeeMsg *msgfee.SetMsgFeeMsg `protobuf:"bytes,80,opt,name=msgfee_set_msg_fee_msg,json=msgfeeSetMsgFeeMsg,proto3,oneof"`
 }
+type Tx_CashMultiSendMsg struct {
+	CashMultiSendMsg *cash.MultiSendMsg `protobuf:"bytes,81,opt,name=cash_multi_send_msg,json=cashMultiSendMsg,proto3,oneof"`
+}
+type Tx_CashBurnMsg struct {
+	CashBurnMsg *cash.BurnMsg `protobuf:"bytes,82,opt,name=cash_burn_msg,json=cashBurnMsg,proto3,oneof"`
+}
+type Tx_CashMintMsg struct {
+	CashMintMsg *cash.MintMsg `protobuf:"bytes,83,opt,name=cash_mint_msg,json=cashMintMsg,proto3,oneof"`
+}
+type Tx_CashApproveMsg struct {
+	CashApproveMsg *cash.ApproveMsg `protobuf:"bytes,84,opt,name=cash_approve_msg,json=cashApproveMsg,proto3,oneof"`
+}
+type Tx_CashTransferFromMsg struct {
+	CashTransferFromMsg *cash.TransferFromMsg `protobuf:"bytes,85,opt,name=cash_transfer_from_msg,json=cashTransferFromMsg,proto3,oneof"`
+}
+type Tx_CashCreateVestingMsg struct {
+	CashCreateVestingMsg *cash.CreateVestingMsg `protobuf:"bytes,86,opt,name=cash_create_vesting_msg,json=cashCreateVestingMsg,proto3,oneof"`
+}
+type Tx_CashReleaseVestingMsg struct {
+	CashReleaseVestingMsg *cash.ReleaseVestingMsg `protobuf:"bytes,87,opt,name=cash_release_vesting_msg,json=cashReleaseVestingMsg,proto3,oneof"`
+}
+type Tx_CashFreezeAccountMsg struct {
+	CashFreezeAccountMsg *cash.FreezeAccountMsg `protobuf:"bytes,88,opt,name=cash_freeze_account_msg,json=cashFreezeAccountMsg,proto3,oneof"`
+}
+type Tx_CashUnfreezeAccountMsg struct {
+	CashUnfreezeAccountMsg *cash.UnfreezeAccountMsg `protobuf:"bytes,89,opt,name=cash_unfreeze_account_msg,json=cashUnfreezeAccountMsg,proto3,oneof"`
+}
+type Tx_CashGrantFeeMsg struct {
+	CashGrantFeeMsg *cash.GrantFeeMsg `protobuf:"bytes,90,opt,name=cash_grant_fee_msg,json=cashGrantFeeMsg,proto3,oneof"`
+}
+type Tx_CashScheduleSendMsg struct {
+	CashScheduleSendMsg *cash.ScheduleSendMsg `protobuf:"bytes,91,opt,name=cash_schedule_send_msg,json=cashScheduleSendMsg,proto3,oneof"`
+}
+type Tx_CashCancelScheduledSendMsg struct {
+	CashCancelScheduledSendMsg *cash.CancelScheduledSendMsg `protobuf:"bytes,92,opt,name=cash_cancel_scheduled_send_msg,json=cashCancelScheduledSendMsg,proto3,oneof"`
+}
+type Tx_CashSetWalletNameMsg struct {
+	CashSetWalletNameMsg *cash.SetWalletNameMsg `protobuf:"bytes,94,opt,name=cash_set_wallet_name_msg,json=cashSetWalletNameMsg,proto3,oneof"`
+}
+type Tx_EscrowReleaseMilestoneMsg struct {
+	EscrowReleaseMilestoneMsg *escrow.ReleaseMilestoneMsg `protobuf:"bytes,95,opt,name=escrow_release_milestone_msg,json=escrowReleaseMilestoneMsg,proto3,oneof"`
+}
+type Tx_EscrowApproveMsg struct {
+	EscrowApproveMsg *escrow.ApproveMsg `protobuf:"bytes,96,opt,name=escrow_approve_msg,json=escrowApproveMsg,proto3,oneof"`
+}
+type Tx_EscrowUpdateEscrowTimeoutMsg struct {
+	EscrowUpdateEscrowTimeoutMsg *escrow.UpdateEscrowTimeoutMsg `protobuf:"bytes,97,opt,name=escrow_update_escrow_timeout_msg,json=escrowUpdateEscrowTimeoutMsg,proto3,oneof"`
+}
+type Tx_EscrowRaiseDisputeMsg struct {
+	EscrowRaiseDisputeMsg *escrow.RaiseDisputeMsg `protobuf:"bytes,98,opt,name=escrow_raise_dispute_msg,json=escrowRaiseDisputeMsg,proto3,oneof"`
+}
+type Tx_EscrowResolveDisputeMsg struct {
+	EscrowResolveDisputeMsg *escrow.ResolveDisputeMsg `protobuf:"bytes,99,opt,name=escrow_resolve_dispute_msg,json=escrowResolveDisputeMsg,proto3,oneof"`
+}
+type Tx_EscrowUpdateEscrowPartiesMsg struct {
+	EscrowUpdateEscrowPartiesMsg *escrow.UpdateEscrowPartiesMsg `protobuf:"bytes,100,opt,name=escrow_update_escrow_parties_msg,json=escrowUpdateEscrowPartiesMsg,proto3,oneof"`
+}
+type Tx_MultisigCreateProposalMsg struct {
+	MultisigCreateProposalMsg *multisig.CreateProposalMsg `protobuf:"bytes,101,opt,name=multisig_create_proposal_msg,json=multisigCreateProposalMsg,proto3,oneof"`
+}
+type Tx_MultisigApproveProposalMsg struct {
+	MultisigApproveProposalMsg *multisig.ApproveProposalMsg `protobuf:"bytes,102,opt,name=multisig_approve_proposal_msg,json=multisigApproveProposalMsg,proto3,oneof"`
+}
+type Tx_MultisigApplyUpdateMsg struct {
+	MultisigApplyUpdateMsg *multisig.ApplyUpdateMsg `protobuf:"bytes,103,opt,name=multisig_apply_update_msg,json=multisigApplyUpdateMsg,proto3,oneof"`
+}
+type Tx_MultisigVetoUpdateMsg struct {
+	MultisigVetoUpdateMsg *multisig.VetoUpdateMsg `protobuf:"bytes,104,opt,name=multisig_veto_update_msg,json=multisigVetoUpdateMsg,proto3,oneof"`
+}
+type Tx_MultisigExpireProposalMsg struct {
+	MultisigExpireProposalMsg *multisig.ExpireProposalMsg `protobuf:"bytes,105,opt,name=multisig_expire_proposal_msg,json=multisigExpireProposalMsg,proto3,oneof"`
+}
+type Tx_SigsRotateKeyMsg struct {
+	SigsRotateKeyMsg *sigs.RotateKeyMsg `protobuf:"bytes,106,opt,name=sigs_rotate_key_msg,json=sigsRotateKeyMsg,proto3,oneof"`
+}
+type Tx_ValidatorsSetBlsKeyMsg struct {
+	ValidatorsSetBlsKeyMsg *validators.SetBLSKeyMsg `protobuf:"bytes,107,opt,name=validators_set_bls_key_msg,json=validatorsSetBlsKeyMsg,proto3,oneof"`
+}
+type Tx_GovDelegateVoteMsg struct {
+	GovDelegateVoteMsg *gov.DelegateVoteMsg `protobuf:"bytes,109,opt,name=gov_delegate_vote_msg,json=govDelegateVoteMsg,proto3,oneof"`
+}
+type Tx_GovRevokeDelegationMsg struct {
+	GovRevokeDelegationMsg *gov.RevokeDelegationMsg `protobuf:"bytes,110,opt,name=gov_revoke_delegation_msg,json=govRevokeDelegationMsg,proto3,oneof"`
+}
+type Tx_MigrationMigrateBucketMsg struct {
+	MigrationMigrateBucketMsg *migration.MigrateBucketMsg `protobuf:"bytes,112,opt,name=migration_migrate_bucket_msg,json=migrationMigrateBucketMsg,proto3,oneof"`
+}
+type Tx_MigrationDowngradeSchemaMsg struct {
+	MigrationDowngradeSchemaMsg *migration.DowngradeSchemaMsg `protobuf:"bytes,113,opt,name=migration_downgrade_schema_msg,json=migrationDowngradeSchemaMsg,proto3,oneof"`
+}
+type Tx_MsgfeeUpdateConfigurationMsg struct {
+	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,114,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
+}
+type Tx_FeatureScheduleFeatureMsg struct {
+	FeatureScheduleFeatureMsg *feature.ScheduleFeatureMsg `protobuf:"bytes,115,opt,name=feature_schedule_feature_msg,json=featureScheduleFeatureMsg,proto3,oneof"`
+}
+type Tx_FeatureUpdateConfigurationMsg struct {
+	FeatureUpdateConfigurationMsg *feature.UpdateConfigurationMsg `protobuf:"bytes,116,opt,name=feature_update_configuration_msg,json=featureUpdateConfigurationMsg,proto3,oneof"`
+}
 
 func (*Tx_CashSendMsg) isTx_Sum()                   {}
 func (*Tx_EscrowCreateMsg) isTx_Sum()               {}
@@ -229,6 +363,39 @@ func (*Tx_GovVoteMsg) isTx_Sum()                    {}
 func (*Tx_GovUpdateElectorateMsg) isTx_Sum()        {}
 func (*Tx_GovUpdateElectionRuleMsg) isTx_Sum()      {}
 func (*Tx_MsgfeeSetMsgFeeMsg) isTx_Sum()            {}
+func (*Tx_CashMultiSendMsg) isTx_Sum()              {}
+func (*Tx_CashBurnMsg) isTx_Sum()                   {}
+func (*Tx_CashMintMsg) isTx_Sum()                   {}
+func (*Tx_CashApproveMsg) isTx_Sum()                {}
+func (*Tx_CashTransferFromMsg) isTx_Sum()           {}
+func (*Tx_CashCreateVestingMsg) isTx_Sum()          {}
+func (*Tx_CashReleaseVestingMsg) isTx_Sum()         {}
+func (*Tx_CashFreezeAccountMsg) isTx_Sum()          {}
+func (*Tx_CashUnfreezeAccountMsg) isTx_Sum()        {}
+func (*Tx_CashGrantFeeMsg) isTx_Sum()               {}
+func (*Tx_CashScheduleSendMsg) isTx_Sum()           {}
+func (*Tx_CashCancelScheduledSendMsg) isTx_Sum()    {}
+func (*Tx_CashSetWalletNameMsg) isTx_Sum()          {}
+func (*Tx_EscrowReleaseMilestoneMsg) isTx_Sum()     {}
+func (*Tx_EscrowApproveMsg) isTx_Sum()              {}
+func (*Tx_EscrowUpdateEscrowTimeoutMsg) isTx_Sum()  {}
+func (*Tx_EscrowRaiseDisputeMsg) isTx_Sum()         {}
+func (*Tx_EscrowResolveDisputeMsg) isTx_Sum()       {}
+func (*Tx_EscrowUpdateEscrowPartiesMsg) isTx_Sum()  {}
+func (*Tx_MultisigCreateProposalMsg) isTx_Sum()     {}
+func (*Tx_MultisigApproveProposalMsg) isTx_Sum()    {}
+func (*Tx_MultisigApplyUpdateMsg) isTx_Sum()        {}
+func (*Tx_MultisigVetoUpdateMsg) isTx_Sum()         {}
+func (*Tx_MultisigExpireProposalMsg) isTx_Sum()     {}
+func (*Tx_SigsRotateKeyMsg) isTx_Sum()              {}
+func (*Tx_ValidatorsSetBlsKeyMsg) isTx_Sum()        {}
+func (*Tx_GovDelegateVoteMsg) isTx_Sum()            {}
+func (*Tx_GovRevokeDelegationMsg) isTx_Sum()        {}
+func (*Tx_MigrationMigrateBucketMsg) isTx_Sum()     {}
+func (*Tx_MigrationDowngradeSchemaMsg) isTx_Sum()   {}
+func (*Tx_MsgfeeUpdateConfigurationMsg) isTx_Sum()  {}
+func (*Tx_FeatureScheduleFeatureMsg) isTx_Sum()     {}
+func (*Tx_FeatureUpdateConfigurationMsg) isTx_Sum() {}
 
 func (m *Tx) GetSum() isTx_Sum {
 	if m != nil {
@@ -440,6 +607,237 @@ func (m *Tx) GetMsgfeeSetMsgFeeMsg() *msgfee.SetMsgFeeMsg {
 	return nil
 }
 
+func (m *Tx) GetCashMultiSendMsg() *cash.MultiSendMsg {
+	if x, ok := m.GetSum().(*Tx_CashMultiSendMsg); ok {
+		return x.CashMultiSendMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashBurnMsg() *cash.BurnMsg {
+	if x, ok := m.GetSum().(*Tx_CashBurnMsg); ok {
+		return x.CashBurnMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashMintMsg() *cash.MintMsg {
+	if x, ok := m.GetSum().(*Tx_CashMintMsg); ok {
+		return x.CashMintMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashApproveMsg() *cash.ApproveMsg {
+	if x, ok := m.GetSum().(*Tx_CashApproveMsg); ok {
+		return x.CashApproveMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashTransferFromMsg() *cash.TransferFromMsg {
+	if x, ok := m.GetSum().(*Tx_CashTransferFromMsg); ok {
+		return x.CashTransferFromMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashCreateVestingMsg() *cash.CreateVestingMsg {
+	if x, ok := m.GetSum().(*Tx_CashCreateVestingMsg); ok {
+		return x.CashCreateVestingMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashReleaseVestingMsg() *cash.ReleaseVestingMsg {
+	if x, ok := m.GetSum().(*Tx_CashReleaseVestingMsg); ok {
+		return x.CashReleaseVestingMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashFreezeAccountMsg() *cash.FreezeAccountMsg {
+	if x, ok := m.GetSum().(*Tx_CashFreezeAccountMsg); ok {
+		return x.CashFreezeAccountMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashUnfreezeAccountMsg() *cash.UnfreezeAccountMsg {
+	if x, ok := m.GetSum().(*Tx_CashUnfreezeAccountMsg); ok {
+		return x.CashUnfreezeAccountMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashGrantFeeMsg() *cash.GrantFeeMsg {
+	if x, ok := m.GetSum().(*Tx_CashGrantFeeMsg); ok {
+		return x.CashGrantFeeMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashScheduleSendMsg() *cash.ScheduleSendMsg {
+	if x, ok := m.GetSum().(*Tx_CashScheduleSendMsg); ok {
+		return x.CashScheduleSendMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashCancelScheduledSendMsg() *cash.CancelScheduledSendMsg {
+	if x, ok := m.GetSum().(*Tx_CashCancelScheduledSendMsg); ok {
+		return x.CashCancelScheduledSendMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetCashSetWalletNameMsg() *cash.SetWalletNameMsg {
+	if x, ok := m.GetSum().(*Tx_CashSetWalletNameMsg); ok {
+		return x.CashSetWalletNameMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetEscrowReleaseMilestoneMsg() *escrow.ReleaseMilestoneMsg {
+	if x, ok := m.GetSum().(*Tx_EscrowReleaseMilestoneMsg); ok {
+		return x.EscrowReleaseMilestoneMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetEscrowApproveMsg() *escrow.ApproveMsg {
+	if x, ok := m.GetSum().(*Tx_EscrowApproveMsg); ok {
+		return x.EscrowApproveMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetEscrowUpdateEscrowTimeoutMsg() *escrow.UpdateEscrowTimeoutMsg {
+	if x, ok := m.GetSum().(*Tx_EscrowUpdateEscrowTimeoutMsg); ok {
+		return x.EscrowUpdateEscrowTimeoutMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetEscrowRaiseDisputeMsg() *escrow.RaiseDisputeMsg {
+	if x, ok := m.GetSum().(*Tx_EscrowRaiseDisputeMsg); ok {
+		return x.EscrowRaiseDisputeMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetEscrowResolveDisputeMsg() *escrow.ResolveDisputeMsg {
+	if x, ok := m.GetSum().(*Tx_EscrowResolveDisputeMsg); ok {
+		return x.EscrowResolveDisputeMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetEscrowUpdateEscrowPartiesMsg() *escrow.UpdateEscrowPartiesMsg {
+	if x, ok := m.GetSum().(*Tx_EscrowUpdateEscrowPartiesMsg); ok {
+		return x.EscrowUpdateEscrowPartiesMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMultisigCreateProposalMsg() *multisig.CreateProposalMsg {
+	if x, ok := m.GetSum().(*Tx_MultisigCreateProposalMsg); ok {
+		return x.MultisigCreateProposalMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMultisigApproveProposalMsg() *multisig.ApproveProposalMsg {
+	if x, ok := m.GetSum().(*Tx_MultisigApproveProposalMsg); ok {
+		return x.MultisigApproveProposalMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMultisigApplyUpdateMsg() *multisig.ApplyUpdateMsg {
+	if x, ok := m.GetSum().(*Tx_MultisigApplyUpdateMsg); ok {
+		return x.MultisigApplyUpdateMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMultisigVetoUpdateMsg() *multisig.VetoUpdateMsg {
+	if x, ok := m.GetSum().(*Tx_MultisigVetoUpdateMsg); ok {
+		return x.MultisigVetoUpdateMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMultisigExpireProposalMsg() *multisig.ExpireProposalMsg {
+	if x, ok := m.GetSum().(*Tx_MultisigExpireProposalMsg); ok {
+		return x.MultisigExpireProposalMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetSigsRotateKeyMsg() *sigs.RotateKeyMsg {
+	if x, ok := m.GetSum().(*Tx_SigsRotateKeyMsg); ok {
+		return x.SigsRotateKeyMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetValidatorsSetBlsKeyMsg() *validators.SetBLSKeyMsg {
+	if x, ok := m.GetSum().(*Tx_ValidatorsSetBlsKeyMsg); ok {
+		return x.ValidatorsSetBlsKeyMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGovDelegateVoteMsg() *gov.DelegateVoteMsg {
+	if x, ok := m.GetSum().(*Tx_GovDelegateVoteMsg); ok {
+		return x.GovDelegateVoteMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetGovRevokeDelegationMsg() *gov.RevokeDelegationMsg {
+	if x, ok := m.GetSum().(*Tx_GovRevokeDelegationMsg); ok {
+		return x.GovRevokeDelegationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMigrationMigrateBucketMsg() *migration.MigrateBucketMsg {
+	if x, ok := m.GetSum().(*Tx_MigrationMigrateBucketMsg); ok {
+		return x.MigrationMigrateBucketMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMigrationDowngradeSchemaMsg() *migration.DowngradeSchemaMsg {
+	if x, ok := m.GetSum().(*Tx_MigrationDowngradeSchemaMsg); ok {
+		return x.MigrationDowngradeSchemaMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_MsgfeeUpdateConfigurationMsg); ok {
+		return x.MsgfeeUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetFeatureScheduleFeatureMsg() *feature.ScheduleFeatureMsg {
+	if x, ok := m.GetSum().(*Tx_FeatureScheduleFeatureMsg); ok {
+		return x.FeatureScheduleFeatureMsg
+	}
+	return nil
+}
+
+func (m *Tx) GetFeatureUpdateConfigurationMsg() *feature.UpdateConfigurationMsg {
+	if x, ok := m.GetSum().(*Tx_FeatureUpdateConfigurationMsg); ok {
+		return x.FeatureUpdateConfigurationMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Tx_OneofMarshaler, _Tx_OneofUnmarshaler, _Tx_OneofSizer, []interface{}{
@@ -469,6 +867,39 @@ func (*Tx) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, fun
 		(*Tx_GovUpdateElectorateMsg)(nil),
 		(*Tx_GovUpdateElectionRuleMsg)(nil),
 		(*Tx_MsgfeeSetMsgFeeMsg)(nil),
+		(*Tx_CashMultiSendMsg)(nil),
+		(*Tx_CashBurnMsg)(nil),
+		(*Tx_CashMintMsg)(nil),
+		(*Tx_CashApproveMsg)(nil),
+		(*Tx_CashTransferFromMsg)(nil),
+		(*Tx_CashCreateVestingMsg)(nil),
+		(*Tx_CashReleaseVestingMsg)(nil),
+		(*Tx_CashFreezeAccountMsg)(nil),
+		(*Tx_CashUnfreezeAccountMsg)(nil),
+		(*Tx_CashGrantFeeMsg)(nil),
+		(*Tx_CashScheduleSendMsg)(nil),
+		(*Tx_CashCancelScheduledSendMsg)(nil),
+		(*Tx_CashSetWalletNameMsg)(nil),
+		(*Tx_EscrowReleaseMilestoneMsg)(nil),
+		(*Tx_EscrowApproveMsg)(nil),
+		(*Tx_EscrowUpdateEscrowTimeoutMsg)(nil),
+		(*Tx_EscrowRaiseDisputeMsg)(nil),
+		(*Tx_EscrowResolveDisputeMsg)(nil),
+		(*Tx_EscrowUpdateEscrowPartiesMsg)(nil),
+		(*Tx_MultisigCreateProposalMsg)(nil),
+		(*Tx_MultisigApproveProposalMsg)(nil),
+		(*Tx_MultisigApplyUpdateMsg)(nil),
+		(*Tx_MultisigVetoUpdateMsg)(nil),
+		(*Tx_MultisigExpireProposalMsg)(nil),
+		(*Tx_SigsRotateKeyMsg)(nil),
+		(*Tx_ValidatorsSetBlsKeyMsg)(nil),
+		(*Tx_GovDelegateVoteMsg)(nil),
+		(*Tx_GovRevokeDelegationMsg)(nil),
+		(*Tx_MigrationMigrateBucketMsg)(nil),
+		(*Tx_MigrationDowngradeSchemaMsg)(nil),
+		(*Tx_MsgfeeUpdateConfigurationMsg)(nil),
+		(*Tx_FeatureScheduleFeatureMsg)(nil),
+		(*Tx_FeatureUpdateConfigurationMsg)(nil),
 	}
 }
 
@@ -606,6 +1037,171 @@ func _Tx_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.MsgfeeSetMsgFeeMsg); err != nil {
 			return err
 		}
+	case *Tx_CashMultiSendMsg:
+		_ = b.EncodeVarint(81<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMultiSendMsg); err != nil {
+			return err
+		}
+	case *Tx_CashBurnMsg:
+		_ = b.EncodeVarint(82<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashBurnMsg); err != nil {
+			return err
+		}
+	case *Tx_CashMintMsg:
+		_ = b.EncodeVarint(83<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMintMsg); err != nil {
+			return err
+		}
+	case *Tx_CashApproveMsg:
+		_ = b.EncodeVarint(84<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashApproveMsg); err != nil {
+			return err
+		}
+	case *Tx_CashTransferFromMsg:
+		_ = b.EncodeVarint(85<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashTransferFromMsg); err != nil {
+			return err
+		}
+	case *Tx_CashCreateVestingMsg:
+		_ = b.EncodeVarint(86<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashCreateVestingMsg); err != nil {
+			return err
+		}
+	case *Tx_CashReleaseVestingMsg:
+		_ = b.EncodeVarint(87<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashReleaseVestingMsg); err != nil {
+			return err
+		}
+	case *Tx_CashFreezeAccountMsg:
+		_ = b.EncodeVarint(88<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashFreezeAccountMsg); err != nil {
+			return err
+		}
+	case *Tx_CashUnfreezeAccountMsg:
+		_ = b.EncodeVarint(89<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashUnfreezeAccountMsg); err != nil {
+			return err
+		}
+	case *Tx_CashGrantFeeMsg:
+		_ = b.EncodeVarint(90<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashGrantFeeMsg); err != nil {
+			return err
+		}
+	case *Tx_CashScheduleSendMsg:
+		_ = b.EncodeVarint(91<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashScheduleSendMsg); err != nil {
+			return err
+		}
+	case *Tx_CashCancelScheduledSendMsg:
+		_ = b.EncodeVarint(92<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashCancelScheduledSendMsg); err != nil {
+			return err
+		}
+	case *Tx_CashSetWalletNameMsg:
+		_ = b.EncodeVarint(94<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashSetWalletNameMsg); err != nil {
+			return err
+		}
+	case *Tx_EscrowReleaseMilestoneMsg:
+		_ = b.EncodeVarint(95<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowReleaseMilestoneMsg); err != nil {
+			return err
+		}
+	case *Tx_EscrowApproveMsg:
+		_ = b.EncodeVarint(96<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowApproveMsg); err != nil {
+			return err
+		}
+	case *Tx_EscrowUpdateEscrowTimeoutMsg:
+		_ = b.EncodeVarint(97<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowUpdateEscrowTimeoutMsg); err != nil {
+			return err
+		}
+	case *Tx_EscrowRaiseDisputeMsg:
+		_ = b.EncodeVarint(98<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowRaiseDisputeMsg); err != nil {
+			return err
+		}
+	case *Tx_EscrowResolveDisputeMsg:
+		_ = b.EncodeVarint(99<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowResolveDisputeMsg); err != nil {
+			return err
+		}
+	case *Tx_EscrowUpdateEscrowPartiesMsg:
+		_ = b.EncodeVarint(100<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowUpdateEscrowPartiesMsg); err != nil {
+			return err
+		}
+	case *Tx_MultisigCreateProposalMsg:
+		_ = b.EncodeVarint(101<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigCreateProposalMsg); err != nil {
+			return err
+		}
+	case *Tx_MultisigApproveProposalMsg:
+		_ = b.EncodeVarint(102<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigApproveProposalMsg); err != nil {
+			return err
+		}
+	case *Tx_MultisigApplyUpdateMsg:
+		_ = b.EncodeVarint(103<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigApplyUpdateMsg); err != nil {
+			return err
+		}
+	case *Tx_MultisigVetoUpdateMsg:
+		_ = b.EncodeVarint(104<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigVetoUpdateMsg); err != nil {
+			return err
+		}
+	case *Tx_MultisigExpireProposalMsg:
+		_ = b.EncodeVarint(105<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigExpireProposalMsg); err != nil {
+			return err
+		}
+	case *Tx_SigsRotateKeyMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SigsRotateKeyMsg); err != nil {
+			return err
+		}
+	case *Tx_ValidatorsSetBlsKeyMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ValidatorsSetBlsKeyMsg); err != nil {
+			return err
+		}
+	case *Tx_GovDelegateVoteMsg:
+		_ = b.EncodeVarint(109<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovDelegateVoteMsg); err != nil {
+			return err
+		}
+	case *Tx_GovRevokeDelegationMsg:
+		_ = b.EncodeVarint(110<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovRevokeDelegationMsg); err != nil {
+			return err
+		}
+	case *Tx_MigrationMigrateBucketMsg:
+		_ = b.EncodeVarint(112<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MigrationMigrateBucketMsg); err != nil {
+			return err
+		}
+	case *Tx_MigrationDowngradeSchemaMsg:
+		_ = b.EncodeVarint(113<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MigrationDowngradeSchemaMsg); err != nil {
+			return err
+		}
+	case *Tx_MsgfeeUpdateConfigurationMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *Tx_FeatureScheduleFeatureMsg:
+		_ = b.EncodeVarint(115<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.FeatureScheduleFeatureMsg); err != nil {
+			return err
+		}
+	case *Tx_FeatureUpdateConfigurationMsg:
+		_ = b.EncodeVarint(116<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.FeatureUpdateConfigurationMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("Tx.Sum has unexpected type %T", x)
@@ -824,31 +1420,295 @@ func _Tx_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bo
 		err := b.DecodeMessage(msg)
 		m.Sum = &Tx_MsgfeeSetMsgFeeMsg{msg}
 		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _Tx_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*Tx)
-	// sum
-	switch x := m.Sum.(type) {
-	case *Tx_CashSendMsg:
-		s := proto.Size(x.CashSendMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *Tx_EscrowCreateMsg:
-		s := proto.Size(x.EscrowCreateMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *Tx_EscrowReleaseMsg:
-		s := proto.Size(x.EscrowReleaseMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *Tx_EscrowReturnMsg:
+	case 81: // sum.cash_multi_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MultiSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashMultiSendMsg{msg}
+		return true, err
+	case 82: // sum.cash_burn_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.BurnMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashBurnMsg{msg}
+		return true, err
+	case 83: // sum.cash_mint_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MintMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashMintMsg{msg}
+		return true, err
+	case 84: // sum.cash_approve_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.ApproveMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashApproveMsg{msg}
+		return true, err
+	case 85: // sum.cash_transfer_from_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.TransferFromMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashTransferFromMsg{msg}
+		return true, err
+	case 86: // sum.cash_create_vesting_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.CreateVestingMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashCreateVestingMsg{msg}
+		return true, err
+	case 87: // sum.cash_release_vesting_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.ReleaseVestingMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashReleaseVestingMsg{msg}
+		return true, err
+	case 88: // sum.cash_freeze_account_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.FreezeAccountMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashFreezeAccountMsg{msg}
+		return true, err
+	case 89: // sum.cash_unfreeze_account_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.UnfreezeAccountMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashUnfreezeAccountMsg{msg}
+		return true, err
+	case 90: // sum.cash_grant_fee_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.GrantFeeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashGrantFeeMsg{msg}
+		return true, err
+	case 91: // sum.cash_schedule_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.ScheduleSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashScheduleSendMsg{msg}
+		return true, err
+	case 92: // sum.cash_cancel_scheduled_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.CancelScheduledSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashCancelScheduledSendMsg{msg}
+		return true, err
+	case 94: // sum.cash_set_wallet_name_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.SetWalletNameMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_CashSetWalletNameMsg{msg}
+		return true, err
+	case 95: // sum.escrow_release_milestone_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.ReleaseMilestoneMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_EscrowReleaseMilestoneMsg{msg}
+		return true, err
+	case 96: // sum.escrow_approve_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.ApproveMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_EscrowApproveMsg{msg}
+		return true, err
+	case 97: // sum.escrow_update_escrow_timeout_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.UpdateEscrowTimeoutMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_EscrowUpdateEscrowTimeoutMsg{msg}
+		return true, err
+	case 98: // sum.escrow_raise_dispute_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.RaiseDisputeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_EscrowRaiseDisputeMsg{msg}
+		return true, err
+	case 99: // sum.escrow_resolve_dispute_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.ResolveDisputeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_EscrowResolveDisputeMsg{msg}
+		return true, err
+	case 100: // sum.escrow_update_escrow_parties_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.UpdateEscrowPartiesMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_EscrowUpdateEscrowPartiesMsg{msg}
+		return true, err
+	case 101: // sum.multisig_create_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.CreateProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MultisigCreateProposalMsg{msg}
+		return true, err
+	case 102: // sum.multisig_approve_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.ApproveProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MultisigApproveProposalMsg{msg}
+		return true, err
+	case 103: // sum.multisig_apply_update_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.ApplyUpdateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MultisigApplyUpdateMsg{msg}
+		return true, err
+	case 104: // sum.multisig_veto_update_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.VetoUpdateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MultisigVetoUpdateMsg{msg}
+		return true, err
+	case 105: // sum.multisig_expire_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.ExpireProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MultisigExpireProposalMsg{msg}
+		return true, err
+	case 106: // sum.sigs_rotate_key_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(sigs.RotateKeyMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_SigsRotateKeyMsg{msg}
+		return true, err
+	case 107: // sum.validators_set_bls_key_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(validators.SetBLSKeyMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_ValidatorsSetBlsKeyMsg{msg}
+		return true, err
+	case 109: // sum.gov_delegate_vote_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.DelegateVoteMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovDelegateVoteMsg{msg}
+		return true, err
+	case 110: // sum.gov_revoke_delegation_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.RevokeDelegationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_GovRevokeDelegationMsg{msg}
+		return true, err
+	case 112: // sum.migration_migrate_bucket_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(migration.MigrateBucketMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MigrationMigrateBucketMsg{msg}
+		return true, err
+	case 113: // sum.migration_downgrade_schema_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(migration.DowngradeSchemaMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MigrationDowngradeSchemaMsg{msg}
+		return true, err
+	case 114: // sum.msgfee_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{msg}
+		return true, err
+	case 115: // sum.feature_schedule_feature_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(feature.ScheduleFeatureMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_FeatureScheduleFeatureMsg{msg}
+		return true, err
+	case 116: // sum.feature_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(feature.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &Tx_FeatureUpdateConfigurationMsg{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _Tx_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*Tx)
+	// sum
+	switch x := m.Sum.(type) {
+	case *Tx_CashSendMsg:
+		s := proto.Size(x.CashSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_EscrowCreateMsg:
+		s := proto.Size(x.EscrowCreateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_EscrowReleaseMsg:
+		s := proto.Size(x.EscrowReleaseMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_EscrowReturnMsg:
 		s := proto.Size(x.EscrowReturnMsg)
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
@@ -963,76 +1823,276 @@ func _Tx_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
-// ExecuteBatchMsg encapsulates multiple messages to support batch transaction
-type ExecuteBatchMsg struct {
-	Messages []ExecuteBatchMsg_Union `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
-}
-
-func (m *ExecuteBatchMsg) Reset()         { *m = ExecuteBatchMsg{} }
-func (m *ExecuteBatchMsg) String() string { return proto.CompactTextString(m) }
-func (*ExecuteBatchMsg) ProtoMessage()    {}
-func (*ExecuteBatchMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8efb1d2ea3c411d, []int{1}
-}
-func (m *ExecuteBatchMsg) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ExecuteBatchMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ExecuteBatchMsg.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *ExecuteBatchMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecuteBatchMsg.Merge(m, src)
-}
-func (m *ExecuteBatchMsg) XXX_Size() int {
-	return m.Size()
-}
-func (m *ExecuteBatchMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecuteBatchMsg.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ExecuteBatchMsg proto.InternalMessageInfo
-
-func (m *ExecuteBatchMsg) GetMessages() []ExecuteBatchMsg_Union {
-	if m != nil {
-		return m.Messages
-	}
-	return nil
-}
-
-type ExecuteBatchMsg_Union struct {
-	// Types that are valid to be assigned to Sum:
-	//	*ExecuteBatchMsg_Union_CashSendMsg
-	//	*ExecuteBatchMsg_Union_EscrowCreateMsg
-	//	*ExecuteBatchMsg_Union_EscrowReleaseMsg
-	//	*ExecuteBatchMsg_Union_EscrowReturnMsg
-	//	*ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg
-	//	*ExecuteBatchMsg_Union_MultisigCreateMsg
-	//	*ExecuteBatchMsg_Union_MultisigUpdateMsg
-	//	*ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg
-	//	*ExecuteBatchMsg_Union_CurrencyCreateMsg
-	//	*ExecuteBatchMsg_Union_UsernameRegisterTokenMsg
-	//	*ExecuteBatchMsg_Union_UsernameTransferTokenMsg
-	//	*ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg
+	case *Tx_CashMultiSendMsg:
+		s := proto.Size(x.CashMultiSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashBurnMsg:
+		s := proto.Size(x.CashBurnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashMintMsg:
+		s := proto.Size(x.CashMintMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashApproveMsg:
+		s := proto.Size(x.CashApproveMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashTransferFromMsg:
+		s := proto.Size(x.CashTransferFromMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashCreateVestingMsg:
+		s := proto.Size(x.CashCreateVestingMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashReleaseVestingMsg:
+		s := proto.Size(x.CashReleaseVestingMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashFreezeAccountMsg:
+		s := proto.Size(x.CashFreezeAccountMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashUnfreezeAccountMsg:
+		s := proto.Size(x.CashUnfreezeAccountMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashGrantFeeMsg:
+		s := proto.Size(x.CashGrantFeeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashScheduleSendMsg:
+		s := proto.Size(x.CashScheduleSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashCancelScheduledSendMsg:
+		s := proto.Size(x.CashCancelScheduledSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_CashSetWalletNameMsg:
+		s := proto.Size(x.CashSetWalletNameMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_EscrowReleaseMilestoneMsg:
+		s := proto.Size(x.EscrowReleaseMilestoneMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_EscrowApproveMsg:
+		s := proto.Size(x.EscrowApproveMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_EscrowUpdateEscrowTimeoutMsg:
+		s := proto.Size(x.EscrowUpdateEscrowTimeoutMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_EscrowRaiseDisputeMsg:
+		s := proto.Size(x.EscrowRaiseDisputeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_EscrowResolveDisputeMsg:
+		s := proto.Size(x.EscrowResolveDisputeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_EscrowUpdateEscrowPartiesMsg:
+		s := proto.Size(x.EscrowUpdateEscrowPartiesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MultisigCreateProposalMsg:
+		s := proto.Size(x.MultisigCreateProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MultisigApproveProposalMsg:
+		s := proto.Size(x.MultisigApproveProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MultisigApplyUpdateMsg:
+		s := proto.Size(x.MultisigApplyUpdateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MultisigVetoUpdateMsg:
+		s := proto.Size(x.MultisigVetoUpdateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MultisigExpireProposalMsg:
+		s := proto.Size(x.MultisigExpireProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_SigsRotateKeyMsg:
+		s := proto.Size(x.SigsRotateKeyMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_ValidatorsSetBlsKeyMsg:
+		s := proto.Size(x.ValidatorsSetBlsKeyMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GovDelegateVoteMsg:
+		s := proto.Size(x.GovDelegateVoteMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_GovRevokeDelegationMsg:
+		s := proto.Size(x.GovRevokeDelegationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MigrationMigrateBucketMsg:
+		s := proto.Size(x.MigrationMigrateBucketMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MigrationDowngradeSchemaMsg:
+		s := proto.Size(x.MigrationDowngradeSchemaMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_MsgfeeUpdateConfigurationMsg:
+		s := proto.Size(x.MsgfeeUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_FeatureScheduleFeatureMsg:
+		s := proto.Size(x.FeatureScheduleFeatureMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Tx_FeatureUpdateConfigurationMsg:
+		s := proto.Size(x.FeatureUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+// ExecuteBatchMsg encapsulates multiple messages to support batch transaction
+type ExecuteBatchMsg struct {
+	Messages []ExecuteBatchMsg_Union `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
+	// Mode declares how the batch is processed when one of its messages fails.
+	Mode batch.Mode `protobuf:"varint,2,opt,name=mode,proto3,enum=batch.Mode" json:"mode,omitempty"`
+}
+
+func (m *ExecuteBatchMsg) Reset()         { *m = ExecuteBatchMsg{} }
+func (m *ExecuteBatchMsg) String() string { return proto.CompactTextString(m) }
+func (*ExecuteBatchMsg) ProtoMessage()    {}
+func (*ExecuteBatchMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8efb1d2ea3c411d, []int{1}
+}
+func (m *ExecuteBatchMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteBatchMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteBatchMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecuteBatchMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteBatchMsg.Merge(m, src)
+}
+func (m *ExecuteBatchMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteBatchMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteBatchMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteBatchMsg proto.InternalMessageInfo
+
+func (m *ExecuteBatchMsg) GetMessages() []ExecuteBatchMsg_Union {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg) GetMode() batch.Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return batch.Mode_Atomic
+}
+
+type ExecuteBatchMsg_Union struct {
+	// Types that are valid to be assigned to Sum:
+	//	*ExecuteBatchMsg_Union_CashSendMsg
+	//	*ExecuteBatchMsg_Union_EscrowCreateMsg
+	//	*ExecuteBatchMsg_Union_EscrowReleaseMsg
+	//	*ExecuteBatchMsg_Union_EscrowReturnMsg
+	//	*ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg
+	//	*ExecuteBatchMsg_Union_MultisigCreateMsg
+	//	*ExecuteBatchMsg_Union_MultisigUpdateMsg
+	//	*ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg
+	//	*ExecuteBatchMsg_Union_CurrencyCreateMsg
+	//	*ExecuteBatchMsg_Union_UsernameRegisterTokenMsg
+	//	*ExecuteBatchMsg_Union_UsernameTransferTokenMsg
+	//	*ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg
 	//	*ExecuteBatchMsg_Union_DistributionCreateMsg
 	//	*ExecuteBatchMsg_Union_DistributionMsg
 	//	*ExecuteBatchMsg_Union_DistributionResetMsg
 	//	*ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg
+	//	*ExecuteBatchMsg_Union_CashMultiSendMsg
+	//	*ExecuteBatchMsg_Union_CashBurnMsg
+	//	*ExecuteBatchMsg_Union_CashMintMsg
+	//	*ExecuteBatchMsg_Union_CashApproveMsg
+	//	*ExecuteBatchMsg_Union_CashTransferFromMsg
+	//	*ExecuteBatchMsg_Union_CashCreateVestingMsg
+	//	*ExecuteBatchMsg_Union_CashReleaseVestingMsg
+	//	*ExecuteBatchMsg_Union_CashFreezeAccountMsg
+	//	*ExecuteBatchMsg_Union_CashUnfreezeAccountMsg
+	//	*ExecuteBatchMsg_Union_CashGrantFeeMsg
+	//	*ExecuteBatchMsg_Union_CashScheduleSendMsg
+	//	*ExecuteBatchMsg_Union_CashCancelScheduledSendMsg
+	//	*ExecuteBatchMsg_Union_CashSetWalletNameMsg
+	//	*ExecuteBatchMsg_Union_EscrowReleaseMilestoneMsg
+	//	*ExecuteBatchMsg_Union_EscrowApproveMsg
+	//	*ExecuteBatchMsg_Union_EscrowUpdateEscrowTimeoutMsg
+	//	*ExecuteBatchMsg_Union_EscrowRaiseDisputeMsg
+	//	*ExecuteBatchMsg_Union_EscrowResolveDisputeMsg
+	//	*ExecuteBatchMsg_Union_EscrowUpdateEscrowPartiesMsg
+	//	*ExecuteBatchMsg_Union_MultisigCreateProposalMsg
+	//	*ExecuteBatchMsg_Union_MultisigApproveProposalMsg
+	//	*ExecuteBatchMsg_Union_MultisigApplyUpdateMsg
+	//	*ExecuteBatchMsg_Union_MultisigVetoUpdateMsg
+	//	*ExecuteBatchMsg_Union_MultisigExpireProposalMsg
+	//	*ExecuteBatchMsg_Union_SigsRotateKeyMsg
+	//	*ExecuteBatchMsg_Union_ValidatorsSetBlsKeyMsg
 	Sum isExecuteBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
@@ -1123,6 +2183,84 @@ type ExecuteBatchMsg_Union_DistributionResetMsg struct {
 type ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg struct {
 	MsgfeeSetMsgFeeMsg *msgfee.SetMsgFeeMsg `protobuf:"bytes,80,opt,name=msgfee_set_msg_fee_msg,json=msgfeeSetMsgFeeMsg,proto3,oneof"`
 }
+type ExecuteBatchMsg_Union_CashMultiSendMsg struct {
+	CashMultiSendMsg *cash.MultiSendMsg `protobuf:"bytes,81,opt,name=cash_multi_send_msg,json=cashMultiSendMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashBurnMsg struct {
+	CashBurnMsg *cash.BurnMsg `protobuf:"bytes,82,opt,name=cash_burn_msg,json=cashBurnMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashMintMsg struct {
+	CashMintMsg *cash.MintMsg `protobuf:"bytes,83,opt,name=cash_mint_msg,json=cashMintMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashApproveMsg struct {
+	CashApproveMsg *cash.ApproveMsg `protobuf:"bytes,84,opt,name=cash_approve_msg,json=cashApproveMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashTransferFromMsg struct {
+	CashTransferFromMsg *cash.TransferFromMsg `protobuf:"bytes,85,opt,name=cash_transfer_from_msg,json=cashTransferFromMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashCreateVestingMsg struct {
+	CashCreateVestingMsg *cash.CreateVestingMsg `protobuf:"bytes,86,opt,name=cash_create_vesting_msg,json=cashCreateVestingMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashReleaseVestingMsg struct {
+	CashReleaseVestingMsg *cash.ReleaseVestingMsg `protobuf:"bytes,87,opt,name=cash_release_vesting_msg,json=cashReleaseVestingMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashFreezeAccountMsg struct {
+	CashFreezeAccountMsg *cash.FreezeAccountMsg `protobuf:"bytes,88,opt,name=cash_freeze_account_msg,json=cashFreezeAccountMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashUnfreezeAccountMsg struct {
+	CashUnfreezeAccountMsg *cash.UnfreezeAccountMsg `protobuf:"bytes,89,opt,name=cash_unfreeze_account_msg,json=cashUnfreezeAccountMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashGrantFeeMsg struct {
+	CashGrantFeeMsg *cash.GrantFeeMsg `protobuf:"bytes,90,opt,name=cash_grant_fee_msg,json=cashGrantFeeMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashScheduleSendMsg struct {
+	CashScheduleSendMsg *cash.ScheduleSendMsg `protobuf:"bytes,91,opt,name=cash_schedule_send_msg,json=cashScheduleSendMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashCancelScheduledSendMsg struct {
+	CashCancelScheduledSendMsg *cash.CancelScheduledSendMsg `protobuf:"bytes,92,opt,name=cash_cancel_scheduled_send_msg,json=cashCancelScheduledSendMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_CashSetWalletNameMsg struct {
+	CashSetWalletNameMsg *cash.SetWalletNameMsg `protobuf:"bytes,94,opt,name=cash_set_wallet_name_msg,json=cashSetWalletNameMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_EscrowReleaseMilestoneMsg struct {
+	EscrowReleaseMilestoneMsg *escrow.ReleaseMilestoneMsg `protobuf:"bytes,95,opt,name=escrow_release_milestone_msg,json=escrowReleaseMilestoneMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_EscrowApproveMsg struct {
+	EscrowApproveMsg *escrow.ApproveMsg `protobuf:"bytes,96,opt,name=escrow_approve_msg,json=escrowApproveMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_EscrowUpdateEscrowTimeoutMsg struct {
+	EscrowUpdateEscrowTimeoutMsg *escrow.UpdateEscrowTimeoutMsg `protobuf:"bytes,97,opt,name=escrow_update_escrow_timeout_msg,json=escrowUpdateEscrowTimeoutMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_EscrowRaiseDisputeMsg struct {
+	EscrowRaiseDisputeMsg *escrow.RaiseDisputeMsg `protobuf:"bytes,98,opt,name=escrow_raise_dispute_msg,json=escrowRaiseDisputeMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_EscrowResolveDisputeMsg struct {
+	EscrowResolveDisputeMsg *escrow.ResolveDisputeMsg `protobuf:"bytes,99,opt,name=escrow_resolve_dispute_msg,json=escrowResolveDisputeMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_EscrowUpdateEscrowPartiesMsg struct {
+	EscrowUpdateEscrowPartiesMsg *escrow.UpdateEscrowPartiesMsg `protobuf:"bytes,100,opt,name=escrow_update_escrow_parties_msg,json=escrowUpdateEscrowPartiesMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_MultisigCreateProposalMsg struct {
+	MultisigCreateProposalMsg *multisig.CreateProposalMsg `protobuf:"bytes,101,opt,name=multisig_create_proposal_msg,json=multisigCreateProposalMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_MultisigApproveProposalMsg struct {
+	MultisigApproveProposalMsg *multisig.ApproveProposalMsg `protobuf:"bytes,102,opt,name=multisig_approve_proposal_msg,json=multisigApproveProposalMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_MultisigApplyUpdateMsg struct {
+	MultisigApplyUpdateMsg *multisig.ApplyUpdateMsg `protobuf:"bytes,103,opt,name=multisig_apply_update_msg,json=multisigApplyUpdateMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_MultisigVetoUpdateMsg struct {
+	MultisigVetoUpdateMsg *multisig.VetoUpdateMsg `protobuf:"bytes,104,opt,name=multisig_veto_update_msg,json=multisigVetoUpdateMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_MultisigExpireProposalMsg struct {
+	MultisigExpireProposalMsg *multisig.ExpireProposalMsg `protobuf:"bytes,105,opt,name=multisig_expire_proposal_msg,json=multisigExpireProposalMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_SigsRotateKeyMsg struct {
+	SigsRotateKeyMsg *sigs.RotateKeyMsg `protobuf:"bytes,106,opt,name=sigs_rotate_key_msg,json=sigsRotateKeyMsg,proto3,oneof"`
+}
+type ExecuteBatchMsg_Union_ValidatorsSetBlsKeyMsg struct {
+	ValidatorsSetBlsKeyMsg *validators.SetBLSKeyMsg `protobuf:"bytes,107,opt,name=validators_set_bls_key_msg,json=validatorsSetBlsKeyMsg,proto3,oneof"`
+}
 
 func (*ExecuteBatchMsg_Union_CashSendMsg) isExecuteBatchMsg_Union_Sum()                   {}
 func (*ExecuteBatchMsg_Union_EscrowCreateMsg) isExecuteBatchMsg_Union_Sum()               {}
@@ -1140,6 +2278,32 @@ func (*ExecuteBatchMsg_Union_DistributionCreateMsg) isExecuteBatchMsg_Union_Sum(
 func (*ExecuteBatchMsg_Union_DistributionMsg) isExecuteBatchMsg_Union_Sum()               {}
 func (*ExecuteBatchMsg_Union_DistributionResetMsg) isExecuteBatchMsg_Union_Sum()          {}
 func (*ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg) isExecuteBatchMsg_Union_Sum()            {}
+func (*ExecuteBatchMsg_Union_CashMultiSendMsg) isExecuteBatchMsg_Union_Sum()              {}
+func (*ExecuteBatchMsg_Union_CashBurnMsg) isExecuteBatchMsg_Union_Sum()                   {}
+func (*ExecuteBatchMsg_Union_CashMintMsg) isExecuteBatchMsg_Union_Sum()                   {}
+func (*ExecuteBatchMsg_Union_CashApproveMsg) isExecuteBatchMsg_Union_Sum()                {}
+func (*ExecuteBatchMsg_Union_CashTransferFromMsg) isExecuteBatchMsg_Union_Sum()           {}
+func (*ExecuteBatchMsg_Union_CashCreateVestingMsg) isExecuteBatchMsg_Union_Sum()          {}
+func (*ExecuteBatchMsg_Union_CashReleaseVestingMsg) isExecuteBatchMsg_Union_Sum()         {}
+func (*ExecuteBatchMsg_Union_CashFreezeAccountMsg) isExecuteBatchMsg_Union_Sum()          {}
+func (*ExecuteBatchMsg_Union_CashUnfreezeAccountMsg) isExecuteBatchMsg_Union_Sum()        {}
+func (*ExecuteBatchMsg_Union_CashGrantFeeMsg) isExecuteBatchMsg_Union_Sum()               {}
+func (*ExecuteBatchMsg_Union_CashScheduleSendMsg) isExecuteBatchMsg_Union_Sum()           {}
+func (*ExecuteBatchMsg_Union_CashCancelScheduledSendMsg) isExecuteBatchMsg_Union_Sum()    {}
+func (*ExecuteBatchMsg_Union_CashSetWalletNameMsg) isExecuteBatchMsg_Union_Sum()          {}
+func (*ExecuteBatchMsg_Union_EscrowReleaseMilestoneMsg) isExecuteBatchMsg_Union_Sum()     {}
+func (*ExecuteBatchMsg_Union_EscrowApproveMsg) isExecuteBatchMsg_Union_Sum()              {}
+func (*ExecuteBatchMsg_Union_EscrowUpdateEscrowTimeoutMsg) isExecuteBatchMsg_Union_Sum()  {}
+func (*ExecuteBatchMsg_Union_EscrowRaiseDisputeMsg) isExecuteBatchMsg_Union_Sum()         {}
+func (*ExecuteBatchMsg_Union_EscrowResolveDisputeMsg) isExecuteBatchMsg_Union_Sum()       {}
+func (*ExecuteBatchMsg_Union_EscrowUpdateEscrowPartiesMsg) isExecuteBatchMsg_Union_Sum()  {}
+func (*ExecuteBatchMsg_Union_MultisigCreateProposalMsg) isExecuteBatchMsg_Union_Sum()     {}
+func (*ExecuteBatchMsg_Union_MultisigApproveProposalMsg) isExecuteBatchMsg_Union_Sum()    {}
+func (*ExecuteBatchMsg_Union_MultisigApplyUpdateMsg) isExecuteBatchMsg_Union_Sum()        {}
+func (*ExecuteBatchMsg_Union_MultisigVetoUpdateMsg) isExecuteBatchMsg_Union_Sum()         {}
+func (*ExecuteBatchMsg_Union_MultisigExpireProposalMsg) isExecuteBatchMsg_Union_Sum()     {}
+func (*ExecuteBatchMsg_Union_SigsRotateKeyMsg) isExecuteBatchMsg_Union_Sum()              {}
+func (*ExecuteBatchMsg_Union_ValidatorsSetBlsKeyMsg) isExecuteBatchMsg_Union_Sum()        {}
 
 func (m *ExecuteBatchMsg_Union) GetSum() isExecuteBatchMsg_Union_Sum {
 	if m != nil {
@@ -1260,69 +2424,277 @@ func (m *ExecuteBatchMsg_Union) GetMsgfeeSetMsgFeeMsg() *msgfee.SetMsgFeeMsg {
 	return nil
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _ExecuteBatchMsg_Union_OneofMarshaler, _ExecuteBatchMsg_Union_OneofUnmarshaler, _ExecuteBatchMsg_Union_OneofSizer, []interface{}{
-		(*ExecuteBatchMsg_Union_CashSendMsg)(nil),
-		(*ExecuteBatchMsg_Union_EscrowCreateMsg)(nil),
-		(*ExecuteBatchMsg_Union_EscrowReleaseMsg)(nil),
-		(*ExecuteBatchMsg_Union_EscrowReturnMsg)(nil),
-		(*ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg)(nil),
-		(*ExecuteBatchMsg_Union_MultisigCreateMsg)(nil),
-		(*ExecuteBatchMsg_Union_MultisigUpdateMsg)(nil),
-		(*ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg)(nil),
-		(*ExecuteBatchMsg_Union_CurrencyCreateMsg)(nil),
-		(*ExecuteBatchMsg_Union_UsernameRegisterTokenMsg)(nil),
-		(*ExecuteBatchMsg_Union_UsernameTransferTokenMsg)(nil),
-		(*ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg)(nil),
-		(*ExecuteBatchMsg_Union_DistributionCreateMsg)(nil),
-		(*ExecuteBatchMsg_Union_DistributionMsg)(nil),
-		(*ExecuteBatchMsg_Union_DistributionResetMsg)(nil),
-		(*ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg)(nil),
+func (m *ExecuteBatchMsg_Union) GetCashMultiSendMsg() *cash.MultiSendMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashMultiSendMsg); ok {
+		return x.CashMultiSendMsg
 	}
+	return nil
 }
 
-func _ExecuteBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*ExecuteBatchMsg_Union)
-	// sum
-	switch x := m.Sum.(type) {
-	case *ExecuteBatchMsg_Union_CashSendMsg:
-		_ = b.EncodeVarint(51<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.CashSendMsg); err != nil {
-			return err
-		}
-	case *ExecuteBatchMsg_Union_EscrowCreateMsg:
-		_ = b.EncodeVarint(52<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.EscrowCreateMsg); err != nil {
-			return err
-		}
-	case *ExecuteBatchMsg_Union_EscrowReleaseMsg:
-		_ = b.EncodeVarint(53<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.EscrowReleaseMsg); err != nil {
-			return err
-		}
-	case *ExecuteBatchMsg_Union_EscrowReturnMsg:
-		_ = b.EncodeVarint(54<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.EscrowReturnMsg); err != nil {
-			return err
-		}
-	case *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg:
-		_ = b.EncodeVarint(55<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.EscrowUpdatePartiesMsg); err != nil {
-			return err
-		}
-	case *ExecuteBatchMsg_Union_MultisigCreateMsg:
-		_ = b.EncodeVarint(56<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.MultisigCreateMsg); err != nil {
-			return err
-		}
-	case *ExecuteBatchMsg_Union_MultisigUpdateMsg:
-		_ = b.EncodeVarint(57<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.MultisigUpdateMsg); err != nil {
-			return err
-		}
-	case *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg:
-		_ = b.EncodeVarint(58<<3 | proto.WireBytes)
+func (m *ExecuteBatchMsg_Union) GetCashBurnMsg() *cash.BurnMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashBurnMsg); ok {
+		return x.CashBurnMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashMintMsg() *cash.MintMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashMintMsg); ok {
+		return x.CashMintMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashApproveMsg() *cash.ApproveMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashApproveMsg); ok {
+		return x.CashApproveMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashTransferFromMsg() *cash.TransferFromMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashTransferFromMsg); ok {
+		return x.CashTransferFromMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashCreateVestingMsg() *cash.CreateVestingMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashCreateVestingMsg); ok {
+		return x.CashCreateVestingMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashReleaseVestingMsg() *cash.ReleaseVestingMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashReleaseVestingMsg); ok {
+		return x.CashReleaseVestingMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashFreezeAccountMsg() *cash.FreezeAccountMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashFreezeAccountMsg); ok {
+		return x.CashFreezeAccountMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashUnfreezeAccountMsg() *cash.UnfreezeAccountMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashUnfreezeAccountMsg); ok {
+		return x.CashUnfreezeAccountMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashGrantFeeMsg() *cash.GrantFeeMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashGrantFeeMsg); ok {
+		return x.CashGrantFeeMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashScheduleSendMsg() *cash.ScheduleSendMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashScheduleSendMsg); ok {
+		return x.CashScheduleSendMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashCancelScheduledSendMsg() *cash.CancelScheduledSendMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashCancelScheduledSendMsg); ok {
+		return x.CashCancelScheduledSendMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetCashSetWalletNameMsg() *cash.SetWalletNameMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_CashSetWalletNameMsg); ok {
+		return x.CashSetWalletNameMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetEscrowReleaseMilestoneMsg() *escrow.ReleaseMilestoneMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_EscrowReleaseMilestoneMsg); ok {
+		return x.EscrowReleaseMilestoneMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetEscrowApproveMsg() *escrow.ApproveMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_EscrowApproveMsg); ok {
+		return x.EscrowApproveMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetEscrowUpdateEscrowTimeoutMsg() *escrow.UpdateEscrowTimeoutMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_EscrowUpdateEscrowTimeoutMsg); ok {
+		return x.EscrowUpdateEscrowTimeoutMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetEscrowRaiseDisputeMsg() *escrow.RaiseDisputeMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_EscrowRaiseDisputeMsg); ok {
+		return x.EscrowRaiseDisputeMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetEscrowResolveDisputeMsg() *escrow.ResolveDisputeMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_EscrowResolveDisputeMsg); ok {
+		return x.EscrowResolveDisputeMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetEscrowUpdateEscrowPartiesMsg() *escrow.UpdateEscrowPartiesMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_EscrowUpdateEscrowPartiesMsg); ok {
+		return x.EscrowUpdateEscrowPartiesMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetMultisigCreateProposalMsg() *multisig.CreateProposalMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_MultisigCreateProposalMsg); ok {
+		return x.MultisigCreateProposalMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetMultisigApproveProposalMsg() *multisig.ApproveProposalMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_MultisigApproveProposalMsg); ok {
+		return x.MultisigApproveProposalMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetMultisigApplyUpdateMsg() *multisig.ApplyUpdateMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_MultisigApplyUpdateMsg); ok {
+		return x.MultisigApplyUpdateMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetMultisigVetoUpdateMsg() *multisig.VetoUpdateMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_MultisigVetoUpdateMsg); ok {
+		return x.MultisigVetoUpdateMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetMultisigExpireProposalMsg() *multisig.ExpireProposalMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_MultisigExpireProposalMsg); ok {
+		return x.MultisigExpireProposalMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetSigsRotateKeyMsg() *sigs.RotateKeyMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_SigsRotateKeyMsg); ok {
+		return x.SigsRotateKeyMsg
+	}
+	return nil
+}
+
+func (m *ExecuteBatchMsg_Union) GetValidatorsSetBlsKeyMsg() *validators.SetBLSKeyMsg {
+	if x, ok := m.GetSum().(*ExecuteBatchMsg_Union_ValidatorsSetBlsKeyMsg); ok {
+		return x.ValidatorsSetBlsKeyMsg
+	}
+	return nil
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ExecuteBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ExecuteBatchMsg_Union_OneofMarshaler, _ExecuteBatchMsg_Union_OneofUnmarshaler, _ExecuteBatchMsg_Union_OneofSizer, []interface{}{
+		(*ExecuteBatchMsg_Union_CashSendMsg)(nil),
+		(*ExecuteBatchMsg_Union_EscrowCreateMsg)(nil),
+		(*ExecuteBatchMsg_Union_EscrowReleaseMsg)(nil),
+		(*ExecuteBatchMsg_Union_EscrowReturnMsg)(nil),
+		(*ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg)(nil),
+		(*ExecuteBatchMsg_Union_MultisigCreateMsg)(nil),
+		(*ExecuteBatchMsg_Union_MultisigUpdateMsg)(nil),
+		(*ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg)(nil),
+		(*ExecuteBatchMsg_Union_CurrencyCreateMsg)(nil),
+		(*ExecuteBatchMsg_Union_UsernameRegisterTokenMsg)(nil),
+		(*ExecuteBatchMsg_Union_UsernameTransferTokenMsg)(nil),
+		(*ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg)(nil),
+		(*ExecuteBatchMsg_Union_DistributionCreateMsg)(nil),
+		(*ExecuteBatchMsg_Union_DistributionMsg)(nil),
+		(*ExecuteBatchMsg_Union_DistributionResetMsg)(nil),
+		(*ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashMultiSendMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashBurnMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashMintMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashApproveMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashTransferFromMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashCreateVestingMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashReleaseVestingMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashFreezeAccountMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashUnfreezeAccountMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashGrantFeeMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashScheduleSendMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashCancelScheduledSendMsg)(nil),
+		(*ExecuteBatchMsg_Union_CashSetWalletNameMsg)(nil),
+		(*ExecuteBatchMsg_Union_EscrowReleaseMilestoneMsg)(nil),
+		(*ExecuteBatchMsg_Union_EscrowApproveMsg)(nil),
+		(*ExecuteBatchMsg_Union_EscrowUpdateEscrowTimeoutMsg)(nil),
+		(*ExecuteBatchMsg_Union_EscrowRaiseDisputeMsg)(nil),
+		(*ExecuteBatchMsg_Union_EscrowResolveDisputeMsg)(nil),
+		(*ExecuteBatchMsg_Union_EscrowUpdateEscrowPartiesMsg)(nil),
+		(*ExecuteBatchMsg_Union_MultisigCreateProposalMsg)(nil),
+		(*ExecuteBatchMsg_Union_MultisigApproveProposalMsg)(nil),
+		(*ExecuteBatchMsg_Union_MultisigApplyUpdateMsg)(nil),
+		(*ExecuteBatchMsg_Union_MultisigVetoUpdateMsg)(nil),
+		(*ExecuteBatchMsg_Union_MultisigExpireProposalMsg)(nil),
+		(*ExecuteBatchMsg_Union_SigsRotateKeyMsg)(nil),
+		(*ExecuteBatchMsg_Union_ValidatorsSetBlsKeyMsg)(nil),
+	}
+}
+
+func _ExecuteBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ExecuteBatchMsg_Union)
+	// sum
+	switch x := m.Sum.(type) {
+	case *ExecuteBatchMsg_Union_CashSendMsg:
+		_ = b.EncodeVarint(51<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashSendMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_EscrowCreateMsg:
+		_ = b.EncodeVarint(52<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowCreateMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_EscrowReleaseMsg:
+		_ = b.EncodeVarint(53<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowReleaseMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_EscrowReturnMsg:
+		_ = b.EncodeVarint(54<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowReturnMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg:
+		_ = b.EncodeVarint(55<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowUpdatePartiesMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_MultisigCreateMsg:
+		_ = b.EncodeVarint(56<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigCreateMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_MultisigUpdateMsg:
+		_ = b.EncodeVarint(57<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigUpdateMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg:
+		_ = b.EncodeVarint(58<<3 | proto.WireBytes)
 		if err := b.EncodeMessage(x.ValidatorsApplyDiffMsg); err != nil {
 			return err
 		}
@@ -1366,6 +2738,136 @@ func _ExecuteBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.Buffer) e
 		if err := b.EncodeMessage(x.MsgfeeSetMsgFeeMsg); err != nil {
 			return err
 		}
+	case *ExecuteBatchMsg_Union_CashMultiSendMsg:
+		_ = b.EncodeVarint(81<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMultiSendMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashBurnMsg:
+		_ = b.EncodeVarint(82<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashBurnMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashMintMsg:
+		_ = b.EncodeVarint(83<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMintMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashApproveMsg:
+		_ = b.EncodeVarint(84<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashApproveMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashTransferFromMsg:
+		_ = b.EncodeVarint(85<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashTransferFromMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashCreateVestingMsg:
+		_ = b.EncodeVarint(86<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashCreateVestingMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashReleaseVestingMsg:
+		_ = b.EncodeVarint(87<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashReleaseVestingMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashFreezeAccountMsg:
+		_ = b.EncodeVarint(88<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashFreezeAccountMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashUnfreezeAccountMsg:
+		_ = b.EncodeVarint(89<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashUnfreezeAccountMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashGrantFeeMsg:
+		_ = b.EncodeVarint(90<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashGrantFeeMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashScheduleSendMsg:
+		_ = b.EncodeVarint(91<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashScheduleSendMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashCancelScheduledSendMsg:
+		_ = b.EncodeVarint(92<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashCancelScheduledSendMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_CashSetWalletNameMsg:
+		_ = b.EncodeVarint(94<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashSetWalletNameMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_EscrowReleaseMilestoneMsg:
+		_ = b.EncodeVarint(95<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowReleaseMilestoneMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_EscrowApproveMsg:
+		_ = b.EncodeVarint(96<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowApproveMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_EscrowUpdateEscrowTimeoutMsg:
+		_ = b.EncodeVarint(97<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowUpdateEscrowTimeoutMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_EscrowRaiseDisputeMsg:
+		_ = b.EncodeVarint(98<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowRaiseDisputeMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_EscrowResolveDisputeMsg:
+		_ = b.EncodeVarint(99<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowResolveDisputeMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_EscrowUpdateEscrowPartiesMsg:
+		_ = b.EncodeVarint(100<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowUpdateEscrowPartiesMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_MultisigCreateProposalMsg:
+		_ = b.EncodeVarint(101<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigCreateProposalMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_MultisigApproveProposalMsg:
+		_ = b.EncodeVarint(102<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigApproveProposalMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_MultisigApplyUpdateMsg:
+		_ = b.EncodeVarint(103<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigApplyUpdateMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_MultisigVetoUpdateMsg:
+		_ = b.EncodeVarint(104<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigVetoUpdateMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_MultisigExpireProposalMsg:
+		_ = b.EncodeVarint(105<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigExpireProposalMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_SigsRotateKeyMsg:
+		_ = b.EncodeVarint(106<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.SigsRotateKeyMsg); err != nil {
+			return err
+		}
+	case *ExecuteBatchMsg_Union_ValidatorsSetBlsKeyMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ValidatorsSetBlsKeyMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ExecuteBatchMsg_Union.Sum has unexpected type %T", x)
@@ -1504,47 +3006,255 @@ func _ExecuteBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wire int, b
 		err := b.DecodeMessage(msg)
 		m.Sum = &ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg{msg}
 		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*ExecuteBatchMsg_Union)
-	// sum
-	switch x := m.Sum.(type) {
-	case *ExecuteBatchMsg_Union_CashSendMsg:
-		s := proto.Size(x.CashSendMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ExecuteBatchMsg_Union_EscrowCreateMsg:
-		s := proto.Size(x.EscrowCreateMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ExecuteBatchMsg_Union_EscrowReleaseMsg:
-		s := proto.Size(x.EscrowReleaseMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ExecuteBatchMsg_Union_EscrowReturnMsg:
-		s := proto.Size(x.EscrowReturnMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg:
-		s := proto.Size(x.EscrowUpdatePartiesMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ExecuteBatchMsg_Union_MultisigCreateMsg:
-		s := proto.Size(x.MultisigCreateMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ExecuteBatchMsg_Union_MultisigUpdateMsg:
-		s := proto.Size(x.MultisigUpdateMsg)
+	case 81: // sum.cash_multi_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MultiSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashMultiSendMsg{msg}
+		return true, err
+	case 82: // sum.cash_burn_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.BurnMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashBurnMsg{msg}
+		return true, err
+	case 83: // sum.cash_mint_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MintMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashMintMsg{msg}
+		return true, err
+	case 84: // sum.cash_approve_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.ApproveMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashApproveMsg{msg}
+		return true, err
+	case 85: // sum.cash_transfer_from_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.TransferFromMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashTransferFromMsg{msg}
+		return true, err
+	case 86: // sum.cash_create_vesting_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.CreateVestingMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashCreateVestingMsg{msg}
+		return true, err
+	case 87: // sum.cash_release_vesting_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.ReleaseVestingMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashReleaseVestingMsg{msg}
+		return true, err
+	case 88: // sum.cash_freeze_account_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.FreezeAccountMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashFreezeAccountMsg{msg}
+		return true, err
+	case 89: // sum.cash_unfreeze_account_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.UnfreezeAccountMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashUnfreezeAccountMsg{msg}
+		return true, err
+	case 90: // sum.cash_grant_fee_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.GrantFeeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashGrantFeeMsg{msg}
+		return true, err
+	case 91: // sum.cash_schedule_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.ScheduleSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashScheduleSendMsg{msg}
+		return true, err
+	case 92: // sum.cash_cancel_scheduled_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.CancelScheduledSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashCancelScheduledSendMsg{msg}
+		return true, err
+	case 94: // sum.cash_set_wallet_name_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.SetWalletNameMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_CashSetWalletNameMsg{msg}
+		return true, err
+	case 95: // sum.escrow_release_milestone_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.ReleaseMilestoneMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_EscrowReleaseMilestoneMsg{msg}
+		return true, err
+	case 96: // sum.escrow_approve_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.ApproveMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_EscrowApproveMsg{msg}
+		return true, err
+	case 97: // sum.escrow_update_escrow_timeout_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.UpdateEscrowTimeoutMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_EscrowUpdateEscrowTimeoutMsg{msg}
+		return true, err
+	case 98: // sum.escrow_raise_dispute_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.RaiseDisputeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_EscrowRaiseDisputeMsg{msg}
+		return true, err
+	case 99: // sum.escrow_resolve_dispute_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.ResolveDisputeMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_EscrowResolveDisputeMsg{msg}
+		return true, err
+	case 100: // sum.escrow_update_escrow_parties_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.UpdateEscrowPartiesMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_EscrowUpdateEscrowPartiesMsg{msg}
+		return true, err
+	case 101: // sum.multisig_create_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.CreateProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_MultisigCreateProposalMsg{msg}
+		return true, err
+	case 102: // sum.multisig_approve_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.ApproveProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_MultisigApproveProposalMsg{msg}
+		return true, err
+	case 103: // sum.multisig_apply_update_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.ApplyUpdateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_MultisigApplyUpdateMsg{msg}
+		return true, err
+	case 104: // sum.multisig_veto_update_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.VetoUpdateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_MultisigVetoUpdateMsg{msg}
+		return true, err
+	case 105: // sum.multisig_expire_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.ExpireProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_MultisigExpireProposalMsg{msg}
+		return true, err
+	case 106: // sum.sigs_rotate_key_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(sigs.RotateKeyMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_SigsRotateKeyMsg{msg}
+		return true, err
+	case 107: // sum.validators_set_bls_key_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(validators.SetBLSKeyMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteBatchMsg_Union_ValidatorsSetBlsKeyMsg{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ExecuteBatchMsg_Union)
+	// sum
+	switch x := m.Sum.(type) {
+	case *ExecuteBatchMsg_Union_CashSendMsg:
+		s := proto.Size(x.CashSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_EscrowCreateMsg:
+		s := proto.Size(x.EscrowCreateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_EscrowReleaseMsg:
+		s := proto.Size(x.EscrowReleaseMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_EscrowReturnMsg:
+		s := proto.Size(x.EscrowReturnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg:
+		s := proto.Size(x.EscrowUpdatePartiesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_MultisigCreateMsg:
+		s := proto.Size(x.MultisigCreateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_MultisigUpdateMsg:
+		s := proto.Size(x.MultisigUpdateMsg)
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
@@ -1593,6 +3303,136 @@ func _ExecuteBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ExecuteBatchMsg_Union_CashMultiSendMsg:
+		s := proto.Size(x.CashMultiSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashBurnMsg:
+		s := proto.Size(x.CashBurnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashMintMsg:
+		s := proto.Size(x.CashMintMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashApproveMsg:
+		s := proto.Size(x.CashApproveMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashTransferFromMsg:
+		s := proto.Size(x.CashTransferFromMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashCreateVestingMsg:
+		s := proto.Size(x.CashCreateVestingMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashReleaseVestingMsg:
+		s := proto.Size(x.CashReleaseVestingMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashFreezeAccountMsg:
+		s := proto.Size(x.CashFreezeAccountMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashUnfreezeAccountMsg:
+		s := proto.Size(x.CashUnfreezeAccountMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashGrantFeeMsg:
+		s := proto.Size(x.CashGrantFeeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashScheduleSendMsg:
+		s := proto.Size(x.CashScheduleSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashCancelScheduledSendMsg:
+		s := proto.Size(x.CashCancelScheduledSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_CashSetWalletNameMsg:
+		s := proto.Size(x.CashSetWalletNameMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_EscrowReleaseMilestoneMsg:
+		s := proto.Size(x.EscrowReleaseMilestoneMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_EscrowApproveMsg:
+		s := proto.Size(x.EscrowApproveMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_EscrowUpdateEscrowTimeoutMsg:
+		s := proto.Size(x.EscrowUpdateEscrowTimeoutMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_EscrowRaiseDisputeMsg:
+		s := proto.Size(x.EscrowRaiseDisputeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_EscrowResolveDisputeMsg:
+		s := proto.Size(x.EscrowResolveDisputeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_EscrowUpdateEscrowPartiesMsg:
+		s := proto.Size(x.EscrowUpdateEscrowPartiesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_MultisigCreateProposalMsg:
+		s := proto.Size(x.MultisigCreateProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_MultisigApproveProposalMsg:
+		s := proto.Size(x.MultisigApproveProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_MultisigApplyUpdateMsg:
+		s := proto.Size(x.MultisigApplyUpdateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_MultisigVetoUpdateMsg:
+		s := proto.Size(x.MultisigVetoUpdateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_MultisigExpireProposalMsg:
+		s := proto.Size(x.MultisigExpireProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_SigsRotateKeyMsg:
+		s := proto.Size(x.SigsRotateKeyMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ExecuteBatchMsg_Union_ValidatorsSetBlsKeyMsg:
+		s := proto.Size(x.ValidatorsSetBlsKeyMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -1622,6 +3462,33 @@ type ProposalOptions struct {
 	//	*ProposalOptions_GovUpdateElectionRuleMsg
 	//	*ProposalOptions_GovCreateTextResolutionMsg
 	//	*ProposalOptions_MsgfeeSetMsgFeeMsg
+	//	*ProposalOptions_CashMultiSendMsg
+	//	*ProposalOptions_CashBurnMsg
+	//	*ProposalOptions_CashMintMsg
+	//	*ProposalOptions_CashApproveMsg
+	//	*ProposalOptions_CashTransferFromMsg
+	//	*ProposalOptions_CashCreateVestingMsg
+	//	*ProposalOptions_CashReleaseVestingMsg
+	//	*ProposalOptions_CashFreezeAccountMsg
+	//	*ProposalOptions_CashUnfreezeAccountMsg
+	//	*ProposalOptions_CashGrantFeeMsg
+	//	*ProposalOptions_CashScheduleSendMsg
+	//	*ProposalOptions_CashCancelScheduledSendMsg
+	//	*ProposalOptions_CashSetWalletNameMsg
+	//	*ProposalOptions_EscrowReleaseMilestoneMsg
+	//	*ProposalOptions_EscrowApproveMsg
+	//	*ProposalOptions_EscrowUpdateEscrowTimeoutMsg
+	//	*ProposalOptions_EscrowRaiseDisputeMsg
+	//	*ProposalOptions_EscrowResolveDisputeMsg
+	//	*ProposalOptions_EscrowUpdateEscrowPartiesMsg
+	//	*ProposalOptions_ValidatorsSetBlsKeyMsg
+	//	*ProposalOptions_GovChangeParametersMsg
+	//	*ProposalOptions_GovTextProposalMsg
+	//	*ProposalOptions_MigrationMigrateBucketMsg
+	//	*ProposalOptions_MigrationDowngradeSchemaMsg
+	//	*ProposalOptions_MsgfeeUpdateConfigurationMsg
+	//	*ProposalOptions_FeatureScheduleFeatureMsg
+	//	*ProposalOptions_FeatureUpdateConfigurationMsg
 	Option isProposalOptions_Option `protobuf_oneof:"option"`
 }
 
@@ -1718,13 +3585,94 @@ type ProposalOptions_GovCreateTextResolutionMsg struct {
 type ProposalOptions_MsgfeeSetMsgFeeMsg struct {
 	MsgfeeSetMsgFeeMsg *msgfee.SetMsgFeeMsg `protobuf:"bytes,80,opt,name=msgfee_set_msg_fee_msg,json=msgfeeSetMsgFeeMsg,proto3,oneof"`
 }
-
-func (*ProposalOptions_CashSendMsg) isProposalOptions_Option()                   {}
-func (*ProposalOptions_EscrowReleaseMsg) isProposalOptions_Option()              {}
-func (*ProposalOptions_UpdateEscrowPartiesMsg) isProposalOptions_Option()        {}
-func (*ProposalOptions_MultisigUpdateMsg) isProposalOptions_Option()             {}
-func (*ProposalOptions_ValidatorsApplyDiffMsg) isProposalOptions_Option()        {}
-func (*ProposalOptions_CurrencyCreateMsg) isProposalOptions_Option()             {}
+type ProposalOptions_CashMultiSendMsg struct {
+	CashMultiSendMsg *cash.MultiSendMsg `protobuf:"bytes,81,opt,name=cash_multi_send_msg,json=cashMultiSendMsg,proto3,oneof"`
+}
+type ProposalOptions_CashBurnMsg struct {
+	CashBurnMsg *cash.BurnMsg `protobuf:"bytes,82,opt,name=cash_burn_msg,json=cashBurnMsg,proto3,oneof"`
+}
+type ProposalOptions_CashMintMsg struct {
+	CashMintMsg *cash.MintMsg `protobuf:"bytes,83,opt,name=cash_mint_msg,json=cashMintMsg,proto3,oneof"`
+}
+type ProposalOptions_CashApproveMsg struct {
+	CashApproveMsg *cash.ApproveMsg `protobuf:"bytes,84,opt,name=cash_approve_msg,json=cashApproveMsg,proto3,oneof"`
+}
+type ProposalOptions_CashTransferFromMsg struct {
+	CashTransferFromMsg *cash.TransferFromMsg `protobuf:"bytes,85,opt,name=cash_transfer_from_msg,json=cashTransferFromMsg,proto3,oneof"`
+}
+type ProposalOptions_CashCreateVestingMsg struct {
+	CashCreateVestingMsg *cash.CreateVestingMsg `protobuf:"bytes,86,opt,name=cash_create_vesting_msg,json=cashCreateVestingMsg,proto3,oneof"`
+}
+type ProposalOptions_CashReleaseVestingMsg struct {
+	CashReleaseVestingMsg *cash.ReleaseVestingMsg `protobuf:"bytes,87,opt,name=cash_release_vesting_msg,json=cashReleaseVestingMsg,proto3,oneof"`
+}
+type ProposalOptions_CashFreezeAccountMsg struct {
+	CashFreezeAccountMsg *cash.FreezeAccountMsg `protobuf:"bytes,88,opt,name=cash_freeze_account_msg,json=cashFreezeAccountMsg,proto3,oneof"`
+}
+type ProposalOptions_CashUnfreezeAccountMsg struct {
+	CashUnfreezeAccountMsg *cash.UnfreezeAccountMsg `protobuf:"bytes,89,opt,name=cash_unfreeze_account_msg,json=cashUnfreezeAccountMsg,proto3,oneof"`
+}
+type ProposalOptions_CashGrantFeeMsg struct {
+	CashGrantFeeMsg *cash.GrantFeeMsg `protobuf:"bytes,90,opt,name=cash_grant_fee_msg,json=cashGrantFeeMsg,proto3,oneof"`
+}
+type ProposalOptions_CashScheduleSendMsg struct {
+	CashScheduleSendMsg *cash.ScheduleSendMsg `protobuf:"bytes,91,opt,name=cash_schedule_send_msg,json=cashScheduleSendMsg,proto3,oneof"`
+}
+type ProposalOptions_CashCancelScheduledSendMsg struct {
+	CashCancelScheduledSendMsg *cash.CancelScheduledSendMsg `protobuf:"bytes,92,opt,name=cash_cancel_scheduled_send_msg,json=cashCancelScheduledSendMsg,proto3,oneof"`
+}
+type ProposalOptions_CashSetWalletNameMsg struct {
+	CashSetWalletNameMsg *cash.SetWalletNameMsg `protobuf:"bytes,94,opt,name=cash_set_wallet_name_msg,json=cashSetWalletNameMsg,proto3,oneof"`
+}
+type ProposalOptions_EscrowReleaseMilestoneMsg struct {
+	EscrowReleaseMilestoneMsg *escrow.ReleaseMilestoneMsg `protobuf:"bytes,95,opt,name=escrow_release_milestone_msg,json=escrowReleaseMilestoneMsg,proto3,oneof"`
+}
+type ProposalOptions_EscrowApproveMsg struct {
+	EscrowApproveMsg *escrow.ApproveMsg `protobuf:"bytes,96,opt,name=escrow_approve_msg,json=escrowApproveMsg,proto3,oneof"`
+}
+type ProposalOptions_EscrowUpdateEscrowTimeoutMsg struct {
+	EscrowUpdateEscrowTimeoutMsg *escrow.UpdateEscrowTimeoutMsg `protobuf:"bytes,97,opt,name=escrow_update_escrow_timeout_msg,json=escrowUpdateEscrowTimeoutMsg,proto3,oneof"`
+}
+type ProposalOptions_EscrowRaiseDisputeMsg struct {
+	EscrowRaiseDisputeMsg *escrow.RaiseDisputeMsg `protobuf:"bytes,98,opt,name=escrow_raise_dispute_msg,json=escrowRaiseDisputeMsg,proto3,oneof"`
+}
+type ProposalOptions_EscrowResolveDisputeMsg struct {
+	EscrowResolveDisputeMsg *escrow.ResolveDisputeMsg `protobuf:"bytes,99,opt,name=escrow_resolve_dispute_msg,json=escrowResolveDisputeMsg,proto3,oneof"`
+}
+type ProposalOptions_EscrowUpdateEscrowPartiesMsg struct {
+	EscrowUpdateEscrowPartiesMsg *escrow.UpdateEscrowPartiesMsg `protobuf:"bytes,100,opt,name=escrow_update_escrow_parties_msg,json=escrowUpdateEscrowPartiesMsg,proto3,oneof"`
+}
+type ProposalOptions_ValidatorsSetBlsKeyMsg struct {
+	ValidatorsSetBlsKeyMsg *validators.SetBLSKeyMsg `protobuf:"bytes,107,opt,name=validators_set_bls_key_msg,json=validatorsSetBlsKeyMsg,proto3,oneof"`
+}
+type ProposalOptions_GovChangeParametersMsg struct {
+	GovChangeParametersMsg *gov.ChangeParametersMsg `protobuf:"bytes,108,opt,name=gov_change_parameters_msg,json=govChangeParametersMsg,proto3,oneof"`
+}
+type ProposalOptions_GovTextProposalMsg struct {
+	GovTextProposalMsg *gov.TextProposalMsg `protobuf:"bytes,111,opt,name=gov_text_proposal_msg,json=govTextProposalMsg,proto3,oneof"`
+}
+type ProposalOptions_MigrationMigrateBucketMsg struct {
+	MigrationMigrateBucketMsg *migration.MigrateBucketMsg `protobuf:"bytes,112,opt,name=migration_migrate_bucket_msg,json=migrationMigrateBucketMsg,proto3,oneof"`
+}
+type ProposalOptions_MigrationDowngradeSchemaMsg struct {
+	MigrationDowngradeSchemaMsg *migration.DowngradeSchemaMsg `protobuf:"bytes,113,opt,name=migration_downgrade_schema_msg,json=migrationDowngradeSchemaMsg,proto3,oneof"`
+}
+type ProposalOptions_MsgfeeUpdateConfigurationMsg struct {
+	MsgfeeUpdateConfigurationMsg *msgfee.UpdateConfigurationMsg `protobuf:"bytes,114,opt,name=msgfee_update_configuration_msg,json=msgfeeUpdateConfigurationMsg,proto3,oneof"`
+}
+type ProposalOptions_FeatureScheduleFeatureMsg struct {
+	FeatureScheduleFeatureMsg *feature.ScheduleFeatureMsg `protobuf:"bytes,115,opt,name=feature_schedule_feature_msg,json=featureScheduleFeatureMsg,proto3,oneof"`
+}
+type ProposalOptions_FeatureUpdateConfigurationMsg struct {
+	FeatureUpdateConfigurationMsg *feature.UpdateConfigurationMsg `protobuf:"bytes,116,opt,name=feature_update_configuration_msg,json=featureUpdateConfigurationMsg,proto3,oneof"`
+}
+
+func (*ProposalOptions_CashSendMsg) isProposalOptions_Option()                   {}
+func (*ProposalOptions_EscrowReleaseMsg) isProposalOptions_Option()              {}
+func (*ProposalOptions_UpdateEscrowPartiesMsg) isProposalOptions_Option()        {}
+func (*ProposalOptions_MultisigUpdateMsg) isProposalOptions_Option()             {}
+func (*ProposalOptions_ValidatorsApplyDiffMsg) isProposalOptions_Option()        {}
+func (*ProposalOptions_CurrencyCreateMsg) isProposalOptions_Option()             {}
 func (*ProposalOptions_ExecuteProposalBatchMsg) isProposalOptions_Option()       {}
 func (*ProposalOptions_UsernameRegisterTokenMsg) isProposalOptions_Option()      {}
 func (*ProposalOptions_UsernameTransferTokenMsg) isProposalOptions_Option()      {}
@@ -1737,6 +3685,33 @@ func (*ProposalOptions_GovUpdateElectorateMsg) isProposalOptions_Option()
 func (*ProposalOptions_GovUpdateElectionRuleMsg) isProposalOptions_Option()      {}
 func (*ProposalOptions_GovCreateTextResolutionMsg) isProposalOptions_Option()    {}
 func (*ProposalOptions_MsgfeeSetMsgFeeMsg) isProposalOptions_Option()            {}
+func (*ProposalOptions_CashMultiSendMsg) isProposalOptions_Option()              {}
+func (*ProposalOptions_CashBurnMsg) isProposalOptions_Option()                   {}
+func (*ProposalOptions_CashMintMsg) isProposalOptions_Option()                   {}
+func (*ProposalOptions_CashApproveMsg) isProposalOptions_Option()                {}
+func (*ProposalOptions_CashTransferFromMsg) isProposalOptions_Option()           {}
+func (*ProposalOptions_CashCreateVestingMsg) isProposalOptions_Option()          {}
+func (*ProposalOptions_CashReleaseVestingMsg) isProposalOptions_Option()         {}
+func (*ProposalOptions_CashFreezeAccountMsg) isProposalOptions_Option()          {}
+func (*ProposalOptions_CashUnfreezeAccountMsg) isProposalOptions_Option()        {}
+func (*ProposalOptions_CashGrantFeeMsg) isProposalOptions_Option()               {}
+func (*ProposalOptions_CashScheduleSendMsg) isProposalOptions_Option()           {}
+func (*ProposalOptions_CashCancelScheduledSendMsg) isProposalOptions_Option()    {}
+func (*ProposalOptions_CashSetWalletNameMsg) isProposalOptions_Option()          {}
+func (*ProposalOptions_EscrowReleaseMilestoneMsg) isProposalOptions_Option()     {}
+func (*ProposalOptions_EscrowApproveMsg) isProposalOptions_Option()              {}
+func (*ProposalOptions_EscrowUpdateEscrowTimeoutMsg) isProposalOptions_Option()  {}
+func (*ProposalOptions_EscrowRaiseDisputeMsg) isProposalOptions_Option()         {}
+func (*ProposalOptions_EscrowResolveDisputeMsg) isProposalOptions_Option()       {}
+func (*ProposalOptions_EscrowUpdateEscrowPartiesMsg) isProposalOptions_Option()  {}
+func (*ProposalOptions_ValidatorsSetBlsKeyMsg) isProposalOptions_Option()        {}
+func (*ProposalOptions_GovChangeParametersMsg) isProposalOptions_Option()        {}
+func (*ProposalOptions_GovTextProposalMsg) isProposalOptions_Option()            {}
+func (*ProposalOptions_MigrationMigrateBucketMsg) isProposalOptions_Option()     {}
+func (*ProposalOptions_MigrationDowngradeSchemaMsg) isProposalOptions_Option()   {}
+func (*ProposalOptions_MsgfeeUpdateConfigurationMsg) isProposalOptions_Option()  {}
+func (*ProposalOptions_FeatureScheduleFeatureMsg) isProposalOptions_Option()     {}
+func (*ProposalOptions_FeatureUpdateConfigurationMsg) isProposalOptions_Option() {}
 
 func (m *ProposalOptions) GetOption() isProposalOptions_Option {
 	if m != nil {
@@ -1871,6 +3846,195 @@ func (m *ProposalOptions) GetMsgfeeSetMsgFeeMsg() *msgfee.SetMsgFeeMsg {
 	return nil
 }
 
+func (m *ProposalOptions) GetCashMultiSendMsg() *cash.MultiSendMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashMultiSendMsg); ok {
+		return x.CashMultiSendMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashBurnMsg() *cash.BurnMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashBurnMsg); ok {
+		return x.CashBurnMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashMintMsg() *cash.MintMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashMintMsg); ok {
+		return x.CashMintMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashApproveMsg() *cash.ApproveMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashApproveMsg); ok {
+		return x.CashApproveMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashTransferFromMsg() *cash.TransferFromMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashTransferFromMsg); ok {
+		return x.CashTransferFromMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashCreateVestingMsg() *cash.CreateVestingMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashCreateVestingMsg); ok {
+		return x.CashCreateVestingMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashReleaseVestingMsg() *cash.ReleaseVestingMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashReleaseVestingMsg); ok {
+		return x.CashReleaseVestingMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashFreezeAccountMsg() *cash.FreezeAccountMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashFreezeAccountMsg); ok {
+		return x.CashFreezeAccountMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashUnfreezeAccountMsg() *cash.UnfreezeAccountMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashUnfreezeAccountMsg); ok {
+		return x.CashUnfreezeAccountMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashGrantFeeMsg() *cash.GrantFeeMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashGrantFeeMsg); ok {
+		return x.CashGrantFeeMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashScheduleSendMsg() *cash.ScheduleSendMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashScheduleSendMsg); ok {
+		return x.CashScheduleSendMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashCancelScheduledSendMsg() *cash.CancelScheduledSendMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashCancelScheduledSendMsg); ok {
+		return x.CashCancelScheduledSendMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetCashSetWalletNameMsg() *cash.SetWalletNameMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_CashSetWalletNameMsg); ok {
+		return x.CashSetWalletNameMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetEscrowReleaseMilestoneMsg() *escrow.ReleaseMilestoneMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_EscrowReleaseMilestoneMsg); ok {
+		return x.EscrowReleaseMilestoneMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetEscrowApproveMsg() *escrow.ApproveMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_EscrowApproveMsg); ok {
+		return x.EscrowApproveMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetEscrowUpdateEscrowTimeoutMsg() *escrow.UpdateEscrowTimeoutMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_EscrowUpdateEscrowTimeoutMsg); ok {
+		return x.EscrowUpdateEscrowTimeoutMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetEscrowRaiseDisputeMsg() *escrow.RaiseDisputeMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_EscrowRaiseDisputeMsg); ok {
+		return x.EscrowRaiseDisputeMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetEscrowResolveDisputeMsg() *escrow.ResolveDisputeMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_EscrowResolveDisputeMsg); ok {
+		return x.EscrowResolveDisputeMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetEscrowUpdateEscrowPartiesMsg() *escrow.UpdateEscrowPartiesMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_EscrowUpdateEscrowPartiesMsg); ok {
+		return x.EscrowUpdateEscrowPartiesMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetValidatorsSetBlsKeyMsg() *validators.SetBLSKeyMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_ValidatorsSetBlsKeyMsg); ok {
+		return x.ValidatorsSetBlsKeyMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetGovChangeParametersMsg() *gov.ChangeParametersMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_GovChangeParametersMsg); ok {
+		return x.GovChangeParametersMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetGovTextProposalMsg() *gov.TextProposalMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_GovTextProposalMsg); ok {
+		return x.GovTextProposalMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetMigrationMigrateBucketMsg() *migration.MigrateBucketMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_MigrationMigrateBucketMsg); ok {
+		return x.MigrationMigrateBucketMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetMigrationDowngradeSchemaMsg() *migration.DowngradeSchemaMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_MigrationDowngradeSchemaMsg); ok {
+		return x.MigrationDowngradeSchemaMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetMsgfeeUpdateConfigurationMsg() *msgfee.UpdateConfigurationMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_MsgfeeUpdateConfigurationMsg); ok {
+		return x.MsgfeeUpdateConfigurationMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetFeatureScheduleFeatureMsg() *feature.ScheduleFeatureMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_FeatureScheduleFeatureMsg); ok {
+		return x.FeatureScheduleFeatureMsg
+	}
+	return nil
+}
+
+func (m *ProposalOptions) GetFeatureUpdateConfigurationMsg() *feature.UpdateConfigurationMsg {
+	if x, ok := m.GetOption().(*ProposalOptions_FeatureUpdateConfigurationMsg); ok {
+		return x.FeatureUpdateConfigurationMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ProposalOptions_OneofMarshaler, _ProposalOptions_OneofUnmarshaler, _ProposalOptions_OneofSizer, []interface{}{
@@ -1892,6 +4056,33 @@ func (*ProposalOptions) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffe
 		(*ProposalOptions_GovUpdateElectionRuleMsg)(nil),
 		(*ProposalOptions_GovCreateTextResolutionMsg)(nil),
 		(*ProposalOptions_MsgfeeSetMsgFeeMsg)(nil),
+		(*ProposalOptions_CashMultiSendMsg)(nil),
+		(*ProposalOptions_CashBurnMsg)(nil),
+		(*ProposalOptions_CashMintMsg)(nil),
+		(*ProposalOptions_CashApproveMsg)(nil),
+		(*ProposalOptions_CashTransferFromMsg)(nil),
+		(*ProposalOptions_CashCreateVestingMsg)(nil),
+		(*ProposalOptions_CashReleaseVestingMsg)(nil),
+		(*ProposalOptions_CashFreezeAccountMsg)(nil),
+		(*ProposalOptions_CashUnfreezeAccountMsg)(nil),
+		(*ProposalOptions_CashGrantFeeMsg)(nil),
+		(*ProposalOptions_CashScheduleSendMsg)(nil),
+		(*ProposalOptions_CashCancelScheduledSendMsg)(nil),
+		(*ProposalOptions_CashSetWalletNameMsg)(nil),
+		(*ProposalOptions_EscrowReleaseMilestoneMsg)(nil),
+		(*ProposalOptions_EscrowApproveMsg)(nil),
+		(*ProposalOptions_EscrowUpdateEscrowTimeoutMsg)(nil),
+		(*ProposalOptions_EscrowRaiseDisputeMsg)(nil),
+		(*ProposalOptions_EscrowResolveDisputeMsg)(nil),
+		(*ProposalOptions_EscrowUpdateEscrowPartiesMsg)(nil),
+		(*ProposalOptions_ValidatorsSetBlsKeyMsg)(nil),
+		(*ProposalOptions_GovChangeParametersMsg)(nil),
+		(*ProposalOptions_GovTextProposalMsg)(nil),
+		(*ProposalOptions_MigrationMigrateBucketMsg)(nil),
+		(*ProposalOptions_MigrationDowngradeSchemaMsg)(nil),
+		(*ProposalOptions_MsgfeeUpdateConfigurationMsg)(nil),
+		(*ProposalOptions_FeatureScheduleFeatureMsg)(nil),
+		(*ProposalOptions_FeatureUpdateConfigurationMsg)(nil),
 	}
 }
 
@@ -1989,6 +4180,141 @@ func _ProposalOptions_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.MsgfeeSetMsgFeeMsg); err != nil {
 			return err
 		}
+	case *ProposalOptions_CashMultiSendMsg:
+		_ = b.EncodeVarint(81<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMultiSendMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashBurnMsg:
+		_ = b.EncodeVarint(82<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashBurnMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashMintMsg:
+		_ = b.EncodeVarint(83<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashMintMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashApproveMsg:
+		_ = b.EncodeVarint(84<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashApproveMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashTransferFromMsg:
+		_ = b.EncodeVarint(85<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashTransferFromMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashCreateVestingMsg:
+		_ = b.EncodeVarint(86<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashCreateVestingMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashReleaseVestingMsg:
+		_ = b.EncodeVarint(87<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashReleaseVestingMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashFreezeAccountMsg:
+		_ = b.EncodeVarint(88<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashFreezeAccountMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashUnfreezeAccountMsg:
+		_ = b.EncodeVarint(89<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashUnfreezeAccountMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashGrantFeeMsg:
+		_ = b.EncodeVarint(90<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashGrantFeeMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashScheduleSendMsg:
+		_ = b.EncodeVarint(91<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashScheduleSendMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashCancelScheduledSendMsg:
+		_ = b.EncodeVarint(92<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashCancelScheduledSendMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_CashSetWalletNameMsg:
+		_ = b.EncodeVarint(94<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashSetWalletNameMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_EscrowReleaseMilestoneMsg:
+		_ = b.EncodeVarint(95<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowReleaseMilestoneMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_EscrowApproveMsg:
+		_ = b.EncodeVarint(96<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowApproveMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_EscrowUpdateEscrowTimeoutMsg:
+		_ = b.EncodeVarint(97<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowUpdateEscrowTimeoutMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_EscrowRaiseDisputeMsg:
+		_ = b.EncodeVarint(98<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowRaiseDisputeMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_EscrowResolveDisputeMsg:
+		_ = b.EncodeVarint(99<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowResolveDisputeMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_EscrowUpdateEscrowPartiesMsg:
+		_ = b.EncodeVarint(100<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.EscrowUpdateEscrowPartiesMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_ValidatorsSetBlsKeyMsg:
+		_ = b.EncodeVarint(107<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ValidatorsSetBlsKeyMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_GovChangeParametersMsg:
+		_ = b.EncodeVarint(108<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovChangeParametersMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_GovTextProposalMsg:
+		_ = b.EncodeVarint(111<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovTextProposalMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_MigrationMigrateBucketMsg:
+		_ = b.EncodeVarint(112<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MigrationMigrateBucketMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_MigrationDowngradeSchemaMsg:
+		_ = b.EncodeVarint(113<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MigrationDowngradeSchemaMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_MsgfeeUpdateConfigurationMsg:
+		_ = b.EncodeVarint(114<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MsgfeeUpdateConfigurationMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_FeatureScheduleFeatureMsg:
+		_ = b.EncodeVarint(115<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.FeatureScheduleFeatureMsg); err != nil {
+			return err
+		}
+	case *ProposalOptions_FeatureUpdateConfigurationMsg:
+		_ = b.EncodeVarint(116<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.FeatureUpdateConfigurationMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ProposalOptions.Option has unexpected type %T", x)
@@ -2143,66 +4469,282 @@ func _ProposalOptions_OneofUnmarshaler(msg proto.Message, tag, wire int, b *prot
 		err := b.DecodeMessage(msg)
 		m.Option = &ProposalOptions_MsgfeeSetMsgFeeMsg{msg}
 		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _ProposalOptions_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*ProposalOptions)
-	// option
-	switch x := m.Option.(type) {
-	case *ProposalOptions_CashSendMsg:
-		s := proto.Size(x.CashSendMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ProposalOptions_EscrowReleaseMsg:
-		s := proto.Size(x.EscrowReleaseMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ProposalOptions_UpdateEscrowPartiesMsg:
-		s := proto.Size(x.UpdateEscrowPartiesMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ProposalOptions_MultisigUpdateMsg:
-		s := proto.Size(x.MultisigUpdateMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ProposalOptions_ValidatorsApplyDiffMsg:
-		s := proto.Size(x.ValidatorsApplyDiffMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ProposalOptions_CurrencyCreateMsg:
-		s := proto.Size(x.CurrencyCreateMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ProposalOptions_ExecuteProposalBatchMsg:
-		s := proto.Size(x.ExecuteProposalBatchMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ProposalOptions_UsernameRegisterTokenMsg:
-		s := proto.Size(x.UsernameRegisterTokenMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ProposalOptions_UsernameTransferTokenMsg:
-		s := proto.Size(x.UsernameTransferTokenMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ProposalOptions_UsernameChangeTokenTargetsMsg:
-		s := proto.Size(x.UsernameChangeTokenTargetsMsg)
-		n += 2 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ProposalOptions_DistributionCreateMsg:
+	case 81: // option.cash_multi_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MultiSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashMultiSendMsg{msg}
+		return true, err
+	case 82: // option.cash_burn_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.BurnMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashBurnMsg{msg}
+		return true, err
+	case 83: // option.cash_mint_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.MintMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashMintMsg{msg}
+		return true, err
+	case 84: // option.cash_approve_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.ApproveMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashApproveMsg{msg}
+		return true, err
+	case 85: // option.cash_transfer_from_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.TransferFromMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashTransferFromMsg{msg}
+		return true, err
+	case 86: // option.cash_create_vesting_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.CreateVestingMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashCreateVestingMsg{msg}
+		return true, err
+	case 87: // option.cash_release_vesting_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.ReleaseVestingMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashReleaseVestingMsg{msg}
+		return true, err
+	case 88: // option.cash_freeze_account_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.FreezeAccountMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashFreezeAccountMsg{msg}
+		return true, err
+	case 89: // option.cash_unfreeze_account_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.UnfreezeAccountMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashUnfreezeAccountMsg{msg}
+		return true, err
+	case 90: // option.cash_grant_fee_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.GrantFeeMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashGrantFeeMsg{msg}
+		return true, err
+	case 91: // option.cash_schedule_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.ScheduleSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashScheduleSendMsg{msg}
+		return true, err
+	case 92: // option.cash_cancel_scheduled_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.CancelScheduledSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashCancelScheduledSendMsg{msg}
+		return true, err
+	case 94: // option.cash_set_wallet_name_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.SetWalletNameMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_CashSetWalletNameMsg{msg}
+		return true, err
+	case 95: // option.escrow_release_milestone_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.ReleaseMilestoneMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_EscrowReleaseMilestoneMsg{msg}
+		return true, err
+	case 96: // option.escrow_approve_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.ApproveMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_EscrowApproveMsg{msg}
+		return true, err
+	case 97: // option.escrow_update_escrow_timeout_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.UpdateEscrowTimeoutMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_EscrowUpdateEscrowTimeoutMsg{msg}
+		return true, err
+	case 98: // option.escrow_raise_dispute_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.RaiseDisputeMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_EscrowRaiseDisputeMsg{msg}
+		return true, err
+	case 99: // option.escrow_resolve_dispute_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.ResolveDisputeMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_EscrowResolveDisputeMsg{msg}
+		return true, err
+	case 100: // option.escrow_update_escrow_parties_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(escrow.UpdateEscrowPartiesMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_EscrowUpdateEscrowPartiesMsg{msg}
+		return true, err
+	case 107: // option.validators_set_bls_key_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(validators.SetBLSKeyMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_ValidatorsSetBlsKeyMsg{msg}
+		return true, err
+	case 108: // option.gov_change_parameters_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.ChangeParametersMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_GovChangeParametersMsg{msg}
+		return true, err
+	case 111: // option.gov_text_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.TextProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_GovTextProposalMsg{msg}
+		return true, err
+	case 112: // option.migration_migrate_bucket_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(migration.MigrateBucketMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_MigrationMigrateBucketMsg{msg}
+		return true, err
+	case 113: // option.migration_downgrade_schema_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(migration.DowngradeSchemaMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_MigrationDowngradeSchemaMsg{msg}
+		return true, err
+	case 114: // option.msgfee_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(msgfee.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_MsgfeeUpdateConfigurationMsg{msg}
+		return true, err
+	case 115: // option.feature_schedule_feature_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(feature.ScheduleFeatureMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_FeatureScheduleFeatureMsg{msg}
+		return true, err
+	case 116: // option.feature_update_configuration_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(feature.UpdateConfigurationMsg)
+		err := b.DecodeMessage(msg)
+		m.Option = &ProposalOptions_FeatureUpdateConfigurationMsg{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ProposalOptions_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ProposalOptions)
+	// option
+	switch x := m.Option.(type) {
+	case *ProposalOptions_CashSendMsg:
+		s := proto.Size(x.CashSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_EscrowReleaseMsg:
+		s := proto.Size(x.EscrowReleaseMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_UpdateEscrowPartiesMsg:
+		s := proto.Size(x.UpdateEscrowPartiesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_MultisigUpdateMsg:
+		s := proto.Size(x.MultisigUpdateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_ValidatorsApplyDiffMsg:
+		s := proto.Size(x.ValidatorsApplyDiffMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CurrencyCreateMsg:
+		s := proto.Size(x.CurrencyCreateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_ExecuteProposalBatchMsg:
+		s := proto.Size(x.ExecuteProposalBatchMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_UsernameRegisterTokenMsg:
+		s := proto.Size(x.UsernameRegisterTokenMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_UsernameTransferTokenMsg:
+		s := proto.Size(x.UsernameTransferTokenMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_UsernameChangeTokenTargetsMsg:
+		s := proto.Size(x.UsernameChangeTokenTargetsMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_DistributionCreateMsg:
 		s := proto.Size(x.DistributionCreateMsg)
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
@@ -2242,67 +4784,202 @@ func _ProposalOptions_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
-type ExecuteProposalBatchMsg struct {
-	Messages []ExecuteProposalBatchMsg_Union `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
-}
-
-func (m *ExecuteProposalBatchMsg) Reset()         { *m = ExecuteProposalBatchMsg{} }
-func (m *ExecuteProposalBatchMsg) String() string { return proto.CompactTextString(m) }
-func (*ExecuteProposalBatchMsg) ProtoMessage()    {}
-func (*ExecuteProposalBatchMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a8efb1d2ea3c411d, []int{3}
-}
-func (m *ExecuteProposalBatchMsg) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ExecuteProposalBatchMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ExecuteProposalBatchMsg.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalTo(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *ExecuteProposalBatchMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecuteProposalBatchMsg.Merge(m, src)
-}
-func (m *ExecuteProposalBatchMsg) XXX_Size() int {
-	return m.Size()
-}
-func (m *ExecuteProposalBatchMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecuteProposalBatchMsg.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ExecuteProposalBatchMsg proto.InternalMessageInfo
-
-func (m *ExecuteProposalBatchMsg) GetMessages() []ExecuteProposalBatchMsg_Union {
-	if m != nil {
-		return m.Messages
-	}
-	return nil
-}
-
-type ExecuteProposalBatchMsg_Union struct {
-	// Types that are valid to be assigned to Sum:
-	//	*ExecuteProposalBatchMsg_Union_SendMsg
-	//	*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg
-	//	*ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg
-	//	*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg
-	//	*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg
-	//	*ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg
-	//	*ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg
-	//	*ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg
+	case *ProposalOptions_CashMultiSendMsg:
+		s := proto.Size(x.CashMultiSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashBurnMsg:
+		s := proto.Size(x.CashBurnMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashMintMsg:
+		s := proto.Size(x.CashMintMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashApproveMsg:
+		s := proto.Size(x.CashApproveMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashTransferFromMsg:
+		s := proto.Size(x.CashTransferFromMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashCreateVestingMsg:
+		s := proto.Size(x.CashCreateVestingMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashReleaseVestingMsg:
+		s := proto.Size(x.CashReleaseVestingMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashFreezeAccountMsg:
+		s := proto.Size(x.CashFreezeAccountMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashUnfreezeAccountMsg:
+		s := proto.Size(x.CashUnfreezeAccountMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashGrantFeeMsg:
+		s := proto.Size(x.CashGrantFeeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashScheduleSendMsg:
+		s := proto.Size(x.CashScheduleSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashCancelScheduledSendMsg:
+		s := proto.Size(x.CashCancelScheduledSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_CashSetWalletNameMsg:
+		s := proto.Size(x.CashSetWalletNameMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_EscrowReleaseMilestoneMsg:
+		s := proto.Size(x.EscrowReleaseMilestoneMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_EscrowApproveMsg:
+		s := proto.Size(x.EscrowApproveMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_EscrowUpdateEscrowTimeoutMsg:
+		s := proto.Size(x.EscrowUpdateEscrowTimeoutMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_EscrowRaiseDisputeMsg:
+		s := proto.Size(x.EscrowRaiseDisputeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_EscrowResolveDisputeMsg:
+		s := proto.Size(x.EscrowResolveDisputeMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_EscrowUpdateEscrowPartiesMsg:
+		s := proto.Size(x.EscrowUpdateEscrowPartiesMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_ValidatorsSetBlsKeyMsg:
+		s := proto.Size(x.ValidatorsSetBlsKeyMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_GovChangeParametersMsg:
+		s := proto.Size(x.GovChangeParametersMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_GovTextProposalMsg:
+		s := proto.Size(x.GovTextProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_MigrationMigrateBucketMsg:
+		s := proto.Size(x.MigrationMigrateBucketMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_MigrationDowngradeSchemaMsg:
+		s := proto.Size(x.MigrationDowngradeSchemaMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_MsgfeeUpdateConfigurationMsg:
+		s := proto.Size(x.MsgfeeUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_FeatureScheduleFeatureMsg:
+		s := proto.Size(x.FeatureScheduleFeatureMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ProposalOptions_FeatureUpdateConfigurationMsg:
+		s := proto.Size(x.FeatureUpdateConfigurationMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type ExecuteProposalBatchMsg struct {
+	Messages []ExecuteProposalBatchMsg_Union `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages"`
+}
+
+func (m *ExecuteProposalBatchMsg) Reset()         { *m = ExecuteProposalBatchMsg{} }
+func (m *ExecuteProposalBatchMsg) String() string { return proto.CompactTextString(m) }
+func (*ExecuteProposalBatchMsg) ProtoMessage()    {}
+func (*ExecuteProposalBatchMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a8efb1d2ea3c411d, []int{3}
+}
+func (m *ExecuteProposalBatchMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecuteProposalBatchMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecuteProposalBatchMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecuteProposalBatchMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecuteProposalBatchMsg.Merge(m, src)
+}
+func (m *ExecuteProposalBatchMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecuteProposalBatchMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecuteProposalBatchMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecuteProposalBatchMsg proto.InternalMessageInfo
+
+func (m *ExecuteProposalBatchMsg) GetMessages() []ExecuteProposalBatchMsg_Union {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type ExecuteProposalBatchMsg_Union struct {
+	// Types that are valid to be assigned to Sum:
+	//	*ExecuteProposalBatchMsg_Union_SendMsg
+	//	*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg
+	//	*ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg
+	//	*ExecuteProposalBatchMsg_Union_MultisigUpdateMsg
+	//	*ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg
+	//	*ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg
+	//	*ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg
+	//	*ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg
 	//	*ExecuteProposalBatchMsg_Union_DistributionCreateMsg
 	//	*ExecuteProposalBatchMsg_Union_DistributionMsg
 	//	*ExecuteProposalBatchMsg_Union_DistributionResetMsg
@@ -2310,6 +4987,7 @@ type ExecuteProposalBatchMsg_Union struct {
 	//	*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg
 	//	*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg
 	//	*ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg
+	//	*ExecuteProposalBatchMsg_Union_GovChangeParametersMsg
 	Sum isExecuteProposalBatchMsg_Union_Sum `protobuf_oneof:"sum"`
 }
 
@@ -2397,6 +5075,9 @@ type ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg struct {
 type ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg struct {
 	MsgfeeSetMsgFeeMsg *msgfee.SetMsgFeeMsg `protobuf:"bytes,80,opt,name=msgfee_set_msg_fee_msg,json=msgfeeSetMsgFeeMsg,proto3,oneof"`
 }
+type ExecuteProposalBatchMsg_Union_GovChangeParametersMsg struct {
+	GovChangeParametersMsg *gov.ChangeParametersMsg `protobuf:"bytes,108,opt,name=gov_change_parameters_msg,json=govChangeParametersMsg,proto3,oneof"`
+}
 
 func (*ExecuteProposalBatchMsg_Union_SendMsg) isExecuteProposalBatchMsg_Union_Sum()                  {}
 func (*ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) isExecuteProposalBatchMsg_Union_Sum()         {}
@@ -2414,7 +5095,8 @@ func (*ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) isExecuteProposalBa
 func (*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) isExecuteProposalBatchMsg_Union_Sum() {}
 func (*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) isExecuteProposalBatchMsg_Union_Sum() {
 }
-func (*ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) isExecuteProposalBatchMsg_Union_Sum() {}
+func (*ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) isExecuteProposalBatchMsg_Union_Sum()     {}
+func (*ExecuteProposalBatchMsg_Union_GovChangeParametersMsg) isExecuteProposalBatchMsg_Union_Sum() {}
 
 func (m *ExecuteProposalBatchMsg_Union) GetSum() isExecuteProposalBatchMsg_Union_Sum {
 	if m != nil {
@@ -2528,6 +5210,13 @@ func (m *ExecuteProposalBatchMsg_Union) GetMsgfeeSetMsgFeeMsg() *msgfee.SetMsgFe
 	return nil
 }
 
+func (m *ExecuteProposalBatchMsg_Union) GetGovChangeParametersMsg() *gov.ChangeParametersMsg {
+	if x, ok := m.GetSum().(*ExecuteProposalBatchMsg_Union_GovChangeParametersMsg); ok {
+		return x.GovChangeParametersMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _ExecuteProposalBatchMsg_Union_OneofMarshaler, _ExecuteProposalBatchMsg_Union_OneofUnmarshaler, _ExecuteProposalBatchMsg_Union_OneofSizer, []interface{}{
@@ -2546,6 +5235,7 @@ func (*ExecuteProposalBatchMsg_Union) XXX_OneofFuncs() (func(msg proto.Message,
 		(*ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg)(nil),
 		(*ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg)(nil),
+		(*ExecuteProposalBatchMsg_Union_GovChangeParametersMsg)(nil),
 	}
 }
 
@@ -2628,6 +5318,11 @@ func _ExecuteProposalBatchMsg_Union_OneofMarshaler(msg proto.Message, b *proto.B
 		if err := b.EncodeMessage(x.MsgfeeSetMsgFeeMsg); err != nil {
 			return err
 		}
+	case *ExecuteProposalBatchMsg_Union_GovChangeParametersMsg:
+		_ = b.EncodeVarint(108<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.GovChangeParametersMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("ExecuteProposalBatchMsg_Union.Sum has unexpected type %T", x)
@@ -2758,6 +5453,14 @@ func _ExecuteProposalBatchMsg_Union_OneofUnmarshaler(msg proto.Message, tag, wir
 		err := b.DecodeMessage(msg)
 		m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg{msg}
 		return true, err
+	case 108: // sum.gov_change_parameters_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(gov.ChangeParametersMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &ExecuteProposalBatchMsg_Union_GovChangeParametersMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -2842,6 +5545,11 @@ func _ExecuteProposalBatchMsg_Union_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *ExecuteProposalBatchMsg_Union_GovChangeParametersMsg:
+		s := proto.Size(x.GovChangeParametersMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -2871,6 +5579,9 @@ type CronTask struct {
 	//	*CronTask_DistributionDistributeMsg
 	//	*CronTask_AswapReleaseMsg
 	//	*CronTask_GovTallyMsg
+	//	*CronTask_CashExecuteScheduledSendMsg
+	//	*CronTask_MultisigApplyUpdateMsg
+	//	*CronTask_MultisigExpireProposalMsg
 	Sum isCronTask_Sum `protobuf_oneof:"sum"`
 }
 
@@ -2928,12 +5639,24 @@ type CronTask_AswapReleaseMsg struct {
 type CronTask_GovTallyMsg struct {
 	GovTallyMsg *gov.TallyMsg `protobuf:"bytes,76,opt,name=gov_tally_msg,json=govTallyMsg,proto3,oneof"`
 }
+type CronTask_CashExecuteScheduledSendMsg struct {
+	CashExecuteScheduledSendMsg *cash.ExecuteScheduledSendMsg `protobuf:"bytes,93,opt,name=cash_execute_scheduled_send_msg,json=cashExecuteScheduledSendMsg,proto3,oneof"`
+}
+type CronTask_MultisigApplyUpdateMsg struct {
+	MultisigApplyUpdateMsg *multisig.ApplyUpdateMsg `protobuf:"bytes,103,opt,name=multisig_apply_update_msg,json=multisigApplyUpdateMsg,proto3,oneof"`
+}
+type CronTask_MultisigExpireProposalMsg struct {
+	MultisigExpireProposalMsg *multisig.ExpireProposalMsg `protobuf:"bytes,105,opt,name=multisig_expire_proposal_msg,json=multisigExpireProposalMsg,proto3,oneof"`
+}
 
-func (*CronTask_EscrowReleaseMsg) isCronTask_Sum()          {}
-func (*CronTask_EscrowReturnMsg) isCronTask_Sum()           {}
-func (*CronTask_DistributionDistributeMsg) isCronTask_Sum() {}
-func (*CronTask_AswapReleaseMsg) isCronTask_Sum()           {}
-func (*CronTask_GovTallyMsg) isCronTask_Sum()               {}
+func (*CronTask_EscrowReleaseMsg) isCronTask_Sum()            {}
+func (*CronTask_EscrowReturnMsg) isCronTask_Sum()             {}
+func (*CronTask_DistributionDistributeMsg) isCronTask_Sum()   {}
+func (*CronTask_AswapReleaseMsg) isCronTask_Sum()             {}
+func (*CronTask_GovTallyMsg) isCronTask_Sum()                 {}
+func (*CronTask_CashExecuteScheduledSendMsg) isCronTask_Sum() {}
+func (*CronTask_MultisigApplyUpdateMsg) isCronTask_Sum()      {}
+func (*CronTask_MultisigExpireProposalMsg) isCronTask_Sum()   {}
 
 func (m *CronTask) GetSum() isCronTask_Sum {
 	if m != nil {
@@ -2984,6 +5707,27 @@ func (m *CronTask) GetGovTallyMsg() *gov.TallyMsg {
 	return nil
 }
 
+func (m *CronTask) GetCashExecuteScheduledSendMsg() *cash.ExecuteScheduledSendMsg {
+	if x, ok := m.GetSum().(*CronTask_CashExecuteScheduledSendMsg); ok {
+		return x.CashExecuteScheduledSendMsg
+	}
+	return nil
+}
+
+func (m *CronTask) GetMultisigApplyUpdateMsg() *multisig.ApplyUpdateMsg {
+	if x, ok := m.GetSum().(*CronTask_MultisigApplyUpdateMsg); ok {
+		return x.MultisigApplyUpdateMsg
+	}
+	return nil
+}
+
+func (m *CronTask) GetMultisigExpireProposalMsg() *multisig.ExpireProposalMsg {
+	if x, ok := m.GetSum().(*CronTask_MultisigExpireProposalMsg); ok {
+		return x.MultisigExpireProposalMsg
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _CronTask_OneofMarshaler, _CronTask_OneofUnmarshaler, _CronTask_OneofSizer, []interface{}{
@@ -2992,6 +5736,9 @@ func (*CronTask) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) erro
 		(*CronTask_DistributionDistributeMsg)(nil),
 		(*CronTask_AswapReleaseMsg)(nil),
 		(*CronTask_GovTallyMsg)(nil),
+		(*CronTask_CashExecuteScheduledSendMsg)(nil),
+		(*CronTask_MultisigApplyUpdateMsg)(nil),
+		(*CronTask_MultisigExpireProposalMsg)(nil),
 	}
 }
 
@@ -3024,6 +5771,21 @@ func _CronTask_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 		if err := b.EncodeMessage(x.GovTallyMsg); err != nil {
 			return err
 		}
+	case *CronTask_CashExecuteScheduledSendMsg:
+		_ = b.EncodeVarint(93<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.CashExecuteScheduledSendMsg); err != nil {
+			return err
+		}
+	case *CronTask_MultisigApplyUpdateMsg:
+		_ = b.EncodeVarint(103<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigApplyUpdateMsg); err != nil {
+			return err
+		}
+	case *CronTask_MultisigExpireProposalMsg:
+		_ = b.EncodeVarint(105<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.MultisigExpireProposalMsg); err != nil {
+			return err
+		}
 	case nil:
 	default:
 		return fmt.Errorf("CronTask.Sum has unexpected type %T", x)
@@ -3074,6 +5836,30 @@ func _CronTask_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffe
 		err := b.DecodeMessage(msg)
 		m.Sum = &CronTask_GovTallyMsg{msg}
 		return true, err
+	case 93: // sum.cash_execute_scheduled_send_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(cash.ExecuteScheduledSendMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_CashExecuteScheduledSendMsg{msg}
+		return true, err
+	case 103: // sum.multisig_apply_update_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.ApplyUpdateMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_MultisigApplyUpdateMsg{msg}
+		return true, err
+	case 105: // sum.multisig_expire_proposal_msg
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(multisig.ExpireProposalMsg)
+		err := b.DecodeMessage(msg)
+		m.Sum = &CronTask_MultisigExpireProposalMsg{msg}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -3108,6 +5894,21 @@ func _CronTask_OneofSizer(msg proto.Message) (n int) {
 		n += 2 // tag and wire
 		n += proto.SizeVarint(uint64(s))
 		n += s
+	case *CronTask_CashExecuteScheduledSendMsg:
+		s := proto.Size(x.CashExecuteScheduledSendMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CronTask_MultisigApplyUpdateMsg:
+		s := proto.Size(x.MultisigApplyUpdateMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *CronTask_MultisigExpireProposalMsg:
+		s := proto.Size(x.MultisigExpireProposalMsg)
+		n += 2 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -3128,90 +5929,151 @@ func init() {
 func init() { proto.RegisterFile("cmd/bnsd/app/codec.proto", fileDescriptor_a8efb1d2ea3c411d) }
 
 var fileDescriptor_a8efb1d2ea3c411d = []byte{
-	// 1326 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x99, 0x4d, 0x73, 0x14, 0xc5,
-	0x1f, 0xc7, 0x13, 0x12, 0xf8, 0xe7, 0xdf, 0x04, 0x92, 0x34, 0x21, 0xd9, 0x2c, 0xb0, 0x81, 0x58,
-	0x65, 0x51, 0x56, 0x39, 0x63, 0x11, 0x9f, 0x05, 0x29, 0x37, 0x09, 0x02, 0xca, 0x83, 0x9b, 0x8d,
-	0x17, 0xd1, 0xa9, 0xce, 0x4c, 0xef, 0x64, 0x8a, 0xd9, 0xe9, 0xad, 0xe9, 0x9e, 0x65, 0x78, 0x05,
-	0x5e, 0x7d, 0x3f, 0x1e, 0x3c, 0xca, 0x4d, 0x8e, 0x9e, 0x28, 0x0b, 0xae, 0xbe, 0x02, 0x4f, 0x56,
-	0xff, 0xba, 0x7b, 0xb6, 0x7b, 0x12, 0x44, 0x05, 0xf1, 0xa1, 0xf6, 0xb6, 0xfd, 0xfb, 0xfe, 0xfa,
-	0xd3, 0x8f, 0xf3, 0xed, 0xee, 0x04, 0x35, 0xc2, 0x7e, 0xe4, 0xef, 0x66, 0x3c, 0xf2, 0xc9, 0x60,
-	0xe0, 0x87, 0x2c, 0xa2, 0xa1, 0x37, 0xc8, 0x99, 0x60, 0x78, 0x5a, 0x46, 0x9b, 0xab, 0x95, 0x5e,
-	0xfa, 0x05, 0xa7, 0x79, 0x46, 0xfa, 0xd4, 0x4e, 0x6b, 0x2e, 0xc6, 0x2c, 0x66, 0xf0, 0xd3, 0x97,
-	0xbf, 0x74, 0xf4, 0x64, 0x3f, 0x89, 0x73, 0x22, 0x12, 0x96, 0x39, 0xc9, 0x27, 0x4a, 0x9f, 0xf0,
-	0x7b, 0xc4, 0x69, 0xa8, 0x89, 0x4b, 0x3f, 0x24, 0x7c, 0xcf, 0x89, 0x2d, 0x95, 0x7e, 0x58, 0xe4,
-	0x39, 0xcd, 0xc2, 0xfb, 0x4e, 0xbc, 0x59, 0xfa, 0x51, 0xc2, 0x45, 0x9e, 0xec, 0x16, 0xfb, 0xe0,
-	0x8b, 0xa5, 0x4f, 0x79, 0x98, 0xb3, 0x7b, 0x4e, 0x74, 0xa1, 0xf4, 0x63, 0x36, 0xac, 0x27, 0xf6,
-	0x79, 0xdc, 0xa3, 0xb4, 0xde, 0x64, 0xbf, 0x48, 0x45, 0xc2, 0x93, 0xb8, 0xde, 0x3d, 0x9e, 0xc4,
-	0xdc, 0x89, 0x35, 0x4a, 0x7f, 0x48, 0xd2, 0x24, 0x22, 0x82, 0xe5, 0x8e, 0xb2, 0xf6, 0xfd, 0x3c,
-	0x3a, 0xd4, 0x2d, 0xf1, 0x39, 0x34, 0xdd, 0xa3, 0x94, 0x37, 0x26, 0xcf, 0x4e, 0x9e, 0x3f, 0x7a,
-	0xe1, 0x98, 0x27, 0x07, 0xe8, 0x5d, 0xa1, 0xf4, 0x5a, 0xd6, 0x63, 0x1d, 0x90, 0xf0, 0x05, 0x84,
-	0x78, 0x12, 0x67, 0x44, 0x14, 0x39, 0xe5, 0x8d, 0x43, 0x67, 0xa7, 0xce, 0x1f, 0xbd, 0x80, 0x3d,
-	0xd9, 0x94, 0xb7, 0x2d, 0xa2, 0x6d, 0x23, 0x75, 0xac, 0x2c, 0xdc, 0x44, 0x33, 0xa6, 0x8f, 0x8d,
-	0xe9, 0xb3, 0x53, 0xe7, 0x67, 0x3b, 0x55, 0x19, 0xaf, 0xa3, 0x63, 0xb2, 0x95, 0x80, 0xd3, 0x2c,
-	0x0a, 0xfa, 0x3c, 0x6e, 0xac, 0xdb, 0x6d, 0x6f, 0xd3, 0x2c, 0xba, 0xc1, 0xe3, 0xab, 0x13, 0x9d,
-	0xa3, 0xb2, 0xac, 0x8b, 0xf8, 0x32, 0x5a, 0x50, 0x73, 0x16, 0x84, 0x39, 0x25, 0x82, 0x42, 0xc5,
-	0x37, 0xa1, 0xe2, 0x82, 0xa7, 0x14, 0x6f, 0x03, 0x14, 0x55, 0x79, 0x4e, 0xc5, 0xaa, 0x10, 0x6e,
-	0x23, 0xac, 0x01, 0x39, 0x4d, 0x29, 0xe1, 0x8a, 0xf0, 0x16, 0x10, 0xb0, 0x21, 0x74, 0x94, 0xa4,
-	0x10, 0xf3, 0x2a, 0x38, 0x8a, 0x59, 0x9d, 0xc8, 0xa9, 0x28, 0xf2, 0x0c, 0x10, 0x6f, 0xbb, 0x9d,
-	0xe8, 0x80, 0xe2, 0x74, 0xa2, 0x0a, 0xe1, 0x1d, 0xb4, 0xa2, 0x01, 0xc5, 0x20, 0x92, 0xa3, 0x18,
-	0x90, 0x5c, 0x24, 0x94, 0x03, 0xe8, 0x1d, 0x00, 0x35, 0x0c, 0x68, 0x07, 0x32, 0x6e, 0xab, 0x04,
-	0xc5, 0x5b, 0x52, 0x52, 0x5d, 0xc1, 0x5b, 0xe8, 0x84, 0x99, 0x5d, 0x7b, 0x7a, 0xde, 0x05, 0xe0,
-	0x09, 0xcf, 0x68, 0xce, 0x04, 0x2d, 0x98, 0xe8, 0x68, 0x8a, 0x6c, 0x8c, 0xee, 0x9f, 0xc4, 0xbc,
-	0x57, 0xc7, 0xa8, 0xf6, 0x6b, 0x98, 0x2a, 0x28, 0x07, 0x39, 0xda, 0x73, 0x01, 0x19, 0x0c, 0xd2,
-	0xfb, 0x41, 0x94, 0xf4, 0x7a, 0x00, 0x7b, 0x5f, 0x0f, 0x72, 0x94, 0xe1, 0x7d, 0x24, 0x33, 0x36,
-	0x93, 0x5e, 0x4f, 0x0f, 0x72, 0x24, 0xd9, 0x8a, 0xec, 0x9d, 0xf9, 0xd2, 0xec, 0x41, 0x7e, 0xa0,
-	0x7b, 0x67, 0x34, 0x77, 0x90, 0x26, 0x3a, 0x1a, 0xe4, 0x06, 0x5a, 0xa0, 0x25, 0x0d, 0x0b, 0x41,
-	0x83, 0x5d, 0x22, 0xc2, 0x3d, 0x80, 0x5c, 0x04, 0xc8, 0x49, 0x4f, 0xfa, 0x87, 0xb7, 0xa5, 0xe4,
-	0xb6, 0x54, 0xcd, 0x3a, 0xba, 0x21, 0xfc, 0x05, 0x3a, 0x65, 0x3c, 0x26, 0xc8, 0x69, 0x9c, 0x70,
-	0x41, 0xf3, 0x40, 0xb0, 0xbb, 0x54, 0x6d, 0x89, 0x4b, 0x80, 0x6b, 0x7a, 0x26, 0xc7, 0xeb, 0xe8,
-	0x9c, 0xae, 0x4c, 0x51, 0xcc, 0x86, 0x11, 0xeb, 0x9a, 0x03, 0x17, 0x39, 0xc9, 0x78, 0xcf, 0x81,
-	0x7f, 0x58, 0x87, 0x77, 0x75, 0xce, 0x41, 0xf0, 0xba, 0x86, 0xef, 0xa2, 0x73, 0x15, 0x3c, 0xdc,
-	0x23, 0x59, 0x4c, 0x35, 0x5a, 0x90, 0x3c, 0xa6, 0x42, 0xed, 0xc4, 0xcb, 0xd0, 0xc4, 0xea, 0xa8,
-	0x89, 0x0d, 0xc8, 0x04, 0x48, 0x57, 0xe5, 0xa9, 0x76, 0xce, 0x98, 0x8c, 0x03, 0x13, 0xf0, 0x67,
-	0x68, 0xd9, 0x36, 0x41, 0x7b, 0xd9, 0xda, 0xd0, 0xc4, 0xb2, 0x67, 0xeb, 0xce, 0xd2, 0x9d, 0xb4,
-	0x95, 0xd1, 0xf2, 0x5d, 0x45, 0xf3, 0x0e, 0x52, 0xb2, 0x36, 0x80, 0x75, 0xca, 0x65, 0x6d, 0x9a,
-	0x82, 0x31, 0x04, 0x5b, 0x95, 0xa4, 0x9b, 0x68, 0xc9, 0x21, 0xe5, 0x94, 0x53, 0x01, 0xbc, 0x4d,
-	0xe0, 0x2d, 0xb9, 0xbc, 0x8e, 0x94, 0x15, 0x6a, 0xd1, 0x16, 0x4c, 0x1c, 0x7f, 0x85, 0x4e, 0x57,
-	0x67, 0x49, 0x50, 0x0c, 0xe2, 0x9c, 0x44, 0x34, 0xe0, 0xe1, 0x1e, 0xed, 0x13, 0xa0, 0x6e, 0xe9,
-	0x5e, 0x56, 0x49, 0xde, 0x8e, 0x4a, 0xda, 0x86, 0x1c, 0x85, 0x5e, 0xa9, 0xd4, 0xba, 0x88, 0x2f,
-	0xa2, 0x79, 0x38, 0x92, 0xec, 0x59, 0xbc, 0x02, 0xcc, 0x79, 0x0f, 0x04, 0x67, 0xfa, 0x8e, 0x43,
-	0x68, 0x34, 0x6f, 0x97, 0xd1, 0x82, 0xaa, 0x6d, 0xbb, 0xdf, 0xc7, 0xda, 0xba, 0x54, 0x75, 0xc7,
-	0xfc, 0xe6, 0x20, 0x66, 0x79, 0x5f, 0xd5, 0xbc, 0x65, 0x7d, 0x57, 0x9d, 0xe6, 0x6d, 0xe7, 0x3b,
-	0xae, 0xab, 0x1b, 0xe3, 0xbb, 0x85, 0x96, 0x63, 0x36, 0x34, 0x5d, 0x1f, 0xe4, 0x6c, 0xc0, 0x38,
-	0x49, 0x01, 0x72, 0x4d, 0xcf, 0x76, 0xcc, 0x86, 0x7a, 0x04, 0xb7, 0xb5, 0xac, 0x67, 0x3b, 0x66,
-	0xc3, 0x7d, 0x71, 0x03, 0x8c, 0x68, 0x4a, 0xeb, 0xc0, 0xeb, 0x16, 0x70, 0x13, 0xf4, 0xfd, 0xc0,
-	0x7d, 0x71, 0xfc, 0x06, 0x9a, 0x95, 0xc0, 0x21, 0xd3, 0x53, 0xfb, 0x09, 0x50, 0x66, 0x81, 0xf2,
-	0x39, 0x33, 0xd3, 0x8a, 0x62, 0x36, 0xd4, 0x25, 0xe9, 0x73, 0xb2, 0x86, 0x76, 0x4a, 0x9a, 0xd2,
-	0x50, 0xb0, 0xdc, 0xac, 0xcc, 0x0d, 0xed, 0x73, 0xb2, 0xba, 0xb2, 0xc6, 0xad, 0x2a, 0x41, 0xfb,
-	0x5c, 0xcc, 0x86, 0x07, 0x28, 0xf8, 0x0e, 0x3a, 0x5d, 0xc7, 0xc2, 0xf6, 0x2c, 0x52, 0x45, 0xbe,
-	0xa9, 0xbf, 0xff, 0x1a, 0x59, 0x6e, 0xc5, 0x22, 0xd5, 0xec, 0x86, 0xcb, 0x1e, 0x69, 0xf8, 0x3a,
-	0x5a, 0x52, 0x57, 0x8a, 0x40, 0xef, 0xf6, 0x40, 0xfe, 0x96, 0xdc, 0xdb, 0xc0, 0x5d, 0xf4, 0x94,
-	0xec, 0x6d, 0xc3, 0xae, 0xbe, 0x42, 0x35, 0x11, 0xab, 0xb0, 0x1d, 0x6d, 0x1f, 0x46, 0x53, 0xbc,
-	0xe8, 0xaf, 0x7d, 0x87, 0xd0, 0x5c, 0xcd, 0x33, 0xf1, 0x25, 0x34, 0xd3, 0xa7, 0x9c, 0x93, 0x18,
-	0xae, 0x16, 0x53, 0xb0, 0xf1, 0x0f, 0x32, 0x57, 0x6f, 0x27, 0x4b, 0x58, 0xd6, 0x9e, 0x7e, 0xf0,
-	0x68, 0x75, 0xa2, 0x53, 0x55, 0x69, 0xfe, 0xfc, 0x7f, 0x74, 0x18, 0x94, 0xf1, 0x65, 0x61, 0x7c,
-	0x59, 0xf8, 0x1b, 0x2f, 0x0b, 0xe3, 0x73, 0x7e, 0x7c, 0xce, 0xd7, 0xcf, 0xf9, 0xbf, 0xc0, 0x41,
-	0xbf, 0x9e, 0x45, 0x73, 0xe6, 0x2c, 0xba, 0x35, 0x90, 0xad, 0xf1, 0x3f, 0x67, 0x7c, 0x2f, 0xc2,
-	0xb7, 0x76, 0xd0, 0x8a, 0x39, 0x7b, 0x14, 0xea, 0x0f, 0xda, 0x8e, 0xaa, 0xbc, 0x05, 0x09, 0x4f,
-	0xb1, 0x9d, 0xff, 0xac, 0x5f, 0xdc, 0x41, 0x4d, 0xf3, 0xb8, 0xa8, 0xae, 0x24, 0xf5, 0x57, 0xc6,
-	0x19, 0xe7, 0x20, 0x34, 0xcb, 0x6e, 0xbd, 0x36, 0x96, 0xe9, 0xc1, 0xd2, 0xd8, 0x8d, 0xc6, 0x6e,
-	0xf4, 0xd2, 0x5f, 0x1d, 0xff, 0xca, 0x4b, 0xee, 0x2e, 0x6a, 0x59, 0xaf, 0x0d, 0x41, 0x4b, 0x21,
-	0xe7, 0x99, 0xa5, 0xa3, 0xc5, 0xbb, 0x05, 0xfc, 0xd3, 0xd6, 0xa3, 0xa3, 0x4b, 0x4b, 0xd1, 0xa9,
-	0x92, 0x54, 0x0b, 0xcd, 0xea, 0xe9, 0xb1, 0x4f, 0x7d, 0xa1, 0xc7, 0xc0, 0x0c, 0x3a, 0xc2, 0xc0,
-	0xf6, 0xd7, 0x7e, 0x40, 0x68, 0xf9, 0x29, 0xce, 0x80, 0xb7, 0xf6, 0xdd, 0xa9, 0x5f, 0xf9, 0x4d,
-	0x2b, 0x79, 0xe6, 0xdd, 0xfa, 0x35, 0x34, 0xf3, 0xac, 0xd3, 0xe5, 0x7f, 0x7c, 0x7c, 0xb2, 0x3c,
-	0xdf, 0xc9, 0x32, 0x36, 0xed, 0xb1, 0x69, 0xd7, 0x4d, 0x7b, 0x6c, 0xaa, 0x2f, 0xc1, 0x54, 0xf5,
-	0xdd, 0xfa, 0xdb, 0x29, 0x34, 0xb3, 0x91, 0xb3, 0xac, 0x4b, 0xf8, 0x5d, 0x7c, 0x13, 0x1d, 0x27,
-	0x85, 0xd8, 0xa3, 0x99, 0x48, 0x42, 0xf8, 0x54, 0xc1, 0x48, 0x67, 0xdb, 0xaf, 0xfe, 0xf2, 0x68,
-	0x75, 0x2d, 0x4e, 0xc4, 0x5e, 0xb1, 0xeb, 0x85, 0xac, 0xef, 0x27, 0x6c, 0xf8, 0x3a, 0xcb, 0xa8,
-	0x7f, 0x8f, 0x92, 0x21, 0xf5, 0x36, 0x58, 0x16, 0x25, 0x30, 0x15, 0xb5, 0xda, 0xff, 0x8c, 0xbf,
-	0x13, 0x7c, 0x89, 0x4e, 0x39, 0xbb, 0xb3, 0x2a, 0xd0, 0xdf, 0xbf, 0xe5, 0x57, 0x6c, 0xd5, 0x11,
-	0x9f, 0xff, 0x2f, 0x87, 0xeb, 0xe8, 0x98, 0xdc, 0x38, 0x82, 0xa4, 0xe9, 0x7d, 0xa8, 0xfc, 0xa9,
-	0x3e, 0x6b, 0xe4, 0x3e, 0xe9, 0xca, 0xa8, 0x7e, 0xc9, 0xc4, 0x6c, 0x68, 0x8a, 0x7a, 0xf5, 0xda,
-	0x8d, 0x07, 0x8f, 0x5b, 0x93, 0x0f, 0x1f, 0xb7, 0x26, 0x7f, 0x7a, 0xdc, 0x9a, 0xfc, 0xe6, 0x49,
-	0x6b, 0xe2, 0xe1, 0x93, 0xd6, 0xc4, 0x8f, 0x4f, 0x5a, 0x13, 0xbb, 0x47, 0xe0, 0xdf, 0x58, 0xeb,
-	0xbf, 0x06, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x63, 0x68, 0x7f, 0x18, 0x1c, 0x00, 0x00,
+	// 2304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xdd, 0x72, 0x1b, 0xb7,
+	0xf5, 0xb7, 0x6c, 0x39, 0x7f, 0x0d, 0x64, 0x5b, 0x12, 0x6c, 0x49, 0x14, 0x65, 0x51, 0x8a, 0xff,
+	0x33, 0x19, 0x4f, 0x67, 0xba, 0xec, 0xd8, 0xfd, 0x6e, 0x52, 0xd7, 0xd4, 0x47, 0xec, 0xc4, 0xb2,
+	0x15, 0x52, 0x52, 0xd2, 0xc6, 0x09, 0xbb, 0xdc, 0x05, 0x57, 0x1b, 0x2d, 0x17, 0xec, 0x62, 0x97,
+	0xa2, 0xfa, 0x14, 0x7d, 0x8a, 0xbe, 0x46, 0x2f, 0x72, 0x93, 0x99, 0xde, 0xe4, 0xb2, 0xbd, 0xc9,
+	0x74, 0xec, 0x17, 0xe8, 0x75, 0xaf, 0x3a, 0x38, 0x38, 0xd8, 0x05, 0x96, 0x54, 0x9b, 0x49, 0xea,
+	0x34, 0x9d, 0xec, 0x1d, 0xf7, 0xfc, 0x0e, 0x7e, 0x00, 0x0e, 0x0e, 0x70, 0xce, 0x01, 0x24, 0x52,
+	0xf3, 0x06, 0x7e, 0xb3, 0x17, 0x0b, 0xbf, 0xe9, 0x0e, 0x87, 0x4d, 0x8f, 0xfb, 0xcc, 0x73, 0x86,
+	0x09, 0x4f, 0x39, 0x9d, 0x95, 0xd2, 0xfa, 0x66, 0x8e, 0x8f, 0x9b, 0x99, 0x60, 0x49, 0xec, 0x0e,
+	0x98, 0xa9, 0x56, 0xbf, 0x15, 0xf0, 0x80, 0xc3, 0xcf, 0xa6, 0xfc, 0x85, 0xd2, 0xe5, 0x41, 0x18,
+	0x24, 0x6e, 0x1a, 0xf2, 0xd8, 0x52, 0xbe, 0x39, 0x6e, 0xba, 0xe2, 0xcc, 0x1d, 0x96, 0x85, 0x3d,
+	0x37, 0xf5, 0x4e, 0x2c, 0x21, 0x1d, 0x37, 0x3d, 0x57, 0xd8, 0xb2, 0x95, 0x71, 0xd3, 0xcb, 0x92,
+	0x84, 0xc5, 0xde, 0xb9, 0x25, 0xaf, 0x8f, 0x9b, 0x7e, 0x28, 0xd2, 0x24, 0xec, 0x65, 0x13, 0x3d,
+	0xde, 0x1a, 0x37, 0x99, 0xf0, 0x12, 0x7e, 0x66, 0x49, 0x97, 0xc7, 0xcd, 0x3e, 0x73, 0xd3, 0x2c,
+	0xb1, 0xe7, 0xb2, 0x34, 0x6e, 0x06, 0x7c, 0x54, 0x6e, 0x3f, 0x10, 0x41, 0x9f, 0xb1, 0xf2, 0x48,
+	0x06, 0x59, 0x94, 0x86, 0x22, 0x0c, 0xca, 0xa3, 0x16, 0x61, 0x20, 0x2c, 0x59, 0x6d, 0xdc, 0x1c,
+	0xb9, 0x51, 0xe8, 0xbb, 0x29, 0x4f, 0x2c, 0xe4, 0xce, 0xa7, 0x6f, 0x90, 0xcb, 0x87, 0x63, 0xfa,
+	0x3a, 0x99, 0xed, 0x33, 0x26, 0x6a, 0x33, 0x5b, 0x33, 0x77, 0xe7, 0xef, 0x5d, 0x77, 0xe4, 0xbc,
+	0x9d, 0x3d, 0xc6, 0x1e, 0xc7, 0x7d, 0xde, 0x06, 0x88, 0xde, 0x23, 0x44, 0x84, 0x41, 0x0c, 0x23,
+	0x16, 0xb5, 0xcb, 0x5b, 0x57, 0xee, 0xce, 0xdf, 0xa3, 0x8e, 0xec, 0xca, 0xe9, 0xa4, 0x7e, 0x47,
+	0x43, 0x6d, 0x43, 0x8b, 0xd6, 0xc9, 0x9c, 0x1e, 0x63, 0x6d, 0x76, 0xeb, 0xca, 0xdd, 0x6b, 0xed,
+	0xfc, 0x9b, 0xde, 0x27, 0xd7, 0x65, 0x2f, 0x5d, 0xc1, 0x62, 0xbf, 0x3b, 0x10, 0x41, 0xed, 0xbe,
+	0xd9, 0x77, 0x87, 0xc5, 0xfe, 0xbe, 0x08, 0x1e, 0x5d, 0x6a, 0xcf, 0xcb, 0x6f, 0xfc, 0xa4, 0x0f,
+	0xc8, 0x92, 0x32, 0x65, 0xd7, 0x4b, 0x98, 0x9b, 0x32, 0x68, 0xf8, 0x43, 0x68, 0xb8, 0xe4, 0x28,
+	0xc4, 0xd9, 0x06, 0x44, 0x35, 0x5e, 0x50, 0xb2, 0x5c, 0x44, 0x5b, 0x84, 0x22, 0x41, 0xc2, 0x22,
+	0xe6, 0x0a, 0xc5, 0xf0, 0x23, 0x60, 0xa0, 0x9a, 0xa1, 0xad, 0x20, 0x45, 0xb1, 0xa8, 0x84, 0x85,
+	0xcc, 0x18, 0x44, 0xc2, 0xd2, 0x2c, 0x89, 0x81, 0xe2, 0xc7, 0xf6, 0x20, 0xda, 0x80, 0x58, 0x83,
+	0xc8, 0x45, 0xf4, 0x88, 0xac, 0x21, 0x41, 0x36, 0xf4, 0xe5, 0x2c, 0x86, 0x6e, 0x92, 0x86, 0x4c,
+	0x00, 0xd1, 0x4f, 0x80, 0xa8, 0xa6, 0x89, 0x8e, 0x40, 0xe3, 0x40, 0x29, 0x28, 0xbe, 0x15, 0x05,
+	0x95, 0x11, 0xba, 0x4b, 0x6e, 0x6a, 0xeb, 0x9a, 0xe6, 0xf9, 0x29, 0x10, 0xde, 0x74, 0x34, 0x66,
+	0x19, 0x68, 0x49, 0x4b, 0x0b, 0x13, 0x99, 0x34, 0x38, 0x3e, 0x49, 0xf3, 0xb3, 0x32, 0x8d, 0xea,
+	0xbf, 0x44, 0x93, 0x0b, 0xe5, 0x24, 0x0b, 0x9f, 0xeb, 0xba, 0xc3, 0x61, 0x74, 0xde, 0xf5, 0xc3,
+	0x7e, 0x1f, 0xc8, 0x7e, 0x8e, 0x93, 0x2c, 0x34, 0x9c, 0x87, 0x52, 0x63, 0x27, 0xec, 0xf7, 0x71,
+	0x92, 0x05, 0x64, 0x22, 0x72, 0x74, 0x7a, 0x03, 0x9a, 0x93, 0xfc, 0x05, 0x8e, 0x4e, 0x63, 0xf6,
+	0x24, 0xb5, 0xb4, 0x98, 0xe4, 0x36, 0x59, 0x62, 0x63, 0xe6, 0x65, 0x29, 0xeb, 0xc2, 0xc6, 0x07,
+	0x92, 0x37, 0x81, 0x64, 0xd9, 0x91, 0x67, 0x8d, 0xb3, 0xab, 0xe0, 0x96, 0x44, 0xf5, 0x3a, 0xda,
+	0x22, 0xfa, 0x21, 0x59, 0xd7, 0xe7, 0x51, 0x37, 0x61, 0x41, 0x28, 0x52, 0x96, 0x74, 0x53, 0x7e,
+	0xca, 0x94, 0x4b, 0xbc, 0x05, 0x74, 0x75, 0x47, 0xeb, 0x38, 0x6d, 0xd4, 0x39, 0x94, 0x2a, 0x8a,
+	0xb3, 0xa6, 0xc1, 0x32, 0x66, 0x91, 0xa7, 0x89, 0x1b, 0x8b, 0xbe, 0x45, 0xfe, 0xcb, 0x32, 0xf9,
+	0x21, 0xea, 0x4c, 0x23, 0x2f, 0x63, 0xf4, 0x94, 0xbc, 0x9e, 0x93, 0x7b, 0x27, 0x6e, 0x1c, 0x30,
+	0xa4, 0x4e, 0xdd, 0x24, 0x60, 0xa9, 0xf2, 0xc4, 0x07, 0xd0, 0xc5, 0x66, 0xd1, 0xc5, 0x36, 0x68,
+	0x02, 0xc9, 0xa1, 0xd2, 0x53, 0xfd, 0x6c, 0x68, 0x8d, 0xa9, 0x0a, 0xf4, 0x3d, 0xb2, 0x6a, 0x9e,
+	0x8d, 0xe6, 0xb2, 0xb5, 0xa0, 0x8b, 0x55, 0xc7, 0xc4, 0xad, 0xa5, 0x5b, 0x36, 0x91, 0x62, 0xf9,
+	0x1e, 0x91, 0x45, 0x8b, 0x52, 0x72, 0x6d, 0x03, 0xd7, 0xba, 0xcd, 0xb5, 0xa3, 0x3f, 0xf4, 0x81,
+	0x60, 0xa2, 0x92, 0xe9, 0x29, 0x59, 0xb1, 0x98, 0x12, 0x26, 0x58, 0x0a, 0x7c, 0x3b, 0xc0, 0xb7,
+	0x62, 0xf3, 0xb5, 0x25, 0xac, 0xa8, 0x6e, 0x99, 0x80, 0x96, 0xd3, 0x8f, 0xc9, 0xed, 0x3c, 0xee,
+	0x74, 0xb3, 0x61, 0x90, 0xb8, 0x3e, 0xeb, 0x0a, 0xef, 0x84, 0x0d, 0x5c, 0x60, 0xdd, 0xc5, 0x51,
+	0xe6, 0x4a, 0xce, 0x91, 0x52, 0xea, 0x80, 0x8e, 0xa2, 0x5e, 0xcb, 0xd1, 0x32, 0x48, 0xdf, 0x24,
+	0x8b, 0x10, 0xbe, 0x4c, 0x2b, 0xee, 0x01, 0xe7, 0xa2, 0x03, 0x80, 0x65, 0xbe, 0x1b, 0x20, 0x2a,
+	0xec, 0xf6, 0x80, 0x2c, 0xa9, 0xd6, 0xe6, 0xe9, 0xf7, 0x36, 0x1e, 0x5d, 0xaa, 0xb9, 0x75, 0xf8,
+	0x2d, 0x80, 0xac, 0x10, 0x15, 0xdd, 0x1b, 0x47, 0xdf, 0x23, 0xab, 0x7b, 0xf3, 0xe4, 0xbb, 0x81,
+	0xcd, 0x51, 0x42, 0x9f, 0x91, 0xd5, 0x80, 0x8f, 0xf4, 0xd0, 0x87, 0x09, 0x1f, 0x72, 0xe1, 0x46,
+	0x40, 0xf2, 0x18, 0xad, 0x1d, 0xf0, 0x11, 0xce, 0xe0, 0x00, 0x61, 0xb4, 0x76, 0xc0, 0x47, 0x13,
+	0x72, 0x4d, 0xe8, 0xb3, 0x88, 0x95, 0x09, 0xdf, 0x31, 0x08, 0x77, 0x00, 0x9f, 0x24, 0x9c, 0x90,
+	0xd3, 0x1f, 0x90, 0x6b, 0x92, 0x70, 0xc4, 0xd1, 0xb4, 0xef, 0x02, 0xcb, 0x35, 0x60, 0x39, 0xe6,
+	0xda, 0xac, 0x24, 0xe0, 0xa3, 0x63, 0x9e, 0x9f, 0x73, 0xb2, 0x05, 0x9e, 0x94, 0x2c, 0x62, 0x5e,
+	0xca, 0x13, 0xbd, 0x32, 0xfb, 0x78, 0xce, 0xc9, 0xe6, 0xea, 0x68, 0xdc, 0xcd, 0x15, 0xf0, 0x9c,
+	0x0b, 0xf8, 0x68, 0x0a, 0x42, 0x9f, 0x93, 0xdb, 0x65, 0x5a, 0x70, 0xcf, 0x2c, 0x52, 0xcc, 0x4f,
+	0x71, 0xff, 0x97, 0x98, 0xa5, 0x2b, 0x66, 0x11, 0x72, 0xd7, 0x6c, 0xee, 0x02, 0xa3, 0xef, 0x90,
+	0x15, 0x95, 0x52, 0x74, 0xd1, 0xdb, 0xbb, 0x7d, 0xa6, 0x78, 0x0f, 0x80, 0xf7, 0x96, 0xa3, 0x60,
+	0xa7, 0x03, 0x5e, 0xbd, 0xc7, 0x90, 0x91, 0x2a, 0xb1, 0x29, 0xa5, 0xdb, 0xe4, 0x26, 0x04, 0x72,
+	0x08, 0x01, 0x45, 0x38, 0x7f, 0x0f, 0x63, 0xaa, 0xc4, 0x9c, 0x7d, 0x89, 0x15, 0x31, 0x7d, 0x51,
+	0x0a, 0x4d, 0x59, 0x9e, 0x0d, 0xf4, 0xb4, 0x53, 0xb5, 0xcd, 0x6c, 0xa0, 0x95, 0x7b, 0x14, 0x64,
+	0x03, 0xf8, 0x99, 0x37, 0x1a, 0x84, 0xb1, 0xda, 0xb2, 0x1d, 0xb3, 0xd1, 0x7e, 0x18, 0xa7, 0x46,
+	0x23, 0xfc, 0x94, 0x1e, 0x0c, 0x8d, 0xdc, 0xe1, 0x30, 0xe1, 0x23, 0x35, 0xe9, 0x43, 0xf4, 0x60,
+	0x68, 0xf7, 0x50, 0x01, 0xe8, 0xc1, 0x52, 0x54, 0x48, 0xe8, 0x13, 0xb2, 0x02, 0xad, 0xf3, 0x13,
+	0xb9, 0x9f, 0xf0, 0x01, 0x70, 0x1c, 0x61, 0xf0, 0x00, 0x0e, 0x7d, 0xe0, 0xee, 0x25, 0x7c, 0xa0,
+	0x88, 0xc0, 0x46, 0x25, 0xb1, 0x74, 0x5f, 0x60, 0xc3, 0x0d, 0x31, 0x62, 0x22, 0x0d, 0xe3, 0x00,
+	0xe8, 0x8e, 0xd1, 0x7d, 0x81, 0x4e, 0x39, 0xfe, 0xb1, 0x82, 0xd1, 0x7d, 0x25, 0x50, 0x96, 0xd3,
+	0x36, 0xa9, 0x01, 0xa1, 0xde, 0xde, 0x26, 0xe3, 0xfb, 0x78, 0xd6, 0x02, 0x23, 0x6e, 0x69, 0x8b,
+	0x72, 0x59, 0x22, 0x13, 0x40, 0x3e, 0xc8, 0x7e, 0xc2, 0xd8, 0xef, 0x59, 0xd7, 0xf5, 0x3c, 0x9e,
+	0xa1, 0xbd, 0x3f, 0x30, 0x07, 0xb9, 0x07, 0xf8, 0x43, 0x05, 0x1b, 0x83, 0x2c, 0xcb, 0xe5, 0x8e,
+	0x01, 0xc2, 0x2c, 0x9e, 0x42, 0xf9, 0x6b, 0xdc, 0x31, 0x40, 0x79, 0x14, 0xf7, 0x4b, 0x8d, 0xe5,
+	0x8e, 0x91, 0xd0, 0x24, 0x42, 0x7f, 0x45, 0x28, 0xd0, 0x06, 0x89, 0x1b, 0xa7, 0xb9, 0x3f, 0xff,
+	0x06, 0x0f, 0x37, 0xe0, 0x7b, 0x5b, 0x42, 0xb9, 0x33, 0x2f, 0x48, 0x99, 0x21, 0xca, 0x17, 0x57,
+	0x1e, 0xd7, 0xbe, 0xdc, 0x68, 0xb9, 0x33, 0x7f, 0x68, 0x2e, 0x6e, 0x07, 0xe1, 0xc2, 0x9f, 0x61,
+	0x71, 0x4b, 0x62, 0xda, 0x23, 0x0d, 0xb5, 0xb8, 0x6e, 0xec, 0xb1, 0x28, 0x27, 0xf5, 0x0b, 0xd6,
+	0xe7, 0xc0, 0x7a, 0x1b, 0xd7, 0x18, 0xd4, 0x34, 0x89, 0x5f, 0x90, 0xd7, 0x61, 0xa5, 0xa7, 0xa2,
+	0xf4, 0x00, 0xd7, 0x5b, 0xee, 0xe2, 0x33, 0x37, 0x8a, 0x58, 0xda, 0x85, 0x98, 0x2e, 0xd9, 0x3f,
+	0x36, 0x17, 0xa7, 0xc3, 0xd2, 0xf7, 0x01, 0x7f, 0xea, 0x0e, 0x98, 0xb1, 0x38, 0x65, 0xb9, 0x8c,
+	0x5f, 0xe5, 0x04, 0x39, 0x8c, 0x98, 0x48, 0x79, 0xac, 0x58, 0xbb, 0x18, 0xbf, 0x4a, 0xa9, 0xb2,
+	0xd6, 0xc1, 0xf8, 0x65, 0xe7, 0xcc, 0x06, 0x68, 0x24, 0xe0, 0xe6, 0x06, 0xfc, 0xad, 0x9d, 0x80,
+	0x5b, 0x5b, 0x10, 0x13, 0xf0, 0x42, 0x46, 0x4f, 0xc8, 0x96, 0x9d, 0x3f, 0xe3, 0x57, 0x1a, 0x0e,
+	0x18, 0xcf, 0x94, 0x1f, 0xb9, 0xc0, 0xd8, 0xb0, 0xd3, 0xe8, 0x5d, 0xf8, 0x38, 0x54, 0x6a, 0x8a,
+	0xfd, 0xb6, 0x99, 0x4c, 0x97, 0x71, 0xb9, 0x9f, 0xb4, 0x35, 0xdc, 0x50, 0xb0, 0xae, 0x1f, 0x8a,
+	0x61, 0x86, 0x67, 0x7b, 0x0f, 0xf7, 0x93, 0xb6, 0x84, 0x54, 0xd8, 0x51, 0x38, 0xee, 0x27, 0xb4,
+	0x82, 0x0d, 0xd0, 0x0f, 0x48, 0x3d, 0xb7, 0xb0, 0xe0, 0xd1, 0xc8, 0x66, 0xf5, 0x80, 0x75, 0xad,
+	0xb0, 0x2f, 0xa8, 0x58, 0xbc, 0xab, 0xda, 0xba, 0x25, 0xe8, 0x42, 0xbb, 0x98, 0xe5, 0x85, 0x7f,
+	0xb1, 0x5d, 0xac, 0x22, 0x63, 0x8a, 0x5d, 0x0a, 0x1c, 0xb2, 0x9c, 0x52, 0xa9, 0x61, 0x05, 0x5f,
+	0xa6, 0xb3, 0x1c, 0xbb, 0xe6, 0xb0, 0x23, 0xf0, 0x9a, 0x5d, 0x7b, 0x18, 0x20, 0x75, 0xc9, 0x46,
+	0xce, 0xaf, 0xfd, 0xc4, 0xea, 0xa0, 0x8f, 0x5b, 0x27, 0xef, 0x00, 0xdd, 0xc3, 0xee, 0xa1, 0xae,
+	0xe1, 0x49, 0x54, 0x9e, 0x42, 0x66, 0x17, 0xd1, 0xb9, 0x59, 0xec, 0x04, 0x78, 0x0a, 0x99, 0xf4,
+	0xd1, 0xb9, 0x59, 0xf1, 0xac, 0x18, 0xd4, 0x06, 0x22, 0x3d, 0x26, 0xa7, 0x1d, 0xb1, 0x94, 0x9b,
+	0xac, 0x27, 0xe8, 0x31, 0x39, 0xeb, 0x31, 0x4b, 0xb9, 0x49, 0xba, 0xac, 0x11, 0x0b, 0xb0, 0xac,
+	0xcd, 0xc6, 0xc3, 0x30, 0x29, 0x19, 0x23, 0x2c, 0x5b, 0x7b, 0x17, 0x94, 0x2e, 0xb0, 0xf6, 0x04,
+	0x28, 0x23, 0xb8, 0x08, 0x03, 0xd1, 0x4d, 0x78, 0x2a, 0x87, 0x7a, 0xca, 0xce, 0x81, 0xf6, 0x13,
+	0xdc, 0x94, 0x12, 0x73, 0xda, 0x80, 0xbd, 0xcb, 0xce, 0x71, 0x53, 0x4a, 0xa1, 0x29, 0xa3, 0xc7,
+	0xa4, 0x6e, 0xd4, 0x7b, 0xf2, 0x40, 0xea, 0x45, 0x22, 0xe7, 0x3a, 0x9d, 0x2c, 0xf8, 0x3a, 0x2c,
+	0x6d, 0x3d, 0xe9, 0xe4, 0x8c, 0x46, 0xc1, 0x27, 0x91, 0x48, 0x20, 0xef, 0x63, 0xb2, 0xac, 0x53,
+	0xbc, 0x00, 0x82, 0xa4, 0x4e, 0xcd, 0x06, 0x98, 0xa9, 0xe8, 0x04, 0x4f, 0xa2, 0x45, 0x8a, 0x46,
+	0x31, 0xbd, 0x33, 0xa4, 0x3a, 0x55, 0x4b, 0xd8, 0x88, 0x9f, 0x32, 0xcd, 0xa8, 0xcb, 0x87, 0xd8,
+	0x48, 0xd5, 0xda, 0xa0, 0xb1, 0x93, 0x2b, 0x14, 0xa9, 0xda, 0x14, 0xc4, 0x4e, 0xf9, 0xd5, 0x2f,
+	0xd6, 0xed, 0x65, 0xde, 0x29, 0x16, 0x12, 0xc3, 0x89, 0x94, 0x7f, 0x5f, 0x29, 0xb5, 0x40, 0xa7,
+	0x9c, 0xf2, 0x97, 0x41, 0xea, 0x93, 0x46, 0xc1, 0xef, 0xf3, 0xb3, 0x78, 0xa2, 0xa8, 0xf8, 0x1d,
+	0xf4, 0xb0, 0x61, 0xf4, 0xb0, 0xa3, 0xd5, 0xcc, 0xb2, 0x62, 0x3d, 0xc7, 0x27, 0x61, 0x1a, 0x90,
+	0x4d, 0x4c, 0x09, 0xd1, 0x63, 0x3d, 0x1e, 0xf7, 0xc3, 0x20, 0x4b, 0x0a, 0x13, 0x25, 0x78, 0x76,
+	0x28, 0x3d, 0x3c, 0x3b, 0xb6, 0x4d, 0x35, 0x3c, 0x3b, 0x94, 0xc2, 0x74, 0x5c, 0x9a, 0x0b, 0x2f,
+	0xbe, 0x8a, 0x40, 0xab, 0x05, 0xb2, 0x17, 0x81, 0xe6, 0x42, 0x59, 0x1e, 0x6e, 0xf7, 0xd4, 0x37,
+	0x9a, 0x0b, 0xd1, 0x49, 0x90, 0x7e, 0x42, 0xb6, 0x34, 0xdd, 0x85, 0x33, 0x49, 0xb1, 0xb4, 0xd5,
+	0x7d, 0x5c, 0x38, 0x95, 0x0d, 0xd4, 0x98, 0xae, 0xd0, 0xba, 0x4a, 0xae, 0x88, 0x6c, 0x70, 0xe7,
+	0x4f, 0xeb, 0x64, 0xa1, 0x74, 0x5f, 0x40, 0xdf, 0x22, 0x73, 0x03, 0x26, 0x84, 0x1b, 0xc0, 0xb5,
+	0xda, 0x15, 0x98, 0xd2, 0xb4, 0x8b, 0x05, 0xe7, 0x28, 0x0e, 0x79, 0xdc, 0x9a, 0xfd, 0xec, 0x8b,
+	0xcd, 0x4b, 0xed, 0xbc, 0x09, 0xdd, 0x24, 0xb3, 0x03, 0xee, 0xb3, 0xda, 0xe5, 0xad, 0x99, 0xbb,
+	0x37, 0xee, 0xcd, 0x3b, 0x70, 0x4b, 0xe1, 0xec, 0x73, 0x9f, 0xb5, 0x01, 0xa8, 0xff, 0xb9, 0x4e,
+	0xae, 0x42, 0xd3, 0xea, 0x26, 0xad, 0xba, 0x49, 0xfb, 0x2f, 0xde, 0xa4, 0x55, 0x97, 0x60, 0xd5,
+	0x25, 0x58, 0xf9, 0x12, 0xac, 0xba, 0x5e, 0xa8, 0xae, 0x17, 0xaa, 0xeb, 0x85, 0xea, 0x7a, 0xa1,
+	0xba, 0x5e, 0xa8, 0xae, 0x17, 0xaa, 0xeb, 0x85, 0xea, 0x7a, 0xe1, 0xdb, 0x7b, 0xbd, 0xa0, 0x2b,
+	0xb8, 0xbf, 0x6f, 0x90, 0x05, 0x3d, 0xe6, 0x67, 0x43, 0x99, 0xcc, 0x88, 0xaf, 0x56, 0x57, 0xfd,
+	0x27, 0xca, 0xa2, 0x23, 0xb2, 0x76, 0xf1, 0x0e, 0xfb, 0x12, 0x55, 0x4d, 0x36, 0x7d, 0x57, 0x7d,
+	0x27, 0xca, 0x91, 0xe7, 0xa4, 0xae, 0x1f, 0xf6, 0x73, 0x27, 0x2e, 0xbf, 0xf0, 0x6f, 0x58, 0x85,
+	0xb8, 0x5e, 0x76, 0xe3, 0xa5, 0x7f, 0x95, 0x4d, 0x87, 0xaa, 0x62, 0xa7, 0x2a, 0x76, 0xbe, 0xf1,
+	0x17, 0xff, 0xff, 0xc9, 0x07, 0xe6, 0x1e, 0x69, 0x18, 0x2f, 0xfd, 0x29, 0x1b, 0xa7, 0x2a, 0x1d,
+	0x29, 0x16, 0xef, 0x19, 0x86, 0xd8, 0xe2, 0xc1, 0xff, 0x90, 0x8d, 0xd3, 0x76, 0xae, 0x84, 0x21,
+	0x36, 0x7f, 0xf6, 0x9f, 0x40, 0xab, 0x2a, 0xb3, 0xaa, 0x32, 0xab, 0x2a, 0xb3, 0xaa, 0x32, 0xab,
+	0x2a, 0xb3, 0xaa, 0x32, 0xbf, 0x52, 0x95, 0xf9, 0xaa, 0x5e, 0x2c, 0x31, 0x60, 0x63, 0x96, 0x35,
+	0x74, 0x13, 0x77, 0xc0, 0x52, 0x96, 0xa8, 0xa1, 0x47, 0x46, 0xc0, 0x56, 0xc9, 0xd3, 0x41, 0xae,
+	0x50, 0x04, 0xec, 0x29, 0x88, 0x7e, 0x08, 0x85, 0x58, 0x6a, 0xd5, 0x67, 0xdc, 0x78, 0x08, 0x95,
+	0x51, 0xd2, 0x2e, 0xcc, 0xe4, 0x43, 0x68, 0x49, 0x5a, 0xbd, 0x58, 0x7e, 0x77, 0x5f, 0x2c, 0xe7,
+	0xc8, 0x6b, 0x1c, 0xea, 0xdb, 0x3b, 0x7f, 0x9c, 0x27, 0xab, 0x17, 0x94, 0x40, 0x74, 0x77, 0xe2,
+	0xf1, 0xf2, 0xff, 0xff, 0x65, 0xcd, 0x34, 0xfd, 0x11, 0xb3, 0xfe, 0x29, 0xd1, 0x6f, 0x94, 0xdf,
+	0x23, 0x73, 0xff, 0xae, 0x8c, 0xfe, 0x3f, 0x51, 0x95, 0xd0, 0x5f, 0xaf, 0x84, 0xae, 0xaa, 0xd3,
+	0xaa, 0x3a, 0x2d, 0x57, 0xa7, 0x55, 0xf5, 0xf8, 0x0d, 0x54, 0x8f, 0xaf, 0x26, 0xe2, 0xeb, 0xbb,
+	0xc9, 0xbf, 0x5e, 0x25, 0x73, 0xdb, 0x09, 0x8f, 0x0f, 0x5d, 0x71, 0x4a, 0x9f, 0x92, 0x1b, 0x6e,
+	0x96, 0x9e, 0xb0, 0x38, 0x0d, 0x3d, 0x38, 0x01, 0xe0, 0x7c, 0xbe, 0xd6, 0x7a, 0xe3, 0x1f, 0x5f,
+	0x6c, 0xde, 0x09, 0xc2, 0xf4, 0x24, 0xeb, 0x39, 0x1e, 0x1f, 0x34, 0x43, 0x3e, 0xfa, 0x3e, 0x8f,
+	0x59, 0xf3, 0x8c, 0xb9, 0x23, 0xe6, 0x6c, 0xf3, 0xd8, 0x0f, 0xc1, 0xc2, 0xa5, 0xd6, 0xdf, 0x8e,
+	0x3f, 0xe3, 0xf8, 0x88, 0xac, 0x5b, 0x4e, 0x9f, 0x7f, 0xb0, 0x2f, 0xbf, 0x93, 0xd6, 0x4c, 0xd4,
+	0x02, 0xbf, 0xfe, 0x7f, 0x3d, 0xdc, 0x27, 0xd7, 0x21, 0xf5, 0x72, 0xa3, 0x48, 0x25, 0x87, 0x4f,
+	0x30, 0x84, 0x41, 0xca, 0x25, 0xa5, 0xaa, 0xe1, 0xbc, 0xcc, 0xb5, 0xf0, 0x93, 0x32, 0xb2, 0x09,
+	0x65, 0x8d, 0xbe, 0x8e, 0x9c, 0x52, 0x3b, 0x7d, 0x84, 0x59, 0x90, 0xd4, 0xd3, 0xa1, 0x75, 0x4a,
+	0xf1, 0xb4, 0x2e, 0xf1, 0x0b, 0xe0, 0x57, 0xf5, 0xd0, 0xf0, 0x8a, 0x1f, 0x05, 0xd0, 0xb7, 0x5b,
+	0xb5, 0xcf, 0x5e, 0x34, 0x66, 0x3e, 0x7f, 0xd1, 0x98, 0xf9, 0xdb, 0x8b, 0xc6, 0xcc, 0x1f, 0x5e,
+	0x36, 0x2e, 0x7d, 0xfe, 0xb2, 0x71, 0xe9, 0x2f, 0x2f, 0x1b, 0x97, 0x7a, 0xaf, 0xc1, 0x3f, 0x28,
+	0xde, 0xff, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe9, 0xab, 0x3c, 0xcc, 0x1e, 0x3a, 0x00, 0x00,
 }
 
 func (m *Tx) Marshal() (dAtA []byte, err error) {
@@ -3685,86 +6547,47 @@ func (m *Tx_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, msg := range m.Messages {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+func (m *Tx_CashMultiSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashMultiSendMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMultiSendMsg.Size()))
+		n29, err := m.CashMultiSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n29
 	}
 	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Sum != nil {
-		nn29, err := m.Sum.MarshalTo(dAtA[i:])
+func (m *Tx_CashBurnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashBurnMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashBurnMsg.Size()))
+		n30, err := m.CashBurnMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn29
+		i += n30
 	}
 	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashMintMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.CashSendMsg != nil {
+	if m.CashMintMsg != nil {
 		dAtA[i] = 0x9a
 		i++
-		dAtA[i] = 0x3
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n30, err := m.CashSendMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n30
-	}
-	return i, nil
-}
-func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.EscrowCreateMsg != nil {
-		dAtA[i] = 0xa2
-		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowCreateMsg.Size()))
-		n31, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMintMsg.Size()))
+		n31, err := m.CashMintMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3772,15 +6595,15 @@ func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) MarshalTo(dAtA []byte) (int, err
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashApproveMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReleaseMsg != nil {
-		dAtA[i] = 0xaa
+	if m.CashApproveMsg != nil {
+		dAtA[i] = 0xa2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n32, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashApproveMsg.Size()))
+		n32, err := m.CashApproveMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3788,15 +6611,15 @@ func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, er
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashTransferFromMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReturnMsg != nil {
-		dAtA[i] = 0xb2
+	if m.CashTransferFromMsg != nil {
+		dAtA[i] = 0xaa
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n33, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashTransferFromMsg.Size()))
+		n33, err := m.CashTransferFromMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3804,15 +6627,15 @@ func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, err
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashCreateVestingMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowUpdatePartiesMsg != nil {
-		dAtA[i] = 0xba
+	if m.CashCreateVestingMsg != nil {
+		dAtA[i] = 0xb2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdatePartiesMsg.Size()))
-		n34, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashCreateVestingMsg.Size()))
+		n34, err := m.CashCreateVestingMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3820,15 +6643,15 @@ func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) MarshalTo(dAtA []byte) (i
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashReleaseVestingMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MultisigCreateMsg != nil {
-		dAtA[i] = 0xc2
+	if m.CashReleaseVestingMsg != nil {
+		dAtA[i] = 0xba
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
-		n35, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashReleaseVestingMsg.Size()))
+		n35, err := m.CashReleaseVestingMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3836,15 +6659,15 @@ func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, e
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashFreezeAccountMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MultisigUpdateMsg != nil {
-		dAtA[i] = 0xca
+	if m.CashFreezeAccountMsg != nil {
+		dAtA[i] = 0xc2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n36, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashFreezeAccountMsg.Size()))
+		n36, err := m.CashFreezeAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3852,15 +6675,15 @@ func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, e
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashUnfreezeAccountMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.ValidatorsApplyDiffMsg != nil {
-		dAtA[i] = 0xd2
+	if m.CashUnfreezeAccountMsg != nil {
+		dAtA[i] = 0xca
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n37, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashUnfreezeAccountMsg.Size()))
+		n37, err := m.CashUnfreezeAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3868,15 +6691,15 @@ func (m *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (i
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashGrantFeeMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.CurrencyCreateMsg != nil {
-		dAtA[i] = 0xda
+	if m.CashGrantFeeMsg != nil {
+		dAtA[i] = 0xd2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n38, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashGrantFeeMsg.Size()))
+		n38, err := m.CashGrantFeeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3884,15 +6707,15 @@ func (m *ExecuteBatchMsg_Union_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, e
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashScheduleSendMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.UsernameRegisterTokenMsg != nil {
-		dAtA[i] = 0xea
+	if m.CashScheduleSendMsg != nil {
+		dAtA[i] = 0xda
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n39, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashScheduleSendMsg.Size()))
+		n39, err := m.CashScheduleSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3900,15 +6723,15 @@ func (m *ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte)
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashCancelScheduledSendMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.UsernameTransferTokenMsg != nil {
-		dAtA[i] = 0xf2
+	if m.CashCancelScheduledSendMsg != nil {
+		dAtA[i] = 0xe2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n40, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashCancelScheduledSendMsg.Size()))
+		n40, err := m.CashCancelScheduledSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3916,15 +6739,15 @@ func (m *ExecuteBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte)
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_CashSetWalletNameMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.UsernameChangeTokenTargetsMsg != nil {
-		dAtA[i] = 0xfa
+	if m.CashSetWalletNameMsg != nil {
+		dAtA[i] = 0xf2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n41, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSetWalletNameMsg.Size()))
+		n41, err := m.CashSetWalletNameMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3932,15 +6755,15 @@ func (m *ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []b
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_EscrowReleaseMilestoneMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DistributionCreateMsg != nil {
-		dAtA[i] = 0x92
+	if m.EscrowReleaseMilestoneMsg != nil {
+		dAtA[i] = 0xfa
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n42, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMilestoneMsg.Size()))
+		n42, err := m.EscrowReleaseMilestoneMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3948,15 +6771,15 @@ func (m *ExecuteBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (in
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_EscrowApproveMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DistributionMsg != nil {
-		dAtA[i] = 0x9a
+	if m.EscrowApproveMsg != nil {
+		dAtA[i] = 0x82
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n43, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowApproveMsg.Size()))
+		n43, err := m.EscrowApproveMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3964,15 +6787,15 @@ func (m *ExecuteBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, err
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_EscrowUpdateEscrowTimeoutMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DistributionResetMsg != nil {
-		dAtA[i] = 0xa2
+	if m.EscrowUpdateEscrowTimeoutMsg != nil {
+		dAtA[i] = 0x8a
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n44, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdateEscrowTimeoutMsg.Size()))
+		n44, err := m.EscrowUpdateEscrowTimeoutMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3980,15 +6803,15 @@ func (m *ExecuteBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int
 	}
 	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_EscrowRaiseDisputeMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MsgfeeSetMsgFeeMsg != nil {
-		dAtA[i] = 0x82
+	if m.EscrowRaiseDisputeMsg != nil {
+		dAtA[i] = 0x92
 		i++
-		dAtA[i] = 0x5
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
-		n45, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowRaiseDisputeMsg.Size()))
+		n45, err := m.EscrowRaiseDisputeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -3996,40 +6819,31 @@ func (m *ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int,
 	}
 	return i, nil
 }
-func (m *ProposalOptions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ProposalOptions) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Option != nil {
-		nn46, err := m.Option.MarshalTo(dAtA[i:])
+func (m *Tx_EscrowResolveDisputeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowResolveDisputeMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowResolveDisputeMsg.Size()))
+		n46, err := m.EscrowResolveDisputeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn46
+		i += n46
 	}
 	return i, nil
 }
-
-func (m *ProposalOptions_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_EscrowUpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.CashSendMsg != nil {
-		dAtA[i] = 0x9a
+	if m.EscrowUpdateEscrowPartiesMsg != nil {
+		dAtA[i] = 0xa2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
-		n47, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdateEscrowPartiesMsg.Size()))
+		n47, err := m.EscrowUpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4037,15 +6851,15 @@ func (m *ProposalOptions_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
-func (m *ProposalOptions_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MultisigCreateProposalMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReleaseMsg != nil {
+	if m.MultisigCreateProposalMsg != nil {
 		dAtA[i] = 0xaa
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n48, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateProposalMsg.Size()))
+		n48, err := m.MultisigCreateProposalMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4053,15 +6867,15 @@ func (m *ProposalOptions_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
-func (m *ProposalOptions_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MultisigApproveProposalMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.UpdateEscrowPartiesMsg != nil {
-		dAtA[i] = 0xba
+	if m.MultisigApproveProposalMsg != nil {
+		dAtA[i] = 0xb2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
-		n49, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigApproveProposalMsg.Size()))
+		n49, err := m.MultisigApproveProposalMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4069,15 +6883,15 @@ func (m *ProposalOptions_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, er
 	}
 	return i, nil
 }
-func (m *ProposalOptions_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MultisigApplyUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MultisigUpdateMsg != nil {
-		dAtA[i] = 0xca
+	if m.MultisigApplyUpdateMsg != nil {
+		dAtA[i] = 0xba
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
-		n50, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigApplyUpdateMsg.Size()))
+		n50, err := m.MultisigApplyUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4085,15 +6899,15 @@ func (m *ProposalOptions_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error)
 	}
 	return i, nil
 }
-func (m *ProposalOptions_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MultisigVetoUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.ValidatorsApplyDiffMsg != nil {
-		dAtA[i] = 0xd2
+	if m.MultisigVetoUpdateMsg != nil {
+		dAtA[i] = 0xc2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
-		n51, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigVetoUpdateMsg.Size()))
+		n51, err := m.MultisigVetoUpdateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4101,15 +6915,15 @@ func (m *ProposalOptions_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, er
 	}
 	return i, nil
 }
-func (m *ProposalOptions_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MultisigExpireProposalMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.CurrencyCreateMsg != nil {
-		dAtA[i] = 0xda
+	if m.MultisigExpireProposalMsg != nil {
+		dAtA[i] = 0xca
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
-		n52, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigExpireProposalMsg.Size()))
+		n52, err := m.MultisigExpireProposalMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4117,15 +6931,15 @@ func (m *ProposalOptions_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error)
 	}
 	return i, nil
 }
-func (m *ProposalOptions_ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_SigsRotateKeyMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.ExecuteProposalBatchMsg != nil {
-		dAtA[i] = 0xe2
+	if m.SigsRotateKeyMsg != nil {
+		dAtA[i] = 0xd2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteProposalBatchMsg.Size()))
-		n53, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.SigsRotateKeyMsg.Size()))
+		n53, err := m.SigsRotateKeyMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4133,15 +6947,15 @@ func (m *ProposalOptions_ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, e
 	}
 	return i, nil
 }
-func (m *ProposalOptions_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_ValidatorsSetBlsKeyMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.UsernameRegisterTokenMsg != nil {
-		dAtA[i] = 0xea
+	if m.ValidatorsSetBlsKeyMsg != nil {
+		dAtA[i] = 0xda
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n54, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsSetBlsKeyMsg.Size()))
+		n54, err := m.ValidatorsSetBlsKeyMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4149,15 +6963,15 @@ func (m *ProposalOptions_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int,
 	}
 	return i, nil
 }
-func (m *ProposalOptions_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_GovDelegateVoteMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.UsernameTransferTokenMsg != nil {
-		dAtA[i] = 0xf2
+	if m.GovDelegateVoteMsg != nil {
+		dAtA[i] = 0xea
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n55, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovDelegateVoteMsg.Size()))
+		n55, err := m.GovDelegateVoteMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4165,15 +6979,15 @@ func (m *ProposalOptions_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int,
 	}
 	return i, nil
 }
-func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_GovRevokeDelegationMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.UsernameChangeTokenTargetsMsg != nil {
-		dAtA[i] = 0xfa
+	if m.GovRevokeDelegationMsg != nil {
+		dAtA[i] = 0xf2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x6
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n56, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovRevokeDelegationMsg.Size()))
+		n56, err := m.GovRevokeDelegationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4181,15 +6995,15 @@ func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (
 	}
 	return i, nil
 }
-func (m *ProposalOptions_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MigrationMigrateBucketMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DistributionCreateMsg != nil {
-		dAtA[i] = 0x92
+	if m.MigrationMigrateBucketMsg != nil {
+		dAtA[i] = 0x82
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x7
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n57, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationMigrateBucketMsg.Size()))
+		n57, err := m.MigrationMigrateBucketMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4197,15 +7011,15 @@ func (m *ProposalOptions_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, err
 	}
 	return i, nil
 }
-func (m *ProposalOptions_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MigrationDowngradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DistributionMsg != nil {
-		dAtA[i] = 0x9a
+	if m.MigrationDowngradeSchemaMsg != nil {
+		dAtA[i] = 0x8a
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x7
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n58, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationDowngradeSchemaMsg.Size()))
+		n58, err := m.MigrationDowngradeSchemaMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4213,15 +7027,15 @@ func (m *ProposalOptions_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
-func (m *ProposalOptions_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DistributionResetMsg != nil {
-		dAtA[i] = 0xa2
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x92
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x7
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n59, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
+		n59, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4229,15 +7043,15 @@ func (m *ProposalOptions_DistributionResetMsg) MarshalTo(dAtA []byte) (int, erro
 	}
 	return i, nil
 }
-func (m *ProposalOptions_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_FeatureScheduleFeatureMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MigrationUpgradeSchemaMsg != nil {
-		dAtA[i] = 0xaa
+	if m.FeatureScheduleFeatureMsg != nil {
+		dAtA[i] = 0x9a
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x7
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
-		n60, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.FeatureScheduleFeatureMsg.Size()))
+		n60, err := m.FeatureScheduleFeatureMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4245,15 +7059,15 @@ func (m *ProposalOptions_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int,
 	}
 	return i, nil
 }
-func (m *ProposalOptions_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Tx_FeatureUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.GovUpdateElectorateMsg != nil {
-		dAtA[i] = 0xea
+	if m.FeatureUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xa2
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x7
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n61, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.FeatureUpdateConfigurationMsg.Size()))
+		n61, err := m.FeatureUpdateConfigurationMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4261,65 +7075,17 @@ func (m *ProposalOptions_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, er
 	}
 	return i, nil
 }
-func (m *ProposalOptions_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.GovUpdateElectionRuleMsg != nil {
-		dAtA[i] = 0xf2
-		i++
-		dAtA[i] = 0x4
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n62, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n62
+func (m *ExecuteBatchMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return i, nil
-}
-func (m *ProposalOptions_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.GovCreateTextResolutionMsg != nil {
-		dAtA[i] = 0xfa
-		i++
-		dAtA[i] = 0x4
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
-		n63, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n63
-	}
-	return i, nil
-}
-func (m *ProposalOptions_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.MsgfeeSetMsgFeeMsg != nil {
-		dAtA[i] = 0x82
-		i++
-		dAtA[i] = 0x5
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
-		n64, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n64
-	}
-	return i, nil
-}
-func (m *ExecuteProposalBatchMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return dAtA[:n], nil
 }
 
-func (m *ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -4336,10 +7102,15 @@ func (m *ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Mode != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Mode))
+	}
 	return i, nil
 }
 
-func (m *ExecuteProposalBatchMsg_Union) Marshal() (dAtA []byte, err error) {
+func (m *ExecuteBatchMsg_Union) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -4349,38 +7120,54 @@ func (m *ExecuteProposalBatchMsg_Union) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExecuteProposalBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	if m.Sum != nil {
-		nn65, err := m.Sum.MarshalTo(dAtA[i:])
+		nn62, err := m.Sum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn65
+		i += nn62
 	}
 	return i, nil
 }
 
-func (m *ExecuteProposalBatchMsg_Union_SendMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.SendMsg != nil {
+	if m.CashSendMsg != nil {
 		dAtA[i] = 0x9a
 		i++
 		dAtA[i] = 0x3
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.SendMsg.Size()))
-		n66, err := m.SendMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
+		n63, err := m.CashSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n63
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowCreateMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowCreateMsg.Size()))
+		n64, err := m.EscrowCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.EscrowReleaseMsg != nil {
 		dAtA[i] = 0xaa
@@ -4388,23 +7175,55 @@ func (m *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte)
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n67, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		n65, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n65
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.UpdateEscrowPartiesMsg != nil {
+	if m.EscrowReturnMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
+		n66, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowUpdatePartiesMsg != nil {
 		dAtA[i] = 0xba
 		i++
 		dAtA[i] = 0x3
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
-		n68, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdatePartiesMsg.Size()))
+		n67, err := m.EscrowUpdatePartiesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n67
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigCreateMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateMsg.Size()))
+		n68, err := m.MultisigCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4412,7 +7231,7 @@ func (m *ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) MarshalTo(dAtA []
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.MultisigUpdateMsg != nil {
 		dAtA[i] = 0xca
@@ -4428,7 +7247,7 @@ func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte)
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.ValidatorsApplyDiffMsg != nil {
 		dAtA[i] = 0xd2
@@ -4444,7 +7263,23 @@ func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CurrencyCreateMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
+		n71, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n71
+	}
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.UsernameRegisterTokenMsg != nil {
 		dAtA[i] = 0xea
@@ -4452,15 +7287,15 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
-		n71, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		n72, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n72
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.UsernameTransferTokenMsg != nil {
 		dAtA[i] = 0xf2
@@ -4468,15 +7303,15 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
-		n72, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		n73, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n73
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.UsernameChangeTokenTargetsMsg != nil {
 		dAtA[i] = 0xfa
@@ -4484,15 +7319,15 @@ func (m *ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(
 		dAtA[i] = 0x3
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
-		n73, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		n74, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n74
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.DistributionCreateMsg != nil {
 		dAtA[i] = 0x92
@@ -4500,15 +7335,15 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []b
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
-		n74, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		n75, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n75
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.DistributionMsg != nil {
 		dAtA[i] = 0x9a
@@ -4516,15 +7351,15 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
-		n75, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		n76, err := m.DistributionMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n76
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
 	if m.DistributionResetMsg != nil {
 		dAtA[i] = 0xa2
@@ -4532,23 +7367,7 @@ func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []by
 		dAtA[i] = 0x4
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
-		n76, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n76
-	}
-	return i, nil
-}
-func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
-	i := 0
-	if m.GovUpdateElectorateMsg != nil {
-		dAtA[i] = 0xea
-		i++
-		dAtA[i] = 0x4
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
-		n77, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		n77, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4556,15 +7375,15 @@ func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) MarshalTo(dAtA []
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.GovUpdateElectionRuleMsg != nil {
-		dAtA[i] = 0xf2
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		dAtA[i] = 0x82
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
-		n78, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
+		n78, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4572,15 +7391,15 @@ func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) MarshalTo(dAtA
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CashMultiSendMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.GovCreateTextResolutionMsg != nil {
-		dAtA[i] = 0xfa
+	if m.CashMultiSendMsg != nil {
+		dAtA[i] = 0x8a
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
-		n79, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMultiSendMsg.Size()))
+		n79, err := m.CashMultiSendMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4588,15 +7407,15 @@ func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) MarshalTo(dAt
 	}
 	return i, nil
 }
-func (m *ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CashBurnMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.MsgfeeSetMsgFeeMsg != nil {
-		dAtA[i] = 0x82
+	if m.CashBurnMsg != nil {
+		dAtA[i] = 0x92
 		i++
 		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
-		n80, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashBurnMsg.Size()))
+		n80, err := m.CashBurnMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4604,48 +7423,31 @@ func (m *ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte
 	}
 	return i, nil
 }
-func (m *CronTask) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Authenticators) > 0 {
-		for _, b := range m.Authenticators {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
-			i += copy(dAtA[i:], b)
-		}
-	}
-	if m.Sum != nil {
-		nn81, err := m.Sum.MarshalTo(dAtA[i:])
+func (m *ExecuteBatchMsg_Union_CashMintMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashMintMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMintMsg.Size()))
+		n81, err := m.CashMintMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn81
+		i += n81
 	}
 	return i, nil
 }
-
-func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CashApproveMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReleaseMsg != nil {
-		dAtA[i] = 0xaa
+	if m.CashApproveMsg != nil {
+		dAtA[i] = 0xa2
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
-		n82, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashApproveMsg.Size()))
+		n82, err := m.CashApproveMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4653,15 +7455,15 @@ func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
-func (m *CronTask_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CashTransferFromMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.EscrowReturnMsg != nil {
-		dAtA[i] = 0xb2
+	if m.CashTransferFromMsg != nil {
+		dAtA[i] = 0xaa
 		i++
-		dAtA[i] = 0x3
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
-		n83, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashTransferFromMsg.Size()))
+		n83, err := m.CashTransferFromMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4669,15 +7471,15 @@ func (m *CronTask_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
-func (m *CronTask_DistributionDistributeMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CashCreateVestingMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.DistributionDistributeMsg != nil {
-		dAtA[i] = 0x9a
+	if m.CashCreateVestingMsg != nil {
+		dAtA[i] = 0xb2
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionDistributeMsg.Size()))
-		n84, err := m.DistributionDistributeMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashCreateVestingMsg.Size()))
+		n84, err := m.CashCreateVestingMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4685,15 +7487,15 @@ func (m *CronTask_DistributionDistributeMsg) MarshalTo(dAtA []byte) (int, error)
 	}
 	return i, nil
 }
-func (m *CronTask_AswapReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CashReleaseVestingMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.AswapReleaseMsg != nil {
+	if m.CashReleaseVestingMsg != nil {
 		dAtA[i] = 0xba
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReleaseMsg.Size()))
-		n85, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashReleaseVestingMsg.Size()))
+		n85, err := m.CashReleaseVestingMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4701,15 +7503,15 @@ func (m *CronTask_AswapReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
-func (m *CronTask_GovTallyMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecuteBatchMsg_Union_CashFreezeAccountMsg) MarshalTo(dAtA []byte) (int, error) {
 	i := 0
-	if m.GovTallyMsg != nil {
-		dAtA[i] = 0xe2
+	if m.CashFreezeAccountMsg != nil {
+		dAtA[i] = 0xc2
 		i++
-		dAtA[i] = 0x4
+		dAtA[i] = 0x5
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.GovTallyMsg.Size()))
-		n86, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashFreezeAccountMsg.Size()))
+		n86, err := m.CashFreezeAccountMsg.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
@@ -4717,719 +7519,1717 @@ func (m *CronTask_GovTallyMsg) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ExecuteBatchMsg_Union_CashUnfreezeAccountMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashUnfreezeAccountMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashUnfreezeAccountMsg.Size()))
+		n87, err := m.CashUnfreezeAccountMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n87
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return i, nil
 }
-func (m *Tx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Fees != nil {
-		l = m.Fees.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if len(m.Signatures) > 0 {
-		for _, e := range m.Signatures {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+func (m *ExecuteBatchMsg_Union_CashGrantFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashGrantFeeMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashGrantFeeMsg.Size()))
+		n88, err := m.CashGrantFeeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n88
 	}
-	if len(m.Multisig) > 0 {
-		for _, b := range m.Multisig {
-			l = len(b)
-			n += 1 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CashScheduleSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashScheduleSendMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashScheduleSendMsg.Size()))
+		n89, err := m.CashScheduleSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n89
 	}
-	if m.Sum != nil {
-		n += m.Sum.Size()
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_CashCancelScheduledSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashCancelScheduledSendMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashCancelScheduledSendMsg.Size()))
+		n90, err := m.CashCancelScheduledSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n90
 	}
-	return n
+	return i, nil
 }
-
-func (m *Tx_CashSendMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteBatchMsg_Union_CashSetWalletNameMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashSetWalletNameMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSetWalletNameMsg.Size()))
+		n91, err := m.CashSetWalletNameMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n91
 	}
-	var l int
-	_ = l
-	if m.CashSendMsg != nil {
-		l = m.CashSendMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_EscrowReleaseMilestoneMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReleaseMilestoneMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMilestoneMsg.Size()))
+		n92, err := m.EscrowReleaseMilestoneMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n92
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_EscrowCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteBatchMsg_Union_EscrowApproveMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowApproveMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowApproveMsg.Size()))
+		n93, err := m.EscrowApproveMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n93
 	}
-	var l int
-	_ = l
-	if m.EscrowCreateMsg != nil {
-		l = m.EscrowCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_EscrowUpdateEscrowTimeoutMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowUpdateEscrowTimeoutMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdateEscrowTimeoutMsg.Size()))
+		n94, err := m.EscrowUpdateEscrowTimeoutMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n94
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_EscrowReleaseMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteBatchMsg_Union_EscrowRaiseDisputeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowRaiseDisputeMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowRaiseDisputeMsg.Size()))
+		n95, err := m.EscrowRaiseDisputeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n95
 	}
-	var l int
-	_ = l
-	if m.EscrowReleaseMsg != nil {
-		l = m.EscrowReleaseMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_EscrowResolveDisputeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowResolveDisputeMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowResolveDisputeMsg.Size()))
+		n96, err := m.EscrowResolveDisputeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n96
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_EscrowReturnMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteBatchMsg_Union_EscrowUpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowUpdateEscrowPartiesMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdateEscrowPartiesMsg.Size()))
+		n97, err := m.EscrowUpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n97
 	}
-	var l int
-	_ = l
-	if m.EscrowReturnMsg != nil {
-		l = m.EscrowReturnMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MultisigCreateProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigCreateProposalMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigCreateProposalMsg.Size()))
+		n98, err := m.MultisigCreateProposalMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n98
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_EscrowUpdatePartiesMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteBatchMsg_Union_MultisigApproveProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigApproveProposalMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigApproveProposalMsg.Size()))
+		n99, err := m.MultisigApproveProposalMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n99
 	}
-	var l int
-	_ = l
-	if m.EscrowUpdatePartiesMsg != nil {
-		l = m.EscrowUpdatePartiesMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MultisigApplyUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigApplyUpdateMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigApplyUpdateMsg.Size()))
+		n100, err := m.MultisigApplyUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n100
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_MultisigCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteBatchMsg_Union_MultisigVetoUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigVetoUpdateMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigVetoUpdateMsg.Size()))
+		n101, err := m.MultisigVetoUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n101
 	}
-	var l int
-	_ = l
-	if m.MultisigCreateMsg != nil {
-		l = m.MultisigCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_MultisigExpireProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigExpireProposalMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigExpireProposalMsg.Size()))
+		n102, err := m.MultisigExpireProposalMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n102
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_MultisigUpdateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteBatchMsg_Union_SigsRotateKeyMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SigsRotateKeyMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SigsRotateKeyMsg.Size()))
+		n103, err := m.SigsRotateKeyMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n103
 	}
-	var l int
-	_ = l
-	if m.MultisigUpdateMsg != nil {
-		l = m.MultisigUpdateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ExecuteBatchMsg_Union_ValidatorsSetBlsKeyMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ValidatorsSetBlsKeyMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsSetBlsKeyMsg.Size()))
+		n104, err := m.ValidatorsSetBlsKeyMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n104
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_ValidatorsApplyDiffMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposalOptions) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.ValidatorsApplyDiffMsg != nil {
-		l = m.ValidatorsApplyDiffMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	if m.Option != nil {
+		nn105, err := m.Option.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn105
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_CurrencyCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CurrencyCreateMsg != nil {
-		l = m.CurrencyCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+
+func (m *ProposalOptions_CashSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashSendMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSendMsg.Size()))
+		n106, err := m.CashSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n106
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_ExecuteBatchMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReleaseMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
+		n107, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n107
 	}
-	var l int
-	_ = l
-	if m.ExecuteBatchMsg != nil {
-		l = m.ExecuteBatchMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UpdateEscrowPartiesMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
+		n108, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n108
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_UsernameRegisterTokenMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigUpdateMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
+		n109, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n109
 	}
-	var l int
-	_ = l
-	if m.UsernameRegisterTokenMsg != nil {
-		l = m.UsernameRegisterTokenMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ValidatorsApplyDiffMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
+		n110, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n110
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_UsernameTransferTokenMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_CurrencyCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CurrencyCreateMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CurrencyCreateMsg.Size()))
+		n111, err := m.CurrencyCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n111
 	}
-	var l int
-	_ = l
-	if m.UsernameTransferTokenMsg != nil {
-		l = m.UsernameTransferTokenMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ExecuteProposalBatchMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecuteProposalBatchMsg.Size()))
+		n112, err := m.ExecuteProposalBatchMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n112
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_UsernameChangeTokenTargetsMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UsernameRegisterTokenMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
+		n113, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n113
 	}
-	var l int
-	_ = l
-	if m.UsernameChangeTokenTargetsMsg != nil {
-		l = m.UsernameChangeTokenTargetsMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UsernameTransferTokenMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
+		n114, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n114
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_DistributionCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UsernameChangeTokenTargetsMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
+		n115, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n115
 	}
-	var l int
-	_ = l
+	return i, nil
+}
+func (m *ProposalOptions_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
 	if m.DistributionCreateMsg != nil {
-		l = m.DistributionCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
+		n116, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n116
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_DistributionMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *ProposalOptions_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
 	if m.DistributionMsg != nil {
-		l = m.DistributionMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
+		n117, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n117
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_DistributionResetMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *ProposalOptions_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
 	if m.DistributionResetMsg != nil {
-		l = m.DistributionResetMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
+		n118, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n118
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_MigrationUpgradeSchemaMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *ProposalOptions_MigrationUpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
 	if m.MigrationUpgradeSchemaMsg != nil {
-		l = m.MigrationUpgradeSchemaMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationUpgradeSchemaMsg.Size()))
+		n119, err := m.MigrationUpgradeSchemaMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n119
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_AswapCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AswapCreateMsg != nil {
-		l = m.AswapCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
-}
-func (m *Tx_AswapReleaseMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AswapReleaseMsg != nil {
-		l = m.AswapReleaseMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ProposalOptions_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectorateMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
+		n120, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n120
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_AswapReturnMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectionRuleMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
+		n121, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n121
 	}
-	var l int
-	_ = l
-	if m.AswapReturnMsg != nil {
-		l = m.AswapReturnMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovCreateTextResolutionMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
+		n122, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n122
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_GovCreateProposalMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
+		n123, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n123
 	}
-	var l int
-	_ = l
-	if m.GovCreateProposalMsg != nil {
-		l = m.GovCreateProposalMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_CashMultiSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashMultiSendMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMultiSendMsg.Size()))
+		n124, err := m.CashMultiSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n124
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_GovDeleteProposalMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_CashBurnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashBurnMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashBurnMsg.Size()))
+		n125, err := m.CashBurnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n125
 	}
-	var l int
-	_ = l
-	if m.GovDeleteProposalMsg != nil {
-		l = m.GovDeleteProposalMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_CashMintMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashMintMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashMintMsg.Size()))
+		n126, err := m.CashMintMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n126
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_GovVoteMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_CashApproveMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashApproveMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashApproveMsg.Size()))
+		n127, err := m.CashApproveMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n127
 	}
-	var l int
-	_ = l
-	if m.GovVoteMsg != nil {
-		l = m.GovVoteMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_CashTransferFromMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashTransferFromMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashTransferFromMsg.Size()))
+		n128, err := m.CashTransferFromMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n128
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_GovUpdateElectorateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_CashCreateVestingMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashCreateVestingMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashCreateVestingMsg.Size()))
+		n129, err := m.CashCreateVestingMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n129
 	}
-	var l int
-	_ = l
-	if m.GovUpdateElectorateMsg != nil {
-		l = m.GovUpdateElectorateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_CashReleaseVestingMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashReleaseVestingMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashReleaseVestingMsg.Size()))
+		n130, err := m.CashReleaseVestingMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n130
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_GovUpdateElectionRuleMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_CashFreezeAccountMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashFreezeAccountMsg != nil {
+		dAtA[i] = 0xc2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashFreezeAccountMsg.Size()))
+		n131, err := m.CashFreezeAccountMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n131
 	}
-	var l int
-	_ = l
-	if m.GovUpdateElectionRuleMsg != nil {
-		l = m.GovUpdateElectionRuleMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_CashUnfreezeAccountMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashUnfreezeAccountMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashUnfreezeAccountMsg.Size()))
+		n132, err := m.CashUnfreezeAccountMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n132
 	}
-	return n
+	return i, nil
 }
-func (m *Tx_MsgfeeSetMsgFeeMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_CashGrantFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashGrantFeeMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashGrantFeeMsg.Size()))
+		n133, err := m.CashGrantFeeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n133
 	}
-	var l int
-	_ = l
-	if m.MsgfeeSetMsgFeeMsg != nil {
-		l = m.MsgfeeSetMsgFeeMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_CashScheduleSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashScheduleSendMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashScheduleSendMsg.Size()))
+		n134, err := m.CashScheduleSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n134
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_CashCancelScheduledSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashCancelScheduledSendMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashCancelScheduledSendMsg.Size()))
+		n135, err := m.CashCancelScheduledSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n135
 	}
-	var l int
-	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_CashSetWalletNameMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashSetWalletNameMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashSetWalletNameMsg.Size()))
+		n136, err := m.CashSetWalletNameMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n136
 	}
-	return n
+	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
+func (m *ProposalOptions_EscrowReleaseMilestoneMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReleaseMilestoneMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMilestoneMsg.Size()))
+		n137, err := m.EscrowReleaseMilestoneMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n137
 	}
-	return n
+	return i, nil
 }
-
-func (m *ExecuteBatchMsg_Union_CashSendMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CashSendMsg != nil {
-		l = m.CashSendMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ProposalOptions_EscrowApproveMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowApproveMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowApproveMsg.Size()))
+		n138, err := m.EscrowApproveMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n138
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EscrowCreateMsg != nil {
-		l = m.EscrowCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ProposalOptions_EscrowUpdateEscrowTimeoutMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowUpdateEscrowTimeoutMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdateEscrowTimeoutMsg.Size()))
+		n139, err := m.EscrowUpdateEscrowTimeoutMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n139
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EscrowReleaseMsg != nil {
-		l = m.EscrowReleaseMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ProposalOptions_EscrowRaiseDisputeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowRaiseDisputeMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowRaiseDisputeMsg.Size()))
+		n140, err := m.EscrowRaiseDisputeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n140
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_EscrowResolveDisputeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowResolveDisputeMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowResolveDisputeMsg.Size()))
+		n141, err := m.EscrowResolveDisputeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n141
 	}
-	var l int
-	_ = l
-	if m.EscrowReturnMsg != nil {
-		l = m.EscrowReturnMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_EscrowUpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowUpdateEscrowPartiesMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowUpdateEscrowPartiesMsg.Size()))
+		n142, err := m.EscrowUpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n142
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_ValidatorsSetBlsKeyMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ValidatorsSetBlsKeyMsg != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsSetBlsKeyMsg.Size()))
+		n143, err := m.ValidatorsSetBlsKeyMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n143
 	}
-	var l int
-	_ = l
-	if m.EscrowUpdatePartiesMsg != nil {
-		l = m.EscrowUpdatePartiesMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_GovChangeParametersMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovChangeParametersMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovChangeParametersMsg.Size()))
+		n144, err := m.GovChangeParametersMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n144
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_GovTextProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovTextProposalMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovTextProposalMsg.Size()))
+		n145, err := m.GovTextProposalMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n145
 	}
-	var l int
-	_ = l
-	if m.MultisigCreateMsg != nil {
-		l = m.MultisigCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_MigrationMigrateBucketMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MigrationMigrateBucketMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationMigrateBucketMsg.Size()))
+		n146, err := m.MigrationMigrateBucketMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n146
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_MigrationDowngradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MigrationDowngradeSchemaMsg != nil {
+		dAtA[i] = 0x8a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MigrationDowngradeSchemaMsg.Size()))
+		n147, err := m.MigrationDowngradeSchemaMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n147
 	}
-	var l int
-	_ = l
-	if m.MultisigUpdateMsg != nil {
-		l = m.MultisigUpdateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_MsgfeeUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeUpdateConfigurationMsg.Size()))
+		n148, err := m.MsgfeeUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n148
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProposalOptions_FeatureScheduleFeatureMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.FeatureScheduleFeatureMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.FeatureScheduleFeatureMsg.Size()))
+		n149, err := m.FeatureScheduleFeatureMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n149
 	}
-	var l int
-	_ = l
-	if m.ValidatorsApplyDiffMsg != nil {
-		l = m.ValidatorsApplyDiffMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	return i, nil
+}
+func (m *ProposalOptions_FeatureUpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.FeatureUpdateConfigurationMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x7
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.FeatureUpdateConfigurationMsg.Size()))
+		n150, err := m.FeatureUpdateConfigurationMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n150
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_CurrencyCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteProposalBatchMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteProposalBatchMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.CurrencyCreateMsg != nil {
-		l = m.CurrencyCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	if len(m.Messages) > 0 {
+		for _, msg := range m.Messages {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ExecuteProposalBatchMsg_Union) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteProposalBatchMsg_Union) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.UsernameRegisterTokenMsg != nil {
-		l = m.UsernameRegisterTokenMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	if m.Sum != nil {
+		nn151, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn151
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_UsernameTransferTokenMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UsernameTransferTokenMsg != nil {
-		l = m.UsernameTransferTokenMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+
+func (m *ExecuteProposalBatchMsg_Union_SendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.SendMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SendMsg.Size()))
+		n152, err := m.SendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n152
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UsernameChangeTokenTargetsMsg != nil {
-		l = m.UsernameChangeTokenTargetsMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReleaseMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
+		n153, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n153
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_DistributionCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DistributionCreateMsg != nil {
-		l = m.DistributionCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UpdateEscrowPartiesMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateEscrowPartiesMsg.Size()))
+		n154, err := m.UpdateEscrowPartiesMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n154
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_DistributionMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DistributionMsg != nil {
-		l = m.DistributionMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigUpdateMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigUpdateMsg.Size()))
+		n155, err := m.MultisigUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n155
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_DistributionResetMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DistributionResetMsg != nil {
-		l = m.DistributionResetMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.ValidatorsApplyDiffMsg != nil {
+		dAtA[i] = 0xd2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidatorsApplyDiffMsg.Size()))
+		n156, err := m.ValidatorsApplyDiffMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n156
 	}
-	return n
+	return i, nil
 }
-func (m *ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MsgfeeSetMsgFeeMsg != nil {
-		l = m.MsgfeeSetMsgFeeMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UsernameRegisterTokenMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameRegisterTokenMsg.Size()))
+		n157, err := m.UsernameRegisterTokenMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n157
 	}
-	return n
+	return i, nil
 }
-func (m *ProposalOptions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Option != nil {
-		n += m.Option.Size()
+func (m *ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UsernameTransferTokenMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameTransferTokenMsg.Size()))
+		n158, err := m.UsernameTransferTokenMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n158
 	}
-	return n
+	return i, nil
 }
-
-func (m *ProposalOptions_CashSendMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CashSendMsg != nil {
-		l = m.CashSendMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.UsernameChangeTokenTargetsMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UsernameChangeTokenTargetsMsg.Size()))
+		n159, err := m.UsernameChangeTokenTargetsMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n159
 	}
-	return n
+	return i, nil
 }
-func (m *ProposalOptions_EscrowReleaseMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EscrowReleaseMsg != nil {
-		l = m.EscrowReleaseMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionCreateMsg != nil {
+		dAtA[i] = 0x92
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionCreateMsg.Size()))
+		n160, err := m.DistributionCreateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n160
 	}
-	return n
+	return i, nil
 }
-func (m *ProposalOptions_UpdateEscrowPartiesMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.UpdateEscrowPartiesMsg != nil {
-		l = m.UpdateEscrowPartiesMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionMsg.Size()))
+		n161, err := m.DistributionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n161
 	}
-	return n
+	return i, nil
 }
-func (m *ProposalOptions_MultisigUpdateMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MultisigUpdateMsg != nil {
-		l = m.MultisigUpdateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionResetMsg != nil {
+		dAtA[i] = 0xa2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionResetMsg.Size()))
+		n162, err := m.DistributionResetMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n162
 	}
-	return n
+	return i, nil
 }
-func (m *ProposalOptions_ValidatorsApplyDiffMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ValidatorsApplyDiffMsg != nil {
-		l = m.ValidatorsApplyDiffMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
-	}
-	return n
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectorateMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectorateMsg.Size()))
+		n163, err := m.GovUpdateElectorateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n163
+	}
+	return i, nil
 }
-func (m *ProposalOptions_CurrencyCreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovUpdateElectionRuleMsg != nil {
+		dAtA[i] = 0xf2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovUpdateElectionRuleMsg.Size()))
+		n164, err := m.GovUpdateElectionRuleMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n164
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovCreateTextResolutionMsg != nil {
+		dAtA[i] = 0xfa
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovCreateTextResolutionMsg.Size()))
+		n165, err := m.GovCreateTextResolutionMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n165
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		dAtA[i] = 0x82
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MsgfeeSetMsgFeeMsg.Size()))
+		n166, err := m.MsgfeeSetMsgFeeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n166
+	}
+	return i, nil
+}
+func (m *ExecuteProposalBatchMsg_Union_GovChangeParametersMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovChangeParametersMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovChangeParametersMsg.Size()))
+		n167, err := m.GovChangeParametersMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n167
+	}
+	return i, nil
+}
+func (m *CronTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CronTask) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.CurrencyCreateMsg != nil {
-		l = m.CurrencyCreateMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	if len(m.Authenticators) > 0 {
+		for _, b := range m.Authenticators {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
-	return n
+	if m.Sum != nil {
+		nn168, err := m.Sum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += nn168
+	}
+	return i, nil
 }
-func (m *ProposalOptions_ExecuteProposalBatchMsg) Size() (n int) {
+
+func (m *CronTask_EscrowReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReleaseMsg != nil {
+		dAtA[i] = 0xaa
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReleaseMsg.Size()))
+		n169, err := m.EscrowReleaseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n169
+	}
+	return i, nil
+}
+func (m *CronTask_EscrowReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.EscrowReturnMsg != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x3
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.EscrowReturnMsg.Size()))
+		n170, err := m.EscrowReturnMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n170
+	}
+	return i, nil
+}
+func (m *CronTask_DistributionDistributeMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.DistributionDistributeMsg != nil {
+		dAtA[i] = 0x9a
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.DistributionDistributeMsg.Size()))
+		n171, err := m.DistributionDistributeMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n171
+	}
+	return i, nil
+}
+func (m *CronTask_AswapReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.AswapReleaseMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AswapReleaseMsg.Size()))
+		n172, err := m.AswapReleaseMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n172
+	}
+	return i, nil
+}
+func (m *CronTask_GovTallyMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.GovTallyMsg != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x4
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.GovTallyMsg.Size()))
+		n173, err := m.GovTallyMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n173
+	}
+	return i, nil
+}
+func (m *CronTask_CashExecuteScheduledSendMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.CashExecuteScheduledSendMsg != nil {
+		dAtA[i] = 0xea
+		i++
+		dAtA[i] = 0x5
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.CashExecuteScheduledSendMsg.Size()))
+		n174, err := m.CashExecuteScheduledSendMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n174
+	}
+	return i, nil
+}
+func (m *CronTask_MultisigApplyUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigApplyUpdateMsg != nil {
+		dAtA[i] = 0xba
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigApplyUpdateMsg.Size()))
+		n175, err := m.MultisigApplyUpdateMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n175
+	}
+	return i, nil
+}
+func (m *CronTask_MultisigExpireProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.MultisigExpireProposalMsg != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x6
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.MultisigExpireProposalMsg.Size()))
+		n176, err := m.MultisigExpireProposalMsg.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n176
+	}
+	return i, nil
+}
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Tx) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ExecuteProposalBatchMsg != nil {
-		l = m.ExecuteProposalBatchMsg.Size()
-		n += 2 + l + sovCodec(uint64(l))
+	if m.Fees != nil {
+		l = m.Fees.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Multisig) > 0 {
+		for _, b := range m.Multisig {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Sum != nil {
+		n += m.Sum.Size()
 	}
 	return n
 }
-func (m *ProposalOptions_UsernameRegisterTokenMsg) Size() (n int) {
+
+func (m *Tx_CashSendMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UsernameRegisterTokenMsg != nil {
-		l = m.UsernameRegisterTokenMsg.Size()
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_UsernameTransferTokenMsg) Size() (n int) {
+func (m *Tx_EscrowCreateMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UsernameTransferTokenMsg != nil {
-		l = m.UsernameTransferTokenMsg.Size()
+	if m.EscrowCreateMsg != nil {
+		l = m.EscrowCreateMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) Size() (n int) {
+func (m *Tx_EscrowReleaseMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UsernameChangeTokenTargetsMsg != nil {
-		l = m.UsernameChangeTokenTargetsMsg.Size()
+	if m.EscrowReleaseMsg != nil {
+		l = m.EscrowReleaseMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_DistributionCreateMsg) Size() (n int) {
+func (m *Tx_EscrowReturnMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DistributionCreateMsg != nil {
-		l = m.DistributionCreateMsg.Size()
+	if m.EscrowReturnMsg != nil {
+		l = m.EscrowReturnMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_DistributionMsg) Size() (n int) {
+func (m *Tx_EscrowUpdatePartiesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowUpdatePartiesMsg != nil {
+		l = m.EscrowUpdatePartiesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MultisigCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigCreateMsg != nil {
+		l = m.MultisigCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_ValidatorsApplyDiffMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsApplyDiffMsg != nil {
+		l = m.ValidatorsApplyDiffMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_CurrencyCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyCreateMsg != nil {
+		l = m.CurrencyCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_ExecuteBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecuteBatchMsg != nil {
+		l = m.ExecuteBatchMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_UsernameRegisterTokenMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameRegisterTokenMsg != nil {
+		l = m.UsernameRegisterTokenMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_UsernameTransferTokenMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameTransferTokenMsg != nil {
+		l = m.UsernameTransferTokenMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_UsernameChangeTokenTargetsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameChangeTokenTargetsMsg != nil {
+		l = m.UsernameChangeTokenTargetsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_DistributionCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionCreateMsg != nil {
+		l = m.DistributionCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_DistributionMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5441,7 +9241,7 @@ func (m *ProposalOptions_DistributionMsg) Size() (n int) {
 	}
 	return n
 }
-func (m *ProposalOptions_DistributionResetMsg) Size() (n int) {
+func (m *Tx_DistributionResetMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5453,7 +9253,7 @@ func (m *ProposalOptions_DistributionResetMsg) Size() (n int) {
 	}
 	return n
 }
-func (m *ProposalOptions_MigrationUpgradeSchemaMsg) Size() (n int) {
+func (m *Tx_MigrationUpgradeSchemaMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5465,385 +9265,5036 @@ func (m *ProposalOptions_MigrationUpgradeSchemaMsg) Size() (n int) {
 	}
 	return n
 }
-func (m *ProposalOptions_GovUpdateElectorateMsg) Size() (n int) {
+func (m *Tx_AswapCreateMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovUpdateElectorateMsg != nil {
-		l = m.GovUpdateElectorateMsg.Size()
+	if m.AswapCreateMsg != nil {
+		l = m.AswapCreateMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_GovUpdateElectionRuleMsg) Size() (n int) {
+func (m *Tx_AswapReleaseMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovUpdateElectionRuleMsg != nil {
-		l = m.GovUpdateElectionRuleMsg.Size()
+	if m.AswapReleaseMsg != nil {
+		l = m.AswapReleaseMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_GovCreateTextResolutionMsg) Size() (n int) {
+func (m *Tx_AswapReturnMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovCreateTextResolutionMsg != nil {
-		l = m.GovCreateTextResolutionMsg.Size()
+	if m.AswapReturnMsg != nil {
+		l = m.AswapReturnMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ProposalOptions_MsgfeeSetMsgFeeMsg) Size() (n int) {
+func (m *Tx_GovCreateProposalMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MsgfeeSetMsgFeeMsg != nil {
-		l = m.MsgfeeSetMsgFeeMsg.Size()
+	if m.GovCreateProposalMsg != nil {
+		l = m.GovCreateProposalMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg) Size() (n int) {
+func (m *Tx_GovDeleteProposalMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
-		}
+	if m.GovDeleteProposalMsg != nil {
+		l = m.GovDeleteProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-
-func (m *ExecuteProposalBatchMsg_Union) Size() (n int) {
+func (m *Tx_GovVoteMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Sum != nil {
-		n += m.Sum.Size()
+	if m.GovVoteMsg != nil {
+		l = m.GovVoteMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-
-func (m *ExecuteProposalBatchMsg_Union_SendMsg) Size() (n int) {
+func (m *Tx_GovUpdateElectorateMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SendMsg != nil {
-		l = m.SendMsg.Size()
+	if m.GovUpdateElectorateMsg != nil {
+		l = m.GovUpdateElectorateMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) Size() (n int) {
+func (m *Tx_GovUpdateElectionRuleMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EscrowReleaseMsg != nil {
-		l = m.EscrowReleaseMsg.Size()
+	if m.GovUpdateElectionRuleMsg != nil {
+		l = m.GovUpdateElectionRuleMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) Size() (n int) {
+func (m *Tx_MsgfeeSetMsgFeeMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UpdateEscrowPartiesMsg != nil {
-		l = m.UpdateEscrowPartiesMsg.Size()
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		l = m.MsgfeeSetMsgFeeMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) Size() (n int) {
+func (m *Tx_CashMultiSendMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MultisigUpdateMsg != nil {
-		l = m.MultisigUpdateMsg.Size()
+	if m.CashMultiSendMsg != nil {
+		l = m.CashMultiSendMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) Size() (n int) {
+func (m *Tx_CashBurnMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ValidatorsApplyDiffMsg != nil {
-		l = m.ValidatorsApplyDiffMsg.Size()
+	if m.CashBurnMsg != nil {
+		l = m.CashBurnMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) Size() (n int) {
+func (m *Tx_CashMintMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UsernameRegisterTokenMsg != nil {
-		l = m.UsernameRegisterTokenMsg.Size()
+	if m.CashMintMsg != nil {
+		l = m.CashMintMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) Size() (n int) {
+func (m *Tx_CashApproveMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UsernameTransferTokenMsg != nil {
-		l = m.UsernameTransferTokenMsg.Size()
+	if m.CashApproveMsg != nil {
+		l = m.CashApproveMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) Size() (n int) {
+func (m *Tx_CashTransferFromMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.UsernameChangeTokenTargetsMsg != nil {
-		l = m.UsernameChangeTokenTargetsMsg.Size()
+	if m.CashTransferFromMsg != nil {
+		l = m.CashTransferFromMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) Size() (n int) {
+func (m *Tx_CashCreateVestingMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DistributionCreateMsg != nil {
-		l = m.DistributionCreateMsg.Size()
+	if m.CashCreateVestingMsg != nil {
+		l = m.CashCreateVestingMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) Size() (n int) {
+func (m *Tx_CashReleaseVestingMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DistributionMsg != nil {
-		l = m.DistributionMsg.Size()
+	if m.CashReleaseVestingMsg != nil {
+		l = m.CashReleaseVestingMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) Size() (n int) {
+func (m *Tx_CashFreezeAccountMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DistributionResetMsg != nil {
-		l = m.DistributionResetMsg.Size()
+	if m.CashFreezeAccountMsg != nil {
+		l = m.CashFreezeAccountMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) Size() (n int) {
+func (m *Tx_CashUnfreezeAccountMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovUpdateElectorateMsg != nil {
-		l = m.GovUpdateElectorateMsg.Size()
+	if m.CashUnfreezeAccountMsg != nil {
+		l = m.CashUnfreezeAccountMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) Size() (n int) {
+func (m *Tx_CashGrantFeeMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovUpdateElectionRuleMsg != nil {
-		l = m.GovUpdateElectionRuleMsg.Size()
+	if m.CashGrantFeeMsg != nil {
+		l = m.CashGrantFeeMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) Size() (n int) {
+func (m *Tx_CashScheduleSendMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovCreateTextResolutionMsg != nil {
-		l = m.GovCreateTextResolutionMsg.Size()
+	if m.CashScheduleSendMsg != nil {
+		l = m.CashScheduleSendMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) Size() (n int) {
+func (m *Tx_CashCancelScheduledSendMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.MsgfeeSetMsgFeeMsg != nil {
-		l = m.MsgfeeSetMsgFeeMsg.Size()
+	if m.CashCancelScheduledSendMsg != nil {
+		l = m.CashCancelScheduledSendMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *CronTask) Size() (n int) {
+func (m *Tx_CashSetWalletNameMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Authenticators) > 0 {
-		for _, b := range m.Authenticators {
-			l = len(b)
-			n += 1 + l + sovCodec(uint64(l))
-		}
+	if m.CashSetWalletNameMsg != nil {
+		l = m.CashSetWalletNameMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
 	}
-	if m.Sum != nil {
-		n += m.Sum.Size()
+	return n
+}
+func (m *Tx_EscrowReleaseMilestoneMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowReleaseMilestoneMsg != nil {
+		l = m.EscrowReleaseMilestoneMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-
-func (m *CronTask_EscrowReleaseMsg) Size() (n int) {
+func (m *Tx_EscrowApproveMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EscrowReleaseMsg != nil {
-		l = m.EscrowReleaseMsg.Size()
+	if m.EscrowApproveMsg != nil {
+		l = m.EscrowApproveMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *CronTask_EscrowReturnMsg) Size() (n int) {
+func (m *Tx_EscrowUpdateEscrowTimeoutMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EscrowReturnMsg != nil {
-		l = m.EscrowReturnMsg.Size()
+	if m.EscrowUpdateEscrowTimeoutMsg != nil {
+		l = m.EscrowUpdateEscrowTimeoutMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *CronTask_DistributionDistributeMsg) Size() (n int) {
+func (m *Tx_EscrowRaiseDisputeMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.DistributionDistributeMsg != nil {
-		l = m.DistributionDistributeMsg.Size()
+	if m.EscrowRaiseDisputeMsg != nil {
+		l = m.EscrowRaiseDisputeMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *CronTask_AswapReleaseMsg) Size() (n int) {
+func (m *Tx_EscrowResolveDisputeMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.AswapReleaseMsg != nil {
-		l = m.AswapReleaseMsg.Size()
+	if m.EscrowResolveDisputeMsg != nil {
+		l = m.EscrowResolveDisputeMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-func (m *CronTask_GovTallyMsg) Size() (n int) {
+func (m *Tx_EscrowUpdateEscrowPartiesMsg) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.GovTallyMsg != nil {
-		l = m.GovTallyMsg.Size()
+	if m.EscrowUpdateEscrowPartiesMsg != nil {
+		l = m.EscrowUpdateEscrowPartiesMsg.Size()
 		n += 2 + l + sovCodec(uint64(l))
 	}
 	return n
 }
-
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+func (m *Tx_MultisigCreateProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigCreateProposalMsg != nil {
+		l = m.MultisigCreateProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MultisigApproveProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigApproveProposalMsg != nil {
+		l = m.MultisigApproveProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MultisigApplyUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigApplyUpdateMsg != nil {
+		l = m.MultisigApplyUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MultisigVetoUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigVetoUpdateMsg != nil {
+		l = m.MultisigVetoUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MultisigExpireProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigExpireProposalMsg != nil {
+		l = m.MultisigExpireProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_SigsRotateKeyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigsRotateKeyMsg != nil {
+		l = m.SigsRotateKeyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_ValidatorsSetBlsKeyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsSetBlsKeyMsg != nil {
+		l = m.ValidatorsSetBlsKeyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovDelegateVoteMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovDelegateVoteMsg != nil {
+		l = m.GovDelegateVoteMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_GovRevokeDelegationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovRevokeDelegationMsg != nil {
+		l = m.GovRevokeDelegationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MigrationMigrateBucketMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationMigrateBucketMsg != nil {
+		l = m.MigrationMigrateBucketMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MigrationDowngradeSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationDowngradeSchemaMsg != nil {
+		l = m.MigrationDowngradeSchemaMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_MsgfeeUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		l = m.MsgfeeUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_FeatureScheduleFeatureMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeatureScheduleFeatureMsg != nil {
+		l = m.FeatureScheduleFeatureMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *Tx_FeatureUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeatureUpdateConfigurationMsg != nil {
+		l = m.FeatureUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
 		}
 	}
+	if m.Mode != 0 {
+		n += 1 + sovCodec(uint64(m.Mode))
+	}
 	return n
 }
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ExecuteBatchMsg_Union) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
 }
-func (m *Tx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
+
+func (m *ExecuteBatchMsg_Union_CashSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_EscrowCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowCreateMsg != nil {
+		l = m.EscrowCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_EscrowReleaseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowReleaseMsg != nil {
+		l = m.EscrowReleaseMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_EscrowReturnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowReturnMsg != nil {
+		l = m.EscrowReturnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowUpdatePartiesMsg != nil {
+		l = m.EscrowUpdatePartiesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigCreateMsg != nil {
+		l = m.MultisigCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsApplyDiffMsg != nil {
+		l = m.ValidatorsApplyDiffMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CurrencyCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyCreateMsg != nil {
+		l = m.CurrencyCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_UsernameRegisterTokenMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameRegisterTokenMsg != nil {
+		l = m.UsernameRegisterTokenMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_UsernameTransferTokenMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameTransferTokenMsg != nil {
+		l = m.UsernameTransferTokenMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameChangeTokenTargetsMsg != nil {
+		l = m.UsernameChangeTokenTargetsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_DistributionCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionCreateMsg != nil {
+		l = m.DistributionCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_DistributionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionMsg != nil {
+		l = m.DistributionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_DistributionResetMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionResetMsg != nil {
+		l = m.DistributionResetMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		l = m.MsgfeeSetMsgFeeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashMultiSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMultiSendMsg != nil {
+		l = m.CashMultiSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashBurnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashBurnMsg != nil {
+		l = m.CashBurnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashMintMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMintMsg != nil {
+		l = m.CashMintMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashApproveMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashApproveMsg != nil {
+		l = m.CashApproveMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashTransferFromMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashTransferFromMsg != nil {
+		l = m.CashTransferFromMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashCreateVestingMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashCreateVestingMsg != nil {
+		l = m.CashCreateVestingMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashReleaseVestingMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashReleaseVestingMsg != nil {
+		l = m.CashReleaseVestingMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashFreezeAccountMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashFreezeAccountMsg != nil {
+		l = m.CashFreezeAccountMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashUnfreezeAccountMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashUnfreezeAccountMsg != nil {
+		l = m.CashUnfreezeAccountMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashGrantFeeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashGrantFeeMsg != nil {
+		l = m.CashGrantFeeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashScheduleSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashScheduleSendMsg != nil {
+		l = m.CashScheduleSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashCancelScheduledSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashCancelScheduledSendMsg != nil {
+		l = m.CashCancelScheduledSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_CashSetWalletNameMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSetWalletNameMsg != nil {
+		l = m.CashSetWalletNameMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_EscrowReleaseMilestoneMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowReleaseMilestoneMsg != nil {
+		l = m.EscrowReleaseMilestoneMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_EscrowApproveMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowApproveMsg != nil {
+		l = m.EscrowApproveMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_EscrowUpdateEscrowTimeoutMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowUpdateEscrowTimeoutMsg != nil {
+		l = m.EscrowUpdateEscrowTimeoutMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_EscrowRaiseDisputeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowRaiseDisputeMsg != nil {
+		l = m.EscrowRaiseDisputeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_EscrowResolveDisputeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowResolveDisputeMsg != nil {
+		l = m.EscrowResolveDisputeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_EscrowUpdateEscrowPartiesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowUpdateEscrowPartiesMsg != nil {
+		l = m.EscrowUpdateEscrowPartiesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigCreateProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigCreateProposalMsg != nil {
+		l = m.MultisigCreateProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigApproveProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigApproveProposalMsg != nil {
+		l = m.MultisigApproveProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigApplyUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigApplyUpdateMsg != nil {
+		l = m.MultisigApplyUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigVetoUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigVetoUpdateMsg != nil {
+		l = m.MultisigVetoUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_MultisigExpireProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigExpireProposalMsg != nil {
+		l = m.MultisigExpireProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_SigsRotateKeyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SigsRotateKeyMsg != nil {
+		l = m.SigsRotateKeyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteBatchMsg_Union_ValidatorsSetBlsKeyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsSetBlsKeyMsg != nil {
+		l = m.ValidatorsSetBlsKeyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Option != nil {
+		n += m.Option.Size()
+	}
+	return n
+}
+
+func (m *ProposalOptions_CashSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSendMsg != nil {
+		l = m.CashSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_EscrowReleaseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowReleaseMsg != nil {
+		l = m.EscrowReleaseMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_UpdateEscrowPartiesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateEscrowPartiesMsg != nil {
+		l = m.UpdateEscrowPartiesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_ValidatorsApplyDiffMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsApplyDiffMsg != nil {
+		l = m.ValidatorsApplyDiffMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CurrencyCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrencyCreateMsg != nil {
+		l = m.CurrencyCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_ExecuteProposalBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecuteProposalBatchMsg != nil {
+		l = m.ExecuteProposalBatchMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_UsernameRegisterTokenMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameRegisterTokenMsg != nil {
+		l = m.UsernameRegisterTokenMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_UsernameTransferTokenMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameTransferTokenMsg != nil {
+		l = m.UsernameTransferTokenMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_UsernameChangeTokenTargetsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameChangeTokenTargetsMsg != nil {
+		l = m.UsernameChangeTokenTargetsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_DistributionCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionCreateMsg != nil {
+		l = m.DistributionCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_DistributionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionMsg != nil {
+		l = m.DistributionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_DistributionResetMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionResetMsg != nil {
+		l = m.DistributionResetMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MigrationUpgradeSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationUpgradeSchemaMsg != nil {
+		l = m.MigrationUpgradeSchemaMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GovUpdateElectorateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectorateMsg != nil {
+		l = m.GovUpdateElectorateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GovUpdateElectionRuleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectionRuleMsg != nil {
+		l = m.GovUpdateElectionRuleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GovCreateTextResolutionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovCreateTextResolutionMsg != nil {
+		l = m.GovCreateTextResolutionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MsgfeeSetMsgFeeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		l = m.MsgfeeSetMsgFeeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashMultiSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMultiSendMsg != nil {
+		l = m.CashMultiSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashBurnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashBurnMsg != nil {
+		l = m.CashBurnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashMintMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashMintMsg != nil {
+		l = m.CashMintMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashApproveMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashApproveMsg != nil {
+		l = m.CashApproveMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashTransferFromMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashTransferFromMsg != nil {
+		l = m.CashTransferFromMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashCreateVestingMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashCreateVestingMsg != nil {
+		l = m.CashCreateVestingMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashReleaseVestingMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashReleaseVestingMsg != nil {
+		l = m.CashReleaseVestingMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashFreezeAccountMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashFreezeAccountMsg != nil {
+		l = m.CashFreezeAccountMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashUnfreezeAccountMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashUnfreezeAccountMsg != nil {
+		l = m.CashUnfreezeAccountMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashGrantFeeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashGrantFeeMsg != nil {
+		l = m.CashGrantFeeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashScheduleSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashScheduleSendMsg != nil {
+		l = m.CashScheduleSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashCancelScheduledSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashCancelScheduledSendMsg != nil {
+		l = m.CashCancelScheduledSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_CashSetWalletNameMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashSetWalletNameMsg != nil {
+		l = m.CashSetWalletNameMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_EscrowReleaseMilestoneMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowReleaseMilestoneMsg != nil {
+		l = m.EscrowReleaseMilestoneMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_EscrowApproveMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowApproveMsg != nil {
+		l = m.EscrowApproveMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_EscrowUpdateEscrowTimeoutMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowUpdateEscrowTimeoutMsg != nil {
+		l = m.EscrowUpdateEscrowTimeoutMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_EscrowRaiseDisputeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowRaiseDisputeMsg != nil {
+		l = m.EscrowRaiseDisputeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_EscrowResolveDisputeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowResolveDisputeMsg != nil {
+		l = m.EscrowResolveDisputeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_EscrowUpdateEscrowPartiesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowUpdateEscrowPartiesMsg != nil {
+		l = m.EscrowUpdateEscrowPartiesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_ValidatorsSetBlsKeyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsSetBlsKeyMsg != nil {
+		l = m.ValidatorsSetBlsKeyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GovChangeParametersMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovChangeParametersMsg != nil {
+		l = m.GovChangeParametersMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_GovTextProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovTextProposalMsg != nil {
+		l = m.GovTextProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MigrationMigrateBucketMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationMigrateBucketMsg != nil {
+		l = m.MigrationMigrateBucketMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MigrationDowngradeSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MigrationDowngradeSchemaMsg != nil {
+		l = m.MigrationDowngradeSchemaMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_MsgfeeUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeUpdateConfigurationMsg != nil {
+		l = m.MsgfeeUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_FeatureScheduleFeatureMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeatureScheduleFeatureMsg != nil {
+		l = m.FeatureScheduleFeatureMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ProposalOptions_FeatureUpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeatureUpdateConfigurationMsg != nil {
+		l = m.FeatureUpdateConfigurationMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecuteProposalBatchMsg_Union) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *ExecuteProposalBatchMsg_Union_SendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SendMsg != nil {
+		l = m.SendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_EscrowReleaseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowReleaseMsg != nil {
+		l = m.EscrowReleaseMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_UpdateEscrowPartiesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UpdateEscrowPartiesMsg != nil {
+		l = m.UpdateEscrowPartiesMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_MultisigUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigUpdateMsg != nil {
+		l = m.MultisigUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_ValidatorsApplyDiffMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ValidatorsApplyDiffMsg != nil {
+		l = m.ValidatorsApplyDiffMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_UsernameRegisterTokenMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameRegisterTokenMsg != nil {
+		l = m.UsernameRegisterTokenMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_UsernameTransferTokenMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameTransferTokenMsg != nil {
+		l = m.UsernameTransferTokenMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_UsernameChangeTokenTargetsMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UsernameChangeTokenTargetsMsg != nil {
+		l = m.UsernameChangeTokenTargetsMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_DistributionCreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionCreateMsg != nil {
+		l = m.DistributionCreateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_DistributionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionMsg != nil {
+		l = m.DistributionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_DistributionResetMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionResetMsg != nil {
+		l = m.DistributionResetMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectorateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectorateMsg != nil {
+		l = m.GovUpdateElectorateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovUpdateElectionRuleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovUpdateElectionRuleMsg != nil {
+		l = m.GovUpdateElectionRuleMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovCreateTextResolutionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovCreateTextResolutionMsg != nil {
+		l = m.GovCreateTextResolutionMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MsgfeeSetMsgFeeMsg != nil {
+		l = m.MsgfeeSetMsgFeeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *ExecuteProposalBatchMsg_Union_GovChangeParametersMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovChangeParametersMsg != nil {
+		l = m.GovChangeParametersMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Authenticators) > 0 {
+		for _, b := range m.Authenticators {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	return n
+}
+
+func (m *CronTask_EscrowReleaseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowReleaseMsg != nil {
+		l = m.EscrowReleaseMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_EscrowReturnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EscrowReturnMsg != nil {
+		l = m.EscrowReturnMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_DistributionDistributeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DistributionDistributeMsg != nil {
+		l = m.DistributionDistributeMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_AswapReleaseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AswapReleaseMsg != nil {
+		l = m.AswapReleaseMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_GovTallyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GovTallyMsg != nil {
+		l = m.GovTallyMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_CashExecuteScheduledSendMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CashExecuteScheduledSendMsg != nil {
+		l = m.CashExecuteScheduledSendMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_MultisigApplyUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigApplyUpdateMsg != nil {
+		l = m.MultisigApplyUpdateMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+func (m *CronTask_MultisigExpireProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultisigExpireProposalMsg != nil {
+		l = m.MultisigExpireProposalMsg.Size()
+		n += 2 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Tx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Fees == nil {
+				m.Fees = &cash.FeeInfo{}
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &sigs.StdSignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Multisig = append(m.Multisig, make([]byte, postIndex-iNdEx))
+			copy(m.Multisig[len(m.Multisig)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.SendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashSendMsg{v}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowCreateMsg{v}
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ReleaseMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowReleaseMsg{v}
+			iNdEx = postIndex
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReturnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ReturnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowReturnMsg{v}
+			iNdEx = postIndex
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdatePartiesMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.UpdatePartiesMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowUpdatePartiesMsg{v}
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigCreateMsg{v}
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.UpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigUpdateMsg{v}
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &validators.ApplyDiffMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ValidatorsApplyDiffMsg{v}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &currency.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CurrencyCreateMsg{v}
+			iNdEx = postIndex
+		case 60:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteBatchMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExecuteBatchMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ExecuteBatchMsg{v}
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.RegisterTokenMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_UsernameRegisterTokenMsg{v}
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.TransferTokenMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_UsernameTransferTokenMsg{v}
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.ChangeTokenTargetsMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_UsernameChangeTokenTargetsMsg{v}
+			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_DistributionCreateMsg{v}
+			iNdEx = postIndex
+		case 67:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.DistributeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_DistributionMsg{v}
+			iNdEx = postIndex
+		case 68:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.ResetMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_DistributionResetMsg{v}
+			iNdEx = postIndex
+		case 69:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &migration.UpgradeSchemaMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MigrationUpgradeSchemaMsg{v}
+			iNdEx = postIndex
+		case 70:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AswapCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &aswap.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AswapCreateMsg{v}
+			iNdEx = postIndex
+		case 71:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AswapReleaseMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &aswap.ReleaseMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AswapReleaseMsg{v}
+			iNdEx = postIndex
+		case 72:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AswapReturnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &aswap.ReturnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_AswapReturnMsg{v}
+			iNdEx = postIndex
+		case 73:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.CreateProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovCreateProposalMsg{v}
+			iNdEx = postIndex
+		case 74:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovDeleteProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.DeleteProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovDeleteProposalMsg{v}
+			iNdEx = postIndex
+		case 75:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovVoteMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.VoteMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovVoteMsg{v}
+			iNdEx = postIndex
+		case 77:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectorateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovUpdateElectorateMsg{v}
+			iNdEx = postIndex
+		case 78:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.UpdateElectionRuleMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovUpdateElectionRuleMsg{v}
+			iNdEx = postIndex
+		case 80:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.SetMsgFeeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MsgfeeSetMsgFeeMsg{v}
+			iNdEx = postIndex
+		case 81:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMultiSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.MultiSendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashMultiSendMsg{v}
+			iNdEx = postIndex
+		case 82:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashBurnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.BurnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashBurnMsg{v}
+			iNdEx = postIndex
+		case 83:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMintMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.MintMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashMintMsg{v}
+			iNdEx = postIndex
+		case 84:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashApproveMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.ApproveMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashApproveMsg{v}
+			iNdEx = postIndex
+		case 85:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashTransferFromMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.TransferFromMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashTransferFromMsg{v}
+			iNdEx = postIndex
+		case 86:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashCreateVestingMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.CreateVestingMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashCreateVestingMsg{v}
+			iNdEx = postIndex
+		case 87:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashReleaseVestingMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.ReleaseVestingMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashReleaseVestingMsg{v}
+			iNdEx = postIndex
+		case 88:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashFreezeAccountMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.FreezeAccountMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashFreezeAccountMsg{v}
+			iNdEx = postIndex
+		case 89:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashUnfreezeAccountMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.UnfreezeAccountMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashUnfreezeAccountMsg{v}
+			iNdEx = postIndex
+		case 90:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashGrantFeeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.GrantFeeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashGrantFeeMsg{v}
+			iNdEx = postIndex
+		case 91:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashScheduleSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.ScheduleSendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashScheduleSendMsg{v}
+			iNdEx = postIndex
+		case 92:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashCancelScheduledSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.CancelScheduledSendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashCancelScheduledSendMsg{v}
+			iNdEx = postIndex
+		case 94:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSetWalletNameMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.SetWalletNameMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_CashSetWalletNameMsg{v}
+			iNdEx = postIndex
+		case 95:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMilestoneMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ReleaseMilestoneMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowReleaseMilestoneMsg{v}
+			iNdEx = postIndex
+		case 96:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowApproveMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ApproveMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowApproveMsg{v}
+			iNdEx = postIndex
+		case 97:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdateEscrowTimeoutMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.UpdateEscrowTimeoutMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowUpdateEscrowTimeoutMsg{v}
+			iNdEx = postIndex
+		case 98:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRaiseDisputeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.RaiseDisputeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowRaiseDisputeMsg{v}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowResolveDisputeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ResolveDisputeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowResolveDisputeMsg{v}
+			iNdEx = postIndex
+		case 100:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdateEscrowPartiesMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.UpdateEscrowPartiesMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_EscrowUpdateEscrowPartiesMsg{v}
+			iNdEx = postIndex
+		case 101:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.CreateProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigCreateProposalMsg{v}
+			iNdEx = postIndex
+		case 102:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigApproveProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.ApproveProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigApproveProposalMsg{v}
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigApplyUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.ApplyUpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigApplyUpdateMsg{v}
+			iNdEx = postIndex
+		case 104:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigVetoUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.VetoUpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigVetoUpdateMsg{v}
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigExpireProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.ExpireProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MultisigExpireProposalMsg{v}
+			iNdEx = postIndex
+		case 106:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigsRotateKeyMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &sigs.RotateKeyMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_SigsRotateKeyMsg{v}
+			iNdEx = postIndex
+		case 107:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsSetBlsKeyMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &validators.SetBLSKeyMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_ValidatorsSetBlsKeyMsg{v}
+			iNdEx = postIndex
+		case 109:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovDelegateVoteMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.DelegateVoteMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovDelegateVoteMsg{v}
+			iNdEx = postIndex
+		case 110:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovRevokeDelegationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.RevokeDelegationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_GovRevokeDelegationMsg{v}
+			iNdEx = postIndex
+		case 112:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationMigrateBucketMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &migration.MigrateBucketMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MigrationMigrateBucketMsg{v}
+			iNdEx = postIndex
+		case 113:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationDowngradeSchemaMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &migration.DowngradeSchemaMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MigrationDowngradeSchemaMsg{v}
+			iNdEx = postIndex
+		case 114:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_MsgfeeUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		case 115:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureScheduleFeatureMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &feature.ScheduleFeatureMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_FeatureScheduleFeatureMsg{v}
+			iNdEx = postIndex
+		case 116:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureUpdateConfigurationMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &feature.UpdateConfigurationMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &Tx_FeatureUpdateConfigurationMsg{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteBatchMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, ExecuteBatchMsg_Union{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= batch.Mode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Union: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Union: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 51:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.SendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CashSendMsg{v}
+			iNdEx = postIndex
+		case 52:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowCreateMsg{v}
+			iNdEx = postIndex
+		case 53:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ReleaseMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowReleaseMsg{v}
+			iNdEx = postIndex
+		case 54:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReturnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.ReturnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowReturnMsg{v}
+			iNdEx = postIndex
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdatePartiesMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.UpdatePartiesMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg{v}
+			iNdEx = postIndex
+		case 56:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigCreateMsg{v}
+			iNdEx = postIndex
+		case 57:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.UpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigUpdateMsg{v}
+			iNdEx = postIndex
+		case 58:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &validators.ApplyDiffMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg{v}
+			iNdEx = postIndex
+		case 59:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &currency.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CurrencyCreateMsg{v}
+			iNdEx = postIndex
+		case 61:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.RegisterTokenMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameRegisterTokenMsg{v}
+			iNdEx = postIndex
+		case 62:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.TransferTokenMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameTransferTokenMsg{v}
+			iNdEx = postIndex
+		case 63:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &username.ChangeTokenTargetsMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg{v}
+			iNdEx = postIndex
+		case 66:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionCreateMsg{v}
+			iNdEx = postIndex
+		case 67:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.DistributeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionMsg{v}
+			iNdEx = postIndex
+		case 68:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &distribution.ResetMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_DistributionResetMsg{v}
+			iNdEx = postIndex
+		case 80:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &msgfee.SetMsgFeeMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg{v}
+			iNdEx = postIndex
+		case 81:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMultiSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.MultiSendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CashMultiSendMsg{v}
+			iNdEx = postIndex
+		case 82:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashBurnMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.BurnMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CashBurnMsg{v}
+			iNdEx = postIndex
+		case 83:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMintMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.MintMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CashMintMsg{v}
+			iNdEx = postIndex
+		case 84:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashApproveMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.ApproveMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CashApproveMsg{v}
+			iNdEx = postIndex
+		case 85:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashTransferFromMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			v := &cash.TransferFromMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Tx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Sum = &ExecuteBatchMsg_Union_CashTransferFromMsg{v}
+			iNdEx = postIndex
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashCreateVestingMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5870,16 +14321,50 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Fees == nil {
-				m.Fees = &cash.FeeInfo{}
+			v := &cash.CreateVestingMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Sum = &ExecuteBatchMsg_Union_CashCreateVestingMsg{v}
+			iNdEx = postIndex
+		case 87:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashReleaseVestingMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.ReleaseVestingMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &ExecuteBatchMsg_Union_CashReleaseVestingMsg{v}
 			iNdEx = postIndex
-		case 2:
+		case 88:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashFreezeAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5906,16 +14391,17 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signatures = append(m.Signatures, &sigs.StdSignature{})
-			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &cash.FreezeAccountMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Sum = &ExecuteBatchMsg_Union_CashFreezeAccountMsg{v}
 			iNdEx = postIndex
-		case 4:
+		case 89:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashUnfreezeAccountMsg", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -5925,27 +14411,30 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Multisig = append(m.Multisig, make([]byte, postIndex-iNdEx))
-			copy(m.Multisig[len(m.Multisig)-1], dAtA[iNdEx:postIndex])
+			v := &cash.UnfreezeAccountMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteBatchMsg_Union_CashUnfreezeAccountMsg{v}
 			iNdEx = postIndex
-		case 51:
+		case 90:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashGrantFeeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5972,15 +14461,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
+			v := &cash.GrantFeeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CashSendMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CashGrantFeeMsg{v}
 			iNdEx = postIndex
-		case 52:
+		case 91:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashScheduleSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6007,15 +14496,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.CreateMsg{}
+			v := &cash.ScheduleSendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_EscrowCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CashScheduleSendMsg{v}
 			iNdEx = postIndex
-		case 53:
+		case 92:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashCancelScheduledSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6042,15 +14531,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReleaseMsg{}
+			v := &cash.CancelScheduledSendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_EscrowReleaseMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CashCancelScheduledSendMsg{v}
 			iNdEx = postIndex
-		case 54:
+		case 94:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReturnMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSetWalletNameMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6077,15 +14566,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReturnMsg{}
+			v := &cash.SetWalletNameMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_EscrowReturnMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_CashSetWalletNameMsg{v}
 			iNdEx = postIndex
-		case 55:
+		case 95:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdatePartiesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMilestoneMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6112,15 +14601,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.UpdatePartiesMsg{}
+			v := &escrow.ReleaseMilestoneMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_EscrowUpdatePartiesMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowReleaseMilestoneMsg{v}
 			iNdEx = postIndex
-		case 56:
+		case 96:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowApproveMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6147,15 +14636,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.CreateMsg{}
+			v := &escrow.ApproveMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MultisigCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowApproveMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdateEscrowTimeoutMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6182,15 +14671,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
+			v := &escrow.UpdateEscrowTimeoutMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MultisigUpdateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowUpdateEscrowTimeoutMsg{v}
 			iNdEx = postIndex
-		case 58:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRaiseDisputeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6217,15 +14706,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &validators.ApplyDiffMsg{}
+			v := &escrow.RaiseDisputeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_ValidatorsApplyDiffMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowRaiseDisputeMsg{v}
 			iNdEx = postIndex
-		case 59:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowResolveDisputeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6252,15 +14741,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &currency.CreateMsg{}
+			v := &escrow.ResolveDisputeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_CurrencyCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowResolveDisputeMsg{v}
 			iNdEx = postIndex
-		case 60:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteBatchMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdateEscrowPartiesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6287,15 +14776,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ExecuteBatchMsg{}
+			v := &escrow.UpdateEscrowPartiesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_ExecuteBatchMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_EscrowUpdateEscrowPartiesMsg{v}
 			iNdEx = postIndex
-		case 61:
+		case 101:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateProposalMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6322,15 +14811,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.RegisterTokenMsg{}
+			v := &multisig.CreateProposalMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_UsernameRegisterTokenMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigCreateProposalMsg{v}
 			iNdEx = postIndex
-		case 62:
+		case 102:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigApproveProposalMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6357,15 +14846,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.TransferTokenMsg{}
+			v := &multisig.ApproveProposalMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_UsernameTransferTokenMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigApproveProposalMsg{v}
 			iNdEx = postIndex
-		case 63:
+		case 103:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigApplyUpdateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6392,15 +14881,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.ChangeTokenTargetsMsg{}
+			v := &multisig.ApplyUpdateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_UsernameChangeTokenTargetsMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigApplyUpdateMsg{v}
 			iNdEx = postIndex
-		case 66:
+		case 104:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigVetoUpdateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6427,15 +14916,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.CreateMsg{}
+			v := &multisig.VetoUpdateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_DistributionCreateMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigVetoUpdateMsg{v}
 			iNdEx = postIndex
-		case 67:
+		case 105:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigExpireProposalMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6462,15 +14951,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.DistributeMsg{}
+			v := &multisig.ExpireProposalMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_DistributionMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_MultisigExpireProposalMsg{v}
 			iNdEx = postIndex
-		case 68:
+		case 106:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SigsRotateKeyMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6497,15 +14986,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.ResetMsg{}
+			v := &sigs.RotateKeyMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_DistributionResetMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_SigsRotateKeyMsg{v}
 			iNdEx = postIndex
-		case 69:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsSetBlsKeyMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6532,15 +15021,68 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &migration.UpgradeSchemaMsg{}
+			v := &validators.SetBLSKeyMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MigrationUpgradeSchemaMsg{v}
+			m.Sum = &ExecuteBatchMsg_Union_ValidatorsSetBlsKeyMsg{v}
 			iNdEx = postIndex
-		case 70:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposalOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposalOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 51:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AswapCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6567,15 +15109,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &aswap.CreateMsg{}
+			v := &cash.SendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AswapCreateMsg{v}
+			m.Option = &ProposalOptions_CashSendMsg{v}
 			iNdEx = postIndex
-		case 71:
+		case 53:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AswapReleaseMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6602,15 +15144,50 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &aswap.ReleaseMsg{}
+			v := &escrow.ReleaseMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AswapReleaseMsg{v}
+			m.Option = &ProposalOptions_EscrowReleaseMsg{v}
+			iNdEx = postIndex
+		case 55:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateEscrowPartiesMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &escrow.UpdatePartiesMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Option = &ProposalOptions_UpdateEscrowPartiesMsg{v}
 			iNdEx = postIndex
-		case 72:
+		case 57:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AswapReturnMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6637,15 +15214,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &aswap.ReturnMsg{}
+			v := &multisig.UpdateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_AswapReturnMsg{v}
+			m.Option = &ProposalOptions_MultisigUpdateMsg{v}
 			iNdEx = postIndex
-		case 73:
+		case 58:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateProposalMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6672,15 +15249,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.CreateProposalMsg{}
+			v := &validators.ApplyDiffMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovCreateProposalMsg{v}
+			m.Option = &ProposalOptions_ValidatorsApplyDiffMsg{v}
 			iNdEx = postIndex
-		case 74:
+		case 59:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovDeleteProposalMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6707,15 +15284,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.DeleteProposalMsg{}
+			v := &currency.CreateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovDeleteProposalMsg{v}
+			m.Option = &ProposalOptions_CurrencyCreateMsg{v}
 			iNdEx = postIndex
-		case 75:
+		case 60:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovVoteMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteProposalBatchMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6742,15 +15319,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.VoteMsg{}
+			v := &ExecuteProposalBatchMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovVoteMsg{v}
+			m.Option = &ProposalOptions_ExecuteProposalBatchMsg{v}
 			iNdEx = postIndex
-		case 77:
+		case 61:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6777,15 +15354,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectorateMsg{}
+			v := &username.RegisterTokenMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovUpdateElectorateMsg{v}
+			m.Option = &ProposalOptions_UsernameRegisterTokenMsg{v}
 			iNdEx = postIndex
-		case 78:
+		case 62:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6812,15 +15389,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectionRuleMsg{}
+			v := &username.TransferTokenMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_GovUpdateElectionRuleMsg{v}
+			m.Option = &ProposalOptions_UsernameTransferTokenMsg{v}
 			iNdEx = postIndex
-		case 80:
+		case 63:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6847,68 +15424,15 @@ func (m *Tx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.SetMsgFeeMsg{}
+			v := &username.ChangeTokenTargetsMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &Tx_MsgfeeSetMsgFeeMsg{v}
+			m.Option = &ProposalOptions_UsernameChangeTokenTargetsMsg{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteBatchMsg: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteBatchMsg: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 66:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6935,67 +15459,15 @@ func (m *ExecuteBatchMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, ExecuteBatchMsg_Union{})
-			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &distribution.CreateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Option = &ProposalOptions_DistributionCreateMsg{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Union: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Union: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 51:
+		case 67:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7022,15 +15494,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
+			v := &distribution.DistributeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_CashSendMsg{v}
+			m.Option = &ProposalOptions_DistributionMsg{v}
 			iNdEx = postIndex
-		case 52:
+		case 68:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7057,15 +15529,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.CreateMsg{}
+			v := &distribution.ResetMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_EscrowCreateMsg{v}
+			m.Option = &ProposalOptions_DistributionResetMsg{v}
 			iNdEx = postIndex
-		case 53:
+		case 69:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7092,15 +15564,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReleaseMsg{}
+			v := &migration.UpgradeSchemaMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_EscrowReleaseMsg{v}
+			m.Option = &ProposalOptions_MigrationUpgradeSchemaMsg{v}
 			iNdEx = postIndex
-		case 54:
+		case 77:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReturnMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7127,15 +15599,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReturnMsg{}
+			v := &gov.UpdateElectorateMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_EscrowReturnMsg{v}
+			m.Option = &ProposalOptions_GovUpdateElectorateMsg{v}
 			iNdEx = postIndex
-		case 55:
+		case 78:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdatePartiesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7162,15 +15634,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.UpdatePartiesMsg{}
+			v := &gov.UpdateElectionRuleMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_EscrowUpdatePartiesMsg{v}
+			m.Option = &ProposalOptions_GovUpdateElectionRuleMsg{v}
 			iNdEx = postIndex
-		case 56:
+		case 79:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateTextResolutionMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7197,15 +15669,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.CreateMsg{}
+			v := &gov.CreateTextResolutionMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MultisigCreateMsg{v}
+			m.Option = &ProposalOptions_GovCreateTextResolutionMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 80:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7232,15 +15704,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
+			v := &msgfee.SetMsgFeeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MultisigUpdateMsg{v}
+			m.Option = &ProposalOptions_MsgfeeSetMsgFeeMsg{v}
 			iNdEx = postIndex
-		case 58:
+		case 81:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMultiSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7267,15 +15739,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &validators.ApplyDiffMsg{}
+			v := &cash.MultiSendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_ValidatorsApplyDiffMsg{v}
+			m.Option = &ProposalOptions_CashMultiSendMsg{v}
 			iNdEx = postIndex
-		case 59:
+		case 82:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashBurnMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7302,15 +15774,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &currency.CreateMsg{}
+			v := &cash.BurnMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_CurrencyCreateMsg{v}
+			m.Option = &ProposalOptions_CashBurnMsg{v}
 			iNdEx = postIndex
-		case 61:
+		case 83:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashMintMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7337,15 +15809,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.RegisterTokenMsg{}
+			v := &cash.MintMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameRegisterTokenMsg{v}
+			m.Option = &ProposalOptions_CashMintMsg{v}
 			iNdEx = postIndex
-		case 62:
+		case 84:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashApproveMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7372,15 +15844,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.TransferTokenMsg{}
+			v := &cash.ApproveMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameTransferTokenMsg{v}
+			m.Option = &ProposalOptions_CashApproveMsg{v}
 			iNdEx = postIndex
-		case 63:
+		case 85:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashTransferFromMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7407,15 +15879,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.ChangeTokenTargetsMsg{}
+			v := &cash.TransferFromMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_UsernameChangeTokenTargetsMsg{v}
+			m.Option = &ProposalOptions_CashTransferFromMsg{v}
 			iNdEx = postIndex
-		case 66:
+		case 86:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashCreateVestingMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7442,15 +15914,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.CreateMsg{}
+			v := &cash.CreateVestingMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DistributionCreateMsg{v}
+			m.Option = &ProposalOptions_CashCreateVestingMsg{v}
 			iNdEx = postIndex
-		case 67:
+		case 87:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashReleaseVestingMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7477,15 +15949,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.DistributeMsg{}
+			v := &cash.ReleaseVestingMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DistributionMsg{v}
+			m.Option = &ProposalOptions_CashReleaseVestingMsg{v}
 			iNdEx = postIndex
-		case 68:
+		case 88:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashFreezeAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7512,15 +15984,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.ResetMsg{}
+			v := &cash.FreezeAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_DistributionResetMsg{v}
+			m.Option = &ProposalOptions_CashFreezeAccountMsg{v}
 			iNdEx = postIndex
-		case 80:
+		case 89:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashUnfreezeAccountMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7547,68 +16019,15 @@ func (m *ExecuteBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.SetMsgFeeMsg{}
+			v := &cash.UnfreezeAccountMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Sum = &ExecuteBatchMsg_Union_MsgfeeSetMsgFeeMsg{v}
+			m.Option = &ProposalOptions_CashUnfreezeAccountMsg{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProposalOptions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProposalOptions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 51:
+		case 90:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CashSendMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashGrantFeeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7635,15 +16054,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &cash.SendMsg{}
+			v := &cash.GrantFeeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_CashSendMsg{v}
+			m.Option = &ProposalOptions_CashGrantFeeMsg{v}
 			iNdEx = postIndex
-		case 53:
+		case 91:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashScheduleSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7670,15 +16089,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.ReleaseMsg{}
+			v := &cash.ScheduleSendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_EscrowReleaseMsg{v}
+			m.Option = &ProposalOptions_CashScheduleSendMsg{v}
 			iNdEx = postIndex
-		case 55:
+		case 92:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateEscrowPartiesMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashCancelScheduledSendMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7705,15 +16124,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &escrow.UpdatePartiesMsg{}
+			v := &cash.CancelScheduledSendMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_UpdateEscrowPartiesMsg{v}
+			m.Option = &ProposalOptions_CashCancelScheduledSendMsg{v}
 			iNdEx = postIndex
-		case 57:
+		case 94:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MultisigUpdateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CashSetWalletNameMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7740,15 +16159,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &multisig.UpdateMsg{}
+			v := &cash.SetWalletNameMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_MultisigUpdateMsg{v}
+			m.Option = &ProposalOptions_CashSetWalletNameMsg{v}
 			iNdEx = postIndex
-		case 58:
+		case 95:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsApplyDiffMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowReleaseMilestoneMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7775,15 +16194,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &validators.ApplyDiffMsg{}
+			v := &escrow.ReleaseMilestoneMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_ValidatorsApplyDiffMsg{v}
+			m.Option = &ProposalOptions_EscrowReleaseMilestoneMsg{v}
 			iNdEx = postIndex
-		case 59:
+		case 96:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowApproveMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7810,15 +16229,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &currency.CreateMsg{}
+			v := &escrow.ApproveMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_CurrencyCreateMsg{v}
+			m.Option = &ProposalOptions_EscrowApproveMsg{v}
 			iNdEx = postIndex
-		case 60:
+		case 97:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteProposalBatchMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdateEscrowTimeoutMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7845,15 +16264,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ExecuteProposalBatchMsg{}
+			v := &escrow.UpdateEscrowTimeoutMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_ExecuteProposalBatchMsg{v}
+			m.Option = &ProposalOptions_EscrowUpdateEscrowTimeoutMsg{v}
 			iNdEx = postIndex
-		case 61:
+		case 98:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameRegisterTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowRaiseDisputeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7880,15 +16299,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.RegisterTokenMsg{}
+			v := &escrow.RaiseDisputeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_UsernameRegisterTokenMsg{v}
+			m.Option = &ProposalOptions_EscrowRaiseDisputeMsg{v}
 			iNdEx = postIndex
-		case 62:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameTransferTokenMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowResolveDisputeMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7915,15 +16334,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.TransferTokenMsg{}
+			v := &escrow.ResolveDisputeMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_UsernameTransferTokenMsg{v}
+			m.Option = &ProposalOptions_EscrowResolveDisputeMsg{v}
 			iNdEx = postIndex
-		case 63:
+		case 100:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsernameChangeTokenTargetsMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowUpdateEscrowPartiesMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7950,15 +16369,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &username.ChangeTokenTargetsMsg{}
+			v := &escrow.UpdateEscrowPartiesMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_UsernameChangeTokenTargetsMsg{v}
+			m.Option = &ProposalOptions_EscrowUpdateEscrowPartiesMsg{v}
 			iNdEx = postIndex
-		case 66:
+		case 107:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionCreateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsSetBlsKeyMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7985,15 +16404,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.CreateMsg{}
+			v := &validators.SetBLSKeyMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_DistributionCreateMsg{v}
+			m.Option = &ProposalOptions_ValidatorsSetBlsKeyMsg{v}
 			iNdEx = postIndex
-		case 67:
+		case 108:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovChangeParametersMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8020,15 +16439,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.DistributeMsg{}
+			v := &gov.ChangeParametersMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_DistributionMsg{v}
+			m.Option = &ProposalOptions_GovChangeParametersMsg{v}
 			iNdEx = postIndex
-		case 68:
+		case 111:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionResetMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GovTextProposalMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8055,15 +16474,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &distribution.ResetMsg{}
+			v := &gov.TextProposalMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_DistributionResetMsg{v}
+			m.Option = &ProposalOptions_GovTextProposalMsg{v}
 			iNdEx = postIndex
-		case 69:
+		case 112:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationUpgradeSchemaMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationMigrateBucketMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8090,15 +16509,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &migration.UpgradeSchemaMsg{}
+			v := &migration.MigrateBucketMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_MigrationUpgradeSchemaMsg{v}
+			m.Option = &ProposalOptions_MigrationMigrateBucketMsg{v}
 			iNdEx = postIndex
-		case 77:
+		case 113:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectorateMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationDowngradeSchemaMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8125,15 +16544,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectorateMsg{}
+			v := &migration.DowngradeSchemaMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_GovUpdateElectorateMsg{v}
+			m.Option = &ProposalOptions_MigrationDowngradeSchemaMsg{v}
 			iNdEx = postIndex
-		case 78:
+		case 114:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovUpdateElectionRuleMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8160,15 +16579,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.UpdateElectionRuleMsg{}
+			v := &msgfee.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_GovUpdateElectionRuleMsg{v}
+			m.Option = &ProposalOptions_MsgfeeUpdateConfigurationMsg{v}
 			iNdEx = postIndex
-		case 79:
+		case 115:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GovCreateTextResolutionMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureScheduleFeatureMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8195,15 +16614,15 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gov.CreateTextResolutionMsg{}
+			v := &feature.ScheduleFeatureMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_GovCreateTextResolutionMsg{v}
+			m.Option = &ProposalOptions_FeatureScheduleFeatureMsg{v}
 			iNdEx = postIndex
-		case 80:
+		case 116:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgfeeSetMsgFeeMsg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureUpdateConfigurationMsg", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8230,11 +16649,11 @@ func (m *ProposalOptions) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &msgfee.SetMsgFeeMsg{}
+			v := &feature.UpdateConfigurationMsg{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Option = &ProposalOptions_MsgfeeSetMsgFeeMsg{v}
+			m.Option = &ProposalOptions_FeatureUpdateConfigurationMsg{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8901,6 +17320,41 @@ func (m *ExecuteProposalBatchMsg_Union) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &ExecuteProposalBatchMsg_Union_MsgfeeSetMsgFeeMsg{v}
 			iNdEx = postIndex
+		case 108:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovChangeParametersMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gov.ChangeParametersMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &ExecuteProposalBatchMsg_Union_GovChangeParametersMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -9161,6 +17615,111 @@ func (m *CronTask) Unmarshal(dAtA []byte) error {
 			}
 			m.Sum = &CronTask_GovTallyMsg{v}
 			iNdEx = postIndex
+		case 93:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CashExecuteScheduledSendMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &cash.ExecuteScheduledSendMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_CashExecuteScheduledSendMsg{v}
+			iNdEx = postIndex
+		case 103:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigApplyUpdateMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.ApplyUpdateMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_MultisigApplyUpdateMsg{v}
+			iNdEx = postIndex
+		case 105:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultisigExpireProposalMsg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &multisig.ExpireProposalMsg{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sum = &CronTask_MultisigExpireProposalMsg{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
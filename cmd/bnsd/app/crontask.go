@@ -4,9 +4,11 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/x/aswap"
+	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/multisig"
 )
 
 // CronTaskMarshaler is a task marshaler implementation to be used by the bnsd
@@ -47,6 +49,18 @@ func (taskMarshaler) MarshalTask(auth []weave.Condition, msg weave.Msg) ([]byte,
 		t.Sum = &CronTask_GovTallyMsg{
 			GovTallyMsg: msg,
 		}
+	case *cash.ExecuteScheduledSendMsg:
+		t.Sum = &CronTask_CashExecuteScheduledSendMsg{
+			CashExecuteScheduledSendMsg: msg,
+		}
+	case *multisig.ApplyUpdateMsg:
+		t.Sum = &CronTask_MultisigApplyUpdateMsg{
+			MultisigApplyUpdateMsg: msg,
+		}
+	case *multisig.ExpireProposalMsg:
+		t.Sum = &CronTask_MultisigExpireProposalMsg{
+			MultisigExpireProposalMsg: msg,
+		}
 	}
 
 	raw, err := t.Marshal()
@@ -3,13 +3,17 @@ package bnsd
 import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/cmd/bnsd/x/username"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/x"
 	"github.com/iov-one/weave/x/batch"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/multisig"
 	"github.com/iov-one/weave/x/utils"
 	"github.com/iov-one/weave/x/validators"
 )
@@ -27,25 +31,35 @@ func decodeProposalOptions(raw []byte) (weave.Msg, error) {
 // proposalOptionsExecutor will set up an executor to allow governance-internal actions
 // such a setup can be easily extended to allow many more actions in other modules.
 func proposalOptionsExecutor(ctrl cash.Controller) gov.Executor {
-	r := app.NewRouter()
-
 	// we only allow these to be authenticated by the governance context, not by sigs or other items
-	auth := gov.Authenticate{}
+	return gov.HandlerAsExecutor(proposalOptionsHandler(ctrl, gov.Authenticate{}))
+}
+
+// multisigProposalExecutor will set up an executor for approved multisig
+// proposals. Messages are authenticated only by the multisig contract of the
+// proposal.
+func multisigProposalExecutor(ctrl cash.Controller) multisig.Executor {
+	return multisig.Executor(gov.HandlerAsExecutor(proposalOptionsHandler(ctrl, multisig.Authenticate{})))
+}
+
+// proposalOptionsHandler returns a handler for all messages that can be
+// executed as a result of a proposal, using given authenticator.
+func proposalOptionsHandler(ctrl cash.Controller, auth x.Authenticator) weave.Handler {
+	r := app.NewRouter()
 
 	// Make sure to register for all items in ProposalOptions
 	cash.RegisterRoutes(r, auth, ctrl)
+	cash.RegisterScheduleRoutes(r, auth, ctrl, cron.NewScheduler(CronTaskMarshaler))
 	validators.RegisterRoutes(r, auth)
-	escrow.RegisterRoutes(r, auth, ctrl)
+	escrow.RegisterRoutes(r, auth, ctrl, cron.NewScheduler(CronTaskMarshaler), username.NewTokenMover())
 	distribution.RegisterRoutes(r, auth, ctrl)
 	migration.RegisterRoutes(r, auth)
 	gov.RegisterBasicProposalRouters(r, auth)
 
 	// We must wrap with batch middleware so it can process ExecuteProposalBatchMsg.
 	// We add ActionTagger here, so the messages executed as a result of a governance vote also get properly tagged.
-	stack := app.ChainDecorators(
+	return app.ChainDecorators(
 		batch.NewDecorator(),
 		utils.NewActionTagger(),
 	).WithHandler(r)
-
-	return gov.HandlerAsExecutor(stack)
 }
@@ -18,6 +18,8 @@ import (
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/feature"
+	"github.com/iov-one/weave/x/gas"
 	"github.com/iov-one/weave/x/gov"
 	"github.com/iov-one/weave/x/msgfee"
 	"github.com/iov-one/weave/x/multisig"
@@ -82,7 +84,7 @@ func GenerateApp(options *server.Options) (abci.Application, error) {
 		dbPath = filepath.Join(options.Home, "bns.db")
 	}
 
-	stack := Stack(nil, options.MinFee)
+	stack := Stack(nil, options.MinFee, options.RateLimit, options.RateLimitWindow, options.TxDeadline)
 	application, err := Application("bnsd", stack, TxDecoder, dbPath, options)
 	if err != nil {
 		return nil, err
@@ -100,6 +102,8 @@ func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
 		&validators.Initializer{},
 		&distribution.Initializer{},
 		&msgfee.Initializer{},
+		&gas.Initializer{},
+		&feature.Initializer{},
 		&escrow.Initializer{Minter: cash.NewController(cash.NewBucket())},
 		&gov.Initializer{},
 		&username.Initializer{},
@@ -111,7 +115,7 @@ func DecorateApp(application app.BaseApp, logger log.Logger) app.BaseApp {
 // InlineApp will take a previously prepared CommitStore and return a complete Application
 func InlineApp(kv weave.CommitKVStore, logger log.Logger, debug bool) abci.Application {
 	minFee := coin.Coin{}
-	stack := Stack(nil, minFee)
+	stack := Stack(nil, minFee, 0, 0, 0)
 	ctx := context.Background()
 	store := app.NewStoreApp("bnsd", kv, QueryRouter(minFee), ctx)
 	base := app.NewBaseApp(store, TxDecoder, stack, nil, debug)
@@ -37,3 +37,9 @@ func TestGenInitOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestGenInitOptionsGolden(t *testing.T) {
+	val, err := GenInitOptions([]string{"TWO", "1234567890"})
+	assert.Nil(t, err)
+	assert.Golden(t, "genesis", val)
+}
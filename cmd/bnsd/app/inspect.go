@@ -0,0 +1,44 @@
+package bnsd
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/cmd/bnsd/x/username"
+	"github.com/iov-one/weave/commands/server"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/x/aswap"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/cron"
+	"github.com/iov-one/weave/x/currency"
+	"github.com/iov-one/weave/x/distribution"
+	"github.com/iov-one/weave/x/escrow"
+	"github.com/iov-one/weave/x/gov"
+	"github.com/iov-one/weave/x/msgfee"
+	"github.com/iov-one/weave/x/multisig"
+	"github.com/iov-one/weave/x/sigs"
+	"github.com/iov-one/weave/x/validators"
+)
+
+// InspectModels returns models of all buckets used by the bnsd application,
+// indexed by the bucket name. It is used by the inspect command to decode
+// the database content.
+func InspectModels() server.ModelRegistry {
+	return server.ModelRegistry{
+		"schema":     func() weave.Persistent { return &migration.Schema{} },
+		"cash":       func() weave.Persistent { return &cash.Set{} },
+		"sigs":       func() weave.Persistent { return &sigs.UserData{} },
+		"uvalid":     func() weave.Persistent { return &validators.Accounts{} },
+		"tokeninfo":  func() weave.Persistent { return &currency.TokenInfo{} },
+		"contracts":  func() weave.Persistent { return &multisig.Contract{} },
+		"esc":        func() weave.Persistent { return &escrow.Escrow{} },
+		"swap":       func() weave.Persistent { return &aswap.Swap{} },
+		"revenue":    func() weave.Persistent { return &distribution.Revenue{} },
+		"msgfee":     func() weave.Persistent { return &msgfee.MsgFee{} },
+		"trs":        func() weave.Persistent { return &cron.TaskResult{} },
+		"electorate": func() weave.Persistent { return &gov.Electorate{} },
+		"electnrule": func() weave.Persistent { return &gov.ElectionRule{} },
+		"proposal":   func() weave.Persistent { return &gov.Proposal{} },
+		"resolution": func() weave.Persistent { return &gov.Resolution{} },
+		"vote":       func() weave.Persistent { return &gov.Vote{} },
+		"tokens":     func() weave.Persistent { return &username.Token{} },
+	}
+}
@@ -16,7 +16,7 @@ func (tx *Tx) Fee(payer weave.Address, fee coin.Coin) {
 
 // Boiler-plate needed to bridge the ExecuteBatchMsg protobuf type into something usable by the batch extension
 
-var _ batch.Msg = (*ExecuteBatchMsg)(nil)
+var _ batch.ModeMsg = (*ExecuteBatchMsg)(nil)
 
 func (*ExecuteBatchMsg) Path() string {
 	return batch.PathExecuteBatchMsg
@@ -26,6 +26,10 @@ func (msg *ExecuteBatchMsg) Validate() error {
 	return batch.Validate(msg)
 }
 
+func (msg *ExecuteBatchMsg) BatchMode() batch.Mode {
+	return msg.Mode
+}
+
 func (msg *ExecuteBatchMsg) MsgList() ([]weave.Msg, error) {
 	var err error
 	messages := make([]weave.Msg, len(msg.Messages))
@@ -14,6 +14,7 @@ import (
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/gas"
 	"github.com/iov-one/weave/x/msgfee"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -91,6 +92,12 @@ func appStateGenesis(keyAddress weave.Address) []byte {
 				Owner:    mustParseAddr("seq:admin/admin/1"),
 				FeeAdmin: mustParseAddr("seq:admin/admin/1"),
 			},
+			"gas": gas.Configuration{
+				ReadCost:      1,
+				WriteCost:     10,
+				WriteByteCost: 1,
+				SignatureCost: 100,
+			},
 			"migration": migration.Configuration{
 				Admin: mustParseAddr("seq:multisig/usage/1"),
 			},
@@ -109,6 +116,8 @@ func appStateGenesis(keyAddress weave.Address) []byte {
 			{"ver": 1, "pkg": "escrow"},
 			{"ver": 1, "pkg": "gov"},
 			{"ver": 1, "pkg": "msgfee"},
+			{"ver": 1, "pkg": "gas"},
+			{"ver": 1, "pkg": "feature"},
 			{"ver": 1, "pkg": "multisig"},
 			{"ver": 1, "pkg": "paychan"},
 			{"ver": 1, "pkg": "sigs"},
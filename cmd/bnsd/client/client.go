@@ -9,9 +9,13 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/currency"
 	"github.com/iov-one/weave/x/sigs"
 	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	tmpubsub "github.com/tendermint/tendermint/libs/pubsub"
 	"github.com/tendermint/tendermint/rpc/client"
@@ -171,15 +175,20 @@ func (b *BnsClient) AbciQuery(path string, data []byte) (AbciResponse, error) {
 	if resp.IsErr() {
 		return out, errors.Errorf("(%d): %s", resp.Code, resp.Log)
 	}
-	out.Height = resp.Height
+	return abciResponse(resp)
+}
 
+// abciResponse pulls out the ResultSets from keys and values of a
+// successful query response.
+func abciResponse(resp abci.ResponseQuery) (AbciResponse, error) {
+	out := AbciResponse{Height: resp.Height}
 	if len(resp.Key) == 0 {
 		return out, nil
 	}
 
 	// assume there is data, parse the result sets
 	var keys, vals app.ResultSet
-	err = keys.Unmarshal(resp.Key)
+	err := keys.Unmarshal(resp.Key)
 	if err != nil {
 		return out, err
 	}
@@ -192,6 +201,29 @@ func (b *BnsClient) AbciQuery(path string, data []byte) (AbciResponse, error) {
 	return out, err
 }
 
+// Simulate runs the transaction against the current state of the node without
+// broadcasting it. The returned result contains data, log, gas used and tags
+// that the transaction would produce if it was delivered now.
+func (b *BnsClient) Simulate(tx weave.Tx) (*abci.ResponseDeliverTx, error) {
+	data, err := tx.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal tx")
+	}
+	q, err := b.conn.ABCIQuery(app.SimulatePath, data)
+	if err != nil {
+		return nil, err
+	}
+	resp := q.Response
+	if resp.IsErr() {
+		return nil, errors.Errorf("(%d): %s", resp.Code, resp.Log)
+	}
+	var res abci.ResponseDeliverTx
+	if err := res.Unmarshal(resp.Value); err != nil {
+		return nil, errors.Wrap(err, "unmarshal result")
+	}
+	return &res, nil
+}
+
 func (b *BnsClient) TxSearch(query string, prove bool, page, perPage int) (*ctypes.ResultTxSearch, error) {
 	return b.conn.TxSearch(query, prove, page, perPage)
 }
@@ -397,6 +429,34 @@ func walletKeyToAddr(key []byte) weave.Address {
 	return key[5:]
 }
 
+// SupplyResponse contains the total supply of a single currency.
+type SupplyResponse struct {
+	Height int64
+	Supply coin.Coin
+}
+
+// TotalSupply returns the total amount of coins of given currency that exist
+// on the chain. Zero value is returned for an unknown currency.
+func (b *BnsClient) TotalSupply(ticker string) (*SupplyResponse, error) {
+	resp, err := b.AbciQuery("/supply", []byte(ticker))
+	if err != nil {
+		return nil, err
+	}
+	out := SupplyResponse{
+		Height: resp.Height,
+		Supply: coin.NewCoin(0, 0, ticker),
+	}
+	if len(resp.Models) == 0 { // empty list or nil
+		return &out, nil
+	}
+	var s cash.Supply
+	if err := s.Unmarshal(resp.Models[0].Value); err != nil {
+		return nil, errors.Wrap(err, "cannot unmarshal supply")
+	}
+	out.Supply = s.Total
+	return &out, nil
+}
+
 type CurrenciesResponse struct {
 	Height     int64
 	Currencies map[string]currency.TokenInfo
@@ -444,6 +504,60 @@ func (b *BnsClient) GetUser(addr weave.Address) (*UserResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	return userFromResponse(addr, resp)
+}
+
+// GetProvenUser works like GetUser, but the result is verified using the
+// merkle proof returned by the node. The proof is verified against the app
+// hash of the block header that follows the queried state, so this call may
+// wait for the next block to be created.
+//
+// This allows to safely fetch the sequence from a node that is not trusted,
+// as long as block headers are verified, for example when the connection is
+// a light client.
+func (b *BnsClient) GetProvenUser(addr weave.Address) (*UserResponse, error) {
+	// make sure we send a valid address to the server
+	err := addr.Validate()
+	if err != nil {
+		return nil, errors.WithMessage(err, "Invalid Address")
+	}
+
+	q, err := b.conn.ABCIQueryWithOptions("/auth", []byte(addr), client.ABCIQueryOptions{Prove: true})
+	if err != nil {
+		return nil, err
+	}
+	if q.Response.IsErr() {
+		return nil, errors.Errorf("(%d): %s", q.Response.Code, q.Response.Log)
+	}
+	resp, err := abciResponse(q.Response)
+	if err != nil {
+		return nil, err
+	}
+
+	// The app hash of the state at height H is part of the header of the
+	// block at height H+1.
+	height := resp.Height + 1
+	if err := client.WaitForHeight(b.conn, height, nil); err != nil {
+		return nil, errors.WithMessage(err, "cannot wait for header")
+	}
+	commit, err := b.conn.Commit(&height)
+	if err != nil {
+		return nil, errors.WithMessage(err, "cannot fetch header")
+	}
+	var value []byte
+	if len(resp.Models) != 0 {
+		value = resp.Models[0].Value
+	}
+	key := sigs.NewBucket().DBKey(addr)
+	if err := iavl.VerifyProof(q.Response.Proof, commit.Header.AppHash, key, value); err != nil {
+		return nil, errors.WithMessage(err, "invalid proof")
+	}
+	return userFromResponse(addr, resp)
+}
+
+// userFromResponse returns the user data of given address from an "/auth"
+// query response, or nil if the address never signed a transaction.
+func userFromResponse(addr weave.Address, resp AbciResponse) (*UserResponse, error) {
 	if len(resp.Models) == 0 { // empty list or nil
 		return nil, nil // no wallet
 	}
@@ -461,7 +575,7 @@ func (b *BnsClient) GetUser(addr weave.Address) (*UserResponse, error) {
 	}
 
 	// parse the value as wallet bytes
-	err = out.UserData.Unmarshal(model.Value)
+	err := out.UserData.Unmarshal(model.Value)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +67,23 @@ func TestWalletQuery(t *testing.T) {
 	assert.Equal(t, initBalance.Ticker, coin.Ticker)
 }
 
+func TestSupplyQuery(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bcp := NewClient(conn)
+	client.WaitForHeight(conn, 5, fastWaiter)
+
+	// unknown currency has no supply
+	resp, err := bcp.TotalSupply("XYZ")
+	assert.Nil(t, err)
+	assert.Equal(t, true, resp.Supply.IsZero())
+
+	// genesis funds are part of the supply
+	resp, err = bcp.TotalSupply(initBalance.Ticker)
+	assert.Nil(t, err)
+	assert.Equal(t, true, resp.Height > 4)
+	assert.Equal(t, true, resp.Supply.IsGTE(initBalance))
+}
+
 func TestNonce(t *testing.T) {
 	addr := GenPrivateKey().PublicKey().Address()
 	conn := NewLocalConnection(node)
@@ -90,6 +107,32 @@ func TestNonce(t *testing.T) {
 	assert.Equal(t, int64(0), n)
 }
 
+func TestGetProvenUser(t *testing.T) {
+	conn := NewLocalConnection(node)
+	bcp := NewClient(conn)
+
+	// Address that never signed a transaction is proven absent.
+	user, err := bcp.GetProvenUser(GenPrivateKey().PublicKey().Address())
+	assert.Nil(t, err)
+	if user != nil {
+		t.Fatalf("unexpected user: %+v", user)
+	}
+
+	src := faucet.PublicKey().Address()
+	tx := BuildSendTx(src, GenPrivateKey().PublicKey().Address(), coin.Coin{Whole: 1, Ticker: initBalance.Ticker}, "proven")
+	n, err := NewNonce(bcp, src).Query()
+	assert.Nil(t, err)
+	SignTx(tx, faucet, getChainID(), n)
+	assert.Nil(t, bcp.BroadcastTx(tx).IsError())
+
+	user, err = bcp.GetProvenUser(src)
+	assert.Nil(t, err)
+	if user == nil {
+		t.Fatal("user not found")
+	}
+	assert.Equal(t, n+1, user.UserData.Sequence)
+}
+
 func TestSendMoney(t *testing.T) {
 	conn := NewLocalConnection(node)
 	bcp := NewClient(conn)
@@ -115,6 +115,8 @@ func initGenesis(filename string, addr weave.Address) error {
 			{"ver": 1, "pkg": "escrow"},
 			{"ver": 1, "pkg": "gov"},
 			{"ver": 1, "pkg": "msgfee"},
+			{"ver": 1, "pkg": "gas"},
+			{"ver": 1, "pkg": "feature"},
 			{"ver": 1, "pkg": "multisig"},
 			{"ver": 1, "pkg": "paychan"},
 			{"ver": 1, "pkg": "sigs"},
@@ -4,6 +4,7 @@ import (
 	"github.com/iov-one/weave"
 	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
 	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/x/cash"
 	"github.com/iov-one/weave/x/sigs"
 	"github.com/iov-one/weave/x/validators"
@@ -31,8 +32,9 @@ func BuildSendTx(source, destination weave.Address, amount coin.Coin, memo strin
 	}
 }
 
-// SignTx modifies the tx in-place, adding signatures
-func SignTx(tx *bnsd.Tx, signer *PrivateKey, chainID string, nonce int64) error {
+// SignTx modifies the tx in-place, adding signatures. Any crypto.Signer
+// implementation can be used, including remote signers.
+func SignTx(tx *bnsd.Tx, signer crypto.Signer, chainID string, nonce int64) error {
 	sig, err := sigs.SignTx(signer, tx, chainID, nonce)
 	if err != nil {
 		return err
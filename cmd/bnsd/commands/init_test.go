@@ -4,7 +4,10 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/iov-one/weave"
@@ -50,3 +53,72 @@ func TestInit(t *testing.T) {
 	assert.Equal(t, 1, len(wallet.Coins))
 	assert.Equal(t, &coin.Coin{Ticker: args[0], Whole: 123456789}, wallet.Coins[0])
 }
+
+func TestInitPeers(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(server.PeerList{
+			Seeds:           []string{"aaa@seed.example.com:26656"},
+			PersistentPeers: []string{"bbb@peer.example.com:26656"},
+		})
+	}))
+	defer srv.Close()
+
+	cases := map[string]struct {
+		args      []string
+		wantErr   bool
+		wantLines []string
+	}{
+		"seeds and peers from flags": {
+			args: []string{
+				"-seeds", "id1@1.2.3.4:26656,id2@5.6.7.8:26656",
+				"-persistent-peers", "id3@9.9.9.9:26656",
+			},
+			wantLines: []string{
+				`seeds = "id1@1.2.3.4:26656,id2@5.6.7.8:26656"`,
+				`persistent_peers = "id3@9.9.9.9:26656"`,
+			},
+		},
+		"peers from url": {
+			args: []string{"-peers-url", srv.URL},
+			wantLines: []string{
+				`seeds = "aaa@seed.example.com:26656"`,
+				`persistent_peers = "bbb@peer.example.com:26656"`,
+			},
+		},
+		"flags take precedence over url": {
+			args: []string{"-peers-url", srv.URL, "-seeds", "id1@1.2.3.4:26656"},
+			wantLines: []string{
+				`seeds = "id1@1.2.3.4:26656"`,
+				`persistent_peers = "bbb@peer.example.com:26656"`,
+			},
+		},
+		"invalid peer format": {
+			args:    []string{"-seeds", "1.2.3.4:26656"},
+			wantErr: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			home, cleanup := tmtest.SetupConfig(t, "testdata")
+			defer cleanup()
+
+			err := server.InitCmd(nil, log.NewNopLogger(), home, tc.args)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("want error")
+				}
+				return
+			}
+			assert.Nil(t, err)
+
+			bz, err := ioutil.ReadFile(filepath.Join(home, "config", "config.toml"))
+			assert.Nil(t, err)
+			for _, want := range tc.wantLines {
+				if !strings.Contains(string(bz), want+"\n") {
+					t.Errorf("config does not contain %q", want)
+				}
+			}
+		})
+	}
+}
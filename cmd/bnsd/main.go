@@ -27,15 +27,18 @@ func init() {
 
 func helpMessage() {
 	fmt.Println("bnsd")
-	fmt.Println("          Blockchain Name Service node")
+	fmt.Println("                Blockchain Name Service node")
 	fmt.Println("")
-	fmt.Println("help      Print this message")
-	fmt.Println("init      Initialize app options in genesis file")
-	fmt.Println("start     Run the abci server")
-	fmt.Println("getblock  Extract a block from blockchain.db")
-	fmt.Println("retry     Run last block again to ensure it produces same result")
-	fmt.Println("testgen   Generate various protoc and json files to test against")
-	fmt.Println("version   Print the app version")
+	fmt.Println("help            Print this message")
+	fmt.Println("init            Initialize app options in genesis file")
+	fmt.Println("start           Run the abci server")
+	fmt.Println("getblock        Extract a block from blockchain.db")
+	fmt.Println("retry           Run last block again to ensure it produces same result")
+	fmt.Println("inspect         Print database entities of a bucket as JSON (node must be stopped)")
+	fmt.Println("migrate-dryrun  Run pending schema migrations in memory and report failures (node must be stopped)")
+	fmt.Println("queryserver     Sync the state from a remote node and serve queries from it, forward other requests to the node")
+	fmt.Println("testgen         Generate various protoc and json files to test against")
+	fmt.Println("version         Print the app version")
 	fmt.Println(`
   -home string
         directory to store files under (default "$HOME/.bns")`)
@@ -67,6 +70,12 @@ func main() {
 		err = server.GetBlockCmd(rest)
 	case "retry":
 		err = server.RetryCmd(bnsd.InlineApp, logger, *varHome, rest)
+	case "inspect":
+		err = server.InspectCmd(bnsd.InspectModels(), filepath.Join(*varHome, "bns.db"), rest)
+	case "migrate-dryrun":
+		err = server.MigrateDryRunCmd(filepath.Join(*varHome, "bns.db"), rest)
+	case "queryserver":
+		err = server.QueryServerCmd(bnsd.InlineApp, logger, filepath.Join(*varHome, "query.db"), rest)
 	case "testgen":
 		err = commands.TestGenCmd(bnsd.Examples(), rest)
 	case "version":
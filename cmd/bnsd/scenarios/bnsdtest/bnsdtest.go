@@ -26,7 +26,6 @@ import (
 	"github.com/iov-one/weave/x/distribution"
 	"github.com/iov-one/weave/x/escrow"
 	"github.com/iov-one/weave/x/multisig"
-	"github.com/stellar/go/exp/crypto/derivation"
 	"github.com/tendermint/tendermint/libs/log"
 	coretypes "github.com/tendermint/tendermint/rpc/core/types"
 	tm "github.com/tendermint/tendermint/types"
@@ -144,15 +143,11 @@ func derivePrivateKey(t testing.TB, hexSeed, path string) *client.PrivateKey {
 		if err != nil {
 			t.Fatalf("failed to decode private key: %s", err)
 		}
-		k, err := derivation.DeriveForPath(path, seed)
+		k, err := crypto.DeriveEd25519FromSeed(seed, path)
 		if err != nil {
 			t.Fatalf("failed to derive private key using path=%q: %s", path, err)
 		}
-		pubKey, err := k.PublicKey()
-		if err != nil {
-			t.Fatalf("failed to derive public key: %s", err)
-		}
-		hexSeed = hex.EncodeToString(append(k.Key, pubKey...))
+		return k
 	}
 	pk, err := client.DecodePrivateKeyFromSeed(hexSeed)
 	if err != nil {
@@ -298,6 +293,8 @@ func initGenesis(t testing.TB, env *EnvConf, filename string) {
 			{"ver": 1, "pkg": "escrow"},
 			{"ver": 1, "pkg": "gov"},
 			{"ver": 1, "pkg": "msgfee"},
+			{"ver": 1, "pkg": "gas"},
+			{"ver": 1, "pkg": "feature"},
 			{"ver": 1, "pkg": "multisig"},
 			{"ver": 1, "pkg": "paychan"},
 			{"ver": 1, "pkg": "sigs"},
@@ -337,9 +334,9 @@ func SeedAccountWithTokens(t testing.TB, env *EnvConf, dest weave.Address) {
 	}
 }
 
-// MustSignTx will modify given transaction by signing it with provided private
-// key. This function fails the test if any operation was not successful.
-func MustSignTx(t testing.TB, env *EnvConf, tx *bnsd.Tx, pk *crypto.PrivateKey) {
+// MustSignTx will modify given transaction by signing it with provided
+// signer. This function fails the test if any operation was not successful.
+func MustSignTx(t testing.TB, env *EnvConf, tx *bnsd.Tx, pk crypto.Signer) {
 	t.Helper()
 
 	nonce := client.NewNonce(env.Client, pk.PublicKey().Address())
@@ -8,6 +8,10 @@ import (
 	"github.com/iov-one/weave/gconf"
 )
 
+func init() {
+	gconf.Register("username", &Configuration{})
+}
+
 func (c *Configuration) Validate() error {
 	var errs error
 	if err := c.Owner.Validate(); err != nil {
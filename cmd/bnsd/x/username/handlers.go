@@ -9,12 +9,6 @@ import (
 	"github.com/iov-one/weave/x"
 )
 
-const (
-	registerTokenCost     = 0
-	transferTokenCost     = 0
-	changeTokenTargetCost = 0
-)
-
 func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
 	r = migration.SchemaMigratingRegistry("username", r)
 
@@ -33,7 +27,7 @@ func (h *registerTokenHandler) Check(ctx weave.Context, db weave.KVStore, tx wea
 	if _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: registerTokenCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h *registerTokenHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
@@ -93,7 +87,7 @@ func (h *transferTokenHandler) Check(ctx weave.Context, db weave.KVStore, tx wea
 	if _, _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: transferTokenCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h *transferTokenHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
@@ -136,7 +130,7 @@ func (h *changeTokenTargetsHandler) Check(ctx weave.Context, db weave.KVStore, t
 	if _, _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: changeTokenTargetCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h *changeTokenTargetsHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
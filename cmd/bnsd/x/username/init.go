@@ -3,7 +3,7 @@ package username
 import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
-	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -23,7 +23,7 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 	stream := opts.Stream("username")
 
 	var conf Configuration
-	if err := gconf.InitConfig(kv, opts, "username", &conf); err != nil {
+	if err := migration.InitConfig(kv, opts, "username", &conf); err != nil {
 		return errors.Wrap(err, "cannot initialize gconf based configuration")
 	}
 
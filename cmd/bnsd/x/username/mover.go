@@ -0,0 +1,44 @@
+package username
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// TokenKind is the kind of the username token as used by extensions that
+// can hold non fungible tokens, for example escrow.
+const TokenKind = "username"
+
+// TokenMover moves the ownership of username tokens on behalf of other
+// extensions. It does not check any signatures, so the caller is
+// responsible for authorizing the transfer.
+type TokenMover struct {
+	bucket orm.ModelBucket
+}
+
+// NewTokenMover returns a TokenMover that operates on the username token
+// bucket.
+func NewTokenMover() TokenMover {
+	return TokenMover{bucket: NewTokenBucket()}
+}
+
+// MoveToken changes the owner of the username token from the source to the
+// destination address. It fails if the token is not owned by the source.
+func (m TokenMover) MoveToken(db weave.KVStore, kind string, id []byte, src, dest weave.Address) error {
+	if kind != TokenKind {
+		return errors.Wrapf(errors.ErrInput, "unsupported token kind %q", kind)
+	}
+	var token Token
+	if err := m.bucket.One(db, id, &token); err != nil {
+		return errors.Wrap(err, "cannot get token from database")
+	}
+	if !token.Owner.Equals(src) {
+		return errors.Wrap(errors.ErrUnauthorized, "token is not owned by the source")
+	}
+	token.Owner = dest
+	if _, err := m.bucket.Put(db, id, &token); err != nil {
+		return errors.Wrap(err, "cannot store token")
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package username
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestTokenMover(t *testing.T) {
+	var (
+		aliceCond = weavetest.NewCondition()
+		bobbyCond = weavetest.NewCondition()
+	)
+
+	cases := map[string]struct {
+		Kind      string
+		Username  string
+		Src       weave.Address
+		WantErr   *errors.Error
+		WantOwner weave.Address
+	}{
+		"owner token is moved": {
+			Kind:      TokenKind,
+			Username:  "alice*iov",
+			Src:       aliceCond.Address(),
+			WantOwner: bobbyCond.Address(),
+		},
+		"token of another owner cannot be moved": {
+			Kind:      TokenKind,
+			Username:  "alice*iov",
+			Src:       bobbyCond.Address(),
+			WantErr:   errors.ErrUnauthorized,
+			WantOwner: aliceCond.Address(),
+		},
+		"unknown token": {
+			Kind:      TokenKind,
+			Username:  "unknown*iov",
+			Src:       aliceCond.Address(),
+			WantErr:   errors.ErrNotFound,
+			WantOwner: aliceCond.Address(),
+		},
+		"unsupported kind": {
+			Kind:      "nft",
+			Username:  "alice*iov",
+			Src:       aliceCond.Address(),
+			WantErr:   errors.ErrInput,
+			WantOwner: aliceCond.Address(),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "username")
+
+			b := NewTokenBucket()
+			_, err := b.Put(db, []byte("alice*iov"), &Token{
+				Metadata: &weave.Metadata{Schema: 1},
+				Owner:    aliceCond.Address(),
+			})
+			assert.Nil(t, err)
+
+			err = NewTokenMover().MoveToken(db, tc.Kind, []byte(tc.Username), tc.Src, bobbyCond.Address())
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var token Token
+			assert.Nil(t, b.One(db, []byte("alice*iov"), &token))
+			if !token.Owner.Equals(tc.WantOwner) {
+				t.Fatalf("want owner %s, got %s", tc.WantOwner, token.Owner)
+			}
+		})
+	}
+}
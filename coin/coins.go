@@ -221,7 +221,7 @@ func NormalizeCoins(cs Coins) (Coins, error) {
 			}
 			return []*Coin{&total}, nil
 		case n > 0:
-			return []*Coin{cs[1], cs[2]}, nil
+			return []*Coin{cs[1], cs[0]}, nil
 		case n < 0:
 			return cs, nil
 		}
@@ -0,0 +1,121 @@
+package coin_test
+
+// Property tests are declared in a separate package, because weavetest
+// depends on the coin package.
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+)
+
+const propertyRuns = 2000
+
+func TestCoinProperties(t *testing.T) {
+	properties := map[string]func(g *weavetest.CoinGen) error{
+		"addition is commutative": func(g *weavetest.CoinGen) error {
+			a, b := g.Coin(), g.Coin()
+			ab, abErr := a.Add(b)
+			ba, baErr := b.Add(a)
+			if (abErr == nil) != (baErr == nil) || !ab.Equals(ba) {
+				return errors.Wrapf(errors.ErrState, "%v + %v = %v (%v), %v + %v = %v (%v)", a, b, ab, abErr, b, a, ba, baErr)
+			}
+			return nil
+		},
+		"subtraction is the inverse of addition": func(g *weavetest.CoinGen) error {
+			a, b := g.Coin(), g.Coin()
+			sum, err := a.Add(b)
+			if err != nil {
+				return nil
+			}
+			diff, err := sum.Subtract(b)
+			if err != nil {
+				return errors.Wrapf(err, "%v - %v", sum, b)
+			}
+			if !diff.Equals(a) {
+				return errors.Wrapf(errors.ErrState, "%v + %v - %v = %v", a, b, b, diff)
+			}
+			return nil
+		},
+		"addition returns an exact result or an overflow error": func(g *weavetest.CoinGen) error {
+			a := g.Coin()
+			b := g.Coin()
+			b.Ticker = a.Ticker
+			sum, err := a.Add(b)
+
+			exact := new(big.Int).Add(units(a), units(b))
+			if exact.CmpAbs(units(coin.NewCoin(coin.MaxInt, coin.MaxFrac, a.Ticker))) > 0 {
+				if !errors.ErrOverflow.Is(err) {
+					return errors.Wrapf(errors.ErrState, "%v + %v: want overflow, got %v (%v)", a, b, sum, err)
+				}
+				return nil
+			}
+			if err != nil {
+				return errors.Wrapf(err, "%v + %v", a, b)
+			}
+			if err := sum.Validate(); err != nil {
+				return errors.Wrapf(err, "%v + %v = %v", a, b, sum)
+			}
+			if units(sum).Cmp(exact) != 0 {
+				return errors.Wrapf(errors.ErrState, "%v + %v: want %s units, got %v", a, b, exact, sum)
+			}
+			return nil
+		},
+		"combining coins is commutative": func(g *weavetest.CoinGen) error {
+			a, b := g.Coins(), g.Coins()
+			ab, abErr := a.Combine(b)
+			ba, baErr := b.Combine(a)
+			if (abErr == nil) != (baErr == nil) || !ab.Equals(ba) {
+				return errors.Wrapf(errors.ErrState, "%v + %v = %v (%v), %v + %v = %v (%v)", a, b, ab, abErr, b, a, ba, baErr)
+			}
+			return nil
+		},
+		"rounding modes of a ratio differ by at most one unit": func(g *weavetest.CoinGen) error {
+			a := g.Coin()
+			n, d := int64(1+g.Coin().Fractional%1000), int64(1+g.Coin().Whole%1000)
+			down, downErr := a.MultiplyRatio(n, d, coin.RoundDown)
+			up, upErr := a.MultiplyRatio(n, d, coin.RoundUp)
+			if downErr != nil || upErr != nil {
+				if !errors.ErrOverflow.Is(upErr) {
+					return errors.Wrapf(errors.ErrState, "%v * %d/%d: round down %v, round up %v", a, n, d, downErr, upErr)
+				}
+				return nil
+			}
+			diff := new(big.Int).Sub(units(up), units(down))
+			if diff.CmpAbs(big.NewInt(1)) > 0 {
+				return errors.Wrapf(errors.ErrState, "%v * %d/%d: round down %v, round up %v", a, n, d, down, up)
+			}
+			return nil
+		},
+		"normalization does not depend on the order": func(g *weavetest.CoinGen) error {
+			cs := g.Coins()
+			reversed := make(coin.Coins, len(cs))
+			for i, c := range cs {
+				reversed[len(cs)-1-i] = c.Clone()
+			}
+			got, err := coin.NormalizeCoins(reversed)
+			if err != nil {
+				return errors.Wrapf(err, "normalize %v", reversed)
+			}
+			if !got.Equals(cs) {
+				return errors.Wrapf(errors.ErrState, "normalize %v: want %v, got %v", reversed, cs, got)
+			}
+			return nil
+		},
+	}
+
+	for name, property := range properties {
+		t.Run(name, func(t *testing.T) {
+			weavetest.CheckCoinProperty(t, propertyRuns, property)
+		})
+	}
+}
+
+// units returns the value of the coin expressed in fractional units.
+func units(c coin.Coin) *big.Int {
+	u := new(big.Int).Mul(big.NewInt(c.Whole), big.NewInt(coin.FracUnit))
+	return u.Add(u, big.NewInt(c.Fractional))
+}
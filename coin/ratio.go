@@ -0,0 +1,107 @@
+package coin
+
+import (
+	"math/big"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// RoundingMode declares how a value that cannot be represented precisely
+// using fractional units is rounded.
+type RoundingMode int
+
+const (
+	// RoundDown rounds toward zero, discarding any remainder.
+	RoundDown RoundingMode = iota
+	// RoundUp rounds away from zero if there is any remainder.
+	RoundUp
+	// RoundHalfUp rounds to the nearest value. A value exactly halfway
+	// between two values is rounded away from zero.
+	RoundHalfUp
+	// RoundHalfEven rounds to the nearest value. A value exactly halfway
+	// between two values is rounded to the one with an even number of
+	// fractional units. This is also known as banker's rounding.
+	RoundHalfEven
+)
+
+// MultiplyRatio returns the coin value multiplied by n/d. The result is
+// computed precisely and rounded only once, to the fractional unit, using
+// given rounding mode. For example a percentage fee is computed as
+//
+//   fee, err := amount.MultiplyRatio(percent, 100, coin.RoundUp)
+//
+// This method fails if d is zero or if the result would overflow the maximum
+// coin value.
+func (c Coin) MultiplyRatio(n, d int64, mode RoundingMode) (Coin, error) {
+	if d == 0 {
+		return Coin{Ticker: c.Ticker}, errors.Wrap(errors.ErrInput, "division by zero")
+	}
+	if mode < RoundDown || mode > RoundHalfEven {
+		return Coin{Ticker: c.Ticker}, errors.Wrapf(errors.ErrInput, "unknown rounding mode %d", mode)
+	}
+
+	units := big.NewInt(c.Whole)
+	units.Mul(units, big.NewInt(FracUnit))
+	units.Add(units, big.NewInt(c.Fractional))
+
+	num := units.Mul(units, big.NewInt(n))
+	den := big.NewInt(d)
+	if den.Sign() < 0 {
+		num.Neg(num)
+		den.Neg(den)
+	}
+
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() != 0 && roundsAwayFromZero(mode, quo, rem, den) {
+		quo.Add(quo, big.NewInt(int64(num.Sign())))
+	}
+
+	if new(big.Int).Abs(quo).Cmp(maxUnits) > 0 {
+		return Coin{Ticker: c.Ticker}, errors.ErrOverflow
+	}
+	whole, frac := new(big.Int).QuoRem(quo, big.NewInt(FracUnit), new(big.Int))
+	res := Coin{
+		Ticker:     c.Ticker,
+		Whole:      whole.Int64(),
+		Fractional: frac.Int64(),
+	}
+	return res, nil
+}
+
+// DivideRounded returns the coin value divided by d, rounded to the
+// fractional unit using given rounding mode. Unlike Divide, no leftover is
+// returned. This method fails if d is zero.
+func (c Coin) DivideRounded(d int64, mode RoundingMode) (Coin, error) {
+	return c.MultiplyRatio(1, d, mode)
+}
+
+// maxUnits is the highest coin value expressed in fractional units.
+var maxUnits = new(big.Int).Add(
+	new(big.Int).Mul(big.NewInt(MaxInt), big.NewInt(FracUnit)),
+	big.NewInt(MaxFrac))
+
+// roundsAwayFromZero returns true if the quotient truncated toward zero must
+// be rounded away from zero, according to the rounding mode and the non zero
+// remainder of a division by a positive denominator.
+func roundsAwayFromZero(mode RoundingMode, quo, rem, den *big.Int) bool {
+	switch mode {
+	case RoundUp:
+		return true
+	case RoundHalfUp, RoundHalfEven:
+		// Compare the remainder with the half of the denominator.
+		twice := new(big.Int).Abs(rem)
+		twice.Lsh(twice, 1)
+		switch cmp := twice.Cmp(den); {
+		case cmp > 0:
+			return true
+		case cmp < 0:
+			return false
+		case mode == RoundHalfUp:
+			return true
+		default:
+			return new(big.Int).Abs(quo).Bit(0) == 1
+		}
+	default:
+		return false
+	}
+}
@@ -0,0 +1,200 @@
+package coin
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+)
+
+func TestCoinMultiplyRatio(t *testing.T) {
+	cases := map[string]struct {
+		coin    Coin
+		n, d    int64
+		mode    RoundingMode
+		want    Coin
+		wantErr *errors.Error
+	}{
+		"exact result is not rounded": {
+			coin: NewCoin(10, 0, "IOV"),
+			n:    3,
+			d:    4,
+			mode: RoundUp,
+			want: NewCoin(7, 500000000, "IOV"),
+		},
+		"round down": {
+			coin: NewCoin(1, 0, "IOV"),
+			n:    2,
+			d:    3,
+			mode: RoundDown,
+			want: NewCoin(0, 666666666, "IOV"),
+		},
+		"round up": {
+			coin: NewCoin(1, 0, "IOV"),
+			n:    1,
+			d:    3,
+			mode: RoundUp,
+			want: NewCoin(0, 333333334, "IOV"),
+		},
+		"round half up below half": {
+			coin: NewCoin(1, 0, "IOV"),
+			n:    1,
+			d:    3,
+			mode: RoundHalfUp,
+			want: NewCoin(0, 333333333, "IOV"),
+		},
+		"round half up above half": {
+			coin: NewCoin(1, 0, "IOV"),
+			n:    2,
+			d:    3,
+			mode: RoundHalfUp,
+			want: NewCoin(0, 666666667, "IOV"),
+		},
+		"round half up a tie": {
+			coin: NewCoin(0, 5, "IOV"),
+			n:    1,
+			d:    2,
+			mode: RoundHalfUp,
+			want: NewCoin(0, 3, "IOV"),
+		},
+		"round half even a tie rounds down to even": {
+			coin: NewCoin(0, 5, "IOV"),
+			n:    1,
+			d:    2,
+			mode: RoundHalfEven,
+			want: NewCoin(0, 2, "IOV"),
+		},
+		"round half even a tie rounds up to even": {
+			coin: NewCoin(0, 7, "IOV"),
+			n:    1,
+			d:    2,
+			mode: RoundHalfEven,
+			want: NewCoin(0, 4, "IOV"),
+		},
+		"negative value is rounded away from zero": {
+			coin: NewCoin(-1, 0, "IOV"),
+			n:    1,
+			d:    3,
+			mode: RoundUp,
+			want: NewCoin(0, -333333334, "IOV"),
+		},
+		"negative value is rounded toward zero": {
+			coin: NewCoin(-1, 0, "IOV"),
+			n:    1,
+			d:    3,
+			mode: RoundDown,
+			want: NewCoin(0, -333333333, "IOV"),
+		},
+		"negative denominator": {
+			coin: NewCoin(3, 0, "IOV"),
+			n:    1,
+			d:    -2,
+			mode: RoundDown,
+			want: NewCoin(-1, -500000000, "IOV"),
+		},
+		"intermediate result does not overflow": {
+			coin: NewCoin(MaxInt, MaxFrac, "IOV"),
+			n:    MaxInt,
+			d:    MaxInt,
+			mode: RoundDown,
+			want: NewCoin(MaxInt, MaxFrac, "IOV"),
+		},
+		"zero value": {
+			coin: NewCoin(0, 0, "IOV"),
+			n:    7,
+			d:    3,
+			mode: RoundUp,
+			want: NewCoin(0, 0, "IOV"),
+		},
+		"overflow": {
+			coin:    NewCoin(MaxInt, 0, "IOV"),
+			n:       3,
+			d:       2,
+			mode:    RoundDown,
+			want:    NewCoin(0, 0, "IOV"),
+			wantErr: errors.ErrOverflow,
+		},
+		"result above maximum value by a small ratio": {
+			coin:    NewCoin(MaxInt, MaxFrac, "IOV"),
+			n:       2*FracUnit - 1,
+			d:       2*FracUnit - 2,
+			mode:    RoundDown,
+			want:    NewCoin(0, 0, "IOV"),
+			wantErr: errors.ErrOverflow,
+		},
+		"zero denominator": {
+			coin:    NewCoin(1, 0, "IOV"),
+			n:       1,
+			d:       0,
+			mode:    RoundDown,
+			want:    NewCoin(0, 0, "IOV"),
+			wantErr: errors.ErrInput,
+		},
+		"unknown rounding mode": {
+			coin:    NewCoin(1, 0, "IOV"),
+			n:       1,
+			d:       2,
+			mode:    RoundingMode(42),
+			want:    NewCoin(0, 0, "IOV"),
+			wantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			got, err := tc.coin.MultiplyRatio(tc.n, tc.d, tc.mode)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("got error: %v", err)
+			}
+			if !got.Equals(tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+			if err == nil {
+				if err := got.Validate(); err != nil {
+					t.Fatalf("invalid result: %s", err)
+				}
+			}
+		})
+	}
+}
+
+func TestCoinDivideRounded(t *testing.T) {
+	cases := map[string]struct {
+		coin    Coin
+		d       int64
+		mode    RoundingMode
+		want    Coin
+		wantErr *errors.Error
+	}{
+		"round down": {
+			coin: NewCoin(2, 0, "IOV"),
+			d:    3,
+			mode: RoundDown,
+			want: NewCoin(0, 666666666, "IOV"),
+		},
+		"round half up": {
+			coin: NewCoin(2, 0, "IOV"),
+			d:    3,
+			mode: RoundHalfUp,
+			want: NewCoin(0, 666666667, "IOV"),
+		},
+		"zero denominator": {
+			coin:    NewCoin(2, 0, "IOV"),
+			d:       0,
+			mode:    RoundDown,
+			want:    NewCoin(0, 0, "IOV"),
+			wantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			got, err := tc.coin.DivideRounded(tc.d, tc.mode)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("got error: %v", err)
+			}
+			if !got.Equals(tc.want) {
+				t.Fatalf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// compacter is implemented by an application that can reclaim the disk space
+// of its store, for example app.StoreApp.
+type compacter interface {
+	Compact() error
+}
+
+// compactEvery runs the store compaction periodically. It never returns.
+func compactEvery(c compacter, interval time.Duration, logger log.Logger) {
+	for range time.Tick(interval) {
+		if err := c.Compact(); err != nil {
+			logger.Error("store compaction failed", "err", err)
+		}
+	}
+}
+
+// compactHandler returns an HTTP handler that runs the store compaction when
+// requested with the POST method. The response is sent once the compaction
+// is done.
+func compactHandler(c compacter, logger log.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if err := c.Compact(); err != nil {
+			logger.Error("store compaction failed", "err", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -54,25 +54,34 @@ func GetBlockCmd(args []string) error {
 }
 
 func openDb(dir string) (dbm.DB, error) {
+	name, parent, err := splitDbPath(dir)
+	if err != nil {
+		return nil, err
+	}
+	db, err := dbm.NewGoLevelDB(name, parent)
+	if err != nil {
+		return nil, err
+	}
+	return db, nil
+}
+
+// splitDbPath returns the database name and the parent directory of a
+// database path that must end with .db
+func splitDbPath(dir string) (string, string, error) {
 	separatorStr := string(os.PathSeparator)
 	if strings.HasSuffix(dir, ".db") {
 		dir = dir[:len(dir)-3]
 	} else if strings.HasSuffix(dir, ".db"+separatorStr) {
 		dir = dir[:len(dir)-4]
 	} else {
-		return nil, errors.Wrapf(errors.ErrInput, "Database directory must end with .db")
+		return "", "", errors.Wrapf(errors.ErrInput, "Database directory must end with .db")
 	}
 
 	cut := strings.LastIndex(dir, separatorStr)
 	if cut == -1 {
-		return nil, errors.Wrapf(errors.ErrInput, "cannot cut paths on %s", dir)
-	}
-	name := dir[cut+1:]
-	db, err := dbm.NewGoLevelDB(name, dir[:cut])
-	if err != nil {
-		return nil, err
+		return "", "", errors.Wrapf(errors.ErrInput, "cannot cut paths on %s", dir)
 	}
-	return db, nil
+	return dir[cut+1:], dir[:cut], nil
 }
 
 func printBlock(store *blockchain.BlockStore, height int64) error {
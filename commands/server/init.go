@@ -6,6 +6,7 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -26,6 +27,9 @@ const (
 	FlagIgnore              = "i"
 	flagIndexAll            = "all"
 	flagIndexTags           = "tags"
+	flagSeeds               = "seeds"
+	flagPersistentPeers     = "persistent-peers"
+	flagPeersURL            = "peers-url"
 )
 
 type indexFlagValues struct {
@@ -33,6 +37,10 @@ type indexFlagValues struct {
 	indexAll bool
 	force    bool
 	ignore   bool
+
+	seeds           string
+	persistentPeers string
+	peersURL        string
 }
 
 /*
@@ -40,6 +48,9 @@ Usage:
   xxx init // index all
   xxx init -all=f  // no index
   xxx init -tags=foo,bar // index only foo and bar
+  xxx init -seeds=id@host:port,id2@host2:port // connect to given seed nodes
+  xxx init -persistent-peers=id@host:port // always stay connected to given peers
+  xxx init -peers-url=https://example.com/peers.json // download seeds and peers
 */
 func parseIndex(args []string) (indexFlagValues, []string, error) {
 	vals := indexFlagValues{}
@@ -49,6 +60,9 @@ func parseIndex(args []string) (indexFlagValues, []string, error) {
 	indexFlags.BoolVar(&vals.indexAll, flagIndexAll, true, "")
 	indexFlags.BoolVar(&vals.force, FlagForce, false, "")
 	indexFlags.BoolVar(&vals.ignore, FlagIgnore, false, "")
+	indexFlags.StringVar(&vals.seeds, flagSeeds, "", "comma-separated list of seed nodes (id@host:port)")
+	indexFlags.StringVar(&vals.persistentPeers, flagPersistentPeers, "", "comma-separated list of persistent peers (id@host:port)")
+	indexFlags.StringVar(&vals.peersURL, flagPeersURL, "", "URL of a JSON document listing seeds and persistent peers")
 
 	err := indexFlags.Parse(args)
 	return vals, indexFlags.Args(), err
@@ -71,6 +85,14 @@ func InitCmd(gen GenOptions, logger log.Logger, home string, args []string) erro
 	if err != nil {
 		return err
 	}
+	peers, err := collectPeers(vals)
+	if err != nil {
+		return err
+	}
+	err = setPeers(confFile, peers)
+	if err != nil {
+		return err
+	}
 
 	// no app_options, leave like tendermint
 	if gen == nil {
@@ -148,6 +170,124 @@ var (
 //   index_all_tags = <all>
 //   index_tags = <tags>
 func setTxIndex(config string, vals indexFlagValues) error {
+	return rewriteConfig(config, func(line string) string {
+		if strings.HasPrefix(line, prefixIndexer) {
+			line = setIndexer
+		} else if strings.HasPrefix(line, prefixIndexAll) {
+			line = fmt.Sprintf("%s = %t", prefixIndexAll, vals.indexAll)
+		} else if strings.HasPrefix(line, prefixIndexTags) {
+			line = fmt.Sprintf(`%s = "%s"`, prefixIndexTags, vals.tags)
+		}
+		return line
+	})
+}
+
+var (
+	prefixSeeds           = "seeds ="
+	prefixPersistentPeers = "persistent_peers ="
+)
+
+// PeerList is the format of the JSON document that can be published to
+// simplify joining an existing network.
+type PeerList struct {
+	Seeds           []string `json:"seeds"`
+	PersistentPeers []string `json:"persistent_peers"`
+}
+
+// collectPeers returns seeds and persistent peers as declared by flags. If an
+// URL is provided, the peer list is downloaded from it first. Values provided
+// via flags take precedence over downloaded ones.
+func collectPeers(vals indexFlagValues) (PeerList, error) {
+	var peers PeerList
+	if vals.peersURL != "" {
+		p, err := fetchPeers(vals.peersURL)
+		if err != nil {
+			return peers, err
+		}
+		peers = p
+	}
+	if vals.seeds != "" {
+		peers.Seeds = splitList(vals.seeds)
+	}
+	if vals.persistentPeers != "" {
+		peers.PersistentPeers = splitList(vals.persistentPeers)
+	}
+
+	for i, p := range peers.Seeds {
+		if err := validatePeer(p); err != nil {
+			return peers, errors.Wrapf(err, "seed %d", i)
+		}
+	}
+	for i, p := range peers.PersistentPeers {
+		if err := validatePeer(p); err != nil {
+			return peers, errors.Wrapf(err, "persistent peer %d", i)
+		}
+	}
+	return peers, nil
+}
+
+func fetchPeers(url string) (PeerList, error) {
+	var peers PeerList
+	client := http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return peers, errors.Wrap(errors.ErrNetwork, err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return peers, errors.Wrapf(errors.ErrNetwork, "fetch peers: unexpected status %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&peers); err != nil {
+		return peers, errors.Wrapf(errors.ErrInput, "cannot decode peer list: %s", err)
+	}
+	return peers, nil
+}
+
+func splitList(s string) []string {
+	var res []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// validatePeer ensures that given peer address is in the id@host:port
+// format expected by tendermint.
+func validatePeer(p string) error {
+	chunks := strings.Split(p, "@")
+	if len(chunks) != 2 || chunks[0] == "" {
+		return errors.Wrapf(errors.ErrInput, "%q is not in id@host:port format", p)
+	}
+	hostport := strings.Split(chunks[1], ":")
+	if len(hostport) != 2 || hostport[0] == "" || hostport[1] == "" {
+		return errors.Wrapf(errors.ErrInput, "%q is not in id@host:port format", p)
+	}
+	return nil
+}
+
+// setPeers sets the following fields in config.toml
+//   seeds = <seeds>
+//   persistent_peers = <persistent peers>
+// Fields are not modified if no value was provided.
+func setPeers(config string, peers PeerList) error {
+	if len(peers.Seeds) == 0 && len(peers.PersistentPeers) == 0 {
+		return nil
+	}
+	return rewriteConfig(config, func(line string) string {
+		if len(peers.Seeds) != 0 && strings.HasPrefix(line, prefixSeeds) {
+			line = fmt.Sprintf(`%s "%s"`, prefixSeeds, strings.Join(peers.Seeds, ","))
+		} else if len(peers.PersistentPeers) != 0 && strings.HasPrefix(line, prefixPersistentPeers) {
+			line = fmt.Sprintf(`%s "%s"`, prefixPersistentPeers, strings.Join(peers.PersistentPeers, ","))
+		}
+		return line
+	})
+}
+
+// rewriteConfig updates config.toml by replacing every line with the result
+// of given function.
+func rewriteConfig(config string, update func(line string) string) error {
 	f, err := os.Open(config)
 	if err != nil {
 		return errors.Wrap(err, "unable to open file")
@@ -157,15 +297,7 @@ func setTxIndex(config string, vals indexFlagValues) error {
 	scan := bufio.NewScanner(f)
 	var buf []string
 	for scan.Scan() {
-		line := scan.Text()
-		if strings.HasPrefix(line, prefixIndexer) {
-			line = setIndexer
-		} else if strings.HasPrefix(line, prefixIndexAll) {
-			line = fmt.Sprintf("%s = %t", prefixIndexAll, vals.indexAll)
-		} else if strings.HasPrefix(line, prefixIndexTags) {
-			line = fmt.Sprintf(`%s = "%s"`, prefixIndexTags, vals.tags)
-		}
-		buf = append(buf, line)
+		buf = append(buf, update(scan.Text()))
 	}
 	buf = append(buf, "")
 	f.Close()
@@ -0,0 +1,163 @@
+package server
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"github.com/tendermint/iavl"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	iavlstore "github.com/iov-one/weave/store/iavl"
+)
+
+const (
+	flagInspectDB      = "db"
+	flagInspectBackend = "db-backend"
+	flagInspectBucket  = "bucket"
+	flagInspectKey     = "key"
+	flagInspectHeight  = "height"
+)
+
+// ModelRegistry maps a bucket name to a function that returns an empty
+// instance of the model stored in that bucket. Bucket name is the name used
+// to prefix the keys in the database, not the query path.
+type ModelRegistry map[string]func() weave.Persistent
+
+// Names returns all registered bucket names in alphabetical order.
+func (r ModelRegistry) Names() []string {
+	names := make([]string, 0, len(r))
+	for name := range r {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type inspectArgs struct {
+	dbPath  string
+	backend string
+	bucket  string
+	key     []byte
+	height  int
+}
+
+func parseInspectArgs(dbPath string, args []string) (inspectArgs, error) {
+	res := inspectArgs{dbPath: dbPath}
+	var hexKey string
+	inspectFlags := flag.NewFlagSet("inspect", flag.ExitOnError)
+	inspectFlags.StringVar(&res.dbPath, flagInspectDB, dbPath, "path to the application database")
+	inspectFlags.StringVar(&res.backend, flagInspectBackend, iavlstore.GoLevelDBBackend, "database backend the node is using: goleveldb or badger")
+	inspectFlags.StringVar(&res.bucket, flagInspectBucket, "", "name of the bucket to inspect")
+	inspectFlags.StringVar(&hexKey, flagInspectKey, "", "hex encoded key of the entity (default all entities in the bucket)")
+	inspectFlags.IntVar(&res.height, flagInspectHeight, 0, "version of the state to load (default latest)")
+	if err := inspectFlags.Parse(args); err != nil {
+		return res, err
+	}
+	if res.bucket == "" {
+		return res, errors.Wrap(errors.ErrInput,
+			"usage: cmd inspect -bucket <name> [-key <hex>] [-height=H] [-db <path to abci.db>] [-db-backend <name>]")
+	}
+	key, err := hex.DecodeString(hexKey)
+	if err != nil {
+		return res, errors.Wrapf(errors.ErrInput, "key: %s", err)
+	}
+	res.key = key
+	return res, nil
+}
+
+// InspectCmd opens the application database in read only mode, loads
+// entities stored in the requested bucket, decodes them using the model
+// registered for that bucket and writes them to stdout as JSON.
+//
+// The database must not be used by a running node.
+func InspectCmd(models ModelRegistry, dbPath string, args []string) error {
+	flags, err := parseInspectArgs(dbPath, args)
+	if err != nil {
+		return err
+	}
+	newModel, ok := models[flags.bucket]
+	if !ok {
+		return errors.Wrapf(errors.ErrInput, "unknown bucket %q, available: %v", flags.bucket, models.Names())
+	}
+
+	tree, db, err := readOnlyTree(flags.dbPath, flags.backend, flags.height)
+	if err != nil {
+		return errors.Wrap(err, "cannot read abci data")
+	}
+	defer db.Close()
+	return inspectBucket(os.Stdout, tree, flags.bucket, flags.key, newModel)
+}
+
+// readOnlyTree opens the database using the given backend without acquiring
+// write access and loads the requested version of the iavl tree. Returned
+// database must be closed by the caller.
+func readOnlyTree(dir, backend string, version int) (*iavl.MutableTree, dbm.DB, error) {
+	name, parent, err := splitDbPath(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	opts := iavlstore.Options{Backend: backend, ReadOnly: true}
+	return iavlstore.OpenTree(parent, name, int64(version), opts)
+}
+
+// inspectedEntity is the JSON representation of a single database entry.
+type inspectedEntity struct {
+	Key   string      `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+func inspectBucket(w io.Writer, tree *iavl.MutableTree, bucket string, key []byte, newModel func() weave.Persistent) error {
+	prefix := append([]byte(bucket), ':')
+
+	entities := make([]inspectedEntity, 0)
+	decode := func(k, v []byte) error {
+		model := newModel()
+		if err := model.Unmarshal(v); err != nil {
+			return errors.Wrapf(errors.ErrModel, "cannot decode %X: %s", k, err)
+		}
+		entities = append(entities, inspectedEntity{
+			Key:   hex.EncodeToString(k[len(prefix):]),
+			Value: model,
+		})
+		return nil
+	}
+
+	if len(key) != 0 {
+		dbkey := append(append([]byte{}, prefix...), key...)
+		_, value := tree.Get(dbkey)
+		if value == nil {
+			return errors.Wrapf(errors.ErrNotFound, "%s %X", bucket, key)
+		}
+		if err := decode(dbkey, value); err != nil {
+			return err
+		}
+		return writeJSON(w, entities[0])
+	}
+
+	start, end := prefix, append([]byte(bucket), ':'+1)
+	var err error
+	tree.IterateRange(start, end, true, func(k, v []byte) bool {
+		err = decode(k, v)
+		return err != nil
+	})
+	if err != nil {
+		return err
+	}
+	return writeJSON(w, entities)
+}
+
+func writeJSON(w io.Writer, obj interface{}) error {
+	js, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return errors.Wrap(errors.ErrInput, err.Error())
+	}
+	_, err = fmt.Fprintln(w, string(js))
+	return err
+}
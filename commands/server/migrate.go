@@ -0,0 +1,71 @@
+package server
+
+import (
+	"flag"
+	"os"
+	"strings"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	iavlstore "github.com/iov-one/weave/store/iavl"
+)
+
+const (
+	flagMigrateDB      = "db"
+	flagMigrateBackend = "db-backend"
+	flagMigrateBuckets = "buckets"
+	flagMigrateHeight  = "height"
+)
+
+// MigrateDryRunCmd opens the application database in read only mode and
+// migrates every entity of the selected buckets in memory to the highest
+// schema version that migrations are registered for. A report is written to
+// stdout as JSON. An error is returned if any entity cannot be migrated.
+//
+// Only buckets registered using migration.MustRegisterBucket can be checked.
+// Nothing is written to the database, but it must not be used by a running
+// node.
+func MigrateDryRunCmd(dbPath string, args []string) error {
+	var (
+		backend string
+		buckets string
+		height  int
+	)
+	fl := flag.NewFlagSet("migrate-dryrun", flag.ExitOnError)
+	fl.StringVar(&dbPath, flagMigrateDB, dbPath, "path to the application database")
+	fl.StringVar(&backend, flagMigrateBackend, iavlstore.GoLevelDBBackend, "database backend the node is using: goleveldb or badger")
+	fl.StringVar(&buckets, flagMigrateBuckets, "", "comma separated names of the buckets to check (default all registered buckets)")
+	fl.IntVar(&height, flagMigrateHeight, 0, "version of the state to load (default latest)")
+	if err := fl.Parse(args); err != nil {
+		return err
+	}
+	var names []string
+	if buckets != "" {
+		names = strings.Split(buckets, ",")
+	}
+
+	tree, db, err := readOnlyTree(dbPath, backend, height)
+	if err != nil {
+		return errors.Wrap(err, "cannot read abci data")
+	}
+	defer db.Close()
+
+	// Cache wrap is never written, so all changes remain in memory.
+	kv := iavlstore.NewCommitStoreFromTree(tree).CacheWrap()
+	reports, err := migration.DryRun(kv, names...)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(os.Stdout, reports); err != nil {
+		return err
+	}
+
+	var failures int
+	for _, r := range reports {
+		failures += len(r.Failures)
+	}
+	if failures != 0 {
+		return errors.Wrapf(errors.ErrSchema, "%d entities cannot be migrated", failures)
+	}
+	return nil
+}
@@ -0,0 +1,344 @@
+package server
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	"github.com/tendermint/tendermint/types"
+
+	"github.com/iov-one/weave/errors"
+	iavlstore "github.com/iov-one/weave/store/iavl"
+)
+
+const (
+	flagQueryBind    = "bind"
+	flagQueryDB      = "db"
+	flagQueryBackend = "db-backend"
+	flagQueryRemote  = "remote"
+	flagQueryPoll    = "poll"
+)
+
+type queryServerArgs struct {
+	bind    string
+	dbPath  string
+	backend string
+	remote  string
+	poll    time.Duration
+}
+
+func parseQueryServerArgs(dbPath string, args []string) (queryServerArgs, error) {
+	res := queryServerArgs{dbPath: dbPath}
+	queryFlags := flag.NewFlagSet("queryserver", flag.ExitOnError)
+	queryFlags.StringVar(&res.bind, flagQueryBind, "localhost:26667", "address the HTTP server listens on")
+	queryFlags.StringVar(&res.dbPath, flagQueryDB, dbPath, "path to the local application database, synced from the remote node")
+	queryFlags.StringVar(&res.backend, flagQueryBackend, iavlstore.GoLevelDBBackend, "database backend of the local application database: goleveldb, cleveldb or badger")
+	queryFlags.StringVar(&res.remote, flagQueryRemote, "http://localhost:26657", "tendermint RPC address that blocks are synced from and all non query requests are forwarded to")
+	queryFlags.DurationVar(&res.poll, flagQueryPoll, time.Second, "how often the remote node is asked for new blocks")
+	err := queryFlags.Parse(args)
+	return res, err
+}
+
+// QueryServerCmd runs an HTTP server that is compatible with the tendermint
+// RPC interface, but that serves abci_query requests directly from a local
+// application database. All other requests (status, blocks, broadcasting
+// transactions, ...) are forwarded to a remote tendermint node.
+//
+// The query server does not participate in the consensus. The local database
+// is kept in sync by fetching committed blocks from the remote node and
+// executing them, exactly like a node replaying the blockchain does. The
+// application hash of every block is compared with the state computed
+// locally and the command fails if they differ. This allows to scale query
+// serving horizontally.
+func QueryServerCmd(gen InlineAppGenerator, logger log.Logger, dbPath string, args []string) error {
+	flags, err := parseQueryServerArgs(dbPath, args)
+	if err != nil {
+		return err
+	}
+	remote, err := url.Parse(flags.remote)
+	if err != nil {
+		return errors.Wrapf(errors.ErrInput, "remote: %s", err)
+	}
+	name, parent, err := splitDbPath(flags.dbPath)
+	if err != nil {
+		return err
+	}
+	kv, err := iavlstore.NewCommitStoreWithOptions(parent, name, iavlstore.Options{Backend: flags.backend})
+	if err != nil {
+		return errors.Wrap(err, "cannot open database")
+	}
+
+	node := rpcclient.NewHTTP(flags.remote, "/websocket")
+	qs := newQueryServer(gen(kv, logger, false), logger, node, httputil.NewSingleHostReverseProxy(remote))
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- http.ListenAndServe(flags.bind, qs)
+	}()
+	logger.Info("Starting query server", "bind", flags.bind, "remote", flags.remote)
+
+	tick := time.NewTicker(flags.poll)
+	defer tick.Stop()
+	for {
+		if err := qs.sync(); err != nil {
+			// The remote node might be temporarily unavailable.
+			// Any other failure means that the local state cannot
+			// be trusted anymore.
+			if !errors.ErrNetwork.Is(err) {
+				return errors.Wrap(err, "cannot sync the local state")
+			}
+			logger.Error("Cannot fetch blocks from the remote node", "err", err)
+		}
+		select {
+		case err := <-serveErr:
+			return err
+		case <-tick.C:
+		}
+	}
+}
+
+// blockSource provides committed blocks of the blockchain. It is implemented
+// by the tendermint RPC client.
+type blockSource interface {
+	Status() (*ctypes.ResultStatus, error)
+	Genesis() (*ctypes.ResultGenesis, error)
+	Block(height *int64) (*ctypes.ResultBlock, error)
+}
+
+// queryServer handles abci_query requests using the local application and
+// forwards all other requests to the remote node.
+type queryServer struct {
+	logger log.Logger
+	node   blockSource
+	remote http.Handler
+
+	// mu guards the application, so that queries are not served while
+	// a block is executed.
+	mu  sync.RWMutex
+	app abci.Application
+}
+
+func newQueryServer(app abci.Application, logger log.Logger, node blockSource, remote http.Handler) *queryServer {
+	return &queryServer{
+		logger: logger,
+		node:   node,
+		remote: remote,
+		app:    app,
+	}
+}
+
+// sync executes all blocks that were committed by the remote node, but are
+// missing in the local state. Failures of the remote node are returned as
+// ErrNetwork. ErrState is returned if the local state is different from the
+// state of the remote node.
+func (qs *queryServer) sync() error {
+	status, err := qs.node.Status()
+	if err != nil {
+		return errors.Wrap(errors.ErrNetwork, err.Error())
+	}
+	latest := status.SyncInfo.LatestBlockHeight
+
+	qs.mu.RLock()
+	info := qs.app.Info(abci.RequestInfo{})
+	qs.mu.RUnlock()
+
+	height := info.LastBlockHeight
+	if height == 0 && latest > 0 {
+		if err := qs.initChain(); err != nil {
+			return err
+		}
+	}
+	for height < latest {
+		height++
+		res, err := qs.node.Block(&height)
+		if err != nil {
+			return errors.Wrapf(errors.ErrNetwork, "block %d: %s", height, err)
+		}
+		if err := qs.applyBlock(res.Block); err != nil {
+			return errors.Wrapf(err, "block %d", height)
+		}
+	}
+	return nil
+}
+
+// initChain initializes the application using the genesis of the remote
+// node.
+func (qs *queryServer) initChain() (err error) {
+	res, err := qs.node.Genesis()
+	if err != nil {
+		return errors.Wrapf(errors.ErrNetwork, "genesis: %s", err)
+	}
+	gen := res.Genesis
+
+	// Validators are passed the same way as tendermint does it when
+	// the chain is initialized.
+	validators := make([]*types.Validator, len(gen.Validators))
+	for i, v := range gen.Validators {
+		validators[i] = types.NewValidator(v.PubKey, v.Power)
+	}
+	req := abci.RequestInitChain{
+		Time:          gen.GenesisTime,
+		ChainId:       gen.ChainID,
+		Validators:    types.TM2PB.ValidatorUpdates(types.NewValidatorSet(validators)),
+		AppStateBytes: gen.AppState,
+	}
+	if gen.ConsensusParams != nil {
+		req.ConsensusParams = types.TM2PB.ConsensusParams(gen.ConsensusParams)
+	}
+
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	defer errors.Recover(&err)
+	qs.app.InitChain(req)
+	qs.logger.Info("Chain initialized from the remote genesis", "chain", gen.ChainID)
+	return nil
+}
+
+// applyBlock executes and commits a block. The application hash stored in
+// the header of the block must be equal to the hash of the local state.
+// Header of the first block contains the application hash of the genesis
+// that is not checked.
+func (qs *queryServer) applyBlock(block *types.Block) (err error) {
+	qs.mu.Lock()
+	defer qs.mu.Unlock()
+	defer errors.Recover(&err)
+
+	info := qs.app.Info(abci.RequestInfo{})
+	if info.LastBlockHeight+1 != block.Height {
+		return errors.Wrapf(errors.ErrState, "local height is %d", info.LastBlockHeight)
+	}
+	if block.Height > 1 && !bytes.Equal(info.LastBlockAppHash, block.AppHash) {
+		return errors.Wrapf(errors.ErrState, "app hash mismatch: local %X, remote %X", info.LastBlockAppHash, block.AppHash)
+	}
+
+	qs.app.BeginBlock(abci.RequestBeginBlock{Hash: block.Hash(), Header: toAbciHeader(block.Header)})
+	for _, tx := range block.Txs {
+		qs.app.DeliverTx(tx)
+	}
+	qs.app.EndBlock(abci.RequestEndBlock{Height: block.Height})
+	hash := qs.app.Commit().Data
+	qs.logger.Debug("Block synced", "height", block.Height, "hash", fmt.Sprintf("%X", hash))
+	return nil
+}
+
+func (qs *queryServer) query(req abci.RequestQuery) abci.ResponseQuery {
+	qs.mu.RLock()
+	defer qs.mu.RUnlock()
+	return qs.app.Query(req)
+}
+
+func (qs *queryServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/abci_query":
+		qs.serveURIQuery(w, r)
+	case r.Method == http.MethodPost:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var req rpctypes.RPCRequest
+		if err := json.Unmarshal(body, &req); err == nil && req.Method == "abci_query" {
+			qs.serveJSONRPCQuery(w, req)
+			return
+		}
+		// Not a query, the remote node must handle it.
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		qs.remote.ServeHTTP(w, r)
+	default:
+		qs.remote.ServeHTTP(w, r)
+	}
+}
+
+// abciQueryParams are the parameters of the abci_query RPC method.
+type abciQueryParams struct {
+	Path   string       `json:"path"`
+	Data   cmn.HexBytes `json:"data"`
+	Height int64        `json:"height"`
+	Prove  bool         `json:"prove"`
+}
+
+func (qs *queryServer) serveJSONRPCQuery(w http.ResponseWriter, req rpctypes.RPCRequest) {
+	var params abciQueryParams
+	if len(req.Params) != 0 {
+		if err := cdc.UnmarshalJSON(req.Params, &params); err != nil {
+			writeRPCResponse(w, rpctypes.RPCInvalidParamsError(req.ID, err))
+			return
+		}
+	}
+	qs.writeQueryResult(w, req, params)
+}
+
+func (qs *queryServer) serveURIQuery(w http.ResponseWriter, r *http.Request) {
+	// URI requests have no ID, the response is using an empty one.
+	req := rpctypes.RPCRequest{ID: rpctypes.JSONRPCStringID("")}
+	q := r.URL.Query()
+	params := abciQueryParams{
+		Path:  strings.Trim(q.Get("path"), `"`),
+		Prove: q.Get("prove") == "true",
+	}
+	if raw := q.Get("data"); raw != "" {
+		data, err := decodeURIBytes(raw)
+		if err != nil {
+			writeRPCResponse(w, rpctypes.RPCInvalidParamsError(req.ID, err))
+			return
+		}
+		params.Data = data
+	}
+	if raw := strings.Trim(q.Get("height"), `"`); raw != "" {
+		height, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			writeRPCResponse(w, rpctypes.RPCInvalidParamsError(req.ID, err))
+			return
+		}
+		params.Height = height
+	}
+	qs.writeQueryResult(w, req, params)
+}
+
+// decodeURIBytes decodes a binary URI argument. As in tendermint, the value
+// is either a 0x prefixed hex or a quoted string.
+func decodeURIBytes(raw string) ([]byte, error) {
+	if strings.HasPrefix(raw, "0x") {
+		return hex.DecodeString(raw[2:])
+	}
+	if len(raw) >= 2 && strings.HasPrefix(raw, `"`) && strings.HasSuffix(raw, `"`) {
+		return []byte(raw[1 : len(raw)-1]), nil
+	}
+	return nil, errors.Wrap(errors.ErrInput, "data must be 0x prefixed hex or a quoted string")
+}
+
+// writeQueryResult writes the result of the query as the response to the
+// request. The request is passed, because the type of its ID is not exported.
+func (qs *queryServer) writeQueryResult(w http.ResponseWriter, req rpctypes.RPCRequest, params abciQueryParams) {
+	res := qs.query(abci.RequestQuery{
+		Path:   params.Path,
+		Data:   params.Data,
+		Height: params.Height,
+		Prove:  params.Prove,
+	})
+	writeRPCResponse(w, rpctypes.NewRPCSuccessResponse(cdc, req.ID, &ctypes.ResultABCIQuery{Response: res}))
+}
+
+func writeRPCResponse(w http.ResponseWriter, res rpctypes.RPCResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(res); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
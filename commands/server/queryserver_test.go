@@ -0,0 +1,316 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/tendermint/tendermint/types"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	iavlstore "github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/weavetest/tmmock"
+)
+
+func TestQueryServerSync(t *testing.T) {
+	chain := newTestChain(t, [][]string{
+		{"a=1", "b=2"},
+		{"a=3"},
+		{},
+		{"c=4"},
+	})
+	tm := chain.serve()
+	defer tm.Close()
+
+	kv := iavlstore.MockCommitStore()
+	qs := newTestQueryServer(kv, tm)
+	assert.Nil(t, qs.sync())
+
+	id, err := kv.LatestVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(4), id.Version)
+	assert.Equal(t, chain.hashes[3], id.Hash)
+
+	for key, want := range map[string]string{"a": "3", "b": "2", "c": "4", "chain": "test-chain"} {
+		res := qs.query(abci.RequestQuery{Data: []byte(key)})
+		assert.Equal(t, want, string(res.Value))
+	}
+
+	// A query server started again continues from the last synced block.
+	qs = newTestQueryServer(kv, tm)
+	before := len(tm.Requests())
+	assert.Nil(t, qs.sync())
+	for _, r := range tm.Requests()[before:] {
+		if r.Method != "status" {
+			t.Fatalf("unexpected %q request", r.Method)
+		}
+	}
+}
+
+func TestQueryServerSyncAppHashMismatch(t *testing.T) {
+	chain := newTestChain(t, [][]string{
+		{"a=1"},
+		{"a=2"},
+		{"a=3"},
+	})
+	chain.blocks[2].AppHash = []byte("invalid hash")
+	tm := chain.serve()
+	defer tm.Close()
+
+	kv := iavlstore.MockCommitStore()
+	qs := newTestQueryServer(kv, tm)
+	if err := qs.sync(); !errors.ErrState.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	// Only blocks that were verified are committed.
+	id, err := kv.LatestVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), id.Version)
+}
+
+func TestQueryServerSyncRemoteFailure(t *testing.T) {
+	tm := tmmock.New()
+	defer tm.Close()
+
+	qs := newTestQueryServer(iavlstore.MockCommitStore(), tm)
+	if err := qs.sync(); !errors.ErrNetwork.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+func TestQueryServerHTTP(t *testing.T) {
+	chain := newTestChain(t, [][]string{
+		{"a=1"},
+	})
+	tm := chain.serve()
+	defer tm.Close()
+
+	qs := newTestQueryServer(iavlstore.MockCommitStore(), tm)
+	assert.Nil(t, qs.sync())
+
+	cases := map[string]struct {
+		req        *http.Request
+		wantResult string
+		wantRemote string
+	}{
+		"uri query": {
+			req:        httptest.NewRequest("GET", `/abci_query?data=0x61`, nil),
+			wantResult: `"value":"MQ=="`,
+		},
+		"uri query with a string": {
+			req:        httptest.NewRequest("GET", `/abci_query?data=%22a%22`, nil),
+			wantResult: `"value":"MQ=="`,
+		},
+		"json-rpc query": {
+			req: httptest.NewRequest("POST", "/", strings.NewReader(
+				`{"jsonrpc":"2.0","id":"q","method":"abci_query","params":{"data":"61"}}`)),
+			wantResult: `"value":"MQ=="`,
+		},
+		"json-rpc request is forwarded": {
+			req: httptest.NewRequest("POST", "/", strings.NewReader(
+				`{"jsonrpc":"2.0","id":"s","method":"status","params":{}}`)),
+			wantResult: `"latest_block_height":"1"`,
+			wantRemote: "status",
+		},
+		"uri request is forwarded": {
+			req:        httptest.NewRequest("GET", "/genesis", nil),
+			wantResult: `"chain_id":"test-chain"`,
+			wantRemote: "genesis",
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			before := len(tm.Requests())
+
+			w := httptest.NewRecorder()
+			qs.ServeHTTP(w, tc.req)
+			assert.Equal(t, http.StatusOK, w.Code)
+			if body := w.Body.String(); !strings.Contains(body, tc.wantResult) {
+				t.Fatalf("unexpected response: %s", body)
+			}
+
+			var remote []string
+			for _, r := range tm.Requests()[before:] {
+				remote = append(remote, r.Method)
+			}
+			if tc.wantRemote == "" {
+				assert.Equal(t, 0, len(remote))
+			} else {
+				assert.Equal(t, []string{tc.wantRemote}, remote)
+			}
+		})
+	}
+}
+
+func newTestQueryServer(kv weave.CommitKVStore, tm *tmmock.Server) *queryServer {
+	remote, err := url.Parse(tm.URL())
+	if err != nil {
+		panic(err)
+	}
+	node := rpcclient.NewHTTP(tm.URL(), "/websocket")
+	return newQueryServer(newKVApp(kv, log.NewNopLogger(), false), log.NewNopLogger(), node, httputil.NewSingleHostReverseProxy(remote))
+}
+
+// testChain is a blockchain of the kvApp application.
+type testChain struct {
+	genesis *types.GenesisDoc
+	blocks  []*types.Block
+	// hashes contains the application hash computed after each block.
+	hashes [][]byte
+}
+
+// newTestChain creates a chain with a block for each list of transactions.
+// Application hashes are computed using a reference application.
+func newTestChain(t testing.TB, txs [][]string) *testChain {
+	t.Helper()
+
+	c := &testChain{
+		genesis: &types.GenesisDoc{
+			GenesisTime: time.Now().UTC(),
+			ChainID:     "test-chain",
+			AppState:    json.RawMessage(`{}`),
+		},
+	}
+	app := newKVApp(iavlstore.MockCommitStore(), log.NewNopLogger(), false)
+	app.InitChain(abci.RequestInitChain{ChainId: c.genesis.ChainID})
+
+	var appHash []byte
+	for i, blockTxs := range txs {
+		var tmtxs []types.Tx
+		for _, tx := range blockTxs {
+			tmtxs = append(tmtxs, types.Tx(tx))
+		}
+		block := types.MakeBlock(int64(i+1), tmtxs, &types.Commit{}, nil)
+		block.ChainID = c.genesis.ChainID
+		block.Time = c.genesis.GenesisTime.Add(time.Duration(i+1) * time.Second)
+		block.AppHash = appHash
+
+		app.BeginBlock(abci.RequestBeginBlock{Hash: block.Hash(), Header: toAbciHeader(block.Header)})
+		for _, tx := range block.Txs {
+			app.DeliverTx(tx)
+		}
+		app.EndBlock(abci.RequestEndBlock{Height: block.Height})
+		appHash = app.Commit().Data
+
+		c.blocks = append(c.blocks, block)
+		c.hashes = append(c.hashes, appHash)
+	}
+	return c
+}
+
+// serve starts a mock of a tendermint node that serves the chain.
+func (c *testChain) serve() *tmmock.Server {
+	tm := tmmock.New()
+	tm.Handle("status", func(json.RawMessage) (interface{}, error) {
+		return aminoJSON(&ctypes.ResultStatus{
+			SyncInfo: ctypes.SyncInfo{LatestBlockHeight: int64(len(c.blocks))},
+		})
+	})
+	tm.Handle("genesis", func(json.RawMessage) (interface{}, error) {
+		return aminoJSON(&ctypes.ResultGenesis{Genesis: c.genesis})
+	})
+	tm.Handle("block", func(raw json.RawMessage) (interface{}, error) {
+		var params map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &params); err != nil {
+			return nil, errors.Wrap(errors.ErrInput, "invalid parameters")
+		}
+		// Amino encodes numbers as strings.
+		height, err := strconv.ParseInt(strings.Trim(string(params["height"]), `"`), 10, 64)
+		if err != nil || height < 1 || height > int64(len(c.blocks)) {
+			return nil, errors.Wrap(errors.ErrNotFound, "block")
+		}
+		return aminoJSON(&ctypes.ResultBlock{Block: c.blocks[height-1]})
+	})
+	return tm
+}
+
+func aminoJSON(v interface{}) (json.RawMessage, error) {
+	raw, err := cdc.MarshalJSON(v)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrType, err.Error())
+	}
+	return raw, nil
+}
+
+// kvApp is a minimal application that stores transactions of the key=value
+// form. The chain ID is stored under the "chain" key.
+type kvApp struct {
+	abci.BaseApplication
+
+	kv    weave.CommitKVStore
+	batch weave.KVCacheWrap
+}
+
+var _ InlineAppGenerator = newKVApp
+
+func newKVApp(kv weave.CommitKVStore, logger log.Logger, debug bool) abci.Application {
+	return &kvApp{kv: kv}
+}
+
+func (a *kvApp) Info(abci.RequestInfo) abci.ResponseInfo {
+	id, err := a.kv.LatestVersion()
+	if err != nil {
+		panic(err)
+	}
+	return abci.ResponseInfo{LastBlockHeight: id.Version, LastBlockAppHash: id.Hash}
+}
+
+func (a *kvApp) InitChain(req abci.RequestInitChain) abci.ResponseInitChain {
+	a.set([]byte("chain"), []byte(req.ChainId))
+	return abci.ResponseInitChain{}
+}
+
+func (a *kvApp) DeliverTx(tx []byte) abci.ResponseDeliverTx {
+	kv := bytes.SplitN(tx, []byte("="), 2)
+	if len(kv) != 2 {
+		return abci.ResponseDeliverTx{Code: 1}
+	}
+	a.set(kv[0], kv[1])
+	return abci.ResponseDeliverTx{}
+}
+
+func (a *kvApp) set(key, value []byte) {
+	if a.batch == nil {
+		a.batch = a.kv.CacheWrap()
+	}
+	if err := a.batch.Set(key, value); err != nil {
+		panic(err)
+	}
+}
+
+func (a *kvApp) Commit() abci.ResponseCommit {
+	if a.batch != nil {
+		if err := a.batch.Write(); err != nil {
+			panic(err)
+		}
+		a.batch = nil
+	}
+	id, err := a.kv.Commit()
+	if err != nil {
+		panic(err)
+	}
+	return abci.ResponseCommit{Data: id.Hash}
+}
+
+func (a *kvApp) Query(req abci.RequestQuery) abci.ResponseQuery {
+	value, err := a.kv.Get(req.Data)
+	if err != nil {
+		return abci.ResponseQuery{Code: 1, Log: err.Error()}
+	}
+	return abci.ResponseQuery{Key: req.Data, Value: value}
+}
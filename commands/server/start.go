@@ -1,9 +1,14 @@
 package server
 
 import (
+	"expvar"
 	"flag"
+	"net/http"
+	"time"
+
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store/iavl"
 	"github.com/tendermint/tendermint/abci/server"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
@@ -11,16 +16,53 @@ import (
 )
 
 const (
-	flagBind   = "bind"
-	flagDebug  = "debug"
-	flagMinFee = "min_fee"
+	flagBind              = "bind"
+	flagDebug             = "debug"
+	flagMinFee            = "min_fee"
+	flagRateLimit         = "rate-limit"
+	flagRateLimitWindow   = "rate-limit-window"
+	flagTxDeadline        = "tx-deadline"
+	flagMetrics           = "metrics"
+	flagDBBackend         = "db-backend"
+	flagDBCacheSize       = "db-cache-size"
+	flagDBHistory         = "db-history"
+	flagDBPruning         = "db-pruning"
+	flagDBKeepEvery       = "db-keep-every"
+	flagDBCompact         = "db-compact-interval"
+	flagStateStream       = "state-stream"
+	flagLevelDBBlockCache = "goleveldb-block-cache"
+	flagLevelDBWriteBuf   = "goleveldb-write-buffer"
+	flagBadgerValueLog    = "badger-value-log-size"
+	flagBadgerCompactors  = "badger-compactors"
 )
 
 type Options struct {
 	MinFee coin.Coin
-	Debug  bool
-	Home   string
-	Logger log.Logger
+	// RateLimit is the maximum number of transactions accepted into the
+	// mempool from a single signer within RateLimitWindow blocks. Zero
+	// disables the limit.
+	RateLimit       int
+	RateLimitWindow int64
+	// TxDeadline is the maximum wall-clock time a single transaction can be
+	// checked for. Delivering a transaction is never limited. Zero disables
+	// the deadline.
+	TxDeadline time.Duration
+	// Metrics is the address the expvar metrics are served on. Empty
+	// value disables the metrics endpoint. The same address serves the
+	// /admin/compact endpoint that triggers the store compaction.
+	Metrics string
+	// CompactInterval is the time between periodic compactions of the
+	// store. Zero disables periodic compaction.
+	CompactInterval time.Duration
+	// StateStream is the target that all state changes are streamed to,
+	// see iavl.OpenChangeStream. Empty value disables the stream.
+	StateStream string
+	Debug       bool
+	Home        string
+	Logger      log.Logger
+	// DB configures the database backend used to persist the
+	// application state. Zero value uses the default configuration.
+	DB iavl.Options
 }
 
 func parseFlags(args []string) (string, *Options, error) {
@@ -34,13 +76,47 @@ func parseFlags(args []string) (string, *Options, error) {
 	startFlags := flag.NewFlagSet("start", flag.ExitOnError)
 	startFlags.StringVar(&addr, flagBind, "tcp://localhost:26658", "address server listens on")
 	startFlags.StringVar(&minFeeStr, flagMinFee, "0 IOV", "minimal anti-spam fee")
+	startFlags.IntVar(&options.RateLimit, flagRateLimit, 0,
+		"maximum number of transactions per signer accepted into the mempool within the rate limit window (0 disables the limit)")
+	startFlags.Int64Var(&options.RateLimitWindow, flagRateLimitWindow, 10,
+		"size of the rate limit window in blocks")
+	startFlags.DurationVar(&options.TxDeadline, flagTxDeadline, 0,
+		"maximum time a transaction can be checked for before it is accepted into the mempool (0 disables the deadline)")
+	startFlags.StringVar(&options.Metrics, flagMetrics, "",
+		"address metrics are served on at /debug/vars and store compaction is triggered on at /admin/compact, for example localhost:26660 (empty disables the endpoints)")
 	startFlags.BoolVar(&options.Debug, flagDebug, false, "call stack returned on error")
+	startFlags.StringVar(&options.DB.Backend, flagDBBackend, iavl.GoLevelDBBackend,
+		"database backend: goleveldb, cleveldb, badger or memdb")
+	startFlags.IntVar(&options.DB.CacheSize, flagDBCacheSize, iavl.DefaultCacheSize,
+		"number of iavl tree nodes cached in memory")
+	startFlags.Int64Var(&options.DB.History, flagDBHistory, iavl.DefaultHistory,
+		"number of past state versions kept on disk")
+	startFlags.StringVar(&options.DB.Pruning, flagDBPruning, iavl.PruneKeepLast,
+		"strategy of releasing past state versions: keep-last, keep-every or nothing")
+	startFlags.Int64Var(&options.DB.KeepEvery, flagDBKeepEvery, 0,
+		"interval of state versions that are never released when using keep-every pruning")
+	startFlags.DurationVar(&options.CompactInterval, flagDBCompact, 0,
+		"time between compactions of the database that reclaim the space of released state versions (0 disables periodic compaction)")
+	startFlags.StringVar(&options.StateStream, flagStateStream, "",
+		"stream all state changes of each block to a file path or to clients connected to a tcp:// or unix:// address (empty disables the stream)")
+	startFlags.IntVar(&options.DB.BlockCacheSize, flagLevelDBBlockCache, 0,
+		"goleveldb block cache size in MiB (default: goleveldb default)")
+	startFlags.IntVar(&options.DB.WriteBufferSize, flagLevelDBWriteBuf, 0,
+		"goleveldb write buffer size in MiB (default: goleveldb default)")
+	startFlags.IntVar(&options.DB.BadgerValueLogFileSize, flagBadgerValueLog, 0,
+		"badger value log file size in MiB (default: badger default)")
+	startFlags.IntVar(&options.DB.BadgerNumCompactors, flagBadgerCompactors, 0,
+		"number of badger compaction workers (default: badger default)")
 	err := startFlags.Parse(args)
 
 	if err != nil {
 		return addr, options, err
 	}
 
+	if err := options.DB.Validate(); err != nil {
+		return addr, options, errors.Wrap(err, "database options")
+	}
+
 	options.MinFee, err = coin.ParseHumanFormat(minFeeStr)
 
 	return addr, options, err
@@ -59,6 +135,15 @@ func StartCmd(gen AppGenerator, logger log.Logger, home string, args []string) e
 	options.Home = home
 	options.Logger = logger
 
+	if options.StateStream != "" {
+		listener, err := iavl.OpenChangeStream(options.StateStream)
+		if err != nil {
+			return errors.Wrap(err, "state stream")
+		}
+		options.DB.Listener = listener
+		logger.Info("Streaming state changes", "target", options.StateStream)
+	}
+
 	// Generate the app in the proper dir
 	app, err := gen(options)
 	if err != nil {
@@ -74,6 +159,29 @@ func StartCmd(gen AppGenerator, logger log.Logger, home string, args []string) e
 
 	svr.SetLogger(logger.With("module", "abci-server"))
 
+	c, canCompact := app.(compacter)
+	if options.CompactInterval > 0 {
+		if !canCompact {
+			return errors.Wrap(errors.ErrInput, "application does not support store compaction")
+		}
+		logger.Info("Compacting store periodically", "interval", options.CompactInterval)
+		go compactEvery(c, options.CompactInterval, logger.With("module", "compaction"))
+	}
+
+	if options.Metrics != "" {
+		logger.Info("Serving metrics", "bind", options.Metrics)
+		go func() {
+			mux := http.NewServeMux()
+			mux.Handle("/debug/vars", expvar.Handler())
+			if canCompact {
+				mux.Handle("/admin/compact", compactHandler(c, logger.With("module", "compaction")))
+			}
+			if err := http.ListenAndServe(options.Metrics, mux); err != nil {
+				logger.Error("metrics server failed", "err", err)
+			}
+		}()
+	}
+
 	done := make(chan bool)
 	cleanupCallback := func() {
 		// Cleanup
@@ -52,3 +52,16 @@ type Scheduler interface {
 	// ErrNotFound if task with given ID is not present in the queue.
 	Delete(KVStore, []byte) error
 }
+
+// HeightScheduler is implemented by schedulers that can queue message
+// execution at a given block height instead of a given time.
+type HeightScheduler interface {
+	Scheduler
+
+	// ScheduleAtHeight queues given message in the database to be
+	// executed at the beginning of the block with given height. Message
+	// will be executed with context containing provided authentication
+	// addresses. Use Delete to cancel the task.
+	// When successful, returns the scheduled task ID.
+	ScheduleAtHeight(KVStore, int64, []Condition, Msg) ([]byte, error)
+}
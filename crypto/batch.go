@@ -0,0 +1,73 @@
+package crypto
+
+import (
+	stded25519 "crypto/ed25519"
+
+	"github.com/hdevalence/ed25519consensus"
+)
+
+// BatchVerifier collects signatures in order to verify them all at once.
+// Ed25519 signatures are verified using batch verification, which is
+// significantly faster than verifying each signature separately. Signatures of
+// other schemes are verified one by one.
+//
+// Batch verification follows the ZIP-215 rules, that differ from the Verify
+// method of an ed25519 public key for non canonical encodings. If the batch
+// fails, each ed25519 signature is verified again separately using the
+// Verify method, so that a signature accepted by the Verify method never
+// fails the batch.
+type BatchVerifier struct {
+	ed25519 ed25519consensus.BatchVerifier
+	edsigs  []batchEntry
+	others  []batchEntry
+}
+
+type batchEntry struct {
+	pubkey  *PublicKey
+	message []byte
+	sig     *Signature
+}
+
+// NewBatchVerifier returns a new instance of an empty batch verifier.
+func NewBatchVerifier() *BatchVerifier {
+	return &BatchVerifier{
+		ed25519: ed25519consensus.NewBatchVerifier(),
+	}
+}
+
+// Add adds a signature of given message to the batch.
+func (b *BatchVerifier) Add(pubkey *PublicKey, message []byte, sig *Signature) {
+	edpub, ok := pubkey.GetPub().(*PublicKey_Ed25519)
+	if !ok {
+		b.others = append(b.others, batchEntry{pubkey: pubkey, message: message, sig: sig})
+		return
+	}
+	edsig, ok := sig.GetSig().(*Signature_Ed25519)
+	if !ok || len(edpub.Ed25519) != stded25519.PublicKeySize {
+		// This signature is invalid. Verify it separately to fail
+		// the batch.
+		b.others = append(b.others, batchEntry{pubkey: pubkey, message: message, sig: sig})
+		return
+	}
+	b.ed25519.Add(stded25519.PublicKey(edpub.Ed25519), message, edsig.Ed25519)
+	b.edsigs = append(b.edsigs, batchEntry{pubkey: pubkey, message: message, sig: sig})
+}
+
+// Verify returns true if all signatures added to the batch are valid. An
+// empty batch is valid.
+func (b *BatchVerifier) Verify() bool {
+	for _, e := range b.others {
+		if !e.pubkey.Verify(e.message, e.sig) {
+			return false
+		}
+	}
+	if len(b.edsigs) == 0 || b.ed25519.Verify() {
+		return true
+	}
+	for _, e := range b.edsigs {
+		if !e.pubkey.Verify(e.message, e.sig) {
+			return false
+		}
+	}
+	return true
+}
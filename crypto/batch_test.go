@@ -0,0 +1,101 @@
+package crypto
+
+import (
+	"testing"
+)
+
+func TestBatchVerifier(t *testing.T) {
+	msg := []byte("foobar")
+	msg2 := []byte("dingbooms")
+
+	ed := GenPrivKeyEd25519()
+	ed2 := GenPrivKeyEd25519()
+	sr := GenPrivKeySr25519()
+
+	sign := func(t testing.TB, key *PrivateKey, msg []byte) *Signature {
+		t.Helper()
+		sig, err := key.Sign(msg)
+		if err != nil {
+			t.Fatalf("cannot sign: %s", err)
+		}
+		return sig
+	}
+
+	type entry struct {
+		pubkey  *PublicKey
+		message []byte
+		sig     *Signature
+	}
+
+	cases := map[string]struct {
+		entries []entry
+		want    bool
+	}{
+		"empty batch is valid": {
+			entries: nil,
+			want:    true,
+		},
+		"valid ed25519 signatures": {
+			entries: []entry{
+				{ed.PublicKey(), msg, sign(t, ed, msg)},
+				{ed.PublicKey(), msg2, sign(t, ed, msg2)},
+				{ed2.PublicKey(), msg, sign(t, ed2, msg)},
+			},
+			want: true,
+		},
+		"one invalid ed25519 signature": {
+			entries: []entry{
+				{ed.PublicKey(), msg, sign(t, ed, msg)},
+				{ed2.PublicKey(), msg, sign(t, ed, msg)},
+				{ed.PublicKey(), msg2, sign(t, ed, msg2)},
+			},
+			want: false,
+		},
+		"signature of a different message": {
+			entries: []entry{
+				{ed.PublicKey(), msg, sign(t, ed, msg2)},
+			},
+			want: false,
+		},
+		"valid mixed signatures": {
+			entries: []entry{
+				{ed.PublicKey(), msg, sign(t, ed, msg)},
+				{sr.PublicKey(), msg, sign(t, sr, msg)},
+				{ed2.PublicKey(), msg2, sign(t, ed2, msg2)},
+			},
+			want: true,
+		},
+		"invalid sr25519 signature in a mixed batch": {
+			entries: []entry{
+				{ed.PublicKey(), msg, sign(t, ed, msg)},
+				{sr.PublicKey(), msg, sign(t, sr, msg2)},
+			},
+			want: false,
+		},
+		"signature of a different scheme": {
+			entries: []entry{
+				{ed.PublicKey(), msg, sign(t, sr, msg)},
+			},
+			want: false,
+		},
+		"missing signature": {
+			entries: []entry{
+				{ed.PublicKey(), msg, sign(t, ed, msg)},
+				{ed2.PublicKey(), msg, &Signature{}},
+			},
+			want: false,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			bv := NewBatchVerifier()
+			for _, e := range tc.entries {
+				bv.Add(e.pubkey, e.message, e.sig)
+			}
+			if got := bv.Verify(); got != tc.want {
+				t.Fatalf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
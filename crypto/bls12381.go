@@ -0,0 +1,248 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"errors"
+
+	"github.com/iov-one/weave"
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+// BLS12-381 keys use the minimal public key size variant. Public keys are
+// compressed G1 points and signatures are compressed G2 points, as defined by
+// the IETF BLS signature draft and used by Ethereum 2.0.
+const (
+	bls12381PubKeySize  = 48
+	bls12381SigSize     = 96
+	bls12381PrivKeySize = 32
+)
+
+var (
+	// bls12381SignatureDST is the domain separation tag used when hashing
+	// messages to the curve.
+	bls12381SignatureDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	// bls12381ProofDST is the domain separation tag used when hashing a
+	// public key to create a proof of possession.
+	bls12381ProofDST = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+)
+
+var _ PubKey = (*PublicKey_Bls12381)(nil)
+
+// Verify verifies the signature was created with this message and public key
+func (p *PublicKey_Bls12381) Verify(message []byte, sig *Signature) bool {
+	blssig, ok := sig.GetSig().(*Signature_Bls12381)
+	if !ok {
+		return false
+	}
+	pub, err := decodeBLS12381PubKey(p.Bls12381)
+	if err != nil {
+		return false
+	}
+	return verifyBLS12381(pub, message, blssig.Bls12381, bls12381SignatureDST)
+}
+
+// Condition encodes the public key into a weave permission
+func (p *PublicKey_Bls12381) Condition() weave.Condition {
+	return weave.NewCondition(ExtensionName, "bls12381", p.Bls12381)
+}
+
+var _ Signer = (*PrivateKey_Bls12381)(nil)
+
+// Sign returns a matching signature for this private key
+func (p *PrivateKey_Bls12381) Sign(message []byte) (*Signature, error) {
+	bz, err := signBLS12381(p.Bls12381, message, bls12381SignatureDST)
+	if err != nil {
+		return nil, err
+	}
+	return &Signature{
+		Sig: &Signature_Bls12381{
+			Bls12381: bz,
+		},
+	}, nil
+}
+
+// PublicKey returns the corresponding PublicKey
+func (p *PrivateKey_Bls12381) PublicKey() *PublicKey {
+	secret, err := decodeBLS12381PrivKey(p.Bls12381)
+	if err != nil {
+		panic(err)
+	}
+	g1 := bls12381.NewG1()
+	pub := g1.New()
+	g1.MulScalar(pub, g1.One(), secret)
+	return &PublicKey{
+		Pub: &PublicKey_Bls12381{
+			Bls12381: g1.ToCompressed(pub),
+		},
+	}
+}
+
+// GenPrivKeyBls12381 returns a random new private key
+func GenPrivKeyBls12381() *PrivateKey {
+	for {
+		secret, err := bls12381.NewFr().Rand(rand.Reader)
+		if err != nil {
+			panic(err)
+		}
+		if secret.IsZero() {
+			continue
+		}
+		return &PrivateKey{
+			Priv: &PrivateKey_Bls12381{
+				Bls12381: secret.ToBytes(),
+			},
+		}
+	}
+}
+
+// SignBLS12381ProofOfPossession returns a proof that the owner of the public
+// key has access to the private key. It is a signature of the public key
+// created using a dedicated domain separation tag.
+//
+// Signatures created by different keys can be safely aggregated only if the
+// proof of possession of each key was verified. Otherwise a rogue key attack
+// is possible.
+func SignBLS12381ProofOfPossession(key *PrivateKey) (*Signature, error) {
+	priv := key.GetBls12381()
+	if priv == nil {
+		return nil, errors.New("not a bls12381 private key")
+	}
+	bz, err := signBLS12381(priv, key.PublicKey().GetBls12381(), bls12381ProofDST)
+	if err != nil {
+		return nil, err
+	}
+	return &Signature{
+		Sig: &Signature_Bls12381{
+			Bls12381: bz,
+		},
+	}, nil
+}
+
+// VerifyBLS12381ProofOfPossession returns true if the proof of possession
+// was created by the owner of the public key.
+func VerifyBLS12381ProofOfPossession(key *PublicKey, proof *Signature) bool {
+	raw := key.GetBls12381()
+	sig := proof.GetBls12381()
+	if raw == nil || sig == nil {
+		return false
+	}
+	pub, err := decodeBLS12381PubKey(raw)
+	if err != nil {
+		return false
+	}
+	return verifyBLS12381(pub, raw, sig, bls12381ProofDST)
+}
+
+// AggregateBLS12381Signatures returns a single signature that combines all
+// given BLS12-381 signatures.
+func AggregateBLS12381Signatures(sigs ...*Signature) (*Signature, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signatures")
+	}
+	g2 := bls12381.NewG2()
+	agg := g2.Zero()
+	for _, s := range sigs {
+		raw := s.GetBls12381()
+		if len(raw) != bls12381SigSize {
+			return nil, errors.New("not a bls12381 signature")
+		}
+		p, err := g2.FromCompressed(raw)
+		if err != nil {
+			return nil, err
+		}
+		g2.Add(agg, agg, p)
+	}
+	return &Signature{
+		Sig: &Signature_Bls12381{
+			Bls12381: g2.ToCompressed(agg),
+		},
+	}, nil
+}
+
+// VerifyAggregateBLS12381 returns true if the aggregated signature was
+// created by all given public keys signing the same message. Proof of
+// possession of all public keys must be verified beforehand.
+func VerifyAggregateBLS12381(pubkeys []*PublicKey, message []byte, sig *Signature) bool {
+	if len(pubkeys) == 0 {
+		return false
+	}
+	raw := sig.GetBls12381()
+	if raw == nil {
+		return false
+	}
+	g1 := bls12381.NewG1()
+	agg := g1.Zero()
+	for _, pk := range pubkeys {
+		p, err := decodeBLS12381PubKey(pk.GetBls12381())
+		if err != nil {
+			return false
+		}
+		g1.Add(agg, agg, p)
+	}
+	return verifyBLS12381(agg, message, raw, bls12381SignatureDST)
+}
+
+// signBLS12381 returns a compressed signature of the message.
+func signBLS12381(rawPriv, message, dst []byte) ([]byte, error) {
+	secret, err := decodeBLS12381PrivKey(rawPriv)
+	if err != nil {
+		return nil, err
+	}
+	g2 := bls12381.NewG2()
+	h, err := g2.HashToCurve(message, dst)
+	if err != nil {
+		return nil, err
+	}
+	sig := g2.New()
+	g2.MulScalar(sig, h, secret)
+	return g2.ToCompressed(sig), nil
+}
+
+// verifyBLS12381 returns true if e(pub, H(message)) == e(G1, sig).
+func verifyBLS12381(pub *bls12381.PointG1, message, rawSig, dst []byte) bool {
+	if len(rawSig) != bls12381SigSize {
+		return false
+	}
+	g2 := bls12381.NewG2()
+	sig, err := g2.FromCompressed(rawSig)
+	if err != nil || !g2.InCorrectSubgroup(sig) {
+		return false
+	}
+	h, err := g2.HashToCurve(message, dst)
+	if err != nil {
+		return false
+	}
+	e := bls12381.NewEngine()
+	e.AddPairInv(bls12381.NewG1().One(), sig)
+	e.AddPair(pub, h)
+	return e.Check()
+}
+
+// decodeBLS12381PubKey returns the public key point. Points at infinity and
+// points outside of the prime order subgroup are rejected.
+func decodeBLS12381PubKey(raw []byte) (*bls12381.PointG1, error) {
+	if len(raw) != bls12381PubKeySize {
+		return nil, errors.New("invalid bls12381 public key length")
+	}
+	g1 := bls12381.NewG1()
+	p, err := g1.FromCompressed(raw)
+	if err != nil {
+		return nil, err
+	}
+	if g1.IsZero(p) || !g1.InCorrectSubgroup(p) {
+		return nil, errors.New("invalid bls12381 public key")
+	}
+	return p, nil
+}
+
+// decodeBLS12381PrivKey returns the private key scalar.
+func decodeBLS12381PrivKey(raw []byte) (*bls12381.Fr, error) {
+	if len(raw) != bls12381PrivKeySize {
+		return nil, errors.New("invalid bls12381 private key length")
+	}
+	secret := bls12381.NewFr().FromBytes(raw)
+	if secret.IsZero() {
+		return nil, errors.New("invalid bls12381 private key")
+	}
+	return secret, nil
+}
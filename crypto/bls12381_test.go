@@ -0,0 +1,129 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestBls12381Signing(t *testing.T) {
+	private := GenPrivKeyBls12381()
+	public := private.PublicKey()
+
+	msg := []byte("foobar")
+	msg2 := []byte("dingbooms")
+
+	sig, err := private.Sign(msg)
+	assert.Nil(t, err)
+	sig2, err := private.Sign(msg2)
+	assert.Nil(t, err)
+
+	if !public.Verify(msg, sig) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+	if !public.Verify(msg2, sig2) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+	if public.Verify(msg, sig2) {
+		t.Fatal("verified message signature of the wrong message")
+	}
+	if public.Verify(msg, &Signature{}) {
+		t.Fatal("verified an empty signature of a message")
+	}
+	if GenPrivKeyBls12381().PublicKey().Verify(msg, sig) {
+		t.Fatal("verified a signature created with a different key")
+	}
+
+	// Proof of possession must not be usable as a message signature.
+	proof, err := SignBLS12381ProofOfPossession(private)
+	assert.Nil(t, err)
+	if public.Verify(public.GetBls12381(), proof) {
+		t.Fatal("proof of possession verified as a signature")
+	}
+}
+
+func TestBls12381Address(t *testing.T) {
+	pub := GenPrivKeyBls12381().PublicKey()
+	pub2 := GenPrivKeyBls12381().PublicKey()
+
+	assert.Nil(t, pub.Condition().Validate())
+	if bytes.Equal(pub.Condition(), pub2.Condition()) {
+		t.Fatal("different public keys produce the same condition")
+	}
+}
+
+func TestBls12381ProofOfPossession(t *testing.T) {
+	key := GenPrivKeyBls12381()
+	other := GenPrivKeyBls12381()
+
+	proof, err := SignBLS12381ProofOfPossession(key)
+	assert.Nil(t, err)
+	if !VerifyBLS12381ProofOfPossession(key.PublicKey(), proof) {
+		t.Fatal("cannot verify the proof of possession")
+	}
+	if VerifyBLS12381ProofOfPossession(other.PublicKey(), proof) {
+		t.Fatal("proof of possession verified with a different key")
+	}
+
+	// Signature of the public key is not a proof of possession.
+	sig, err := key.Sign(key.PublicKey().GetBls12381())
+	assert.Nil(t, err)
+	if VerifyBLS12381ProofOfPossession(key.PublicKey(), sig) {
+		t.Fatal("signature verified as a proof of possession")
+	}
+
+	if _, err := SignBLS12381ProofOfPossession(GenPrivKeyEd25519()); err == nil {
+		t.Fatal("created a proof of possession of an ed25519 key")
+	}
+}
+
+func TestBls12381Aggregation(t *testing.T) {
+	keys := []*PrivateKey{
+		GenPrivKeyBls12381(),
+		GenPrivKeyBls12381(),
+		GenPrivKeyBls12381(),
+	}
+	msg := []byte("attestation")
+
+	var (
+		pubkeys []*PublicKey
+		sigs    []*Signature
+	)
+	for _, k := range keys {
+		sig, err := k.Sign(msg)
+		assert.Nil(t, err)
+		sigs = append(sigs, sig)
+		pubkeys = append(pubkeys, k.PublicKey())
+	}
+
+	agg, err := AggregateBLS12381Signatures(sigs...)
+	assert.Nil(t, err)
+	if !VerifyAggregateBLS12381(pubkeys, msg, agg) {
+		t.Fatal("cannot verify the aggregated signature")
+	}
+	if VerifyAggregateBLS12381(pubkeys[:2], msg, agg) {
+		t.Fatal("aggregated signature verified with a missing public key")
+	}
+	if VerifyAggregateBLS12381(pubkeys, []byte("other"), agg) {
+		t.Fatal("aggregated signature verified for a different message")
+	}
+	if VerifyAggregateBLS12381(nil, msg, agg) {
+		t.Fatal("aggregated signature verified without public keys")
+	}
+
+	partial, err := AggregateBLS12381Signatures(sigs[0], sigs[2])
+	assert.Nil(t, err)
+	if !VerifyAggregateBLS12381([]*PublicKey{pubkeys[0], pubkeys[2]}, msg, partial) {
+		t.Fatal("cannot verify the partial aggregated signature")
+	}
+
+	edsig, err := GenPrivKeyEd25519().Sign(msg)
+	assert.Nil(t, err)
+	if _, err := AggregateBLS12381Signatures(sigs[0], edsig); err == nil {
+		t.Fatal("aggregated an ed25519 signature")
+	}
+	if _, err := AggregateBLS12381Signatures(); err == nil {
+		t.Fatal("aggregated no signatures")
+	}
+}
@@ -0,0 +1,188 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/tyler-smith/go-bip39"
+	"golang.org/x/crypto/ed25519"
+)
+
+// hardenedOffset is added to the index of a hardened derivation path
+// segment, as described in BIP-32.
+const hardenedOffset = 0x80000000
+
+// ValidateMnemonic returns an error if given mnemonic is not a valid BIP-39
+// mnemonic. Whitespaces are relevant.
+//
+// Use this instead of bip39.IsMnemonicValid because this function ensures the
+// checksum consistency. bip39.IsMnemonicValid does not test the checksum. It
+// also ignores whitespaces.
+//
+// This function ensures that the mnemonic is a single space separated list of
+// words as this is important during seed creation.
+func ValidateMnemonic(mnemonic string) error {
+	// A lazy way to check that words are exactly single space separated.
+	expected := strings.Join(strings.Fields(mnemonic), " ")
+	if mnemonic != expected {
+		return errors.New("whitespace violation")
+	}
+
+	// Entropy generation does base validation of checking if words are
+	// valid and in the right amount. It also tests the checksum.
+	if _, err := bip39.EntropyFromMnemonic(mnemonic); err != nil {
+		return fmt.Errorf("entropy: %s", err)
+	}
+	return nil
+}
+
+// mnemonicSeed returns the BIP-39 seed of given mnemonic. Passphrase is not
+// supported.
+func mnemonicSeed(mnemonic string) ([]byte, error) {
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, fmt.Errorf("invalid mnemonic: %s", err)
+	}
+	return bip39.NewSeed(mnemonic, ""), nil
+}
+
+// DeriveEd25519 returns an ed25519 private key derived from given BIP-39
+// mnemonic using SLIP-0010. Only hardened derivation is supported for ed25519
+// keys, for example "m/44'/234'/0'".
+func DeriveEd25519(mnemonic, path string) (*PrivateKey, error) {
+	seed, err := mnemonicSeed(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	return DeriveEd25519FromSeed(seed, path)
+}
+
+// DeriveEd25519FromSeed returns an ed25519 private key derived from given
+// seed using SLIP-0010.
+func DeriveEd25519FromSeed(seed []byte, path string) (*PrivateKey, error) {
+	indexes, err := parseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+	key, chain := hmacSplit([]byte("ed25519 seed"), seed)
+	for _, index := range indexes {
+		if index < hardenedOffset {
+			return nil, errors.New("ed25519 supports only hardened derivation")
+		}
+		data := make([]byte, 0, 1+len(key)+4)
+		data = append(data, 0)
+		data = append(data, key...)
+		data = appendUint32(data, index)
+		key, chain = hmacSplit(chain, data)
+	}
+	return &PrivateKey{
+		Priv: &PrivateKey_Ed25519{
+			Ed25519: ed25519.NewKeyFromSeed(key),
+		},
+	}, nil
+}
+
+// DeriveSecp256k1 returns a secp256k1 private key derived from given BIP-39
+// mnemonic using BIP-32. Returned is the 32 bytes long, big endian encoded
+// private key scalar.
+//
+// Secp256k1 keys cannot be used to sign weave transactions. This function
+// exists to derive keys for other chains consistently with wallets.
+func DeriveSecp256k1(mnemonic, path string) ([]byte, error) {
+	seed, err := mnemonicSeed(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	return deriveSecp256k1FromSeed(seed, path)
+}
+
+// deriveSecp256k1FromSeed returns a secp256k1 private key derived from given
+// seed using BIP-32.
+func deriveSecp256k1FromSeed(seed []byte, path string) ([]byte, error) {
+	indexes, err := parseDerivationPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	curve := btcec.S256()
+	key, chain := hmacSplit([]byte("Bitcoin seed"), seed)
+	k := new(big.Int).SetBytes(key)
+	if k.Sign() == 0 || k.Cmp(curve.N) >= 0 {
+		return nil, errors.New("invalid master key")
+	}
+	for _, index := range indexes {
+		var data []byte
+		if index >= hardenedOffset {
+			data = append([]byte{0}, padScalar(k)...)
+		} else {
+			_, pub := btcec.PrivKeyFromBytes(curve, padScalar(k))
+			data = pub.SerializeCompressed()
+		}
+		data = appendUint32(data, index)
+
+		var il []byte
+		il, chain = hmacSplit(chain, data)
+		t := new(big.Int).SetBytes(il)
+		if t.Cmp(curve.N) >= 0 {
+			return nil, fmt.Errorf("invalid child key at index %d", index)
+		}
+		k = t.Add(t, k)
+		k.Mod(k, curve.N)
+		if k.Sign() == 0 {
+			return nil, fmt.Errorf("invalid child key at index %d", index)
+		}
+	}
+	return padScalar(k), nil
+}
+
+// parseDerivationPath returns indexes of all segments of a derivation path in
+// the "m/44'/234'/0'" format. Hardened indexes are returned with the
+// hardened offset added.
+func parseDerivationPath(path string) ([]uint32, error) {
+	segments := strings.Split(path, "/")
+	if segments[0] != "m" {
+		return nil, errors.New("derivation path must start with m")
+	}
+	indexes := make([]uint32, 0, len(segments)-1)
+	for _, s := range segments[1:] {
+		var offset uint32
+		if strings.HasSuffix(s, "'") {
+			offset = hardenedOffset
+			s = s[:len(s)-1]
+		}
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil || n >= hardenedOffset {
+			return nil, fmt.Errorf("invalid derivation path segment %q", s)
+		}
+		indexes = append(indexes, uint32(n)+offset)
+	}
+	return indexes, nil
+}
+
+// hmacSplit returns both halves of the HMAC-SHA512 of given data.
+func hmacSplit(key, data []byte) ([]byte, []byte) {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	sum := mac.Sum(nil)
+	return sum[:32], sum[32:]
+}
+
+func appendUint32(b []byte, n uint32) []byte {
+	var raw [4]byte
+	binary.BigEndian.PutUint32(raw[:], n)
+	return append(b, raw[:]...)
+}
+
+// padScalar returns the 32 bytes long, big endian encoding of given scalar.
+func padScalar(n *big.Int) []byte {
+	raw := n.Bytes()
+	out := make([]byte, 32)
+	copy(out[32-len(raw):], raw)
+	return out
+}
@@ -0,0 +1,170 @@
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+const testMnemonic = `shy else mystery outer define there front bracket dawn honey excuse virus lazy book kiss cannon oven law coconut hedgehog veteran narrow great cage`
+
+func TestDeriveEd25519FromSeed(t *testing.T) {
+	// Test vector 1 from SLIP-0010.
+	seed := fromHex(t, "000102030405060708090a0b0c0d0e0f")
+
+	cases := map[string]struct {
+		Path    string
+		WantKey string
+		WantErr bool
+	}{
+		"master key": {
+			Path:    "m",
+			WantKey: "2b4be7f19ee27bbf30c667b642d5f4aa69fd169872f8fc3059c08ebae2eb19e7",
+		},
+		"single segment": {
+			Path:    "m/0'",
+			WantKey: "68e0fe46dfb67e368c75379acec591dad19df3cde26e63b93a8e704f1dade7a3",
+		},
+		"many segments": {
+			Path:    "m/0'/1'/2'/2'/1000000000'",
+			WantKey: "8f94d394a8e8fd6b1bc2f3f49f5c47e385281d5c17e65324b0f62483e37e8793",
+		},
+		"non hardened segment": {
+			Path:    "m/0'/1",
+			WantErr: true,
+		},
+		"invalid path": {
+			Path:    "44'/234'/0'",
+			WantErr: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			key, err := DeriveEd25519FromSeed(seed, tc.Path)
+			if tc.WantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cannot derive: %s", err)
+			}
+			if got := hex.EncodeToString(key.GetEd25519()[:32]); got != tc.WantKey {
+				t.Fatalf("want %s key, got %s", tc.WantKey, got)
+			}
+		})
+	}
+}
+
+func TestDeriveEd25519(t *testing.T) {
+	key, err := DeriveEd25519(testMnemonic, "m/44'/234'/0'")
+	if err != nil {
+		t.Fatalf("cannot derive: %s", err)
+	}
+	const want = "4f0e10cf49d0a4c23288af31c4282079daf78cc45bfd11e02499d3d44465135a"
+	if got := hex.EncodeToString(key.GetEd25519()[:32]); got != want {
+		t.Fatalf("want %s key, got %s", want, got)
+	}
+
+	msg := []byte("derived key can sign")
+	sig, err := key.Sign(msg)
+	if err != nil {
+		t.Fatalf("cannot sign: %s", err)
+	}
+	if !key.PublicKey().Verify(msg, sig) {
+		t.Fatal("invalid signature")
+	}
+
+	if _, err := DeriveEd25519(testMnemonic+" ", "m/44'/234'/0'"); err == nil {
+		t.Fatal("invalid mnemonic must not be accepted")
+	}
+}
+
+func TestDeriveSecp256k1(t *testing.T) {
+	// Test vector 1 from BIP-32.
+	seed := fromHex(t, "000102030405060708090a0b0c0d0e0f")
+
+	cases := map[string]struct {
+		Path    string
+		WantKey string
+	}{
+		"master key": {
+			Path:    "m",
+			WantKey: "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35",
+		},
+		"hardened segment": {
+			Path:    "m/0'",
+			WantKey: "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea",
+		},
+		"non hardened segment": {
+			Path:    "m/0'/1",
+			WantKey: "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368",
+		},
+		"many segments": {
+			Path:    "m/0'/1/2'/2/1000000000",
+			WantKey: "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8",
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			key, err := deriveSecp256k1FromSeed(seed, tc.Path)
+			if err != nil {
+				t.Fatalf("cannot derive: %s", err)
+			}
+			if got := hex.EncodeToString(key); got != tc.WantKey {
+				t.Fatalf("want %s key, got %s", tc.WantKey, got)
+			}
+		})
+	}
+
+	key, err := DeriveSecp256k1(testMnemonic, "m/44'/60'/0'/0/0")
+	if err != nil {
+		t.Fatalf("cannot derive: %s", err)
+	}
+	const want = "d1d8326665463762f36606459f2891daf9822fc524f6463f44d790d54a1cfe1b"
+	if got := hex.EncodeToString(key); got != want {
+		t.Fatalf("want %s key, got %s", want, got)
+	}
+}
+
+func TestValidateMnemonic(t *testing.T) {
+	cases := map[string]struct {
+		Mnemonic string
+		WantErr  bool
+	}{
+		"valid mnemonic 12 words": {
+			Mnemonic: "super bulk plunge better rookie donor reward obscure rescue type trade pelican",
+		},
+		"valid mnemonic 24 words": {
+			Mnemonic: testMnemonic,
+		},
+		"additional whitespace": {
+			Mnemonic: "super bulk plunge better rookie    donor reward obscure rescue type trade pelican",
+			WantErr:  true,
+		},
+		"invalid checksum": {
+			Mnemonic: "super bulk plunge better rookie donor reward obscure rescue type trade trade",
+			WantErr:  true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := ValidateMnemonic(tc.Mnemonic)
+			if tc.WantErr != (err != nil) {
+				t.Fatalf("unexpected result: %v", err)
+			}
+		})
+	}
+}
+
+func fromHex(t testing.TB, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("cannot decode hex: %s", err)
+	}
+	return b
+}
@@ -1,5 +1,21 @@
 /*
 Crypto package is used to build, verify and convert signatures. It also defines useful interfaces to
 work with signatures when building new extensions.
+
+Two signature schemes are supported: ed25519 and sr25519. The sr25519 scheme is using the same signing context as
+Substrate based chains, so keys managed by Substrate tooling can be used to sign transactions.
+
+BLS12-381 keys are supported as well. Signatures of the same message created by many keys can be aggregated into a
+single signature. To prevent rogue key attacks, only keys with a verified proof of possession may be aggregated.
+
+Multisig public key is a composite key that requires signatures of a threshold of its member keys. It is verified as a
+single signature, so parties that frequently sign together can use a single account and a single signature entry.
+
+Signer interface is implemented by PrivateKey, that is kept in memory, and by RemoteSigner, that delegates signing to a
+remote service such as a key management service or a hardware security module. Private keys can be stored encrypted
+with a passphrase using EncryptPrivateKey and DecryptPrivateKey.
+
+Keys can be derived from a BIP-39 mnemonic. DeriveEd25519 uses SLIP-0010 and DeriveSecp256k1 uses BIP-32, so that the
+same keys are created as by wallets using the same derivation path.
 */
 package crypto
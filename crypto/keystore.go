@@ -0,0 +1,119 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+// keystoreMagic is the prefix of all encrypted keystore files. It allows to
+// tell an encrypted key apart from a raw one.
+var keystoreMagic = []byte("weave-keystore")
+
+const (
+	keystoreVersion = 1
+	keystoreSaltLen = 32
+
+	// scrypt parameters as recommended for interactive logins.
+	keystoreScryptN = 1 << 15
+	keystoreScryptR = 8
+	keystoreScryptP = 1
+)
+
+// ErrInvalidPassphrase is returned when an encrypted keystore cannot be
+// decrypted with the given passphrase.
+var ErrInvalidPassphrase = errors.New("invalid passphrase")
+
+// EncryptPrivateKey returns the serialized private key encrypted with given
+// passphrase. The encryption key is derived from the passphrase using scrypt
+// and the private key is sealed using NaCl secretbox.
+//
+// The following format is used:
+//
+//	magic    | version | salt     | nonce    | sealed private key
+//	14 bytes | uint8   | 32 bytes | 24 bytes | secretbox of the serialized key
+func EncryptPrivateKey(key *PrivateKey, passphrase []byte) ([]byte, error) {
+	raw, err := key.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("cannot serialize private key: %s", err)
+	}
+
+	salt := make([]byte, keystoreSaltLen)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("cannot generate salt: %s", err)
+	}
+	var nonce [24]byte
+	if _, err := io.ReadFull(rand.Reader, nonce[:]); err != nil {
+		return nil, fmt.Errorf("cannot generate nonce: %s", err)
+	}
+	secret, err := keystoreSecret(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(keystoreMagic)+1+len(salt)+len(nonce)+len(raw)+secretbox.Overhead)
+	out = append(out, keystoreMagic...)
+	out = append(out, keystoreVersion)
+	out = append(out, salt...)
+	out = append(out, nonce[:]...)
+	return secretbox.Seal(out, raw, &nonce, secret), nil
+}
+
+// DecryptPrivateKey returns the private key encrypted using
+// EncryptPrivateKey.
+func DecryptPrivateKey(data []byte, passphrase []byte) (*PrivateKey, error) {
+	if !IsEncryptedKey(data) {
+		return nil, errors.New("not an encrypted keystore")
+	}
+	data = data[len(keystoreMagic):]
+	if data[0] != keystoreVersion {
+		return nil, fmt.Errorf("unsupported keystore version %d", data[0])
+	}
+	data = data[1:]
+	if len(data) < keystoreSaltLen+24+secretbox.Overhead {
+		return nil, errors.New("keystore data too short")
+	}
+	salt := data[:keystoreSaltLen]
+	var nonce [24]byte
+	copy(nonce[:], data[keystoreSaltLen:])
+	sealed := data[keystoreSaltLen+24:]
+
+	secret, err := keystoreSecret(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+	raw, ok := secretbox.Open(nil, sealed, &nonce, secret)
+	if !ok {
+		return nil, ErrInvalidPassphrase
+	}
+	var key PrivateKey
+	if err := key.Unmarshal(raw); err != nil {
+		return nil, fmt.Errorf("cannot deserialize private key: %s", err)
+	}
+	if key.GetPriv() == nil {
+		return nil, errors.New("private key missing")
+	}
+	return &key, nil
+}
+
+// IsEncryptedKey returns true if given data is an encrypted keystore created
+// using EncryptPrivateKey.
+func IsEncryptedKey(data []byte) bool {
+	return len(data) > len(keystoreMagic) && bytes.HasPrefix(data, keystoreMagic)
+}
+
+// keystoreSecret derives a secretbox key from given passphrase.
+func keystoreSecret(passphrase, salt []byte) (*[32]byte, error) {
+	raw, err := scrypt.Key(passphrase, salt, keystoreScryptN, keystoreScryptR, keystoreScryptP, 32)
+	if err != nil {
+		return nil, fmt.Errorf("cannot derive key: %s", err)
+	}
+	var secret [32]byte
+	copy(secret[:], raw)
+	return &secret, nil
+}
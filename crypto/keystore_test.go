@@ -0,0 +1,62 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestKeystoreEncryption(t *testing.T) {
+	cases := map[string]*PrivateKey{
+		"ed25519": GenPrivKeyEd25519(),
+		"sr25519": GenPrivKeySr25519(),
+	}
+	for testName, key := range cases {
+		t.Run(testName, func(t *testing.T) {
+			passphrase := []byte("secret passphrase")
+
+			data, err := EncryptPrivateKey(key, passphrase)
+			assert.Nil(t, err)
+			if !IsEncryptedKey(data) {
+				t.Fatal("encrypted key not recognized")
+			}
+			raw, err := key.Marshal()
+			assert.Nil(t, err)
+			if bytes.Contains(data, raw) {
+				t.Fatal("private key stored as plain text")
+			}
+
+			got, err := DecryptPrivateKey(data, passphrase)
+			assert.Nil(t, err)
+			assert.Equal(t, key.PublicKey().Address(), got.PublicKey().Address())
+
+			if _, err := DecryptPrivateKey(data, []byte("wrong")); err != ErrInvalidPassphrase {
+				t.Fatalf("want invalid passphrase error, got %v", err)
+			}
+
+			// Any modification of the encrypted data must be detected.
+			data[len(data)-1] ^= 1
+			if _, err := DecryptPrivateKey(data, passphrase); err == nil {
+				t.Fatal("corrupted keystore decrypted")
+			}
+		})
+	}
+}
+
+func TestDecryptInvalidKeystore(t *testing.T) {
+	cases := map[string][]byte{
+		"empty":        nil,
+		"raw key":      GenPrivKeyEd25519().GetEd25519(),
+		"magic only":   keystoreMagic,
+		"bad version":  append(append([]byte{}, keystoreMagic...), 0xFF),
+		"missing data": append(append([]byte{}, keystoreMagic...), keystoreVersion, 1, 2, 3),
+	}
+	for testName, data := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if _, err := DecryptPrivateKey(data, []byte("secret")); err == nil {
+				t.Fatal("want an error")
+			}
+		})
+	}
+}
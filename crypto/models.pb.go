@@ -24,6 +24,9 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 type PublicKey struct {
 	// Types that are valid to be assigned to Pub:
 	//	*PublicKey_Ed25519
+	//	*PublicKey_Sr25519
+	//	*PublicKey_Multisig
+	//	*PublicKey_Bls12381
 	Pub isPublicKey_Pub `protobuf_oneof:"pub"`
 }
 
@@ -69,8 +72,20 @@ type isPublicKey_Pub interface {
 type PublicKey_Ed25519 struct {
 	Ed25519 []byte `protobuf:"bytes,1,opt,name=ed25519,proto3,oneof"`
 }
+type PublicKey_Sr25519 struct {
+	Sr25519 []byte `protobuf:"bytes,2,opt,name=sr25519,proto3,oneof"`
+}
+type PublicKey_Multisig struct {
+	Multisig *MultisigPublicKey `protobuf:"bytes,3,opt,name=multisig,proto3,oneof"`
+}
+type PublicKey_Bls12381 struct {
+	Bls12381 []byte `protobuf:"bytes,4,opt,name=bls12381,proto3,oneof"`
+}
 
-func (*PublicKey_Ed25519) isPublicKey_Pub() {}
+func (*PublicKey_Ed25519) isPublicKey_Pub()  {}
+func (*PublicKey_Sr25519) isPublicKey_Pub()  {}
+func (*PublicKey_Multisig) isPublicKey_Pub() {}
+func (*PublicKey_Bls12381) isPublicKey_Pub() {}
 
 func (m *PublicKey) GetPub() isPublicKey_Pub {
 	if m != nil {
@@ -86,10 +101,34 @@ func (m *PublicKey) GetEd25519() []byte {
 	return nil
 }
 
+func (m *PublicKey) GetSr25519() []byte {
+	if x, ok := m.GetPub().(*PublicKey_Sr25519); ok {
+		return x.Sr25519
+	}
+	return nil
+}
+
+func (m *PublicKey) GetMultisig() *MultisigPublicKey {
+	if x, ok := m.GetPub().(*PublicKey_Multisig); ok {
+		return x.Multisig
+	}
+	return nil
+}
+
+func (m *PublicKey) GetBls12381() []byte {
+	if x, ok := m.GetPub().(*PublicKey_Bls12381); ok {
+		return x.Bls12381
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*PublicKey) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _PublicKey_OneofMarshaler, _PublicKey_OneofUnmarshaler, _PublicKey_OneofSizer, []interface{}{
 		(*PublicKey_Ed25519)(nil),
+		(*PublicKey_Sr25519)(nil),
+		(*PublicKey_Multisig)(nil),
+		(*PublicKey_Bls12381)(nil),
 	}
 }
 
@@ -100,6 +139,17 @@ func _PublicKey_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 	case *PublicKey_Ed25519:
 		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
 		_ = b.EncodeRawBytes(x.Ed25519)
+	case *PublicKey_Sr25519:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.Sr25519)
+	case *PublicKey_Multisig:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Multisig); err != nil {
+			return err
+		}
+	case *PublicKey_Bls12381:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.Bls12381)
 	case nil:
 	default:
 		return fmt.Errorf("PublicKey.Pub has unexpected type %T", x)
@@ -117,6 +167,28 @@ func _PublicKey_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buff
 		x, err := b.DecodeRawBytes(true)
 		m.Pub = &PublicKey_Ed25519{x}
 		return true, err
+	case 2: // pub.sr25519
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Pub = &PublicKey_Sr25519{x}
+		return true, err
+	case 3: // pub.multisig
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultisigPublicKey)
+		err := b.DecodeMessage(msg)
+		m.Pub = &PublicKey_Multisig{msg}
+		return true, err
+	case 4: // pub.bls12381
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Pub = &PublicKey_Bls12381{x}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -130,6 +202,19 @@ func _PublicKey_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(len(x.Ed25519)))
 		n += len(x.Ed25519)
+	case *PublicKey_Sr25519:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Sr25519)))
+		n += len(x.Sr25519)
+	case *PublicKey_Multisig:
+		s := proto.Size(x.Multisig)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *PublicKey_Bls12381:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Bls12381)))
+		n += len(x.Bls12381)
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -137,9 +222,66 @@ func _PublicKey_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// MultisigPublicKey is a composite public key that is satisfied by
+// signatures of at least threshold of its member keys. Member keys cannot be
+// multisig keys.
+type MultisigPublicKey struct {
+	Threshold uint32       `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Pubkeys   []*PublicKey `protobuf:"bytes,2,rep,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+}
+
+func (m *MultisigPublicKey) Reset()         { *m = MultisigPublicKey{} }
+func (m *MultisigPublicKey) String() string { return proto.CompactTextString(m) }
+func (*MultisigPublicKey) ProtoMessage()    {}
+func (*MultisigPublicKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16c93fab133ec0b1, []int{1}
+}
+func (m *MultisigPublicKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigPublicKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultisigPublicKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultisigPublicKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigPublicKey.Merge(m, src)
+}
+func (m *MultisigPublicKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigPublicKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigPublicKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigPublicKey proto.InternalMessageInfo
+
+func (m *MultisigPublicKey) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MultisigPublicKey) GetPubkeys() []*PublicKey {
+	if m != nil {
+		return m.Pubkeys
+	}
+	return nil
+}
+
 type PrivateKey struct {
 	// Types that are valid to be assigned to Priv:
 	//	*PrivateKey_Ed25519
+	//	*PrivateKey_Sr25519
+	//	*PrivateKey_Bls12381
 	Priv isPrivateKey_Priv `protobuf_oneof:"priv"`
 }
 
@@ -147,7 +289,7 @@ func (m *PrivateKey) Reset()         { *m = PrivateKey{} }
 func (m *PrivateKey) String() string { return proto.CompactTextString(m) }
 func (*PrivateKey) ProtoMessage()    {}
 func (*PrivateKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16c93fab133ec0b1, []int{1}
+	return fileDescriptor_16c93fab133ec0b1, []int{2}
 }
 func (m *PrivateKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,8 +327,16 @@ type isPrivateKey_Priv interface {
 type PrivateKey_Ed25519 struct {
 	Ed25519 []byte `protobuf:"bytes,1,opt,name=ed25519,proto3,oneof"`
 }
+type PrivateKey_Sr25519 struct {
+	Sr25519 []byte `protobuf:"bytes,2,opt,name=sr25519,proto3,oneof"`
+}
+type PrivateKey_Bls12381 struct {
+	Bls12381 []byte `protobuf:"bytes,3,opt,name=bls12381,proto3,oneof"`
+}
 
-func (*PrivateKey_Ed25519) isPrivateKey_Priv() {}
+func (*PrivateKey_Ed25519) isPrivateKey_Priv()  {}
+func (*PrivateKey_Sr25519) isPrivateKey_Priv()  {}
+func (*PrivateKey_Bls12381) isPrivateKey_Priv() {}
 
 func (m *PrivateKey) GetPriv() isPrivateKey_Priv {
 	if m != nil {
@@ -202,10 +352,26 @@ func (m *PrivateKey) GetEd25519() []byte {
 	return nil
 }
 
+func (m *PrivateKey) GetSr25519() []byte {
+	if x, ok := m.GetPriv().(*PrivateKey_Sr25519); ok {
+		return x.Sr25519
+	}
+	return nil
+}
+
+func (m *PrivateKey) GetBls12381() []byte {
+	if x, ok := m.GetPriv().(*PrivateKey_Bls12381); ok {
+		return x.Bls12381
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*PrivateKey) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _PrivateKey_OneofMarshaler, _PrivateKey_OneofUnmarshaler, _PrivateKey_OneofSizer, []interface{}{
 		(*PrivateKey_Ed25519)(nil),
+		(*PrivateKey_Sr25519)(nil),
+		(*PrivateKey_Bls12381)(nil),
 	}
 }
 
@@ -216,6 +382,12 @@ func _PrivateKey_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 	case *PrivateKey_Ed25519:
 		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
 		_ = b.EncodeRawBytes(x.Ed25519)
+	case *PrivateKey_Sr25519:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.Sr25519)
+	case *PrivateKey_Bls12381:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.Bls12381)
 	case nil:
 	default:
 		return fmt.Errorf("PrivateKey.Priv has unexpected type %T", x)
@@ -233,6 +405,20 @@ func _PrivateKey_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buf
 		x, err := b.DecodeRawBytes(true)
 		m.Priv = &PrivateKey_Ed25519{x}
 		return true, err
+	case 2: // priv.sr25519
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Priv = &PrivateKey_Sr25519{x}
+		return true, err
+	case 3: // priv.bls12381
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Priv = &PrivateKey_Bls12381{x}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -246,6 +432,14 @@ func _PrivateKey_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(len(x.Ed25519)))
 		n += len(x.Ed25519)
+	case *PrivateKey_Sr25519:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Sr25519)))
+		n += len(x.Sr25519)
+	case *PrivateKey_Bls12381:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Bls12381)))
+		n += len(x.Bls12381)
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -256,6 +450,9 @@ func _PrivateKey_OneofSizer(msg proto.Message) (n int) {
 type Signature struct {
 	// Types that are valid to be assigned to Sig:
 	//	*Signature_Ed25519
+	//	*Signature_Sr25519
+	//	*Signature_Multisig
+	//	*Signature_Bls12381
 	Sig isSignature_Sig `protobuf_oneof:"sig"`
 }
 
@@ -263,7 +460,7 @@ func (m *Signature) Reset()         { *m = Signature{} }
 func (m *Signature) String() string { return proto.CompactTextString(m) }
 func (*Signature) ProtoMessage()    {}
 func (*Signature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_16c93fab133ec0b1, []int{2}
+	return fileDescriptor_16c93fab133ec0b1, []int{3}
 }
 func (m *Signature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -301,8 +498,20 @@ type isSignature_Sig interface {
 type Signature_Ed25519 struct {
 	Ed25519 []byte `protobuf:"bytes,1,opt,name=ed25519,proto3,oneof"`
 }
+type Signature_Sr25519 struct {
+	Sr25519 []byte `protobuf:"bytes,2,opt,name=sr25519,proto3,oneof"`
+}
+type Signature_Multisig struct {
+	Multisig *MultisigSignature `protobuf:"bytes,3,opt,name=multisig,proto3,oneof"`
+}
+type Signature_Bls12381 struct {
+	Bls12381 []byte `protobuf:"bytes,4,opt,name=bls12381,proto3,oneof"`
+}
 
-func (*Signature_Ed25519) isSignature_Sig() {}
+func (*Signature_Ed25519) isSignature_Sig()  {}
+func (*Signature_Sr25519) isSignature_Sig()  {}
+func (*Signature_Multisig) isSignature_Sig() {}
+func (*Signature_Bls12381) isSignature_Sig() {}
 
 func (m *Signature) GetSig() isSignature_Sig {
 	if m != nil {
@@ -318,10 +527,34 @@ func (m *Signature) GetEd25519() []byte {
 	return nil
 }
 
+func (m *Signature) GetSr25519() []byte {
+	if x, ok := m.GetSig().(*Signature_Sr25519); ok {
+		return x.Sr25519
+	}
+	return nil
+}
+
+func (m *Signature) GetMultisig() *MultisigSignature {
+	if x, ok := m.GetSig().(*Signature_Multisig); ok {
+		return x.Multisig
+	}
+	return nil
+}
+
+func (m *Signature) GetBls12381() []byte {
+	if x, ok := m.GetSig().(*Signature_Bls12381); ok {
+		return x.Bls12381
+	}
+	return nil
+}
+
 // XXX_OneofFuncs is for the internal use of the proto package.
 func (*Signature) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
 	return _Signature_OneofMarshaler, _Signature_OneofUnmarshaler, _Signature_OneofSizer, []interface{}{
 		(*Signature_Ed25519)(nil),
+		(*Signature_Sr25519)(nil),
+		(*Signature_Multisig)(nil),
+		(*Signature_Bls12381)(nil),
 	}
 }
 
@@ -332,6 +565,17 @@ func _Signature_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
 	case *Signature_Ed25519:
 		_ = b.EncodeVarint(1<<3 | proto.WireBytes)
 		_ = b.EncodeRawBytes(x.Ed25519)
+	case *Signature_Sr25519:
+		_ = b.EncodeVarint(2<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.Sr25519)
+	case *Signature_Multisig:
+		_ = b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.Multisig); err != nil {
+			return err
+		}
+	case *Signature_Bls12381:
+		_ = b.EncodeVarint(4<<3 | proto.WireBytes)
+		_ = b.EncodeRawBytes(x.Bls12381)
 	case nil:
 	default:
 		return fmt.Errorf("Signature.Sig has unexpected type %T", x)
@@ -349,6 +593,28 @@ func _Signature_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buff
 		x, err := b.DecodeRawBytes(true)
 		m.Sig = &Signature_Ed25519{x}
 		return true, err
+	case 2: // sig.sr25519
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Sig = &Signature_Sr25519{x}
+		return true, err
+	case 3: // sig.multisig
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(MultisigSignature)
+		err := b.DecodeMessage(msg)
+		m.Sig = &Signature_Multisig{msg}
+		return true, err
+	case 4: // sig.bls12381
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeRawBytes(true)
+		m.Sig = &Signature_Bls12381{x}
+		return true, err
 	default:
 		return false, nil
 	}
@@ -362,6 +628,19 @@ func _Signature_OneofSizer(msg proto.Message) (n int) {
 		n += 1 // tag and wire
 		n += proto.SizeVarint(uint64(len(x.Ed25519)))
 		n += len(x.Ed25519)
+	case *Signature_Sr25519:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Sr25519)))
+		n += len(x.Sr25519)
+	case *Signature_Multisig:
+		s := proto.Size(x.Multisig)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *Signature_Bls12381:
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(len(x.Bls12381)))
+		n += len(x.Bls12381)
 	case nil:
 	default:
 		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
@@ -369,26 +648,85 @@ func _Signature_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+// MultisigSignature is a signature created using a multisig public key. It
+// contains a signature for each member key of the multisig public key, in the
+// same order. A missing signature is represented by an empty signature.
+type MultisigSignature struct {
+	Signatures []*Signature `protobuf:"bytes,1,rep,name=signatures,proto3" json:"signatures,omitempty"`
+}
+
+func (m *MultisigSignature) Reset()         { *m = MultisigSignature{} }
+func (m *MultisigSignature) String() string { return proto.CompactTextString(m) }
+func (*MultisigSignature) ProtoMessage()    {}
+func (*MultisigSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_16c93fab133ec0b1, []int{4}
+}
+func (m *MultisigSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultisigSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultisigSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultisigSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultisigSignature.Merge(m, src)
+}
+func (m *MultisigSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultisigSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultisigSignature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultisigSignature proto.InternalMessageInfo
+
+func (m *MultisigSignature) GetSignatures() []*Signature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PublicKey)(nil), "crypto.PublicKey")
+	proto.RegisterType((*MultisigPublicKey)(nil), "crypto.MultisigPublicKey")
 	proto.RegisterType((*PrivateKey)(nil), "crypto.PrivateKey")
 	proto.RegisterType((*Signature)(nil), "crypto.Signature")
+	proto.RegisterType((*MultisigSignature)(nil), "crypto.MultisigSignature")
 }
 
 func init() { proto.RegisterFile("crypto/models.proto", fileDescriptor_16c93fab133ec0b1) }
 
 var fileDescriptor_16c93fab133ec0b1 = []byte{
-	// 160 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4e, 0x2e, 0xaa, 0x2c,
-	0x28, 0xc9, 0xd7, 0xcf, 0xcd, 0x4f, 0x49, 0xcd, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x62, 0x83, 0x08, 0x2a, 0xe9, 0x71, 0x71, 0x06, 0x94, 0x26, 0xe5, 0x64, 0x26, 0x7b, 0xa7, 0x56,
-	0x0a, 0x49, 0x71, 0xb1, 0xa7, 0xa6, 0x18, 0x99, 0x9a, 0x1a, 0x5a, 0x4a, 0x30, 0x2a, 0x30, 0x6a,
-	0xf0, 0x78, 0x30, 0x04, 0xc1, 0x04, 0x9c, 0x58, 0xb9, 0x98, 0x0b, 0x4a, 0x93, 0x94, 0x0c, 0xb8,
-	0xb8, 0x02, 0x8a, 0x32, 0xcb, 0x12, 0x4b, 0x52, 0x09, 0x69, 0x60, 0xe3, 0x62, 0x29, 0x28, 0xca,
-	0x2c, 0x03, 0xd9, 0x10, 0x9c, 0x99, 0x9e, 0x97, 0x58, 0x52, 0x5a, 0x94, 0x4a, 0xc8, 0x86, 0xe2,
-	0xcc, 0x74, 0x27, 0x89, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e,
-	0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x48, 0x62, 0x03,
-	0x3b, 0xdd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x29, 0x87, 0x23, 0x3e, 0xd1, 0x00, 0x00, 0x00,
+	// 315 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcd, 0x4a, 0xfb, 0x40,
+	0x14, 0xc5, 0x33, 0x4d, 0xff, 0xfd, 0xb8, 0xfd, 0xbb, 0xe8, 0xb8, 0x19, 0xa5, 0x0c, 0x25, 0xab,
+	0x82, 0x50, 0x49, 0x4a, 0x51, 0xb7, 0x5d, 0x48, 0x41, 0x84, 0x12, 0xf7, 0x42, 0xd3, 0x0e, 0xe9,
+	0x68, 0x6a, 0xc2, 0xcc, 0xa4, 0x90, 0xb7, 0xf0, 0x15, 0x7c, 0x1b, 0x97, 0x5d, 0xba, 0x94, 0xe4,
+	0x45, 0xc4, 0x7c, 0x8c, 0x95, 0xac, 0x44, 0x5c, 0xce, 0xef, 0x1e, 0xce, 0xb9, 0xf7, 0x30, 0x70,
+	0xbc, 0x12, 0x49, 0xa4, 0xc2, 0xf3, 0x6d, 0xb8, 0x66, 0x81, 0x1c, 0x47, 0x22, 0x54, 0x21, 0x6e,
+	0x15, 0xd0, 0x7a, 0x41, 0xd0, 0x5d, 0xc4, 0x5e, 0xc0, 0x57, 0x37, 0x2c, 0xc1, 0xa7, 0xd0, 0x66,
+	0x6b, 0x67, 0x3a, 0xb5, 0xaf, 0x08, 0x1a, 0xa2, 0xd1, 0xff, 0xb9, 0xe1, 0x56, 0xe0, 0x73, 0x26,
+	0x45, 0x31, 0x6b, 0x54, 0xb3, 0x12, 0xe0, 0x0b, 0xe8, 0x6c, 0xe3, 0x40, 0x71, 0xc9, 0x7d, 0x62,
+	0x0e, 0xd1, 0xa8, 0xe7, 0x9c, 0x8c, 0x8b, 0x80, 0xf1, 0x6d, 0xc9, 0x75, 0xc8, 0xdc, 0x70, 0xb5,
+	0x18, 0x0f, 0xa0, 0xe3, 0x05, 0xd2, 0x76, 0x26, 0x97, 0x36, 0x69, 0x96, 0xae, 0x9a, 0xcc, 0xfe,
+	0x81, 0x19, 0xc5, 0x9e, 0x75, 0x0f, 0xfd, 0x9a, 0x0b, 0x1e, 0x40, 0x57, 0x6d, 0x04, 0x93, 0x9b,
+	0x30, 0x58, 0xe7, 0xcb, 0x1e, 0xb9, 0x5f, 0x00, 0x9f, 0x41, 0x3b, 0x8a, 0xbd, 0x47, 0x96, 0x48,
+	0xd2, 0x18, 0x9a, 0xa3, 0x9e, 0xd3, 0xaf, 0xf6, 0xd1, 0x0e, 0x6e, 0xa5, 0xb0, 0x1e, 0x00, 0x16,
+	0x82, 0xef, 0x96, 0x8a, 0xfd, 0xa6, 0x83, 0xc3, 0x53, 0xcc, 0xda, 0x29, 0x2d, 0x68, 0x46, 0x82,
+	0xef, 0xf2, 0xbe, 0xef, 0xb8, 0xff, 0xb4, 0x54, 0xb1, 0x60, 0x7f, 0xd9, 0xb7, 0x0e, 0xf9, 0x59,
+	0xdf, 0x92, 0xfb, 0xd6, 0x35, 0xf4, 0x6b, 0x2e, 0xd8, 0x06, 0x90, 0xd5, 0x43, 0x12, 0xf4, 0xbd,
+	0x54, 0x2d, 0x73, 0x0f, 0x44, 0x33, 0xf2, 0x9a, 0x52, 0xb4, 0x4f, 0x29, 0x7a, 0x4f, 0x29, 0x7a,
+	0xce, 0xa8, 0xb1, 0xcf, 0xa8, 0xf1, 0x96, 0x51, 0xc3, 0x6b, 0xe5, 0x9f, 0x70, 0xf2, 0x11, 0x00,
+	0x00, 0xff, 0xff, 0x28, 0x74, 0xb6, 0x17, 0x9b, 0x02, 0x00, 0x00,
 }
 
 func (m *PublicKey) Marshal() (dAtA []byte, err error) {
@@ -426,6 +764,75 @@ func (m *PublicKey_Ed25519) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *PublicKey_Sr25519) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Sr25519 != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Sr25519)))
+		i += copy(dAtA[i:], m.Sr25519)
+	}
+	return i, nil
+}
+func (m *PublicKey_Multisig) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Multisig != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(m.Multisig.Size()))
+		n2, err := m.Multisig.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	return i, nil
+}
+func (m *PublicKey_Bls12381) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Bls12381 != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Bls12381)))
+		i += copy(dAtA[i:], m.Bls12381)
+	}
+	return i, nil
+}
+func (m *MultisigPublicKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigPublicKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(m.Threshold))
+	}
+	if len(m.Pubkeys) > 0 {
+		for _, msg := range m.Pubkeys {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintModels(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func (m *PrivateKey) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -442,11 +849,11 @@ func (m *PrivateKey) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Priv != nil {
-		nn2, err := m.Priv.MarshalTo(dAtA[i:])
+		nn3, err := m.Priv.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn2
+		i += nn3
 	}
 	return i, nil
 }
@@ -461,6 +868,26 @@ func (m *PrivateKey_Ed25519) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *PrivateKey_Sr25519) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Sr25519 != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Sr25519)))
+		i += copy(dAtA[i:], m.Sr25519)
+	}
+	return i, nil
+}
+func (m *PrivateKey_Bls12381) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Bls12381 != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Bls12381)))
+		i += copy(dAtA[i:], m.Bls12381)
+	}
+	return i, nil
+}
 func (m *Signature) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -477,11 +904,11 @@ func (m *Signature) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if m.Sig != nil {
-		nn3, err := m.Sig.MarshalTo(dAtA[i:])
+		nn4, err := m.Sig.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += nn3
+		i += nn4
 	}
 	return i, nil
 }
@@ -496,6 +923,70 @@ func (m *Signature_Ed25519) MarshalTo(dAtA []byte) (int, error) {
 	}
 	return i, nil
 }
+func (m *Signature_Sr25519) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Sr25519 != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Sr25519)))
+		i += copy(dAtA[i:], m.Sr25519)
+	}
+	return i, nil
+}
+func (m *Signature_Multisig) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Multisig != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(m.Multisig.Size()))
+		n5, err := m.Multisig.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	return i, nil
+}
+func (m *Signature_Bls12381) MarshalTo(dAtA []byte) (int, error) {
+	i := 0
+	if m.Bls12381 != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Bls12381)))
+		i += copy(dAtA[i:], m.Bls12381)
+	}
+	return i, nil
+}
+func (m *MultisigSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultisigSignature) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, msg := range m.Signatures {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintModels(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -529,53 +1020,181 @@ func (m *PublicKey_Ed25519) Size() (n int) {
 	}
 	return n
 }
-func (m *PrivateKey) Size() (n int) {
+func (m *PublicKey_Sr25519) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Priv != nil {
-		n += m.Priv.Size()
+	if m.Sr25519 != nil {
+		l = len(m.Sr25519)
+		n += 1 + l + sovModels(uint64(l))
 	}
 	return n
 }
-
-func (m *PrivateKey_Ed25519) Size() (n int) {
+func (m *PublicKey_Multisig) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Ed25519 != nil {
-		l = len(m.Ed25519)
+	if m.Multisig != nil {
+		l = m.Multisig.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
 	return n
 }
-func (m *Signature) Size() (n int) {
+func (m *PublicKey_Bls12381) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Sig != nil {
-		n += m.Sig.Size()
+	if m.Bls12381 != nil {
+		l = len(m.Bls12381)
+		n += 1 + l + sovModels(uint64(l))
 	}
 	return n
 }
-
-func (m *Signature_Ed25519) Size() (n int) {
+func (m *MultisigPublicKey) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Ed25519 != nil {
-		l = len(m.Ed25519)
-		n += 1 + l + sovModels(uint64(l))
+	if m.Threshold != 0 {
+		n += 1 + sovModels(uint64(m.Threshold))
 	}
-	return n
+	if len(m.Pubkeys) > 0 {
+		for _, e := range m.Pubkeys {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrivateKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Priv != nil {
+		n += m.Priv.Size()
+	}
+	return n
+}
+
+func (m *PrivateKey_Ed25519) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ed25519 != nil {
+		l = len(m.Ed25519)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *PrivateKey_Sr25519) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sr25519 != nil {
+		l = len(m.Sr25519)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *PrivateKey_Bls12381) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bls12381 != nil {
+		l = len(m.Bls12381)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *Signature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sig != nil {
+		n += m.Sig.Size()
+	}
+	return n
+}
+
+func (m *Signature_Ed25519) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ed25519 != nil {
+		l = len(m.Ed25519)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *Signature_Sr25519) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sr25519 != nil {
+		l = len(m.Sr25519)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *Signature_Multisig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Multisig != nil {
+		l = m.Multisig.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *Signature_Bls12381) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bls12381 != nil {
+		l = len(m.Bls12381)
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *MultisigSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
 }
 
 func sovModels(x uint64) (n int) {
@@ -653,6 +1272,107 @@ func (m *PublicKey) Unmarshal(dAtA []byte) error {
 			copy(v, dAtA[iNdEx:postIndex])
 			m.Pub = &PublicKey_Ed25519{v}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sr25519", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Pub = &PublicKey_Sr25519{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MultisigPublicKey{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Pub = &PublicKey_Multisig{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bls12381", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Pub = &PublicKey_Bls12381{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -677,7 +1397,7 @@ func (m *PublicKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PrivateKey) Unmarshal(dAtA []byte) error {
+func (m *MultisigPublicKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -700,17 +1420,36 @@ func (m *PrivateKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PrivateKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultisigPublicKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PrivateKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultisigPublicKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ed25519", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkeys", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -720,24 +1459,25 @@ func (m *PrivateKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := make([]byte, postIndex-iNdEx)
-			copy(v, dAtA[iNdEx:postIndex])
-			m.Priv = &PrivateKey_Ed25519{v}
+			m.Pubkeys = append(m.Pubkeys, &PublicKey{})
+			if err := m.Pubkeys[len(m.Pubkeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -763,7 +1503,7 @@ func (m *PrivateKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Signature) Unmarshal(dAtA []byte) error {
+func (m *PrivateKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -786,10 +1526,10 @@ func (m *Signature) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Signature: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrivateKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Signature: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrivateKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -823,7 +1563,347 @@ func (m *Signature) Unmarshal(dAtA []byte) error {
 			}
 			v := make([]byte, postIndex-iNdEx)
 			copy(v, dAtA[iNdEx:postIndex])
-			m.Sig = &Signature_Ed25519{v}
+			m.Priv = &PrivateKey_Ed25519{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sr25519", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Priv = &PrivateKey_Sr25519{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bls12381", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Priv = &PrivateKey_Bls12381{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Signature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Signature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Signature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ed25519", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Sig = &Signature_Ed25519{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sr25519", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Sig = &Signature_Sr25519{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multisig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MultisigSignature{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Sig = &Signature_Multisig{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bls12381", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Sig = &Signature_Bls12381{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultisigSignature) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultisigSignature: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultisigSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, &Signature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,179 @@
+package crypto
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/iov-one/weave"
+)
+
+// MaxMultisigKeys is the maximum number of member keys of a multisig public
+// key. It limits the cost of a multisig signature verification.
+const MaxMultisigKeys = 20
+
+// NewMultisigPublicKey returns a public key that is satisfied by signatures
+// of at least threshold of given keys. The order of keys is relevant, as it
+// defines the address of the key and the order of signatures.
+func NewMultisigPublicKey(threshold uint32, pubkeys ...*PublicKey) (*PublicKey, error) {
+	key := &MultisigPublicKey{
+		Threshold: threshold,
+		Pubkeys:   pubkeys,
+	}
+	if err := key.Validate(); err != nil {
+		return nil, err
+	}
+	return &PublicKey{
+		Pub: &PublicKey_Multisig{Multisig: key},
+	}, nil
+}
+
+// Validate returns an error if this multisig public key cannot be satisfied
+// or is not valid.
+func (k *MultisigPublicKey) Validate() error {
+	if k == nil {
+		return errors.New("multisig public key missing")
+	}
+	switch n := len(k.Pubkeys); {
+	case n == 0:
+		return errors.New("no member public keys")
+	case n > MaxMultisigKeys:
+		return fmt.Errorf("too many member public keys, max %d", MaxMultisigKeys)
+	}
+	if k.Threshold == 0 {
+		return errors.New("threshold must be greater than zero")
+	}
+	if int(k.Threshold) > len(k.Pubkeys) {
+		return errors.New("threshold greater than the number of member public keys")
+	}
+	seen := make(map[string]struct{}, len(k.Pubkeys))
+	for i, p := range k.Pubkeys {
+		switch p.GetPub().(type) {
+		case nil:
+			return fmt.Errorf("member public key %d missing", i)
+		case *PublicKey_Multisig:
+			return fmt.Errorf("member public key %d is a multisig public key", i)
+		}
+		addr := p.Address().String()
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("member public key %d is a duplicate", i)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
+
+var _ PubKey = (*PublicKey_Multisig)(nil)
+
+// Verify returns true if the signature contains valid signatures of the
+// message created by at least threshold of member keys. Any invalid member
+// signature fails the verification.
+func (p *PublicKey_Multisig) Verify(message []byte, sig *Signature) bool {
+	if p.Multisig.Validate() != nil {
+		return false
+	}
+	msig, ok := sig.GetSig().(*Signature_Multisig)
+	if !ok || msig.Multisig == nil {
+		return false
+	}
+	sigs := msig.Multisig.Signatures
+	if len(sigs) != len(p.Multisig.Pubkeys) {
+		return false
+	}
+	var valid uint32
+	for i, s := range sigs {
+		if s.GetSig() == nil {
+			continue
+		}
+		if !p.Multisig.Pubkeys[i].Verify(message, s) {
+			return false
+		}
+		valid++
+	}
+	return valid >= p.Multisig.Threshold
+}
+
+// Condition encodes the public key into a weave permission. The condition
+// data is the serialized multisig public key, so both the threshold and all
+// member keys define the address.
+func (p *PublicKey_Multisig) Condition() weave.Condition {
+	raw, err := p.Multisig.Marshal()
+	if err != nil {
+		return nil
+	}
+	return weave.NewCondition(ExtensionName, "multisig", raw)
+}
+
+// NewMultisigSignature returns a signature for a multisig public key.
+// Signatures must be given in the same order as member keys of the multisig
+// public key. Use nil for a member that did not sign.
+func NewMultisigSignature(sigs ...*Signature) *Signature {
+	msig := &MultisigSignature{
+		Signatures: make([]*Signature, len(sigs)),
+	}
+	for i, s := range sigs {
+		if s == nil {
+			s = &Signature{}
+		}
+		msig.Signatures[i] = s
+	}
+	return &Signature{
+		Sig: &Signature_Multisig{Multisig: msig},
+	}
+}
+
+// MultisigSigner is a Signer of a multisig public key. It signs using all
+// available member signers, that must satisfy the threshold.
+type MultisigSigner struct {
+	pubkey  *PublicKey
+	signers []Signer
+}
+
+var _ Signer = (*MultisigSigner)(nil)
+
+// NewMultisigSigner returns a signer for given multisig public key. Signers
+// must be given in the same order as member keys of the multisig public key.
+// Use nil for a member that is not available.
+func NewMultisigSigner(pubkey *PublicKey, signers ...Signer) (*MultisigSigner, error) {
+	key := pubkey.GetMultisig()
+	if err := key.Validate(); err != nil {
+		return nil, err
+	}
+	if len(signers) != len(key.Pubkeys) {
+		return nil, errors.New("signer required for each member public key")
+	}
+	var available uint32
+	for i, s := range signers {
+		if s == nil {
+			continue
+		}
+		if !s.PublicKey().Address().Equals(key.Pubkeys[i].Address()) {
+			return nil, fmt.Errorf("signer %d does not match the member public key", i)
+		}
+		available++
+	}
+	if available < key.Threshold {
+		return nil, errors.New("not enough signers to satisfy the threshold")
+	}
+	return &MultisigSigner{pubkey: pubkey, signers: signers}, nil
+}
+
+// Sign returns a multisig signature created by all available signers.
+func (m *MultisigSigner) Sign(message []byte) (*Signature, error) {
+	sigs := make([]*Signature, len(m.signers))
+	for i, s := range m.signers {
+		if s == nil {
+			continue
+		}
+		sig, err := s.Sign(message)
+		if err != nil {
+			return nil, fmt.Errorf("member %d: %s", i, err)
+		}
+		sigs[i] = sig
+	}
+	return NewMultisigSignature(sigs...), nil
+}
+
+// PublicKey returns the multisig public key.
+func (m *MultisigSigner) PublicKey() *PublicKey {
+	return m.pubkey
+}
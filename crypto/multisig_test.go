@@ -0,0 +1,193 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestNewMultisigPublicKey(t *testing.T) {
+	a := GenPrivKeyEd25519().PublicKey()
+	b := GenPrivKeySr25519().PublicKey()
+	c := GenPrivKeyEd25519().PublicKey()
+	nested, err := NewMultisigPublicKey(1, a, b)
+	assert.Nil(t, err)
+
+	many := make([]*PublicKey, MaxMultisigKeys+1)
+	for i := range many {
+		many[i] = GenPrivKeyEd25519().PublicKey()
+	}
+
+	cases := map[string]struct {
+		threshold uint32
+		pubkeys   []*PublicKey
+		wantErr   bool
+	}{
+		"valid": {
+			threshold: 2,
+			pubkeys:   []*PublicKey{a, b, c},
+		},
+		"threshold equal to the number of keys": {
+			threshold: 3,
+			pubkeys:   []*PublicKey{a, b, c},
+		},
+		"zero threshold": {
+			threshold: 0,
+			pubkeys:   []*PublicKey{a, b, c},
+			wantErr:   true,
+		},
+		"threshold too high": {
+			threshold: 4,
+			pubkeys:   []*PublicKey{a, b, c},
+			wantErr:   true,
+		},
+		"no keys": {
+			threshold: 1,
+			wantErr:   true,
+		},
+		"too many keys": {
+			threshold: 1,
+			pubkeys:   many,
+			wantErr:   true,
+		},
+		"duplicated key": {
+			threshold: 1,
+			pubkeys:   []*PublicKey{a, b, a},
+			wantErr:   true,
+		},
+		"empty key": {
+			threshold: 1,
+			pubkeys:   []*PublicKey{a, {}},
+			wantErr:   true,
+		},
+		"nested multisig key": {
+			threshold: 1,
+			pubkeys:   []*PublicKey{c, nested},
+			wantErr:   true,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			_, err := NewMultisigPublicKey(tc.threshold, tc.pubkeys...)
+			if tc.wantErr != (err != nil) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestMultisigVerify(t *testing.T) {
+	a := GenPrivKeyEd25519()
+	b := GenPrivKeySr25519()
+	c := GenPrivKeyEd25519()
+	other := GenPrivKeyEd25519()
+
+	pubkey, err := NewMultisigPublicKey(2, a.PublicKey(), b.PublicKey(), c.PublicKey())
+	assert.Nil(t, err)
+
+	msg := []byte("foobar")
+	sign := func(key *PrivateKey, msg []byte) *Signature {
+		sig, err := key.Sign(msg)
+		assert.Nil(t, err)
+		return sig
+	}
+
+	cases := map[string]struct {
+		sig  *Signature
+		want bool
+	}{
+		"threshold signatures": {
+			sig:  NewMultisigSignature(sign(a, msg), nil, sign(c, msg)),
+			want: true,
+		},
+		"all signatures": {
+			sig:  NewMultisigSignature(sign(a, msg), sign(b, msg), sign(c, msg)),
+			want: true,
+		},
+		"not enough signatures": {
+			sig:  NewMultisigSignature(nil, sign(b, msg), nil),
+			want: false,
+		},
+		"one invalid signature": {
+			sig:  NewMultisigSignature(sign(a, msg), sign(b, msg), sign(other, msg)),
+			want: false,
+		},
+		"signature of a different message": {
+			sig:  NewMultisigSignature(sign(a, msg), sign(b, []byte("other")), nil),
+			want: false,
+		},
+		"signatures in a wrong order": {
+			sig:  NewMultisigSignature(sign(c, msg), nil, sign(a, msg)),
+			want: false,
+		},
+		"missing member signature slots": {
+			sig:  NewMultisigSignature(sign(a, msg), sign(b, msg)),
+			want: false,
+		},
+		"not a multisig signature": {
+			sig:  sign(a, msg),
+			want: false,
+		},
+		"nil signature": {
+			sig:  nil,
+			want: false,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if got := pubkey.Verify(msg, tc.sig); got != tc.want {
+				t.Fatalf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMultisigCondition(t *testing.T) {
+	a := GenPrivKeyEd25519().PublicKey()
+	b := GenPrivKeyEd25519().PublicKey()
+
+	k1, err := NewMultisigPublicKey(1, a, b)
+	assert.Nil(t, err)
+	k2, err := NewMultisigPublicKey(2, a, b)
+	assert.Nil(t, err)
+	k3, err := NewMultisigPublicKey(1, b, a)
+	assert.Nil(t, err)
+
+	assert.Nil(t, k1.Condition().Validate())
+	if k1.Address().Equals(k2.Address()) {
+		t.Fatal("different threshold must produce a different address")
+	}
+	if k1.Address().Equals(k3.Address()) {
+		t.Fatal("different order of keys must produce a different address")
+	}
+}
+
+func TestMultisigSigner(t *testing.T) {
+	a := GenPrivKeyEd25519()
+	b := GenPrivKeySr25519()
+	c := GenPrivKeyEd25519()
+
+	pubkey, err := NewMultisigPublicKey(2, a.PublicKey(), b.PublicKey(), c.PublicKey())
+	assert.Nil(t, err)
+
+	if _, err := NewMultisigSigner(pubkey, a, nil, nil); err == nil {
+		t.Fatal("signer not satisfying the threshold created")
+	}
+	if _, err := NewMultisigSigner(pubkey, a, c, nil); err == nil {
+		t.Fatal("signer with a wrong key order created")
+	}
+	if _, err := NewMultisigSigner(a.PublicKey(), a); err == nil {
+		t.Fatal("signer of a non multisig key created")
+	}
+
+	signer, err := NewMultisigSigner(pubkey, a, nil, c)
+	assert.Nil(t, err)
+	assert.Equal(t, pubkey.Address(), signer.PublicKey().Address())
+
+	msg := []byte("foobar")
+	sig, err := signer.Sign(msg)
+	assert.Nil(t, err)
+	if !pubkey.Verify(msg, sig) {
+		t.Fatal("cannot verify the signature")
+	}
+}
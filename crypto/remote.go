@@ -0,0 +1,106 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// RemoteSigner is a Signer that does not have access to the private key.
+// Signing is delegated to a remote service, for example a key management
+// service or a hardware security module exposed over HTTP.
+//
+// The remote service must implement the following API:
+//
+//	GET  <url>/pubkey  returns {"pubkey": <serialized PublicKey>}
+//	POST <url>/sign    accepts {"message": <bytes>}
+//	                   returns {"signature": <serialized Signature>}
+//
+// All binary values are base64 encoded, as done by the encoding/json
+// package.
+type RemoteSigner struct {
+	url    string
+	cli    *http.Client
+	pubkey *PublicKey
+}
+
+var _ Signer = (*RemoteSigner)(nil)
+
+// NewRemoteSigner returns a signer using the remote service available under
+// given URL. The public key is fetched once, when the signer is created.
+// If client is nil, http.DefaultClient is used.
+func NewRemoteSigner(url string, client *http.Client) (*RemoteSigner, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	s := &RemoteSigner{
+		url: strings.TrimRight(url, "/"),
+		cli: client,
+	}
+
+	resp, err := s.cli.Get(s.url + "/pubkey")
+	if err != nil {
+		return nil, fmt.Errorf("cannot fetch public key: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot fetch public key: response status %d", resp.StatusCode)
+	}
+	var payload struct {
+		Pubkey []byte `json:"pubkey"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("cannot decode public key response: %s", err)
+	}
+	var pubkey PublicKey
+	if err := pubkey.Unmarshal(payload.Pubkey); err != nil {
+		return nil, fmt.Errorf("cannot deserialize public key: %s", err)
+	}
+	if pubkey.GetPub() == nil {
+		return nil, errors.New("public key missing")
+	}
+	s.pubkey = &pubkey
+	return s, nil
+}
+
+// Sign requests the remote service to sign given message. The returned
+// signature is verified before being returned, so that a misbehaving
+// service cannot produce invalid transactions.
+func (s *RemoteSigner) Sign(message []byte) (*Signature, error) {
+	body, err := json.Marshal(struct {
+		Message []byte `json:"message"`
+	}{Message: message})
+	if err != nil {
+		return nil, fmt.Errorf("cannot serialize request: %s", err)
+	}
+	resp, err := s.cli.Post(s.url+"/sign", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("cannot request signature: %s", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot request signature: response status %d", resp.StatusCode)
+	}
+	var payload struct {
+		Signature []byte `json:"signature"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("cannot decode signature response: %s", err)
+	}
+	var sig Signature
+	if err := sig.Unmarshal(payload.Signature); err != nil {
+		return nil, fmt.Errorf("cannot deserialize signature: %s", err)
+	}
+	if !s.pubkey.Verify(message, &sig) {
+		return nil, errors.New("remote signer returned an invalid signature")
+	}
+	return &sig, nil
+}
+
+// PublicKey returns the public key of the remote signer.
+func (s *RemoteSigner) PublicKey() *PublicKey {
+	return s.pubkey
+}
@@ -0,0 +1,67 @@
+package crypto
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	key := GenPrivKeyEd25519()
+	other := GenPrivKeyEd25519()
+
+	// signWith is the key used by the remote service to sign. It can be
+	// changed to simulate a misbehaving service.
+	signWith := key
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/pubkey", func(w http.ResponseWriter, r *http.Request) {
+		raw, err := key.PublicKey().Marshal()
+		assert.Nil(t, err)
+		_ = json.NewEncoder(w).Encode(map[string][]byte{"pubkey": raw})
+	})
+	mux.HandleFunc("/sign", func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Message []byte `json:"message"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		sig, err := signWith.Sign(req.Message)
+		assert.Nil(t, err)
+		raw, err := sig.Marshal()
+		assert.Nil(t, err)
+		_ = json.NewEncoder(w).Encode(map[string][]byte{"signature": raw})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	signer, err := NewRemoteSigner(srv.URL, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, key.PublicKey().Address(), signer.PublicKey().Address())
+
+	msg := []byte("foobar")
+	sig, err := signer.Sign(msg)
+	assert.Nil(t, err)
+	if !key.PublicKey().Verify(msg, sig) {
+		t.Fatal("invalid signature")
+	}
+
+	signWith = other
+	if _, err := signer.Sign(msg); err == nil {
+		t.Fatal("signature created with a different key accepted")
+	}
+}
+
+func TestRemoteSignerUnavailable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	defer srv.Close()
+
+	if _, err := NewRemoteSigner(srv.URL, nil); err == nil {
+		t.Fatal("want an error")
+	}
+}
@@ -0,0 +1,121 @@
+package crypto
+
+import (
+	"errors"
+
+	"github.com/ChainSafe/go-schnorrkel"
+	"github.com/iov-one/weave"
+)
+
+// sr25519SigningContext is the signing context used by Substrate based
+// chains. Using the same context allows to sign with Substrate key management
+// tools.
+var sr25519SigningContext = []byte("substrate")
+
+var _ PubKey = (*PublicKey_Sr25519)(nil)
+
+// Verify verifies the signature was created with this message and public key
+func (p *PublicKey_Sr25519) Verify(message []byte, sig *Signature) bool {
+	srsig, ok := sig.GetSig().(*Signature_Sr25519)
+	if !ok {
+		return false
+	}
+	if len(p.Sr25519) != 32 || len(srsig.Sr25519) != 64 {
+		return false
+	}
+
+	var rawPub [32]byte
+	copy(rawPub[:], p.Sr25519)
+	var publicKey schnorrkel.PublicKey
+	if err := publicKey.Decode(rawPub); err != nil {
+		return false
+	}
+	var rawSig [64]byte
+	copy(rawSig[:], srsig.Sr25519)
+	var signature schnorrkel.Signature
+	if err := signature.Decode(rawSig); err != nil {
+		return false
+	}
+	t := schnorrkel.NewSigningContext(sr25519SigningContext, message)
+	return publicKey.Verify(&signature, t)
+}
+
+// Condition encodes the public key into a weave permission
+func (p *PublicKey_Sr25519) Condition() weave.Condition {
+	return weave.NewCondition(ExtensionName, "sr25519", p.Sr25519)
+}
+
+var _ Signer = (*PrivateKey_Sr25519)(nil)
+
+// Sign returns a matching signature for this private key
+func (p *PrivateKey_Sr25519) Sign(message []byte) (*Signature, error) {
+	secret, err := p.miniSecretKey()
+	if err != nil {
+		return nil, err
+	}
+	t := schnorrkel.NewSigningContext(sr25519SigningContext, message)
+	sig, err := secret.ExpandEd25519().Sign(t)
+	if err != nil {
+		return nil, err
+	}
+	bz := sig.Encode()
+	return &Signature{
+		Sig: &Signature_Sr25519{
+			Sr25519: bz[:],
+		},
+	}, nil
+}
+
+// PublicKey returns the corresponding PublicKey
+func (p *PrivateKey_Sr25519) PublicKey() *PublicKey {
+	secret, err := p.miniSecretKey()
+	if err != nil {
+		panic(err)
+	}
+	pub := secret.Public().Encode()
+	return &PublicKey{
+		Pub: &PublicKey_Sr25519{
+			Sr25519: pub[:],
+		},
+	}
+}
+
+// miniSecretKey decodes the 32 bytes long secret that the private key is
+// stored as.
+func (p *PrivateKey_Sr25519) miniSecretKey() (*schnorrkel.MiniSecretKey, error) {
+	if len(p.Sr25519) != 32 {
+		return nil, errors.New("invalid sr25519 private key length")
+	}
+	var raw [32]byte
+	copy(raw[:], p.Sr25519)
+	return schnorrkel.NewMiniSecretKeyFromRaw(raw)
+}
+
+// GenPrivKeySr25519 returns a random new private key
+func GenPrivKeySr25519() *PrivateKey {
+	secret, err := schnorrkel.GenerateMiniSecretKey()
+	if err != nil {
+		panic(err)
+	}
+	raw := secret.Encode()
+	return &PrivateKey{
+		Priv: &PrivateKey_Sr25519{
+			Sr25519: raw[:],
+		},
+	}
+}
+
+// PrivKeySr25519FromSeed will deterministically generate a private key from
+// a given 32 bytes long seed. The seed is used as the mini secret key, the
+// same way as Substrate does. Use if you have a strong source of external
+// randomness, or for deterministic keys in test cases.
+func PrivKeySr25519FromSeed(seed []byte) *PrivateKey {
+	if len(seed) != 32 {
+		panic("sr25519 seed must be 32 bytes long")
+	}
+	return &PrivateKey{
+		Priv: &PrivateKey_Sr25519{
+			Sr25519: append([]byte(nil), seed...),
+		},
+	}
+}
@@ -0,0 +1,76 @@
+package crypto
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestSr25519Signing(t *testing.T) {
+	private := GenPrivKeySr25519()
+	public := private.PublicKey()
+
+	msg := []byte("foobar")
+	msg2 := []byte("dingbooms")
+
+	sig, err := private.Sign(msg)
+	assert.Nil(t, err)
+	sig2, err := private.Sign(msg2)
+	assert.Nil(t, err)
+
+	if !public.Verify(msg, sig) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+	if !public.Verify(msg2, sig2) {
+		t.Fatal("cannot verify a message signed with this public key")
+	}
+
+	if public.Verify(msg, sig2) {
+		t.Fatal("verified message signature of the wrong message")
+	}
+	if public.Verify(msg2, sig) {
+		t.Fatal("verified message signature of the wrong message")
+	}
+
+	if public.Verify(msg, &Signature{}) {
+		t.Fatal("verified an empty signature of a message")
+	}
+	if public.Verify(msg, nil) {
+		t.Fatal("verified a nil signature of a message")
+	}
+
+	// Signature of a different scheme must not be accepted.
+	edsig, err := GenPrivKeyEd25519().Sign(msg)
+	assert.Nil(t, err)
+	if public.Verify(msg, edsig) {
+		t.Fatal("verified an ed25519 signature")
+	}
+}
+
+func TestSr25519Address(t *testing.T) {
+	pub := GenPrivKeySr25519().PublicKey()
+	pub2 := GenPrivKeySr25519().PublicKey()
+
+	assert.Nil(t, pub.Condition().Validate())
+	if bytes.Equal(pub.Condition(), pub2.Condition()) {
+		t.Fatal("different public keys produce the same condition")
+	}
+
+	// The same seed must always produce the same key.
+	seed := bytes.Repeat([]byte{7}, 32)
+	assert.Equal(t, PrivKeySr25519FromSeed(seed).PublicKey(), PrivKeySr25519FromSeed(seed).PublicKey())
+
+	// Keys of different schemes must never produce the same condition.
+	edpub := PrivKeyEd25519FromSeed(seed).PublicKey()
+	if bytes.Equal(PrivKeySr25519FromSeed(seed).PublicKey().Condition(), edpub.Condition()) {
+		t.Fatal("sr25519 and ed25519 keys produce the same condition")
+	}
+
+	bz, err := pub.Marshal()
+	assert.Nil(t, err)
+	var read PublicKey
+	err = read.Unmarshal(bz)
+	assert.Nil(t, err)
+	assert.Equal(t, read.Condition(), pub.Condition())
+}
@@ -22,6 +22,16 @@ from the genesis into the database,
 
 5. Use `Load` function to load your configuration state from the database,
 
+6. Use `Register` in your extension initialization to allow updating the
+configuration without the knowledge of its type, for example via governance
+parameter change proposals.
+
+7. Use typed getters (`Int`, `Coin`, `Duration` and `Address`) to read a single
+field of a registered configuration. A getter returns the provided default
+value when the configuration was never set, for example on a fresh chain that
+did not declare it in the genesis. The default is not used when the
+configuration exists, even if the field holds a zero value.
+
 
 See existing extensions for an example of how to use this package.
 
@@ -52,6 +52,11 @@ func Load(db ReadStore, pkg string, dst Unmarshaler) error {
 	if err := dst.Unmarshal(raw); err != nil {
 		return errors.Wrapf(err, "unmarshal: key %q", key)
 	}
+	if migrate, ok := migrators[pkg]; ok {
+		if err := migrate(db, pkg, dst); err != nil {
+			return errors.Wrapf(err, "migrate: key %q", key)
+		}
+	}
 	return nil
 }
 
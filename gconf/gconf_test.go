@@ -19,6 +19,41 @@ func TestLoadSave(t *testing.T) {
 	}
 }
 
+func TestLoadMigrates(t *testing.T) {
+	var calls int
+	RegisterMigrator("gconfmigrate", func(db ReadStore, pkg string, conf Unmarshaler) error {
+		calls++
+		if pkg != "gconfmigrate" {
+			return fmt.Errorf("unexpected package %q", pkg)
+		}
+		return conf.(*configuration).err
+	})
+	defer delete(migrators, "gconfmigrate")
+
+	db := store.MemStore()
+	c := configuration{raw: "foobar"}
+	if err := Save(db, "gconfmigrate", &c); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	if err := Load(db, "gconfmigrate", &c); err != nil {
+		t.Fatalf("cannot load configuration: %s", err)
+	}
+	if calls != 1 {
+		t.Fatalf("want migrator called once, got %d", calls)
+	}
+
+	// Configuration of other packages is not migrated.
+	if err := Save(db, "gconf", &c); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	if err := Load(db, "gconf", &c); err != nil {
+		t.Fatalf("cannot load configuration: %s", err)
+	}
+	if calls != 1 {
+		t.Fatalf("want migrator called once, got %d", calls)
+	}
+}
+
 // configuration is a mock of a protobuf configuration object. It does not
 // marshal/unmarshal itself properly but rather ensures that the right bytes
 // were passed around.
@@ -0,0 +1,122 @@
+package gconf
+
+import (
+	"math"
+	"reflect"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+)
+
+// Int returns the value of an integer field of the package configuration or
+// def if the configuration was never set.
+func Int(db ReadStore, pkg, field string, def int64) (int64, error) {
+	val, ok, err := loadField(db, pkg, field, isInt)
+	if err != nil || !ok {
+		return def, err
+	}
+	switch val.Kind() {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if val.Uint() > math.MaxInt64 {
+			return def, errors.Wrapf(errors.ErrOverflow, "%s configuration field %q", pkg, field)
+		}
+		return int64(val.Uint()), nil
+	default:
+		return val.Int(), nil
+	}
+}
+
+func isInt(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		// Durations are integers as well, but reading them as
+		// such is most likely a mistake.
+		return t != durationType && t != unixDurationType
+	}
+	return false
+}
+
+// Coin returns the value of a coin field of the package configuration or def
+// if the configuration was never set. A nil coin pointer is returned as a
+// zero coin.
+func Coin(db ReadStore, pkg, field string, def coin.Coin) (coin.Coin, error) {
+	val, ok, err := loadField(db, pkg, field, func(t reflect.Type) bool {
+		return t == coinType || t == reflect.PtrTo(coinType)
+	})
+	if err != nil || !ok {
+		return def, err
+	}
+	if val.Kind() == reflect.Ptr {
+		if val.IsNil() {
+			return coin.Coin{}, nil
+		}
+		val = val.Elem()
+	}
+	return val.Interface().(coin.Coin), nil
+}
+
+// Duration returns the value of a duration field of the package
+// configuration or def if the configuration was never set. Both
+// time.Duration and weave.UnixDuration fields are supported.
+func Duration(db ReadStore, pkg, field string, def time.Duration) (time.Duration, error) {
+	val, ok, err := loadField(db, pkg, field, func(t reflect.Type) bool {
+		return t == durationType || t == unixDurationType
+	})
+	if err != nil || !ok {
+		return def, err
+	}
+	if val.Type() == unixDurationType {
+		return val.Interface().(weave.UnixDuration).Duration(), nil
+	}
+	return val.Interface().(time.Duration), nil
+}
+
+// Address returns the value of an address field of the package
+// configuration or def if the configuration was never set.
+func Address(db ReadStore, pkg, field string, def weave.Address) (weave.Address, error) {
+	val, ok, err := loadField(db, pkg, field, func(t reflect.Type) bool {
+		return t == addressType
+	})
+	if err != nil || !ok {
+		return def, err
+	}
+	return val.Interface().(weave.Address), nil
+}
+
+var (
+	coinType         = reflect.TypeOf(coin.Coin{})
+	addressType      = reflect.TypeOf(weave.Address{})
+	durationType     = reflect.TypeOf(time.Duration(0))
+	unixDurationType = reflect.TypeOf(weave.UnixDuration(0))
+)
+
+// loadField returns the value of a field with given name of the registered
+// package configuration. False is returned if the configuration was never
+// set. The field type is validated before the configuration is loaded, so
+// that a mistake is reported even if the configuration does not exist.
+func loadField(db ReadStore, pkg, name string, validType func(reflect.Type) bool) (reflect.Value, bool, error) {
+	t, ok := registered[pkg]
+	if !ok {
+		return reflect.Value{}, false, errors.Wrapf(errors.ErrNotFound, "no configuration registered for %q package", pkg)
+	}
+	field, ok := t.FieldByName(name)
+	if !ok {
+		return reflect.Value{}, false, errors.Wrapf(errors.ErrType, "%s configuration has no %q field", pkg, name)
+	}
+	if !validType(field.Type) {
+		return reflect.Value{}, false, errors.Wrapf(errors.ErrType, "%s configuration field %q is of type %s", pkg, name, field.Type)
+	}
+
+	config := reflect.New(t)
+	switch err := Load(db, pkg, config.Interface().(Unmarshaler)); {
+	case err == nil:
+		return config.Elem().FieldByIndex(field.Index), true, nil
+	case errors.ErrNotFound.Is(err):
+		return reflect.Value{}, false, nil
+	default:
+		return reflect.Value{}, false, errors.Wrap(err, "load configuration")
+	}
+}
@@ -0,0 +1,152 @@
+package gconf
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestTypedGetters(t *testing.T) {
+	Register("getterstest", &getterconfig{})
+	defer delete(registered, "getterstest")
+
+	owner := weavetest.NewCondition().Address()
+	stored := &getterconfig{
+		Owner:    owner,
+		Num:      42,
+		Count:    7,
+		Fee:      coin.NewCoin(1, 2, "IOV"),
+		Period:   weave.UnixDuration(60),
+		Timeout:  time.Minute,
+		Optional: nil,
+	}
+
+	cases := map[string]struct {
+		Init    ValidMarshaler
+		Get     func(db ReadStore) (interface{}, error)
+		Want    interface{}
+		WantErr *errors.Error
+	}{
+		"int from the configuration": {
+			Init: stored,
+			Get:  func(db ReadStore) (interface{}, error) { return Int(db, "getterstest", "Num", 1) },
+			Want: int64(42),
+		},
+		"unsigned int from the configuration": {
+			Init: stored,
+			Get:  func(db ReadStore) (interface{}, error) { return Int(db, "getterstest", "Count", 1) },
+			Want: int64(7),
+		},
+		"int default": {
+			Get:  func(db ReadStore) (interface{}, error) { return Int(db, "getterstest", "Num", 1) },
+			Want: int64(1),
+		},
+		"coin from the configuration": {
+			Init: stored,
+			Get: func(db ReadStore) (interface{}, error) {
+				return Coin(db, "getterstest", "Fee", coin.NewCoin(5, 0, "ETH"))
+			},
+			Want: coin.NewCoin(1, 2, "IOV"),
+		},
+		"nil coin from the configuration is zero": {
+			Init: stored,
+			Get: func(db ReadStore) (interface{}, error) {
+				return Coin(db, "getterstest", "Optional", coin.NewCoin(5, 0, "ETH"))
+			},
+			Want: coin.Coin{},
+		},
+		"coin default": {
+			Get: func(db ReadStore) (interface{}, error) {
+				return Coin(db, "getterstest", "Fee", coin.NewCoin(5, 0, "ETH"))
+			},
+			Want: coin.NewCoin(5, 0, "ETH"),
+		},
+		"unix duration from the configuration": {
+			Init: stored,
+			Get: func(db ReadStore) (interface{}, error) {
+				return Duration(db, "getterstest", "Period", time.Hour)
+			},
+			Want: time.Minute,
+		},
+		"duration from the configuration": {
+			Init: stored,
+			Get: func(db ReadStore) (interface{}, error) {
+				return Duration(db, "getterstest", "Timeout", time.Hour)
+			},
+			Want: time.Minute,
+		},
+		"duration default": {
+			Get: func(db ReadStore) (interface{}, error) {
+				return Duration(db, "getterstest", "Period", time.Hour)
+			},
+			Want: time.Hour,
+		},
+		"address from the configuration": {
+			Init: stored,
+			Get: func(db ReadStore) (interface{}, error) {
+				return Address(db, "getterstest", "Owner", nil)
+			},
+			Want: owner,
+		},
+		"address default": {
+			Get: func(db ReadStore) (interface{}, error) {
+				return Address(db, "getterstest", "Owner", nil)
+			},
+			Want: weave.Address(nil),
+		},
+		"package not registered": {
+			Get:     func(db ReadStore) (interface{}, error) { return Int(db, "unknown", "Num", 1) },
+			Want:    int64(1),
+			WantErr: errors.ErrNotFound,
+		},
+		"unknown field": {
+			Init:    stored,
+			Get:     func(db ReadStore) (interface{}, error) { return Int(db, "getterstest", "Unknown", 1) },
+			Want:    int64(1),
+			WantErr: errors.ErrType,
+		},
+		"field of a different type is reported without configuration": {
+			Get:     func(db ReadStore) (interface{}, error) { return Int(db, "getterstest", "Period", 1) },
+			Want:    int64(1),
+			WantErr: errors.ErrType,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			if tc.Init != nil {
+				if err := Save(db, "getterstest", tc.Init); err != nil {
+					t.Fatalf("cannot save configuration: %s", err)
+				}
+			}
+			got, err := tc.Get(db)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			assert.Equal(t, tc.Want, got)
+		})
+	}
+}
+
+type getterconfig struct {
+	Owner    weave.Address
+	Num      int64
+	Count    uint32
+	Fee      coin.Coin
+	Period   weave.UnixDuration
+	Timeout  time.Duration
+	Optional *coin.Coin
+}
+
+func (c *getterconfig) GetOwner() weave.Address    { return c.Owner }
+func (c *getterconfig) Marshal() ([]byte, error)   { return json.Marshal(c) }
+func (c *getterconfig) Unmarshal(raw []byte) error { return json.Unmarshal(raw, &c) }
+func (c *getterconfig) Validate() error            { return nil }
@@ -6,6 +6,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/x"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 // OwnedConfig must have an Owner field in protobuf. A configuration update
@@ -17,15 +18,27 @@ type OwnedConfig interface {
 	GetOwner() weave.Address
 }
 
+// UpdateConfigurationHandler is a generic handler of a configuration update
+// message. It can be used by any extension that stores its configuration
+// using this package.
+//
+// The message must declare a "Patch" field of the same type as the
+// configuration. Non zero fields of the patch are copied over the stored
+// configuration. The result is validated before it is persisted.
 type UpdateConfigurationHandler struct {
 	pkg string
-	// We require this type to load the data.
+	// We require this type to load the data. The value itself is never
+	// modified, each call is using a new instance of the same type.
 	config OwnedConfig
 	auth   x.Authenticator
 }
 
 var _ weave.Handler = (*UpdateConfigurationHandler)(nil)
 
+// NewUpdateConfigurationHandler returns a handler that updates the
+// configuration of given package. Config must be a pointer to the
+// configuration type, for example &Configuration{}. Only the configuration
+// owner, as authenticated by auth, is authorized to apply a change.
 func NewUpdateConfigurationHandler(pkg string, config OwnedConfig, auth x.Authenticator) UpdateConfigurationHandler {
 	return UpdateConfigurationHandler{
 		pkg:    pkg,
@@ -35,60 +48,77 @@ func NewUpdateConfigurationHandler(pkg string, config OwnedConfig, auth x.Authen
 }
 
 func (h UpdateConfigurationHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	if err := h.applyTx(ctx, store, tx); err != nil {
+	if _, err := h.applyTx(ctx, store, tx); err != nil {
 		return nil, err
 	}
 	return &weave.CheckResult{}, nil
 }
 
 func (h UpdateConfigurationHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	if err := h.applyTx(ctx, store, tx); err != nil {
+	tags, err := h.applyTx(ctx, store, tx)
+	if err != nil {
 		return nil, err
 	}
-	return &weave.DeliverResult{}, nil
+	return &weave.DeliverResult{Tags: tags}, nil
+}
+
+func (h UpdateConfigurationHandler) applyTx(ctx weave.Context, store weave.KVStore, tx weave.Tx) ([]common.KVPair, error) {
+	payload, err := patchPayload(tx)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot get message payload")
+	}
+	// Unmarshal does not reset the destination, so a configuration must
+	// always be loaded into a new instance. Otherwise for example repeated
+	// fields would accumulate values between calls.
+	config := reflect.New(reflect.TypeOf(h.config).Elem()).Interface().(OwnedConfig)
+	return update(ctx, store, h.auth, h.pkg, config, payload)
 }
 
-func (h UpdateConfigurationHandler) applyTx(ctx weave.Context, store weave.KVStore, tx weave.Tx) error {
-	if err := Load(store, h.pkg, h.config); err != nil {
-		return errors.Wrap(err, "load message")
+// update loads the configuration of given package into config, patches it
+// with non zero fields of the payload and saves the result. Configuration
+// owner must be authenticated in order to authorize the change. Returned
+// tags describe the change.
+func update(ctx weave.Context, store Store, auth x.Authenticator, pkg string, config, payload OwnedConfig) ([]common.KVPair, error) {
+	if err := Load(store, pkg, config); err != nil {
+		return nil, errors.Wrap(err, "load message")
 	}
 
 	// Configuration owner must sign the transaction in order to
 	// authenticate the change.
-	owner := h.config.GetOwner()
+	owner := config.GetOwner()
 	if owner == nil {
-		return errors.Wrap(errors.ErrUnauthorized, "owner signature required")
+		return nil, errors.Wrap(errors.ErrUnauthorized, "owner signature required")
 	}
-	if !h.auth.HasAddress(ctx, owner) {
-		return errors.Wrap(errors.ErrUnauthorized, "owner did not sign transaction")
+	if !auth.HasAddress(ctx, owner) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "owner did not sign transaction")
 	}
 
-	payload, err := patchPayload(tx)
+	changed, err := patch(config, payload)
 	if err != nil {
-		return errors.Wrap(err, "cannot get message payload")
-	}
-	if err := patch(h.config, payload); err != nil {
-		return errors.Wrap(err, "cannot patch config with message payload")
+		return nil, errors.Wrap(err, "cannot patch config with message payload")
 	}
-
-	if err := Save(store, h.pkg, h.config); err != nil {
-		return errors.Wrap(err, "cannot save updated config")
+	// Save validates the configuration before it is persisted.
+	if err := Save(store, pkg, config); err != nil {
+		return nil, errors.Wrap(err, "cannot save updated config")
 	}
-	return nil
+	return updateTags(pkg, owner, changed), nil
 }
 
-func patch(config OwnedConfig, payload OwnedConfig) error {
+// patch copies non zero fields of the payload into the config. Names of the
+// fields that changed value are returned.
+func patch(config OwnedConfig, payload OwnedConfig) ([]string, error) {
 	// We are guaranteed that config and payload are the same type from
 	// patchPayload.
 	pType := reflect.TypeOf(payload)
 	cType := reflect.TypeOf(config)
 	if !pType.ConvertibleTo(cType) {
-		return errors.Wrap(errors.ErrMsg, "config in message doesn't match store")
+		return nil, errors.Wrap(errors.ErrMsg, "config in message doesn't match store")
 	}
 
 	cval := reflect.ValueOf(config).Elem()
 	pval := reflect.ValueOf(payload).Elem()
 
+	var changed []string
 	for i := 0; i < cval.NumField(); i++ {
 		got := pval.Field(i)
 
@@ -96,11 +126,14 @@ func patch(config OwnedConfig, payload OwnedConfig) error {
 		if isZero(got) {
 			continue
 		}
+		if !reflect.DeepEqual(cval.Field(i).Interface(), got.Interface()) {
+			changed = append(changed, cval.Type().Field(i).Name)
+		}
 
 		cval.Field(i).Set(got)
 	}
 
-	return nil
+	return changed, nil
 }
 
 // isZero returns true if given value represents a zero value of a given type.
@@ -131,6 +164,9 @@ func patchPayload(tx weave.Tx) (OwnedConfig, error) {
 	val := pval.Elem()
 
 	field := val.FieldByName("Patch")
+	if !field.IsValid() || field.Kind() != reflect.Ptr {
+		return nil, errors.Wrapf(errors.ErrInput, `message %T has no "Patch" field`, msg)
+	}
 	if field.IsNil() {
 		return nil, errors.Wrap(errors.ErrState, `"Patch" field is required`)
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 func TestUpdateConfigurationHandler(t *testing.T) {
@@ -30,6 +31,9 @@ func TestUpdateConfigurationHandler(t *testing.T) {
 		// When not nil database state will be tested to contain the
 		// exact version of the configuration.
 		WantConfig *myconfig
+
+		// Tags emitted by a successful delivery.
+		WantTags []common.KVPair
 	}{
 		"success": {
 			Init: &myconfig{
@@ -53,6 +57,11 @@ func TestUpdateConfigurationHandler(t *testing.T) {
 				Str:   "boing!",
 				Cn:    coin.NewCoin(4, 4, "XYZ"),
 			},
+			WantTags: []common.KVPair{
+				{Key: []byte("gconf.update"), Value: []byte("mypkg")},
+				{Key: []byte("gconf.mypkg.fields"), Value: []byte("Num,Str,Cn")},
+				{Key: []byte("gconf.mypkg.updater"), Value: []byte(cond.Address().String())},
+			},
 		},
 		"message must be signed by the configuration owner": {
 			Init: &myconfig{
@@ -90,6 +99,11 @@ func TestUpdateConfigurationHandler(t *testing.T) {
 				Str:   "foobar",
 				Cn:    coin.NewCoin(0, 4, "IOV"),
 			},
+			WantTags: []common.KVPair{
+				{Key: []byte("gconf.update"), Value: []byte("mypkg")},
+				{Key: []byte("gconf.mypkg.fields"), Value: []byte("Cn")},
+				{Key: []byte("gconf.mypkg.updater"), Value: []byte(cond.Address().String())},
+			},
 		},
 		"invalid configuration is not accepted": {
 			Init: &myconfig{
@@ -110,6 +124,24 @@ func TestUpdateConfigurationHandler(t *testing.T) {
 			WantCheckErr:   errors.ErrCurrency,
 			WantDeliverErr: errors.ErrCurrency,
 		},
+		"message without a patch is not accepted": {
+			Init: &myconfig{
+				Owner: cond.Address(),
+				Num:   5125,
+				Str:   "foobar",
+				Cn:    coin.NewCoin(10, 409, "IOV"),
+			},
+			Msg:            &weavetest.Msg{RoutePath: "myconfig"},
+			MsgConditions:  []weave.Condition{cond},
+			WantCheckErr:   errors.ErrInput,
+			WantDeliverErr: errors.ErrInput,
+			WantConfig: &myconfig{
+				Owner: cond.Address(),
+				Num:   5125,
+				Str:   "foobar",
+				Cn:    coin.NewCoin(10, 409, "IOV"),
+			},
+		},
 	}
 
 	for testName, tc := range cases {
@@ -138,9 +170,17 @@ func TestUpdateConfigurationHandler(t *testing.T) {
 			}
 			cache.Discard()
 
-			if _, err := handler.Deliver(ctx, db, tx); !tc.WantDeliverErr.Is(err) {
+			res, err := handler.Deliver(ctx, db, tx)
+			if !tc.WantDeliverErr.Is(err) {
 				t.Fatal(err)
 			}
+			if err == nil {
+				assert.Equal(t, tc.WantTags, res.Tags)
+			}
+
+			// Configuration instance provided to the handler is only
+			// a type template and must never be modified.
+			assert.Equal(t, myconfig{}, c)
 
 			if tc.WantConfig != nil {
 				var got myconfig
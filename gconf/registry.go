@@ -0,0 +1,74 @@
+package gconf
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+// registered maps package names to their configuration types.
+var registered = make(map[string]reflect.Type)
+
+// Register declares the configuration type of given package. A registered
+// configuration can be updated using a serialized patch, without the
+// knowledge of its type. This is used for example by governance proposals.
+//
+// This function panics if a configuration for given package was already
+// registered or when the configuration is not a pointer to a struct. It is
+// intended to be called during the extension initialization.
+func Register(pkg string, conf OwnedConfig) {
+	if _, ok := registered[pkg]; ok {
+		panic(fmt.Sprintf("configuration of %q package already registered", pkg))
+	}
+	t := reflect.TypeOf(conf)
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		panic(fmt.Sprintf("configuration of %q package must be a pointer to a struct, got %T", pkg, conf))
+	}
+	registered[pkg] = t.Elem()
+}
+
+// Migrator upgrades the configuration of given package right after it was
+// loaded from the database.
+type Migrator func(db ReadStore, pkg string, conf Unmarshaler) error
+
+// migrators maps package names to functions upgrading their configuration.
+var migrators = make(map[string]Migrator)
+
+// RegisterMigrator declares a function that is called every time the
+// configuration of given package is loaded. This allows to upgrade a stored
+// configuration, for example to the current schema version, without this
+// package knowing the migration mechanism. Use migration.MustRegisterConfig
+// instead of calling this function directly.
+//
+// This function panics if a migrator for given package was already
+// registered. It is intended to be called during the extension
+// initialization.
+func RegisterMigrator(pkg string, fn Migrator) {
+	if _, ok := migrators[pkg]; ok {
+		panic(fmt.Sprintf("configuration migrator of %q package already registered", pkg))
+	}
+	migrators[pkg] = fn
+}
+
+// UpdateRegistered patches the stored configuration of given package with
+// non zero fields of the serialized patch. The patch must be the serialized
+// configuration of the type registered for that package. Just like with the
+// configuration update message, the configuration owner must be
+// authenticated in order to authorize the change. Returned tags describe the
+// change and should be included in the transaction result.
+func UpdateRegistered(ctx weave.Context, db Store, auth x.Authenticator, pkg string, rawPatch []byte) ([]common.KVPair, error) {
+	t, ok := registered[pkg]
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrNotFound, "no configuration registered for %q package", pkg)
+	}
+	config := reflect.New(t).Interface().(OwnedConfig)
+	payload := reflect.New(t).Interface().(OwnedConfig)
+	if err := payload.Unmarshal(rawPatch); err != nil {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot unmarshal %q configuration patch: %s", pkg, err)
+	}
+	return update(ctx, db, auth, pkg, config, payload)
+}
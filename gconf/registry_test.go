@@ -0,0 +1,94 @@
+package gconf
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestUpdateRegistered(t *testing.T) {
+	Register("registrytest", &myconfig{})
+	defer delete(registered, "registrytest")
+
+	owner := weavetest.NewCondition()
+	initial := &myconfig{
+		Owner: owner.Address(),
+		Num:   5125,
+		Str:   "foobar",
+		Cn:    coin.NewCoin(10, 409, "IOV"),
+	}
+	patch, err := json.Marshal(&myconfig{Num: 333})
+	assert.Nil(t, err)
+
+	cases := map[string]struct {
+		Pkg        string
+		Patch      []byte
+		Conditions []weave.Condition
+		WantErr    *errors.Error
+		WantConfig *myconfig
+	}{
+		"success": {
+			Pkg:        "registrytest",
+			Patch:      patch,
+			Conditions: []weave.Condition{owner},
+			WantConfig: &myconfig{
+				Owner: owner.Address(),
+				Num:   333,
+				Str:   "foobar",
+				Cn:    coin.NewCoin(10, 409, "IOV"),
+			},
+		},
+		"owner must be authenticated": {
+			Pkg:        "registrytest",
+			Patch:      patch,
+			Conditions: []weave.Condition{weavetest.NewCondition()},
+			WantErr:    errors.ErrUnauthorized,
+			WantConfig: initial,
+		},
+		"package not registered": {
+			Pkg:        "unknown",
+			Patch:      patch,
+			Conditions: []weave.Condition{owner},
+			WantErr:    errors.ErrNotFound,
+			WantConfig: initial,
+		},
+		"invalid patch": {
+			Pkg:        "registrytest",
+			Patch:      []byte("not a json"),
+			Conditions: []weave.Condition{owner},
+			WantErr:    errors.ErrInput,
+			WantConfig: initial,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			assert.Nil(t, Save(db, "registrytest", initial))
+
+			auth := &weavetest.CtxAuth{Key: "auth"}
+			ctx := auth.SetConditions(context.Background(), tc.Conditions...)
+			if _, err := UpdateRegistered(ctx, db, auth, tc.Pkg, tc.Patch); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			var got myconfig
+			assert.Nil(t, Load(db, "registrytest", &got))
+			assert.Equal(t, tc.WantConfig, &got)
+		})
+	}
+}
+
+func TestRegisterTwice(t *testing.T) {
+	Register("registrytwice", &myconfig{})
+	defer delete(registered, "registrytwice")
+
+	assert.Panics(t, func() { Register("registrytwice", &myconfig{}) })
+}
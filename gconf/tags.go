@@ -0,0 +1,36 @@
+package gconf
+
+import (
+	"strings"
+
+	"github.com/iov-one/weave"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+const (
+	// UpdateTag is the tag key used to mark the name of the package which
+	// configuration was changed.
+	UpdateTag = "gconf.update"
+)
+
+// updateTags returns tags describing a configuration change, so that
+// monitoring systems can alert about parameter changes, for example a fee
+// update. Besides the package name tagged with the UpdateTag key, comma
+// separated names of the changed fields are tagged with the
+// "gconf.<package>.fields" key and the address that authorized the change
+// with the "gconf.<package>.updater" key.
+//
+// Tendermint collapses multiple tags with the same key, so if several
+// configurations are changed within a single transaction, only one of the
+// package names is tagged with the UpdateTag key. Package specific keys are
+// always unique. No tags are returned if no field changed value.
+func updateTags(pkg string, updater weave.Address, fields []string) []common.KVPair {
+	if len(fields) == 0 {
+		return nil
+	}
+	return weave.NewTags("gconf").
+		String("update", pkg).
+		String(pkg+".fields", strings.Join(fields, ",")).
+		Address(pkg+".updater", updater).
+		KVPairs()
+}
@@ -47,6 +47,28 @@ type Registry interface {
 	Handle(Msg, Handler)
 }
 
+// PreHook is called before a message is processed by its handler, both in
+// Check and Deliver. Returning an error aborts processing of the
+// transaction.
+type PreHook func(ctx Context, store KVStore, tx Tx) error
+
+// PostHook is called after a message was successfully delivered by its
+// handler. It can read the handler result and extend it, for example with
+// additional tags. Returning an error aborts processing of the transaction.
+type PostHook func(ctx Context, store KVStore, tx Tx, res *DeliverResult) error
+
+// HookRegistry is an interface to subscribe to processing of messages handled
+// by another extension. Hooks allow extensions to react to each other without
+// importing each other's controllers.
+type HookRegistry interface {
+	// PreHook registers given hook to be called before every message of
+	// provided type is processed.
+	PreHook(Msg, PreHook)
+	// PostHook registers given hook to be called after every message of
+	// provided type is successfully delivered.
+	PostHook(Msg, PostHook)
+}
+
 // Options are the app options
 // Each extension can look up it's key and parse the json as desired
 type Options map[string]json.RawMessage
@@ -190,33 +190,247 @@ func (m *UpgradeSchemaMsg) GetPkg() string {
 	return ""
 }
 
+// DowngradeSchemaMsg is a request to downgrade schema version of a given
+// package by one version. This allows to roll back an upgrade. Entities stored
+// using the higher schema version can be used only if a reverse migration was
+// registered for them.
+type DowngradeSchemaMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Name of the package that schema version downgrade is made for.
+	Pkg string `protobuf:"bytes,2,opt,name=pkg,proto3" json:"pkg,omitempty"`
+}
+
+func (m *DowngradeSchemaMsg) Reset()         { *m = DowngradeSchemaMsg{} }
+func (m *DowngradeSchemaMsg) String() string { return proto.CompactTextString(m) }
+func (*DowngradeSchemaMsg) ProtoMessage()    {}
+func (*DowngradeSchemaMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf669b5eede564b, []int{3}
+}
+func (m *DowngradeSchemaMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DowngradeSchemaMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DowngradeSchemaMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DowngradeSchemaMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DowngradeSchemaMsg.Merge(m, src)
+}
+func (m *DowngradeSchemaMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DowngradeSchemaMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DowngradeSchemaMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DowngradeSchemaMsg proto.InternalMessageInfo
+
+func (m *DowngradeSchemaMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *DowngradeSchemaMsg) GetPkg() string {
+	if m != nil {
+		return m.Pkg
+	}
+	return ""
+}
+
+// BucketMigration tracks the progress of migrating all entities of a bucket
+// to the current schema version of its package.
+type BucketMigration struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Bucket holds the name of the migrated bucket.
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// Schema holds the version that the entities are migrated to.
+	Schema uint32 `protobuf:"varint,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Cursor holds the key of the last migrated entity. Migration continues
+	// with the entity following it.
+	Cursor []byte `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// Migrated holds the number of entities migrated so far.
+	Migrated uint64 `protobuf:"varint,5,opt,name=migrated,proto3" json:"migrated,omitempty"`
+	// Done is set once all entities of the bucket were migrated.
+	Done bool `protobuf:"varint,6,opt,name=done,proto3" json:"done,omitempty"`
+}
+
+func (m *BucketMigration) Reset()         { *m = BucketMigration{} }
+func (m *BucketMigration) String() string { return proto.CompactTextString(m) }
+func (*BucketMigration) ProtoMessage()    {}
+func (*BucketMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf669b5eede564b, []int{4}
+}
+func (m *BucketMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BucketMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BucketMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BucketMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketMigration.Merge(m, src)
+}
+func (m *BucketMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *BucketMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketMigration proto.InternalMessageInfo
+
+func (m *BucketMigration) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *BucketMigration) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *BucketMigration) GetSchema() uint32 {
+	if m != nil {
+		return m.Schema
+	}
+	return 0
+}
+
+func (m *BucketMigration) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *BucketMigration) GetMigrated() uint64 {
+	if m != nil {
+		return m.Migrated
+	}
+	return 0
+}
+
+func (m *BucketMigration) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+// MigrateBucketMsg is a request to migrate the next chunk of entities stored
+// in a given bucket to the current schema version. All entities are migrated
+// by repeatedly submitting this message until the migration is done.
+type MigrateBucketMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Name of the bucket that is migrated.
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+}
+
+func (m *MigrateBucketMsg) Reset()         { *m = MigrateBucketMsg{} }
+func (m *MigrateBucketMsg) String() string { return proto.CompactTextString(m) }
+func (*MigrateBucketMsg) ProtoMessage()    {}
+func (*MigrateBucketMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ecf669b5eede564b, []int{5}
+}
+func (m *MigrateBucketMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MigrateBucketMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MigrateBucketMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MigrateBucketMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateBucketMsg.Merge(m, src)
+}
+func (m *MigrateBucketMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *MigrateBucketMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateBucketMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateBucketMsg proto.InternalMessageInfo
+
+func (m *MigrateBucketMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *MigrateBucketMsg) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Configuration)(nil), "migration.Configuration")
 	proto.RegisterType((*Schema)(nil), "migration.Schema")
 	proto.RegisterType((*UpgradeSchemaMsg)(nil), "migration.UpgradeSchemaMsg")
+	proto.RegisterType((*DowngradeSchemaMsg)(nil), "migration.DowngradeSchemaMsg")
+	proto.RegisterType((*BucketMigration)(nil), "migration.BucketMigration")
+	proto.RegisterType((*MigrateBucketMsg)(nil), "migration.MigrateBucketMsg")
 }
 
 func init() { proto.RegisterFile("migration/codec.proto", fileDescriptor_ecf669b5eede564b) }
 
 var fileDescriptor_ecf669b5eede564b = []byte{
-	// 260 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcd, 0xcd, 0x4c, 0x2f,
-	0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x4f, 0xce, 0x4f, 0x49, 0x4d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0xe2, 0x84, 0x0b, 0x4b, 0x71, 0x23, 0x89, 0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83,
-	0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0xc9, 0x9b, 0x8b, 0xd7, 0x39, 0x3f, 0x2f, 0x2d, 0x33, 0xbd,
-	0x14, 0xa2, 0x47, 0xc8, 0x8a, 0x8b, 0x35, 0x31, 0x25, 0x37, 0x33, 0x4f, 0x82, 0x49, 0x81, 0x51,
-	0x83, 0xc7, 0x49, 0xe5, 0xd7, 0x3d, 0x79, 0x85, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4,
-	0xfc, 0x5c, 0xfd, 0xcc, 0xfc, 0x32, 0xdd, 0xfc, 0xbc, 0x54, 0xfd, 0xf2, 0xd4, 0xc4, 0xb2, 0x54,
-	0x3d, 0xc7, 0x94, 0x94, 0xa2, 0xd4, 0xe2, 0xe2, 0x20, 0x88, 0x16, 0xa5, 0x44, 0x2e, 0xb6, 0xe0,
-	0xe4, 0x8c, 0xd4, 0xdc, 0x44, 0x21, 0x6d, 0x2e, 0x8e, 0xdc, 0xd4, 0x92, 0xc4, 0x94, 0xc4, 0x92,
-	0x44, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x7e, 0x3d, 0x88, 0x16, 0x5f, 0xa8, 0x70, 0x10,
-	0x5c, 0x81, 0x90, 0x00, 0x17, 0x73, 0x41, 0x76, 0x3a, 0xd8, 0x42, 0xce, 0x20, 0x10, 0x53, 0x48,
-	0x82, 0x8b, 0xbd, 0x2c, 0xb5, 0xa8, 0x38, 0x33, 0x3f, 0x4f, 0x82, 0x59, 0x81, 0x51, 0x83, 0x37,
-	0x08, 0xc6, 0x55, 0x0a, 0xe4, 0x12, 0x08, 0x2d, 0x48, 0x2f, 0x4a, 0x4c, 0x49, 0x85, 0xd8, 0xe4,
-	0x5b, 0x9c, 0x4e, 0xa1, 0x65, 0x4e, 0x12, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8,
-	0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7,
-	0x90, 0xc4, 0x06, 0x0e, 0x23, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x60, 0x35, 0xa8, 0x1c,
-	0x6a, 0x01, 0x00, 0x00,
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0x4d, 0x4f, 0xc2, 0x40,
+	0x14, 0x64, 0x05, 0x2a, 0x3c, 0x24, 0x90, 0x8d, 0x9a, 0x0d, 0x87, 0xda, 0x34, 0x1e, 0x9a, 0x18,
+	0xdb, 0x44, 0x6f, 0xde, 0x44, 0x6f, 0x86, 0x83, 0x25, 0xc6, 0xf3, 0xd2, 0x5d, 0x97, 0x86, 0xb4,
+	0x8f, 0xf4, 0x03, 0xfe, 0x86, 0xbf, 0xc6, 0xdf, 0xe0, 0x91, 0xa3, 0x27, 0x63, 0xe0, 0x5f, 0x78,
+	0x32, 0x6d, 0x17, 0xe2, 0xd5, 0xc8, 0x6d, 0x66, 0xb2, 0xf3, 0x66, 0xb2, 0xef, 0xc1, 0x49, 0x14,
+	0xaa, 0x84, 0x67, 0x21, 0xc6, 0x5e, 0x80, 0x42, 0x06, 0xee, 0x3c, 0xc1, 0x0c, 0x69, 0x7b, 0x27,
+	0x0f, 0x3a, 0xbf, 0xf4, 0xc1, 0xb1, 0x42, 0x85, 0x25, 0xf4, 0x0a, 0x54, 0xa9, 0xf6, 0x03, 0x74,
+	0xef, 0x30, 0x7e, 0x09, 0x55, 0x5e, 0x79, 0xe8, 0x0d, 0x34, 0xb9, 0x88, 0xc2, 0x98, 0x1d, 0x58,
+	0xc4, 0x39, 0x1a, 0x9e, 0x7f, 0x7f, 0x9e, 0x59, 0x2a, 0xcc, 0xa6, 0xf9, 0xc4, 0x0d, 0x30, 0xf2,
+	0x42, 0x5c, 0x5c, 0x62, 0x2c, 0xbd, 0xa5, 0xe4, 0x0b, 0xe9, 0xde, 0x0a, 0x91, 0xc8, 0x34, 0xf5,
+	0x2b, 0x8b, 0xcd, 0xc1, 0x18, 0x07, 0x53, 0x19, 0x71, 0x7a, 0x01, 0xad, 0x48, 0x66, 0x5c, 0xf0,
+	0x8c, 0x33, 0x62, 0x11, 0xa7, 0x73, 0xd5, 0x73, 0x2b, 0xcb, 0x48, 0xcb, 0xfe, 0xee, 0x01, 0xed,
+	0x43, 0x7d, 0x3e, 0x53, 0x65, 0x60, 0xdb, 0x2f, 0x20, 0x65, 0x70, 0xb8, 0x90, 0x49, 0x1a, 0x62,
+	0xcc, 0xea, 0x16, 0x71, 0xba, 0xfe, 0x96, 0xda, 0x8f, 0xd0, 0x7f, 0x9a, 0xab, 0x84, 0x0b, 0x59,
+	0x25, 0x8d, 0x52, 0xf5, 0xcf, 0x30, 0x7b, 0x0c, 0xf4, 0x1e, 0x97, 0xf1, 0x7e, 0x87, 0xbe, 0x11,
+	0xe8, 0x0d, 0xf3, 0x60, 0x26, 0xb3, 0xd1, 0x76, 0x1d, 0x7f, 0x1b, 0x79, 0x0a, 0xc6, 0xa4, 0xf4,
+	0xeb, 0xa9, 0x9a, 0x15, 0x7a, 0x5a, 0x96, 0xd4, 0x3f, 0xa3, 0x59, 0xa1, 0x07, 0x79, 0x92, 0x62,
+	0xc2, 0x1a, 0xc5, 0xe2, 0x7c, 0xcd, 0xe8, 0x00, 0x5a, 0xd5, 0x41, 0x48, 0xc1, 0x9a, 0x16, 0x71,
+	0x1a, 0xfe, 0x8e, 0x53, 0x0a, 0x0d, 0x81, 0xb1, 0x64, 0x86, 0x45, 0x9c, 0x96, 0x5f, 0x62, 0xfb,
+	0x19, 0xfa, 0x55, 0x63, 0xa9, 0xeb, 0xa7, 0x6a, 0x2f, 0xc5, 0x87, 0xec, 0x7d, 0x6d, 0x92, 0xd5,
+	0xda, 0x24, 0x5f, 0x6b, 0x93, 0xbc, 0x6e, 0xcc, 0xda, 0x6a, 0x63, 0xd6, 0x3e, 0x36, 0x66, 0x6d,
+	0x62, 0x94, 0xa7, 0x78, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x05, 0xd7, 0xb2, 0x2c, 0xd1, 0x02,
+	0x00, 0x00,
 }
 
 func (m *Configuration) Marshal() (dAtA []byte, err error) {
@@ -316,6 +530,134 @@ func (m *UpgradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *DowngradeSchemaMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DowngradeSchemaMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	if len(m.Pkg) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Pkg)))
+		i += copy(dAtA[i:], m.Pkg)
+	}
+	return i, nil
+}
+
+func (m *BucketMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BucketMigration) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.Bucket) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Bucket)))
+		i += copy(dAtA[i:], m.Bucket)
+	}
+	if m.Schema != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Schema))
+	}
+	if len(m.Cursor) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Cursor)))
+		i += copy(dAtA[i:], m.Cursor)
+	}
+	if m.Migrated != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Migrated))
+	}
+	if m.Done {
+		dAtA[i] = 0x30
+		i++
+		if m.Done {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *MigrateBucketMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MigrateBucketMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.Bucket) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Bucket)))
+		i += copy(dAtA[i:], m.Bucket)
+	}
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -375,6 +717,70 @@ func (m *UpgradeSchemaMsg) Size() (n int) {
 	return n
 }
 
+func (m *DowngradeSchemaMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Pkg)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *BucketMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Bucket)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Schema != 0 {
+		n += 1 + sovCodec(uint64(m.Schema))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Migrated != 0 {
+		n += 1 + sovCodec(uint64(m.Migrated))
+	}
+	if m.Done {
+		n += 2
+	}
+	return n
+}
+
+func (m *MigrateBucketMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Bucket)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -383,12 +789,239 @@ func sovCodec(x uint64) (n int) {
 			break
 		}
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Configuration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Schema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pkg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pkg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Configuration) Unmarshal(dAtA []byte) error {
+func (m *UpgradeSchemaMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -411,17 +1044,17 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpgradeSchemaMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpgradeSchemaMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -431,25 +1064,59 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pkg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pkg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -475,7 +1142,7 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Schema) Unmarshal(dAtA []byte) error {
+func (m *DowngradeSchemaMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -498,10 +1165,10 @@ func (m *Schema) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Schema: wiretype end group for non-group")
+			return fmt.Errorf("proto: DowngradeSchemaMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Schema: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DowngradeSchemaMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -572,11 +1239,132 @@ func (m *Schema) Unmarshal(dAtA []byte) error {
 			}
 			m.Pkg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BucketMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BucketMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bucket = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			m.Version = 0
+			m.Schema = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -586,11 +1374,84 @@ func (m *Schema) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				m.Schema |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Migrated", wireType)
+			}
+			m.Migrated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Migrated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Done", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Done = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -615,7 +1476,7 @@ func (m *Schema) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpgradeSchemaMsg) Unmarshal(dAtA []byte) error {
+func (m *MigrateBucketMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -638,10 +1499,10 @@ func (m *UpgradeSchemaMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradeSchemaMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: MigrateBucketMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradeSchemaMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MigrateBucketMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -682,7 +1543,7 @@ func (m *UpgradeSchemaMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pkg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -710,7 +1571,7 @@ func (m *UpgradeSchemaMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pkg = string(dAtA[iNdEx:postIndex])
+			m.Bucket = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
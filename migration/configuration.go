@@ -1,6 +1,7 @@
 package migration
 
 import (
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 )
@@ -12,6 +13,28 @@ func (c *Configuration) Validate() error {
 	return nil
 }
 
+// MustRegisterConfig makes the configuration of given package schema
+// versioned. Every time the configuration is loaded using the gconf package,
+// it is upgraded to the current schema version of the package, the same way
+// entities of a Bucket are. The upgraded configuration is persisted the next
+// time it is saved, for example by the configuration update handler.
+//
+// Configuration must implement Migratable interface and its migrations must
+// be registered using MustRegister.
+func MustRegisterConfig(pkg string) {
+	gconf.RegisterMigrator(pkg, configMigrator(reg))
+}
+
+func configMigrator(r *register) gconf.Migrator {
+	return func(db gconf.ReadStore, pkg string, conf gconf.Unmarshaler) error {
+		kv, ok := db.(weave.ReadOnlyKVStore)
+		if !ok {
+			return errors.Wrapf(errors.ErrType, "%T is not a key value store", db)
+		}
+		return migrate(r, NewSchemaBucket(), pkg, kv, conf)
+	}
+}
+
 func mustLoadConf(db gconf.Store) Configuration {
 	var conf Configuration
 	if err := gconf.Load(db, "migration", &conf); err != nil {
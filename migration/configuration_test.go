@@ -0,0 +1,56 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/store"
+)
+
+func TestConfigMigrator(t *testing.T) {
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyModel)
+		msg.Cnt += 2
+		return msg.err
+	})
+	migrate := configMigrator(reg)
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, "testpkg", 1)
+	if err := gconf.Save(db, "testpkg", &MyModel{Metadata: &weave.Metadata{Schema: 1}, Cnt: 5}); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+
+	// Configuration stored using an older schema version is
+	// upgraded once the schema version of the package changes.
+	ensureSchemaVersion(t, db, "testpkg", 2)
+
+	var conf MyModel
+	if err := gconf.Load(db, "testpkg", &conf); err != nil {
+		t.Fatalf("cannot load configuration: %s", err)
+	}
+	if err := migrate(db, "testpkg", &conf); err != nil {
+		t.Fatalf("cannot migrate configuration: %s", err)
+	}
+	if conf.Metadata.Schema != 2 || conf.Cnt != 5+2 {
+		t.Fatalf("unexpected configuration: %#v", conf)
+	}
+
+	// Migration is done in memory only, until the configuration is
+	// saved.
+	var stored MyModel
+	if err := gconf.Load(db, "testpkg", &stored); err != nil {
+		t.Fatalf("cannot load configuration: %s", err)
+	}
+	if stored.Metadata.Schema != 1 || stored.Cnt != 5 {
+		t.Fatalf("unexpected stored configuration: %#v", stored)
+	}
+
+	if err := migrate(db, "unknown", &stored); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error for a package without schema, got %+v", err)
+	}
+}
@@ -43,7 +43,10 @@ that require no change. For example:
     }
 
 3. change your bucket implementation to embed `migration.Bucket` instead of
-`orm.Bucket`
+`orm.Bucket`. Entities are migrated lazily. Each entity is upgraded to the
+current schema version when read and the upgraded version is persisted the
+next time it is saved, so there is no need to migrate all stored entities at
+once.
 
 4. wrap your handler with `migration.SchemaMigratingHandler` to ensure all
 messages are always migrated to the latest schema before being passed to the
@@ -53,5 +56,40 @@ handler,
 This is not necessary for models as it will default to the current schema
 version.
 
+
+Genesis.
+
+Genesis can declare entities and configurations using an older schema
+version, for example when restarting a chain from an exported state. Register
+the migration initializer before any other initializer and use `InitConfig`
+instead of `gconf.InitConfig` to load a schema versioned configuration. Both
+entities and configurations are upgraded to the current schema version before
+being stored.
+
+A stored configuration is upgraded as well when the schema version of its
+package changes. Call `MustRegisterConfig` in the package `init` and the
+configuration is migrated every time it is loaded using the gconf package.
+
+Rollback.
+
+A schema version upgrade can be rolled back using `DowngradeSchemaMsg`. Register
+a reverse migration function for each entity using `MustRegisterDowngrade`, so
+that entities already stored using the higher schema version can still be
+used. Such entities are downgraded when read.
+
+Bulk migration.
+
+Entities that are not accessed are never migrated and therefore old schema
+migration functions cannot be removed. To migrate all entities of a bucket,
+register that bucket using `MustRegisterBucket` function and submit
+`MigrateBucketMsg` until the migration is done. Each message migrates a
+limited number of entities and the progress is stored in the database. The
+progress of each chunk is tagged and logged, together with the time it took.
+Register post-migration validation functions using `MustRegisterValidator` to
+ensure each migrated entity is correct.
+
+Use `DryRun` function to migrate all entities of registered buckets in memory
+and find those that cannot be migrated, before upgrading the schema version.
+
 */
 package migration
@@ -0,0 +1,127 @@
+package migration
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// DryRunReport describes the result of migrating all entities of a single
+// bucket without writing them back.
+type DryRunReport struct {
+	// Bucket is the name of the checked bucket.
+	Bucket string `json:"bucket"`
+	// Package is the name of the package that declares the bucket schema.
+	Package string `json:"package"`
+	// Schema is the current schema version of the package.
+	Schema uint32 `json:"schema"`
+	// Target is the schema version that the entities were migrated to. It
+	// is the highest version with a migration registered.
+	Target uint32 `json:"target"`
+	// Entities is the number of checked entities.
+	Entities int `json:"entities"`
+	// Failures lists all entities that cannot be migrated.
+	Failures []DryRunFailure `json:"failures,omitempty"`
+}
+
+// DryRunFailure describes an entity that cannot be migrated.
+type DryRunFailure struct {
+	// Key is the hex encoded key of the entity.
+	Key   string `json:"key"`
+	Error string `json:"error"`
+}
+
+// DryRun loads every entity of registered buckets with given names and
+// migrates it in memory to the highest schema version that migrations are
+// registered for, including migrations not yet activated by a schema upgrade.
+// Migrated entities are never written to the database. If no bucket name is
+// given, all registered buckets are checked.
+//
+// Use this function to verify that pending migrations succeed for all stored
+// entities before upgrading the schema version.
+func DryRun(db weave.ReadOnlyKVStore, bucketNames ...string) ([]DryRunReport, error) {
+	return reg.DryRun(db, bucketNames...)
+}
+
+func (r *register) DryRun(db weave.ReadOnlyKVStore, bucketNames ...string) ([]DryRunReport, error) {
+	if len(bucketNames) == 0 {
+		for name := range r.buckets {
+			bucketNames = append(bucketNames, name)
+		}
+		sort.Strings(bucketNames)
+	}
+
+	reports := make([]DryRunReport, 0, len(bucketNames))
+	for _, name := range bucketNames {
+		b, err := r.Bucket(name)
+		if err != nil {
+			return nil, err
+		}
+		report, err := r.dryRunBucket(db, b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "bucket %q", name)
+		}
+		reports = append(reports, report)
+	}
+	return reports, nil
+}
+
+func (r *register) dryRunBucket(db weave.ReadOnlyKVStore, b Bucket) (DryRunReport, error) {
+	report := DryRunReport{
+		Bucket:  b.bucketName,
+		Package: b.packageName,
+		Target:  r.Latest(b.model),
+	}
+	ver, err := b.schema.CurrentSchema(db, b.packageName)
+	if err != nil {
+		return report, errors.Wrap(err, "current schema version")
+	}
+	report.Schema = ver
+	if report.Target < ver {
+		// Missing migrations are reported for each entity.
+		report.Target = ver
+	}
+
+	prefix := b.DBKey(nil)
+	end := append(prefix[:len(prefix)-1:len(prefix)-1], ';')
+	it, err := db.Iterator(prefix, end)
+	if err != nil {
+		return report, errors.Wrap(err, "cannot create iterator")
+	}
+	defer it.Release()
+
+	for {
+		key, value, err := it.Next()
+		if err != nil {
+			if errors.ErrIteratorDone.Is(err) {
+				return report, nil
+			}
+			return report, errors.Wrap(err, "iterator")
+		}
+		report.Entities++
+		if err := r.dryRunEntity(db, b, key[len(prefix):], value, report.Target); err != nil {
+			report.Failures = append(report.Failures, DryRunFailure{
+				Key:   fmt.Sprintf("%X", key[len(prefix):]),
+				Error: err.Error(),
+			})
+		}
+	}
+}
+
+func (r *register) dryRunEntity(db weave.ReadOnlyKVStore, b Bucket, key, value []byte, target uint32) error {
+	// Parsing using the embedded bucket does not migrate the entity.
+	obj, err := b.Bucket.Parse(key, value)
+	if err != nil {
+		return errors.Wrap(err, "parse")
+	}
+	m, ok := obj.Value().(Migratable)
+	if !ok {
+		return errors.Wrapf(errors.ErrModel, "%T cannot be migrated", obj.Value())
+	}
+	if m.GetMetadata() != nil && m.GetMetadata().Schema > target {
+		return r.Downgrade(db, m, target)
+	}
+	return r.Apply(db, m, target)
+}
@@ -0,0 +1,65 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestDryRun(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyModel)
+		if msg.Cnt < 0 {
+			return errors.Wrap(errors.ErrState, "negative counter")
+		}
+		msg.Cnt += 2
+		return nil
+	})
+
+	b := NewBucket(thisPkgName, "mymodel", &MyModel{}).useRegister(reg)
+	reg.MustRegisterBucket(b)
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+
+	for key, cnt := range map[string]int{"a": 1, "b": -1, "c": 3} {
+		obj := orm.NewSimpleObj([]byte(key), &MyModel{
+			Metadata: &weave.Metadata{Schema: 1},
+			Cnt:      cnt,
+		})
+		assert.Nil(t, b.Save(db, obj))
+	}
+
+	reports, err := reg.DryRun(db)
+	assert.Nil(t, err)
+	if len(reports) != 1 {
+		t.Fatalf("want one report, got %d", len(reports))
+	}
+	r := reports[0]
+	if r.Bucket != "mymodel" || r.Package != thisPkgName || r.Schema != 1 || r.Target != 2 || r.Entities != 3 {
+		t.Fatalf("unexpected report: %+v", r)
+	}
+	if len(r.Failures) != 1 || r.Failures[0].Key != "62" {
+		t.Fatalf("unexpected failures: %+v", r.Failures)
+	}
+
+	// Dry run must not modify stored entities.
+	raw := orm.NewBucket("mymodel", &MyModel{})
+	obj, err := raw.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	if m := obj.Value().(*MyModel); m.Metadata.Schema != 1 || m.Cnt != 1 {
+		t.Fatalf("unexpected stored model: %#v", m)
+	}
+
+	if _, err := reg.DryRun(db, "unknown"); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error for an unknown bucket, got %+v", err)
+	}
+}
@@ -1,9 +1,14 @@
 package migration
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 // SchemaMigratingRegistry decorates given registry to always migrate schema of
@@ -94,6 +99,16 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
 		bucket: bucket,
 		auth:   auth,
 	})
+	r.Handle(&DowngradeSchemaMsg{}, &downgradeSchemaHandler{
+		bucket: bucket,
+		auth:   auth,
+	})
+	r.Handle(&MigrateBucketMsg{}, &migrateBucketHandler{
+		schema:     bucket,
+		progress:   NewBucketMigrationBucket(),
+		auth:       auth,
+		migrations: reg,
+	})
 }
 
 type upgradeSchemaHandler struct {
@@ -146,6 +161,223 @@ func (h *upgradeSchemaHandler) validate(ctx weave.Context, db weave.KVStore, tx
 	return &msg, nil
 }
 
+type downgradeSchemaHandler struct {
+	bucket *SchemaBucket
+	auth   x.Authenticator
+}
+
+func (h *downgradeSchemaHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *downgradeSchemaHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, ver, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.bucket.Delete(db, schemaID(msg.Pkg, ver)); err != nil {
+		return nil, errors.Wrap(err, "delete schema version")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// validate returns the message and the current schema version of the package
+// that is downgraded.
+func (h *downgradeSchemaHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*DowngradeSchemaMsg, uint32, error) {
+	var msg DowngradeSchemaMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, 0, errors.Wrap(err, "load msg")
+	}
+
+	conf := mustLoadConf(db)
+	if !h.auth.HasAddress(ctx, conf.Admin) {
+		return nil, 0, errors.Wrap(errors.ErrUnauthorized, "admin signature required")
+	}
+
+	ver, err := h.bucket.CurrentSchema(db, msg.Pkg)
+	if err != nil {
+		return nil, 0, errors.Wrap(err, "current schema version")
+	}
+	if ver < 2 {
+		return nil, 0, errors.Wrapf(errors.ErrState, "schema version %d cannot be downgraded", ver)
+	}
+	return &msg, ver, nil
+}
+
+// migrateBucketChunkSize is the maximum number of entities migrated by a
+// single MigrateBucketMsg. It limits the amount of work done within a single
+// transaction, so that big buckets are migrated across many blocks.
+const migrateBucketChunkSize = 100
+
+type migrateBucketHandler struct {
+	schema     *SchemaBucket
+	progress   *BucketMigrationBucket
+	auth       x.Authenticator
+	migrations *register
+}
+
+func (h *migrateBucketHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *migrateBucketHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, b, progress, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	keys, done, err := nextChunk(db, b, progress.Cursor, migrateBucketChunkSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		// Reading through the migration bucket upgrades the entity to
+		// the current schema and saving writes it back.
+		obj, err := b.Get(db, key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "get %q", key)
+		}
+		if obj == nil {
+			continue
+		}
+		if err := b.Save(db, obj); err != nil {
+			return nil, errors.Wrapf(err, "save %q", key)
+		}
+	}
+
+	if len(keys) > 0 {
+		progress.Cursor = keys[len(keys)-1]
+	}
+	progress.Migrated += uint64(len(keys))
+	progress.Done = done
+	obj := orm.NewSimpleObj([]byte(progress.Bucket), progress)
+	if err := h.progress.Save(db, obj); err != nil {
+		return nil, errors.Wrap(err, "save progress")
+	}
+
+	// Duration is not deterministic and therefore it is only logged.
+	weave.GetLogger(ctx).Info("Bucket migration chunk",
+		"bucket", progress.Bucket,
+		"schema", progress.Schema,
+		"entities", len(keys),
+		"migrated", progress.Migrated,
+		"done", done,
+		"duration", time.Since(start))
+
+	log := fmt.Sprintf("%d entities migrated to schema %d", progress.Migrated, progress.Schema)
+	if done {
+		log += ", migration done"
+	}
+	return &weave.DeliverResult{
+		Data: obj.Key(),
+		Log:  log,
+		Tags: bucketMigrationTags(progress, len(keys)),
+	}, nil
+}
+
+// bucketMigrationTags returns tags describing the progress of a bucket
+// migration. The name of the migrated bucket is tagged with the
+// "migration.bucket" key. The number of entities migrated by the last chunk,
+// the total number of migrated entities and whether the migration is done are
+// tagged with "migration.<bucket>.chunk", "migration.<bucket>.migrated" and
+// "migration.<bucket>.done" keys.
+func bucketMigrationTags(progress *BucketMigration, chunk int) []common.KVPair {
+	return weave.NewTags("migration").
+		String("bucket", progress.Bucket).
+		Int(progress.Bucket+".chunk", int64(chunk)).
+		Uint(progress.Bucket+".migrated", progress.Migrated).
+		Bool(progress.Bucket+".done", progress.Done).
+		KVPairs()
+}
+
+// validate returns the message, the bucket that it migrates and the current
+// progress of the migration. A migration that was done for a previous schema
+// version is started again from the beginning.
+func (h *migrateBucketHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*MigrateBucketMsg, Bucket, *BucketMigration, error) {
+	var msg MigrateBucketMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, Bucket{}, nil, errors.Wrap(err, "load msg")
+	}
+
+	conf := mustLoadConf(db)
+	if !h.auth.HasAddress(ctx, conf.Admin) {
+		return nil, Bucket{}, nil, errors.Wrap(errors.ErrUnauthorized, "admin signature required")
+	}
+
+	b, err := h.migrations.Bucket(msg.Bucket)
+	if err != nil {
+		return nil, Bucket{}, nil, err
+	}
+	ver, err := h.schema.CurrentSchema(db, b.packageName)
+	if err != nil {
+		return nil, Bucket{}, nil, errors.Wrap(err, "current schema version")
+	}
+
+	progress, err := h.progress.GetBucketMigration(db, msg.Bucket)
+	switch {
+	case errors.ErrNotFound.Is(err):
+		progress = &BucketMigration{
+			Metadata: &weave.Metadata{Schema: 1},
+			Bucket:   msg.Bucket,
+			Schema:   ver,
+		}
+	case err != nil:
+		return nil, Bucket{}, nil, errors.Wrap(err, "bucket migration")
+	case progress.Schema != ver:
+		progress.Schema = ver
+		progress.Cursor = nil
+		progress.Migrated = 0
+		progress.Done = false
+	case progress.Done:
+		return nil, Bucket{}, nil, errors.Wrapf(errors.ErrState, "bucket already migrated to schema %d", ver)
+	}
+	return &msg, b, progress, nil
+}
+
+// nextChunk returns at most limit keys of the entities stored in given bucket
+// that follow the cursor key. A nil cursor returns the keys of the first
+// entities. Returned flag is true if there are no more entities to migrate.
+func nextChunk(db weave.ReadOnlyKVStore, b Bucket, cursor []byte, limit int) ([][]byte, bool, error) {
+	prefix := b.DBKey(nil)
+	start := prefix
+	if cursor != nil {
+		// Appending a zero byte creates the smallest key that is
+		// greater than the cursor key.
+		start = append(b.DBKey(cursor), 0)
+	}
+	// All keys of this bucket are prefixed with the bucket name followed by
+	// a colon. Semicolon follows the colon in ASCII.
+	end := append(prefix[:len(prefix)-1:len(prefix)-1], ';')
+
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "cannot create iterator")
+	}
+	defer it.Release()
+
+	var keys [][]byte
+	for {
+		key, _, err := it.Next()
+		if err != nil {
+			if errors.ErrIteratorDone.Is(err) {
+				return keys, true, nil
+			}
+			return nil, false, errors.Wrap(err, "iterator")
+		}
+		if len(keys) == limit {
+			return keys, false, nil
+		}
+		keys = append(keys, append([]byte(nil), key[len(prefix):]...))
+	}
+}
+
 // SchemaRoutingHandler clubs together message handlers for a single type
 // message but different schema formats. Each handler is registered together
 // with the lowest schema version that it supports. For example
@@ -1,14 +1,19 @@
 package migration
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 func TestSchemaMigratingHandler(t *testing.T) {
@@ -242,3 +247,153 @@ var _ Migratable = (*MigratableMsg)(nil)
 func (m *MigratableMsg) GetMetadata() *weave.Metadata {
 	return m.Metadata
 }
+
+func TestMigrateBucket(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyModel)
+		msg.Cnt += 2
+		return msg.err
+	})
+	reg.MustRegister(3, &MyModel{}, NoModification)
+
+	b := NewBucket(thisPkgName, "mymodel", &MyModel{}).useRegister(reg)
+	reg.MustRegisterBucket(b)
+
+	db := store.MemStore()
+	MustInitPkg(db, "migration")
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+
+	admin := weavetest.NewCondition()
+	assert.Nil(t, gconf.Save(db, "migration", &Configuration{Admin: admin.Address()}))
+
+	const entities = 2*migrateBucketChunkSize + 10
+	for i := 0; i < entities; i++ {
+		obj := orm.NewSimpleObj([]byte(fmt.Sprintf("%04d", i)), &MyModel{
+			Metadata: &weave.Metadata{Schema: 1},
+			Cnt:      i,
+		})
+		assert.Nil(t, b.Save(db, obj))
+	}
+
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+
+	handler := &migrateBucketHandler{
+		schema:     NewSchemaBucket(),
+		progress:   NewBucketMigrationBucket(),
+		auth:       &weavetest.Auth{Signer: admin},
+		migrations: reg,
+	}
+	var tags []common.KVPair
+	migrate := func(bucketName string) error {
+		ctx := context.Background()
+		tx := &weavetest.Tx{Msg: &MigrateBucketMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			Bucket:   bucketName,
+		}}
+		if _, err := handler.Check(ctx, db, tx); err != nil {
+			return err
+		}
+		res, err := handler.Deliver(ctx, db, tx)
+		if err == nil {
+			tags = res.Tags
+		}
+		return err
+	}
+	assertProgress := func(t testing.TB, schema uint32, migrated uint64, done bool) {
+		t.Helper()
+		p, err := NewBucketMigrationBucket().GetBucketMigration(db, "mymodel")
+		assert.Nil(t, err)
+		if p.Schema != schema || p.Migrated != migrated || p.Done != done {
+			t.Fatalf("unexpected progress: %+v", p)
+		}
+	}
+
+	if err := migrate("unknown"); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error for an unknown bucket, got %+v", err)
+	}
+
+	assert.Nil(t, migrate("mymodel"))
+	assertProgress(t, 2, migrateBucketChunkSize, false)
+	assert.Nil(t, migrate("mymodel"))
+	assertProgress(t, 2, 2*migrateBucketChunkSize, false)
+	assert.Nil(t, migrate("mymodel"))
+	assertProgress(t, 2, entities, true)
+	assert.Equal(t, tags, []common.KVPair{
+		{Key: []byte("migration.bucket"), Value: []byte("mymodel")},
+		{Key: []byte("migration.mymodel.chunk"), Value: []byte("10")},
+		{Key: []byte("migration.mymodel.migrated"), Value: []byte(fmt.Sprint(entities))},
+		{Key: []byte("migration.mymodel.done"), Value: []byte("true")},
+	})
+
+	if err := migrate("mymodel"); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error for a migrated bucket, got %+v", err)
+	}
+
+	// All entities must be stored using the current schema.
+	raw := orm.NewBucket("mymodel", &MyModel{})
+	for i := 0; i < entities; i++ {
+		obj, err := raw.Get(db, []byte(fmt.Sprintf("%04d", i)))
+		assert.Nil(t, err)
+		if m := obj.Value().(*MyModel); m.Metadata.Schema != 2 || m.Cnt != i+2 {
+			t.Fatalf("unexpected stored model: %#v", m)
+		}
+	}
+
+	// Upgrading the schema starts the migration again.
+	ensureSchemaVersion(t, db, thisPkgName, 3)
+	assert.Nil(t, migrate("mymodel"))
+	assertProgress(t, 3, migrateBucketChunkSize, false)
+
+	handler.auth = &weavetest.Auth{Signer: weavetest.NewCondition()}
+	if err := migrate("mymodel"); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+}
+
+func TestDowngradeSchema(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+
+	admin := weavetest.NewCondition()
+	assert.Nil(t, gconf.Save(db, "migration", &Configuration{Admin: admin.Address()}))
+
+	handler := &downgradeSchemaHandler{
+		bucket: NewSchemaBucket(),
+		auth:   &weavetest.Auth{Signer: admin},
+	}
+	downgrade := func() error {
+		tx := &weavetest.Tx{Msg: &DowngradeSchemaMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			Pkg:      thisPkgName,
+		}}
+		if _, err := handler.Check(nil, db, tx); err != nil {
+			return err
+		}
+		_, err := handler.Deliver(nil, db, tx)
+		return err
+	}
+
+	if err := downgrade(); !errors.ErrState.Is(err) {
+		t.Fatalf("want state error when downgrading the first version, got %+v", err)
+	}
+
+	ensureSchemaVersion(t, db, thisPkgName, 3)
+	assert.Nil(t, downgrade())
+	ver, err := NewSchemaBucket().CurrentSchema(db, thisPkgName)
+	assert.Nil(t, err)
+	assert.Equal(t, ver, uint32(2))
+
+	// Downgraded version can be upgraded again.
+	ensureSchemaVersion(t, db, thisPkgName, 3)
+
+	handler.auth = &weavetest.Auth{Signer: weavetest.NewCondition()}
+	if err := downgrade(); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("want unauthorized error, got %+v", err)
+	}
+}
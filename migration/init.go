@@ -8,6 +8,11 @@ import (
 
 // Initializer fulfils the InitStater interface to load data from
 // the genesis file
+//
+// This initializer must be registered before initializers of all other
+// extensions. Once schema versions are initialized, entities declared in the
+// genesis using an older schema version are upgraded to the current schema
+// version when saved by a schema versioned bucket.
 type Initializer struct{}
 
 var _ weave.Initializer = Initializer{}
@@ -60,3 +65,34 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 
 	return nil
 }
+
+// InitConfig loads the configuration of given package from the genesis and
+// stores it in the database, the same as gconf.InitConfig does. The difference
+// is that the configuration declared in the genesis can use an older schema
+// version. Before being stored, it is upgraded to the current schema version
+// of the package.
+//
+// Configuration must implement Migratable interface.
+func InitConfig(db weave.KVStore, opts weave.Options, pkg string, conf gconf.Configuration) error {
+	return initConfig(reg, db, opts, pkg, conf)
+}
+
+func initConfig(r *register, db weave.KVStore, opts weave.Options, pkg string, conf gconf.Configuration) error {
+	var confOptions weave.Options
+	if err := opts.ReadOptions("conf", &confOptions); err != nil {
+		return errors.Wrap(err, "read conf")
+	}
+	if confOptions[pkg] == nil {
+		return errors.Wrapf(errors.ErrNotFound, "no configuration in genesis for %q package", pkg)
+	}
+	if err := confOptions.ReadOptions(pkg, conf); err != nil {
+		return errors.Wrapf(err, "read configuration for %s", pkg)
+	}
+	if err := migrate(r, NewSchemaBucket(), pkg, db, conf); err != nil {
+		return errors.Wrapf(err, "migrate configuration for %s", pkg)
+	}
+	if err := gconf.Save(db, pkg, conf); err != nil {
+		return errors.Wrapf(err, "save configuration for %s", pkg)
+	}
+	return nil
+}
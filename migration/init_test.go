@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/store"
 )
 
@@ -54,3 +56,48 @@ func TestGenesisInitializeSchemaVersions(t *testing.T) {
 		}
 	}
 }
+
+func TestGenesisInitConfigMigratesSchema(t *testing.T) {
+	const genesis = `
+	{
+		"conf": {
+			"testpkg": {
+				"metadata": {"schema": 1},
+				"cnt": 5
+			}
+		}
+	}
+	`
+
+	var opts weave.Options
+	if err := json.Unmarshal([]byte(genesis), &opts); err != nil {
+		t.Fatalf("cannot unmarshal genesis: %s", err)
+	}
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyModel)
+		msg.Cnt += 2
+		return msg.err
+	})
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, "testpkg", 2)
+
+	if err := initConfig(reg, db, opts, "testpkg", &MyModel{}); err != nil {
+		t.Fatalf("cannot initialize configuration: %s", err)
+	}
+
+	var conf MyModel
+	if err := gconf.Load(db, "testpkg", &conf); err != nil {
+		t.Fatalf("cannot load configuration: %s", err)
+	}
+	if conf.Metadata.Schema != 2 || conf.Cnt != 5+2 {
+		t.Fatalf("unexpected configuration: %#v", conf)
+	}
+
+	if err := initConfig(reg, db, opts, "unknown", &MyModel{}); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error for a missing configuration, got %+v", err)
+	}
+}
@@ -12,6 +12,7 @@ var _ orm.CloneableData = (*Schema)(nil)
 
 func init() {
 	MustRegister(1, &Schema{}, NoModification)
+	MustRegister(1, &BucketMigration{}, NoModification)
 }
 
 func (s *Schema) Validate() error {
@@ -145,7 +146,67 @@ func (b *SchemaBucket) validateNextSchema(db weave.KVStore, next *Schema) error
 	return nil
 }
 
-// RegisterQuery registers schema bucket for querying.
+var _ orm.CloneableData = (*BucketMigration)(nil)
+
+func (m *BucketMigration) Validate() error {
+	if err := m.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if m.Bucket == "" {
+		return errors.Wrap(errors.ErrModel, "bucket is required")
+	}
+	if m.Schema < 1 {
+		return errors.Wrap(errors.ErrModel, "schema must be greater than zero")
+	}
+	return nil
+}
+
+func (m *BucketMigration) Copy() orm.CloneableData {
+	return &BucketMigration{
+		Metadata: m.Metadata.Copy(),
+		Bucket:   m.Bucket,
+		Schema:   m.Schema,
+		Cursor:   append([]byte(nil), m.Cursor...),
+		Migrated: m.Migrated,
+		Done:     m.Done,
+	}
+}
+
+// BucketMigrationBucket stores the progress of bucket migrations. Each
+// migration is stored under the name of the migrated bucket.
+type BucketMigrationBucket struct {
+	orm.Bucket
+}
+
+func NewBucketMigrationBucket() *BucketMigrationBucket {
+	return &BucketMigrationBucket{
+		Bucket: NewBucket("migration", "bucketmig", &BucketMigration{}),
+	}
+}
+
+// GetBucketMigration returns the progress of migrating a bucket with given
+// name. It returns ErrNotFound if the migration of this bucket was never
+// started.
+func (b *BucketMigrationBucket) GetBucketMigration(db weave.ReadOnlyKVStore, bucketName string) (*BucketMigration, error) {
+	obj, err := b.Get(db, []byte(bucketName))
+	if err != nil {
+		return nil, errors.Wrap(err, "bucket get")
+	}
+	if obj == nil || obj.Value() == nil {
+		return nil, errors.Wrapf(errors.ErrNotFound, "no migration of bucket %q", bucketName)
+	}
+	m, ok := obj.Value().(*BucketMigration)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrModel, "invalid type: %T", obj.Value())
+	}
+	return m, nil
+}
+
+// RegisterQuery registers schema and bucket migration buckets for querying.
+// Current schema version of each package is available under the
+// "/migrations" path.
 func RegisterQuery(qr weave.QueryRouter) {
 	NewSchemaBucket().Register("schemas", qr)
+	qr.Register("/migrations", NewSchemaVersionQuery())
+	NewBucketMigrationBucket().Register("bucketmigrations", qr)
 }
@@ -17,3 +17,35 @@ func (msg *UpgradeSchemaMsg) Validate() error {
 func (UpgradeSchemaMsg) Path() string {
 	return "migration/upgrade_schema"
 }
+
+var _ weave.Msg = (*DowngradeSchemaMsg)(nil)
+
+func (msg *DowngradeSchemaMsg) Validate() error {
+	if err := msg.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if msg.Pkg == "" {
+		return errors.Wrap(errors.ErrEmpty, "pkg is required")
+	}
+	return nil
+}
+
+func (DowngradeSchemaMsg) Path() string {
+	return "migration/downgrade_schema"
+}
+
+var _ weave.Msg = (*MigrateBucketMsg)(nil)
+
+func (msg *MigrateBucketMsg) Validate() error {
+	if err := msg.Metadata.Validate(); err != nil {
+		return errors.Wrap(err, "metadata")
+	}
+	if msg.Bucket == "" {
+		return errors.Wrap(errors.ErrEmpty, "bucket is required")
+	}
+	return nil
+}
+
+func (MigrateBucketMsg) Path() string {
+	return "migration/migrate_bucket"
+}
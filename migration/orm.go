@@ -12,6 +12,12 @@ import (
 // enforce every model to contain schema version information and where needed
 // migrates objects on the fly, before returning to the user.
 //
+// Migration is lazy. An entity is upgraded to the current schema version
+// every time it is read, but the database is not modified. The migrated
+// entity is written back only when it is saved. This allows to upgrade the
+// schema without migrating all stored entities at once, which for big
+// buckets could be too expensive to be done within a single block.
+//
 // This bucket does not migrate on the fly the data returned by the queries.
 // Both Register and Query methods are using orm.Bucket implementation to
 // return data as stored in the database. This is important for the proof to
@@ -19,6 +25,8 @@ import (
 type Bucket struct {
 	orm.Bucket
 	packageName string
+	bucketName  string
+	model       reflect.Type
 	schema      *SchemaBucket
 	migrations  *register
 }
@@ -33,6 +41,8 @@ func NewBucket(packageName string, bucketName string, model orm.Model) Bucket {
 	return Bucket{
 		Bucket:      orm.NewBucket(bucketName, model),
 		packageName: packageName,
+		bucketName:  bucketName,
+		model:       reflect.TypeOf(model),
 		schema:      NewSchemaBucket(),
 		migrations:  reg,
 	}
@@ -57,6 +67,34 @@ func (svb Bucket) Get(db weave.ReadOnlyKVStore, key []byte) (orm.Object, error)
 	return obj, nil
 }
 
+func (svb Bucket) GetIndexed(db weave.ReadOnlyKVStore, name string, key []byte) ([]orm.Object, error) {
+	objs, err := svb.Bucket.GetIndexed(db, name, key)
+	if err != nil {
+		return nil, err
+	}
+	return svb.migrateAll(db, objs)
+}
+
+func (svb Bucket) GetIndexedLike(db weave.ReadOnlyKVStore, name string, pattern orm.Object) ([]orm.Object, error) {
+	objs, err := svb.Bucket.GetIndexedLike(db, name, pattern)
+	if err != nil {
+		return nil, err
+	}
+	return svb.migrateAll(db, objs)
+}
+
+func (svb Bucket) migrateAll(db weave.ReadOnlyKVStore, objs []orm.Object) ([]orm.Object, error) {
+	for _, obj := range objs {
+		if obj == nil {
+			continue
+		}
+		if err := svb.migrate(db, obj); err != nil {
+			return nil, errors.Wrap(err, "migrate")
+		}
+	}
+	return objs, nil
+}
+
 func (svb Bucket) Save(db weave.KVStore, obj orm.Object) error {
 	if err := svb.migrate(db, obj); err != nil {
 		return errors.Wrap(err, "migrate")
@@ -196,8 +234,14 @@ func migrate(
 		return nil
 	}
 
+	// A model with a schema higher than the current one is stored only if
+	// the schema version was downgraded. Such model can be used only if
+	// a reverse migration was registered.
 	if meta.Schema > currSchemaVer {
-		return errors.Wrapf(errors.ErrSchema, "model schema higher than %d", currSchemaVer)
+		if err := migrations.Downgrade(db, m, currSchemaVer); err != nil {
+			return errors.Wrapf(err, "model schema higher than %d", currSchemaVer)
+		}
+		return nil
 	}
 
 	// Migration is applied in place, directly modifying the instance.
@@ -212,8 +256,8 @@ func migrate(
 //
 // Returns an error if the passed value is not Migratable,
 // not registered with migrations, missing Metadata, has a Schema
-// higher than currentSchema, if the final migrated value is invalid,
-// or other such conditions.
+// higher than currentSchema without a reverse migration registered, if the
+// final migrated value is invalid, or other such conditions.
 //
 // If this returns no error, you can safely use the contents of value in
 // code working with the currentSchema.
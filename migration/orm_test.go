@@ -89,6 +89,117 @@ func TestSchemaVersionedBucket(t *testing.T) {
 	assert.Nil(t, b.Save(db, obj12))
 }
 
+func TestSchemaVersionedBucketLazyMigration(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyModel)
+		msg.Cnt += 2
+		return msg.err
+	})
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 1)
+
+	indexAll := func(orm.Object) ([]byte, error) { return []byte("all"), nil }
+	b := NewBucket(thisPkgName, "mymodel", &MyModel{}).
+		useRegister(reg).
+		WithIndex("all", indexAll, false)
+
+	obj := orm.NewSimpleObj([]byte("a"), &MyModel{
+		Metadata: &weave.Metadata{Schema: 1},
+		Cnt:      5,
+	})
+	assert.Nil(t, b.Save(db, obj))
+
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+
+	assertMigrated := func(t testing.TB, objs []orm.Object) {
+		t.Helper()
+		if len(objs) != 1 {
+			t.Fatalf("want one object, got %d", len(objs))
+		}
+		if m := objs[0].Value().(*MyModel); m.Metadata.Schema != 2 || m.Cnt != 5+2 {
+			t.Fatalf("unexpected result model: %#v", m)
+		}
+	}
+
+	objs, err := b.GetIndexed(db, "all", []byte("all"))
+	assert.Nil(t, err)
+	assertMigrated(t, objs)
+
+	objs, err = b.GetIndexedLike(db, "all", obj)
+	assert.Nil(t, err)
+	assertMigrated(t, objs)
+
+	// Reading must not modify the database. The stored entity is upgraded
+	// only when saved.
+	raw := orm.NewBucket("mymodel", &MyModel{})
+	assertStored := func(t testing.TB, schema uint32, cnt int) {
+		t.Helper()
+		obj, err := raw.Get(db, []byte("a"))
+		assert.Nil(t, err)
+		if m := obj.Value().(*MyModel); m.Metadata.Schema != schema || m.Cnt != cnt {
+			t.Fatalf("unexpected stored model: %#v", m)
+		}
+	}
+	assertStored(t, 1, 5)
+
+	stored, err := b.Get(db, []byte("a"))
+	assert.Nil(t, err)
+	assert.Nil(t, b.Save(db, stored))
+	assertStored(t, 2, 5+2)
+
+	// An already migrated entity must not be migrated again.
+	objs, err = b.GetIndexed(db, "all", []byte("all"))
+	assert.Nil(t, err)
+	assertMigrated(t, objs)
+}
+
+func TestSchemaVersionedBucketDowngrade(t *testing.T) {
+	const thisPkgName = "testpkg"
+
+	reg := newRegister()
+	reg.MustRegister(1, &MyModel{}, NoModification)
+	reg.MustRegister(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyModel)
+		msg.Cnt += 2
+		return msg.err
+	})
+
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, thisPkgName, 2)
+
+	b := &MyModelBucket{
+		Bucket: NewBucket(thisPkgName, "mymodel", &MyModel{}).useRegister(reg),
+	}
+	obj := orm.NewSimpleObj([]byte("a"), &MyModel{
+		Metadata: &weave.Metadata{Schema: 1},
+		Cnt:      5,
+	})
+	assert.Nil(t, b.Save(db, obj))
+
+	// Roll back the second schema version.
+	assert.Nil(t, NewSchemaBucket().Delete(db, schemaID(thisPkgName, 2)))
+
+	if _, err := b.GetMyModel(db, "a"); !errors.ErrSchema.Is(err) {
+		t.Fatalf("want schema error without a downgrade registered, got %+v", err)
+	}
+
+	reg.MustRegisterDowngrade(2, &MyModel{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyModel)
+		msg.Cnt -= 2
+		return msg.err
+	})
+	if m, err := b.GetMyModel(db, "a"); err != nil {
+		t.Fatalf("cannot get model: %s", err)
+	} else if m.Metadata.Schema != 1 || m.Cnt != 5 {
+		t.Fatalf("unexpected result model: %#v", m)
+	}
+}
+
 type MyModelBucket struct {
 	Bucket
 }
@@ -0,0 +1,90 @@
+package migration
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// SchemaVersionQuery returns the current schema version of packages. For
+// each package only the schema entity with the highest version is returned.
+// Results are ordered by the package name.
+//
+// Use the key query with a package name to get the current schema version of
+// that package. Use the prefix query to get the current schema versions of all
+// packages with a name starting with given prefix. An empty prefix matches
+// all packages.
+type SchemaVersionQuery struct {
+	bucket *SchemaBucket
+}
+
+var _ weave.QueryHandler = SchemaVersionQuery{}
+
+// NewSchemaVersionQuery returns a query handler that lists the current schema
+// version of packages.
+func NewSchemaVersionQuery() SchemaVersionQuery {
+	return SchemaVersionQuery{bucket: NewSchemaBucket()}
+}
+
+// Query handles queries from the QueryRouter.
+func (q SchemaVersionQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	var match func(pkg string) bool
+	switch mod {
+	case weave.KeyQueryMod:
+		match = func(pkg string) bool { return pkg == string(data) }
+	case weave.PrefixQueryMod:
+		match = func(pkg string) bool { return strings.HasPrefix(pkg, string(data)) }
+	default:
+		return nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
+	}
+
+	prefix := q.bucket.DBKey(nil)
+	it, err := db.Iterator(prefix, append(bytes.TrimSuffix(prefix, []byte(":")), ';'))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create iterator")
+	}
+	defer it.Release()
+
+	type current struct {
+		version uint32
+		model   weave.Model
+	}
+	latest := make(map[string]current)
+	for {
+		key, value, err := it.Next()
+		if err != nil {
+			if errors.ErrIteratorDone.Is(err) {
+				break
+			}
+			return nil, errors.Wrap(err, "iterator")
+		}
+		var s Schema
+		if err := s.Unmarshal(value); err != nil {
+			return nil, errors.Wrapf(errors.ErrState, "cannot unmarshal %X schema: %s", key, err)
+		}
+		if !match(s.Pkg) {
+			continue
+		}
+		if c, ok := latest[s.Pkg]; ok && c.version > s.Version {
+			continue
+		}
+		latest[s.Pkg] = current{
+			version: s.Version,
+			model:   weave.Model{Key: key, Value: value},
+		}
+	}
+
+	pkgs := make([]string, 0, len(latest))
+	for pkg := range latest {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Strings(pkgs)
+	res := make([]weave.Model, len(pkgs))
+	for i, pkg := range pkgs {
+		res[i] = latest[pkg].model
+	}
+	return res, nil
+}
@@ -0,0 +1,67 @@
+package migration
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestSchemaVersionQuery(t *testing.T) {
+	db := store.MemStore()
+	ensureSchemaVersion(t, db, "cash", 3)
+	ensureSchemaVersion(t, db, "cashx", 1)
+	ensureSchemaVersion(t, db, "gov", 2)
+
+	cases := map[string]struct {
+		mod     string
+		data    string
+		want    map[string]uint32
+		wantErr *errors.Error
+	}{
+		"single package": {
+			mod:  weave.KeyQueryMod,
+			data: "cash",
+			want: map[string]uint32{"cash": 3},
+		},
+		"unknown package": {
+			mod:  weave.KeyQueryMod,
+			data: "unknown",
+			want: map[string]uint32{},
+		},
+		"packages with prefix": {
+			mod:  weave.PrefixQueryMod,
+			data: "cash",
+			want: map[string]uint32{"cash": 3, "cashx": 1},
+		},
+		"all packages": {
+			mod:  weave.PrefixQueryMod,
+			data: "",
+			want: map[string]uint32{"cash": 3, "cashx": 1, "gov": 2},
+		},
+		"unknown mod": {
+			mod:     "foo",
+			wantErr: errors.ErrInput,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			models, err := NewSchemaVersionQuery().Query(db, tc.mod, []byte(tc.data))
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			got := make(map[string]uint32)
+			for _, m := range models {
+				var s Schema
+				assert.Nil(t, s.Unmarshal(m.Value))
+				got[s.Pkg] = s.Version
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 )
 
 type Migratable interface {
@@ -21,6 +22,10 @@ type Migratable interface {
 // Migrator is a function that migrates in place an entity of a single type.
 type Migrator func(weave.ReadOnlyKVStore, Migratable) error
 
+// Validator is a function that validates an entity of a single type right
+// after it was migrated to a schema version. It must not modify the entity.
+type Validator func(weave.ReadOnlyKVStore, Migratable) error
+
 // NoModification is a migration function that migrates data that requires no
 // change. It should be used to register migrations that do not require any
 // modifications.
@@ -37,12 +42,18 @@ func RefuseMigration(weave.ReadOnlyKVStore, Migratable) error {
 
 func newRegister() *register {
 	return &register{
-		migrateTo: make(map[payloadVersion]Migrator),
+		migrateTo:     make(map[payloadVersion]Migrator),
+		downgradeFrom: make(map[payloadVersion]Migrator),
+		validators:    make(map[payloadVersion]Validator),
+		buckets:       make(map[string]Bucket),
 	}
 }
 
 type register struct {
-	migrateTo map[payloadVersion]Migrator
+	migrateTo     map[payloadVersion]Migrator
+	downgradeFrom map[payloadVersion]Migrator
+	validators    map[payloadVersion]Validator
+	buckets       map[string]Bucket
 }
 
 // payloadVersion references a message or a model at a given schema version.
@@ -86,6 +97,95 @@ func (r *register) Register(migrationTo uint32, msgOrModel Migratable, fn Migrat
 	return nil
 }
 
+func (r *register) MustRegisterValidator(migrationTo uint32, msgOrModel Migratable, fn Validator) {
+	if err := r.RegisterValidator(migrationTo, msgOrModel, fn); err != nil {
+		panic(err)
+	}
+}
+
+func (r *register) RegisterValidator(migrationTo uint32, msgOrModel Migratable, fn Validator) error {
+	pv := payloadVersion{
+		version: migrationTo,
+		payload: reflect.TypeOf(msgOrModel),
+	}
+	if _, ok := r.migrateTo[pv]; !ok {
+		return errors.Wrapf(errors.ErrInput, "missing %d version migration", migrationTo)
+	}
+	if _, ok := r.validators[pv]; ok {
+		return errors.Wrapf(errors.ErrDuplicate,
+			"validator already registered: %s.%s:%d", pv.payload.PkgPath(), pv.payload.Name(), migrationTo)
+	}
+	r.validators[pv] = fn
+	return nil
+}
+
+func (r *register) MustRegisterDowngrade(migrationFrom uint32, msgOrModel Migratable, fn Migrator) {
+	if err := r.RegisterDowngrade(migrationFrom, msgOrModel, fn); err != nil {
+		panic(err)
+	}
+}
+
+func (r *register) RegisterDowngrade(migrationFrom uint32, msgOrModel Migratable, fn Migrator) error {
+	if migrationFrom < 2 {
+		return errors.Wrap(errors.ErrInput, "minimal allowed version is 2")
+	}
+
+	pv := payloadVersion{
+		version: migrationFrom,
+		payload: reflect.TypeOf(msgOrModel),
+	}
+	if _, ok := r.migrateTo[pv]; !ok {
+		return errors.Wrapf(errors.ErrInput, "missing %d version migration", migrationFrom)
+	}
+	if _, ok := r.downgradeFrom[pv]; ok {
+		return errors.Wrapf(errors.ErrDuplicate,
+			"downgrade already registered: %s.%s:%d", pv.payload.PkgPath(), pv.payload.Name(), migrationFrom)
+	}
+	r.downgradeFrom[pv] = fn
+	return nil
+}
+
+func (r *register) MustRegisterBucket(b orm.Bucket) {
+	if err := r.RegisterBucket(b); err != nil {
+		panic(err)
+	}
+}
+
+func (r *register) RegisterBucket(b orm.Bucket) error {
+	svb, ok := b.(Bucket)
+	if !ok {
+		return errors.Wrapf(errors.ErrType, "%T is not a schema versioned bucket", b)
+	}
+	if _, ok := r.buckets[svb.bucketName]; ok {
+		return errors.Wrapf(errors.ErrDuplicate, "bucket %q already registered", svb.bucketName)
+	}
+	r.buckets[svb.bucketName] = svb
+	return nil
+}
+
+// Latest returns the highest schema version that a migration for given
+// message or model type was registered for. It returns zero if no migration
+// was registered.
+func (r *register) Latest(tp reflect.Type) uint32 {
+	var ver uint32
+	for {
+		if _, ok := r.migrateTo[payloadVersion{payload: tp, version: ver + 1}]; !ok {
+			return ver
+		}
+		ver++
+	}
+}
+
+// Bucket returns a bucket registered under given name. It returns ErrNotFound
+// if no such bucket was registered.
+func (r *register) Bucket(name string) (Bucket, error) {
+	b, ok := r.buckets[name]
+	if !ok {
+		return Bucket{}, errors.Wrapf(errors.ErrNotFound, "bucket %q not registered", name)
+	}
+	return b, nil
+}
+
 // Apply updates the object by applying all missing data migrations. Even a no
 // modification migration is updating the metadata to point to the latest data
 // format version.
@@ -110,7 +210,8 @@ func (r *register) Apply(db weave.ReadOnlyKVStore, m Migratable, migrateTo uint3
 
 	tp := reflect.TypeOf(m)
 	for v := meta.Schema + 1; v <= migrateTo; v++ {
-		migrate, ok := r.migrateTo[payloadVersion{payload: tp, version: v}]
+		pv := payloadVersion{payload: tp, version: v}
+		migrate, ok := r.migrateTo[pv]
 		if !ok {
 			return errors.Wrapf(errors.ErrSchema, "migration to version %d missing", v)
 		}
@@ -118,6 +219,47 @@ func (r *register) Apply(db weave.ReadOnlyKVStore, m Migratable, migrateTo uint3
 			return errors.Wrapf(err, "migration to version %d", v)
 		}
 		meta.Schema = v
+		if validate, ok := r.validators[pv]; ok {
+			if err := validate(db, m); err != nil {
+				return errors.Wrapf(err, "validation of version %d", v)
+			}
+		}
+	}
+
+	if err := m.Validate(); err != nil {
+		return errors.Wrap(err, "validation")
+	}
+	return nil
+}
+
+// Downgrade updates the object by applying all reverse data migrations
+// necessary to bring it down to the given schema version. Objects with a
+// schema version not higher than the given one are not modified.
+//
+// Because changes are applied directly on the passed object (in place), even
+// if this function fails some of the reverse migrations might be applied.
+//
+// Validation method is called only on the final version of the object.
+func (r *register) Downgrade(db weave.ReadOnlyKVStore, m Migratable, downgradeTo uint32) error {
+	if downgradeTo < 1 {
+		return errors.Wrap(errors.ErrInput, "minimal allowed version is 1")
+	}
+
+	meta := m.GetMetadata()
+	if err := meta.Validate(); err != nil {
+		return err
+	}
+
+	tp := reflect.TypeOf(m)
+	for v := meta.Schema; v > downgradeTo; v-- {
+		downgrade, ok := r.downgradeFrom[payloadVersion{payload: tp, version: v}]
+		if !ok {
+			return errors.Wrapf(errors.ErrSchema, "downgrade from version %d missing", v)
+		}
+		if err := downgrade(db, m); err != nil {
+			return errors.Wrapf(err, "downgrade from version %d", v)
+		}
+		meta.Schema = v - 1
 	}
 
 	if err := m.Validate(); err != nil {
@@ -141,6 +283,40 @@ func MustRegister(migrationTo uint32, msgOrModel Migratable, fn Migrator) {
 	reg.MustRegister(migrationTo, msgOrModel, fn)
 }
 
+// MustRegisterValidator registers a validation function for a given message
+// or model. Validation function is called right after the entity was migrated
+// to migrationTo version. It is not called for entities created using that
+// version. Validator can be registered only for a version that has a
+// migration registered.
+//
+// Use validators to ensure that a migration produced expected result, for
+// example that a migrated value is within the range accepted by the new
+// schema version.
+func MustRegisterValidator(migrationTo uint32, msgOrModel Migratable, fn Validator) {
+	reg.MustRegisterValidator(migrationTo, msgOrModel, fn)
+}
+
+// MustRegisterDowngrade registers a reverse migration function for a given
+// message or model. Reverse migration function will be called when
+// downgrading data from migrationFrom version to a version one less.
+// Downgrade can be registered only for a version that has a migration
+// registered. Minimal allowed migrationFrom version is 2.
+//
+// Reverse migrations allow to roll back a schema version upgrade using
+// DowngradeSchemaMsg, for example when an upgrade shipped with a faulty
+// migration.
+func MustRegisterDowngrade(migrationFrom uint32, msgOrModel Migratable, fn Migrator) {
+	reg.MustRegisterDowngrade(migrationFrom, msgOrModel, fn)
+}
+
+// MustRegisterBucket registers a schema versioned bucket so that all its
+// entities can be migrated to the current schema version using
+// MigrateBucketMsg. Given bucket must be created using NewBucket function.
+// Bucket name must be unique.
+func MustRegisterBucket(b orm.Bucket) {
+	reg.MustRegisterBucket(b)
+}
+
 // Apply updates the object by applying all missing data migrations. Even a no
 // modification migration is updating the metadata to point to the latest data
 // format version.
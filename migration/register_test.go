@@ -1,6 +1,7 @@
 package migration
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/iov-one/weave"
@@ -86,3 +87,110 @@ func TestMigrateUnknownVersion(t *testing.T) {
 	}
 	assert.Equal(t, mymsg.Metadata.Schema, uint32(3))
 }
+
+func TestRegisterDowngrade(t *testing.T) {
+	reg := newRegister()
+	reg.MustRegister(1, &MyMsg{}, NoModification)
+	reg.MustRegister(2, &MyMsg{}, NoModification)
+
+	if err := reg.RegisterDowngrade(1, &MyMsg{}, NoModification); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error when downgrading from the first version: %s", err)
+	}
+	if err := reg.RegisterDowngrade(3, &MyMsg{}, NoModification); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error when missing migration: %s", err)
+	}
+	reg.MustRegisterDowngrade(2, &MyMsg{}, NoModification)
+	if err := reg.RegisterDowngrade(2, &MyMsg{}, NoModification); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("unexpected error when registering a duplicate: %s", err)
+	}
+}
+
+func TestDowngrade(t *testing.T) {
+	reg := newRegister()
+	reg.MustRegister(1, &MyMsg{}, NoModification)
+	reg.MustRegister(2, &MyMsg{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyMsg)
+		msg.Content += "to2"
+		return nil
+	})
+	reg.MustRegister(3, &MyMsg{}, NoModification)
+	reg.MustRegisterDowngrade(2, &MyMsg{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyMsg)
+		msg.Content = strings.TrimSuffix(msg.Content, "to2")
+		return nil
+	})
+
+	mymsg := &MyMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Content:  "init ",
+	}
+	assert.Nil(t, reg.Apply(nil, mymsg, 3))
+	assert.Equal(t, mymsg.Content, "init to2")
+
+	// Downgrade from the third version is not registered.
+	if err := reg.Downgrade(nil, mymsg, 1); !errors.ErrSchema.Is(err) {
+		t.Fatalf("unexpected downgrade failure: %s", err)
+	}
+	assert.Equal(t, mymsg.Metadata.Schema, uint32(3))
+
+	reg.MustRegisterDowngrade(3, &MyMsg{}, NoModification)
+	assert.Nil(t, reg.Downgrade(nil, mymsg, 1))
+	assert.Equal(t, mymsg.Metadata.Schema, uint32(1))
+	assert.Equal(t, mymsg.Content, "init ")
+
+	// Downgrading to a higher version does not modify the message.
+	assert.Nil(t, reg.Downgrade(nil, mymsg, 2))
+	assert.Equal(t, mymsg.Metadata.Schema, uint32(1))
+}
+
+func TestValidator(t *testing.T) {
+	reg := newRegister()
+	reg.MustRegister(1, &MyMsg{}, NoModification)
+	reg.MustRegister(2, &MyMsg{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyMsg)
+		msg.Content += "to2"
+		return nil
+	})
+	reg.MustRegister(3, &MyMsg{}, NoModification)
+
+	if err := reg.RegisterValidator(4, &MyMsg{}, nil); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error when missing migration: %s", err)
+	}
+
+	var validated []uint32
+	reg.MustRegisterValidator(2, &MyMsg{}, func(db weave.ReadOnlyKVStore, m Migratable) error {
+		msg := m.(*MyMsg)
+		validated = append(validated, msg.Metadata.Schema)
+		if !strings.HasSuffix(msg.Content, "to2") {
+			return errors.Wrap(errors.ErrState, "not migrated")
+		}
+		if msg.Content == "invalid to2" {
+			return errors.Wrap(errors.ErrInput, "invalid content")
+		}
+		return nil
+	})
+	if err := reg.RegisterValidator(2, &MyMsg{}, nil); !errors.ErrDuplicate.Is(err) {
+		t.Fatalf("unexpected error when registering a duplicate: %s", err)
+	}
+
+	mymsg := &MyMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Content:  "init ",
+	}
+	assert.Nil(t, reg.Apply(nil, mymsg, 3))
+	assert.Equal(t, validated, []uint32{2})
+
+	// Validator is not called for entities that are not migrated to its
+	// version.
+	assert.Nil(t, reg.Apply(nil, &MyMsg{Metadata: &weave.Metadata{Schema: 2}}, 3))
+	assert.Equal(t, validated, []uint32{2})
+
+	invalid := &MyMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Content:  "invalid ",
+	}
+	if err := reg.Apply(nil, invalid, 3); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected validation failure: %s", err)
+	}
+	assert.Equal(t, invalid.Metadata.Schema, uint32(2))
+}
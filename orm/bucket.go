@@ -64,6 +64,8 @@ type bucket struct {
 }
 
 var _ Bucket = (*bucket)(nil)
+var _ weave.ProvableQueryHandler = (*bucket)(nil)
+var _ weave.ProvableRangeQueryHandler = (*bucket)(nil)
 
 type namedIndex struct {
 	Index
@@ -131,11 +133,32 @@ func (b bucket) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weav
 	case weave.PrefixQueryMod:
 		prefix := b.DBKey(data)
 		return queryPrefix(db, prefix)
+	case weave.ReversePrefixQueryMod:
+		prefix := b.DBKey(data)
+		return queryReversePrefix(db, prefix)
 	default:
 		return nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
 	}
 }
 
+// QueryKey returns the database key read by a key query. Prefix queries
+// read many keys and are proven using QueryRange.
+func (b bucket) QueryKey(mod string, data []byte) ([]byte, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrapf(errors.ErrInput, "cannot prove %q query", mod)
+	}
+	return b.DBKey(data), nil
+}
+
+// QueryRange returns the range of database keys read by a prefix query.
+func (b bucket) QueryRange(mod string, data []byte) ([]byte, []byte, error) {
+	if mod != weave.PrefixQueryMod {
+		return nil, nil, errors.Wrapf(errors.ErrInput, "cannot prove %q query as a range", mod)
+	}
+	start, end := prefixRange(b.DBKey(data))
+	return start, end, nil
+}
+
 // DBKey is the full key we store in the db, including prefix
 // We copy into a new array rather than use append, as we don't
 // want consecutive calls to overwrite the same byte array.
@@ -384,6 +384,17 @@ func TestBucketQuery(t *testing.T) {
 			mod:      "prefix",
 			expected: []weave.Model{dba, dbb, dbc},
 		},
+		"reverse prefix query - multi hit": {
+			path:     bPath,
+			mod:      "reverse-prefix",
+			data:     []byte("a"),
+			expected: []weave.Model{dbb, dba},
+		},
+		"reverse prefix query - all": {
+			path:     bPath,
+			mod:      "reverse-prefix",
+			expected: []weave.Model{dbc, dbb, dba},
+		},
 		"simple index - miss": {
 			path: iPath,
 			data: e77,
@@ -408,6 +419,11 @@ func TestBucketQuery(t *testing.T) {
 			mod:      "prefix",
 			expected: []weave.Model{dbc, dba, dbb},
 		},
+		"reverse prefix index - all (in reverse order of index, last byte)": {
+			path:     iPath,
+			mod:      "reverse-prefix",
+			expected: []weave.Model{dbb, dba, dbc},
+		},
 		"unique index - hit": {
 			path:     uiPath,
 			data:     encodeSequence(256 + 5),
@@ -418,6 +434,11 @@ func TestBucketQuery(t *testing.T) {
 			mod:      "prefix",
 			expected: []weave.Model{dbc, dba, dbb},
 		},
+		"unique reverse prefix index - all (in reverse order of index, full count)": {
+			path:     uiPath,
+			mod:      "reverse-prefix",
+			expected: []weave.Model{dbb, dba, dbc},
+		},
 	}
 
 	for testName, tc := range cases {
@@ -183,6 +183,35 @@ func (i Index) GetPrefix(db weave.ReadOnlyKVStore, prefix []byte) ([][]byte, err
 	if err != nil {
 		return nil, err
 	}
+	return i.consumeRefs(itr)
+}
+
+// GetPrefixReverse works like GetPrefix but returns the references in
+// reverse order, starting with the last index value.
+func (i Index) GetPrefixReverse(db weave.ReadOnlyKVStore, prefix []byte) ([][]byte, error) {
+	dbPrefix := i.IndexKey(prefix)
+	itr, err := db.ReverseIterator(prefixRange(dbPrefix))
+	if err != nil {
+		return nil, err
+	}
+	refs, err := i.consumeRefs(itr)
+	if err != nil {
+		return nil, err
+	}
+	// References of a single index value are stored in ascending order.
+	// Reverse them all, so that the result is ordered like the index
+	// values.
+	if !i.unique {
+		for j, k := 0, len(refs)-1; j < k; j, k = j+1, k-1 {
+			refs[j], refs[k] = refs[k], refs[j]
+		}
+	}
+	return refs, nil
+}
+
+// consumeRefs returns all references stored under the index values read by
+// the iterator.
+func (i Index) consumeRefs(itr weave.Iterator) ([][]byte, error) {
 	defer itr.Release()
 
 	var data [][]byte
@@ -223,6 +252,12 @@ func (i Index) Query(db weave.ReadOnlyKVStore, mod string,
 			return nil, err
 		}
 		return i.loadRefs(db, refs)
+	case weave.ReversePrefixQueryMod:
+		refs, err := i.GetPrefixReverse(db, data)
+		if err != nil {
+			return nil, err
+		}
+		return i.loadRefs(db, refs)
 	default:
 		return nil, errors.Wrap(errors.ErrHuman, "not implemented: "+mod)
 	}
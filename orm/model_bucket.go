@@ -106,6 +106,16 @@ func WithIndex(name string, indexer Indexer, unique bool) ModelBucketOption {
 	}
 }
 
+// WithMultiKeyIndex configures the bucket to build an index with given name.
+// All entities stored in the bucket are indexed using all values returned by
+// the indexer function. If an index is unique, there can be only one entity
+// referenced per index value.
+func WithMultiKeyIndex(name string, indexer MultiKeyIndexer, unique bool) ModelBucketOption {
+	return func(mb *modelBucket) {
+		mb.b = mb.b.WithMultiKeyIndex(name, indexer, unique)
+	}
+}
+
 // WithIDSequence configures the bucket to use the given sequence instance for
 // generating ID.
 func WithIDSequence(s Sequence) ModelBucketOption {
@@ -64,3 +64,13 @@ func queryPrefix(db weave.ReadOnlyKVStore, prefix []byte) ([]weave.Model, error)
 	}
 	return consumeIterator(iter)
 }
+
+// queryReversePrefix works like queryPrefix but returns the models in
+// descending key order.
+func queryReversePrefix(db weave.ReadOnlyKVStore, prefix []byte) ([]weave.Model, error) {
+	iter, err := db.ReverseIterator(prefixRange(prefix))
+	if err != nil {
+		return nil, err
+	}
+	return consumeIterator(iter)
+}
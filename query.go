@@ -9,6 +9,9 @@ const (
 	KeyQueryMod = ""
 	// PrefixQueryMod means to query for anything with this prefix
 	PrefixQueryMod = "prefix"
+	// ReversePrefixQueryMod means to query for anything with this prefix,
+	// returning the results in descending key order
+	ReversePrefixQueryMod = "reverse-prefix"
 	// RangeQueryMod means to expect complex range query
 	// TODO: implement
 	RangeQueryMod = "range"
@@ -33,6 +36,28 @@ type QueryHandler interface {
 	Query(db ReadOnlyKVStore, mod string, data []byte) ([]Model, error)
 }
 
+// ProvableQueryHandler is a QueryHandler that can tell which database key is
+// read to answer a query. Such queries can be answered together with a merkle
+// proof of the presence or absence of that key.
+type ProvableQueryHandler interface {
+	QueryHandler
+	// QueryKey returns the database key that is read by the query. An
+	// error is returned if the query does not read a single key.
+	QueryKey(mod string, data []byte) ([]byte, error)
+}
+
+// ProvableRangeQueryHandler is a QueryHandler that can tell which range of
+// database keys is read to answer a query. Such queries can be answered
+// together with a merkle proof that the result contains all keys of that
+// range.
+type ProvableRangeQueryHandler interface {
+	QueryHandler
+	// QueryRange returns the range [start, end) of database keys that is
+	// read by the query. A nil end means that the range has no upper
+	// limit. An error is returned if the query does not read a range.
+	QueryRange(mod string, data []byte) (start, end []byte, err error)
+}
+
 // QueryRegister is a function that adds some handlers
 // to this router
 type QueryRegister func(QueryRouter)
@@ -1,5 +1,7 @@
 package weave
 
+import "github.com/tendermint/tendermint/crypto/merkle"
+
 //////////////////////////////////////////////////////////
 // Defines all public interfaces for interacting with stores
 //
@@ -19,7 +21,7 @@ type ReadOnlyKVStore interface {
 	Iterator(start, end []byte) (Iterator, error)
 
 	// ReverseIterator over a domain of keys in descending order. End is exclusive.
-	// Start must be greater than end, or the Iterator is invalid.
+	// Start must be less than end, or the Iterator is invalid.
 	// CONTRACT: No writes may happen within a domain while an iterator exists over it.
 	ReverseIterator(start, end []byte) (Iterator, error)
 }
@@ -125,24 +127,14 @@ type KVCacheWrap interface {
 // to disk. We modify it in batch by getting a CacheWrap()
 // and then Write(). Commit() will persist all changes to disk
 //
-// This store should also be able to return merkle proofs for
-// any committed state.
+// A store that can return merkle proofs of the committed state
+// should also implement ProvableKVStore. A store that keeps the state
+// of past versions should also implement VersionedKVStore.
 type CommitKVStore interface {
 	// Get returns the value at last committed state
 	// returns nil iff key doesn't exist. Panics on nil key.
 	Get(key []byte) ([]byte, error)
 
-	// TODO: Get with proof, also historical queries
-	// GetVersionedWithProof(key []byte, version int64) (value []byte)
-
-	// func (b *Bonsai) GetWithProof(key []byte) ([]byte, iavl.KeyProof, error) {
-	//   return b.Tree.GetWithProof(key)
-	// }
-
-	// func (b *Bonsai) GetVersionedWithProof(key []byte, version int64) ([]byte, iavl.KeyProof, error) {
-	//   return b.Tree.GetVersionedWithProof(key, uint64(version))
-	// }
-
 	// Get a CacheWrap to perform actions
 	// TODO: add Batch to atomic writes and efficiency
 	// invisibly inside this CacheWrap???
@@ -166,6 +158,42 @@ type CommitKVStore interface {
 	LoadVersion(ver int64) error
 }
 
+// ProvableKVStore is implemented by a CommitKVStore that can prove the
+// presence or absence of a key or a range of keys in the last committed
+// state.
+type ProvableKVStore interface {
+	// GetWithProof returns the value at last committed state together
+	// with a merkle proof that can be verified against the app hash of
+	// that state. Value is nil iff key doesn't exist, in which case the
+	// proof of absence is returned.
+	GetWithProof(key []byte) ([]byte, *merkle.Proof, error)
+
+	// GetRangeWithProof returns all keys and values at last committed
+	// state that are in range [start, end) together with a merkle proof
+	// that no other key exists in that range. A nil end means that the
+	// range has no upper limit.
+	GetRangeWithProof(start, end []byte) ([][]byte, [][]byte, *merkle.Proof, error)
+}
+
+// VersionedKVStore is implemented by a CommitKVStore that keeps the state
+// of past versions.
+type VersionedKVStore interface {
+	// ReadVersion returns a read only view of the state committed at
+	// given version. An error is returned if the version does not exist,
+	// for example because it was pruned. If the store implements
+	// ProvableKVStore, the returned view implements it as well.
+	ReadVersion(version int64) (ReadOnlyKVStore, error)
+}
+
+// CompactableKVStore is implemented by a CommitKVStore that can reclaim the
+// disk space of released data, for example of pruned versions.
+type CompactableKVStore interface {
+	// Compact rewrites the underlying database to release the space of
+	// deleted data. It can be called while the store is in use, but it
+	// may take a long time and slow down other operations.
+	Compact() error
+}
+
 // CommitID contains the tree version number and its merkle root.
 type CommitID struct {
 	Version int64
@@ -1,14 +1,18 @@
 package iavl
 
 import (
+	"bytes"
+	"time"
+
 	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
 	dbm "github.com/tendermint/tendermint/libs/db"
 
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store"
 )
 
-// TODO: make these configurable?
+// Defaults used when Options do not provide a value.
 const (
 	DefaultCacheSize int   = 10000
 	DefaultHistory   int64 = 20
@@ -16,43 +20,101 @@ const (
 
 // CommitStore manages a iavl committed state
 type CommitStore struct {
-	tree       *iavl.MutableTree
-	numHistory int64
+	tree    *iavl.MutableTree
+	db      dbm.DB
+	pruning pruning
+	// changes collects all changes of the working version. It is nil if
+	// no listener is configured.
+	changes  *changeLog
+	listener ChangeListener
 }
 
 var _ store.CommitKVStore = CommitStore{}
+var _ store.ProvableKVStore = CommitStore{}
+var _ store.VersionedKVStore = CommitStore{}
+var _ store.CompactableKVStore = CommitStore{}
 
 // NewCommitStore creates a new store with disk backing
 func NewCommitStore(path, name string) CommitStore {
-	// Create the underlying leveldb datastore which will
-	// persist the Merkle tree inner & leaf nodes.
-	db, err := dbm.NewGoLevelDB(name, path)
+	commit, err := NewCommitStoreWithOptions(path, name, DefaultOptions())
 	if err != nil {
 		panic(err)
 	}
+	return commit
+}
 
-	tree := iavl.NewMutableTree(db, DefaultCacheSize)
-	commit := CommitStore{tree, DefaultHistory}
+// NewCommitStoreWithOptions creates a new store using the database backend
+// and tuning configured by given options.
+func NewCommitStoreWithOptions(path, name string, opts Options) (CommitStore, error) {
+	if err := opts.Validate(); err != nil {
+		return CommitStore{}, errors.Wrap(err, "options")
+	}
+	opts = opts.withDefaults()
 
-	err = commit.LoadLatestVersion()
+	// Create the underlying datastore which will persist the Merkle
+	// tree inner & leaf nodes.
+	db, err := openDB(path, name, opts)
 	if err != nil {
-		panic(err)
+		return CommitStore{}, errors.Wrap(err, "open database")
 	}
 
-	return commit
+	tree := iavl.NewMutableTree(db, opts.CacheSize)
+	commit := CommitStore{
+		tree:    tree,
+		db:      db,
+		pruning: newPruning(opts),
+	}
+	if opts.Listener != nil {
+		commit.changes = newChangeLog()
+		commit.listener = opts.Listener
+	}
+
+	if err := commit.LoadLatestVersion(); err != nil {
+		return CommitStore{}, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return commit, nil
+}
+
+// OpenTree opens the database selected in the options and loads the requested
+// version of the iavl tree. Zero version loads the latest one. An error is
+// returned if the tree is empty. Returned database must be closed by the
+// caller.
+//
+// Use it together with the ReadOnly option to read the state of a node
+// without running it.
+func OpenTree(path, name string, version int64, opts Options) (*iavl.MutableTree, dbm.DB, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, nil, errors.Wrap(err, "options")
+	}
+	opts = opts.withDefaults()
+	db, err := openDB(path, name, opts)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "open database")
+	}
+	tree := iavl.NewMutableTree(db, opts.CacheSize)
+	ver, err := tree.LoadVersion(version)
+	if err != nil {
+		db.Close()
+		return nil, nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	if ver == 0 {
+		db.Close()
+		return nil, nil, errors.Wrap(errors.ErrState, "iavl tree is empty")
+	}
+	return tree, db, nil
 }
 
 // NewCommitStoreFromTree accepts a preloaded MutableTree and wraps it
 // Mainly designed for test code... or devs who want full control
 func NewCommitStoreFromTree(tree *iavl.MutableTree) CommitStore {
-	return CommitStore{tree, DefaultHistory}
+	return CommitStore{tree: tree, pruning: newPruning(DefaultOptions())}
 }
 
 // MockCommitStore creates a new in-memory store for testing
 func MockCommitStore() CommitStore {
 	var db dbm.DB = dbm.NewMemDB()
 	tree := iavl.NewMutableTree(db, DefaultCacheSize)
-	return CommitStore{tree, DefaultHistory}
+	return CommitStore{tree: tree, db: db, pruning: newPruning(DefaultOptions())}
 }
 
 // Get returns the value at last committed state
@@ -62,6 +124,7 @@ func (s CommitStore) Get(key []byte) ([]byte, error) {
 	if len(key) == 0 {
 		return nil, errors.Wrap(errors.ErrDatabase, "nil key")
 	}
+	metrics.Add(metricGets, 1)
 	version := int64(s.tree.Version())
 	_, val := s.tree.GetVersioned(key, version)
 	return val, nil
@@ -69,14 +132,26 @@ func (s CommitStore) Get(key []byte) ([]byte, error) {
 
 // Commit the next version to disk, and returns info
 func (s CommitStore) Commit() (store.CommitID, error) {
+	defer commitTime.observeSince(time.Now())
+
+	// Listener is notified before the version is saved. If it fails, the
+	// application stops and the version is committed again on restart,
+	// so that no change is lost.
+	if s.listener != nil {
+		cs := s.changes.changeSet(s.tree.Version()+1, s.tree.WorkingHash())
+		if err := s.listener.StateCommitted(cs); err != nil {
+			return store.CommitID{}, errors.Wrap(err, "change listener")
+		}
+		s.changes.reset()
+	}
+
 	hash, version, err := s.tree.SaveVersion()
 	if err != nil {
 		panic(err)
 	}
 
 	// Potentially release an old version of history
-	if s.numHistory > 0 && (s.numHistory < version) {
-		toRelease := version - s.numHistory
+	if toRelease, ok := s.pruning.release(version); ok {
 		err = s.tree.DeleteVersion(toRelease)
 		if err != nil {
 			panic(err)
@@ -94,6 +169,7 @@ func (s CommitStore) Commit() (store.CommitID, error) {
 // If there was a crash during the last commit, it is guaranteed
 // to return a stable state, even if older.
 func (s CommitStore) LoadLatestVersion() error {
+	s.changes.reset()
 	_, err := s.tree.Load()
 	return err
 }
@@ -103,6 +179,7 @@ func (s CommitStore) LoadLatestVersion() error {
 // loading must be idempotent (return the same commit id).  Otherwise the
 // behavior is undefined.
 func (s CommitStore) LoadVersion(version int64) error {
+	s.changes.reset()
 	_, err := s.tree.LoadVersion(version)
 	return err
 }
@@ -123,7 +200,7 @@ func (s CommitStore) LatestVersion() (store.CommitID, error) {
 // to rollback writes here, without throwing away the CommitStore
 // and re-loading from disk.
 func (s CommitStore) Adapter() store.CacheableKVStore {
-	var kv store.KVStore = adapter{tree: s.tree}
+	var kv store.KVStore = adapter{tree: s.tree, changes: s.changes}
 	return store.BTreeCacheable{KVStore: kv}
 }
 
@@ -133,39 +210,137 @@ func (s CommitStore) CacheWrap() store.KVCacheWrap {
 	return s.Adapter().CacheWrap()
 }
 
-// func (b *Bonsai) GetVersionedWithProof(key []byte, version int64) ([]byte, iavl.KeyProof, error) {
-//   return b.Tree.GetVersionedWithProof(key, uint64(version))
-// }
+// GetWithProof returns the value at last committed state together with a
+// merkle proof of presence or, if the key does not exist, a proof of absence.
+// The proof can be verified against the app hash using VerifyProof.
+func (s CommitStore) GetWithProof(key []byte) ([]byte, *merkle.Proof, error) {
+	tree, err := s.tree.GetImmutable(s.tree.Version())
+	if err != nil {
+		return nil, nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return getWithProof(tree, key)
+}
+
+// GetRangeWithProof returns all keys and values at last committed state
+// that are in range [start, end) together with a merkle proof that no other
+// key exists in that range. A nil end means that the range has no upper
+// limit. The proof can be verified against the app hash using
+// VerifyRangeProof.
+func (s CommitStore) GetRangeWithProof(start, end []byte) ([][]byte, [][]byte, *merkle.Proof, error) {
+	tree, err := s.tree.GetImmutable(s.tree.Version())
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return getRangeWithProof(tree, start, end)
+}
+
+// ReadVersion returns a read only view of the state committed at given
+// version. Versions removed by pruning cannot be read.
+func (s CommitStore) ReadVersion(version int64) (store.ReadOnlyKVStore, error) {
+	if !s.tree.VersionExists(version) {
+		return nil, errors.Wrapf(errors.ErrNotFound, "version %d", version)
+	}
+	tree, err := s.tree.GetImmutable(version)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return versionAdapter{tree: tree}, nil
+}
 
-// TODO: create batch and reader and wrap the rest in btree...
+func getWithProof(tree *iavl.ImmutableTree, key []byte) ([]byte, *merkle.Proof, error) {
+	defer proofTime.observeSince(time.Now())
+
+	if len(key) == 0 {
+		return nil, nil, errors.Wrap(errors.ErrDatabase, "nil key")
+	}
+	val, proof, err := tree.GetWithProof(key)
+	if err != nil {
+		return nil, nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	if proof == nil {
+		return nil, nil, errors.Wrap(errors.ErrDatabase, "empty tree cannot be proven")
+	}
+	var op merkle.ProofOp
+	if val == nil {
+		op = iavl.NewIAVLAbsenceOp(key, proof).ProofOp()
+	} else {
+		op = iavl.NewIAVLValueOp(key, proof).ProofOp()
+	}
+	return val, &merkle.Proof{Ops: []merkle.ProofOp{op}}, nil
+}
+
+func getRangeWithProof(tree *iavl.ImmutableTree, start, end []byte) ([][]byte, [][]byte, *merkle.Proof, error) {
+	defer proofTime.observeSince(time.Now())
+
+	if tree.Size() == 0 {
+		return nil, nil, nil, errors.Wrap(errors.ErrDatabase, "empty tree cannot be proven")
+	}
+
+	// Proof must include the first key after the range, so that it can
+	// be verified that no key was omitted at the end of the range. Limit
+	// allows for one more leaf in case the left neighbour of the start
+	// is counted as well.
+	var limit int
+	if end != nil {
+		tree.IterateRange(start, end, true, func(key, value []byte) bool {
+			limit++
+			return false
+		})
+		limit += 2
+	}
+	keys, values, proof, err := tree.GetRangeWithProof(start, nil, limit)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	for i, k := range keys {
+		if end != nil && bytes.Compare(k, end) >= 0 {
+			keys, values = keys[:i], values[:i]
+			break
+		}
+	}
+
+	raw, err := proofCodec.MarshalBinaryBare(proof)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	op := merkle.ProofOp{Type: ProofOpIAVLRange, Key: start, Data: raw}
+	return keys, values, &merkle.Proof{Ops: []merkle.ProofOp{op}}, nil
+}
 
 // adapter converts the working iavl.Tree to match these interfaces
 type adapter struct {
-	tree *iavl.MutableTree
+	tree    *iavl.MutableTree
+	changes *changeLog
 }
 
 var _ store.KVStore = adapter{}
 
 // Get returns nil iff key doesn't exist. Panics on nil key.
 func (a adapter) Get(key []byte) ([]byte, error) {
+	metrics.Add(metricGets, 1)
 	_, val := a.tree.Get(key)
 	return val, nil
 }
 
 // Has checks if a key exists. Panics on nil key.
 func (a adapter) Has(key []byte) (bool, error) {
+	metrics.Add(metricGets, 1)
 	return a.tree.Has(key), nil
 }
 
 // Set adds a new value
 func (a adapter) Set(key, value []byte) error {
+	metrics.Add(metricSets, 1)
 	a.tree.Set(key, value)
+	a.changes.set(key, value)
 	return nil
 }
 
 // Delete removes from the tree
 func (a adapter) Delete(key []byte) error {
+	metrics.Add(metricDeletes, 1)
 	a.tree.Remove(key)
+	a.changes.delete(key)
 	return nil
 }
 
@@ -188,7 +363,7 @@ func (a adapter) Iterator(start, end []byte) (store.Iterator, error) {
 }
 
 // ReverseIterator over a domain of keys in descending order. End is exclusive.
-// Start must be greater than end, or the Iterator is invalid.
+// Start must be less than end, or the Iterator is invalid.
 // CONTRACT: No writes may happen within a domain while an iterator exists over it.
 func (a adapter) ReverseIterator(start, end []byte) (store.Iterator, error) {
 	iter := newLazyIterator()
@@ -199,3 +374,57 @@ func (a adapter) ReverseIterator(start, end []byte) (store.Iterator, error) {
 
 	return iter, nil
 }
+
+// versionAdapter provides read only access to a committed version of the
+// tree.
+type versionAdapter struct {
+	tree *iavl.ImmutableTree
+}
+
+var _ store.ReadOnlyKVStore = versionAdapter{}
+var _ store.ProvableKVStore = versionAdapter{}
+
+// Get returns nil iff key doesn't exist. Panics on nil key.
+func (a versionAdapter) Get(key []byte) ([]byte, error) {
+	metrics.Add(metricGets, 1)
+	_, val := a.tree.Get(key)
+	return val, nil
+}
+
+// Has checks if a key exists. Panics on nil key.
+func (a versionAdapter) Has(key []byte) (bool, error) {
+	metrics.Add(metricGets, 1)
+	return a.tree.Has(key), nil
+}
+
+// Iterator over a domain of keys in ascending order. End is exclusive.
+func (a versionAdapter) Iterator(start, end []byte) (store.Iterator, error) {
+	iter := newLazyIterator()
+	go func() {
+		a.tree.IterateRange(start, end, true, iter.add)
+		iter.Release()
+	}()
+	return iter, nil
+}
+
+// ReverseIterator over a domain of keys in descending order. End is exclusive.
+func (a versionAdapter) ReverseIterator(start, end []byte) (store.Iterator, error) {
+	iter := newLazyIterator()
+	go func() {
+		a.tree.IterateRange(start, end, false, iter.add)
+		iter.Release()
+	}()
+	return iter, nil
+}
+
+// GetWithProof returns the value of this version together with a merkle
+// proof of presence or absence.
+func (a versionAdapter) GetWithProof(key []byte) ([]byte, *merkle.Proof, error) {
+	return getWithProof(a.tree, key)
+}
+
+// GetRangeWithProof returns all keys and values of this version that are in
+// range [start, end) together with a merkle proof.
+func (a versionAdapter) GetRangeWithProof(start, end []byte) ([][]byte, [][]byte, *merkle.Proof, error) {
+	return getRangeWithProof(a.tree, start, end)
+}
@@ -73,7 +73,7 @@ func TestCommitOverwrite(t *testing.T) {
 		t.Run(testName, func(t *testing.T) {
 			commit, close := makeCommitStore()
 			// only one to trigger a cleanup
-			commit.numHistory = 1
+			commit.pruning.keepRecent = 1
 
 			id, err := commit.LatestVersion()
 			assert.Nil(t, err)
@@ -143,3 +143,51 @@ func randBytes(length int) []byte {
 	rand.Read(res)
 	return res
 }
+
+func TestCommitBatchKeepsOperationOrder(t *testing.T) {
+	cases := map[string]func(db store.KVStore){
+		"set once": func(db store.KVStore) {
+			assert.Nil(t, db.Set([]byte("alice"), []byte("3")))
+			assert.Nil(t, db.Set([]byte("bob"), []byte("2")))
+		},
+		"many changes of the same keys": func(db store.KVStore) {
+			assert.Nil(t, db.Set([]byte("bob"), []byte("1")))
+			assert.Nil(t, db.Set([]byte("alice"), []byte("1")))
+			assert.Nil(t, db.Set([]byte("carol"), []byte("1")))
+			assert.Nil(t, db.Set([]byte("bob"), []byte("2")))
+			assert.Nil(t, db.Delete([]byte("carol")))
+			assert.Nil(t, db.Delete([]byte("carol")))
+			assert.Nil(t, db.Set([]byte("alice"), []byte("3")))
+			assert.Nil(t, db.Set([]byte("erin"), []byte("1")))
+			assert.Nil(t, db.Delete([]byte("erin")))
+			assert.Nil(t, db.Set([]byte("erin"), []byte("5")))
+		},
+	}
+
+	for testName, change := range cases {
+		t.Run(testName, func(t *testing.T) {
+			commit := MockCommitStore()
+
+			// Changes are written in many transactions of a block.
+			deliver := commit.CacheWrap()
+			tx := deliver.CacheWrap()
+			change(tx)
+			assert.Nil(t, tx.Write())
+			tx = deliver.CacheWrap()
+			assert.Nil(t, tx.Set([]byte("dave"), []byte("4")))
+			assert.Nil(t, tx.Write())
+			assert.Nil(t, deliver.Write())
+			id, err := commit.Commit()
+			assert.Nil(t, err)
+
+			// Applying each operation directly to the tree must
+			// result in the same app hash.
+			direct := MockCommitStore()
+			change(direct.Adapter())
+			assert.Nil(t, direct.Adapter().Set([]byte("dave"), []byte("4")))
+			want, err := direct.Commit()
+			assert.Nil(t, err)
+			assert.Equal(t, want.Hash, id.Hash)
+		})
+	}
+}
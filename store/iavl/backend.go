@@ -0,0 +1,222 @@
+package iavl
+
+import (
+	"fmt"
+
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// Names of the database backends that can be used to persist the iavl tree.
+const (
+	GoLevelDBBackend = "goleveldb"
+	CLevelDBBackend  = "cleveldb"
+	BadgerBackend    = "badger"
+	MemDBBackend     = "memdb"
+)
+
+// Names of the strategies used to release past versions of the iavl tree.
+const (
+	// PruneKeepLast keeps only the most recent History versions.
+	PruneKeepLast = "keep-last"
+	// PruneKeepEvery keeps the most recent History versions and every
+	// version that is a multiple of KeepEvery.
+	PruneKeepEvery = "keep-every"
+	// PruneNothing keeps all versions. Use it for archive nodes.
+	PruneNothing = "nothing"
+)
+
+// Options configures the database that backs a CommitStore. Zero value
+// fields are replaced with defaults when the store is created.
+type Options struct {
+	// Backend is the name of the storage engine used. One of the
+	// *Backend constants declared in this package.
+	Backend string
+	// CacheSize is the number of iavl tree nodes kept in memory.
+	CacheSize int
+	// History is the number of past versions kept on disk. Older versions
+	// are released on commit, according to the pruning strategy.
+	History int64
+	// Pruning is the name of the strategy used to release past versions.
+	// One of the Prune* constants declared in this package.
+	Pruning string
+	// KeepEvery is the interval of versions that are never released. Used
+	// only by the keep-every pruning strategy.
+	KeepEvery int64
+
+	// BlockCacheSize is the size (in MiB) of the goleveldb block cache.
+	// Used only by the goleveldb backend.
+	BlockCacheSize int
+	// WriteBufferSize is the size (in MiB) of the goleveldb write buffer.
+	// Used only by the goleveldb backend.
+	WriteBufferSize int
+
+	// BadgerValueLogFileSize is the size (in MiB) of a single badger value
+	// log file. Used only by the badger backend.
+	BadgerValueLogFileSize int
+	// BadgerNumCompactors is the number of badger compaction workers. Used
+	// only by the badger backend.
+	BadgerNumCompactors int
+
+	// ReadOnly opens the database without acquiring write access, so that
+	// it can be read while another process is using it. Only goleveldb and
+	// badger backends support it.
+	ReadOnly bool
+
+	// Listener, if set, is notified about all changes of the state
+	// committed in each version.
+	Listener ChangeListener
+}
+
+// DefaultOptions returns the options used when no custom configuration
+// is provided.
+func DefaultOptions() Options {
+	return Options{
+		Backend:   GoLevelDBBackend,
+		CacheSize: DefaultCacheSize,
+		History:   DefaultHistory,
+		Pruning:   PruneKeepLast,
+	}
+}
+
+// withDefaults returns a copy of the options with all zero value fields set
+// to their default values.
+func (o Options) withDefaults() Options {
+	def := DefaultOptions()
+	if o.Backend == "" {
+		o.Backend = def.Backend
+	}
+	if o.CacheSize == 0 {
+		o.CacheSize = def.CacheSize
+	}
+	if o.History == 0 {
+		o.History = def.History
+	}
+	if o.Pruning == "" {
+		o.Pruning = def.Pruning
+	}
+	return o
+}
+
+// Validate returns an error if the options cannot be used to create a store.
+func (o Options) Validate() error {
+	o = o.withDefaults()
+	var errs error
+	errs = errors.AppendField(errs, "Backend", validateBackend(o.Backend))
+	if o.CacheSize < 0 {
+		errs = errors.AppendField(errs, "CacheSize", errors.ErrInput)
+	}
+	if o.History < 0 {
+		errs = errors.AppendField(errs, "History", errors.ErrInput)
+	}
+	errs = errors.AppendField(errs, "Pruning", validatePruning(o.Pruning))
+	if o.KeepEvery < 0 || (o.Pruning == PruneKeepEvery && o.KeepEvery == 0) {
+		errs = errors.AppendField(errs, "KeepEvery", errors.ErrInput)
+	}
+	if o.BlockCacheSize < 0 {
+		errs = errors.AppendField(errs, "BlockCacheSize", errors.ErrInput)
+	}
+	if o.WriteBufferSize < 0 {
+		errs = errors.AppendField(errs, "WriteBufferSize", errors.ErrInput)
+	}
+	if o.BadgerValueLogFileSize < 0 {
+		errs = errors.AppendField(errs, "BadgerValueLogFileSize", errors.ErrInput)
+	}
+	if o.BadgerNumCompactors < 0 {
+		errs = errors.AppendField(errs, "BadgerNumCompactors", errors.ErrInput)
+	}
+	if o.ReadOnly && o.Backend != GoLevelDBBackend && o.Backend != BadgerBackend {
+		errs = errors.AppendField(errs, "ReadOnly",
+			errors.Wrapf(errors.ErrInput, "not supported by %q backend", o.Backend))
+	}
+	return errs
+}
+
+func validateBackend(name string) error {
+	switch name {
+	case GoLevelDBBackend, CLevelDBBackend, MemDBBackend:
+		return nil
+	case BadgerBackend:
+		// badger is available only when the binary is compiled with
+		// the badgerdb build tag.
+		if !badgerSupported {
+			return errors.Wrap(errors.ErrInput, "badger backend is not supported by this build, compile with the badgerdb tag")
+		}
+		return nil
+	default:
+		return errors.Wrapf(errors.ErrInput, "unknown database backend %q", name)
+	}
+}
+
+func validatePruning(name string) error {
+	switch name {
+	case PruneKeepLast, PruneKeepEvery, PruneNothing:
+		return nil
+	default:
+		return errors.Wrapf(errors.ErrInput, "unknown pruning strategy %q", name)
+	}
+}
+
+// pruning decides which past versions are released. Options must be valid.
+type pruning struct {
+	strategy   string
+	keepRecent int64
+	keepEvery  int64
+}
+
+func newPruning(o Options) pruning {
+	return pruning{
+		strategy:   o.Pruning,
+		keepRecent: o.History,
+		keepEvery:  o.KeepEvery,
+	}
+}
+
+// release returns the version that can be released after the given version
+// was saved and false if no version should be released.
+func (p pruning) release(saved int64) (int64, bool) {
+	if p.strategy == PruneNothing || p.keepRecent <= 0 || saved <= p.keepRecent {
+		return 0, false
+	}
+	version := saved - p.keepRecent
+	if p.strategy == PruneKeepEvery && version%p.keepEvery == 0 {
+		return 0, false
+	}
+	return version, true
+}
+
+// openDB creates a database instance using the backend selected in the
+// options. Options must be valid.
+func openDB(path, name string, o Options) (db dbm.DB, err error) {
+	switch o.Backend {
+	case MemDBBackend:
+		return dbm.NewMemDB(), nil
+	case GoLevelDBBackend:
+		lvlopts := &opt.Options{
+			BlockCacheCapacity: o.BlockCacheSize * opt.MiB,
+			WriteBuffer:        o.WriteBufferSize * opt.MiB,
+			ReadOnly:           o.ReadOnly,
+		}
+		db, err := dbm.NewGoLevelDBWithOpts(name, path, lvlopts)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrDatabase, err.Error())
+		}
+		return db, nil
+	case CLevelDBBackend:
+		// cleveldb is available only when the binary is compiled with
+		// the gcc build tag. Otherwise tendermint panics.
+		defer func() {
+			if r := recover(); r != nil {
+				db = nil
+				err = errors.Wrap(errors.ErrDatabase, fmt.Sprint(r))
+			}
+		}()
+		return dbm.NewDB(name, dbm.CLevelDBBackend, path), nil
+	case BadgerBackend:
+		return openBadger(path, name, o)
+	default:
+		return nil, validateBackend(o.Backend)
+	}
+}
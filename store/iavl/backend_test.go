@@ -0,0 +1,215 @@
+package iavl
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestNewCommitStoreWithOptions(t *testing.T) {
+	cases := map[string]struct {
+		opts    Options
+		wantErr *errors.Error
+	}{
+		"zero value options use defaults": {
+			opts: Options{},
+		},
+		"goleveldb with tuning": {
+			opts: Options{
+				Backend:         GoLevelDBBackend,
+				CacheSize:       100,
+				History:         2,
+				BlockCacheSize:  16,
+				WriteBufferSize: 8,
+			},
+		},
+		"memdb": {
+			opts: Options{Backend: MemDBBackend},
+		},
+		"badger with tuning": {
+			opts: Options{
+				Backend:                BadgerBackend,
+				BadgerValueLogFileSize: 64,
+				BadgerNumCompactors:    2,
+			},
+			wantErr: wantBadgerErr(),
+		},
+		"negative badger compactors": {
+			opts:    Options{Backend: MemDBBackend, BadgerNumCompactors: -1},
+			wantErr: errors.ErrInput,
+		},
+		"unknown backend": {
+			opts:    Options{Backend: "mongodb"},
+			wantErr: errors.ErrInput,
+		},
+		"keep every pruning": {
+			opts: Options{Backend: MemDBBackend, Pruning: PruneKeepEvery, KeepEvery: 100},
+		},
+		"no pruning": {
+			opts: Options{Backend: MemDBBackend, Pruning: PruneNothing},
+		},
+		"keep every pruning requires an interval": {
+			opts:    Options{Backend: MemDBBackend, Pruning: PruneKeepEvery},
+			wantErr: errors.ErrInput,
+		},
+		"unknown pruning": {
+			opts:    Options{Backend: MemDBBackend, Pruning: "sometimes"},
+			wantErr: errors.ErrInput,
+		},
+		"negative cache size": {
+			opts:    Options{CacheSize: -1},
+			wantErr: errors.ErrInput,
+		},
+		"read only memdb": {
+			opts:    Options{Backend: MemDBBackend, ReadOnly: true},
+			wantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "iavl-backend-")
+			assert.Nil(t, err)
+			defer os.RemoveAll(tmpDir)
+
+			commit, err := NewCommitStoreWithOptions(tmpDir, "base", tc.opts)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			assert.Nil(t, commit.Adapter().Set([]byte("foo"), []byte("bar")))
+			id, err := commit.Commit()
+			assert.Nil(t, err)
+			assert.Equal(t, int64(1), id.Version)
+		})
+	}
+}
+
+func TestOpenTree(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "iavl-backend-")
+	assert.Nil(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	commit, err := NewCommitStoreWithOptions(tmpDir, "base", Options{})
+	assert.Nil(t, err)
+	for _, value := range []string{"first", "second"} {
+		assert.Nil(t, commit.Adapter().Set([]byte("key"), []byte(value)))
+		_, err := commit.Commit()
+		assert.Nil(t, err)
+	}
+	// Release the lock so that the database can be opened again.
+	commit.db.Close()
+
+	cases := map[string]struct {
+		name      string
+		version   int64
+		opts      Options
+		wantValue string
+		wantErr   *errors.Error
+	}{
+		"latest version": {
+			name:      "base",
+			opts:      Options{ReadOnly: true},
+			wantValue: "second",
+		},
+		"past version": {
+			name:      "base",
+			version:   1,
+			opts:      Options{ReadOnly: true},
+			wantValue: "first",
+		},
+		"missing version": {
+			name:    "base",
+			version: 5,
+			opts:    Options{ReadOnly: true},
+			wantErr: errors.ErrDatabase,
+		},
+		"empty database": {
+			name:    "empty",
+			wantErr: errors.ErrState,
+		},
+		"read only memdb": {
+			name:    "base",
+			opts:    Options{Backend: MemDBBackend, ReadOnly: true},
+			wantErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			tree, db, err := OpenTree(tmpDir, tc.name, tc.version, tc.opts)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			defer db.Close()
+
+			_, value := tree.Get([]byte("key"))
+			assert.Equal(t, tc.wantValue, string(value))
+		})
+	}
+}
+
+// wantBadgerErr returns the error expected when a badger store is created.
+// Badger is available only when tests are run with the badgerdb build tag.
+func wantBadgerErr() *errors.Error {
+	if badgerSupported {
+		return nil
+	}
+	return errors.ErrInput
+}
+
+func TestPruning(t *testing.T) {
+	cases := map[string]struct {
+		opts        Options
+		wantKept    []int64
+		wantRemoved []int64
+	}{
+		"keep last": {
+			opts:        Options{Backend: MemDBBackend, History: 3},
+			wantKept:    []int64{8, 9, 10},
+			wantRemoved: []int64{1, 4, 7},
+		},
+		"keep every": {
+			opts:        Options{Backend: MemDBBackend, History: 2, Pruning: PruneKeepEvery, KeepEvery: 4},
+			wantKept:    []int64{4, 8, 9, 10},
+			wantRemoved: []int64{1, 2, 3, 5, 6, 7},
+		},
+		"nothing": {
+			opts:     Options{Backend: MemDBBackend, History: 2, Pruning: PruneNothing},
+			wantKept: []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			commit, err := NewCommitStoreWithOptions("", "base", tc.opts)
+			assert.Nil(t, err)
+
+			for i := 0; i < 10; i++ {
+				assert.Nil(t, commit.Adapter().Set([]byte{byte(i)}, []byte("value")))
+				_, err := commit.Commit()
+				assert.Nil(t, err)
+			}
+
+			for _, v := range tc.wantKept {
+				if !commit.tree.VersionExists(v) {
+					t.Errorf("version %d must be kept", v)
+				}
+			}
+			for _, v := range tc.wantRemoved {
+				if commit.tree.VersionExists(v) {
+					t.Errorf("version %d must be released", v)
+				}
+			}
+		})
+	}
+}
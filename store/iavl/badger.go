@@ -0,0 +1,293 @@
+// +build badgerdb
+
+package iavl
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/dgraph-io/badger"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// badgerSupported is true when the binary is compiled with the badgerdb build
+// tag.
+const badgerSupported = true
+
+// openBadger creates a badger database in a directory named after the
+// database inside of the path directory.
+func openBadger(path, name string, o Options) (dbm.DB, error) {
+	dir := filepath.Join(path, name+".db")
+	if !o.ReadOnly {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, errors.Wrap(errors.ErrDatabase, err.Error())
+		}
+	}
+	opts := badger.DefaultOptions(dir)
+	opts.ReadOnly = o.ReadOnly
+	if o.BadgerValueLogFileSize > 0 {
+		opts.ValueLogFileSize = int64(o.BadgerValueLogFileSize) << 20
+	}
+	if o.BadgerNumCompactors > 0 {
+		opts.NumCompactors = o.BadgerNumCompactors
+	}
+	db, err := badger.Open(opts)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return &badgerDB{db: db}, nil
+}
+
+// badgerDB implements the tendermint database interface on top of badger.
+// Just like other tendermint database implementations, it panics on storage
+// failures.
+//
+// Badger is configured to sync all writes, so there is no difference between
+// Set and SetSync.
+type badgerDB struct {
+	db *badger.DB
+}
+
+var _ dbm.DB = (*badgerDB)(nil)
+var _ compacter = (*badgerDB)(nil)
+
+func (b *badgerDB) Get(key []byte) []byte {
+	var value []byte
+	err := b.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		switch {
+		case err == badger.ErrKeyNotFound:
+			return nil
+		case err != nil:
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+func (b *badgerDB) Has(key []byte) bool {
+	return b.Get(key) != nil
+}
+
+func (b *badgerDB) Set(key, value []byte) {
+	err := b.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, value)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (b *badgerDB) SetSync(key, value []byte) {
+	b.Set(key, value)
+}
+
+func (b *badgerDB) Delete(key []byte) {
+	err := b.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (b *badgerDB) DeleteSync(key []byte) {
+	b.Delete(key)
+}
+
+func (b *badgerDB) Iterator(start, end []byte) dbm.Iterator {
+	return newBadgerIterator(b.db, start, end, false)
+}
+
+func (b *badgerDB) ReverseIterator(start, end []byte) dbm.Iterator {
+	return newBadgerIterator(b.db, start, end, true)
+}
+
+func (b *badgerDB) Close() {
+	if err := b.db.Close(); err != nil {
+		panic(err)
+	}
+}
+
+func (b *badgerDB) NewBatch() dbm.Batch {
+	return &badgerBatch{db: b.db}
+}
+
+func (b *badgerDB) Print() {
+	it := b.Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		fmt.Printf("[%X]:\t[%X]\n", it.Key(), it.Value())
+	}
+}
+
+func (b *badgerDB) Stats() map[string]string {
+	lsm, vlog := b.db.Size()
+	return map[string]string{
+		"badger.lsm_size":  fmt.Sprint(lsm),
+		"badger.vlog_size": fmt.Sprint(vlog),
+	}
+}
+
+// Compact merges all levels of the LSM tree and rewrites value log files until
+// no more space can be reclaimed.
+func (b *badgerDB) Compact() error {
+	if err := b.db.Flatten(1); err != nil {
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	for {
+		switch err := b.db.RunValueLogGC(0.5); err {
+		case nil:
+		case badger.ErrNoRewrite:
+			return nil
+		default:
+			return errors.Wrap(errors.ErrDatabase, err.Error())
+		}
+	}
+}
+
+// badgerIterator iterates over a consistent snapshot of the database in
+// range [start, end).
+type badgerIterator struct {
+	txn     *badger.Txn
+	it      *badger.Iterator
+	start   []byte
+	end     []byte
+	reverse bool
+}
+
+var _ dbm.Iterator = (*badgerIterator)(nil)
+
+func newBadgerIterator(db *badger.DB, start, end []byte, reverse bool) *badgerIterator {
+	txn := db.NewTransaction(false)
+	opts := badger.DefaultIteratorOptions
+	opts.Reverse = reverse
+	it := txn.NewIterator(opts)
+
+	switch {
+	case !reverse && start != nil:
+		it.Seek(start)
+	case reverse && end != nil:
+		// In reverse mode seek moves to the greatest key that is
+		// not greater than the end, which is exclusive.
+		it.Seek(end)
+		if it.Valid() && bytes.Equal(it.Item().Key(), end) {
+			it.Next()
+		}
+	default:
+		it.Rewind()
+	}
+	return &badgerIterator{txn: txn, it: it, start: start, end: end, reverse: reverse}
+}
+
+func (i *badgerIterator) Domain() ([]byte, []byte) {
+	return i.start, i.end
+}
+
+func (i *badgerIterator) Valid() bool {
+	if !i.it.Valid() {
+		return false
+	}
+	key := i.it.Item().Key()
+	if i.reverse {
+		return i.start == nil || bytes.Compare(key, i.start) >= 0
+	}
+	return i.end == nil || bytes.Compare(key, i.end) < 0
+}
+
+func (i *badgerIterator) Next() {
+	i.assertValid()
+	i.it.Next()
+}
+
+func (i *badgerIterator) Key() []byte {
+	i.assertValid()
+	return i.it.Item().KeyCopy(nil)
+}
+
+func (i *badgerIterator) Value() []byte {
+	i.assertValid()
+	value, err := i.it.Item().ValueCopy(nil)
+	if err != nil {
+		panic(err)
+	}
+	return value
+}
+
+func (i *badgerIterator) Close() {
+	i.it.Close()
+	i.txn.Discard()
+}
+
+func (i *badgerIterator) assertValid() {
+	if !i.Valid() {
+		panic("iterator is invalid")
+	}
+}
+
+// badgerBatch collects operations and writes them on Write. Badger limits the
+// size of a transaction, so a big batch is split into several transactions.
+// Because of that, a batch is not atomic. This is fine for the iavl tree,
+// because it writes the root of a version after all its nodes.
+type badgerBatch struct {
+	db  *badger.DB
+	ops []badgerOp
+}
+
+var _ dbm.Batch = (*badgerBatch)(nil)
+
+type badgerOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+func (op badgerOp) apply(txn *badger.Txn) error {
+	if op.delete {
+		return txn.Delete(op.key)
+	}
+	return txn.Set(op.key, op.value)
+}
+
+func (b *badgerBatch) Set(key, value []byte) {
+	b.ops = append(b.ops, badgerOp{key: key, value: value})
+}
+
+func (b *badgerBatch) Delete(key []byte) {
+	b.ops = append(b.ops, badgerOp{key: key, delete: true})
+}
+
+func (b *badgerBatch) Write() {
+	txn := b.db.NewTransaction(true)
+	for _, op := range b.ops {
+		err := op.apply(txn)
+		if err == badger.ErrTxnTooBig {
+			if err := txn.Commit(); err != nil {
+				panic(err)
+			}
+			txn = b.db.NewTransaction(true)
+			err = op.apply(txn)
+		}
+		if err != nil {
+			txn.Discard()
+			panic(err)
+		}
+	}
+	if err := txn.Commit(); err != nil {
+		panic(err)
+	}
+	b.ops = nil
+}
+
+func (b *badgerBatch) WriteSync() {
+	b.Write()
+}
@@ -0,0 +1,159 @@
+// +build badgerdb
+
+package iavl
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func makeBadgerStore() (store.CacheableKVStore, func()) {
+	tmpDir, err := ioutil.TempDir("/tmp", "iavl-badger-")
+	if err != nil {
+		panic(err)
+	}
+	commit, err := NewCommitStoreWithOptions(tmpDir, "base", Options{Backend: BadgerBackend})
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		panic(err)
+	}
+	return commit.Adapter(), func() { os.RemoveAll(tmpDir) }
+}
+
+var badgerSuite = store.NewTestSuite(makeBadgerStore)
+
+func TestBadgerStoreGetSet(t *testing.T) {
+	badgerSuite.GetSet(t)
+}
+
+func TestBadgerStoreCacheConflicts(t *testing.T) {
+	badgerSuite.CacheConflicts(t)
+}
+
+func TestBadgerStoreFuzzIterator(t *testing.T) {
+	badgerSuite.FuzzIterator(t)
+}
+
+func TestBadgerStoreIteratorWithConflicts(t *testing.T) {
+	badgerSuite.IteratorWithConflicts(t)
+}
+
+func TestBadgerIterator(t *testing.T) {
+	db, cleanup := openTestBadger(t)
+	defer cleanup()
+
+	for _, k := range []string{"a", "b", "c", "d"} {
+		db.Set([]byte(k), []byte("v"+k))
+	}
+
+	cases := map[string]struct {
+		start, end []byte
+		reverse    bool
+		want       []string
+	}{
+		"full range": {
+			want: []string{"a", "b", "c", "d"},
+		},
+		"end is exclusive": {
+			start: []byte("b"),
+			end:   []byte("d"),
+			want:  []string{"b", "c"},
+		},
+		"start between keys": {
+			start: []byte("bb"),
+			want:  []string{"c", "d"},
+		},
+		"reverse full range": {
+			reverse: true,
+			want:    []string{"d", "c", "b", "a"},
+		},
+		"reverse end is exclusive": {
+			start:   []byte("b"),
+			end:     []byte("d"),
+			reverse: true,
+			want:    []string{"c", "b"},
+		},
+		"reverse end between keys": {
+			end:     []byte("bb"),
+			reverse: true,
+			want:    []string{"b", "a"},
+		},
+		"empty range": {
+			start: []byte("x"),
+			want:  nil,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var it dbm.Iterator
+			if tc.reverse {
+				it = db.ReverseIterator(tc.start, tc.end)
+			} else {
+				it = db.Iterator(tc.start, tc.end)
+			}
+			defer it.Close()
+
+			var got []string
+			for ; it.Valid(); it.Next() {
+				got = append(got, string(it.Key()))
+				assert.Equal(t, "v"+string(it.Key()), string(it.Value()))
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestBadgerBatch(t *testing.T) {
+	db, cleanup := openTestBadger(t)
+	defer cleanup()
+
+	db.Set([]byte("deleted"), []byte("value"))
+
+	batch := db.NewBatch()
+	// Enough data to not fit into a single badger transaction.
+	value := make([]byte, 1024)
+	for i := 0; i < 20000; i++ {
+		batch.Set([]byte(fmt.Sprintf("key-%05d", i)), value)
+	}
+	batch.Delete([]byte("deleted"))
+	batch.Write()
+
+	if db.Has([]byte("deleted")) {
+		t.Fatal("deleted key was found")
+	}
+	for i := 0; i < 20000; i++ {
+		if !db.Has([]byte(fmt.Sprintf("key-%05d", i))) {
+			t.Fatalf("key %d not found", i)
+		}
+	}
+
+	c, ok := db.(compacter)
+	if !ok {
+		t.Fatal("badger database cannot be compacted")
+	}
+	assert.Nil(t, c.Compact())
+}
+
+func openTestBadger(t testing.TB) (dbm.DB, func()) {
+	t.Helper()
+	tmpDir, err := ioutil.TempDir("/tmp", "badger-")
+	if err != nil {
+		t.Fatalf("cannot create a temporary directory: %s", err)
+	}
+	db, err := openBadger(tmpDir, "test", Options{})
+	if err != nil {
+		os.RemoveAll(tmpDir)
+		t.Fatalf("cannot open badger: %s", err)
+	}
+	return db, func() {
+		db.Close()
+		os.RemoveAll(tmpDir)
+	}
+}
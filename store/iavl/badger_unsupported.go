@@ -0,0 +1,13 @@
+// +build !badgerdb
+
+package iavl
+
+import dbm "github.com/tendermint/tendermint/libs/db"
+
+// badgerSupported is true when the binary is compiled with the badgerdb build
+// tag.
+const badgerSupported = false
+
+func openBadger(path, name string, o Options) (dbm.DB, error) {
+	return nil, validateBackend(BadgerBackend)
+}
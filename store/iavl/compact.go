@@ -0,0 +1,45 @@
+package iavl
+
+import (
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb/util"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// compacter is implemented by databases of this package that can reclaim the
+// disk space of deleted data.
+type compacter interface {
+	Compact() error
+}
+
+// Compact rewrites the database that persists the iavl tree to release the
+// disk space of pruned versions. Deleted data is removed by the database
+// engine in the background as well, but only when enough new data is
+// written. Compaction can be triggered manually to reclaim the space right
+// away, for example after the history was reduced.
+//
+// Compaction is safe to run while the store is in use.
+func (s CommitStore) Compact() error {
+	defer compactTime.observeSince(time.Now())
+	metrics.Add(metricCompactions, 1)
+
+	switch db := s.db.(type) {
+	case nil:
+		return errors.Wrap(errors.ErrState, "store database is not known")
+	case *dbm.MemDB:
+		// Deleted data is released by the garbage collector.
+		return nil
+	case *dbm.GoLevelDB:
+		if err := db.DB().CompactRange(util.Range{}); err != nil {
+			return errors.Wrap(errors.ErrDatabase, err.Error())
+		}
+		return nil
+	case compacter:
+		return db.Compact()
+	default:
+		return errors.Wrapf(errors.ErrHuman, "compaction of %T database is not supported", s.db)
+	}
+}
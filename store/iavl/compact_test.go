@@ -0,0 +1,56 @@
+package iavl
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestCompact(t *testing.T) {
+	cases := map[string]struct {
+		opts    Options
+		wantErr *errors.Error
+	}{
+		"goleveldb": {
+			opts: Options{Backend: GoLevelDBBackend, History: 2},
+		},
+		"memdb": {
+			opts: Options{Backend: MemDBBackend, History: 2},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", "iavl-compact-")
+			assert.Nil(t, err)
+			defer os.RemoveAll(tmpDir)
+
+			commit, err := NewCommitStoreWithOptions(tmpDir, "base", tc.opts)
+			assert.Nil(t, err)
+			for i := 0; i < 10; i++ {
+				assert.Nil(t, commit.Adapter().Set([]byte("foo"), []byte{byte(i)}))
+				_, err := commit.Commit()
+				assert.Nil(t, err)
+			}
+
+			if err := commit.Compact(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			// Compaction must not change the state.
+			val, err := commit.Get([]byte("foo"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte{9}, val)
+		})
+	}
+}
+
+func TestCompactUnknownDatabase(t *testing.T) {
+	commit := NewCommitStoreFromTree(MockCommitStore().tree)
+	if err := commit.Compact(); !errors.ErrState.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
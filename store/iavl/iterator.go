@@ -40,6 +40,7 @@ func (i *lazyIterator) Next() ([]byte, []byte, error) {
 	case <-i.stop:
 		return nil, nil, errors.Wrap(errors.ErrIteratorDone, "closed")
 	case data := <-i.read:
+		metrics.Add(metricIteratorSteps, 1)
 		return data.Key, data.Value, nil
 	}
 }
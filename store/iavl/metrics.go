@@ -0,0 +1,72 @@
+package iavl
+
+import (
+	"expvar"
+	"fmt"
+	"time"
+)
+
+// metrics holds statistics of all iavl stores of the process. The statistics
+// are published by the expvar package as "weave_store", for example:
+//
+//   "weave_store": {
+//     "gets": 1204, "sets": 311, "deletes": 2, "iterator_steps": 9840,
+//     "proof_time_us": {"count": 3, "sum": 1210, "le_100": 0, "le_1000": 3, ...},
+//     "commit_time_us": {"count": 12, "sum": 90311, "le_10000": 12, ...}
+//   }
+//
+// Histogram buckets are not cumulative. A "le_X" bucket counts observations
+// greater than the previous bound and not greater than X.
+var metrics = expvar.NewMap("weave_store")
+
+// Names of the published counters.
+const (
+	metricGets          = "gets"
+	metricSets          = "sets"
+	metricDeletes       = "deletes"
+	metricIteratorSteps = "iterator_steps"
+	metricCompactions   = "compactions"
+)
+
+var (
+	proofTime   = newHistogram("proof_time_us", 100, 1000, 10000, 100000)
+	commitTime  = newHistogram("commit_time_us", 1000, 10000, 100000, 1000000)
+	compactTime = newHistogram("compact_time_us", 1000000, 10000000, 100000000, 1000000000)
+)
+
+// histogram counts observations in buckets of given upper bounds.
+type histogram struct {
+	m      *expvar.Map
+	bounds []int64
+	names  []string
+}
+
+func newHistogram(name string, bounds ...int64) *histogram {
+	h := &histogram{
+		m:      new(expvar.Map).Init(),
+		bounds: bounds,
+	}
+	for _, b := range bounds {
+		h.names = append(h.names, fmt.Sprintf("le_%d", b))
+	}
+	h.names = append(h.names, "le_inf")
+	metrics.Set(name, h.m)
+	return h
+}
+
+// observeSince records the time elapsed since start in microseconds.
+func (h *histogram) observeSince(start time.Time) {
+	h.observe(int64(time.Since(start) / time.Microsecond))
+}
+
+func (h *histogram) observe(value int64) {
+	h.m.Add("count", 1)
+	h.m.Add("sum", value)
+	for i, b := range h.bounds {
+		if value <= b {
+			h.m.Add(h.names[i], 1)
+			return
+		}
+	}
+	h.m.Add(h.names[len(h.names)-1], 1)
+}
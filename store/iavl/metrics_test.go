@@ -0,0 +1,80 @@
+package iavl
+
+import (
+	"expvar"
+	"testing"
+
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	before := readMetrics()
+
+	commit := MockCommitStore()
+	db := commit.Adapter()
+	assert.Nil(t, db.Set([]byte("alice"), []byte("1")))
+	assert.Nil(t, db.Set([]byte("bob"), []byte("2")))
+	assert.Nil(t, db.Delete([]byte("bob")))
+	_, err := db.Get([]byte("alice"))
+	assert.Nil(t, err)
+
+	it, err := db.Iterator(nil, nil)
+	assert.Nil(t, err)
+	_, _, err = it.Next()
+	assert.Nil(t, err)
+	it.Release()
+
+	_, err = commit.Commit()
+	assert.Nil(t, err)
+	_, _, err = commit.GetWithProof([]byte("alice"))
+	assert.Nil(t, err)
+
+	after := readMetrics()
+	want := map[string]int64{
+		metricGets:          1,
+		metricSets:          2,
+		metricDeletes:       1,
+		metricIteratorSteps: 1,
+		"proof_time_us":     1,
+		"commit_time_us":    1,
+	}
+	for name, diff := range want {
+		if got := after[name] - before[name]; got != diff {
+			t.Errorf("want %q to grow by %d, got %d", name, diff, got)
+		}
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	h := newHistogram("test_histogram", 10, 100)
+	for _, v := range []int64{1, 10, 11, 500} {
+		h.observe(v)
+	}
+	want := map[string]int64{
+		"count":  4,
+		"sum":    522,
+		"le_10":  2,
+		"le_100": 1,
+		"le_inf": 1,
+	}
+	for name, value := range want {
+		assert.Equal(t, value, h.m.Get(name).(*expvar.Int).Value())
+	}
+}
+
+// readMetrics returns the current value of all counters and the number of
+// observations of all histograms.
+func readMetrics() map[string]int64 {
+	values := make(map[string]int64)
+	metrics.Do(func(kv expvar.KeyValue) {
+		switch v := kv.Value.(type) {
+		case *expvar.Int:
+			values[kv.Key] = v.Value()
+		case *expvar.Map:
+			if c, ok := v.Get("count").(*expvar.Int); ok {
+				values[kv.Key] = c.Value()
+			}
+		}
+	})
+	return values
+}
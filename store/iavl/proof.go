@@ -0,0 +1,118 @@
+package iavl
+
+import (
+	"bytes"
+
+	amino "github.com/tendermint/go-amino"
+	"github.com/tendermint/iavl"
+	"github.com/tendermint/tendermint/crypto/merkle"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// ProofOpIAVLRange is the type of the proof operation returned by
+// CommitStore.GetRangeWithProof. It contains an amino encoded iavl range
+// proof.
+const ProofOpIAVLRange = "weave:iavl:range"
+
+// proofCodec is used to serialize range proofs.
+var proofCodec = amino.NewCodec()
+
+// proofRuntime knows how to decode and verify proofs returned by
+// CommitStore.GetWithProof.
+var proofRuntime = newProofRuntime()
+
+func newProofRuntime() *merkle.ProofRuntime {
+	prt := merkle.NewProofRuntime()
+	prt.RegisterOpDecoder(iavl.ProofOpIAVLValue, iavl.IAVLValueOpDecoder)
+	prt.RegisterOpDecoder(iavl.ProofOpIAVLAbsence, iavl.IAVLAbsenceOpDecoder)
+	return prt
+}
+
+// VerifyProof returns an error if the proof does not prove that given key is
+// set to given value in the state with given app hash. A nil value means that
+// the proof of absence of the key is expected.
+//
+// The app hash of the state at height H is part of the header of the block
+// at height H+1.
+func VerifyProof(proof *merkle.Proof, appHash, key, value []byte) error {
+	if proof == nil {
+		return errors.Wrap(errors.ErrEmpty, "proof")
+	}
+	keypath := merkle.KeyPath{}.AppendKey(key, merkle.KeyEncodingURL).String()
+	var err error
+	if value == nil {
+		err = proofRuntime.VerifyAbsence(proof, appHash, keypath)
+	} else {
+		err = proofRuntime.VerifyValue(proof, appHash, keypath, value)
+	}
+	if err != nil {
+		return errors.Wrap(errors.ErrUnauthorized, err.Error())
+	}
+	return nil
+}
+
+// VerifyRangeProof returns an error if the proof does not prove that given
+// keys and values are all the entries with a key in range [start, end) in the
+// state with given app hash. A nil end means that the range has no upper
+// limit.
+func VerifyRangeProof(proof *merkle.Proof, appHash, start, end []byte, keys, values [][]byte) error {
+	if proof == nil {
+		return errors.Wrap(errors.ErrEmpty, "proof")
+	}
+	if len(proof.Ops) != 1 || proof.Ops[0].Type != ProofOpIAVLRange {
+		return errors.Wrap(errors.ErrInput, "not a range proof")
+	}
+	if len(keys) != len(values) {
+		return errors.Wrap(errors.ErrInput, "keys and values do not match")
+	}
+	var rp iavl.RangeProof
+	if err := proofCodec.UnmarshalBinaryBare(proof.Ops[0].Data, &rp); err != nil {
+		return errors.Wrap(errors.ErrInput, "cannot decode proof")
+	}
+	if err := rp.Verify(appHash); err != nil {
+		return errors.Wrap(errors.ErrUnauthorized, err.Error())
+	}
+
+	// Proven keys are contiguous. All proven keys that are in the range
+	// must be returned.
+	var (
+		inRange  [][]byte
+		afterEnd bool
+	)
+	for _, k := range rp.Keys() {
+		switch {
+		case bytes.Compare(k, start) < 0:
+		case end != nil && bytes.Compare(k, end) >= 0:
+			afterEnd = true
+		default:
+			inRange = append(inRange, k)
+		}
+	}
+	if len(inRange) != len(keys) {
+		return errors.Wrap(errors.ErrUnauthorized, "proof does not match the result")
+	}
+	for i, k := range keys {
+		if !bytes.Equal(k, inRange[i]) {
+			return errors.Wrap(errors.ErrUnauthorized, "proof does not match the result")
+		}
+		if err := rp.VerifyItem(k, values[i]); err != nil {
+			return errors.Wrap(errors.ErrUnauthorized, err.Error())
+		}
+	}
+
+	// Make sure that the proven keys cover both ends of the range.
+	if len(keys) == 0 || !bytes.Equal(keys[0], start) {
+		if err := rp.VerifyAbsence(append([]byte{}, start...)); err != nil {
+			return errors.Wrapf(errors.ErrUnauthorized, "range start: %s", err)
+		}
+	}
+	if !afterEnd {
+		proven := rp.Keys()
+		after := append(append([]byte{}, proven[len(proven)-1]...), 0)
+		if err := rp.VerifyAbsence(after); err != nil {
+			return errors.Wrapf(errors.ErrUnauthorized, "range end: %s", err)
+		}
+	}
+	return nil
+}
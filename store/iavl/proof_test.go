@@ -0,0 +1,171 @@
+package iavl
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGetWithProof(t *testing.T) {
+	commit, close := makeCommitStore()
+	defer close()
+
+	db := commit.CacheWrap()
+	assert.Nil(t, db.Set([]byte("alice"), []byte("1")))
+	assert.Nil(t, db.Set([]byte("bob"), []byte("2")))
+	assert.Nil(t, db.Set([]byte("carol"), []byte("3")))
+	assert.Nil(t, db.Write())
+	id, err := commit.Commit()
+	assert.Nil(t, err)
+
+	val, proof, err := commit.GetWithProof([]byte("bob"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("2"), val)
+	assert.Nil(t, VerifyProof(proof, id.Hash, []byte("bob"), []byte("2")))
+	if err := VerifyProof(proof, id.Hash, []byte("bob"), []byte("3")); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("modified value must not be proven: %+v", err)
+	}
+	if err := VerifyProof(proof, id.Hash, []byte("bob"), nil); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("proof of presence must not prove absence: %+v", err)
+	}
+	if err := VerifyProof(proof, id.Hash, []byte("alice"), []byte("2")); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("proof must not be valid for another key: %+v", err)
+	}
+
+	val, proof, err = commit.GetWithProof([]byte("bobby"))
+	assert.Nil(t, err)
+	if val != nil {
+		t.Fatalf("unexpected value: %q", val)
+	}
+	assert.Nil(t, VerifyProof(proof, id.Hash, []byte("bobby"), nil))
+	if err := VerifyProof(proof, id.Hash, []byte("bobby"), []byte("2")); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("proof of absence must not prove a value: %+v", err)
+	}
+
+	// Proof must be verified against the app hash of the same state.
+	db = commit.CacheWrap()
+	assert.Nil(t, db.Set([]byte("dave"), []byte("4")))
+	assert.Nil(t, db.Write())
+	next, err := commit.Commit()
+	assert.Nil(t, err)
+	if err := VerifyProof(proof, next.Hash, []byte("bobby"), nil); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("proof must not be valid for another state: %+v", err)
+	}
+
+	if err := VerifyProof(nil, id.Hash, []byte("bob"), []byte("2")); !errors.ErrEmpty.Is(err) {
+		t.Fatalf("unexpected error for a missing proof: %+v", err)
+	}
+}
+
+func TestGetRangeWithProof(t *testing.T) {
+	commit := MockCommitStore()
+	db := commit.CacheWrap()
+	for _, k := range []string{"a", "b1", "b2", "b3", "c", "d"} {
+		assert.Nil(t, db.Set([]byte(k), []byte("value-"+k)))
+	}
+	assert.Nil(t, db.Write())
+	id, err := commit.Commit()
+	assert.Nil(t, err)
+
+	cases := map[string]struct {
+		Start    []byte
+		End      []byte
+		WantKeys []string
+	}{
+		"range in the middle": {
+			Start:    []byte("b"),
+			End:      []byte("c"),
+			WantKeys: []string{"b1", "b2", "b3"},
+		},
+		"range starting with an existing key": {
+			Start:    []byte("b2"),
+			End:      []byte("d"),
+			WantKeys: []string{"b2", "b3", "c"},
+		},
+		"range without an upper limit": {
+			Start:    []byte("c"),
+			WantKeys: []string{"c", "d"},
+		},
+		"whole state": {
+			WantKeys: []string{"a", "b1", "b2", "b3", "c", "d"},
+		},
+		"empty range": {
+			Start: []byte("b4"),
+			End:   []byte("b9"),
+		},
+		"empty range after all keys": {
+			Start: []byte("e"),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			keys, values, proof, err := commit.GetRangeWithProof(tc.Start, tc.End)
+			assert.Nil(t, err)
+			if len(keys) != len(tc.WantKeys) {
+				t.Fatalf("want %q keys, got %q", tc.WantKeys, keys)
+			}
+			for i, k := range tc.WantKeys {
+				assert.Equal(t, []byte(k), keys[i])
+				assert.Equal(t, []byte("value-"+k), values[i])
+			}
+			assert.Nil(t, VerifyRangeProof(proof, id.Hash, tc.Start, tc.End, keys, values))
+
+			if len(keys) != 0 {
+				if err := VerifyRangeProof(proof, id.Hash, tc.Start, tc.End, keys[1:], values[1:]); !errors.ErrUnauthorized.Is(err) {
+					t.Fatalf("omitted value must not be proven: %+v", err)
+				}
+				modified := append([][]byte{[]byte("modified")}, values[1:]...)
+				if err := VerifyRangeProof(proof, id.Hash, tc.Start, tc.End, keys, modified); !errors.ErrUnauthorized.Is(err) {
+					t.Fatalf("modified value must not be proven: %+v", err)
+				}
+			}
+			// A wider range than the proven one must not be accepted.
+			if err := VerifyRangeProof(proof, id.Hash, nil, nil, keys, values); len(keys) != 6 && !errors.ErrUnauthorized.Is(err) {
+				t.Fatalf("wider range must not be proven: %+v", err)
+			}
+		})
+	}
+
+	if err := VerifyRangeProof(nil, id.Hash, nil, nil, nil, nil); !errors.ErrEmpty.Is(err) {
+		t.Fatalf("unexpected error for a missing proof: %+v", err)
+	}
+	_, keyProof, err := commit.GetWithProof([]byte("a"))
+	assert.Nil(t, err)
+	if err := VerifyRangeProof(keyProof, id.Hash, nil, nil, nil, nil); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error for a key proof: %+v", err)
+	}
+}
+
+func TestReadVersion(t *testing.T) {
+	commit, err := NewCommitStoreWithOptions("", "base", Options{Backend: MemDBBackend, History: 2})
+	assert.Nil(t, err)
+
+	var hashes [][]byte
+	for i := 1; i <= 4; i++ {
+		assert.Nil(t, commit.Adapter().Set([]byte("counter"), []byte{byte(i)}))
+		id, err := commit.Commit()
+		assert.Nil(t, err)
+		hashes = append(hashes, id.Hash)
+	}
+
+	for _, version := range []int64{1, 2, 5} {
+		if _, err := commit.ReadVersion(version); !errors.ErrNotFound.Is(err) {
+			t.Fatalf("version %d must not be available: %+v", version, err)
+		}
+	}
+
+	for _, version := range []int64{3, 4} {
+		db, err := commit.ReadVersion(version)
+		assert.Nil(t, err)
+		val, err := db.Get([]byte("counter"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{byte(version)}, val)
+
+		_, proof, err := db.(store.ProvableKVStore).GetWithProof([]byte("counter"))
+		assert.Nil(t, err)
+		assert.Nil(t, VerifyProof(proof, hashes[version-1], []byte("counter"), []byte{byte(version)}))
+	}
+}
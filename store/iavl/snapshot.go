@@ -0,0 +1,165 @@
+package iavl
+
+import (
+	"bytes"
+
+	"github.com/tendermint/iavl"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+)
+
+// ExportSnapshot exports the state at given version as a sequence of chunks.
+// Each chunk contains up to chunkSize key value pairs in ascending key order
+// together with a range proof that can be verified against the root hash of
+// that version. Chunks are passed to fn one by one. Exporting the same
+// version with the same chunk size always produces the same chunks.
+//
+// An empty state is exported as no chunks.
+func (s CommitStore) ExportSnapshot(version int64, chunkSize int, fn func(*Chunk) error) error {
+	if chunkSize <= 0 {
+		return errors.Wrap(errors.ErrInput, "chunk size must be greater than zero")
+	}
+	tree, err := s.tree.GetImmutable(version)
+	if err != nil {
+		return errors.Wrapf(errors.ErrNotFound, "version %d: %s", version, err)
+	}
+
+	size := int64(chunkSize)
+	for index := int64(0); index*size < tree.Size(); index++ {
+		start, _ := tree.GetByIndex(index * size)
+		// Limit is one more than the chunk size, so that the proof
+		// covers the first key of the next chunk as well.
+		keys, values, proof, err := tree.GetRangeWithProof(start, nil, chunkSize+1)
+		if err != nil {
+			return errors.Wrap(errors.ErrDatabase, err.Error())
+		}
+		rawProof, err := proofCodec.MarshalBinaryBare(proof)
+		if err != nil {
+			return errors.Wrap(errors.ErrDatabase, err.Error())
+		}
+		chunk := Chunk{
+			Version: version,
+			Index:   index,
+			Proof:   rawProof,
+		}
+		for i := 0; i < len(keys) && i < chunkSize; i++ {
+			chunk.Pairs = append(chunk.Pairs, &Pair{Key: keys[i], Value: values[i]})
+		}
+		if err := fn(&chunk); err != nil {
+			return errors.Wrapf(err, "chunk %d", index)
+		}
+	}
+	return nil
+}
+
+// SnapshotImporter verifies chunks created by ExportSnapshot and writes their
+// content into a commit store.
+//
+// Because the root hash of an iavl tree depends on the versions in which its
+// nodes were created, the state committed by the importer has the same
+// content, but not the same root hash as the exported one. Only the imported
+// data is guaranteed to match the root hash given to the importer.
+type SnapshotImporter struct {
+	commit  CommitStore
+	root    []byte
+	version int64
+
+	// next is the index of the next expected chunk.
+	next int64
+	// nextKey is the first key that the next chunk must start with.
+	nextKey []byte
+	// done is set once the last chunk of the snapshot was imported.
+	done bool
+}
+
+// NewSnapshotImporter returns an importer that writes a snapshot of the state
+// at given version and root hash into this store. The store must be empty.
+func (s CommitStore) NewSnapshotImporter(version int64, root []byte) (*SnapshotImporter, error) {
+	if s.tree.Version() != 0 || s.tree.Size() != 0 {
+		return nil, errors.Wrap(errors.ErrState, "store is not empty")
+	}
+	im := &SnapshotImporter{
+		commit:  s,
+		root:    root,
+		version: version,
+		// An empty state is exported as no chunks.
+		done: len(root) == 0,
+	}
+	return im, nil
+}
+
+// Add verifies given chunk and writes its content into the store. Chunks must
+// be added in the order they were exported.
+func (im *SnapshotImporter) Add(c *Chunk) error {
+	if im.done {
+		return errors.Wrap(errors.ErrState, "snapshot is complete")
+	}
+	if c.Version != im.version {
+		return errors.Wrapf(errors.ErrInput, "chunk version %d, expected %d", c.Version, im.version)
+	}
+	if c.Index != im.next {
+		return errors.Wrapf(errors.ErrInput, "chunk %d, expected %d", c.Index, im.next)
+	}
+	if len(c.Pairs) == 0 {
+		return errors.Wrap(errors.ErrEmpty, "pairs")
+	}
+
+	var proof iavl.RangeProof
+	if err := proofCodec.UnmarshalBinaryBare(c.Proof, &proof); err != nil {
+		return errors.Wrap(errors.ErrInput, "cannot decode proof")
+	}
+	if err := proof.Verify(im.root); err != nil {
+		return errors.Wrap(errors.ErrUnauthorized, err.Error())
+	}
+
+	keys := proof.Keys()
+	if len(keys) != len(c.Pairs) && len(keys) != len(c.Pairs)+1 {
+		return errors.Wrap(errors.ErrUnauthorized, "proof does not match pairs")
+	}
+	for i, p := range c.Pairs {
+		if !bytes.Equal(keys[i], p.Key) {
+			return errors.Wrapf(errors.ErrUnauthorized, "proof does not match pair %d key", i)
+		}
+		if err := proof.VerifyItem(p.Key, p.Value); err != nil {
+			return errors.Wrapf(errors.ErrUnauthorized, "pair %d: %s", i, err)
+		}
+	}
+
+	// Make sure no key was omitted before this chunk.
+	if c.Index == 0 {
+		if err := proof.VerifyAbsence([]byte{}); err != nil {
+			return errors.Wrapf(errors.ErrUnauthorized, "not the first chunk: %s", err)
+		}
+	} else if !bytes.Equal(c.Pairs[0].Key, im.nextKey) {
+		return errors.Wrap(errors.ErrUnauthorized, "chunk does not follow the previous one")
+	}
+
+	// Either the proof covers the beginning of the next chunk or this is
+	// the last chunk and no key follows.
+	if len(keys) > len(c.Pairs) {
+		im.nextKey = keys[len(keys)-1]
+	} else {
+		last := c.Pairs[len(c.Pairs)-1].Key
+		after := append(append([]byte{}, last...), 0)
+		if err := proof.VerifyAbsence(after); err != nil {
+			return errors.Wrapf(errors.ErrUnauthorized, "not the last chunk: %s", err)
+		}
+		im.done = true
+	}
+
+	for _, p := range c.Pairs {
+		im.commit.tree.Set(p.Key, p.Value)
+	}
+	im.next++
+	return nil
+}
+
+// Commit persists the imported state. It fails unless all chunks of the
+// snapshot were added.
+func (im *SnapshotImporter) Commit() (store.CommitID, error) {
+	if !im.done {
+		return store.CommitID{}, errors.Wrapf(errors.ErrState, "snapshot is incomplete, expected chunk %d", im.next)
+	}
+	return im.commit.Commit()
+}
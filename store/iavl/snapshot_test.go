@@ -0,0 +1,173 @@
+package iavl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	source := MockCommitStore()
+	db := source.CacheWrap()
+	for i := 0; i < 7; i++ {
+		assert.Nil(t, db.Set([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i))))
+	}
+	assert.Nil(t, db.Write())
+	id, err := source.Commit()
+	assert.Nil(t, err)
+
+	// Modifications after the exported version must not be exported.
+	db = source.CacheWrap()
+	assert.Nil(t, db.Set([]byte("key-1"), []byte("modified")))
+	assert.Nil(t, db.Set([]byte("key-9"), []byte("added")))
+	assert.Nil(t, db.Write())
+	_, err = source.Commit()
+	assert.Nil(t, err)
+
+	for _, chunkSize := range []int{1, 2, 3, 7, 100} {
+		t.Run(fmt.Sprintf("chunk size %d", chunkSize), func(t *testing.T) {
+			chunks := exportChunks(t, source, id.Version, chunkSize)
+			wantChunks := (7 + chunkSize - 1) / chunkSize
+			if len(chunks) != wantChunks {
+				t.Fatalf("want %d chunks, got %d", wantChunks, len(chunks))
+			}
+
+			dest := MockCommitStore()
+			im, err := dest.NewSnapshotImporter(id.Version, id.Hash)
+			assert.Nil(t, err)
+			for _, c := range chunks {
+				assert.Nil(t, im.Add(c))
+			}
+			_, err = im.Commit()
+			assert.Nil(t, err)
+
+			for i := 0; i < 7; i++ {
+				val, err := dest.Get([]byte(fmt.Sprintf("key-%d", i)))
+				assert.Nil(t, err)
+				assert.Equal(t, []byte(fmt.Sprintf("value-%d", i)), val)
+			}
+			val, err := dest.Get([]byte("key-9"))
+			assert.Nil(t, err)
+			if val != nil {
+				t.Fatalf("unexpected value: %q", val)
+			}
+		})
+	}
+}
+
+func TestSnapshotImportVerification(t *testing.T) {
+	source := MockCommitStore()
+	db := source.CacheWrap()
+	for i := 0; i < 6; i++ {
+		assert.Nil(t, db.Set([]byte(fmt.Sprintf("key-%d", i)), []byte(fmt.Sprintf("value-%d", i))))
+	}
+	assert.Nil(t, db.Write())
+	id, err := source.Commit()
+	assert.Nil(t, err)
+
+	cases := map[string]struct {
+		Root       []byte
+		Tamper     func(chunks []*Chunk) []*Chunk
+		WantAddErr *errors.Error
+		WantErr    *errors.Error
+	}{
+		"valid snapshot": {
+			Root: id.Hash,
+		},
+		"wrong root hash": {
+			Root:       []byte("a very different root hash ..."),
+			WantAddErr: errors.ErrUnauthorized,
+		},
+		"modified value": {
+			Root: id.Hash,
+			Tamper: func(chunks []*Chunk) []*Chunk {
+				chunks[1].Pairs[0].Value = []byte("modified")
+				return chunks
+			},
+			WantAddErr: errors.ErrUnauthorized,
+		},
+		"omitted pair": {
+			Root: id.Hash,
+			Tamper: func(chunks []*Chunk) []*Chunk {
+				chunks[1].Pairs = chunks[1].Pairs[1:]
+				return chunks
+			},
+			WantAddErr: errors.ErrUnauthorized,
+		},
+		"missing chunk": {
+			Root: id.Hash,
+			Tamper: func(chunks []*Chunk) []*Chunk {
+				return append(chunks[:1], chunks[2:]...)
+			},
+			WantAddErr: errors.ErrInput,
+		},
+		"missing last chunk": {
+			Root: id.Hash,
+			Tamper: func(chunks []*Chunk) []*Chunk {
+				return chunks[:2]
+			},
+			WantErr: errors.ErrState,
+		},
+		"wrong version": {
+			Root: id.Hash,
+			Tamper: func(chunks []*Chunk) []*Chunk {
+				chunks[0].Version++
+				return chunks
+			},
+			WantAddErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			chunks := exportChunks(t, source, id.Version, 2)
+			if tc.Tamper != nil {
+				chunks = tc.Tamper(chunks)
+			}
+
+			dest := MockCommitStore()
+			im, err := dest.NewSnapshotImporter(id.Version, tc.Root)
+			assert.Nil(t, err)
+			for _, c := range chunks {
+				if err := im.Add(c); err != nil {
+					if !tc.WantAddErr.Is(err) {
+						t.Fatalf("unexpected add error: %+v", err)
+					}
+					return
+				}
+			}
+			if tc.WantAddErr != nil {
+				t.Fatalf("want %s add error", tc.WantAddErr)
+			}
+			if _, err := im.Commit(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected commit error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestSnapshotImportIntoNonEmptyStore(t *testing.T) {
+	commit := MockCommitStore()
+	db := commit.CacheWrap()
+	assert.Nil(t, db.Set([]byte("key"), []byte("value")))
+	assert.Nil(t, db.Write())
+	id, err := commit.Commit()
+	assert.Nil(t, err)
+
+	if _, err := commit.NewSnapshotImporter(id.Version, id.Hash); !errors.ErrState.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+func exportChunks(t testing.TB, commit CommitStore, version int64, chunkSize int) []*Chunk {
+	t.Helper()
+	var chunks []*Chunk
+	err := commit.ExportSnapshot(version, chunkSize, func(c *Chunk) error {
+		chunks = append(chunks, c)
+		return nil
+	})
+	assert.Nil(t, err)
+	return chunks
+}
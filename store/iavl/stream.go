@@ -0,0 +1,283 @@
+package iavl
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/iov-one/weave/errors"
+)
+
+// ChangeListener is notified about all changes of the state committed in each
+// version. It can be used to mirror the state in an external service.
+type ChangeListener interface {
+	// StateCommitted is called with the changes of each version, right
+	// before the version is saved. Returning an error aborts the commit.
+	// Because of that, a version can be reported more than once, for
+	// example when the application is restarted after a failure.
+	StateCommitted(*ChangeSet) error
+}
+
+// changeLog collects the final state of all keys modified in the working
+// version. Methods of a nil changeLog do nothing, so that changes are not
+// collected if there is no listener.
+type changeLog struct {
+	dirty map[string]treeChange
+}
+
+// treeChange is the final state of a modified key.
+type treeChange struct {
+	value   []byte
+	deleted bool
+}
+
+func newChangeLog() *changeLog {
+	return &changeLog{dirty: make(map[string]treeChange)}
+}
+
+func (c *changeLog) set(key, value []byte) {
+	if c != nil {
+		c.dirty[string(key)] = treeChange{value: value}
+	}
+}
+
+func (c *changeLog) delete(key []byte) {
+	if c != nil {
+		c.dirty[string(key)] = treeChange{deleted: true}
+	}
+}
+
+func (c *changeLog) reset() {
+	if c != nil {
+		c.dirty = make(map[string]treeChange)
+	}
+}
+
+// changeSet returns all collected changes in ascending key order.
+func (c *changeLog) changeSet(version int64, hash []byte) *ChangeSet {
+	keys := make([]string, 0, len(c.dirty))
+	for k := range c.dirty {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	cs := &ChangeSet{
+		Version: version,
+		Hash:    hash,
+		Changes: make([]*Change, len(keys)),
+	}
+	for i, k := range keys {
+		ch := c.dirty[k]
+		cs.Changes[i] = &Change{Key: []byte(k), Value: ch.value, Deleted: ch.deleted}
+	}
+	return cs
+}
+
+// maxFrameSize is the biggest change set frame accepted by the StreamReader.
+const maxFrameSize = 1 << 30
+
+// StreamWriter is a ChangeListener that writes each change set to a stream as
+// a protobuf message prefixed with its length encoded as uvarint. Use
+// StreamReader to read the stream.
+type StreamWriter struct {
+	w io.Writer
+}
+
+var _ ChangeListener = (*StreamWriter)(nil)
+
+// NewStreamWriter returns a listener that writes change sets to given writer.
+func NewStreamWriter(w io.Writer) *StreamWriter {
+	return &StreamWriter{w: w}
+}
+
+// StateCommitted writes the change set as a single frame.
+func (s *StreamWriter) StateCommitted(cs *ChangeSet) error {
+	frame, err := encodeFrame(cs)
+	if err != nil {
+		return err
+	}
+	if _, err := s.w.Write(frame); err != nil {
+		return errors.Wrap(errors.ErrDatabase, err.Error())
+	}
+	return nil
+}
+
+func encodeFrame(cs *ChangeSet) ([]byte, error) {
+	raw, err := cs.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	frame := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(raw))
+	n := binary.PutUvarint(frame, uint64(len(raw)))
+	return append(frame[:n], raw...), nil
+}
+
+// StreamReader reads change sets written by a StreamWriter.
+type StreamReader struct {
+	r *bufio.Reader
+}
+
+// NewStreamReader returns a reader of the change set stream.
+func NewStreamReader(r io.Reader) *StreamReader {
+	return &StreamReader{r: bufio.NewReader(r)}
+}
+
+// Next returns the next change set of the stream. It returns io.EOF when the
+// stream ends.
+func (s *StreamReader) Next() (*ChangeSet, error) {
+	size, err := binary.ReadUvarint(s.r)
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	if size > maxFrameSize {
+		return nil, errors.Wrapf(errors.ErrInput, "frame of %d bytes is too big", size)
+	}
+	raw := make([]byte, size)
+	if _, err := io.ReadFull(s.r, raw); err != nil {
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	var cs ChangeSet
+	if err := cs.Unmarshal(raw); err != nil {
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	return &cs, nil
+}
+
+// SocketStream is a ChangeListener that serves the change set stream to all
+// clients connected to a socket. Frames are encoded the same way as by the
+// StreamWriter.
+//
+// Streaming to a socket is done on a best effort basis. A client receives
+// only change sets committed while it is connected and a client that cannot
+// keep up with the stream is disconnected. A client must use the version of
+// received change sets to detect gaps and fill them, for example by reading
+// the state with ABCI queries.
+type SocketStream struct {
+	ln net.Listener
+
+	mu      sync.Mutex
+	clients map[net.Conn]chan []byte
+}
+
+var _ ChangeListener = (*SocketStream)(nil)
+
+// socketBuffer is the number of change sets buffered for each client.
+const socketBuffer = 256
+
+// NewSocketStream starts listening for clients on given address. Network must
+// be "tcp" or "unix".
+func NewSocketStream(network, addr string) (*SocketStream, error) {
+	ln, err := net.Listen(network, addr)
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, err.Error())
+	}
+	s := &SocketStream{
+		ln:      ln,
+		clients: make(map[net.Conn]chan []byte),
+	}
+	go s.accept()
+	return s, nil
+}
+
+func (s *SocketStream) accept() {
+	for {
+		conn, err := s.ln.Accept()
+		if err != nil {
+			return
+		}
+		frames := make(chan []byte, socketBuffer)
+		s.mu.Lock()
+		s.clients[conn] = frames
+		s.mu.Unlock()
+		go s.serve(conn, frames)
+	}
+}
+
+func (s *SocketStream) serve(conn net.Conn, frames <-chan []byte) {
+	defer conn.Close()
+	for frame := range frames {
+		if _, err := conn.Write(frame); err != nil {
+			s.drop(conn)
+			return
+		}
+	}
+}
+
+// drop disconnects the client. It must be called without the lock held.
+func (s *SocketStream) drop(conn net.Conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if frames, ok := s.clients[conn]; ok {
+		delete(s.clients, conn)
+		close(frames)
+	}
+}
+
+// StateCommitted sends the change set to all connected clients. It never
+// fails because of a client.
+func (s *SocketStream) StateCommitted(cs *ChangeSet) error {
+	frame, err := encodeFrame(cs)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn, frames := range s.clients {
+		select {
+		case frames <- frame:
+		default:
+			// Client is too slow. Closing the connection
+			// interrupts a pending write.
+			delete(s.clients, conn)
+			close(frames)
+			conn.Close()
+		}
+	}
+	return nil
+}
+
+// Addr returns the address the clients can connect to.
+func (s *SocketStream) Addr() net.Addr {
+	return s.ln.Addr()
+}
+
+// Close stops accepting clients and disconnects all connected clients.
+func (s *SocketStream) Close() error {
+	err := s.ln.Close()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn, frames := range s.clients {
+		delete(s.clients, conn)
+		close(frames)
+		conn.Close()
+	}
+	return err
+}
+
+// OpenChangeStream returns a listener that streams change sets to given
+// target. Target is either a path of a file that the stream is appended to,
+// or a socket address prefixed with tcp:// or unix:// that clients can
+// connect to.
+func OpenChangeStream(target string) (ChangeListener, error) {
+	switch {
+	case strings.HasPrefix(target, "tcp://"):
+		return NewSocketStream("tcp", strings.TrimPrefix(target, "tcp://"))
+	case strings.HasPrefix(target, "unix://"):
+		return NewSocketStream("unix", strings.TrimPrefix(target, "unix://"))
+	case target == "":
+		return nil, errors.Wrap(errors.ErrEmpty, "target")
+	default:
+		fd, err := os.OpenFile(strings.TrimPrefix(target, "file://"), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, errors.Wrap(errors.ErrInput, err.Error())
+		}
+		return NewStreamWriter(fd), nil
+	}
+}
@@ -0,0 +1,146 @@
+package iavl
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestStreamChanges(t *testing.T) {
+	var buf bytes.Buffer
+	commit, err := NewCommitStoreWithOptions("", "base", Options{
+		Backend:  MemDBBackend,
+		Listener: NewStreamWriter(&buf),
+	})
+	assert.Nil(t, err)
+
+	// First version is written directly to the tree.
+	assert.Nil(t, commit.Adapter().Set([]byte("b"), []byte("1")))
+	assert.Nil(t, commit.Adapter().Set([]byte("a"), []byte("1")))
+	id1, err := commit.Commit()
+	assert.Nil(t, err)
+
+	// Second version is written with a batch. Only the final change of
+	// each key is streamed.
+	db := commit.CacheWrap()
+	assert.Nil(t, db.Set([]byte("c"), []byte("1")))
+	assert.Nil(t, db.Set([]byte("c"), []byte("2")))
+	assert.Nil(t, db.Delete([]byte("a")))
+	assert.Nil(t, db.Write())
+	id2, err := commit.Commit()
+	assert.Nil(t, err)
+
+	// Version without changes is streamed as well.
+	id3, err := commit.Commit()
+	assert.Nil(t, err)
+
+	want := []*ChangeSet{
+		{
+			Version: id1.Version,
+			Hash:    id1.Hash,
+			Changes: []*Change{
+				{Key: []byte("a"), Value: []byte("1")},
+				{Key: []byte("b"), Value: []byte("1")},
+			},
+		},
+		{
+			Version: id2.Version,
+			Hash:    id2.Hash,
+			Changes: []*Change{
+				{Key: []byte("a"), Deleted: true},
+				{Key: []byte("c"), Value: []byte("2")},
+			},
+		},
+		{
+			Version: id3.Version,
+			Hash:    id3.Hash,
+			Changes: []*Change{},
+		},
+	}
+
+	r := NewStreamReader(&buf)
+	for i, w := range want {
+		got, err := r.Next()
+		assert.Nil(t, err)
+		if got.Version != w.Version || !bytes.Equal(got.Hash, w.Hash) {
+			t.Fatalf("change set %d: want version %d hash %X, got version %d hash %X",
+				i, w.Version, w.Hash, got.Version, got.Hash)
+		}
+		assert.Equal(t, len(w.Changes), len(got.Changes))
+		for j, c := range w.Changes {
+			assert.Equal(t, c.Key, got.Changes[j].Key)
+			assert.Equal(t, c.Value, got.Changes[j].Value)
+			assert.Equal(t, c.Deleted, got.Changes[j].Deleted)
+		}
+	}
+	if _, err := r.Next(); err != io.EOF {
+		t.Fatalf("want end of the stream, got %+v", err)
+	}
+}
+
+func TestStreamListenerFailure(t *testing.T) {
+	commit, err := NewCommitStoreWithOptions("", "base", Options{
+		Backend:  MemDBBackend,
+		Listener: NewStreamWriter(failingWriter{}),
+	})
+	assert.Nil(t, err)
+
+	assert.Nil(t, commit.Adapter().Set([]byte("a"), []byte("1")))
+	if _, err := commit.Commit(); !errors.ErrDatabase.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	// Version must not be saved if the listener failed.
+	id, err := commit.LatestVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), id.Version)
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, io.ErrClosedPipe
+}
+
+func TestSocketStream(t *testing.T) {
+	s, err := NewSocketStream("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	defer s.Close()
+
+	conn, err := net.Dial("tcp", s.Addr().String())
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	// Client is registered asynchronously, so keep sending until the
+	// first change set is received.
+	received := make(chan *ChangeSet)
+	go func() {
+		cs, err := NewStreamReader(conn).Next()
+		if err == nil {
+			received <- cs
+		}
+		close(received)
+	}()
+	tick := time.NewTicker(10 * time.Millisecond)
+	defer tick.Stop()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case cs, ok := <-received:
+			if !ok {
+				t.Fatal("cannot read the stream")
+			}
+			assert.Equal(t, int64(7), cs.Version)
+			return
+		case <-tick.C:
+			assert.Nil(t, s.StateCommitted(&ChangeSet{Version: 7}))
+		case <-timeout:
+			t.Fatal("timeout")
+		}
+	}
+}
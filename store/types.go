@@ -29,6 +29,15 @@ type KVCacheWrap = weave.KVCacheWrap
 // CommitKVStore is an alias to interface in root package
 type CommitKVStore = weave.CommitKVStore
 
+// ProvableKVStore is an alias to interface in root package
+type ProvableKVStore = weave.ProvableKVStore
+
+// VersionedKVStore is an alias to interface in root package
+type VersionedKVStore = weave.VersionedKVStore
+
+// CompactableKVStore is an alias to interface in root package
+type CompactableKVStore = weave.CompactableKVStore
+
 // CommitID is an alias to interface in root package
 type CommitID = weave.CommitID
 
@@ -0,0 +1,104 @@
+package weave
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/iov-one/weave/coin"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+// Tags builds a list of tags that are emitted together with a transaction
+// result and used by tendermint to index and search the transaction history.
+//
+// All keys created by a single builder share the same prefix, which should be
+// the name of the extension emitting them. A key is the prefix and the given
+// name joined with a dot, for example "multisig.create". Values are encoded
+// by typed helpers so that all extensions use the same representation of
+// addresses, coins and other entities.
+//
+// Tendermint collapses multiple tags with the same key, so only the last
+// value of a key that was set more than once is indexed.
+type Tags struct {
+	prefix string
+	pairs  []common.KVPair
+}
+
+// NewTags returns a tags builder that prefixes all keys with the given
+// extension name. An empty extension name does not prefix the keys.
+func NewTags(extension string) *Tags {
+	return &Tags{prefix: extension}
+}
+
+func (t *Tags) key(name string) []byte {
+	if t.prefix == "" {
+		return []byte(name)
+	}
+	if name == "" {
+		return []byte(t.prefix)
+	}
+	return []byte(t.prefix + "." + name)
+}
+
+// Bytes tags the raw value. An empty name tags the value with the extension
+// name as the key.
+func (t *Tags) Bytes(name string, value []byte) *Tags {
+	t.pairs = append(t.pairs, common.KVPair{Key: t.key(name), Value: value})
+	return t
+}
+
+// String tags the string value.
+func (t *Tags) String(name, value string) *Tags {
+	return t.Bytes(name, []byte(value))
+}
+
+// ID tags the upper case hex representation of an entity ID.
+func (t *Tags) ID(name string, id []byte) *Tags {
+	return t.String(name, fmt.Sprintf("%X", id))
+}
+
+// Address tags the upper case hex representation of an address.
+func (t *Tags) Address(name string, a Address) *Tags {
+	return t.String(name, a.String())
+}
+
+// Coin tags the human readable representation of a coin, for example
+// "1.5 IOV".
+func (t *Tags) Coin(name string, c coin.Coin) *Tags {
+	return t.String(name, c.String())
+}
+
+// Path tags the path of a message, for example "cash/send".
+func (t *Tags) Path(name string, msg Msg) *Tags {
+	return t.String(name, msg.Path())
+}
+
+// Int tags the decimal representation of an integer.
+func (t *Tags) Int(name string, n int64) *Tags {
+	return t.String(name, strconv.FormatInt(n, 10))
+}
+
+// Uint tags the decimal representation of an unsigned integer.
+func (t *Tags) Uint(name string, n uint64) *Tags {
+	return t.String(name, strconv.FormatUint(n, 10))
+}
+
+// Bool tags either "true" or "false".
+func (t *Tags) Bool(name string, b bool) *Tags {
+	return t.String(name, strconv.FormatBool(b))
+}
+
+// KVPairs returns all tags in the order they were added.
+func (t *Tags) KVPairs() []common.KVPair {
+	return t.pairs
+}
+
+// AddTags appends all tags from the builder to the result.
+func (d *DeliverResult) AddTags(t *Tags) {
+	d.Tags = append(d.Tags, t.KVPairs()...)
+}
+
+// AddTags appends all tags from the builder to the result.
+func (c *CheckResult) AddTags(t *Tags) {
+	c.Tags = append(c.Tags, t.KVPairs()...)
+}
@@ -0,0 +1,82 @@
+package weave
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+type tagsTestMsg struct {
+	Msg
+}
+
+func (tagsTestMsg) Path() string { return "test/tags" }
+
+func TestTags(t *testing.T) {
+	cases := map[string]struct {
+		tags *Tags
+		want map[string]string
+	}{
+		"empty": {
+			tags: NewTags("ext"),
+			want: map[string]string{},
+		},
+		"typed values are prefixed with the extension name": {
+			tags: NewTags("ext").
+				String("str", "a value").
+				ID("id", []byte{0xab, 0x01}).
+				Address("addr", Address{0xde, 0xad}).
+				Coin("coin", coin.NewCoin(1, 500000000, "IOV")).
+				Path("path", tagsTestMsg{}).
+				Int("int", -7).
+				Uint("uint", 42).
+				Bool("bool", true),
+			want: map[string]string{
+				"ext.str":  "a value",
+				"ext.id":   "AB01",
+				"ext.addr": "DEAD",
+				"ext.coin": "1.5 IOV",
+				"ext.path": "test/tags",
+				"ext.int":  "-7",
+				"ext.uint": "42",
+				"ext.bool": "true",
+			},
+		},
+		"empty name uses the extension name as the key": {
+			tags: NewTags("ext").String("", "x"),
+			want: map[string]string{"ext": "x"},
+		},
+		"empty extension does not prefix keys": {
+			tags: NewTags("").Bytes("action", []byte("y")),
+			want: map[string]string{"action": "y"},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			pairs := tc.tags.KVPairs()
+			assert.Equal(t, len(tc.want), len(pairs))
+			for _, p := range pairs {
+				want, ok := tc.want[string(p.Key)]
+				if !ok {
+					t.Fatalf("unexpected tag %q", p.Key)
+				}
+				assert.Equal(t, want, string(p.Value))
+			}
+		})
+	}
+}
+
+func TestResultAddTags(t *testing.T) {
+	tags := NewTags("ext").String("a", "1").String("b", "2")
+
+	var d DeliverResult
+	d.AddTags(tags)
+	assert.Equal(t, 2, len(d.ToABCI().Tags))
+
+	c := NewCheck(10, "ok")
+	c.AddTags(tags)
+	ac := c.ToABCI()
+	assert.Equal(t, 2, len(ac.Tags))
+	assert.Equal(t, "ext.b", string(ac.Tags[1].Key))
+}
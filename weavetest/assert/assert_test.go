@@ -118,3 +118,42 @@ func (t *tmock) Fatalf(s string, args ...interface{}) {
 	t.TB.Logf(s, args...)
 	t.failcalls++
 }
+
+func TestGolden(t *testing.T) {
+	cases := map[string]struct {
+		Name     string
+		Got      string
+		WantFail bool
+	}{
+		"content equal to the golden file": {
+			Name:     "example",
+			Got:      "first line\nsecond line\n",
+			WantFail: false,
+		},
+		"content different than the golden file": {
+			Name:     "example",
+			Got:      "first line\nthird line\n",
+			WantFail: true,
+		},
+		"missing golden file": {
+			Name:     "does-not-exist",
+			Got:      "first line\n",
+			WantFail: true,
+		},
+	}
+
+	if *updateFl {
+		t.Skip("golden files of this test must not be updated")
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			mock := &tmock{TB: t}
+			Golden(mock, tc.Name, []byte(tc.Got))
+			failed := mock.failcalls > 0
+			if tc.WantFail != failed {
+				t.Fatalf("unlexpected failed call state: %d failures", mock.failcalls)
+			}
+		})
+	}
+}
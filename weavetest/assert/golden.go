@@ -0,0 +1,57 @@
+package assert
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+var updateFl = flag.Bool("update", false, "If true, golden files are written with the current results instead of being compared with them.")
+
+// GoldenPath returns the path of the golden file with given name. Golden
+// files are kept in the testdata directory of the tested package.
+func GoldenPath(name string) string {
+	return filepath.Join("testdata", name+".golden")
+}
+
+// Golden fails the test if given value is not equal to the content of the
+// golden file with given name.
+//
+// When tests are run with the -update flag, the golden file is written with
+// given value instead. Review the changes of golden files before committing
+// them.
+func Golden(t Tester, name string, got []byte) {
+	t.Helper()
+
+	path := GoldenPath(name)
+
+	if *updateFl {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("cannot create golden file directory: %s", err)
+		}
+		if err := ioutil.WriteFile(path, got, 0644); err != nil {
+			t.Fatalf("cannot write golden file: %s", err)
+		}
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("cannot read golden file (run tests with -update flag to create it): %s", err)
+	}
+
+	if !bytes.Equal(want, got) {
+		diff := difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(want)),
+			B:        difflib.SplitLines(string(got)),
+			FromFile: "Gold",
+			ToFile:   "Current",
+			Context:  2,
+		}
+		text, _ := difflib.GetUnifiedDiffString(diff)
+		t.Fatalf("result different than %s golden file:\n%s", path, text)
+	}
+}
@@ -0,0 +1,57 @@
+package weavetest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+)
+
+// BenchmarkHandler delivers given transaction b.N times using the handler.
+// Each delivery is done using a separate cache of the database, that is
+// discarded afterwards, so that every run starts from the same state. Populate
+// the database with the data that the handler depends on, before running the
+// benchmark.
+//
+// Alongside the execution time, the gas declared by the handler check is
+// reported as "gas/op" and the execution time per unit of gas as "ns/gas".
+// Comparing "ns/gas" of different handlers allows to verify if gas costs
+// roughly track the real cost of execution.
+func BenchmarkHandler(b *testing.B, h weave.Handler, ctx weave.Context, db weave.CacheableKVStore, tx weave.Tx) {
+	b.Helper()
+
+	check := db.CacheWrap()
+	res, err := h.Check(ctx, check, tx)
+	check.Discard()
+	if err != nil {
+		b.Fatalf("cannot check transaction: %+v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var elapsed time.Duration
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		cache := db.CacheWrap()
+		b.StartTimer()
+
+		start := time.Now()
+		_, err := h.Deliver(ctx, cache, tx)
+		elapsed += time.Since(start)
+
+		b.StopTimer()
+		cache.Discard()
+		if err != nil {
+			b.Fatalf("cannot deliver transaction: %+v", err)
+		}
+		b.StartTimer()
+	}
+	b.StopTimer()
+
+	b.ReportMetric(float64(res.GasAllocated), "gas/op")
+	if res.GasAllocated > 0 {
+		perOp := float64(elapsed.Nanoseconds()) / float64(b.N)
+		b.ReportMetric(perOp/float64(res.GasAllocated), "ns/gas")
+	}
+}
@@ -0,0 +1,42 @@
+package weavetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestBenchmarkHandler(t *testing.T) {
+	cases := map[string]struct {
+		gas        int64
+		wantNsRate bool
+	}{
+		"gas declared": {
+			gas:        100,
+			wantNsRate: true,
+		},
+		"no gas declared": {
+			gas:        0,
+			wantNsRate: false,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			h := &Handler{
+				CheckResult: weave.CheckResult{GasAllocated: tc.gas},
+			}
+			db := store.MemStore()
+			res := testing.Benchmark(func(b *testing.B) {
+				BenchmarkHandler(b, h, context.Background(), db, &Tx{})
+			})
+			assert.Equal(t, true, h.DeliverCallCount() >= res.N)
+			assert.Equal(t, float64(tc.gas), res.Extra["gas/op"])
+			_, ok := res.Extra["ns/gas"]
+			assert.Equal(t, tc.wantNsRate, ok)
+		})
+	}
+}
@@ -0,0 +1,156 @@
+package weavetest
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+)
+
+// Names of the CashController methods, used to describe recorded calls.
+const (
+	CashMoveCoins = "MoveCoins"
+	CashCoinMint  = "CoinMint"
+	CashCoinBurn  = "CoinBurn"
+	CashBalance   = "Balance"
+)
+
+// CashController is a mock implementing cash.Controller interface. It keeps
+// balances in memory and ignores the database it is called with. Every call
+// is recorded.
+//
+// The outcome of calls can be scripted using Outcomes. This allows to test
+// how a handler deals with failures, for example when the second of many
+// transfers fails.
+type CashController struct {
+	// Outcomes are consumed one by one by consecutive calls, in order. A
+	// non nil outcome is returned by the call without executing it. A nil
+	// outcome, or no outcome left, means that the call is executed
+	// normally.
+	Outcomes []error
+	// Calls records all calls made, in order.
+	Calls []CashCall
+
+	balances map[string]coin.Coins
+}
+
+// CashCall describes a single call of the CashController.
+type CashCall struct {
+	// Method is the name of the called method, one of Cash* constants.
+	Method string
+	// Src is the source address of a move, or the address of all other
+	// calls.
+	Src weave.Address
+	// Dest is the destination address of a move.
+	Dest   weave.Address
+	Amount coin.Coin
+	// Err is the error returned by the call.
+	Err error
+}
+
+// NewCashController returns a controller with no funds.
+func NewCashController() *CashController {
+	return &CashController{balances: make(map[string]coin.Coins)}
+}
+
+// SetBalance sets the funds of given address. An address with a balance set
+// is considered to exist, even if it has no funds.
+func (c *CashController) SetBalance(addr weave.Address, coins ...*coin.Coin) {
+	c.balances[addr.String()] = coin.Coins(coins).Clone()
+}
+
+// MoveCoins moves funds between addresses. It fails if the source does not
+// own the amount.
+func (c *CashController) MoveCoins(ctx weave.Context, db weave.KVStore, src, dest weave.Address, amount coin.Coin) error {
+	err := c.outcome()
+	if err == nil {
+		err = c.move(src, dest, amount)
+	}
+	c.Calls = append(c.Calls, CashCall{Method: CashMoveCoins, Src: src, Dest: dest, Amount: amount, Err: err})
+	return err
+}
+
+func (c *CashController) move(src, dest weave.Address, amount coin.Coin) error {
+	if !amount.IsPositive() {
+		return errors.Wrap(errors.ErrAmount, "non-positive amount")
+	}
+	from, err := c.take(src, amount)
+	if err != nil {
+		return err
+	}
+	to, err := c.balances[dest.String()].Clone().Add(amount)
+	if err != nil {
+		return err
+	}
+	c.balances[src.String()] = from
+	c.balances[dest.String()] = to
+	return nil
+}
+
+// take returns the balance of given address without the amount. The stored
+// balance is not modified.
+func (c *CashController) take(addr weave.Address, amount coin.Coin) (coin.Coins, error) {
+	balance := c.balances[addr.String()].Clone()
+	if !balance.Contains(amount) {
+		return nil, errors.Wrap(errors.ErrAmount, "funds not available")
+	}
+	return balance.Subtract(amount)
+}
+
+// CoinMint adds funds to given address. The amount must be positive.
+func (c *CashController) CoinMint(ctx weave.Context, db weave.KVStore, addr weave.Address, amount coin.Coin) error {
+	err := c.outcome()
+	if err == nil && !amount.IsPositive() {
+		err = errors.Wrap(errors.ErrAmount, "non-positive amount")
+	}
+	if err == nil {
+		var balance coin.Coins
+		if balance, err = c.balances[addr.String()].Clone().Add(amount); err == nil {
+			c.balances[addr.String()] = balance
+		}
+	}
+	c.Calls = append(c.Calls, CashCall{Method: CashCoinMint, Src: addr, Amount: amount, Err: err})
+	return err
+}
+
+// CoinBurn removes funds from given address. It fails if the address does
+// not own the amount.
+func (c *CashController) CoinBurn(db weave.KVStore, addr weave.Address, amount coin.Coin) error {
+	err := c.outcome()
+	if err == nil && !amount.IsPositive() {
+		err = errors.Wrap(errors.ErrAmount, "non-positive amount")
+	}
+	if err == nil {
+		var balance coin.Coins
+		if balance, err = c.take(addr, amount); err == nil {
+			c.balances[addr.String()] = balance
+		}
+	}
+	c.Calls = append(c.Calls, CashCall{Method: CashCoinBurn, Src: addr, Amount: amount, Err: err})
+	return err
+}
+
+// Balance returns the funds of given address. Just like the cash controller,
+// it fails with ErrNotFound if the address never owned any funds.
+func (c *CashController) Balance(db weave.KVStore, addr weave.Address) (coin.Coins, error) {
+	err := c.outcome()
+	var balance coin.Coins
+	if err == nil {
+		if b, ok := c.balances[addr.String()]; ok {
+			balance = b.Clone()
+		} else {
+			err = errors.Wrap(errors.ErrNotFound, "no account")
+		}
+	}
+	c.Calls = append(c.Calls, CashCall{Method: CashBalance, Src: addr, Err: err})
+	return balance, err
+}
+
+// outcome returns the next scripted outcome, if any.
+func (c *CashController) outcome() error {
+	if len(c.Outcomes) == 0 {
+		return nil
+	}
+	err := c.Outcomes[0]
+	c.Outcomes = c.Outcomes[1:]
+	return err
+}
@@ -0,0 +1,52 @@
+package weavetest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestCashController(t *testing.T) {
+	ctx := context.Background()
+	alice := weave.Address("alice")
+	bob := weave.Address("bob")
+
+	ctrl := NewCashController()
+	ctrl.SetBalance(alice, coin.NewCoinp(10, 0, "IOV"))
+	ctrl.Outcomes = []error{nil, errors.ErrDatabase}
+
+	assert.Nil(t, ctrl.MoveCoins(ctx, nil, alice, bob, coin.NewCoin(4, 0, "IOV")))
+	if err := ctrl.MoveCoins(ctx, nil, alice, bob, coin.NewCoin(1, 0, "IOV")); !errors.ErrDatabase.Is(err) {
+		t.Fatalf("want scripted error, got %+v", err)
+	}
+	if err := ctrl.MoveCoins(ctx, nil, alice, bob, coin.NewCoin(7, 0, "IOV")); !errors.ErrAmount.Is(err) {
+		t.Fatalf("want insufficient funds error, got %+v", err)
+	}
+	assert.Nil(t, ctrl.CoinMint(ctx, nil, bob, coin.NewCoin(1, 0, "IOV")))
+	assert.Nil(t, ctrl.CoinBurn(nil, alice, coin.NewCoin(6, 0, "IOV")))
+	if _, err := ctrl.Balance(nil, weave.Address("carol")); !errors.ErrNotFound.Is(err) {
+		t.Fatalf("want not found error, got %+v", err)
+	}
+
+	balance, err := ctrl.Balance(nil, alice)
+	assert.Nil(t, err)
+	assert.Equal(t, true, balance.IsEmpty())
+	balance, err = ctrl.Balance(nil, bob)
+	assert.Nil(t, err)
+	assert.Equal(t, true, balance.Equals(coin.Coins{coin.NewCoinp(5, 0, "IOV")}))
+
+	var methods []string
+	for _, c := range ctrl.Calls {
+		methods = append(methods, c.Method)
+	}
+	assert.Equal(t, []string{
+		CashMoveCoins, CashMoveCoins, CashMoveCoins,
+		CashCoinMint, CashCoinBurn,
+		CashBalance, CashBalance, CashBalance,
+	}, methods)
+	assert.Equal(t, errors.ErrDatabase, ctrl.Calls[1].Err)
+}
@@ -0,0 +1,286 @@
+package weavetest
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+// DefaultBlockInterval is the time between two blocks created by the Chain if
+// the block time is not provided.
+const DefaultBlockInterval = 5 * time.Second
+
+// Chain runs an ABCI application block by block, the same way tendermint
+// does. It allows to write scenario tests that submit transactions to the
+// whole application and check the committed state and emitted tags.
+//
+// Unlike the WeaveRunner, the Chain does not fail a test if a transaction is
+// rejected. Transaction results are returned so that both success and
+// failure can be tested.
+type Chain struct {
+	t       testing.TB
+	app     abci.Application
+	chainID string
+	height  int64
+	time    time.Time
+	sign    TxSigner
+}
+
+// TxSigner is called for each transaction before it is submitted to the
+// application. It is expected to attach signatures to the transaction. The
+// format of the transaction and its signatures is application specific.
+type TxSigner func(tx weave.Tx, chainID string) error
+
+// NewChain returns a chain that runs given application. The chain must be
+// initialized using InitChain before creating the first block.
+func NewChain(t testing.TB, app abci.Application, chainID string) *Chain {
+	return &Chain{
+		t:       t,
+		app:     app,
+		chainID: chainID,
+		time:    time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+// WithSigner sets a signer that is called for each submitted transaction.
+func (c *Chain) WithSigner(fn TxSigner) *Chain {
+	c.sign = fn
+	return c
+}
+
+// ChainID returns the chain ID of this chain.
+func (c *Chain) ChainID() string {
+	return c.chainID
+}
+
+// Height returns the height of the last committed block.
+func (c *Chain) Height() int64 {
+	return c.height
+}
+
+// Time returns the time of the last committed block or the genesis time if
+// no block was created.
+func (c *Chain) Time() time.Time {
+	return c.time
+}
+
+// InitChain serializes given genesis to JSON and loads it. It must be called
+// before the first block is created.
+func (c *Chain) InitChain(genesis interface{}) {
+	c.t.Helper()
+
+	if c.height != 0 {
+		c.t.Fatalf("cannot initialize after a block, height=%d", c.height)
+	}
+	raw, err := json.MarshalIndent(genesis, "", "  ")
+	if err != nil {
+		c.t.Fatalf("cannot JSON serialize genesis: %s", err)
+	}
+	c.app.InitChain(abci.RequestInitChain{
+		Time:          c.time,
+		ChainId:       c.chainID,
+		AppStateBytes: raw,
+	})
+}
+
+// Block describes the next block created by the Chain. Zero value fields are
+// set to follow the previous block, that is the next height and the time
+// DefaultBlockInterval after the previous block.
+type Block struct {
+	Height int64
+	Time   time.Time
+	Txs    []weave.Tx
+}
+
+// BlockResult is the outcome of processing a block.
+type BlockResult struct {
+	Height  int64
+	Time    time.Time
+	AppHash []byte
+	// Txs contains a result for each transaction of the block, in the
+	// same order.
+	Txs        []abci.ResponseDeliverTx
+	BeginBlock abci.ResponseBeginBlock
+	EndBlock   abci.ResponseEndBlock
+}
+
+// Err returns the error returned by the application for the transaction with
+// given index or nil if it was successful.
+func (r *BlockResult) Err(i int) error {
+	if r.Txs[i].IsOK() {
+		return nil
+	}
+	return errors.ABCIError(r.Txs[i].Code, r.Txs[i].Log)
+}
+
+// Tags returns all tags emitted while processing the block.
+func (r *BlockResult) Tags() []common.KVPair {
+	tags := append([]common.KVPair{}, r.BeginBlock.Tags...)
+	for _, tx := range r.Txs {
+		tags = append(tags, tx.Tags...)
+	}
+	return append(tags, r.EndBlock.Tags...)
+}
+
+// HasTag returns true if a tag with given key and value was emitted while
+// processing the block.
+func (r *BlockResult) HasTag(key, value []byte) bool {
+	for _, tag := range r.Tags() {
+		if bytes.Equal(tag.Key, key) && bytes.Equal(tag.Value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Commit processes given block and commits the state. Any failure of the
+// application other than a rejected transaction ends the test instantly.
+func (c *Chain) Commit(b Block) *BlockResult {
+	c.t.Helper()
+
+	if b.Height == 0 {
+		b.Height = c.height + 1
+	}
+	if b.Height <= c.height {
+		c.t.Fatalf("block height %d must be greater than %d", b.Height, c.height)
+	}
+	if b.Time.IsZero() {
+		b.Time = c.time.Add(DefaultBlockInterval)
+	}
+	if b.Time.Before(c.time) {
+		c.t.Fatalf("block time %s must not be before %s", b.Time, c.time)
+	}
+
+	res := BlockResult{
+		Height: b.Height,
+		Time:   b.Time,
+		Txs:    make([]abci.ResponseDeliverTx, len(b.Txs)),
+	}
+	res.BeginBlock = c.app.BeginBlock(abci.RequestBeginBlock{
+		Header: abci.Header{
+			ChainID: c.chainID,
+			Height:  b.Height,
+			Time:    b.Time,
+			NumTxs:  int64(len(b.Txs)),
+		},
+	})
+	for i, tx := range b.Txs {
+		res.Txs[i] = c.app.DeliverTx(c.marshal(tx))
+	}
+	res.EndBlock = c.app.EndBlock(abci.RequestEndBlock{Height: b.Height})
+	res.AppHash = c.app.Commit().Data
+
+	c.height = b.Height
+	c.time = b.Time
+	return &res
+}
+
+// Deliver creates the next block that contains given transactions.
+func (c *Chain) Deliver(txs ...weave.Tx) *BlockResult {
+	c.t.Helper()
+	return c.Commit(Block{Txs: txs})
+}
+
+// CheckTx runs the check of given transaction against the last committed
+// state and returns the error returned by the application, if any.
+func (c *Chain) CheckTx(tx weave.Tx) error {
+	c.t.Helper()
+	res := c.app.CheckTx(c.marshal(tx))
+	if res.IsOK() {
+		return nil
+	}
+	return errors.ABCIError(res.Code, res.Log)
+}
+
+func (c *Chain) marshal(tx weave.Tx) []byte {
+	c.t.Helper()
+	if c.sign != nil {
+		if err := c.sign(tx, c.chainID); err != nil {
+			c.t.Fatalf("cannot sign transaction: %+v", err)
+		}
+	}
+	raw, err := tx.Marshal()
+	if err != nil {
+		c.t.Fatalf("cannot marshal transaction: %+v", err)
+	}
+	return raw
+}
+
+// Query runs a query against the last committed state and returns all found
+// models. Path may contain a query modifier, for example "/wallets?prefix".
+// Any failure ends the test instantly.
+func (c *Chain) Query(path string, data []byte) []weave.Model {
+	c.t.Helper()
+
+	res := c.app.Query(abci.RequestQuery{Path: path, Data: data})
+	if res.IsErr() {
+		c.t.Fatalf("query %q failed: %+v", path, errors.ABCIError(res.Code, res.Log))
+	}
+	keys, err := decodeResults(res.Key)
+	if err != nil {
+		c.t.Fatalf("cannot decode query keys: %s", err)
+	}
+	values, err := decodeResults(res.Value)
+	if err != nil {
+		c.t.Fatalf("cannot decode query values: %s", err)
+	}
+	if len(keys) != len(values) {
+		c.t.Fatalf("query returned %d keys and %d values", len(keys), len(values))
+	}
+	models := make([]weave.Model, len(keys))
+	for i := range keys {
+		models[i] = weave.Pair(keys[i], values[i])
+	}
+	return models
+}
+
+// Load queries the model stored under given key and unmarshals it into
+// dest. It returns false if the model does not exist.
+func (c *Chain) Load(path string, key []byte, dest weave.Persistent) bool {
+	c.t.Helper()
+
+	models := c.Query(path, key)
+	switch len(models) {
+	case 0:
+		return false
+	case 1:
+		if err := dest.Unmarshal(models[0].Value); err != nil {
+			c.t.Fatalf("cannot unmarshal %q model: %s", path, err)
+		}
+		return true
+	default:
+		c.t.Fatalf("query %q returned %d models", path, len(models))
+		return false
+	}
+}
+
+// decodeResults decodes the protobuf ResultSet message that query results
+// are serialized with. The app package cannot be used, because its tests
+// depend on this package.
+func decodeResults(raw []byte) ([][]byte, error) {
+	var results [][]byte
+	for len(raw) > 0 {
+		key, n := proto.DecodeVarint(raw)
+		if n == 0 {
+			return nil, errors.Wrap(errors.ErrInput, "invalid field key")
+		}
+		if key != 1<<3|proto.WireBytes {
+			return nil, errors.Wrapf(errors.ErrInput, "unexpected field key %d", key)
+		}
+		raw = raw[n:]
+		size, n := proto.DecodeVarint(raw)
+		if n == 0 || size > uint64(len(raw)-n) {
+			return nil, errors.Wrap(errors.ErrInput, "invalid field length")
+		}
+		results = append(results, raw[n:n+int(size)])
+		raw = raw[n+int(size):]
+	}
+	return results, nil
+}
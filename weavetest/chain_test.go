@@ -0,0 +1,144 @@
+package weavetest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store/iavl"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+func TestChain(t *testing.T) {
+	qr := weave.NewQueryRouter()
+	qr.Register("/blocks", blockQueryHandler{})
+	store := app.NewStoreApp("test", iavl.MockCommitStore(), qr, context.Background())
+	base := app.NewBaseApp(store, decodeTx, blockHandler{}, nil, false)
+	base.WithInit(app.ChainInitializers())
+
+	var signed int
+	chain := NewChain(t, base, "test-chain").WithSigner(func(tx weave.Tx, chainID string) error {
+		assert.Equal(t, "test-chain", chainID)
+		signed++
+		return nil
+	})
+	chain.InitChain(map[string]interface{}{})
+	genesis := chain.Time()
+
+	res := chain.Deliver(&Tx{Msg: &Msg{RoutePath: "test/block"}})
+	assert.Nil(t, res.Err(0))
+	assert.Equal(t, int64(1), res.Height)
+	assert.Equal(t, genesis.Add(DefaultBlockInterval), res.Time)
+	assert.Equal(t, true, res.HasTag([]byte("block"), []byte("1")))
+	assert.Equal(t, 1, signed)
+
+	var saved Msg
+	assert.Equal(t, true, chain.Load("/blocks", []byte("1"), &saved))
+	assert.Equal(t, "test/block", saved.RoutePath)
+
+	blockTime := genesis.Add(time.Hour)
+	res = chain.Commit(Block{
+		Height: 10,
+		Time:   blockTime,
+		Txs: []weave.Tx{
+			&Tx{Msg: &Msg{RoutePath: "test/fail"}},
+			&Tx{Msg: &Msg{RoutePath: "test/block"}},
+		},
+	})
+	if err := res.Err(0); !errors.ErrHuman.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	assert.Nil(t, res.Err(1))
+	assert.Equal(t, true, res.HasTag([]byte("block"), []byte("10")))
+	assert.Equal(t, int64(10), chain.Height())
+	assert.Equal(t, blockTime, chain.Time())
+	assert.Equal(t, 2, len(chain.Query("/blocks?prefix", nil)))
+	assert.Equal(t, false, chain.Load("/blocks", []byte("2"), &saved))
+
+	if err := chain.CheckTx(&Tx{Msg: &Msg{RoutePath: "test/fail"}}); !errors.ErrHuman.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+func decodeTx(raw []byte) (weave.Tx, error) {
+	var tx Tx
+	if err := tx.Unmarshal(raw); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+// blockHandler saves the message of each transaction under the height of
+// the block it was delivered in. It rejects messages with the "test/fail"
+// path.
+type blockHandler struct{}
+
+func (blockHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := blockMsg(tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (blockHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := blockMsg(tx)
+	if err != nil {
+		return nil, err
+	}
+	height, _ := weave.GetHeight(ctx)
+	key := []byte(fmt.Sprint(height))
+	raw, err := msg.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Set(append([]byte("b:"), key...), raw); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{
+		Tags: []common.KVPair{{Key: []byte("block"), Value: key}},
+	}, nil
+}
+
+func blockMsg(tx weave.Tx) (weave.Msg, error) {
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return nil, err
+	}
+	if msg.Path() == "test/fail" {
+		return nil, errors.Wrap(errors.ErrHuman, "rejected")
+	}
+	return msg, nil
+}
+
+// blockQueryHandler returns messages saved by the blockHandler.
+type blockQueryHandler struct{}
+
+func (blockQueryHandler) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod == weave.PrefixQueryMod {
+		iter, err := db.Iterator([]byte("b:"), []byte("b;"))
+		if err != nil {
+			return nil, err
+		}
+		defer iter.Release()
+		var models []weave.Model
+		key, value, err := iter.Next()
+		for err == nil {
+			models = append(models, weave.Pair(key, value))
+			key, value, err = iter.Next()
+		}
+		if !errors.ErrIteratorDone.Is(err) {
+			return nil, err
+		}
+		return models, nil
+	}
+	value, err := db.Get(append([]byte("b:"), data...))
+	if err != nil || value == nil {
+		return nil, err
+	}
+	return []weave.Model{weave.Pair(data, value)}, nil
+}
@@ -0,0 +1,126 @@
+package weavetest
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave/coin"
+)
+
+// CoinGen generates random coins for property based tests. Generated values
+// prefer the edges of the allowed range, so that overflows and sign changes
+// are exercised often.
+//
+// A CoinGen is deterministic. The same seed always produces the same sequence
+// of coins, so that a failure can be reproduced.
+type CoinGen struct {
+	seed int64
+	rnd  *rand.Rand
+	// Tickers used by generated coins. A short list makes it likely that
+	// generated coins are of the same currency and can be combined.
+	Tickers []string
+}
+
+// NewCoinGen returns a generator that uses given seed. Use a zero seed to pick
+// a random one. The seed can be read with the Seed method.
+func NewCoinGen(seed int64) *CoinGen {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &CoinGen{
+		seed:    seed,
+		rnd:     rand.New(rand.NewSource(seed)),
+		Tickers: []string{"DOGE", "ETH", "IOV"},
+	}
+}
+
+// Seed returns the seed of this generator.
+func (g *CoinGen) Seed() int64 {
+	return g.seed
+}
+
+// Coin returns a valid coin of a random value. The value can be zero or
+// negative.
+func (g *CoinGen) Coin() coin.Coin {
+	return g.coin(g.Tickers[g.rnd.Intn(len(g.Tickers))])
+}
+
+// PositiveCoin returns a valid coin of a random value greater than zero.
+func (g *CoinGen) PositiveCoin() coin.Coin {
+	for {
+		c := g.Coin()
+		if c.IsZero() {
+			continue
+		}
+		if !c.IsPositive() {
+			c = c.Negative()
+		}
+		return c
+	}
+}
+
+// Coins returns a valid and normalized set of coins. Each currency is
+// present at most once and no coin is of zero value.
+func (g *CoinGen) Coins() coin.Coins {
+	var cs coin.Coins
+	for _, ticker := range g.Tickers {
+		if g.rnd.Intn(2) == 0 {
+			continue
+		}
+		c := g.coin(ticker)
+		for c.IsZero() {
+			c = g.coin(ticker)
+		}
+		cs = append(cs, &c)
+	}
+	sort.Slice(cs, func(i, j int) bool { return cs[i].Ticker < cs[j].Ticker })
+	return cs
+}
+
+func (g *CoinGen) coin(ticker string) coin.Coin {
+	whole := g.value(coin.MaxInt)
+	fractional := g.value(coin.MaxFrac)
+	// Signs of both parts must match.
+	if (whole < 0 && fractional > 0) || (whole > 0 && fractional < 0) {
+		fractional = -fractional
+	}
+	return coin.NewCoin(whole, fractional, ticker)
+}
+
+// value returns a random value from the [-max, max] range.
+func (g *CoinGen) value(max int64) int64 {
+	switch g.rnd.Intn(8) {
+	case 0:
+		return 0
+	case 1:
+		return 1
+	case 2:
+		return -1
+	case 3:
+		return max
+	case 4:
+		return -max
+	case 5:
+		return g.rnd.Int63n(max+1) - g.rnd.Int63n(max+1)
+	default:
+		// Small values are the most common in practice.
+		return g.rnd.Int63n(1000) - g.rnd.Int63n(1000)
+	}
+}
+
+// CheckCoinProperty calls the property given number of times with the same
+// generator. The test fails if the property returns an error. The error
+// message contains the seed of the generator, so that the failure can be
+// reproduced.
+func CheckCoinProperty(t testing.TB, runs int, property func(g *CoinGen) error) {
+	t.Helper()
+
+	g := NewCoinGen(0)
+	for i := 0; i < runs; i++ {
+		if err := property(g); err != nil {
+			t.Fatalf("seed %d, run %d: %+v", g.Seed(), i, err)
+		}
+	}
+}
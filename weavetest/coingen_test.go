@@ -0,0 +1,29 @@
+package weavetest
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestCoinGen(t *testing.T) {
+	a, b := NewCoinGen(42), NewCoinGen(42)
+	for i := 0; i < 1000; i++ {
+		c := a.Coin()
+		assert.Nil(t, c.Validate())
+		assert.Equal(t, c, b.Coin())
+
+		p := a.PositiveCoin()
+		assert.Nil(t, p.Validate())
+		assert.Equal(t, true, p.IsPositive())
+		assert.Equal(t, p, b.PositiveCoin())
+
+		cs := a.Coins()
+		assert.Nil(t, cs.Validate())
+		normalized, err := coin.NormalizeCoins(cs)
+		assert.Nil(t, err)
+		assert.Equal(t, true, normalized.Equals(cs))
+		assert.Equal(t, cs, b.Coins())
+	}
+}
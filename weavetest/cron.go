@@ -14,18 +14,20 @@ import (
 
 // Cron is a in memory implementation of the ticker and scheduler.
 type Cron struct {
-	Err   error
-	tasks []*crontask
+	Err         error
+	tasks       []*crontask
+	heightTasks []*crontask
 }
 
 type crontask struct {
-	tid   []byte
-	runAt time.Time
-	auth  []weave.Condition
-	msg   weave.Msg
+	tid       []byte
+	runAt     time.Time
+	runHeight int64
+	auth      []weave.Condition
+	msg       weave.Msg
 }
 
-var _ weave.Scheduler = (*Cron)(nil)
+var _ weave.HeightScheduler = (*Cron)(nil)
 var _ weave.Ticker = (*Cron)(nil)
 
 // Schedule implementes weave.Scheduler interface.
@@ -54,6 +56,33 @@ func (c *Cron) Schedule(db weave.KVStore, runAt time.Time, auth []weave.Conditio
 	return tid, nil
 }
 
+// ScheduleAtHeight implementes weave.HeightScheduler interface.
+func (c *Cron) ScheduleAtHeight(db weave.KVStore, height int64, auth []weave.Condition, msg weave.Msg) ([]byte, error) {
+	if c.Err != nil {
+		return nil, c.Err
+	}
+
+	tid := make([]byte, 8)
+	if _, err := rand.Read(tid); err != nil {
+		panic(err)
+	}
+
+	c.heightTasks = append(c.heightTasks, &crontask{
+		tid:       tid,
+		runHeight: height,
+		auth:      auth,
+		msg:       msg,
+	})
+
+	// Keep in order from the lowest to the highest. Those to be executed
+	// first are first.
+	sort.SliceStable(c.heightTasks, func(i, j int) bool {
+		return c.heightTasks[i].runHeight < c.heightTasks[j].runHeight
+	})
+
+	return tid, nil
+}
+
 // Delete implementes weave.Scheduler interface.
 func (c *Cron) Delete(db weave.KVStore, taskID []byte) error {
 	if c.Err != nil {
@@ -66,6 +95,12 @@ func (c *Cron) Delete(db weave.KVStore, taskID []byte) error {
 			return nil
 		}
 	}
+	for i, t := range c.heightTasks {
+		if bytes.Equal(t.tid, taskID) {
+			c.heightTasks = append(c.heightTasks[:i], c.heightTasks[i+1:]...)
+			return nil
+		}
+	}
 	return errors.Wrap(errors.ErrNotFound, "no task")
 }
 
@@ -90,5 +125,21 @@ func (c *Cron) Tick(ctx weave.Context, store weave.CacheableKVStore) weave.TickR
 		}
 	}
 	c.tasks = c.tasks[len(tags):]
+
+	if height, ok := weave.GetHeight(ctx); ok {
+		var due int
+		for _, t := range c.heightTasks {
+			if t.runHeight > height {
+				// Tasks are ordered by execution height.
+				break
+			}
+			tags = append(tags, common.KVPair{
+				Key:   []byte(fmt.Sprintf("task_%d", len(tags))),
+				Value: t.tid,
+			})
+			due++
+		}
+		c.heightTasks = c.heightTasks[due:]
+	}
 	return weave.TickResult{Tags: tags}
 }
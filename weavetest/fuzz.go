@@ -0,0 +1,329 @@
+package weavetest
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// Fuzzer creates randomized instances of protobuf messages. Instances are
+// created by randomizing fields of a valid message, so that most of the
+// message stays valid and the validation or the handler logic deeper in the
+// code is exercised as well.
+//
+// A Fuzzer is deterministic. The same seed always produces the same sequence
+// of messages, so that a failure can be reproduced.
+type Fuzzer struct {
+	seed int64
+	rnd  *rand.Rand
+}
+
+// maxFuzzDepth limits how deep nested messages are generated.
+const maxFuzzDepth = 3
+
+// NewFuzzer returns a fuzzer that uses given seed. Use a zero seed to pick a
+// random one. The seed can be read with the Seed method.
+func NewFuzzer(seed int64) *Fuzzer {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &Fuzzer{
+		seed: seed,
+		rnd:  rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Seed returns the seed of this fuzzer.
+func (f *Fuzzer) Seed() int64 {
+	return f.seed
+}
+
+// Random returns a new instance of the message type with all fields set to
+// random values.
+func (f *Fuzzer) Random(msg weave.Msg) weave.Msg {
+	m := reflect.New(reflect.TypeOf(msg).Elem())
+	f.fill(m.Elem(), 0)
+	return m.Interface().(weave.Msg)
+}
+
+// Mutate returns a copy of the message with a few randomly chosen fields set
+// to random values. The original message is not modified.
+func (f *Fuzzer) Mutate(msg weave.Msg) (weave.Msg, error) {
+	m, err := clone(msg)
+	if err != nil {
+		return nil, err
+	}
+	fields := leafFields(reflect.ValueOf(m).Elem(), nil, 0)
+	if len(fields) == 0 {
+		return m, nil
+	}
+	for n := 1 + f.rnd.Intn(3); n > 0; n-- {
+		f.fill(fields[f.rnd.Intn(len(fields))], 0)
+	}
+	return m, nil
+}
+
+// Corrupt returns an instance of the message decoded from its serialized
+// form with random bytes changed, removed or added. An error is returned if
+// the corrupted data cannot be decoded.
+func (f *Fuzzer) Corrupt(msg weave.Msg) (weave.Msg, error) {
+	raw, err := msg.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal")
+	}
+	for n := 1 + f.rnd.Intn(3); n > 0; n-- {
+		switch i := f.rnd.Intn(len(raw) + 1); f.rnd.Intn(3) {
+		case 0:
+			if i < len(raw) {
+				raw[i] = byte(f.rnd.Intn(256))
+			}
+		case 1:
+			if i < len(raw) {
+				raw = append(raw[:i], raw[i+1:]...)
+			}
+		default:
+			raw = append(raw[:i], append([]byte{byte(f.rnd.Intn(256))}, raw[i:]...)...)
+		}
+	}
+	m := reflect.New(reflect.TypeOf(msg).Elem()).Interface().(weave.Msg)
+	if err := m.Unmarshal(raw); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	return m, nil
+}
+
+// Next returns a mutated or a corrupted version of the message. Corrupted
+// data that cannot be decoded is replaced with a mutated message.
+func (f *Fuzzer) Next(msg weave.Msg) (weave.Msg, error) {
+	if f.rnd.Intn(4) == 0 {
+		if m, err := f.Corrupt(msg); err == nil {
+			return m, nil
+		}
+	}
+	return f.Mutate(msg)
+}
+
+func clone(msg weave.Msg) (weave.Msg, error) {
+	raw, err := msg.Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal")
+	}
+	m := reflect.New(reflect.TypeOf(msg).Elem()).Interface().(weave.Msg)
+	if err := m.Unmarshal(raw); err != nil {
+		return nil, errors.Wrap(err, "unmarshal")
+	}
+	return m, nil
+}
+
+// leafFields returns all fields of the structure that can be set by the
+// fuzzer, including fields of nested structures.
+func leafFields(v reflect.Value, fields []reflect.Value, depth int) []reflect.Value {
+	for i := 0; i < v.NumField(); i++ {
+		if !fuzzable(v.Type().Field(i)) {
+			continue
+		}
+		field := v.Field(i)
+		switch {
+		case field.Kind() == reflect.Struct && depth < maxFuzzDepth:
+			fields = leafFields(field, fields, depth+1)
+		case field.Kind() == reflect.Ptr && !field.IsNil() && field.Elem().Kind() == reflect.Struct && depth < maxFuzzDepth:
+			fields = append(fields, field)
+			fields = leafFields(field.Elem(), fields, depth+1)
+		default:
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// fuzzable returns false for fields that are not part of the serialized
+// message, for example fields generated for the protobuf library internals.
+func fuzzable(f reflect.StructField) bool {
+	return f.PkgPath == "" && !strings.HasPrefix(f.Name, "XXX_")
+}
+
+// fill sets the value to a random one.
+func (f *Fuzzer) fill(v reflect.Value, depth int) {
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(f.rnd.Intn(2) == 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(f.randInt() >> uint(64-v.Type().Bits()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(uint64(f.randInt()) >> uint(64-v.Type().Bits()))
+	case reflect.String:
+		v.SetString(f.randString())
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			v.SetBytes(f.randBytes())
+			return
+		}
+		if depth >= maxFuzzDepth {
+			v.Set(reflect.Zero(v.Type()))
+			return
+		}
+		n := f.rnd.Intn(4)
+		s := reflect.MakeSlice(v.Type(), n, n)
+		for i := 0; i < n; i++ {
+			// Elements are never nil, because protobuf cannot
+			// serialize nil elements of a list.
+			if el := s.Index(i); el.Kind() == reflect.Ptr {
+				el.Set(reflect.New(el.Type().Elem()))
+				f.fill(el.Elem(), depth+1)
+			} else {
+				f.fill(el, depth+1)
+			}
+		}
+		v.Set(s)
+	case reflect.Ptr:
+		if depth >= maxFuzzDepth || f.rnd.Intn(5) == 0 {
+			v.Set(reflect.Zero(v.Type()))
+			return
+		}
+		p := reflect.New(v.Type().Elem())
+		f.fill(p.Elem(), depth+1)
+		v.Set(p)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if fuzzable(v.Type().Field(i)) {
+				f.fill(v.Field(i), depth+1)
+			}
+		}
+	default:
+		// Maps and interfaces (protobuf oneof fields) are not
+		// supported and left unchanged.
+	}
+}
+
+// randInt returns a random 64 bit value, preferring values on the edges of
+// the allowed range. Values are scaled down by the caller by shifting.
+func (f *Fuzzer) randInt() int64 {
+	switch f.rnd.Intn(6) {
+	case 0:
+		return 0
+	case 1:
+		return 1
+	case 2:
+		return -1
+	case 3:
+		return math.MaxInt64
+	case 4:
+		return math.MinInt64
+	default:
+		return int64(f.rnd.Uint64())
+	}
+}
+
+func (f *Fuzzer) randString() string {
+	switch f.rnd.Intn(5) {
+	case 0:
+		return ""
+	case 1:
+		return strings.Repeat("x", 1+f.rnd.Intn(4096))
+	case 2:
+		return "\x00\xff ą"
+	default:
+		return string(f.randBytes())
+	}
+}
+
+func (f *Fuzzer) randBytes() []byte {
+	switch f.rnd.Intn(4) {
+	case 0:
+		return nil
+	case 1:
+		return make([]byte, 1+f.rnd.Intn(4096))
+	default:
+		b := make([]byte, f.rnd.Intn(64))
+		f.rnd.Read(b)
+		return b
+	}
+}
+
+// FuzzValidate validates given number of randomized versions of the message.
+// The test fails if validation panics or returns a different result when
+// called twice for the same message.
+func FuzzValidate(t testing.TB, msg weave.Msg, runs int) {
+	t.Helper()
+
+	f := NewFuzzer(0)
+	for i := 0; i < runs; i++ {
+		m, err := f.Next(msg)
+		if err != nil {
+			t.Fatalf("cannot fuzz %T message: %s", msg, err)
+		}
+		first := safeCall(m.Validate)
+		second := safeCall(m.Validate)
+		if first != second {
+			t.Fatalf("seed %d, run %d: non deterministic validation of %#v: %q and %q", f.Seed(), i, m, first, second)
+		}
+		if strings.HasPrefix(first, "panic") {
+			t.Fatalf("seed %d, run %d: validation of %#v failed: %s", f.Seed(), i, m, first)
+		}
+	}
+}
+
+// FuzzHandler runs given number of randomized versions of the message through
+// the handler. Each message is checked and delivered twice, each time using a
+// separate cache of the database, that is discarded afterwards. The test fails
+// if the handler panics or returns a different result for the same message.
+func FuzzHandler(t testing.TB, h weave.Handler, ctx weave.Context, db weave.CacheableKVStore, msg weave.Msg, runs int) {
+	t.Helper()
+
+	f := NewFuzzer(0)
+	for i := 0; i < runs; i++ {
+		m, err := f.Next(msg)
+		if err != nil {
+			t.Fatalf("cannot fuzz %T message: %s", msg, err)
+		}
+		tx := &Tx{Msg: m}
+		calls := map[string]func(weave.KVStore) error{
+			"check": func(db weave.KVStore) error {
+				_, err := h.Check(ctx, db, tx)
+				return err
+			},
+			"deliver": func(db weave.KVStore) error {
+				res, err := h.Deliver(ctx, db, tx)
+				if err == nil && res == nil {
+					return errors.Wrap(errors.ErrState, "no result")
+				}
+				return err
+			},
+		}
+		for name, call := range calls {
+			var results [2]string
+			for j := range results {
+				cache := db.CacheWrap()
+				results[j] = safeCall(func() error { return call(cache) })
+				cache.Discard()
+			}
+			if results[0] != results[1] {
+				t.Fatalf("seed %d, run %d: non deterministic %s of %#v: %q and %q", f.Seed(), i, name, m, results[0], results[1])
+			}
+			if strings.HasPrefix(results[0], "panic") {
+				t.Fatalf("seed %d, run %d: %s of %#v failed: %s", f.Seed(), i, name, m, results[0])
+			}
+		}
+	}
+}
+
+// safeCall returns the description of the error returned by given function.
+// A panic is recovered and described as well.
+func safeCall(fn func() error) (res string) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = fmt.Sprintf("panic: %v", r)
+		}
+	}()
+	if err := fn(); err != nil {
+		return "error: " + err.Error()
+	}
+	return "ok"
+}
@@ -0,0 +1,94 @@
+package weavetest
+
+import (
+	"encoding/json"
+	"reflect"
+	"runtime"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestFuzzerIsDeterministic(t *testing.T) {
+	msg := &fuzzMsg{Name: "name", Count: 4, Nested: &fuzzMsg{Name: "nested"}}
+	a, b := NewFuzzer(42), NewFuzzer(42)
+	for i := 0; i < 100; i++ {
+		ma, err := a.Next(msg)
+		assert.Nil(t, err)
+		mb, err := b.Next(msg)
+		assert.Nil(t, err)
+		if !reflect.DeepEqual(ma, mb) {
+			t.Fatalf("run %d: different messages %#v and %#v", i, ma, mb)
+		}
+	}
+	assert.Equal(t, &fuzzMsg{Name: "name", Count: 4, Nested: &fuzzMsg{Name: "nested"}}, msg)
+}
+
+func TestFuzzValidate(t *testing.T) {
+	cases := map[string]struct {
+		msg        *fuzzMsg
+		wantFailed bool
+	}{
+		"validation never fails": {
+			msg: &fuzzMsg{Name: "name"},
+		},
+		"validation panics on nil nested message": {
+			msg:        &fuzzMsg{Name: "panic", Nested: &fuzzMsg{}},
+			wantFailed: true,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			rt := &recordingTB{TB: t}
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				FuzzValidate(rt, tc.msg, 200)
+			}()
+			<-done
+			assert.Equal(t, tc.wantFailed, rt.failed)
+		})
+	}
+}
+
+// recordingTB records a test failure instead of failing the test.
+type recordingTB struct {
+	testing.TB
+	failed bool
+}
+
+func (r *recordingTB) Fatalf(format string, args ...interface{}) {
+	r.failed = true
+	runtime.Goexit()
+}
+
+// fuzzMsg is a message serialized with JSON, so that the fuzzer can be
+// tested without a protobuf message declaration.
+type fuzzMsg struct {
+	Name   string
+	Count  int32
+	Data   []byte
+	Nested *fuzzMsg
+}
+
+func (m *fuzzMsg) Path() string {
+	return "test/fuzz"
+}
+
+// Validate panics if the message is named "panic" and the nested message is
+// nil.
+func (m *fuzzMsg) Validate() error {
+	if m.Name == "panic" && m.Nested.Name == "" {
+		return errors.Wrap(errors.ErrEmpty, "nested name")
+	}
+	return nil
+}
+
+func (m *fuzzMsg) Marshal() ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (m *fuzzMsg) Unmarshal(raw []byte) error {
+	return json.Unmarshal(raw, m)
+}
@@ -0,0 +1,411 @@
+/*
+Package tmmock provides a mock of the Tendermint RPC server.
+
+The server understands both JSON-RPC requests and URI requests (for example
+GET /genesis) and can serve event subscriptions over a websocket connection,
+just like a Tendermint node does. Responses are canned and must be registered
+upfront. All requests are recorded, so that a test can inspect what a client
+sent.
+
+This functionality is not part of the weavetest package, because it depends
+on the app package, that is tested using weavetest.
+*/
+package tmmock
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+)
+
+// Server is a mock of the Tendermint RPC server.
+type Server struct {
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	handlers map[string]Handler
+	queries  map[string][]weave.Model
+	requests []Request
+	subs     []*subscription
+}
+
+// Request is a single call received by the server.
+type Request struct {
+	Method string
+	// Params are JSON encoded parameters of the call. Parameters of an
+	// URI request are encoded as a JSON object with string values.
+	Params json.RawMessage
+}
+
+// Handler returns the result of a call with given JSON encoded parameters.
+// The result is JSON serialized into the response. An error is returned to
+// the client as a JSON-RPC error.
+//
+// Tendermint clients decode results using the amino JSON format, where for
+// example int64 values are encoded as strings. Return a json.RawMessage to
+// have full control over the response content.
+type Handler func(params json.RawMessage) (interface{}, error)
+
+type subscription struct {
+	conn  *wsConn
+	id    string
+	query string
+}
+
+// New starts a new mock server. Stop it with the Close method.
+func New() *Server {
+	s := &Server{
+		handlers: make(map[string]Handler),
+		queries:  make(map[string][]weave.Model),
+	}
+	s.Handle("abci_query", s.abciQuery)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/websocket", s.serveWebsocket)
+	mux.HandleFunc("/", s.serveHTTP)
+	s.srv = httptest.NewServer(mux)
+	return s
+}
+
+// URL returns the address of the server. It can be used as the address of a
+// Tendermint node.
+func (s *Server) URL() string {
+	return s.srv.URL
+}
+
+// Close stops the server and closes all websocket connections.
+func (s *Server) Close() {
+	s.mu.Lock()
+	for _, sub := range s.subs {
+		sub.conn.Close()
+	}
+	s.subs = nil
+	s.mu.Unlock()
+
+	s.srv.Close()
+}
+
+// Handle registers a handler for the method. It replaces any previously
+// registered handler of the method.
+func (s *Server) Handle(method string, h Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method] = h
+}
+
+// Respond registers a canned result for the method.
+func (s *Server) Respond(method string, result interface{}) {
+	s.Handle(method, func(json.RawMessage) (interface{}, error) {
+		return result, nil
+	})
+}
+
+// SetQuery registers models returned by an ABCI query with given path and
+// data. An ABCI query that was not registered returns no models.
+func (s *Server) SetQuery(path string, data []byte, models ...weave.Model) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.queries[queryKey(path, data)] = models
+}
+
+func queryKey(path string, data []byte) string {
+	return path + "\x00" + string(data)
+}
+
+func (s *Server) abciQuery(raw json.RawMessage) (interface{}, error) {
+	var params struct {
+		Path string `json:"path"`
+		Data string `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &params); err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "invalid parameters")
+	}
+	data, err := decodeHex(params.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "data")
+	}
+
+	s.mu.Lock()
+	models := s.queries[queryKey(params.Path, data)]
+	s.mu.Unlock()
+
+	keys, err := app.ResultsFromKeys(models).Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "keys")
+	}
+	values, err := app.ResultsFromValues(models).Marshal()
+	if err != nil {
+		return nil, errors.Wrap(err, "values")
+	}
+	return map[string]interface{}{
+		"response": map[string]string{
+			"key":   base64.StdEncoding.EncodeToString(keys),
+			"value": base64.StdEncoding.EncodeToString(values),
+		},
+	}, nil
+}
+
+// decodeHex decodes hex data, as sent by Tendermint clients. URI requests
+// use a 0x prefix.
+func decodeHex(s string) ([]byte, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, errors.Wrap(errors.ErrInput, "invalid hex")
+	}
+	return raw, nil
+}
+
+// Requests returns all requests received by the server, in order.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// rpcRequest is a JSON-RPC request.
+type rpcRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+}
+
+// rpcResponse is a JSON-RPC response.
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    string `json:"data,omitempty"`
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	var req rpcRequest
+	if r.Method == http.MethodPost {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			http.Error(w, "invalid JSON-RPC request", http.StatusBadRequest)
+			return
+		}
+	} else {
+		// An URI request, for example GET /abci_query?path="/"
+		params := make(map[string]string)
+		for name, values := range r.URL.Query() {
+			params[name] = strings.Trim(values[0], `"`)
+		}
+		raw, err := json.Marshal(params)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		req = rpcRequest{
+			ID:     json.RawMessage(`""`),
+			Method: strings.TrimPrefix(r.URL.Path, "/"),
+			Params: raw,
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(s.call(req))
+}
+
+// call records the request and returns the response of the registered
+// handler.
+func (s *Server) call(req rpcRequest) rpcResponse {
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: req.Method, Params: req.Params})
+	h, ok := s.handlers[req.Method]
+	s.mu.Unlock()
+
+	resp := rpcResponse{JSONRPC: "2.0", ID: req.ID}
+	if !ok {
+		resp.Error = &rpcError{Code: -32601, Message: "Method not found"}
+		return resp
+	}
+	result, err := h(req.Params)
+	if err != nil {
+		resp.Error = &rpcError{Code: -32603, Message: "Internal error", Data: err.Error()}
+		return resp
+	}
+	resp.Result = result
+	return resp
+}
+
+func (s *Server) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(*http.Request) bool { return true },
+	}
+	c, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	conn := &wsConn{conn: c}
+	defer s.unsubscribe(conn, "")
+	defer conn.Close()
+
+	for {
+		_, raw, err := c.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req rpcRequest
+		if err := json.Unmarshal(raw, &req); err != nil {
+			continue
+		}
+		var resp rpcResponse
+		switch req.Method {
+		case "subscribe", "unsubscribe", "unsubscribe_all":
+			resp = s.manageSubscription(conn, req)
+		default:
+			resp = s.call(req)
+		}
+		if err := conn.WriteJSON(resp); err != nil {
+			return
+		}
+	}
+}
+
+// manageSubscription records the request and updates subscriptions of the
+// connection.
+func (s *Server) manageSubscription(conn *wsConn, req rpcRequest) rpcResponse {
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Method: req.Method, Params: req.Params})
+	s.mu.Unlock()
+
+	var params struct {
+		Query string `json:"query"`
+	}
+	_ = json.Unmarshal(req.Params, &params)
+
+	switch req.Method {
+	case "subscribe":
+		s.subscribe(conn, rpcID(req.ID), params.Query)
+	case "unsubscribe":
+		s.unsubscribe(conn, params.Query)
+	default:
+		s.unsubscribe(conn, "")
+	}
+	return rpcResponse{JSONRPC: "2.0", ID: req.ID, Result: struct{}{}}
+}
+
+// rpcID returns the JSON-RPC request ID as a string.
+func rpcID(raw json.RawMessage) string {
+	var id string
+	if err := json.Unmarshal(raw, &id); err == nil {
+		return id
+	}
+	return string(raw)
+}
+
+func (s *Server) subscribe(conn *wsConn, id, query string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subs = append(s.subs, &subscription{conn: conn, id: id, query: query})
+}
+
+// unsubscribe removes subscriptions of the connection for given query. An
+// empty query removes all subscriptions of the connection.
+func (s *Server) unsubscribe(conn *wsConn, query string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	subs := s.subs[:0]
+	for _, sub := range s.subs {
+		if sub.conn != conn || (query != "" && sub.query != query) {
+			subs = append(subs, sub)
+		}
+	}
+	s.subs = subs
+}
+
+// Publish sends an event to all clients subscribed to given query. Data is
+// the amino JSON encoded event, for example
+//
+//   {"type": "tendermint/event/NewBlockHeader", "value": {...}}
+//
+// Publish returns the number of subscriptions the event was sent to.
+func (s *Server) Publish(query string, data json.RawMessage) int {
+	s.mu.Lock()
+	var subs []*subscription
+	for _, sub := range s.subs {
+		if sub.query == query {
+			subs = append(subs, sub)
+		}
+	}
+	s.mu.Unlock()
+
+	var sent int
+	for _, sub := range subs {
+		id, err := json.Marshal(sub.id + "#event")
+		if err != nil {
+			continue
+		}
+		resp := rpcResponse{
+			JSONRPC: "2.0",
+			ID:      id,
+			Result: map[string]interface{}{
+				"query": query,
+				"data":  data,
+			},
+		}
+		if err := sub.conn.WriteJSON(resp); err == nil {
+			sent++
+		}
+	}
+	return sent
+}
+
+// WaitSubscribed blocks until a client subscribes to given query or the
+// timeout is reached.
+func (s *Server) WaitSubscribed(query string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		s.mu.Lock()
+		for _, sub := range s.subs {
+			if sub.query == query {
+				s.mu.Unlock()
+				return nil
+			}
+		}
+		s.mu.Unlock()
+
+		if time.Now().After(deadline) {
+			return errors.Wrapf(errors.ErrNotFound, "no subscription for %q", query)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// wsConn is a websocket connection that is safe to write to concurrently.
+type wsConn struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+func (c *wsConn) WriteJSON(v interface{}) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conn.WriteJSON(v)
+}
+
+func (c *wsConn) Close() error {
+	return c.conn.Close()
+}
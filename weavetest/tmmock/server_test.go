@@ -0,0 +1,110 @@
+package tmmock
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/cmd/bnsd/client"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestServerABCIQuery(t *testing.T) {
+	tm := New()
+	defer tm.Close()
+
+	model := weave.Pair([]byte("key"), []byte("value"))
+	tm.SetQuery("/items", []byte("key"), model)
+
+	c := client.NewClient(client.NewHTTPConnection(tm.URL()))
+
+	resp, err := c.AbciQuery("/items", []byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, []weave.Model{model}, resp.Models)
+
+	resp, err = c.AbciQuery("/items", []byte("unknown"))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(resp.Models))
+
+	requests := tm.Requests()
+	assert.Equal(t, 2, len(requests))
+	assert.Equal(t, "abci_query", requests[0].Method)
+}
+
+func TestServerURIRequest(t *testing.T) {
+	tm := New()
+	defer tm.Close()
+
+	tm.Respond("genesis", json.RawMessage(`{"genesis": {"chain_id": "test-chain"}}`))
+
+	resp, err := http.Get(tm.URL() + "/genesis")
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	var payload struct {
+		ID     string
+		Result struct {
+			Genesis struct {
+				ChainID string `json:"chain_id"`
+			}
+		}
+		Error *rpcError
+	}
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&payload))
+	assert.Equal(t, "test-chain", payload.Result.Genesis.ChainID)
+
+	resp, err = http.Get(tm.URL() + `/status?height="4"`)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	raw, err := ioutil.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, true, strings.Contains(string(raw), "Method not found"))
+
+	assert.Equal(t, []Request{
+		{Method: "genesis", Params: json.RawMessage(`{}`)},
+		{Method: "status", Params: json.RawMessage(`{"height":"4"}`)},
+	}, tm.Requests())
+}
+
+func TestServerEvents(t *testing.T) {
+	tm := New()
+	defer tm.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(tm.URL(), "http") + "/websocket"
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	assert.Nil(t, err)
+	defer conn.Close()
+
+	const query = "tm.event='NewBlockHeader'"
+	err = conn.WriteJSON(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      "sub",
+		"method":  "subscribe",
+		"params":  map[string]string{"query": query},
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, tm.WaitSubscribed(query, time.Second))
+
+	var subscribed rpcResponse
+	assert.Nil(t, conn.ReadJSON(&subscribed))
+	assert.Equal(t, `"sub"`, string(subscribed.ID))
+
+	assert.Equal(t, 0, tm.Publish("tm.event='Tx'", json.RawMessage(`{}`)))
+	assert.Equal(t, 1, tm.Publish(query, json.RawMessage(`{"type": "test"}`)))
+
+	var event struct {
+		ID     string
+		Result struct {
+			Query string
+			Data  json.RawMessage
+		}
+	}
+	assert.Nil(t, conn.ReadJSON(&event))
+	assert.Equal(t, "sub#event", event.ID)
+	assert.Equal(t, query, event.Result.Query)
+	assert.Equal(t, `{"type":"test"}`, string(event.Result.Data))
+}
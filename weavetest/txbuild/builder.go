@@ -0,0 +1,227 @@
+/*
+Package txbuild provides a builder of signed transactions for tests.
+
+This functionality is not part of the weavetest package, because it depends on
+the x/sigs and x/cash extensions, that are tested using weavetest.
+*/
+package txbuild
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/sigs"
+)
+
+// Signer is a test key that signs transactions. It tracks the sequence value
+// that its next signature must use.
+type Signer struct {
+	Key crypto.Signer
+	// Seq is the sequence value used by the next signature. It is
+	// incremented each time a transaction signed by this signer is built.
+	Seq int64
+}
+
+// NewSigner returns a signer with a newly generated unique key. Sequence
+// counting starts with zero.
+func NewSigner() *Signer {
+	return &Signer{Key: weavetest.NewKey()}
+}
+
+// Address returns the address of the signer.
+func (s *Signer) Address() weave.Address {
+	return s.Key.PublicKey().Address()
+}
+
+// Condition returns the condition of the signer.
+func (s *Signer) Condition() weave.Condition {
+	return s.Key.PublicKey().Condition()
+}
+
+// Sync sets the sequence value to the one expected by the application with
+// given state.
+func (s *Signer) Sync(db weave.ReadOnlyKVStore) error {
+	seq, err := sigs.NextNonce(db, s.Address())
+	if err != nil {
+		return errors.Wrap(err, "next nonce")
+	}
+	s.Seq = seq
+	return nil
+}
+
+// Builder assembles signed transactions.
+//
+// An application transaction type is used as a template. It is expected to be
+// a protobuf message with a message container oneof field named "Sum", fees
+// field named "Fees" and a list of signatures named "Signatures", just like
+// the bnsd transaction. Multisig contract IDs are stored in the "Multisig"
+// field.
+//
+// Builder can be reused to create many transactions. Each built transaction
+// increments the sequence of all its signers, so that the next transaction
+// signed by them is accepted by the application as well.
+type Builder struct {
+	chainID  string
+	typ      reflect.Type
+	msg      weave.Msg
+	fees     *cash.FeeInfo
+	multisig [][]byte
+	signers  []*Signer
+}
+
+// New returns a builder of transactions for the chain with given ID. Built
+// transactions are of the same type as the template, for example &bnsd.Tx{}.
+// The template is never modified.
+func New(chainID string, template weave.Tx) *Builder {
+	return &Builder{
+		chainID: chainID,
+		typ:     reflect.TypeOf(template).Elem(),
+	}
+}
+
+// Msg sets the message of the transaction. To build a transaction with many
+// messages, use the batch message supported by the application.
+func (b *Builder) Msg(msg weave.Msg) *Builder {
+	b.msg = msg
+	return b
+}
+
+// Fee sets the fee paid by given address.
+func (b *Builder) Fee(payer weave.Address, amount coin.Coin) *Builder {
+	b.fees = &cash.FeeInfo{Payer: payer, Fees: &amount}
+	return b
+}
+
+// Multisig sets multisig contracts used to authenticate the transaction.
+func (b *Builder) Multisig(contractIDs ...[]byte) *Builder {
+	b.multisig = contractIDs
+	return b
+}
+
+// Sign sets the signers of the transaction. Signatures are created in the
+// given order.
+func (b *Builder) Sign(signers ...*Signer) *Builder {
+	b.signers = signers
+	return b
+}
+
+// Build returns a new transaction. Sequence of each signer is incremented
+// only if the transaction was successfully built.
+func (b *Builder) Build() (weave.Tx, error) {
+	ptr := reflect.New(b.typ)
+	tx, ok := ptr.Interface().(signedTx)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrType, "%s is not a signed transaction", ptr.Type())
+	}
+	if b.msg == nil {
+		return nil, errors.Wrap(errors.ErrEmpty, "message")
+	}
+	if err := setMsg(ptr, b.msg); err != nil {
+		return nil, err
+	}
+	if b.fees != nil {
+		if err := setField(ptr, "Fees", reflect.ValueOf(b.fees)); err != nil {
+			return nil, err
+		}
+	}
+	if len(b.multisig) != 0 {
+		if err := setField(ptr, "Multisig", reflect.ValueOf(b.multisig)); err != nil {
+			return nil, err
+		}
+	}
+
+	signatures := make([]*sigs.StdSignature, 0, len(b.signers))
+	for i, s := range b.signers {
+		sig, err := sigs.SignTx(s.Key, tx, b.chainID, s.Seq)
+		if err != nil {
+			return nil, errors.Wrapf(err, "signature %d", i)
+		}
+		signatures = append(signatures, sig)
+	}
+	if len(signatures) != 0 {
+		if err := setField(ptr, "Signatures", reflect.ValueOf(signatures)); err != nil {
+			return nil, err
+		}
+	}
+	for _, s := range b.signers {
+		s.Seq++
+	}
+	return tx, nil
+}
+
+// MustBuild returns a new transaction. Any failure ends the test instantly.
+func (b *Builder) MustBuild(t testing.TB) weave.Tx {
+	t.Helper()
+	tx, err := b.Build()
+	if err != nil {
+		t.Fatalf("cannot build transaction: %+v", err)
+	}
+	return tx
+}
+
+type signedTx interface {
+	weave.Tx
+	sigs.SignedTx
+}
+
+// setField sets the value of the transaction structure field with given
+// name.
+func setField(ptr reflect.Value, name string, value reflect.Value) error {
+	field := ptr.Elem().FieldByName(name)
+	if !field.IsValid() {
+		return errors.Wrapf(errors.ErrType, "%s has no %s field", ptr.Type(), name)
+	}
+	if !value.Type().AssignableTo(field.Type()) {
+		return errors.Wrapf(errors.ErrType, "%s field of %s is of type %s", name, ptr.Type(), field.Type())
+	}
+	field.Set(value)
+	return nil
+}
+
+// setMsg wraps the message with the oneof container type declared for it
+// and sets it as the transaction message. This is the reverse of
+// weave.ExtractMsgFromSum.
+func setMsg(ptr reflect.Value, msg weave.Msg) error {
+	sum := ptr.Elem().FieldByName("Sum")
+	if !sum.IsValid() || sum.Kind() != reflect.Interface {
+		return errors.Wrapf(errors.ErrType, "%s has no message container", ptr.Type())
+	}
+	msgType := reflect.TypeOf(msg)
+	for _, w := range oneofWrappers(ptr) {
+		wt := reflect.TypeOf(w)
+		if wt.Kind() != reflect.Ptr || wt.Elem().Kind() != reflect.Struct || wt.Elem().NumField() != 1 {
+			continue
+		}
+		if wt.Elem().Field(0).Type != msgType || !wt.AssignableTo(sum.Type()) {
+			continue
+		}
+		wrapper := reflect.New(wt.Elem())
+		wrapper.Elem().Field(0).Set(reflect.ValueOf(msg))
+		sum.Set(wrapper)
+		return nil
+	}
+	return errors.Wrapf(errors.ErrType, "%T message is not supported by %s", msg, ptr.Type())
+}
+
+// oneofWrappers returns instances of all oneof container types declared by
+// the protobuf message. Depending on the protobuf library version, they are
+// returned by a different generated method.
+func oneofWrappers(ptr reflect.Value) []interface{} {
+	for _, name := range []string{"XXX_OneofWrappers", "XXX_OneofFuncs"} {
+		m := ptr.MethodByName(name)
+		if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() == 0 {
+			continue
+		}
+		out := m.Call(nil)
+		if wrappers, ok := out[len(out)-1].Interface().([]interface{}); ok {
+			return wrappers
+		}
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package txbuild
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	bnsd "github.com/iov-one/weave/cmd/bnsd/app"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/iov-one/weave/x/sigs"
+)
+
+func TestBuilder(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "sigs")
+
+	alice, bob := NewSigner(), NewSigner()
+	send := &cash.SendMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Source:      alice.Address(),
+		Destination: bob.Address(),
+		Amount:      coin.NewCoinp(1, 0, "IOV"),
+	}
+	b := New("test-chain", &bnsd.Tx{}).
+		Msg(send).
+		Fee(alice.Address(), coin.NewCoin(0, 1, "IOV")).
+		Sign(alice, bob)
+
+	for i := 0; i < 3; i++ {
+		tx := b.MustBuild(t)
+
+		msg, err := tx.GetMsg()
+		assert.Nil(t, err)
+		assert.Equal(t, send, msg)
+		assert.Equal(t, alice.Address(), tx.(*bnsd.Tx).Fees.Payer)
+
+		conds, err := sigs.VerifyTxSignatures(db, tx.(sigs.SignedTx), "test-chain")
+		assert.Nil(t, err)
+		assert.Equal(t, []weave.Condition{alice.Condition(), bob.Condition()}, conds)
+	}
+	assert.Equal(t, int64(3), alice.Seq)
+	assert.Equal(t, int64(3), bob.Seq)
+
+	resynced := &Signer{Key: alice.Key}
+	assert.Nil(t, resynced.Sync(db))
+	assert.Equal(t, int64(3), resynced.Seq)
+}
+
+func TestBuilderErrors(t *testing.T) {
+	cases := map[string]struct {
+		template weave.Tx
+		msg      weave.Msg
+		wantErr  *errors.Error
+	}{
+		"message not supported by the transaction": {
+			template: &bnsd.Tx{},
+			msg:      &weavetest.Msg{RoutePath: "test/msg"},
+			wantErr:  errors.ErrType,
+		},
+		"transaction that cannot be signed": {
+			template: &weavetest.Tx{},
+			msg:      &cash.SendMsg{},
+			wantErr:  errors.ErrType,
+		},
+		"missing message": {
+			template: &bnsd.Tx{},
+			msg:      nil,
+			wantErr:  errors.ErrEmpty,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			signer := NewSigner()
+			_, err := New("test-chain", tc.template).Msg(tc.msg).Sign(signer).Build()
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			assert.Equal(t, int64(0), signer.Seq)
+		})
+	}
+}
@@ -12,13 +12,6 @@ import (
 	"github.com/iov-one/weave/x/cash"
 )
 
-const (
-	// pay swap cost up-front
-	createSwapCost  int64 = 300
-	returnSwapCost  int64 = 0
-	releaseSwapCost int64 = 0
-)
-
 // RegisterRoutes will instantiate and register
 // all handlers in this package
 func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller) {
@@ -44,17 +37,14 @@ type CreateSwapHandler struct {
 
 var _ weave.Handler = CreateSwapHandler{}
 
-// Check does the validation and sets the cost of the transaction
+// Check does the validation.
 func (h CreateSwapHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
 	_, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	res := &weave.CheckResult{
-		GasAllocated: createSwapCost,
-	}
-	return res, nil
+	return &weave.CheckResult{}, nil
 }
 
 // Deliver moves the tokens from sender to the swap account if all conditions are met.
@@ -80,7 +70,7 @@ func (h CreateSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave
 	if _, err := h.bucket.Put(db, key, swap); err != nil {
 		return nil, errors.Wrap(err, "cannot save swap entity")
 	}
-	if err := cash.MoveCoins(db, h.bank, swap.Source, swap.Address, msg.Amount); err != nil {
+	if err := cash.MoveCoins(ctx, db, h.bank, swap.Source, swap.Address, msg.Amount); err != nil {
 		return nil, errors.Wrap(err, "cannot deposit funds")
 	}
 	return &weave.DeliverResult{Data: key}, nil
@@ -116,15 +106,14 @@ type ReleaseSwapHandler struct {
 
 var _ weave.Handler = ReleaseSwapHandler{}
 
-// Check just verifies it is properly formed and returns
-// the cost of executing it
+// Check just verifies it is properly formed.
 func (h ReleaseSwapHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
 	_, _, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &weave.CheckResult{GasAllocated: releaseSwapCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 // Deliver moves the tokens from swap account to the receiver if
@@ -141,7 +130,7 @@ func (h ReleaseSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weav
 	}
 
 	// withdraw the money from swap to destination
-	if err := cash.MoveCoins(db, h.bank, swap.Address, swap.Destination, amount); err != nil {
+	if err := cash.MoveCoins(ctx, db, h.bank, swap.Address, swap.Destination, amount); err != nil {
 		return nil, err
 	}
 
@@ -187,15 +176,14 @@ type ReturnSwapHandler struct {
 
 var _ weave.Handler = ReturnSwapHandler{}
 
-// Check just verifies it is properly formed and returns
-// the cost of executing it.
+// Check just verifies it is properly formed.
 func (h ReturnSwapHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
 	_, _, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &weave.CheckResult{GasAllocated: returnSwapCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 // Deliver moves all the tokens from the swap to the defined sender if
@@ -212,7 +200,7 @@ func (h ReturnSwapHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave
 	}
 
 	// withdraw all coins from swap to the defined "sender"
-	if err := cash.MoveCoins(db, h.bank, swap.Address, swap.Source, available); err != nil {
+	if err := cash.MoveCoins(ctx, db, h.bank, swap.Address, swap.Source, available); err != nil {
 		return nil, err
 	}
 	if err := h.bucket.Delete(db, msg.SwapID); err != nil {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
 	"github.com/tendermint/tendermint/libs/common"
 )
 
@@ -59,6 +60,10 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 
 	msgList, _ := batchMsg.MsgList()
 
+	if mode(batchMsg) == Mode_ContinueOnError {
+		return d.checkEach(ctx, store, tx, msgList, next)
+	}
+
 	checks := make([]*weave.CheckResult, len(msgList))
 	for i, msg := range msgList {
 		checks[i], err = next.Check(ctx, store, &BatchTx{Tx: tx, msg: msg})
@@ -69,6 +74,39 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 	return d.combineChecks(checks)
 }
 
+// checkEach checks all messages, discarding changes of those that failed. The
+// result data contains a ResultList with the outcome of each message.
+func (d Decorator) checkEach(ctx weave.Context, store weave.KVStore, tx weave.Tx, msgList []weave.Msg, next weave.Checker) (*weave.CheckResult, error) {
+	cstore, ok := store.(weave.CacheableKVStore)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "continue on error mode requires a cacheable store")
+	}
+
+	results := make([]Result, len(msgList))
+	var checks []*weave.CheckResult
+	for i, msg := range msgList {
+		cache := cstore.CacheWrap()
+		res, err := next.Check(ctx, cache, &BatchTx{Tx: tx, msg: msg})
+		if err != nil {
+			cache.Discard()
+			results[i].Code, results[i].Log = errors.ABCIInfo(err, false)
+			continue
+		}
+		if err := cache.Write(); err != nil {
+			return nil, errors.Wrap(err, "write cache")
+		}
+		results[i] = Result{Data: res.Data, Log: res.Log}
+		checks = append(checks, res)
+	}
+
+	res, err := d.combineChecks(checks)
+	if err != nil {
+		return nil, err
+	}
+	res.Data, _ = (&ResultList{Results: results}).Marshal()
+	return res, nil
+}
+
 // combines all data bytes as protobuf.
 // joins all log messages with \n
 func (*Decorator) combineChecks(checks []*weave.CheckResult) (*weave.CheckResult, error) {
@@ -122,6 +160,10 @@ func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 
 	msgList, _ := batchMsg.MsgList()
 
+	if mode(batchMsg) == Mode_ContinueOnError {
+		return d.deliverEach(ctx, store, tx, msgList, next)
+	}
+
 	delivers := make([]*weave.DeliverResult, len(msgList))
 	for i, msg := range msgList {
 		delivers[i], err = next.Deliver(ctx, store, &BatchTx{Tx: tx, msg: msg})
@@ -132,6 +174,42 @@ func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 	return d.combineDelivers(delivers)
 }
 
+// deliverEach delivers all messages, discarding changes of those that failed.
+// The result data contains a ResultList with the outcome of each message.
+func (d Decorator) deliverEach(ctx weave.Context, store weave.KVStore, tx weave.Tx, msgList []weave.Msg, next weave.Deliverer) (*weave.DeliverResult, error) {
+	cstore, ok := store.(weave.CacheableKVStore)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "continue on error mode requires a cacheable store")
+	}
+
+	results := make([]Result, len(msgList))
+	var delivers []*weave.DeliverResult
+	for i, msg := range msgList {
+		cache := cstore.CacheWrap()
+		res, err := next.Deliver(ctx, cache, &BatchTx{Tx: tx, msg: msg})
+		if err != nil {
+			cache.Discard()
+			results[i].Code, results[i].Log = errors.ABCIInfo(err, false)
+			continue
+		}
+		if err := cache.Write(); err != nil {
+			return nil, errors.Wrap(err, "write cache")
+		}
+		results[i] = Result{Data: res.Data, Log: res.Log}
+		for _, t := range res.Tags {
+			results[i].Tags = append(results[i].Tags, Tag{Key: t.Key, Value: t.Value})
+		}
+		delivers = append(delivers, res)
+	}
+
+	res, err := d.combineDelivers(delivers)
+	if err != nil {
+		return nil, err
+	}
+	res.Data, _ = (&ResultList{Results: results}).Marshal()
+	return res, nil
+}
+
 // combines all data bytes as protobuf.
 // joins all log messages with \n
 func (*Decorator) combineDelivers(delivers []*weave.DeliverResult) (*weave.DeliverResult, error) {
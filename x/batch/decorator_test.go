@@ -8,6 +8,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/iov-one/weave/x/batch"
@@ -303,3 +304,149 @@ func TestDecorator(t *testing.T) {
 		})
 	}
 }
+
+// modeMsg is a batch message that declares its processing mode.
+type modeMsg struct {
+	mockMsg
+	mode batch.Mode
+}
+
+var _ batch.ModeMsg = (*modeMsg)(nil)
+
+func (m *modeMsg) BatchMode() batch.Mode {
+	return m.mode
+}
+
+// writeMock writes a key for each processed message and fails when
+// processing the message with the index listed in fail.
+type writeMock struct {
+	cnt  int
+	fail map[int]error
+}
+
+func (m *writeMock) process(store weave.KVStore) error {
+	i := m.cnt
+	m.cnt++
+	if err := store.Set([]byte{byte(i)}, []byte("value")); err != nil {
+		return err
+	}
+	return m.fail[i]
+}
+
+func (m *writeMock) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if err := m.process(store); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{Log: "checked", GasAllocated: 1}, nil
+}
+
+func (m *writeMock) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	if err := m.process(store); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{
+		Data:    []byte("data"),
+		Log:     "delivered",
+		GasUsed: 1,
+		Tags:    []common.KVPair{{Key: []byte("key"), Value: []byte("value")}},
+	}, nil
+}
+
+func TestDecoratorContinueOnError(t *testing.T) {
+	specs := map[string]struct {
+		Mode        batch.Mode
+		Fail        map[int]error
+		WantErr     *errors.Error
+		WantKeys    []byte
+		WantNoKeys  []byte
+		WantCodes   []uint32
+		WantGasUsed int64
+	}{
+		"atomic batch fails as a whole": {
+			Mode:       batch.Mode_Atomic,
+			Fail:       map[int]error{1: errors.ErrAmount},
+			WantErr:    errors.ErrAmount,
+			WantKeys:   []byte{0, 1},
+			WantNoKeys: []byte{2},
+		},
+		"failed message changes are discarded": {
+			Mode:        batch.Mode_ContinueOnError,
+			Fail:        map[int]error{1: errors.ErrAmount},
+			WantKeys:    []byte{0, 2},
+			WantNoKeys:  []byte{1},
+			WantCodes:   []uint32{0, errors.ErrAmount.ABCICode(), 0},
+			WantGasUsed: 2,
+		},
+		"all messages failed": {
+			Mode:       batch.Mode_ContinueOnError,
+			Fail:       map[int]error{0: errors.ErrAmount, 1: errors.ErrState, 2: errors.ErrAmount},
+			WantNoKeys: []byte{0, 1, 2},
+			WantCodes:  []uint32{errors.ErrAmount.ABCICode(), errors.ErrState.ABCICode(), errors.ErrAmount.ABCICode()},
+		},
+	}
+
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			decorator := batch.NewDecorator()
+			msg := &modeMsg{mockMsg: mockMsg{list: make([]weave.Msg, 3)}, mode: spec.Mode}
+			tx := &weavetest.Tx{Msg: msg}
+
+			db := store.MemStore()
+			// Atomic mode relies on the savepoint decorator to discard
+			// changes. This test checks only what the batch decorator
+			// writes to the store.
+			res, err := decorator.Deliver(nil, db, tx, &writeMock{fail: spec.Fail})
+			if !spec.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			for _, k := range spec.WantKeys {
+				if ok, err := db.Has([]byte{k}); err != nil || !ok {
+					t.Errorf("key %d must be present: %v", k, err)
+				}
+			}
+			for _, k := range spec.WantNoKeys {
+				if ok, err := db.Has([]byte{k}); err != nil || ok {
+					t.Errorf("key %d must not be present: %v", k, err)
+				}
+			}
+			if spec.WantErr != nil {
+				return
+			}
+
+			assert.Equal(t, spec.WantGasUsed, res.GasUsed)
+			var results batch.ResultList
+			if err := results.Unmarshal(res.Data); err != nil {
+				t.Fatalf("cannot unmarshal results: %s", err)
+			}
+			if len(results.Results) != len(spec.WantCodes) {
+				t.Fatalf("want %d results, got %d", len(spec.WantCodes), len(results.Results))
+			}
+			for i, r := range results.Results {
+				assert.Equal(t, spec.WantCodes[i], r.Code)
+				if r.Code == 0 {
+					assert.Equal(t, "delivered", r.Log)
+					assert.Equal(t, []byte("data"), r.Data)
+					assert.Equal(t, 1, len(r.Tags))
+				} else if r.Log == "" {
+					t.Errorf("failed result %d must have a log", i)
+				}
+			}
+
+			cres, err := decorator.Check(nil, store.MemStore(), tx, &writeMock{fail: spec.Fail})
+			assert.Nil(t, err)
+			if err := results.Unmarshal(cres.Data); err != nil {
+				t.Fatalf("cannot unmarshal check results: %s", err)
+			}
+			assert.Equal(t, len(spec.WantCodes), len(results.Results))
+		})
+	}
+}
+
+func TestValidateMode(t *testing.T) {
+	msg := &modeMsg{mockMsg: mockMsg{list: make([]weave.Msg, 1)}, mode: batch.Mode(42)}
+	if err := batch.Validate(msg); !errors.ErrInput.Is(err) {
+		t.Fatalf("unknown mode must be rejected, got %v", err)
+	}
+	msg.mode = batch.Mode_ContinueOnError
+	assert.Nil(t, batch.Validate(msg))
+}
@@ -10,5 +10,11 @@ Package batch implements batch transactions.
 > are only applied once per transaction, which means that all the "embedded"
 > transactions don't hit the middleware.
 
+By default a batch is atomic. A batch message implementing ModeMsg can declare
+the ContinueOnError mode instead. In this mode all messages are processed and
+changes of each failed message are discarded. The transaction succeeds even if
+some of the messages failed. The result data contains a ResultList with the
+ABCI code, data, log and tags of each message.
+
 */
 package batch
@@ -14,6 +14,22 @@ type Msg interface {
 	MsgList() ([]weave.Msg, error)
 }
 
+// ModeMsg is implemented by a batch message that declares how it is
+// processed. A batch message that does not implement this interface is
+// processed atomically.
+type ModeMsg interface {
+	Msg
+	BatchMode() Mode
+}
+
+// mode returns the processing mode of the batch message.
+func mode(msg Msg) Mode {
+	if m, ok := msg.(ModeMsg); ok {
+		return m.BatchMode()
+	}
+	return Mode_Atomic
+}
+
 func Validate(msg Msg) error {
 	msgs, err := msg.MsgList()
 	if err != nil {
@@ -22,5 +38,8 @@ func Validate(msg Msg) error {
 	if len(msgs) > MaxBatchMessages {
 		return errors.Wrapf(errors.ErrInput, "transaction is too large, max is %d", MaxBatchMessages)
 	}
+	if _, ok := Mode_name[int32(mode(msg))]; !ok {
+		return errors.Wrapf(errors.ErrInput, "unknown mode %d", mode(msg))
+	}
 	return nil
 }
@@ -0,0 +1,66 @@
+package cash
+
+import (
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+)
+
+func init() {
+	migration.MustRegister(1, &Allowance{}, migration.NoModification)
+}
+
+var _ orm.Model = (*Allowance)(nil)
+
+// Validate ensures the allowance is valid.
+func (a *Allowance) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", a.Metadata.Validate())
+	errs = errors.AppendField(errs, "Owner", a.Owner.Validate())
+	errs = errors.AppendField(errs, "Spender", a.Spender.Validate())
+	if a.Owner.Equals(a.Spender) {
+		errs = errors.Append(errs, errors.Field("Spender", errors.ErrInput, "must be different than owner"))
+	}
+	amount := coin.Coins(a.Amount)
+	if amount.IsEmpty() {
+		errs = errors.Append(errs, errors.Field("Amount", errors.ErrAmount, "required"))
+	} else {
+		errs = errors.AppendField(errs, "Amount", amount.Validate())
+		if !amount.IsPositive() {
+			errs = errors.Append(errs, errors.Field("Amount", errors.ErrAmount, "must be positive"))
+		}
+	}
+	return errs
+}
+
+// NewAllowanceBucket returns a bucket for keeping track of allowances given
+// by wallet owners to spenders. Allowance is stored under the key created
+// using AllowanceKey function.
+func NewAllowanceBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("allowance", &Allowance{},
+		orm.WithIndex("spender", idxAllowanceSpender, false),
+	)
+	return migration.NewModelBucket("cash", b)
+}
+
+// AllowanceKey returns the key under which an allowance given by the owner
+// to the spender is stored. Owner address is the key prefix so that all
+// allowances given by a single owner can be queried using a prefix query.
+func AllowanceKey(owner, spender weave.Address) []byte {
+	key := make([]byte, 0, len(owner)+len(spender))
+	key = append(key, owner...)
+	return append(key, spender...)
+}
+
+func idxAllowanceSpender(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	a, ok := obj.Value().(*Allowance)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "can only take index of Allowance")
+	}
+	return a.Spender, nil
+}
@@ -1,10 +1,16 @@
 package cash
 
 import (
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 )
 
+func init() {
+	gconf.Register("cash", &Configuration{})
+}
+
 func (c *Configuration) Validate() error {
 	// owner field is optional... possible to make it immutable
 	if len(c.Owner) != 0 {
@@ -12,6 +18,18 @@ func (c *Configuration) Validate() error {
 			return errors.Wrap(err, "owner address")
 		}
 	}
+	// minter is optional, minting is disabled when not set
+	if len(c.Minter) != 0 {
+		if err := c.Minter.Validate(); err != nil {
+			return errors.Wrap(err, "minter address")
+		}
+	}
+	// freezer is optional, freezing is disabled when not set
+	if len(c.Freezer) != 0 {
+		if err := c.Freezer.Validate(); err != nil {
+			return errors.Wrap(err, "freezer address")
+		}
+	}
 	if len(c.CollectorAddress) == 0 {
 		return errors.Wrap(errors.ErrState, "collector address missing")
 	}
@@ -27,14 +45,58 @@ func (c *Configuration) Validate() error {
 			return errors.Wrap(errors.ErrState, "minimal fee cannot be negative")
 		}
 	}
+
+	tickers := make(map[string]bool, len(c.MinimalSendAmounts))
+	for _, m := range c.MinimalSendAmounts {
+		if err := m.Validate(); err != nil {
+			return errors.Wrap(err, "minimal send amount")
+		}
+		if !m.IsPositive() {
+			return errors.Wrap(errors.ErrState, "minimal send amount must be positive")
+		}
+		if tickers[m.Ticker] {
+			return errors.Wrapf(errors.ErrDuplicate, "minimal send amount for %s", m.Ticker)
+		}
+		tickers[m.Ticker] = true
+	}
 	return nil
 }
 
-func mustLoadConf(db gconf.Store) Configuration {
+// ensureMinimalSend returns an error if the amount is lower than the minimal
+// send amount configured for its currency. No minimum is enforced if the
+// configuration does not exist.
+func ensureMinimalSend(db gconf.ReadStore, amount coin.Coin) error {
 	var conf Configuration
-	if err := gconf.Load(db, "cash", &conf); err != nil {
-		err = errors.Wrap(err, "load configuration")
-		panic(err)
+	switch err := gconf.Load(db, "cash", &conf); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		return nil
+	default:
+		return errors.Wrap(err, "load configuration")
+	}
+	for _, m := range conf.MinimalSendAmounts {
+		if m.Ticker == amount.Ticker && !amount.IsGTE(*m) {
+			return errors.Wrapf(errors.ErrAmount, "minimal send amount is %s", m)
+		}
+	}
+	return nil
+}
+
+// minimalFee returns the minimal transaction fee. No fee is required if the
+// configuration does not exist.
+func minimalFee(db gconf.ReadStore) (coin.Coin, error) {
+	return gconf.Coin(db, "cash", "MinimalFee", coin.Coin{})
+}
+
+// feeCollector returns the address that transaction fees are paid to. Fees
+// cannot be collected if the configuration does not exist.
+func feeCollector(db gconf.ReadStore) (weave.Address, error) {
+	addr, err := gconf.Address(db, "cash", "CollectorAddress", nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(addr) == 0 {
+		return nil, errors.Wrap(errors.ErrState, "fee collector not configured")
 	}
-	return conf
+	return addr, nil
 }
@@ -4,20 +4,29 @@ import (
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
 )
 
 // CoinsMover is an interface for moving coins between accounts.
 type CoinMover interface {
 	// Moving coins must happen from the source to the destination address.
 	// Zero or negative values must result in an error.
-	MoveCoins(store weave.KVStore, src weave.Address, dest weave.Address, amount coin.Coin) error
+	MoveCoins(ctx weave.Context, store weave.KVStore, src weave.Address, dest weave.Address, amount coin.Coin) error
 }
 
 // CoinMinter is an interface to create new coins.
 type CoinMinter interface {
 	// CoinMint increase the number of funds on given account by a
 	// specified amount.
-	CoinMint(weave.KVStore, weave.Address, coin.Coin) error
+	CoinMint(weave.Context, weave.KVStore, weave.Address, coin.Coin) error
+}
+
+// CoinBurner is an interface to destroy existing coins.
+type CoinBurner interface {
+	// CoinBurn decrease the number of funds on given account by a
+	// specified amount. Burned coins are removed from the total supply.
+	// Zero or negative values must result in an error.
+	CoinBurn(weave.KVStore, weave.Address, coin.Coin) error
 }
 
 // Balancer is an interface to query the amount of coins.
@@ -26,18 +35,42 @@ type Balancer interface {
 	Balance(weave.KVStore, weave.Address) (coin.Coins, error)
 }
 
+// MoveObserver is notified about every coin movement done by the controller.
+// It allows other extensions to react to transfers without reimplementing
+// the controller.
+type MoveObserver interface {
+	// OnMove is called after the amount was moved from the source to the
+	// destination address. Returning an error fails the movement and the
+	// whole transaction.
+	OnMove(store weave.KVStore, src weave.Address, dest weave.Address, amount coin.Coin) error
+}
+
+// MoveObserverFunc provides MoveObserver interface support.
+type MoveObserverFunc func(store weave.KVStore, src weave.Address, dest weave.Address, amount coin.Coin) error
+
+// OnMove calls the function.
+func (fn MoveObserverFunc) OnMove(store weave.KVStore, src weave.Address, dest weave.Address, amount coin.Coin) error {
+	return fn(store, src, dest, amount)
+}
+
 // Controller is the functionality needed by cash.Handler and cash.Decorator.
 // BaseController should work plenty fine, but you can add other logic if so
 // desired
 type Controller interface {
 	CoinMover
+	CoinMinter
+	CoinBurner
 	Balancer
 }
 
 // BaseController implements Controller interface, using WalletBucket as the
 // storage engine. Wallet must return something that supports AsSet.
 type BaseController struct {
-	bucket WalletBucket
+	bucket    WalletBucket
+	supply    orm.ModelBucket
+	frozen    orm.ModelBucket
+	vestings  orm.ModelBucket
+	observers []MoveObserver
 }
 
 var _ Controller = BaseController{}
@@ -45,7 +78,22 @@ var _ Controller = BaseController{}
 // NewController returns a base controller implementation.
 func NewController(bucket WalletBucket) BaseController {
 	ValidateWalletBucket(bucket)
-	return BaseController{bucket: bucket}
+	return BaseController{
+		bucket:   bucket,
+		supply:   NewSupplyBucket(),
+		frozen:   NewFrozenAccountBucket(),
+		vestings: NewVestingBucket(),
+	}
+}
+
+// WithObservers returns a copy of the controller that notifies given
+// observers about every coin movement. Observers are called in the order
+// they were registered.
+func (c BaseController) WithObservers(observers ...MoveObserver) BaseController {
+	all := make([]MoveObserver, 0, len(c.observers)+len(observers))
+	all = append(all, c.observers...)
+	c.observers = append(all, observers...)
+	return c
 }
 
 // Balance returns the amount of funds stored under given account address.
@@ -61,9 +109,12 @@ func (c BaseController) Balance(store weave.KVStore, src weave.Address) (coin.Co
 }
 
 // MoveCoins moves the given amount from src to dest.
-// If src doesn't exist, or doesn't have sufficient
-// coins, it fails.
-func (c BaseController) MoveCoins(store weave.KVStore,
+// If src doesn't exist, is frozen or doesn't have sufficient
+// coins that are not locked by a vesting, it fails. If dest doesn't exist,
+// it is created at the block height of the context. All observers are
+// notified about a successful movement and, if the store is provided by
+// TransferTagger, the movement is recorded to be tagged.
+func (c BaseController) MoveCoins(ctx weave.Context, store weave.KVStore,
 	src weave.Address, dest weave.Address, amount coin.Coin) error {
 
 	if amount.IsZero() {
@@ -72,6 +123,9 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 	if !amount.IsPositive() {
 		return errors.Wrapf(errors.ErrAmount, "non-positive SendMsg: %#v", &amount)
 	}
+	if err := ensureNotFrozen(store, c.frozen, src); err != nil {
+		return err
+	}
 
 	// load sender, subtract funds, and save
 	sender, err := c.bucket.Get(store, src)
@@ -84,6 +138,9 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 	if !AsCoins(sender).Contains(amount) {
 		return errors.Wrap(errors.ErrAmount, "funds")
 	}
+	if err := ensureUnlocked(store, c.vestings, src, AsCoins(sender), amount); err != nil {
+		return err
+	}
 	err = Subtract(AsCoinage(sender), amount)
 	if err != nil {
 		return err
@@ -94,7 +151,7 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 	}
 
 	// load/create recipient, add funds, save
-	recipient, err := c.bucket.GetOrCreate(store, dest)
+	recipient, err := c.getOrCreateWallet(ctx, store, dest)
 	if err != nil {
 		return err
 	}
@@ -102,18 +159,51 @@ func (c BaseController) MoveCoins(store weave.KVStore,
 	if err != nil {
 		return err
 	}
-	return c.bucket.Save(store, recipient)
+	if err := c.bucket.Save(store, recipient); err != nil {
+		return err
+	}
+
+	if r, ok := store.(TransferRecorder); ok {
+		r.RecordTransfer(src, dest, amount)
+	}
+
+	for _, o := range c.observers {
+		if err := o.OnMove(store, src, dest, amount); err != nil {
+			return errors.Wrap(err, "move observer")
+		}
+	}
+	return nil
+}
+
+// getOrCreateWallet returns the wallet stored under given address or
+// creates a new one. The block height of the context is set as the creation
+// height of a new wallet.
+func (c BaseController) getOrCreateWallet(ctx weave.Context, store weave.KVStore, addr weave.Address) (orm.Object, error) {
+	obj, err := c.bucket.Get(store, addr)
+	if err != nil || obj != nil {
+		return obj, err
+	}
+	obj, err = c.bucket.GetOrCreate(store, addr)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := obj.Value().(*Set); ok {
+		s.CreationHeight, _ = weave.GetHeight(ctx)
+	}
+	return obj, nil
 }
 
 // CoinMint attempts to add the given amount of coins to
-// the destination address. Fails if it overflows the wallet.
+// the destination address. Fails if it overflows the wallet. If dest doesn't
+// exist, it is created at the block height of the context.
+// Minted coins are added to the total supply.
 //
 // Note the amount may also be negative:
 // "the lord giveth and the lord taketh away"
-func (c BaseController) CoinMint(store weave.KVStore,
+func (c BaseController) CoinMint(ctx weave.Context, store weave.KVStore,
 	dest weave.Address, amount coin.Coin) error {
 
-	recipient, err := c.bucket.GetOrCreate(store, dest)
+	recipient, err := c.getOrCreateWallet(ctx, store, dest)
 	if err != nil {
 		return err
 	}
@@ -121,6 +211,43 @@ func (c BaseController) CoinMint(store weave.KVStore,
 	if err != nil {
 		return err
 	}
+	if err := c.bucket.Save(store, recipient); err != nil {
+		return err
+	}
+	return updateSupply(store, c.supply, amount)
+}
+
+// CoinBurn removes the given amount of coins from the source address and
+// decreases the total supply. Fails if the source is frozen or does not have
+// sufficient coins that are not locked by a vesting.
+func (c BaseController) CoinBurn(store weave.KVStore,
+	src weave.Address, amount coin.Coin) error {
+
+	if !amount.IsPositive() {
+		return errors.Wrapf(errors.ErrAmount, "non-positive burn: %#v", &amount)
+	}
+	if err := ensureNotFrozen(store, c.frozen, src); err != nil {
+		return err
+	}
 
-	return c.bucket.Save(store, recipient)
+	owner, err := c.bucket.Get(store, src)
+	if err != nil {
+		return err
+	}
+	if owner == nil {
+		return errors.Wrapf(errors.ErrEmpty, "empty account %s", src)
+	}
+	if !AsCoins(owner).Contains(amount) {
+		return errors.Wrap(errors.ErrAmount, "funds")
+	}
+	if err := ensureUnlocked(store, c.vestings, src, AsCoins(owner), amount); err != nil {
+		return err
+	}
+	if err := Subtract(AsCoinage(owner), amount); err != nil {
+		return err
+	}
+	if err := c.bucket.Save(store, owner); err != nil {
+		return err
+	}
+	return updateSupply(store, c.supply, amount.Negative())
 }
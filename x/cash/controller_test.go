@@ -1,6 +1,7 @@
 package cash
 
 import (
+	"context"
 	"testing"
 
 	"github.com/iov-one/weave"
@@ -42,6 +43,7 @@ type checkCmd struct {
 }
 
 func TestIssueCoins(t *testing.T) {
+	ctx := context.Background()
 	addr1 := weavetest.NewCondition().Address()
 	addr2 := weavetest.NewCondition().Address()
 
@@ -113,7 +115,7 @@ func TestIssueCoins(t *testing.T) {
 			migration.MustInitPkg(kv, "cash")
 
 			for i, issue := range tc.issue {
-				if err := controller.CoinMint(kv, issue.addr, issue.amount); !issue.wantErr.Is(err) {
+				if err := controller.CoinMint(ctx, kv, issue.addr, issue.amount); !issue.wantErr.Is(err) {
 					t.Fatalf("issue #%d: unexpected error: %+v", i, err)
 				}
 			}
@@ -142,6 +144,7 @@ func TestIssueCoins(t *testing.T) {
 }
 
 func TestMoveCoins(t *testing.T) {
+	ctx := context.Background()
 	addr1 := weavetest.NewCondition().Address()
 	addr2 := weavetest.NewCondition().Address()
 	addr3 := weavetest.NewCondition().Address()
@@ -212,11 +215,11 @@ func TestMoveCoins(t *testing.T) {
 			kv := store.MemStore()
 			migration.MustInitPkg(kv, "cash")
 
-			if err := controller.CoinMint(kv, tc.issue.addr, tc.issue.amount); !tc.issue.wantErr.Is(err) {
+			if err := controller.CoinMint(ctx, kv, tc.issue.addr, tc.issue.amount); !tc.issue.wantErr.Is(err) {
 				t.Fatalf("unexpected coin minting error: %+v", err)
 			}
 
-			if err := controller.MoveCoins(kv, tc.move.sender, tc.move.recipient, tc.move.amount); !tc.move.wantErr.Is(err) {
+			if err := controller.MoveCoins(ctx, kv, tc.move.sender, tc.move.recipient, tc.move.amount); !tc.move.wantErr.Is(err) {
 				t.Fatalf("unexpected coin transfer error: %+v", err)
 			}
 
@@ -243,6 +246,7 @@ func TestMoveCoins(t *testing.T) {
 }
 
 func TestBalance(t *testing.T) {
+	ctx := context.Background()
 	store := store.MemStore()
 	migration.MustInitPkg(store, "cash")
 
@@ -250,17 +254,17 @@ func TestBalance(t *testing.T) {
 
 	addr1 := weavetest.NewCondition().Address()
 	coin1 := coin.NewCoin(1, 20, "BTC")
-	if err := ctrl.CoinMint(store, addr1, coin1); err != nil {
+	if err := ctrl.CoinMint(ctx, store, addr1, coin1); err != nil {
 		t.Fatalf("cannot issue coins: %s", err)
 	}
 
 	addr2 := weavetest.NewCondition().Address()
 	coin2_1 := coin.NewCoin(3, 40, "ETH")
 	coin2_2 := coin.NewCoin(5, 0, "DOGE")
-	if err := ctrl.CoinMint(store, addr2, coin2_1); err != nil {
+	if err := ctrl.CoinMint(ctx, store, addr2, coin2_1); err != nil {
 		t.Fatalf("cannot issue coins: %s", err)
 	}
-	if err := ctrl.CoinMint(store, addr2, coin2_2); err != nil {
+	if err := ctrl.CoinMint(ctx, store, addr2, coin2_2); err != nil {
 		t.Fatalf("cannot issue coins: %s", err)
 	}
 
@@ -299,3 +303,151 @@ func TestBalance(t *testing.T) {
 		})
 	}
 }
+
+func TestBurnCoins(t *testing.T) {
+	ctx := context.Background()
+	addr1 := weavetest.NewCondition().Address()
+	addr2 := weavetest.NewCondition().Address()
+
+	controller := NewController(NewBucket())
+
+	cases := map[string]struct {
+		issue      []issueCmd
+		burnAddr   weave.Address
+		burn       coin.Coin
+		wantErr    *errors.Error
+		wantSupply coin.Coin
+	}{
+		"burn part of the funds": {
+			issue: []issueCmd{
+				{addr: addr1, amount: coin.NewCoin(10, 0, "FOO")},
+				{addr: addr2, amount: coin.NewCoin(5, 0, "FOO")},
+			},
+			burnAddr:   addr1,
+			burn:       coin.NewCoin(3, 0, "FOO"),
+			wantSupply: coin.NewCoin(12, 0, "FOO"),
+		},
+		"burn all funds": {
+			issue: []issueCmd{
+				{addr: addr1, amount: coin.NewCoin(10, 0, "FOO")},
+			},
+			burnAddr:   addr1,
+			burn:       coin.NewCoin(10, 0, "FOO"),
+			wantSupply: coin.NewCoin(0, 0, "FOO"),
+		},
+		"insufficient funds": {
+			issue: []issueCmd{
+				{addr: addr1, amount: coin.NewCoin(10, 0, "FOO")},
+			},
+			burnAddr:   addr1,
+			burn:       coin.NewCoin(11, 0, "FOO"),
+			wantErr:    errors.ErrAmount,
+			wantSupply: coin.NewCoin(10, 0, "FOO"),
+		},
+		"no wallet": {
+			burnAddr:   addr2,
+			burn:       coin.NewCoin(1, 0, "FOO"),
+			wantErr:    errors.ErrEmpty,
+			wantSupply: coin.NewCoin(0, 0, "FOO"),
+		},
+		"zero amount": {
+			issue: []issueCmd{
+				{addr: addr1, amount: coin.NewCoin(10, 0, "FOO")},
+			},
+			burnAddr:   addr1,
+			burn:       coin.NewCoin(0, 0, "FOO"),
+			wantErr:    errors.ErrAmount,
+			wantSupply: coin.NewCoin(10, 0, "FOO"),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+
+			for i, issue := range tc.issue {
+				if err := controller.CoinMint(ctx, kv, issue.addr, issue.amount); err != nil {
+					t.Fatalf("issue #%d: cannot mint: %+v", i, err)
+				}
+			}
+
+			if err := controller.CoinBurn(kv, tc.burnAddr, tc.burn); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			supply, err := TotalSupply(kv, tc.wantSupply.Ticker)
+			if err != nil {
+				t.Fatalf("cannot get supply: %s", err)
+			}
+			if !supply.Equals(tc.wantSupply) {
+				t.Fatalf("want %v supply, got %v", tc.wantSupply, supply)
+			}
+		})
+	}
+}
+
+func TestMoveObserver(t *testing.T) {
+	ctx := context.Background()
+	addr1 := weavetest.NewCondition().Address()
+	addr2 := weavetest.NewCondition().Address()
+
+	type move struct {
+		src    weave.Address
+		dest   weave.Address
+		amount coin.Coin
+	}
+
+	cases := map[string]struct {
+		observerErr error
+		amount      coin.Coin
+		wantErr     *errors.Error
+		wantMoves   int
+	}{
+		"observer is notified about a move": {
+			amount:    coin.NewCoin(3, 0, "FOO"),
+			wantMoves: 1,
+		},
+		"observer is not notified about a failed move": {
+			amount:    coin.NewCoin(30, 0, "FOO"),
+			wantErr:   errors.ErrAmount,
+			wantMoves: 0,
+		},
+		"observer error fails the move": {
+			observerErr: errors.ErrState,
+			amount:      coin.NewCoin(3, 0, "FOO"),
+			wantErr:     errors.ErrState,
+			wantMoves:   1,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+
+			var moves []move
+			observer := MoveObserverFunc(func(db weave.KVStore, src, dest weave.Address, amount coin.Coin) error {
+				moves = append(moves, move{src: src, dest: dest, amount: amount})
+				return tc.observerErr
+			})
+			controller := NewController(NewBucket()).WithObservers(observer)
+
+			if err := controller.CoinMint(ctx, kv, addr1, coin.NewCoin(10, 0, "FOO")); err != nil {
+				t.Fatalf("cannot mint: %+v", err)
+			}
+			if err := controller.MoveCoins(ctx, kv, addr1, addr2, tc.amount); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if len(moves) != tc.wantMoves {
+				t.Fatalf("want %d moves, got %d", tc.wantMoves, len(moves))
+			}
+			for _, m := range moves {
+				if !m.src.Equals(addr1) || !m.dest.Equals(addr2) || !m.amount.Equals(tc.amount) {
+					t.Fatalf("unexpected move: %+v", m)
+				}
+			}
+		})
+	}
+}
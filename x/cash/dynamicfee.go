@@ -27,7 +27,8 @@ can be replaced by
 	cash.NewDynamicFeeDecorator(authFn, ctrl),
 
 As with FeeDecorator, all deducted fees are send to the collector, whose
-address is configured via gconf package.
+address is configured via gconf package. Fees can be paid using a fee grant
+the same way as with FeeDecorator.
 
 */
 
@@ -78,11 +79,11 @@ func (d DynamicFeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx we
 			}
 		} else {
 			cache.Discard()
-			_ = d.chargeMinimalFee(store, payer)
+			_ = d.chargeMinimalFee(ctx, store, payer)
 		}
 	}()
 
-	if err := d.chargeFee(cache, payer, fee); err != nil {
+	if err := d.chargeFee(ctx, cache, payer, fee); err != nil {
 		return nil, errors.Wrap(err, "cannot charge fee")
 	}
 	cres, err = next.Check(ctx, cache, tx)
@@ -116,11 +117,11 @@ func (d DynamicFeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx
 			}
 		} else {
 			cache.Discard()
-			_ = d.chargeMinimalFee(store, payer)
+			_ = d.chargeMinimalFee(ctx, store, payer)
 		}
 	}()
 
-	if err := d.chargeFee(cache, payer, fee); err != nil {
+	if err := d.chargeFee(ctx, cache, payer, fee); err != nil {
 		return nil, errors.Wrap(err, "cannot charge fee")
 	}
 	res, err := next.Deliver(ctx, cache, tx)
@@ -134,29 +135,43 @@ func (d DynamicFeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx
 	return res, nil
 }
 
-func (d DynamicFeeDecorator) chargeFee(store weave.KVStore, src weave.Address, amount coin.Coin) error {
+// chargeFee ensures that the source authorized the payment, either by signing
+// the transaction or by a fee grant, and moves the fee to the collector.
+func (d DynamicFeeDecorator) chargeFee(ctx weave.Context, store weave.KVStore, src weave.Address, amount coin.Coin) error {
+	if !d.auth.HasAddress(ctx, src) {
+		if err := spendFeeGrant(ctx, store, d.auth, src, amount); err != nil {
+			return err
+		}
+	}
 	if amount.IsZero() {
 		return nil
 	}
-	dest := mustLoadConf(store).CollectorAddress
-	return d.ctrl.MoveCoins(store, src, dest, amount)
+	dest, err := feeCollector(store)
+	if err != nil {
+		return err
+	}
+	return d.ctrl.MoveCoins(ctx, store, src, dest, amount)
 }
 
 // chargeMinimalFee deduct an anty span fee from a given account.
-func (d DynamicFeeDecorator) chargeMinimalFee(store weave.KVStore, src weave.Address) error {
-	fee := mustLoadConf(store).MinimalFee
+func (d DynamicFeeDecorator) chargeMinimalFee(ctx weave.Context, store weave.KVStore, src weave.Address) error {
+	fee, err := minimalFee(store)
+	if err != nil {
+		return err
+	}
 	if fee.IsZero() {
 		return nil
 	}
 	if fee.Ticker == "" {
 		return errors.Wrap(errors.ErrHuman, "minimal fee without a ticker")
 	}
-	return d.chargeFee(store, src, fee)
+	return d.chargeFee(ctx, store, src, fee)
 }
 
 // prepare is all shared setup between Check and Deliver. It computes the fee
-// for the transaction, ensures that the payer is authenticated and prepares
-// the database transaction.
+// for the transaction and prepares the database transaction. Payer
+// authentication is done when charging the fee, because using a fee grant
+// modifies the state.
 func (d DynamicFeeDecorator) prepare(ctx weave.Context, store weave.KVStore, tx weave.Tx) (fee coin.Coin, payer weave.Address, cache weave.KVCacheWrap, err error) {
 	finfo, err := d.extractFee(ctx, tx, store)
 	if err != nil {
@@ -168,12 +183,6 @@ func (d DynamicFeeDecorator) prepare(ctx weave.Context, store weave.KVStore, tx
 	}
 	payer = finfo.GetPayer()
 
-	// Verify we have access to the money.
-	if !d.auth.HasAddress(ctx, payer) {
-		err := errors.Wrap(errors.ErrUnauthorized, "fee payer signature missing")
-		return fee, payer, cache, err
-	}
-
 	// Ensure we can execute subtransactions (see check on utils.Savepoint).
 	cstore, ok := store.(weave.CacheableKVStore)
 	if !ok {
@@ -195,7 +204,10 @@ func (d DynamicFeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store we
 
 	txFee := finfo.GetFees()
 	if coin.IsEmpty(txFee) {
-		minFee := mustLoadConf(store).MinimalFee
+		minFee, err := minimalFee(store)
+		if err != nil {
+			return nil, err
+		}
 		if minFee.IsZero() {
 			return finfo, nil
 		}
@@ -206,7 +218,10 @@ func (d DynamicFeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store we
 		return nil, errors.Wrap(err, "invalid fee")
 	}
 
-	minFee := mustLoadConf(store).MinimalFee
+	minFee, err := minimalFee(store)
+	if err != nil {
+		return nil, err
+	}
 	if minFee.IsZero() {
 		return finfo, nil
 	}
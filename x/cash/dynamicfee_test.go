@@ -15,6 +15,7 @@ import (
 )
 
 func TestCacheWriteFail(t *testing.T) {
+	ctx := context.Background()
 	auth := &weavetest.Auth{
 		Signer: weavetest.NewCondition(),
 	}
@@ -30,7 +31,7 @@ func TestCacheWriteFail(t *testing.T) {
 	}
 	bucket := NewBucket()
 	ctrl := NewController(bucket)
-	if err := ctrl.CoinMint(store, auth.Signer.Address(), coin.NewCoin(100, 0, "IOV")); err != nil {
+	if err := ctrl.CoinMint(ctx, store, auth.Signer.Address(), coin.NewCoin(100, 0, "IOV")); err != nil {
 		t.Fatalf("cannot mint: %s", err)
 	}
 
@@ -322,8 +323,14 @@ func ensureWallets(t *testing.T, db weave.KVStore, wallets []orm.Object) {
 func assertCharged(t *testing.T, db weave.KVStore, ctrl Controller, want coin.Coin) {
 	t.Helper()
 
-	minimumFee := mustLoadConf(db).MinimalFee
-	collectorAddr := mustLoadConf(db).CollectorAddress
+	minimumFee, err := minimalFee(db)
+	if err != nil {
+		t.Fatalf("cannot load minimal fee: %s", err)
+	}
+	collectorAddr, err := feeCollector(db)
+	if err != nil {
+		t.Fatalf("cannot load fee collector: %s", err)
+	}
 
 	switch chargedFee, err := ctrl.Balance(db, collectorAddr); {
 	case err == nil:
@@ -0,0 +1,117 @@
+package cash
+
+import (
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x"
+)
+
+func init() {
+	migration.MustRegister(1, &FeeGrant{}, migration.NoModification)
+}
+
+var _ orm.Model = (*FeeGrant)(nil)
+
+// Validate ensures the fee grant is valid.
+func (g *FeeGrant) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", g.Metadata.Validate())
+	errs = errors.AppendField(errs, "Granter", g.Granter.Validate())
+	errs = errors.AppendField(errs, "Grantee", g.Grantee.Validate())
+	if g.Granter.Equals(g.Grantee) {
+		errs = errors.Append(errs, errors.Field("Grantee", errors.ErrInput, "must be different than granter"))
+	}
+	budget := coin.Coins(g.Budget)
+	if budget.IsEmpty() {
+		errs = errors.Append(errs, errors.Field("Budget", errors.ErrAmount, "required"))
+	} else {
+		errs = errors.AppendField(errs, "Budget", budget.Validate())
+		if !budget.IsPositive() {
+			errs = errors.Append(errs, errors.Field("Budget", errors.ErrAmount, "must be positive"))
+		}
+	}
+	if g.Expiration == 0 {
+		errs = errors.Append(errs, errors.Field("Expiration", errors.ErrInput, "required"))
+	}
+	errs = errors.AppendField(errs, "Expiration", g.Expiration.Validate())
+	return errs
+}
+
+// NewFeeGrantBucket returns a bucket for keeping track of fee grants. Fee
+// grant is stored under the key created using FeeGrantKey function.
+func NewFeeGrantBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("feegrant", &FeeGrant{},
+		orm.WithIndex("grantee", idxFeeGrantGrantee, false),
+	)
+	return migration.NewModelBucket("cash", b)
+}
+
+// FeeGrantKey returns the key under which a fee grant given by the granter
+// to the grantee is stored. Granter address is the key prefix so that all
+// grants given by a single granter can be queried using a prefix query.
+func FeeGrantKey(granter, grantee weave.Address) []byte {
+	key := make([]byte, 0, len(granter)+len(grantee))
+	key = append(key, granter...)
+	return append(key, grantee...)
+}
+
+func idxFeeGrantGrantee(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	g, ok := obj.Value().(*FeeGrant)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "can only take index of FeeGrant")
+	}
+	return g.Grantee, nil
+}
+
+// spendFeeGrant ensures that the main signer of the transaction was granted
+// the right to pay fees from the payer wallet and deducts the fee from the
+// grant budget. A grant with a fully spent budget is deleted.
+func spendFeeGrant(ctx weave.Context, db weave.KVStore, auth x.Authenticator, payer weave.Address, fee coin.Coin) error {
+	grantee := x.MainSigner(ctx, auth).Address()
+	if grantee == nil {
+		return errors.Wrap(errors.ErrUnauthorized, "fee payer signature missing")
+	}
+
+	bucket := NewFeeGrantBucket()
+	key := FeeGrantKey(payer, grantee)
+	var grant FeeGrant
+	switch err := bucket.One(db, key, &grant); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		return errors.Wrap(errors.ErrUnauthorized, "fee payer signature missing")
+	default:
+		return errors.Wrap(err, "cannot load fee grant")
+	}
+
+	if weave.IsExpired(ctx, grant.Expiration) {
+		return errors.Wrap(errors.ErrExpired, "fee grant")
+	}
+	if fee.IsZero() {
+		return nil
+	}
+
+	budget, err := coin.Coins(grant.Budget).Subtract(fee)
+	if err != nil {
+		return errors.Wrap(err, "cannot deduct fee from grant budget")
+	}
+	if !budget.IsNonNegative() {
+		return errors.Wrap(errors.ErrAmount, "fee grant budget exceeded")
+	}
+	if budget.IsEmpty() {
+		if err := bucket.Delete(db, key); err != nil {
+			return errors.Wrap(err, "cannot delete fee grant")
+		}
+		return nil
+	}
+	grant.Budget = budget
+	if _, err := bucket.Put(db, key, &grant); err != nil {
+		return errors.Wrap(err, "cannot update fee grant")
+	}
+	return nil
+}
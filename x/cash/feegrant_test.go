@@ -0,0 +1,238 @@
+package cash
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestFeeGrant(t *testing.T) {
+	ctx := context.Background()
+	now := weave.AsUnixTime(time.Now())
+	granter := weavetest.NewCondition()
+	grantee := weavetest.NewCondition()
+	collector := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		signer     weave.Condition
+		grant      *FeeGrant
+		fee        coin.Coin
+		wantErr    *errors.Error
+		wantBudget coin.Coins
+	}{
+		"grantee pays fee from granter wallet": {
+			signer: grantee,
+			grant: &FeeGrant{
+				Budget:     coin.Coins{coin.NewCoinp(5, 0, "IOV")},
+				Expiration: now + 1000,
+			},
+			fee:        coin.NewCoin(2, 0, "IOV"),
+			wantBudget: coin.Coins{coin.NewCoinp(3, 0, "IOV")},
+		},
+		"fully spent grant is deleted": {
+			signer: grantee,
+			grant: &FeeGrant{
+				Budget:     coin.Coins{coin.NewCoinp(2, 0, "IOV")},
+				Expiration: now + 1000,
+			},
+			fee:        coin.NewCoin(2, 0, "IOV"),
+			wantBudget: nil,
+		},
+		"fee above budget is rejected": {
+			signer: grantee,
+			grant: &FeeGrant{
+				Budget:     coin.Coins{coin.NewCoinp(1, 0, "IOV")},
+				Expiration: now + 1000,
+			},
+			fee:        coin.NewCoin(2, 0, "IOV"),
+			wantErr:    errors.ErrAmount,
+			wantBudget: coin.Coins{coin.NewCoinp(1, 0, "IOV")},
+		},
+		"expired grant cannot be used": {
+			signer: grantee,
+			grant: &FeeGrant{
+				Budget:     coin.Coins{coin.NewCoinp(5, 0, "IOV")},
+				Expiration: now - 1,
+			},
+			fee:        coin.NewCoin(2, 0, "IOV"),
+			wantErr:    errors.ErrExpired,
+			wantBudget: coin.Coins{coin.NewCoinp(5, 0, "IOV")},
+		},
+		"fee cannot be paid without a grant": {
+			signer:  grantee,
+			fee:     coin.NewCoin(2, 0, "IOV"),
+			wantErr: errors.ErrUnauthorized,
+		},
+		"grant cannot be used by another signer": {
+			signer: weavetest.NewCondition(),
+			grant: &FeeGrant{
+				Budget:     coin.Coins{coin.NewCoinp(5, 0, "IOV")},
+				Expiration: now + 1000,
+			},
+			fee:        coin.NewCoin(2, 0, "IOV"),
+			wantErr:    errors.ErrUnauthorized,
+			wantBudget: coin.Coins{coin.NewCoinp(5, 0, "IOV")},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: tc.signer}
+			ctrl := NewController(NewBucket())
+			decorators := map[string]weave.Decorator{
+				"static":  NewFeeDecorator(auth, ctrl),
+				"dynamic": NewDynamicFeeDecorator(auth, ctrl),
+			}
+			for name, d := range decorators {
+				db := store.MemStore()
+				migration.MustInitPkg(db, "cash")
+				config := Configuration{
+					Metadata:         &weave.Metadata{Schema: 1},
+					CollectorAddress: collector,
+				}
+				if err := gconf.Save(db, "cash", &config); err != nil {
+					t.Fatalf("cannot save configuration: %s", err)
+				}
+				if err := ctrl.CoinMint(ctx, db, granter.Address(), coin.NewCoin(10, 0, "IOV")); err != nil {
+					t.Fatalf("cannot mint: %s", err)
+				}
+				grants := NewFeeGrantBucket()
+				key := FeeGrantKey(granter.Address(), grantee.Address())
+				if tc.grant != nil {
+					tc.grant.Metadata = &weave.Metadata{Schema: 1}
+					tc.grant.Granter = granter.Address()
+					tc.grant.Grantee = grantee.Address()
+					if _, err := grants.Put(db, key, tc.grant); err != nil {
+						t.Fatalf("cannot store grant: %s", err)
+					}
+				}
+
+				ctx := weave.WithBlockTime(context.Background(), now.Time())
+				tx := &txMock{info: &FeeInfo{Payer: granter.Address(), Fees: &tc.fee}}
+				if _, err := d.Deliver(ctx, db, tx, &weavetest.Handler{}); !tc.wantErr.Is(err) {
+					t.Fatalf("%s: unexpected error: %+v", name, err)
+				}
+
+				var g FeeGrant
+				switch err := grants.One(db, key, &g); {
+				case tc.wantBudget == nil:
+					if !errors.ErrNotFound.Is(err) {
+						t.Fatalf("%s: want no grant, got %+v", name, err)
+					}
+				case err != nil:
+					t.Fatalf("%s: cannot load grant: %s", name, err)
+				case !tc.wantBudget.Equals(g.Budget):
+					t.Fatalf("%s: want %v budget, got %v", name, tc.wantBudget, g.Budget)
+				}
+
+				wantBalance := coin.Coins{coin.NewCoinp(10, 0, "IOV")}
+				if tc.wantErr == nil {
+					wantBalance = coin.Coins{coin.NewCoinp(8, 0, "IOV")}
+				}
+				balance, err := ctrl.Balance(db, granter.Address())
+				if err != nil {
+					t.Fatalf("%s: cannot get granter balance: %s", name, err)
+				}
+				if !wantBalance.Equals(balance) {
+					t.Fatalf("%s: want %v granter balance, got %v", name, wantBalance, balance)
+				}
+			}
+		})
+	}
+}
+
+func TestGrantFee(t *testing.T) {
+	now := weave.AsUnixTime(time.Now())
+	granter := weavetest.NewCondition()
+	grantee := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		signer  weave.Condition
+		msg     *GrantFeeMsg
+		wantErr *errors.Error
+	}{
+		"granter can give a grant": {
+			signer: granter,
+			msg: &GrantFeeMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				Granter:    granter.Address(),
+				Grantee:    grantee.Address(),
+				Budget:     coin.Coins{coin.NewCoinp(1, 0, "IOV")},
+				Expiration: now + 100,
+			},
+		},
+		"granter signature is required": {
+			signer: grantee,
+			msg: &GrantFeeMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				Granter:    granter.Address(),
+				Grantee:    grantee.Address(),
+				Budget:     coin.Coins{coin.NewCoinp(1, 0, "IOV")},
+				Expiration: now + 100,
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"expiration must be in the future": {
+			signer: granter,
+			msg: &GrantFeeMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				Granter:    granter.Address(),
+				Grantee:    grantee.Address(),
+				Budget:     coin.Coins{coin.NewCoinp(1, 0, "IOV")},
+				Expiration: now,
+			},
+			wantErr: errors.ErrExpired,
+		},
+		"empty budget revokes a grant": {
+			signer: granter,
+			msg: &GrantFeeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Granter:  granter.Address(),
+				Grantee:  grantee.Address(),
+			},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "cash")
+
+			h := NewGrantFeeHandler(&weavetest.Auth{Signer: tc.signer})
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			tx := &weavetest.Tx{Msg: tc.msg}
+			if _, err := h.Check(ctx, db, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := h.Deliver(ctx, db, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+
+			var g FeeGrant
+			err := NewFeeGrantBucket().One(db, FeeGrantKey(granter.Address(), grantee.Address()), &g)
+			if len(tc.msg.Budget) == 0 {
+				if !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want no grant, got %+v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cannot load grant: %s", err)
+			}
+			if !coin.Coins(tc.msg.Budget).Equals(g.Budget) || g.Expiration != tc.msg.Expiration {
+				t.Fatalf("unexpected grant: %+v", g)
+			}
+		})
+	}
+}
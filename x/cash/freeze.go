@@ -0,0 +1,45 @@
+package cash
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+)
+
+func init() {
+	migration.MustRegister(1, &FrozenAccount{}, migration.NoModification)
+}
+
+var _ orm.Model = (*FrozenAccount)(nil)
+
+// Validate ensures the frozen account is valid.
+func (f *FrozenAccount) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", f.Metadata.Validate())
+	errs = errors.AppendField(errs, "Address", f.Address.Validate())
+	if len(f.Reason) > maxMemoSize {
+		errs = errors.Append(errs, errors.Field("Reason", errors.ErrInput, "too long"))
+	}
+	return errs
+}
+
+// NewFrozenAccountBucket returns a bucket for keeping track of frozen
+// accounts. Frozen account is stored under its address.
+func NewFrozenAccountBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("frozen", &FrozenAccount{})
+	return migration.NewModelBucket("cash", b)
+}
+
+// ensureNotFrozen returns ErrUnauthorized if the given address is frozen.
+func ensureNotFrozen(db weave.ReadOnlyKVStore, bucket orm.ModelBucket, addr weave.Address) error {
+	var f FrozenAccount
+	switch err := bucket.One(db, addr, &f); {
+	case err == nil:
+		return errors.Wrapf(errors.ErrUnauthorized, "account %s is frozen", addr)
+	case errors.ErrNotFound.Is(err):
+		return nil
+	default:
+		return errors.Wrap(err, "cannot load frozen account")
+	}
+}
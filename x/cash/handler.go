@@ -2,10 +2,13 @@ package cash
 
 import (
 	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 // RegisterRoutes will instantiate and register
@@ -14,12 +17,55 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator, control Controller)
 	r = migration.SchemaMigratingRegistry("cash", r)
 
 	r.Handle(&SendMsg{}, NewSendHandler(auth, control))
+	r.Handle(&MultiSendMsg{}, NewMultiSendHandler(auth, control))
+	r.Handle(&BurnMsg{}, NewBurnHandler(auth, control))
+	r.Handle(&MintMsg{}, NewMintHandler(auth, control))
+	r.Handle(&ApproveMsg{}, NewApproveHandler(auth))
+	r.Handle(&TransferFromMsg{}, NewTransferFromHandler(auth, control))
+	r.Handle(&CreateVestingMsg{}, NewCreateVestingHandler(auth, control))
+	r.Handle(&ReleaseVestingMsg{}, NewReleaseVestingHandler())
+	r.Handle(&FreezeAccountMsg{}, NewFreezeAccountHandler(auth))
+	r.Handle(&UnfreezeAccountMsg{}, NewUnfreezeAccountHandler(auth))
+	r.Handle(&SetWalletNameMsg{}, NewSetWalletNameHandler(auth))
+	r.Handle(&GrantFeeMsg{}, NewGrantFeeHandler(auth))
 	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
 }
 
-// RegisterQuery will register this bucket as "/wallets"
+// RegisterScheduleRoutes will instantiate and register handlers for
+// scheduling and canceling transfers. Scheduled transfers are executed by the
+// cron, so RegisterCronRoutes must be used to register the cron handlers.
+func RegisterScheduleRoutes(r weave.Registry, auth x.Authenticator, control Controller, scheduler weave.Scheduler) {
+	r = migration.SchemaMigratingRegistry("cash", r)
+
+	r.Handle(&ScheduleSendMsg{}, NewScheduleSendHandler(auth, control, scheduler))
+	r.Handle(&CancelScheduledSendMsg{}, NewCancelScheduledSendHandler(auth, control, scheduler))
+}
+
+// RegisterCronRoutes will instantiate and register all handlers for messages
+// that are executed by the cron only.
+func RegisterCronRoutes(r weave.Registry, auth x.Authenticator, control Controller) {
+	r = migration.SchemaMigratingRegistry("cash", r)
+
+	r.Handle(&ExecuteScheduledSendMsg{}, NewExecuteScheduledSendHandler(auth, control))
+}
+
+// RegisterQuery will register this bucket as "/wallets" with wallets
+// searchable by name using "/wallets/name", the paginated
+// list of currency holders as "/holders", the total supply as "/supply",
+// allowances as "/allowances", vesting schedules as "/vestings", frozen
+// accounts as "/frozen", fee grants as "/feegrants" and scheduled transfers
+// as "/scheduledsends". Supply invariant check can be run using the
+// "/invariants" query.
 func RegisterQuery(qr weave.QueryRouter) {
 	NewBucket().Register("wallets", qr)
+	qr.Register("/holders", NewHoldersQuery())
+	NewSupplyBucket().Register("supply", qr)
+	NewAllowanceBucket().Register("allowances", qr)
+	NewVestingBucket().Register("vestings", qr)
+	NewFrozenAccountBucket().Register("frozen", qr)
+	NewFeeGrantBucket().Register("feegrants", qr)
+	NewScheduledSendBucket().Register("scheduledsends", qr)
+	qr.Register("/invariants", SupplyInvariantQuery{})
 }
 
 // SendHandler will handle sending coins
@@ -38,8 +84,7 @@ func NewSendHandler(auth x.Authenticator, control Controller) SendHandler {
 	}
 }
 
-// Check just verifies it is properly formed and returns
-// the cost of executing it
+// Check just verifies it is properly formed.
 func (h SendHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
 	var msg SendMsg
 	if err := weave.LoadMsg(tx, &msg); err != nil {
@@ -50,11 +95,11 @@ func (h SendHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx)
 	if !h.auth.HasAddress(ctx, msg.Source) {
 		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
 	}
-
-	res := weave.CheckResult{
-		GasAllocated: sendTxCost,
+	if err := ensureMinimalSend(store, *msg.Amount); err != nil {
+		return nil, err
 	}
-	return &res, nil
+
+	return &weave.CheckResult{}, nil
 }
 
 // Deliver moves the tokens from source to receiver if
@@ -69,13 +114,951 @@ func (h SendHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx
 	if !h.auth.HasAddress(ctx, msg.Source) {
 		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
 	}
+	if err := ensureMinimalSend(store, *msg.Amount); err != nil {
+		return nil, err
+	}
+
+	if err := h.control.MoveCoins(ctx, store, msg.Source, msg.Destination, *msg.Amount); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// MultiSendHandler will handle sending coins from a single source to many
+// destinations.
+type MultiSendHandler struct {
+	auth    x.Authenticator
+	control Controller
+}
+
+var _ weave.Handler = MultiSendHandler{}
+
+// NewMultiSendHandler creates a handler for MultiSendMsg
+func NewMultiSendHandler(auth x.Authenticator, control Controller) MultiSendHandler {
+	return MultiSendHandler{
+		auth:    auth,
+		control: control,
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h MultiSendHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	var msg MultiSendMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	// Make sure we have permission from the sources
+	if !h.auth.HasAddress(ctx, msg.Source) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
+	}
+	for i, o := range msg.Outputs {
+		if err := ensureMinimalSend(store, *o.Amount); err != nil {
+			return nil, errors.Wrapf(err, "output %d", i)
+		}
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver moves the tokens from source to all receivers. If any of the
+// transfers fails, an error is returned and the whole transaction, including
+// already processed outputs, is discarded.
+func (h MultiSendHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	var msg MultiSendMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	// Make sure we have permission from the source.
+	if !h.auth.HasAddress(ctx, msg.Source) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
+	}
+
+	for i, o := range msg.Outputs {
+		if err := ensureMinimalSend(store, *o.Amount); err != nil {
+			return nil, errors.Wrapf(err, "output %d", i)
+		}
+		if err := h.control.MoveCoins(ctx, store, msg.Source, o.Destination, *o.Amount); err != nil {
+			return nil, errors.Wrapf(err, "output %d", i)
+		}
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+const (
+	// BurnSourceTag is the tag key used to mark the address of the burned
+	// coins owner.
+	BurnSourceTag = "burn.source"
+	// BurnAmountTag is the tag key used to mark the amount of burned coins.
+	BurnAmountTag = "burn.amount"
+)
+
+// BurnHandler will handle destroying coins
+type BurnHandler struct {
+	auth   x.Authenticator
+	burner CoinBurner
+}
+
+var _ weave.Handler = BurnHandler{}
+
+// NewBurnHandler creates a handler for BurnMsg
+func NewBurnHandler(auth x.Authenticator, burner CoinBurner) BurnHandler {
+	return BurnHandler{
+		auth:   auth,
+		burner: burner,
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h BurnHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	var msg BurnMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	if !h.auth.HasAddress(ctx, msg.Source) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver destroys the tokens from the source wallet and decreases the total
+// supply.
+func (h BurnHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	var msg BurnMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	if !h.auth.HasAddress(ctx, msg.Source) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
+	}
+
+	if err := h.burner.CoinBurn(store, msg.Source, *msg.Amount); err != nil {
+		return nil, err
+	}
+	res := weave.DeliverResult{
+		Tags: []common.KVPair{
+			{Key: []byte(BurnSourceTag), Value: []byte(msg.Source.String())},
+			{Key: []byte(BurnAmountTag), Value: []byte(msg.Amount.String())},
+		},
+	}
+	return &res, nil
+}
+
+// MintHandler will handle creating new coins
+type MintHandler struct {
+	auth   x.Authenticator
+	minter CoinMinter
+}
+
+var _ weave.Handler = MintHandler{}
+
+// NewMintHandler creates a handler for MintMsg
+func NewMintHandler(auth x.Authenticator, minter CoinMinter) MintHandler {
+	return MintHandler{
+		auth:   auth,
+		minter: minter,
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h MintHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver creates new coins on the destination account and increases the
+// total supply.
+func (h MintHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.minter.CoinMint(ctx, store, msg.Destination, *msg.Amount); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h MintHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*MintMsg, error) {
+	var msg MintMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	var conf Configuration
+	if err := gconf.Load(store, "cash", &conf); err != nil {
+		return nil, errors.Wrap(err, "load configuration")
+	}
+	if len(conf.Minter) == 0 {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "minting disabled")
+	}
+	if !h.auth.HasAddress(ctx, conf.Minter) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "minter signature missing")
+	}
+	return &msg, nil
+}
+
+// ApproveHandler will handle setting allowances
+type ApproveHandler struct {
+	auth       x.Authenticator
+	allowances orm.ModelBucket
+}
+
+var _ weave.Handler = ApproveHandler{}
+
+// NewApproveHandler creates a handler for ApproveMsg
+func NewApproveHandler(auth x.Authenticator) ApproveHandler {
+	return ApproveHandler{
+		auth:       auth,
+		allowances: NewAllowanceBucket(),
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h ApproveHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the allowance, replacing any previous one given to the
+// same spender. An empty amount removes the allowance.
+func (h ApproveHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	key := AllowanceKey(msg.Owner, msg.Spender)
+	if len(msg.Amount) == 0 {
+		if err := h.allowances.Delete(store, key); err != nil {
+			return nil, errors.Wrap(err, "cannot revoke allowance")
+		}
+		return &weave.DeliverResult{}, nil
+	}
+
+	allowance := Allowance{
+		Metadata: &weave.Metadata{Schema: 1},
+		Owner:    msg.Owner,
+		Spender:  msg.Spender,
+		Amount:   msg.Amount,
+	}
+	if _, err := h.allowances.Put(store, key, &allowance); err != nil {
+		return nil, errors.Wrap(err, "cannot store allowance")
+	}
+	return &weave.DeliverResult{Data: key}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h ApproveHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ApproveMsg, error) {
+	var msg ApproveMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, msg.Owner) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
+	}
+	return &msg, nil
+}
+
+// GrantFeeHandler will handle setting fee grants
+type GrantFeeHandler struct {
+	auth   x.Authenticator
+	grants orm.ModelBucket
+}
+
+var _ weave.Handler = GrantFeeHandler{}
+
+// NewGrantFeeHandler creates a handler for GrantFeeMsg
+func NewGrantFeeHandler(auth x.Authenticator) GrantFeeHandler {
+	return GrantFeeHandler{
+		auth:   auth,
+		grants: NewFeeGrantBucket(),
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h GrantFeeHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the fee grant, replacing any previous one given to the
+// same grantee. An empty budget removes the grant.
+func (h GrantFeeHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	key := FeeGrantKey(msg.Granter, msg.Grantee)
+	if len(msg.Budget) == 0 {
+		if err := h.grants.Delete(store, key); err != nil {
+			return nil, errors.Wrap(err, "cannot revoke fee grant")
+		}
+		return &weave.DeliverResult{}, nil
+	}
+
+	grant := FeeGrant{
+		Metadata:   &weave.Metadata{Schema: 1},
+		Granter:    msg.Granter,
+		Grantee:    msg.Grantee,
+		Budget:     msg.Budget,
+		Expiration: msg.Expiration,
+	}
+	if _, err := h.grants.Put(store, key, &grant); err != nil {
+		return nil, errors.Wrap(err, "cannot store fee grant")
+	}
+	return &weave.DeliverResult{Data: key}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h GrantFeeHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*GrantFeeMsg, error) {
+	var msg GrantFeeMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, msg.Granter) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "Granter signature missing")
+	}
+	if len(msg.Budget) != 0 && weave.IsExpired(ctx, msg.Expiration) {
+		return nil, errors.Wrap(errors.ErrExpired, "expiration in the past")
+	}
+	return &msg, nil
+}
+
+// TransferFromHandler will handle spending coins on behalf of the owner
+type TransferFromHandler struct {
+	auth       x.Authenticator
+	control    CoinMover
+	allowances orm.ModelBucket
+}
+
+var _ weave.Handler = TransferFromHandler{}
+
+// NewTransferFromHandler creates a handler for TransferFromMsg
+func NewTransferFromHandler(auth x.Authenticator, control CoinMover) TransferFromHandler {
+	return TransferFromHandler{
+		auth:       auth,
+		control:    control,
+		allowances: NewAllowanceBucket(),
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h TransferFromHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver moves the tokens from the owner to the destination and decreases
+// the allowance by the transferred amount.
+func (h TransferFromHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, allowance, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	key := AllowanceKey(msg.Owner, msg.Spender)
+	left, err := coin.Coins(allowance.Amount).Subtract(*msg.Amount)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot decrease allowance")
+	}
+	if left.IsEmpty() {
+		if err := h.allowances.Delete(store, key); err != nil {
+			return nil, errors.Wrap(err, "cannot delete allowance")
+		}
+	} else {
+		allowance.Amount = left
+		if _, err := h.allowances.Put(store, key, allowance); err != nil {
+			return nil, errors.Wrap(err, "cannot store allowance")
+		}
+	}
+
+	if err := h.control.MoveCoins(ctx, store, msg.Owner, msg.Destination, *msg.Amount); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h TransferFromHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*TransferFromMsg, *Allowance, error) {
+	var msg TransferFromMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, msg.Spender) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "spender signature missing")
+	}
+
+	var allowance Allowance
+	switch err := h.allowances.One(store, AllowanceKey(msg.Owner, msg.Spender), &allowance); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "no allowance")
+	default:
+		return nil, nil, errors.Wrap(err, "cannot load allowance")
+	}
+	if !coin.Coins(allowance.Amount).Contains(*msg.Amount) {
+		return nil, nil, errors.Wrap(errors.ErrAmount, "allowance exceeded")
+	}
+	return &msg, &allowance, nil
+}
+
+// CreateVestingHandler will handle locking coins in a vesting schedule
+type CreateVestingHandler struct {
+	auth     x.Authenticator
+	control  CoinMover
+	vestings orm.ModelBucket
+}
+
+var _ weave.Handler = CreateVestingHandler{}
+
+// NewCreateVestingHandler creates a handler for CreateVestingMsg
+func NewCreateVestingHandler(auth x.Authenticator, control CoinMover) CreateVestingHandler {
+	return CreateVestingHandler{
+		auth:     auth,
+		control:  control,
+		vestings: NewVestingBucket(),
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h CreateVestingHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver moves the coins from the source to the beneficiary and creates the
+// vesting that locks them.
+func (h CreateVestingHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Coins must be moved before they are locked, so that the source can
+	// lock its own coins.
+	if err := MoveCoins(ctx, store, h.control, msg.Source, msg.Beneficiary, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	vesting := Vesting{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Beneficiary: msg.Beneficiary,
+		Amount:      msg.Amount,
+		StartTime:   msg.StartTime,
+		CliffTime:   msg.CliffTime,
+		EndTime:     msg.EndTime,
+	}
+	key, err := vestingSeq.NextVal(store)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot acquire key")
+	}
+	if _, err := h.vestings.Put(store, key, &vesting); err != nil {
+		return nil, errors.Wrap(err, "cannot store vesting")
+	}
+	return &weave.DeliverResult{Data: key}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h CreateVestingHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*CreateVestingMsg, error) {
+	var msg CreateVestingMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, msg.Source) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
+	}
+	return &msg, nil
+}
+
+// ReleaseVestingHandler will handle unlocking released coins of the
+// beneficiary
+type ReleaseVestingHandler struct {
+	vestings orm.ModelBucket
+}
+
+var _ weave.Handler = ReleaseVestingHandler{}
+
+// NewReleaseVestingHandler creates a handler for ReleaseVestingMsg
+func NewReleaseVestingHandler() ReleaseVestingHandler {
+	return ReleaseVestingHandler{
+		vestings: NewVestingBucket(),
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h ReleaseVestingHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver unlocks all coins released at the current block time. Fully
+// released vesting is deleted.
+func (h ReleaseVestingHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, vesting, releasable, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	released, err := coin.Coins(vesting.Released).Combine(releasable)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot sum released coins")
+	}
+	if released.Equals(vesting.Amount) {
+		if err := h.vestings.Delete(store, msg.VestingId); err != nil {
+			return nil, errors.Wrap(err, "cannot delete vesting")
+		}
+		return &weave.DeliverResult{}, nil
+	}
+	vesting.Released = released
+	if _, err := h.vestings.Put(store, msg.VestingId, vesting); err != nil {
+		return nil, errors.Wrap(err, "cannot store vesting")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h ReleaseVestingHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ReleaseVestingMsg, *Vesting, coin.Coins, error) {
+	var msg ReleaseVestingMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+	var vesting Vesting
+	if err := h.vestings.One(store, msg.VestingId, &vesting); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load vesting")
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "block time")
+	}
+	releasable, err := vesting.Releasable(weave.AsUnixTime(now))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if releasable.IsEmpty() {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "nothing to release")
+	}
+	return &msg, &vesting, releasable, nil
+}
+
+// ScheduleSendHandler will handle locking coins for a scheduled transfer
+type ScheduleSendHandler struct {
+	auth      x.Authenticator
+	control   CoinMover
+	scheduler weave.Scheduler
+	sends     orm.ModelBucket
+}
+
+var _ weave.Handler = ScheduleSendHandler{}
+
+// NewScheduleSendHandler creates a handler for ScheduleSendMsg
+func NewScheduleSendHandler(auth x.Authenticator, control CoinMover, scheduler weave.Scheduler) ScheduleSendHandler {
+	return ScheduleSendHandler{
+		auth:      auth,
+		control:   control,
+		scheduler: scheduler,
+		sends:     NewScheduledSendBucket(),
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h ScheduleSendHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver moves the coins from the source to the scheduled send address and
+// schedules the transfer to the destination.
+func (h ScheduleSendHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := scheduledSendSeq.NextVal(store)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot acquire key")
+	}
+	cond := ScheduledSendCondition(key)
+	send := ScheduledSend{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Source:      msg.Source,
+		Destination: msg.Destination,
+		Address:     cond.Address(),
+		Amount:      msg.Amount,
+		Memo:        msg.Memo,
+		Ref:         msg.Ref,
+		SendAt:      msg.SendAt,
+	}
+
+	if err := h.control.MoveCoins(ctx, store, msg.Source, send.Address, *msg.Amount); err != nil {
+		return nil, err
+	}
+
+	execMsg := &ExecuteScheduledSendMsg{
+		Metadata:        &weave.Metadata{Schema: 1},
+		ScheduledSendID: key,
+	}
+	// Only the cron task authenticated with the scheduled send condition
+	// can execute the transfer.
+	taskID, err := h.scheduler.Schedule(store, msg.SendAt.Time(), []weave.Condition{cond}, execMsg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule send task")
+	}
+	send.TaskID = taskID
+
+	if _, err := h.sends.Put(store, key, &send); err != nil {
+		return nil, errors.Wrap(err, "cannot store scheduled send")
+	}
+	return &weave.DeliverResult{Data: key}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h ScheduleSendHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ScheduleSendMsg, error) {
+	var msg ScheduleSendMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, msg.Source) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "Account owner signature missing")
+	}
+	if !weave.InTheFuture(ctx, msg.SendAt.Time()) {
+		return nil, errors.Wrap(errors.ErrInput, "send time must be in the future")
+	}
+	return &msg, nil
+}
+
+// ExecuteScheduledSendHandler will handle transferring the locked coins to
+// the destination
+type ExecuteScheduledSendHandler struct {
+	auth    x.Authenticator
+	control CoinMover
+	sends   orm.ModelBucket
+}
+
+var _ weave.Handler = ExecuteScheduledSendHandler{}
+
+// NewExecuteScheduledSendHandler creates a handler for
+// ExecuteScheduledSendMsg
+func NewExecuteScheduledSendHandler(auth x.Authenticator, control CoinMover) ExecuteScheduledSendHandler {
+	return ExecuteScheduledSendHandler{
+		auth:    auth,
+		control: control,
+		sends:   NewScheduledSendBucket(),
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h ExecuteScheduledSendHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver moves the coins from the scheduled send address to the destination
+// and deletes the scheduled send.
+func (h ExecuteScheduledSendHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, send, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.control.MoveCoins(ctx, store, send.Address, send.Destination, *send.Amount); err != nil {
+		return nil, err
+	}
+	if err := h.sends.Delete(store, msg.ScheduledSendID); err != nil {
+		return nil, errors.Wrap(err, "cannot delete scheduled send")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h ExecuteScheduledSendHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*ExecuteScheduledSendMsg, *ScheduledSend, error) {
+	var msg ExecuteScheduledSendMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var send ScheduledSend
+	if err := h.sends.One(store, msg.ScheduledSendID, &send); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load scheduled send")
+	}
+	if !h.auth.HasAddress(ctx, send.Address) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "scheduled send signature missing")
+	}
+	if weave.InTheFuture(ctx, send.SendAt.Time()) {
+		return nil, nil, errors.Wrap(errors.ErrState, "send time not reached")
+	}
+	return &msg, &send, nil
+}
+
+// CancelScheduledSendHandler will handle returning the locked coins to the
+// source
+type CancelScheduledSendHandler struct {
+	auth      x.Authenticator
+	control   CoinMover
+	scheduler weave.Scheduler
+	sends     orm.ModelBucket
+}
+
+var _ weave.Handler = CancelScheduledSendHandler{}
+
+// NewCancelScheduledSendHandler creates a handler for CancelScheduledSendMsg
+func NewCancelScheduledSendHandler(auth x.Authenticator, control CoinMover, scheduler weave.Scheduler) CancelScheduledSendHandler {
+	return CancelScheduledSendHandler{
+		auth:      auth,
+		control:   control,
+		scheduler: scheduler,
+		sends:     NewScheduledSendBucket(),
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h CancelScheduledSendHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver returns the locked coins to the source, deletes the scheduled task
+// and the scheduled send.
+func (h CancelScheduledSendHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, send, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.control.MoveCoins(ctx, store, send.Address, send.Source, *send.Amount); err != nil {
+		return nil, err
+	}
+	if err := h.sends.Delete(store, msg.ScheduledSendID); err != nil {
+		return nil, errors.Wrap(err, "cannot delete scheduled send")
+	}
+
+	switch err := h.scheduler.Delete(store, send.TaskID); {
+	case err == nil:
+		// All good.
+	case errors.ErrNotFound.Is(err):
+		// The task was already processed but the transfer failed. We
+		// want the task to not exist and this is true.
+	default:
+		return nil, errors.Wrap(err, "cannot delete scheduled send task")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h CancelScheduledSendHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*CancelScheduledSendMsg, *ScheduledSend, error) {
+	var msg CancelScheduledSendMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var send ScheduledSend
+	if err := h.sends.One(store, msg.ScheduledSendID, &send); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load scheduled send")
+	}
+	if !h.auth.HasAddress(ctx, send.Source) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "only the source can cancel a scheduled send")
+	}
+	return &msg, &send, nil
+}
+
+// FreezeAccountHandler will handle adding accounts to the freeze list
+type FreezeAccountHandler struct {
+	auth   x.Authenticator
+	frozen orm.ModelBucket
+}
+
+var _ weave.Handler = FreezeAccountHandler{}
+
+// NewFreezeAccountHandler creates a handler for FreezeAccountMsg
+func NewFreezeAccountHandler(auth x.Authenticator) FreezeAccountHandler {
+	return FreezeAccountHandler{
+		auth:   auth,
+		frozen: NewFrozenAccountBucket(),
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h FreezeAccountHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	var msg FreezeAccountMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if err := ensureFreezer(ctx, store, h.auth); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
 
-	if err := h.control.MoveCoins(store, msg.Source, msg.Destination, *msg.Amount); err != nil {
+// Deliver adds the account to the freeze list.
+func (h FreezeAccountHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	var msg FreezeAccountMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if err := ensureFreezer(ctx, store, h.auth); err != nil {
 		return nil, err
 	}
+	frozen := FrozenAccount{
+		Metadata: &weave.Metadata{Schema: 1},
+		Address:  msg.Address,
+		Reason:   msg.Reason,
+	}
+	if _, err := h.frozen.Put(store, msg.Address, &frozen); err != nil {
+		return nil, errors.Wrap(err, "cannot store frozen account")
+	}
 	return &weave.DeliverResult{}, nil
 }
 
+// UnfreezeAccountHandler will handle removing accounts from the freeze list
+type UnfreezeAccountHandler struct {
+	auth   x.Authenticator
+	frozen orm.ModelBucket
+}
+
+var _ weave.Handler = UnfreezeAccountHandler{}
+
+// NewUnfreezeAccountHandler creates a handler for UnfreezeAccountMsg
+func NewUnfreezeAccountHandler(auth x.Authenticator) UnfreezeAccountHandler {
+	return UnfreezeAccountHandler{
+		auth:   auth,
+		frozen: NewFrozenAccountBucket(),
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h UnfreezeAccountHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	var msg UnfreezeAccountMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if err := ensureFreezer(ctx, store, h.auth); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver removes the account from the freeze list.
+func (h UnfreezeAccountHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	var msg UnfreezeAccountMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if err := ensureFreezer(ctx, store, h.auth); err != nil {
+		return nil, err
+	}
+	if err := h.frozen.Delete(store, msg.Address); err != nil {
+		return nil, errors.Wrap(err, "cannot delete frozen account")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// SetWalletNameHandler will handle setting the name of a wallet.
+type SetWalletNameHandler struct {
+	auth    x.Authenticator
+	wallets Bucket
+}
+
+var _ weave.Handler = SetWalletNameHandler{}
+
+// NewSetWalletNameHandler creates a handler for SetWalletNameMsg
+func NewSetWalletNameHandler(auth x.Authenticator) SetWalletNameHandler {
+	return SetWalletNameHandler{
+		auth:    auth,
+		wallets: NewBucket(),
+	}
+}
+
+// Check just verifies it is properly formed.
+func (h SetWalletNameHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver sets the name of the wallet. Name must not be used by any other
+// wallet.
+func (h SetWalletNameHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, wallet, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+	wallet.Value().(*Set).Name = msg.Name
+	if err := h.wallets.Save(store, wallet); err != nil {
+		return nil, errors.Wrap(err, "cannot save wallet")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h SetWalletNameHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*SetWalletNameMsg, orm.Object, error) {
+	var msg SetWalletNameMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	if !h.auth.HasAddress(ctx, msg.Address) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "wallet owner signature missing")
+	}
+	wallet, err := h.wallets.Get(store, msg.Address)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load wallet")
+	}
+	if wallet == nil {
+		return nil, nil, errors.Wrapf(errors.ErrNotFound, "wallet %s", msg.Address)
+	}
+	if msg.Name != "" {
+		owners, err := h.wallets.GetIndexed(store, walletNameIndex, []byte(msg.Name))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "cannot query name index")
+		}
+		for _, o := range owners {
+			if !msg.Address.Equals(o.Key()) {
+				return nil, nil, errors.Wrapf(errors.ErrDuplicate, "name %q already in use", msg.Name)
+			}
+		}
+	}
+	return &msg, wallet, nil
+}
+
+// ensureFreezer returns an error if the freezer from the cash configuration
+// did not authorize the transaction. Freezing is disabled if the freezer is
+// not set.
+func ensureFreezer(ctx weave.Context, store weave.KVStore, auth x.Authenticator) error {
+	var conf Configuration
+	if err := gconf.Load(store, "cash", &conf); err != nil {
+		return errors.Wrap(err, "load configuration")
+	}
+	if len(conf.Freezer) == 0 {
+		return errors.Wrap(errors.ErrUnauthorized, "freezing disabled")
+	}
+	if !auth.HasAddress(ctx, conf.Freezer) {
+		return errors.Wrap(errors.ErrUnauthorized, "freezer signature missing")
+	}
+	return nil
+}
+
 func NewConfigHandler(auth x.Authenticator) weave.Handler {
 	var conf Configuration
 	return gconf.NewUpdateConfigurationHandler("cash", &conf, auth)
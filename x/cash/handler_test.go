@@ -1,11 +1,13 @@
 package cash
 
 import (
+	"context"
 	"testing"
 
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
@@ -108,3 +110,741 @@ func TestSend(t *testing.T) {
 		})
 	}
 }
+
+func TestMultiSend(t *testing.T) {
+	foo := coin.NewCoin(100, 0, "FOO")
+	half := coin.NewCoin(50, 0, "FOO")
+
+	perm := weave.NewCondition("sig", "ed25519", []byte{1, 2, 3})
+	perm2 := weave.NewCondition("sig", "ed25519", []byte{4, 5, 6})
+	perm3 := weave.NewCondition("sig", "ed25519", []byte{7, 8, 9})
+
+	cases := map[string]struct {
+		signers        []weave.Condition
+		initState      []orm.Object
+		msg            weave.Msg
+		wantCheckErr   *errors.Error
+		wantDeliverErr *errors.Error
+		wantBalances   map[string]coin.Coins
+	}{
+		"empty message": {
+			msg:            &MultiSendMsg{},
+			wantCheckErr:   errors.ErrEmpty,
+			wantDeliverErr: errors.ErrEmpty,
+		},
+		"unauthorized": {
+			msg: &MultiSendMsg{
+				Source: perm.Address(),
+				Outputs: []Output{
+					{Destination: perm2.Address(), Amount: &half},
+				},
+			},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+		},
+		"source too poor for all outputs": {
+			signers: []weave.Condition{perm},
+			initState: []orm.Object{
+				must(WalletWith(perm.Address(), &half)),
+			},
+			msg: &MultiSendMsg{
+				Source: perm.Address(),
+				Outputs: []Output{
+					{Destination: perm2.Address(), Amount: &half},
+					{Destination: perm3.Address(), Amount: &half},
+				},
+			},
+			wantDeliverErr: errors.ErrAmount,
+		},
+		"source got cash": {
+			signers: []weave.Condition{perm},
+			initState: []orm.Object{
+				must(WalletWith(perm.Address(), &foo)),
+			},
+			msg: &MultiSendMsg{
+				Source: perm.Address(),
+				Outputs: []Output{
+					{Destination: perm2.Address(), Amount: &half},
+					{Destination: perm3.Address(), Amount: &half},
+				},
+			},
+			wantBalances: map[string]coin.Coins{
+				perm.Address().String():  nil,
+				perm2.Address().String(): {&half},
+				perm3.Address().String(): {&half},
+			},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signers: tc.signers}
+			controller := NewController(NewBucket())
+			h := NewMultiSendHandler(auth, controller)
+
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+			bucket := NewBucket()
+			for _, wallet := range tc.initState {
+				if err := bucket.Save(kv, wallet); err != nil {
+					t.Fatalf("cannot save %q wallet: %s", wallet.Key(), err)
+				}
+			}
+
+			tx := &weavetest.Tx{Msg: tc.msg}
+
+			if _, err := h.Check(nil, kv, tx); !tc.wantCheckErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := h.Deliver(nil, kv, tx); !tc.wantDeliverErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+
+			for _, c := range []weave.Condition{perm, perm2, perm3} {
+				want, ok := tc.wantBalances[c.Address().String()]
+				if !ok {
+					continue
+				}
+				got, err := controller.Balance(kv, c.Address())
+				if err != nil {
+					t.Fatalf("cannot get %s balance: %s", c.Address(), err)
+				}
+				if !got.Equals(want) {
+					t.Errorf("want %s balance %v, got %v", c.Address(), want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestBurn(t *testing.T) {
+	ctx := context.Background()
+	foo := coin.NewCoin(100, 0, "FOO")
+	some := coin.NewCoin(30, 0, "FOO")
+
+	perm := weave.NewCondition("sig", "ed25519", []byte{1, 2, 3})
+
+	cases := map[string]struct {
+		signers        []weave.Condition
+		msg            weave.Msg
+		wantCheckErr   *errors.Error
+		wantDeliverErr *errors.Error
+		wantBalance    coin.Coins
+	}{
+		"empty message": {
+			msg:            &BurnMsg{},
+			wantCheckErr:   errors.ErrEmpty,
+			wantDeliverErr: errors.ErrEmpty,
+			wantBalance:    coin.Coins{&foo},
+		},
+		"unauthorized": {
+			msg: &BurnMsg{
+				Source: perm.Address(),
+				Amount: &some,
+			},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+			wantBalance:    coin.Coins{&foo},
+		},
+		"insufficient funds": {
+			signers: []weave.Condition{perm},
+			msg: &BurnMsg{
+				Source: perm.Address(),
+				Amount: coin.NewCoinp(101, 0, "FOO"),
+			},
+			wantDeliverErr: errors.ErrAmount,
+			wantBalance:    coin.Coins{&foo},
+		},
+		"burn coins": {
+			signers: []weave.Condition{perm},
+			msg: &BurnMsg{
+				Source: perm.Address(),
+				Amount: &some,
+			},
+			wantBalance: coin.Coins{coin.NewCoinp(70, 0, "FOO")},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signers: tc.signers}
+			controller := NewController(NewBucket())
+			h := NewBurnHandler(auth, controller)
+
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+			if err := controller.CoinMint(ctx, kv, perm.Address(), foo); err != nil {
+				t.Fatalf("cannot mint: %s", err)
+			}
+
+			tx := &weavetest.Tx{Msg: tc.msg}
+
+			if _, err := h.Check(nil, kv, tx); !tc.wantCheckErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			res, err := h.Deliver(nil, kv, tx)
+			if !tc.wantDeliverErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+			if err == nil && len(res.Tags) == 0 {
+				t.Fatal("burn tags not emitted")
+			}
+
+			balance, err := controller.Balance(kv, perm.Address())
+			if err != nil {
+				t.Fatalf("cannot get balance: %s", err)
+			}
+			if !balance.Equals(tc.wantBalance) {
+				t.Fatalf("want %v balance, got %v", tc.wantBalance, balance)
+			}
+
+			total := tc.wantBalance[0]
+			supply, err := TotalSupply(kv, "FOO")
+			if err != nil {
+				t.Fatalf("cannot get supply: %s", err)
+			}
+			if !supply.Equals(*total) {
+				t.Fatalf("want %v supply, got %v", total, supply)
+			}
+		})
+	}
+}
+
+func TestMint(t *testing.T) {
+	minter := weavetest.NewCondition()
+	other := weavetest.NewCondition()
+	dest := weavetest.NewCondition().Address()
+
+	amount := coin.NewCoin(10, 0, "FOO")
+
+	cases := map[string]struct {
+		conf           Configuration
+		signers        []weave.Condition
+		msg            weave.Msg
+		wantCheckErr   *errors.Error
+		wantDeliverErr *errors.Error
+		wantBalance    coin.Coins
+	}{
+		"minter can mint": {
+			conf:        Configuration{Minter: minter.Address()},
+			signers:     []weave.Condition{minter},
+			msg:         &MintMsg{Destination: dest, Amount: &amount},
+			wantBalance: coin.Coins{&amount},
+		},
+		"minting disabled": {
+			conf:           Configuration{},
+			signers:        []weave.Condition{minter},
+			msg:            &MintMsg{Destination: dest, Amount: &amount},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+		},
+		"not a minter": {
+			conf:           Configuration{Minter: minter.Address()},
+			signers:        []weave.Condition{other},
+			msg:            &MintMsg{Destination: dest, Amount: &amount},
+			wantCheckErr:   errors.ErrUnauthorized,
+			wantDeliverErr: errors.ErrUnauthorized,
+		},
+		"invalid message": {
+			conf:           Configuration{Minter: minter.Address()},
+			signers:        []weave.Condition{minter},
+			msg:            &MintMsg{Destination: dest},
+			wantCheckErr:   errors.ErrAmount,
+			wantDeliverErr: errors.ErrAmount,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signers: tc.signers}
+			controller := NewController(NewBucket())
+			h := NewMintHandler(auth, controller)
+
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+			tc.conf.CollectorAddress = other.Address()
+			if err := gconf.Save(kv, "cash", &tc.conf); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+
+			tx := &weavetest.Tx{Msg: tc.msg}
+
+			if _, err := h.Check(nil, kv, tx); !tc.wantCheckErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := h.Deliver(nil, kv, tx); !tc.wantDeliverErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+			if tc.wantDeliverErr != nil {
+				return
+			}
+
+			balance, err := controller.Balance(kv, dest)
+			if err != nil {
+				t.Fatalf("cannot get balance: %s", err)
+			}
+			if !balance.Equals(tc.wantBalance) {
+				t.Fatalf("want %v balance, got %v", tc.wantBalance, balance)
+			}
+			supply, err := TotalSupply(kv, amount.Ticker)
+			if err != nil {
+				t.Fatalf("cannot get supply: %s", err)
+			}
+			if !supply.Equals(amount) {
+				t.Fatalf("want %v supply, got %v", amount, supply)
+			}
+		})
+	}
+}
+
+func TestAllowance(t *testing.T) {
+	ctx := context.Background()
+	owner := weavetest.NewCondition()
+	spender := weavetest.NewCondition()
+	dest := weavetest.NewCondition().Address()
+
+	controller := NewController(NewBucket())
+	allowances := NewAllowanceBucket()
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+	if err := controller.CoinMint(ctx, kv, owner.Address(), coin.NewCoin(100, 0, "FOO")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+
+	steps := []struct {
+		handler       weave.Handler
+		msg           weave.Msg
+		wantErr       *errors.Error
+		wantAllowance coin.Coins
+	}{
+		{
+			handler: NewTransferFromHandler(&weavetest.Auth{Signer: spender}, controller),
+			msg: &TransferFromMsg{
+				Owner:       owner.Address(),
+				Spender:     spender.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(1, 0, "FOO"),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		{
+			handler: NewApproveHandler(&weavetest.Auth{Signer: spender}),
+			msg: &ApproveMsg{
+				Owner:   owner.Address(),
+				Spender: spender.Address(),
+				Amount:  coin.Coins{coin.NewCoinp(10, 0, "FOO")},
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		{
+			handler: NewApproveHandler(&weavetest.Auth{Signer: owner}),
+			msg: &ApproveMsg{
+				Owner:   owner.Address(),
+				Spender: spender.Address(),
+				Amount:  coin.Coins{coin.NewCoinp(10, 0, "FOO")},
+			},
+			wantAllowance: coin.Coins{coin.NewCoinp(10, 0, "FOO")},
+		},
+		{
+			handler: NewTransferFromHandler(&weavetest.Auth{Signer: spender}, controller),
+			msg: &TransferFromMsg{
+				Owner:       owner.Address(),
+				Spender:     spender.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(4, 0, "FOO"),
+			},
+			wantAllowance: coin.Coins{coin.NewCoinp(6, 0, "FOO")},
+		},
+		{
+			handler: NewTransferFromHandler(&weavetest.Auth{Signer: spender}, controller),
+			msg: &TransferFromMsg{
+				Owner:       owner.Address(),
+				Spender:     spender.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(7, 0, "FOO"),
+			},
+			wantErr:       errors.ErrAmount,
+			wantAllowance: coin.Coins{coin.NewCoinp(6, 0, "FOO")},
+		},
+		{
+			handler: NewTransferFromHandler(&weavetest.Auth{Signer: spender}, controller),
+			msg: &TransferFromMsg{
+				Owner:       owner.Address(),
+				Spender:     spender.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(6, 0, "FOO"),
+			},
+			wantAllowance: nil,
+		},
+		{
+			handler: NewApproveHandler(&weavetest.Auth{Signer: owner}),
+			msg: &ApproveMsg{
+				Owner:   owner.Address(),
+				Spender: spender.Address(),
+				Amount:  coin.Coins{coin.NewCoinp(5, 0, "FOO")},
+			},
+			wantAllowance: coin.Coins{coin.NewCoinp(5, 0, "FOO")},
+		},
+		{
+			handler: NewApproveHandler(&weavetest.Auth{Signer: owner}),
+			msg: &ApproveMsg{
+				Owner:   owner.Address(),
+				Spender: spender.Address(),
+			},
+			wantAllowance: nil,
+		},
+	}
+
+	for i, step := range steps {
+		tx := &weavetest.Tx{Msg: step.msg}
+		if _, err := step.handler.Check(nil, kv, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected check error: %+v", i, err)
+		}
+		if _, err := step.handler.Deliver(nil, kv, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected deliver error: %+v", i, err)
+		}
+
+		var a Allowance
+		err := allowances.One(kv, AllowanceKey(owner.Address(), spender.Address()), &a)
+		if step.wantAllowance == nil {
+			if !errors.ErrNotFound.Is(err) {
+				t.Fatalf("step %d: want no allowance, got %+v", i, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("step %d: cannot load allowance: %s", i, err)
+		}
+		if !step.wantAllowance.Equals(a.Amount) {
+			t.Fatalf("step %d: want %v allowance, got %v", i, step.wantAllowance, a.Amount)
+		}
+	}
+
+	balance, err := controller.Balance(kv, dest)
+	if err != nil {
+		t.Fatalf("cannot get destination balance: %s", err)
+	}
+	if want := (coin.Coins{coin.NewCoinp(10, 0, "FOO")}); !balance.Equals(want) {
+		t.Fatalf("want %v destination balance, got %v", want, balance)
+	}
+}
+
+func TestFreezeAccount(t *testing.T) {
+	ctx := context.Background()
+	owner := weavetest.NewCondition()
+	freezer := weavetest.NewCondition()
+	other := weavetest.NewCondition()
+	frozen := weavetest.NewCondition().Address()
+
+	controller := NewController(NewBucket())
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+	conf := Configuration{
+		Owner:            owner.Address(),
+		CollectorAddress: other.Address(),
+		Freezer:          freezer.Address(),
+	}
+	if err := gconf.Save(kv, "cash", &conf); err != nil {
+		t.Fatalf("cannot save configuration: %s", err)
+	}
+	if err := controller.CoinMint(ctx, kv, frozen, coin.NewCoin(10, 0, "FOO")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+
+	steps := []struct {
+		signer      weave.Condition
+		msg         weave.Msg
+		wantErr     *errors.Error
+		wantMoveErr *errors.Error
+	}{
+		{
+			signer:  other,
+			msg:     &FreezeAccountMsg{Address: frozen},
+			wantErr: errors.ErrUnauthorized,
+		},
+		{
+			// Configuration owner is not allowed to freeze accounts.
+			signer:  owner,
+			msg:     &FreezeAccountMsg{Address: frozen},
+			wantErr: errors.ErrUnauthorized,
+		},
+		{
+			signer:      freezer,
+			msg:         &FreezeAccountMsg{Address: frozen, Reason: "court order"},
+			wantMoveErr: errors.ErrUnauthorized,
+		},
+		{
+			signer:      owner,
+			msg:         &UnfreezeAccountMsg{Address: frozen},
+			wantErr:     errors.ErrUnauthorized,
+			wantMoveErr: errors.ErrUnauthorized,
+		},
+		{
+			signer: freezer,
+			msg:    &UnfreezeAccountMsg{Address: frozen},
+		},
+	}
+
+	for i, step := range steps {
+		auth := &weavetest.Auth{Signer: step.signer}
+		var h weave.Handler
+		switch step.msg.(type) {
+		case *FreezeAccountMsg:
+			h = NewFreezeAccountHandler(auth)
+		case *UnfreezeAccountMsg:
+			h = NewUnfreezeAccountHandler(auth)
+		}
+
+		tx := &weavetest.Tx{Msg: step.msg}
+		if _, err := h.Check(nil, kv, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected check error: %+v", i, err)
+		}
+		if _, err := h.Deliver(nil, kv, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected deliver error: %+v", i, err)
+		}
+
+		db := kv.CacheWrap()
+		if err := controller.MoveCoins(ctx, db, frozen, other.Address(), coin.NewCoin(1, 0, "FOO")); !step.wantMoveErr.Is(err) {
+			t.Fatalf("step %d: unexpected move error: %+v", i, err)
+		}
+		if err := controller.CoinBurn(db, frozen, coin.NewCoin(1, 0, "FOO")); !step.wantMoveErr.Is(err) {
+			t.Fatalf("step %d: unexpected burn error: %+v", i, err)
+		}
+		db.Discard()
+	}
+}
+
+func TestMinimalSendAmount(t *testing.T) {
+	ctx := context.Background()
+	source := weavetest.NewCondition()
+	dest := weavetest.NewCondition().Address()
+	collector := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		msg     weave.Msg
+		wantErr *errors.Error
+	}{
+		"send above minimum": {
+			msg: &SendMsg{
+				Source:      source.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(1, 0, "FOO"),
+			},
+		},
+		"send below minimum": {
+			msg: &SendMsg{
+				Source:      source.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(0, 999, "FOO"),
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"currency without minimum": {
+			msg: &SendMsg{
+				Source:      source.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(0, 1, "BAR"),
+			},
+		},
+		"multi send with an output below minimum": {
+			msg: &MultiSendMsg{
+				Source: source.Address(),
+				Outputs: []Output{
+					{Destination: dest, Amount: coin.NewCoinp(2, 0, "FOO")},
+					{Destination: dest, Amount: coin.NewCoinp(0, 1, "FOO")},
+				},
+			},
+			wantErr: errors.ErrAmount,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.Auth{Signer: source}
+			controller := NewController(NewBucket())
+			handlers := map[string]weave.Handler{
+				"cash/send":       NewSendHandler(auth, controller),
+				"cash/multi_send": NewMultiSendHandler(auth, controller),
+			}
+			h := handlers[tc.msg.Path()]
+
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+			conf := Configuration{
+				Metadata:           &weave.Metadata{Schema: 1},
+				CollectorAddress:   collector,
+				MinimalSendAmounts: []*coin.Coin{coin.NewCoinp(0, 1000, "FOO")},
+			}
+			if err := gconf.Save(kv, "cash", &conf); err != nil {
+				t.Fatalf("cannot save configuration: %s", err)
+			}
+			for _, c := range []coin.Coin{coin.NewCoin(10, 0, "FOO"), coin.NewCoin(10, 0, "BAR")} {
+				if err := controller.CoinMint(ctx, kv, source.Address(), c); err != nil {
+					t.Fatalf("cannot mint: %s", err)
+				}
+			}
+
+			tx := &weavetest.Tx{Msg: tc.msg}
+			if _, err := h.Check(nil, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := h.Deliver(nil, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestSetWalletName(t *testing.T) {
+	ctx := context.Background()
+	alice := weavetest.NewCondition()
+	bob := weavetest.NewCondition()
+	empty := weavetest.NewCondition()
+
+	cases := map[string]struct {
+		signer  weave.Condition
+		msg     *SetWalletNameMsg
+		wantErr *errors.Error
+		// wantOwner is the expected owner of the name after the message
+		// is processed.
+		wantOwner weave.Address
+	}{
+		"set a name": {
+			signer:    alice,
+			msg:       &SetWalletNameMsg{Address: alice.Address(), Name: "alice_new"},
+			wantOwner: alice.Address(),
+		},
+		"change the name": {
+			signer:    bob,
+			msg:       &SetWalletNameMsg{Address: bob.Address(), Name: "bob_new"},
+			wantOwner: bob.Address(),
+		},
+		"set the same name again": {
+			signer:    bob,
+			msg:       &SetWalletNameMsg{Address: bob.Address(), Name: "bob_wallet"},
+			wantOwner: bob.Address(),
+		},
+		"remove the name": {
+			signer: bob,
+			msg:    &SetWalletNameMsg{Address: bob.Address(), Name: ""},
+		},
+		"name used by another wallet": {
+			signer:    alice,
+			msg:       &SetWalletNameMsg{Address: alice.Address(), Name: "bob_wallet"},
+			wantErr:   errors.ErrDuplicate,
+			wantOwner: bob.Address(),
+		},
+		"owner signature missing": {
+			signer:  bob,
+			msg:     &SetWalletNameMsg{Address: alice.Address(), Name: "alice_new"},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"wallet does not exist": {
+			signer:  empty,
+			msg:     &SetWalletNameMsg{Address: empty.Address(), Name: "empty"},
+			wantErr: errors.ErrNotFound,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			kv := store.MemStore()
+			migration.MustInitPkg(kv, "cash")
+
+			bucket := NewBucket()
+			ctrl := NewController(bucket)
+			for _, addr := range []weave.Address{alice.Address(), bob.Address()} {
+				if err := ctrl.CoinMint(ctx, kv, addr, coin.NewCoin(1, 0, "IOV")); err != nil {
+					t.Fatalf("cannot mint: %s", err)
+				}
+			}
+			bobWallet, err := bucket.Get(kv, bob.Address())
+			if err != nil {
+				t.Fatalf("cannot get wallet: %s", err)
+			}
+			bobWallet.Value().(*Set).Name = "bob_wallet"
+			if err := bucket.Save(kv, bobWallet); err != nil {
+				t.Fatalf("cannot save wallet: %s", err)
+			}
+
+			tc.msg.Metadata = &weave.Metadata{Schema: 1}
+			h := NewSetWalletNameHandler(&weavetest.Auth{Signer: tc.signer})
+			tx := &weavetest.Tx{Msg: tc.msg}
+			cache := kv.CacheWrap()
+			if _, err := h.Check(nil, cache, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			cache.Discard()
+			if _, err := h.Deliver(nil, kv, tx); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+
+			if tc.msg.Name == "" {
+				return
+			}
+			owners, err := bucket.GetIndexed(kv, "name", []byte(tc.msg.Name))
+			if err != nil {
+				t.Fatalf("cannot query name index: %s", err)
+			}
+			switch {
+			case tc.wantOwner == nil && len(owners) != 0:
+				t.Fatalf("want no owner, got %s", weave.Address(owners[0].Key()))
+			case tc.wantOwner != nil && (len(owners) != 1 || !tc.wantOwner.Equals(owners[0].Key())):
+				t.Fatalf("want %s to own the name, got %d owners", tc.wantOwner, len(owners))
+			}
+		})
+	}
+}
+
+func TestFuzzSend(t *testing.T) {
+	perm := weavetest.NewCondition()
+	foo := coin.NewCoin(100, 0, "FOO")
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+	if err := NewBucket().Save(kv, must(WalletWith(perm.Address(), &foo))); err != nil {
+		t.Fatalf("cannot save wallet: %s", err)
+	}
+
+	auth := &weavetest.Auth{Signer: perm}
+	h := NewSendHandler(auth, NewController(NewBucket()))
+	msg := &SendMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Amount:      coin.NewCoinp(10, 0, "FOO"),
+		Source:      perm.Address(),
+		Destination: weavetest.NewCondition().Address(),
+	}
+	weavetest.FuzzHandler(t, h, context.Background(), kv, msg, 500)
+}
+
+func BenchmarkSendHandler(b *testing.B) {
+	perm := weavetest.NewCondition()
+	foo := coin.NewCoin(100, 0, "FOO")
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+	bucket := NewBucket()
+	if err := bucket.Save(kv, must(WalletWith(perm.Address(), &foo))); err != nil {
+		b.Fatalf("cannot save wallet: %s", err)
+	}
+	// Populate the store with other wallets, so that the handler does not
+	// operate on an almost empty database.
+	for i := 0; i < 1000; i++ {
+		addr := weave.NewCondition("test", "wallet", weavetest.SequenceID(uint64(i+1))).Address()
+		if err := bucket.Save(kv, must(WalletWith(addr, &foo))); err != nil {
+			b.Fatalf("cannot save wallet: %s", err)
+		}
+	}
+
+	auth := &weavetest.Auth{Signer: perm}
+	h := NewSendHandler(auth, NewController(bucket))
+	tx := &weavetest.Tx{Msg: &SendMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Amount:      coin.NewCoinp(10, 0, "FOO"),
+		Source:      perm.Address(),
+		Destination: weavetest.NewCondition().Address(),
+	}}
+	weavetest.BenchmarkHandler(b, h, context.Background(), kv, tx)
+}
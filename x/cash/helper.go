@@ -6,9 +6,9 @@ import (
 	"github.com/iov-one/weave/errors"
 )
 
-func MoveCoins(db weave.KVStore, bank CoinMover, src, dest weave.Address, amounts []*coin.Coin) error {
+func MoveCoins(ctx weave.Context, db weave.KVStore, bank CoinMover, src, dest weave.Address, amounts []*coin.Coin) error {
 	for _, c := range amounts {
-		err := bank.MoveCoins(db, src, dest, *c)
+		err := bank.MoveCoins(ctx, db, src, dest, *c)
 		if err != nil {
 			return errors.Wrapf(err, "failed to move %q", c.String())
 		}
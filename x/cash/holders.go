@@ -0,0 +1,111 @@
+package cash
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+const (
+	// walletTickerIndex is the name of the wallet index that allows to
+	// find all holders of a currency.
+	walletTickerIndex = "ticker"
+
+	// holdersPageSize is the maximum number of wallets returned by a single
+	// holders query.
+	holdersPageSize = 100
+)
+
+// idxWalletTicker indexes a wallet by each currency it holds. Index key is
+// the ticker followed by the wallet address, so that all holders of a
+// currency are stored next to each other, ordered by address.
+func idxWalletTicker(obj orm.Object) ([][]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	coins := AsCoins(obj)
+	keys := make([][]byte, 0, len(coins))
+	for _, c := range coins {
+		keys = append(keys, holderKey(c.Ticker, obj.Key()))
+	}
+	return keys, nil
+}
+
+func holderKey(ticker string, addr []byte) []byte {
+	key := make([]byte, 0, len(ticker)+1+len(addr))
+	key = append(key, ticker...)
+	key = append(key, ':')
+	return append(key, addr...)
+}
+
+// HoldersQuery lists wallets holding coins of a given currency. Results are
+// ordered by the wallet address and split into pages of at most 100
+// wallets.
+//
+// Query data is the ticker, optionally followed by a colon and the address
+// of the last wallet of the previous page, for example "IOV" to request the
+// first page and "IOV:<address>" to request the page that follows the
+// given address.
+type HoldersQuery struct {
+	index orm.Index
+}
+
+var _ weave.QueryHandler = HoldersQuery{}
+
+// NewHoldersQuery returns a query handler that reads the wallet ticker
+// index.
+func NewHoldersQuery() HoldersQuery {
+	// This must be the same index as the one registered on the wallet
+	// bucket by NewBucket.
+	index := orm.NewMultiKeyIndex(BucketName+"_"+walletTickerIndex, idxWalletTicker, true, nil)
+	return HoldersQuery{index: index}
+}
+
+// Query handles queries from the QueryRouter.
+func (q HoldersQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
+	}
+
+	ticker := data
+	start := q.index.IndexKey(holderKey(string(ticker), nil))
+	if i := bytes.IndexByte(data, ':'); i >= 0 {
+		ticker = data[:i]
+		// Appending a zero byte creates the smallest key that is
+		// greater than the key of the last returned wallet.
+		start = append(q.index.IndexKey(data), 0)
+	}
+	if len(ticker) == 0 {
+		return nil, errors.Wrap(errors.ErrInput, "ticker required")
+	}
+	// Colon is followed by a semicolon in ASCII, so this is the first key
+	// after all keys with the ticker prefix.
+	end := q.index.IndexKey(append(append([]byte{}, ticker...), ';'))
+
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create iterator")
+	}
+	defer it.Release()
+
+	wallets := NewBucket()
+	var res []weave.Model
+	for len(res) < holdersPageSize {
+		_, addr, err := it.Next()
+		if err != nil {
+			if errors.ErrIteratorDone.Is(err) {
+				break
+			}
+			return nil, errors.Wrap(err, "cannot read index")
+		}
+		key := wallets.DBKey(addr)
+		value, err := db.Get(key)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot load wallet")
+		}
+		res = append(res, weave.Model{Key: key, Value: value})
+	}
+	return res, nil
+}
@@ -0,0 +1,92 @@
+package cash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestHoldersQuery(t *testing.T) {
+	ctx := context.Background()
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+
+	ctrl := NewController(NewBucket())
+	holders := make(map[string]bool)
+	for i := 0; i < 250; i++ {
+		addr := weavetest.NewCondition().Address()
+		if err := ctrl.CoinMint(ctx, db, addr, coin.NewCoin(1, 0, "IOV")); err != nil {
+			t.Fatalf("cannot mint: %s", err)
+		}
+		holders[addr.String()] = true
+	}
+	// Wallets that do not hold IOV must not be listed.
+	for i := 0; i < 20; i++ {
+		addr := weavetest.NewCondition().Address()
+		if err := ctrl.CoinMint(ctx, db, addr, coin.NewCoin(1, 0, "ETH")); err != nil {
+			t.Fatalf("cannot mint: %s", err)
+		}
+	}
+	// A wallet that no longer holds IOV must not be listed.
+	gone := weavetest.NewCondition().Address()
+	if err := ctrl.CoinMint(ctx, db, gone, coin.NewCoin(1, 0, "IOV")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+	if err := ctrl.CoinBurn(db, gone, coin.NewCoin(1, 0, "IOV")); err != nil {
+		t.Fatalf("cannot burn: %s", err)
+	}
+
+	q := NewHoldersQuery()
+	bucket := NewBucket()
+	found := make(map[string]bool)
+	pages := 0
+	data := []byte("IOV")
+	for {
+		models, err := q.Query(db, weave.KeyQueryMod, data)
+		if err != nil {
+			t.Fatalf("cannot query: %s", err)
+		}
+		if len(models) == 0 {
+			break
+		}
+		if len(models) > holdersPageSize {
+			t.Fatalf("page too big: %d", len(models))
+		}
+		pages++
+		var last weave.Address
+		for _, m := range models {
+			if _, err := bucket.Parse(m.Key, m.Value); err != nil {
+				t.Fatalf("cannot parse wallet: %s", err)
+			}
+			// Model key is prefixed with the bucket name.
+			last = m.Key[len(BucketName)+1:]
+			if found[last.String()] {
+				t.Fatalf("wallet %s returned twice", last)
+			}
+			found[last.String()] = true
+		}
+		data = append([]byte("IOV:"), last...)
+	}
+
+	if pages != 3 {
+		t.Errorf("want 3 pages, got %d", pages)
+	}
+	if len(found) != len(holders) {
+		t.Fatalf("want %d holders, got %d", len(holders), len(found))
+	}
+	for addr := range found {
+		if !holders[addr] {
+			t.Errorf("unexpected holder %s", addr)
+		}
+	}
+
+	if _, err := q.Query(db, weave.KeyQueryMod, nil); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error, got %+v", err)
+	}
+}
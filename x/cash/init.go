@@ -1,9 +1,12 @@
 package cash
 
 import (
+	"context"
+
 	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
-	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
 )
 
 // GenesisAccount is used to parse the json from genesis file
@@ -27,6 +30,7 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 		return errors.Wrap(err, "read cash attribute")
 	}
 	bucket := NewBucket()
+	supply := NewSupplyBucket()
 	for _, acct := range accts {
 		if err := acct.Address.Validate(); err != nil {
 			return err
@@ -39,11 +43,64 @@ func (Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, k
 		if err != nil {
 			return err
 		}
+		for _, c := range acct.Set.Coins {
+			if err := updateSupply(kv, supply, *c); err != nil {
+				return errors.Wrap(err, "supply")
+			}
+		}
+	}
+
+	if err := initVestings(opts, kv); err != nil {
+		return errors.Wrap(err, "vesting")
 	}
 
-	if err := gconf.InitConfig(kv, opts, "cash", &Configuration{}); err != nil {
+	if err := migration.InitConfig(kv, opts, "cash", &Configuration{}); err != nil {
 		return errors.Wrap(err, "init config")
 	}
 
 	return nil
 }
+
+// GenesisVesting is used to parse vesting schedules from the genesis file.
+// Vested coins are created in the wallet of the beneficiary and locked.
+type GenesisVesting struct {
+	Beneficiary weave.Address  `json:"beneficiary"`
+	Amount      []*coin.Coin   `json:"amount"`
+	StartTime   weave.UnixTime `json:"start_time"`
+	CliffTime   weave.UnixTime `json:"cliff_time"`
+	EndTime     weave.UnixTime `json:"end_time"`
+}
+
+func initVestings(opts weave.Options, kv weave.KVStore) error {
+	var vestings []GenesisVesting
+	if err := opts.ReadOptions("vesting", &vestings); err != nil {
+		return errors.Wrap(err, "read vesting attribute")
+	}
+	bucket := NewVestingBucket()
+	ctrl := NewController(NewBucket())
+	for _, v := range vestings {
+		key, err := vestingSeq.NextVal(kv)
+		if err != nil {
+			return errors.Wrap(err, "cannot acquire key")
+		}
+		vesting := Vesting{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Beneficiary: v.Beneficiary,
+			Amount:      v.Amount,
+			StartTime:   v.StartTime,
+			CliffTime:   v.CliffTime,
+			EndTime:     v.EndTime,
+		}
+		if _, err := bucket.Put(kv, key, &vesting); err != nil {
+			return errors.Wrap(err, "cannot save vesting")
+		}
+		// Genesis has no block height, wallets are created at height zero.
+		ctx := context.Background()
+		for _, c := range v.Amount {
+			if err := ctrl.CoinMint(ctx, kv, vesting.Beneficiary, *c); err != nil {
+				return errors.Wrap(err, "cannot issue coins")
+			}
+		}
+	}
+	return nil
+}
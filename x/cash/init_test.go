@@ -83,6 +83,10 @@ func TestInitState(t *testing.T) {
 				assert.Equal(t, true, acct != nil)
 				for i := range tc.wallet.Coins {
 					assert.Equal(t, tc.wallet.Coins[i], AsCoins(acct)[i])
+
+					total, err := TotalSupply(kv, tc.wallet.Coins[i].Ticker)
+					assert.Nil(t, err)
+					assert.Equal(t, *tc.wallet.Coins[i], total)
 				}
 			}
 		})
@@ -0,0 +1,169 @@
+package cash
+
+import (
+	"sort"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+)
+
+// CheckSupplyInvariant ensures that for every currency the sum of all wallet
+// balances is equal to the tracked total supply. Coins held by extensions
+// (escrows, payment channels, vestings) are stored in wallets as well, so
+// they are included in the sum. Currencies without a tracked supply are
+// skipped, see SeedSupply.
+// An ErrState error describing all mismatches is returned if the invariant
+// does not hold.
+func CheckSupplyInvariant(db weave.ReadOnlyKVStore) error {
+	balances, err := sumWallets(db)
+	if err != nil {
+		return errors.Wrap(err, "cannot sum wallets")
+	}
+	supplies, err := allSupplies(db)
+	if err != nil {
+		return errors.Wrap(err, "cannot load supply")
+	}
+
+	// Currencies without a supply record are not tracked, which is the
+	// case for chains started without supply tracking that were not
+	// seeded yet.
+	tickers := make([]string, 0, len(supplies))
+	for t := range supplies {
+		tickers = append(tickers, t)
+	}
+	// Sort to always return the same error message.
+	sort.Strings(tickers)
+
+	var errs error
+	for _, t := range tickers {
+		balance, supply := balances[t], supplies[t]
+		if balance.Whole != supply.Whole || balance.Fractional != supply.Fractional {
+			errs = errors.Append(errs, errors.Wrapf(errors.ErrState,
+				"%s wallets hold %d.%09d and supply is %d.%09d",
+				t, balance.Whole, balance.Fractional, supply.Whole, supply.Fractional))
+		}
+	}
+	return errs
+}
+
+// sumWallets returns the sum of all wallet balances for each currency.
+func sumWallets(db weave.ReadOnlyKVStore) (map[string]coin.Coin, error) {
+	it, err := db.Iterator([]byte(BucketName+":"), []byte(BucketName+";"))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create iterator")
+	}
+	defer it.Release()
+
+	sums := make(map[string]coin.Coin)
+	for {
+		_, value, err := it.Next()
+		if err != nil {
+			if errors.ErrIteratorDone.Is(err) {
+				return sums, nil
+			}
+			return nil, err
+		}
+		var s Set
+		if err := s.Unmarshal(value); err != nil {
+			return nil, errors.Wrap(err, "cannot unmarshal wallet")
+		}
+		for _, c := range s.Coins {
+			sum, ok := sums[c.Ticker]
+			if !ok {
+				sum = coin.NewCoin(0, 0, c.Ticker)
+			}
+			if sums[c.Ticker], err = sum.Add(*c); err != nil {
+				return nil, errors.Wrapf(err, "cannot sum %s", c.Ticker)
+			}
+		}
+	}
+}
+
+// allSupplies returns the tracked total supply of each currency.
+func allSupplies(db weave.ReadOnlyKVStore) (map[string]coin.Coin, error) {
+	it, err := db.Iterator([]byte("supply:"), []byte("supply;"))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create iterator")
+	}
+	defer it.Release()
+
+	supplies := make(map[string]coin.Coin)
+	for {
+		_, value, err := it.Next()
+		if err != nil {
+			if errors.ErrIteratorDone.Is(err) {
+				return supplies, nil
+			}
+			return nil, err
+		}
+		var s Supply
+		if err := s.Unmarshal(value); err != nil {
+			return nil, errors.Wrap(err, "cannot unmarshal supply")
+		}
+		supplies[s.Total.Ticker] = s.Total
+	}
+}
+
+// SupplyInvariantQuery runs the supply invariant check on query. An error is
+// returned if the invariant does not hold, otherwise the result is empty.
+type SupplyInvariantQuery struct{}
+
+var _ weave.QueryHandler = SupplyInvariantQuery{}
+
+// Query handles queries from the QueryRouter.
+func (SupplyInvariantQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
+	}
+	if err := CheckSupplyInvariant(db); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
+
+// SupplyInvariantTicker wraps a ticker and checks the supply invariant at
+// the beginning of every n-th block.
+type SupplyInvariantTicker struct {
+	next  weave.Ticker
+	every int64
+	halt  bool
+}
+
+var _ weave.Ticker = SupplyInvariantTicker{}
+
+// NewSupplyInvariantTicker returns a ticker that calls the next ticker and
+// then checks the supply invariant every given number of blocks. Next ticker
+// can be nil. If halt is true, the node is stopped when the invariant does
+// not hold. Otherwise the error is only logged.
+func NewSupplyInvariantTicker(next weave.Ticker, every int64, halt bool) SupplyInvariantTicker {
+	if every < 1 {
+		panic("block interval must be positive")
+	}
+	return SupplyInvariantTicker{
+		next:  next,
+		every: every,
+		halt:  halt,
+	}
+}
+
+// Tick implements weave.Ticker interface.
+func (t SupplyInvariantTicker) Tick(ctx weave.Context, db weave.CacheableKVStore) weave.TickResult {
+	var res weave.TickResult
+	if t.next != nil {
+		res = t.next.Tick(ctx, db)
+	}
+
+	height, ok := weave.GetHeight(ctx)
+	if !ok || height%t.every != 0 {
+		return res
+	}
+	if err := CheckSupplyInvariant(db); err != nil {
+		if t.halt {
+			// State is broken and the node cannot continue.
+			panic(errors.Wrapf(err, "supply invariant at height %d", height))
+		}
+		weave.GetLogger(ctx).Error("Supply invariant broken", "height", height, "err", err)
+	}
+	return res
+}
@@ -0,0 +1,117 @@
+package cash
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestCheckSupplyInvariant(t *testing.T) {
+	ctx := context.Background()
+	addr1 := weavetest.NewCondition().Address()
+	addr2 := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		prepare func(t testing.TB, db weave.KVStore, ctrl Controller)
+		wantErr *errors.Error
+	}{
+		"empty state": {
+			prepare: func(t testing.TB, db weave.KVStore, ctrl Controller) {},
+		},
+		"minted, moved and burned coins": {
+			prepare: func(t testing.TB, db weave.KVStore, ctrl Controller) {
+				mustMint(t, db, ctrl, addr1, coin.NewCoin(10, 0, "IOV"))
+				mustMint(t, db, ctrl, addr1, coin.NewCoin(0, 5, "ETH"))
+				if err := ctrl.MoveCoins(ctx, db, addr1, addr2, coin.NewCoin(3, 1, "IOV")); err != nil {
+					t.Fatalf("cannot move: %s", err)
+				}
+				if err := ctrl.CoinBurn(db, addr2, coin.NewCoin(1, 0, "IOV")); err != nil {
+					t.Fatalf("cannot burn: %s", err)
+				}
+			},
+		},
+		"wallet created without updating the supply": {
+			prepare: func(t testing.TB, db weave.KVStore, ctrl Controller) {
+				mustMint(t, db, ctrl, addr1, coin.NewCoin(10, 0, "IOV"))
+				w, err := WalletWith(addr2, coin.NewCoinp(1, 0, "IOV"))
+				if err != nil {
+					t.Fatalf("cannot create wallet: %s", err)
+				}
+				if err := NewBucket().Save(db, w); err != nil {
+					t.Fatalf("cannot save wallet: %s", err)
+				}
+			},
+			wantErr: errors.ErrState,
+		},
+		"currency without a supply record": {
+			prepare: func(t testing.TB, db weave.KVStore, ctrl Controller) {
+				mustMint(t, db, ctrl, addr1, coin.NewCoin(10, 0, "IOV"))
+				w, err := WalletWith(addr2, coin.NewCoinp(1, 0, "ETH"))
+				if err != nil {
+					t.Fatalf("cannot create wallet: %s", err)
+				}
+				if err := NewBucket().Save(db, w); err != nil {
+					t.Fatalf("cannot save wallet: %s", err)
+				}
+			},
+		},
+		"supply of a currency that no wallet holds": {
+			prepare: func(t testing.TB, db weave.KVStore, ctrl Controller) {
+				if err := updateSupply(db, NewSupplyBucket(), coin.NewCoin(1, 0, "ETH")); err != nil {
+					t.Fatalf("cannot update supply: %s", err)
+				}
+			},
+			wantErr: errors.ErrState,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "cash")
+			tc.prepare(t, db, NewController(NewBucket()))
+
+			if err := CheckSupplyInvariant(db); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if _, err := (SupplyInvariantQuery{}).Query(db, weave.KeyQueryMod, nil); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected query error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestSupplyInvariantTicker(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	if err := updateSupply(db, NewSupplyBucket(), coin.NewCoin(1, 0, "ETH")); err != nil {
+		t.Fatalf("cannot update supply: %s", err)
+	}
+
+	ticker := NewSupplyInvariantTicker(nil, 10, true)
+
+	// Invariant is not checked at this height.
+	ctx := weave.WithHeight(context.Background(), 11)
+	ticker.Tick(ctx, db)
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("want panic")
+		}
+	}()
+	ctx = weave.WithHeight(context.Background(), 20)
+	ticker.Tick(ctx, db)
+}
+
+func mustMint(t testing.TB, db weave.KVStore, ctrl Controller, addr weave.Address, amount coin.Coin) {
+	t.Helper()
+	if err := ctrl.CoinMint(context.Background(), db, addr, amount); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+}
@@ -1,6 +1,8 @@
 package cash
 
 import (
+	"regexp"
+
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
@@ -12,6 +14,8 @@ import (
 func init() {
 	migration.MustRegister(1, &Set{}, migration.NoModification)
 	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+
+	migration.MustRegisterBucket(NewBucket().Bucket)
 }
 
 // BucketName is where we store the balances
@@ -20,11 +24,20 @@ const BucketName = "cash"
 var _ orm.CloneableData = (*Set)(nil)
 var _ Coinage = (*Set)(nil)
 
+// IsWalletName checks if the name is a valid wallet name.
+var IsWalletName = regexp.MustCompile(`^[a-z0-9_]{4,20}$`).MatchString
+
 // Validate requires that all coins are in alphabetical
 func (s *Set) Validate() error {
 	var errs error
 	errs = errors.AppendField(errs, "Metadata", s.Metadata.Validate())
 	errs = errors.AppendField(errs, "Coins", XCoins(s).Validate())
+	if s.Name != "" && !IsWalletName(s.Name) {
+		errs = errors.Append(errs, errors.Field("Name", errors.ErrInput, "invalid wallet name"))
+	}
+	if s.CreationHeight < 0 {
+		errs = errors.Append(errs, errors.Field("CreationHeight", errors.ErrInput, "must not be negative"))
+	}
 	return errs
 }
 
@@ -116,10 +129,14 @@ type Bucket struct {
 
 var _ WalletBucket = Bucket{}
 
-// NewBucket initializes a cash.Bucket with default name
+// NewBucket initializes a cash.Bucket with default name. Wallets are indexed
+// by each currency they hold and by their name.
 func NewBucket() Bucket {
+	b := migration.NewBucket("cash", BucketName, &Set{}).
+		WithMultiKeyIndex(walletTickerIndex, idxWalletTicker, true).
+		WithIndex(walletNameIndex, idxWalletName, true)
 	return Bucket{
-		Bucket: migration.NewBucket("cash", BucketName, &Set{}),
+		Bucket: b,
 	}
 }
 
@@ -133,6 +150,26 @@ func (b Bucket) GetOrCreate(db weave.KVStore, key weave.Address) (orm.Object, er
 	return obj, err
 }
 
+// walletNameIndex is the name of the wallet index that allows to find a
+// wallet by its name.
+const walletNameIndex = "name"
+
+// idxWalletName indexes a wallet by its name. Wallets without a name are
+// not indexed.
+func idxWalletName(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	s, ok := obj.Value().(*Set)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "can only take index of Set")
+	}
+	if s.Name == "" {
+		return nil, nil
+	}
+	return []byte(s.Name), nil
+}
+
 // WalletBucket is what we expect to be able to do with wallets
 // The object it returns must support AsSet (only checked runtime :()
 type WalletBucket interface {
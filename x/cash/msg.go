@@ -1,6 +1,8 @@
 package cash
 
 import (
+	"fmt"
+
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
@@ -9,14 +11,28 @@ import (
 
 func init() {
 	migration.MustRegister(1, &SendMsg{}, migration.NoModification)
+	migration.MustRegister(1, &MultiSendMsg{}, migration.NoModification)
+	migration.MustRegister(1, &BurnMsg{}, migration.NoModification)
+	migration.MustRegister(1, &MintMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ApproveMsg{}, migration.NoModification)
+	migration.MustRegister(1, &TransferFromMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CreateVestingMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ReleaseVestingMsg{}, migration.NoModification)
+	migration.MustRegister(1, &FreezeAccountMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UnfreezeAccountMsg{}, migration.NoModification)
+	migration.MustRegister(1, &SetWalletNameMsg{}, migration.NoModification)
+	migration.MustRegister(1, &GrantFeeMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ScheduleSendMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ExecuteScheduledSendMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CancelScheduledSendMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
 }
 
 const (
-	sendTxCost int64 = 100
-
 	maxMemoSize int = 128
 	maxRefSize  int = 64
+
+	maxOutputs int = 100
 )
 
 var _ weave.Msg = (*SendMsg)(nil)
@@ -63,6 +79,321 @@ func (s *SendMsg) DefaultSource(addr []byte) *SendMsg {
 	}
 }
 
+var _ weave.Msg = (*MultiSendMsg)(nil)
+
+// Path returns the routing path for this message.
+func (MultiSendMsg) Path() string {
+	return "cash/multi_send"
+}
+
+// Validate makes sure that this is sensible.
+func (m *MultiSendMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Source", m.Source.Validate())
+	switch n := len(m.Outputs); {
+	case n == 0:
+		errs = errors.Append(errs, errors.Field("Outputs", errors.ErrEmpty, "required"))
+	case n > maxOutputs:
+		errs = errors.Append(errs, errors.Field("Outputs", errors.ErrInput, "too many"))
+	}
+	for i, o := range m.Outputs {
+		if coin.IsEmpty(o.Amount) || !o.Amount.IsPositive() {
+			errs = errors.Append(errs, errors.Field(fmt.Sprintf("Outputs.%d.Amount", i), errors.ErrAmount, "must be positive"))
+		} else {
+			errs = errors.AppendField(errs, fmt.Sprintf("Outputs.%d.Amount", i), o.Amount.Validate())
+		}
+		errs = errors.AppendField(errs, fmt.Sprintf("Outputs.%d.Destination", i), o.Destination.Validate())
+	}
+	if len(m.Memo) > maxMemoSize {
+		errs = errors.Append(errs, errors.Field("Memo", errors.ErrState, "too long"))
+	}
+	if len(m.Ref) > maxRefSize {
+		errs = errors.Append(errs, errors.Field("Ref", errors.ErrState, "too long"))
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*BurnMsg)(nil)
+
+// Path returns the routing path for this message.
+func (BurnMsg) Path() string {
+	return "cash/burn"
+}
+
+// Validate makes sure that this is sensible.
+func (m *BurnMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Source", m.Source.Validate())
+	if coin.IsEmpty(m.Amount) || !m.Amount.IsPositive() {
+		errs = errors.Append(errs, errors.Field("Amount", errors.ErrAmount, "must be positive"))
+	} else {
+		errs = errors.AppendField(errs, "Amount", m.Amount.Validate())
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*MintMsg)(nil)
+
+// Path returns the routing path for this message.
+func (MintMsg) Path() string {
+	return "cash/mint"
+}
+
+// Validate makes sure that this is sensible.
+func (m *MintMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Destination", m.Destination.Validate())
+	if coin.IsEmpty(m.Amount) || !m.Amount.IsPositive() {
+		errs = errors.Append(errs, errors.Field("Amount", errors.ErrAmount, "must be positive"))
+	} else {
+		errs = errors.AppendField(errs, "Amount", m.Amount.Validate())
+	}
+	if len(m.Memo) > maxMemoSize {
+		errs = errors.Append(errs, errors.Field("Memo", errors.ErrState, "too long"))
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*ApproveMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ApproveMsg) Path() string {
+	return "cash/approve"
+}
+
+// Validate makes sure that this is sensible.
+func (m *ApproveMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Owner", m.Owner.Validate())
+	errs = errors.AppendField(errs, "Spender", m.Spender.Validate())
+	if m.Owner.Equals(m.Spender) {
+		errs = errors.Append(errs, errors.Field("Spender", errors.ErrInput, "must be different than owner"))
+	}
+	// Empty amount is allowed and revokes the allowance.
+	if amount := coin.Coins(m.Amount); !amount.IsEmpty() {
+		errs = errors.AppendField(errs, "Amount", amount.Validate())
+		if !amount.IsPositive() {
+			errs = errors.Append(errs, errors.Field("Amount", errors.ErrAmount, "must be positive"))
+		}
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*TransferFromMsg)(nil)
+
+// Path returns the routing path for this message.
+func (TransferFromMsg) Path() string {
+	return "cash/transfer_from"
+}
+
+// Validate makes sure that this is sensible.
+func (m *TransferFromMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Owner", m.Owner.Validate())
+	errs = errors.AppendField(errs, "Spender", m.Spender.Validate())
+	errs = errors.AppendField(errs, "Destination", m.Destination.Validate())
+	if coin.IsEmpty(m.Amount) || !m.Amount.IsPositive() {
+		errs = errors.Append(errs, errors.Field("Amount", errors.ErrAmount, "must be positive"))
+	} else {
+		errs = errors.AppendField(errs, "Amount", m.Amount.Validate())
+	}
+	if len(m.Memo) > maxMemoSize {
+		errs = errors.Append(errs, errors.Field("Memo", errors.ErrState, "too long"))
+	}
+	if len(m.Ref) > maxRefSize {
+		errs = errors.Append(errs, errors.Field("Ref", errors.ErrState, "too long"))
+	}
+
+	return errs
+}
+
+var _ weave.Msg = (*CreateVestingMsg)(nil)
+
+// Path returns the routing path for this message.
+func (CreateVestingMsg) Path() string {
+	return "cash/create_vesting"
+}
+
+// Validate makes sure that this is sensible.
+func (m *CreateVestingMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Source", m.Source.Validate())
+	errs = errors.AppendField(errs, "Beneficiary", m.Beneficiary.Validate())
+	if amount := coin.Coins(m.Amount); !amount.IsPositive() {
+		errs = errors.Append(errs, errors.Field("Amount", errors.ErrAmount, "must be positive"))
+	} else {
+		errs = errors.AppendField(errs, "Amount", amount.Validate())
+	}
+	errs = errors.Append(errs, validateSchedule(m.StartTime, m.CliffTime, m.EndTime))
+
+	return errs
+}
+
+var _ weave.Msg = (*ReleaseVestingMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ReleaseVestingMsg) Path() string {
+	return "cash/release_vesting"
+}
+
+// Validate makes sure that this is sensible.
+func (m *ReleaseVestingMsg) Validate() error {
+	if len(m.VestingId) == 0 {
+		return errors.Field("VestingId", errors.ErrEmpty, "required")
+	}
+	return nil
+}
+
+var _ weave.Msg = (*FreezeAccountMsg)(nil)
+
+// Path returns the routing path for this message.
+func (FreezeAccountMsg) Path() string {
+	return "cash/freeze_account"
+}
+
+// Validate makes sure that this is sensible.
+func (m *FreezeAccountMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+	if len(m.Reason) > maxMemoSize {
+		errs = errors.Append(errs, errors.Field("Reason", errors.ErrInput, "too long"))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*UnfreezeAccountMsg)(nil)
+
+// Path returns the routing path for this message.
+func (UnfreezeAccountMsg) Path() string {
+	return "cash/unfreeze_account"
+}
+
+// Validate makes sure that this is sensible.
+func (m *UnfreezeAccountMsg) Validate() error {
+	return errors.AppendField(nil, "Address", m.Address.Validate())
+}
+
+var _ weave.Msg = (*SetWalletNameMsg)(nil)
+
+// Path returns the routing path for this message.
+func (SetWalletNameMsg) Path() string {
+	return "cash/set_wallet_name"
+}
+
+// Validate makes sure that this is sensible.
+func (m *SetWalletNameMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Address", m.Address.Validate())
+	// Empty name is allowed and removes the current name.
+	if m.Name != "" && !IsWalletName(m.Name) {
+		errs = errors.Append(errs, errors.Field("Name", errors.ErrInput, "invalid wallet name"))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*GrantFeeMsg)(nil)
+
+// Path returns the routing path for this message.
+func (GrantFeeMsg) Path() string {
+	return "cash/grant_fee"
+}
+
+// Validate makes sure that this is sensible.
+func (m *GrantFeeMsg) Validate() error {
+	var errs error
+
+	errs = errors.AppendField(errs, "Granter", m.Granter.Validate())
+	errs = errors.AppendField(errs, "Grantee", m.Grantee.Validate())
+	if m.Granter.Equals(m.Grantee) {
+		errs = errors.Append(errs, errors.Field("Grantee", errors.ErrInput, "must be different than granter"))
+	}
+	// Empty budget is allowed and revokes the grant.
+	if budget := coin.Coins(m.Budget); !budget.IsEmpty() {
+		errs = errors.AppendField(errs, "Budget", budget.Validate())
+		if !budget.IsPositive() {
+			errs = errors.Append(errs, errors.Field("Budget", errors.ErrAmount, "must be positive"))
+		}
+		if m.Expiration == 0 {
+			errs = errors.Append(errs, errors.Field("Expiration", errors.ErrInput, "required"))
+		}
+	}
+	errs = errors.AppendField(errs, "Expiration", m.Expiration.Validate())
+
+	return errs
+}
+
+var _ weave.Msg = (*ScheduleSendMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ScheduleSendMsg) Path() string {
+	return "cash/schedule_send"
+}
+
+// Validate makes sure that this is sensible.
+func (m *ScheduleSendMsg) Validate() error {
+	var errs error
+
+	if coin.IsEmpty(m.Amount) || !m.Amount.IsPositive() {
+		errs = errors.Append(errs, errors.Field("Amount", errors.ErrAmount, "must be positive"))
+	} else {
+		errs = errors.AppendField(errs, "Amount", m.Amount.Validate())
+	}
+	errs = errors.AppendField(errs, "Source", m.Source.Validate())
+	errs = errors.AppendField(errs, "Destination", m.Destination.Validate())
+	if len(m.Memo) > maxMemoSize {
+		errs = errors.Append(errs, errors.Field("Memo", errors.ErrState, "too long"))
+	}
+	if len(m.Ref) > maxRefSize {
+		errs = errors.Append(errs, errors.Field("Ref", errors.ErrState, "too long"))
+	}
+	if m.SendAt == 0 {
+		errs = errors.Append(errs, errors.Field("SendAt", errors.ErrInput, "required"))
+	}
+	errs = errors.AppendField(errs, "SendAt", m.SendAt.Validate())
+
+	return errs
+}
+
+var _ weave.Msg = (*ExecuteScheduledSendMsg)(nil)
+
+// Path returns the routing path for this message.
+func (ExecuteScheduledSendMsg) Path() string {
+	return "cash/execute_scheduled_send"
+}
+
+// Validate makes sure that this is sensible.
+func (m *ExecuteScheduledSendMsg) Validate() error {
+	if len(m.ScheduledSendID) == 0 {
+		return errors.Field("ScheduledSendID", errors.ErrEmpty, "required")
+	}
+	return nil
+}
+
+var _ weave.Msg = (*CancelScheduledSendMsg)(nil)
+
+// Path returns the routing path for this message.
+func (CancelScheduledSendMsg) Path() string {
+	return "cash/cancel_scheduled_send"
+}
+
+// Validate makes sure that this is sensible.
+func (m *CancelScheduledSendMsg) Validate() error {
+	if len(m.ScheduledSendID) == 0 {
+		return errors.Field("ScheduledSendID", errors.ErrEmpty, "required")
+	}
+	return nil
+}
+
 // FeeTx exposes information about the fees that should be paid.
 type FeeTx interface {
 	GetFees() *FeeInfo
@@ -114,6 +445,12 @@ func (m *UpdateConfigurationMsg) Validate() error {
 	if len(c.Owner) != 0 {
 		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
 	}
+	if len(c.Minter) != 0 {
+		errs = errors.AppendField(errs, "Minter", c.Minter.Validate())
+	}
+	if len(c.Freezer) != 0 {
+		errs = errors.AppendField(errs, "Freezer", c.Freezer.Validate())
+	}
 	if len(c.CollectorAddress) != 0 {
 		errs = errors.AppendField(errs, "CollectorAddress", c.CollectorAddress.Validate())
 	}
@@ -145,3 +145,220 @@ func TestValidateFeeTx(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMultiSendMsg(t *testing.T) {
+	addr1 := weavetest.NewCondition().Address()
+	addr2 := weavetest.NewCondition().Address()
+	addr3 := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		msg     weave.Msg
+		wantErr *errors.Error
+	}{
+		"success": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs: []Output{
+					{Destination: addr2, Amount: coin.NewCoinp(10, 0, "FOO")},
+					{Destination: addr3, Amount: coin.NewCoinp(1, 0, "BAR")},
+				},
+				Memo: "some memo message",
+				Ref:  []byte("some reference"),
+			},
+			wantErr: nil,
+		},
+		"missing source": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Outputs: []Output{
+					{Destination: addr2, Amount: coin.NewCoinp(10, 0, "FOO")},
+				},
+			},
+			wantErr: errors.ErrEmpty,
+		},
+		"no outputs": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+			},
+			wantErr: errors.ErrEmpty,
+		},
+		"too many outputs": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs:  make([]Output, maxOutputs+1),
+			},
+			wantErr: errors.ErrInput,
+		},
+		"output without destination": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs: []Output{
+					{Destination: addr2, Amount: coin.NewCoinp(10, 0, "FOO")},
+					{Amount: coin.NewCoinp(10, 0, "FOO")},
+				},
+			},
+			wantErr: errors.ErrEmpty,
+		},
+		"output with zero amount": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs: []Output{
+					{Destination: addr2, Amount: coin.NewCoinp(0, 0, "FOO")},
+				},
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"memo too long": {
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr1,
+				Outputs: []Output{
+					{Destination: addr2, Amount: coin.NewCoinp(10, 0, "FOO")},
+				},
+				Memo: strings.Repeat("x", maxMemoSize+1),
+			},
+			wantErr: errors.ErrState,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.msg.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestValidateBurnMsg(t *testing.T) {
+	addr := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		msg     weave.Msg
+		wantErr *errors.Error
+	}{
+		"success": {
+			msg: &BurnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr,
+				Amount:   coin.NewCoinp(10, 0, "FOO"),
+			},
+			wantErr: nil,
+		},
+		"missing source": {
+			msg: &BurnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Amount:   coin.NewCoinp(10, 0, "FOO"),
+			},
+			wantErr: errors.ErrEmpty,
+		},
+		"missing amount": {
+			msg: &BurnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr,
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"negative amount": {
+			msg: &BurnMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   addr,
+				Amount:   coin.NewCoinp(-10, 0, "FOO"),
+			},
+			wantErr: errors.ErrAmount,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.msg.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestValidateApproveMsg(t *testing.T) {
+	owner := weavetest.NewCondition().Address()
+	spender := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		msg     weave.Msg
+		wantErr *errors.Error
+	}{
+		"success": {
+			msg: &ApproveMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Owner:    owner,
+				Spender:  spender,
+				Amount:   coin.Coins{coin.NewCoinp(10, 0, "FOO")},
+			},
+			wantErr: nil,
+		},
+		"revoke": {
+			msg: &ApproveMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Owner:    owner,
+				Spender:  spender,
+			},
+			wantErr: nil,
+		},
+		"spender is the owner": {
+			msg: &ApproveMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Owner:    owner,
+				Spender:  owner,
+				Amount:   coin.Coins{coin.NewCoinp(10, 0, "FOO")},
+			},
+			wantErr: errors.ErrInput,
+		},
+		"negative amount": {
+			msg: &ApproveMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Owner:    owner,
+				Spender:  spender,
+				Amount:   coin.Coins{coin.NewCoinp(-10, 0, "FOO")},
+			},
+			wantErr: errors.ErrAmount,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.msg.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestFuzzMsgs(t *testing.T) {
+	addr1 := weavetest.NewCondition().Address()
+	addr2 := weavetest.NewCondition().Address()
+	amount := coin.NewCoinp(1, 0, "IOV")
+
+	msgs := []weave.Msg{
+		&SendMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Amount:      amount,
+			Source:      addr1,
+			Destination: addr2,
+			Memo:        "memo",
+		},
+		&MultiSendMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			Source:   addr1,
+			Outputs: []Output{
+				{Amount: amount, Destination: addr2},
+			},
+		},
+	}
+	for _, msg := range msgs {
+		weavetest.FuzzValidate(t, msg, 1000)
+	}
+}
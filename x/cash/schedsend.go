@@ -0,0 +1,69 @@
+package cash
+
+import (
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+)
+
+func init() {
+	migration.MustRegister(1, &ScheduledSend{}, migration.NoModification)
+}
+
+var _ orm.Model = (*ScheduledSend)(nil)
+
+// Validate ensures the scheduled send is valid.
+func (s *ScheduledSend) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", s.Metadata.Validate())
+	errs = errors.AppendField(errs, "Source", s.Source.Validate())
+	errs = errors.AppendField(errs, "Destination", s.Destination.Validate())
+	errs = errors.AppendField(errs, "Address", s.Address.Validate())
+	if coin.IsEmpty(s.Amount) || !s.Amount.IsPositive() {
+		errs = errors.Append(errs, errors.Field("Amount", errors.ErrAmount, "must be positive"))
+	} else {
+		errs = errors.AppendField(errs, "Amount", s.Amount.Validate())
+	}
+	if len(s.Memo) > maxMemoSize {
+		errs = errors.Append(errs, errors.Field("Memo", errors.ErrState, "too long"))
+	}
+	if len(s.Ref) > maxRefSize {
+		errs = errors.Append(errs, errors.Field("Ref", errors.ErrState, "too long"))
+	}
+	if s.SendAt == 0 {
+		errs = errors.Append(errs, errors.Field("SendAt", errors.ErrInput, "required"))
+	}
+	errs = errors.AppendField(errs, "SendAt", s.SendAt.Validate())
+	return errs
+}
+
+// NewScheduledSendBucket returns a bucket for keeping track of scheduled
+// transfers. Scheduled send is indexed by the source address.
+func NewScheduledSendBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("schedsend", &ScheduledSend{},
+		orm.WithIDSequence(scheduledSendSeq),
+		orm.WithIndex("source", idxScheduledSendSource, false),
+	)
+	return migration.NewModelBucket("cash", b)
+}
+
+var scheduledSendSeq = orm.NewSequence("schedsend", "id")
+
+// ScheduledSendCondition calculates the address of a scheduled send given
+// the key.
+func ScheduledSendCondition(key []byte) weave.Condition {
+	return weave.NewCondition("schedsend", "seq", key)
+}
+
+func idxScheduledSendSource(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	s, ok := obj.Value().(*ScheduledSend)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "can only take index of ScheduledSend")
+	}
+	return s.Source, nil
+}
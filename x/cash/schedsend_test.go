@@ -0,0 +1,188 @@
+package cash
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestScheduledSend(t *testing.T) {
+	ctx := context.Background()
+	now := weave.AsUnixTime(time.Now())
+	source := weavetest.NewCondition()
+	dest := weavetest.NewCondition().Address()
+
+	ctrl := NewController(NewBucket())
+	cron := &weavetest.Cron{}
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	if err := ctrl.CoinMint(ctx, db, source.Address(), coin.NewCoin(10, 0, "IOV")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+
+	// First scheduled send is created with ID 1 and second with ID 2.
+	firstID := weavetest.SequenceID(1)
+	secondID := weavetest.SequenceID(2)
+
+	steps := []struct {
+		handler     weave.Handler
+		msg         weave.Msg
+		blockTime   weave.UnixTime
+		wantErr     *errors.Error
+		wantSource  coin.Coins
+		wantDest    coin.Coins
+		wantPending int
+	}{
+		{
+			handler: NewScheduleSendHandler(&weavetest.Auth{Signer: weavetest.NewCondition()}, ctrl, cron),
+			msg: &ScheduleSendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      source.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(4, 0, "IOV"),
+				SendAt:      now + 100,
+			},
+			blockTime:  now,
+			wantErr:    errors.ErrUnauthorized,
+			wantSource: coin.Coins{coin.NewCoinp(10, 0, "IOV")},
+		},
+		{
+			handler: NewScheduleSendHandler(&weavetest.Auth{Signer: source}, ctrl, cron),
+			msg: &ScheduleSendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      source.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(4, 0, "IOV"),
+				SendAt:      now,
+			},
+			blockTime:  now,
+			wantErr:    errors.ErrInput,
+			wantSource: coin.Coins{coin.NewCoinp(10, 0, "IOV")},
+		},
+		{
+			handler: NewScheduleSendHandler(&weavetest.Auth{Signer: source}, ctrl, cron),
+			msg: &ScheduleSendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      source.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(4, 0, "IOV"),
+				SendAt:      now + 100,
+			},
+			blockTime:   now,
+			wantSource:  coin.Coins{coin.NewCoinp(6, 0, "IOV")},
+			wantPending: 1,
+		},
+		{
+			handler: NewExecuteScheduledSendHandler(&weavetest.Auth{Signer: ScheduledSendCondition(firstID)}, ctrl),
+			msg: &ExecuteScheduledSendMsg{
+				Metadata:        &weave.Metadata{Schema: 1},
+				ScheduledSendID: firstID,
+			},
+			blockTime:   now + 99,
+			wantErr:     errors.ErrState,
+			wantSource:  coin.Coins{coin.NewCoinp(6, 0, "IOV")},
+			wantPending: 1,
+		},
+		{
+			handler: NewExecuteScheduledSendHandler(&weavetest.Auth{Signer: source}, ctrl),
+			msg: &ExecuteScheduledSendMsg{
+				Metadata:        &weave.Metadata{Schema: 1},
+				ScheduledSendID: firstID,
+			},
+			blockTime:   now + 100,
+			wantErr:     errors.ErrUnauthorized,
+			wantSource:  coin.Coins{coin.NewCoinp(6, 0, "IOV")},
+			wantPending: 1,
+		},
+		{
+			handler: NewExecuteScheduledSendHandler(&weavetest.Auth{Signer: ScheduledSendCondition(firstID)}, ctrl),
+			msg: &ExecuteScheduledSendMsg{
+				Metadata:        &weave.Metadata{Schema: 1},
+				ScheduledSendID: firstID,
+			},
+			blockTime:  now + 100,
+			wantSource: coin.Coins{coin.NewCoinp(6, 0, "IOV")},
+			wantDest:   coin.Coins{coin.NewCoinp(4, 0, "IOV")},
+		},
+		{
+			handler: NewScheduleSendHandler(&weavetest.Auth{Signer: source}, ctrl, cron),
+			msg: &ScheduleSendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      source.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(5, 0, "IOV"),
+				SendAt:      now + 200,
+			},
+			blockTime:   now + 100,
+			wantSource:  coin.Coins{coin.NewCoinp(1, 0, "IOV")},
+			wantDest:    coin.Coins{coin.NewCoinp(4, 0, "IOV")},
+			wantPending: 1,
+		},
+		{
+			handler: NewCancelScheduledSendHandler(&weavetest.Auth{Signer: weavetest.NewCondition()}, ctrl, cron),
+			msg: &CancelScheduledSendMsg{
+				Metadata:        &weave.Metadata{Schema: 1},
+				ScheduledSendID: secondID,
+			},
+			blockTime:   now + 150,
+			wantErr:     errors.ErrUnauthorized,
+			wantSource:  coin.Coins{coin.NewCoinp(1, 0, "IOV")},
+			wantDest:    coin.Coins{coin.NewCoinp(4, 0, "IOV")},
+			wantPending: 1,
+		},
+		{
+			handler: NewCancelScheduledSendHandler(&weavetest.Auth{Signer: source}, ctrl, cron),
+			msg: &CancelScheduledSendMsg{
+				Metadata:        &weave.Metadata{Schema: 1},
+				ScheduledSendID: secondID,
+			},
+			blockTime:  now + 150,
+			wantSource: coin.Coins{coin.NewCoinp(6, 0, "IOV")},
+			wantDest:   coin.Coins{coin.NewCoinp(4, 0, "IOV")},
+		},
+	}
+
+	sends := NewScheduledSendBucket()
+	for i, step := range steps {
+		ctx := weave.WithBlockTime(context.Background(), step.blockTime.Time())
+		tx := &weavetest.Tx{Msg: step.msg}
+		cache := db.CacheWrap()
+		if _, err := step.handler.Check(ctx, cache, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected check error: %+v", i, err)
+		}
+		cache.Discard()
+		if _, err := step.handler.Deliver(ctx, db, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected deliver error: %+v", i, err)
+		}
+
+		assertBalance(t, ctrl, db, source.Address(), step.wantSource)
+		assertBalance(t, ctrl, db, dest, step.wantDest)
+
+		var pending []ScheduledSend
+		if _, err := sends.ByIndex(db, "source", source.Address(), &pending); err != nil && !errors.ErrNotFound.Is(err) {
+			t.Fatalf("step %d: cannot list scheduled sends: %s", i, err)
+		}
+		if len(pending) != step.wantPending {
+			t.Fatalf("step %d: want %d pending sends, got %d", i, step.wantPending, len(pending))
+		}
+	}
+}
+
+func assertBalance(t testing.TB, ctrl Controller, db weave.KVStore, addr weave.Address, want coin.Coins) {
+	t.Helper()
+	got, err := ctrl.Balance(db, addr)
+	if err != nil && !errors.ErrNotFound.Is(err) {
+		t.Fatalf("cannot get %s balance: %s", addr, err)
+	}
+	if !want.Equals(got) {
+		t.Fatalf("want %v balance of %s, got %v", want, addr, got)
+	}
+}
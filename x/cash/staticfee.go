@@ -9,7 +9,10 @@ Minimal fee is configured via gconf package. If minimal is zero, no fees
 required, but will speed processing. If a currency is set on minimal fee, then
 all fees must be paid in that currency
 
-It uses auth to verify the source.
+It uses auth to verify the source. If the payer did not sign the transaction,
+the fee is paid only if the payer granted the main signer the right to pay
+fees from its wallet (see GrantFeeMsg). The fee is then deducted from the
+grant budget.
 
 */
 
@@ -52,13 +55,19 @@ func (d FeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 		return next.Check(ctx, store, tx)
 	}
 
-	// verify we have access to the money
+	// verify we have access to the money, either directly or using a
+	// fee grant given to the signer
 	if !d.auth.HasAddress(ctx, finfo.Payer) {
-		return nil, errors.Wrap(errors.ErrUnauthorized, "Fee payer signature missing")
+		if err := spendFeeGrant(ctx, store, d.auth, finfo.Payer, *fee); err != nil {
+			return nil, err
+		}
 	}
 	// and have enough
-	collector := mustLoadConf(store).CollectorAddress
-	err = d.ctrl.MoveCoins(store, finfo.Payer, collector, *fee)
+	collector, err := feeCollector(store)
+	if err != nil {
+		return nil, err
+	}
+	err = d.ctrl.MoveCoins(ctx, store, finfo.Payer, collector, *fee)
 	if err != nil {
 		return nil, err
 	}
@@ -86,13 +95,19 @@ func (d FeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.T
 		return next.Deliver(ctx, store, tx)
 	}
 
-	// verify we have access to the money
+	// verify we have access to the money, either directly or using a
+	// fee grant given to the signer
 	if !d.auth.HasAddress(ctx, finfo.Payer) {
-		return nil, errors.Wrap(errors.ErrUnauthorized, "Fee payer signature missing")
+		if err := spendFeeGrant(ctx, store, d.auth, finfo.Payer, *fee); err != nil {
+			return nil, err
+		}
 	}
 	// and subtract it from the account
-	collector := mustLoadConf(store).CollectorAddress
-	err = d.ctrl.MoveCoins(store, finfo.Payer, collector, *fee)
+	collector, err := feeCollector(store)
+	if err != nil {
+		return nil, err
+	}
+	err = d.ctrl.MoveCoins(ctx, store, finfo.Payer, collector, *fee)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +125,10 @@ func (d FeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store weave.KVS
 
 	fee := finfo.GetFees()
 	if coin.IsEmpty(fee) {
-		minFee := mustLoadConf(store).MinimalFee
+		minFee, err := minimalFee(store)
+		if err != nil {
+			return nil, err
+		}
 		if minFee.IsZero() {
 			return finfo, nil
 		}
@@ -123,7 +141,10 @@ func (d FeeDecorator) extractFee(ctx weave.Context, tx weave.Tx, store weave.KVS
 		return nil, err
 	}
 
-	cmp := mustLoadConf(store).MinimalFee
+	cmp, err := minimalFee(store)
+	if err != nil {
+		return nil, err
+	}
 	if cmp.IsZero() {
 		return finfo, nil
 	}
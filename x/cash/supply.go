@@ -0,0 +1,116 @@
+package cash
+
+import (
+	"sort"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+)
+
+func init() {
+	migration.MustRegister(1, &Supply{}, migration.NoModification)
+}
+
+var _ orm.Model = (*Supply)(nil)
+
+// Validate ensures the supply is valid.
+func (s *Supply) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", s.Metadata.Validate())
+	errs = errors.AppendField(errs, "Total", s.Total.Validate())
+	return errs
+}
+
+// NewSupplyBucket returns a bucket for keeping track of the total supply of
+// each currency. Supply is indexed by the currency ticker.
+func NewSupplyBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("supply", &Supply{})
+	return migration.NewModelBucket("cash", b)
+}
+
+// updateSupply changes the total supply of the given amount currency. Amount
+// can be negative in order to decrease the supply. Supply cannot become
+// negative, which happens when burning coins of a currency whose supply was
+// not seeded. See SeedSupply.
+func updateSupply(db weave.KVStore, bucket orm.ModelBucket, amount coin.Coin) error {
+	var s Supply
+	switch err := bucket.One(db, []byte(amount.Ticker), &s); {
+	case err == nil:
+	case errors.ErrNotFound.Is(err):
+		s = Supply{
+			Metadata: &weave.Metadata{Schema: 1},
+			Total:    coin.NewCoin(0, 0, amount.Ticker),
+		}
+	default:
+		return errors.Wrap(err, "cannot load supply")
+	}
+
+	total, err := s.Total.Add(amount)
+	if err != nil {
+		return errors.Wrap(err, "cannot update supply")
+	}
+	if !total.IsNonNegative() {
+		return errors.Wrapf(errors.ErrState,
+			"%s supply cannot be negative, it must be seeded first", amount.Ticker)
+	}
+	s.Total = total
+	if _, err := bucket.Put(db, []byte(amount.Ticker), &s); err != nil {
+		return errors.Wrap(err, "cannot save supply")
+	}
+	return nil
+}
+
+// SeedSupply creates the total supply of every currency held in wallets that
+// has no supply record yet. The supply is set to the sum of all wallet
+// balances of that currency. Supply is tracked since the genesis, so this
+// function must be called once when upgrading a chain that was started
+// without supply tracking. Supply that is already tracked is not modified.
+func SeedSupply(db weave.KVStore) error {
+	balances, err := sumWallets(db)
+	if err != nil {
+		return errors.Wrap(err, "cannot sum wallets")
+	}
+	supplies, err := allSupplies(db)
+	if err != nil {
+		return errors.Wrap(err, "cannot load supply")
+	}
+
+	tickers := make([]string, 0, len(balances))
+	for t := range balances {
+		if _, ok := supplies[t]; !ok {
+			tickers = append(tickers, t)
+		}
+	}
+	// Sort to always write in the same order.
+	sort.Strings(tickers)
+
+	bucket := NewSupplyBucket()
+	for _, t := range tickers {
+		s := Supply{
+			Metadata: &weave.Metadata{Schema: 1},
+			Total:    balances[t],
+		}
+		if _, err := bucket.Put(db, []byte(t), &s); err != nil {
+			return errors.Wrapf(err, "cannot save %s supply", t)
+		}
+	}
+	return nil
+}
+
+// TotalSupply returns the total amount of coins of given currency that exist.
+// Supply is tracked since the genesis and updated with every mint and burn
+// operation.
+func TotalSupply(db weave.ReadOnlyKVStore, ticker string) (coin.Coin, error) {
+	var s Supply
+	switch err := NewSupplyBucket().One(db, []byte(ticker), &s); {
+	case err == nil:
+		return s.Total, nil
+	case errors.ErrNotFound.Is(err):
+		return coin.NewCoin(0, 0, ticker), nil
+	default:
+		return coin.Coin{}, errors.Wrap(err, "cannot load supply")
+	}
+}
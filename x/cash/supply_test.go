@@ -0,0 +1,59 @@
+package cash
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestSeedSupply(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	ctrl := NewController(NewBucket())
+
+	addr1 := weavetest.NewCondition().Address()
+	addr2 := weavetest.NewCondition().Address()
+
+	// Supply of IOV is tracked, ETH wallet was created before supply
+	// tracking was introduced.
+	mustMint(t, db, ctrl, addr1, coin.NewCoin(5, 0, "IOV"))
+	w, err := WalletWith(addr2, coin.NewCoinp(1, 0, "IOV"), coin.NewCoinp(7, 0, "ETH"))
+	if err != nil {
+		t.Fatalf("cannot create wallet: %s", err)
+	}
+	if err := NewBucket().Save(db, w); err != nil {
+		t.Fatalf("cannot save wallet: %s", err)
+	}
+
+	if err := ctrl.CoinBurn(db, addr2, coin.NewCoin(1, 0, "ETH")); !errors.ErrState.Is(err) {
+		t.Fatalf("want burn of not seeded supply to fail, got %+v", err)
+	}
+
+	if err := SeedSupply(db); err != nil {
+		t.Fatalf("cannot seed supply: %s", err)
+	}
+	assertSupply(t, db, coin.NewCoin(7, 0, "ETH"))
+	// Tracked supply is not modified.
+	assertSupply(t, db, coin.NewCoin(5, 0, "IOV"))
+
+	if err := ctrl.CoinBurn(db, addr2, coin.NewCoin(1, 0, "ETH")); err != nil {
+		t.Fatalf("cannot burn: %s", err)
+	}
+	assertSupply(t, db, coin.NewCoin(6, 0, "ETH"))
+}
+
+func assertSupply(t testing.TB, db weave.ReadOnlyKVStore, want coin.Coin) {
+	t.Helper()
+	got, err := TotalSupply(db, want.Ticker)
+	if err != nil {
+		t.Fatalf("cannot get %s supply: %s", want.Ticker, err)
+	}
+	if !got.Equals(want) {
+		t.Fatalf("want %s supply, got %s", want, got)
+	}
+}
@@ -0,0 +1,206 @@
+package cash
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+const (
+	// TransferSenderTag is the tag key suffix used to mark the address that
+	// the coins were moved from.
+	TransferSenderTag = "sender"
+	// TransferRecipientTag is the tag key suffix used to mark the address
+	// that the coins were moved to.
+	TransferRecipientTag = "recipient"
+	// TransferAmountTag is the tag key suffix used to mark the amount of
+	// moved coins, without the ticker.
+	TransferAmountTag = "amount"
+	// TransferTickerTag is the tag key suffix used to mark the ticker of
+	// moved coins.
+	TransferTickerTag = "ticker"
+	// TransferMemoHashTag is the tag key suffix used to mark the hex encoded
+	// sha256 hash of the message memo. It is present only if the message
+	// has a non empty memo.
+	TransferMemoHashTag = "memo_hash"
+)
+
+// TransferTagger is a decorator that records all coin movements done by a
+// BaseController while processing a transaction and adds them as DeliverTx
+// tags. This includes movements done by other extensions, for example
+// escrow release or a fee payment, so that indexers get a single stream of
+// all transfers.
+//
+// Tendermint collapses multiple tags with the same key, so each transfer
+// is tagged using keys with the transfer index, for example
+// transfer.0.sender, transfer.0.recipient, transfer.0.amount,
+// transfer.0.ticker and transfer.0.memo_hash.
+//
+// Only transfers that are written to the store are tagged. Movements done
+// inside of a discarded cache wrap are ignored.
+type TransferTagger struct{}
+
+var _ weave.Decorator = TransferTagger{}
+
+// NewTransferTagger creates a TransferTagger decorator.
+func NewTransferTagger() TransferTagger {
+	return TransferTagger{}
+}
+
+// Check does nothing.
+func (TransferTagger) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	return next.Check(ctx, db, tx)
+}
+
+// Deliver passes a transfer recording store into the child and uses the
+// recorded transfers to add tags to the DeliverResult.
+func (TransferTagger) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	var transfers []transfer
+	res, err := next.Deliver(ctx, newTransferRecordingStore(db, &transfers), tx)
+	if err != nil {
+		return nil, err
+	}
+	if len(transfers) == 0 {
+		return res, nil
+	}
+
+	var memoHash string
+	if msg, err := tx.GetMsg(); err == nil {
+		if m, ok := msg.(memoer); ok && m.GetMemo() != "" {
+			h := sha256.Sum256([]byte(m.GetMemo()))
+			memoHash = hex.EncodeToString(h[:])
+		}
+	}
+	for i, t := range transfers {
+		res.Tags = append(res.Tags, t.tags(i, memoHash)...)
+	}
+	return res, nil
+}
+
+// memoer is implemented by all messages that contain a memo.
+type memoer interface {
+	GetMemo() string
+}
+
+// transfer is a single coin movement.
+type transfer struct {
+	src    weave.Address
+	dest   weave.Address
+	amount coin.Coin
+}
+
+func (t transfer) tags(index int, memoHash string) []common.KVPair {
+	key := func(name string) []byte {
+		return []byte(fmt.Sprintf("transfer.%d.%s", index, name))
+	}
+	// Ticker is tagged separately.
+	amount := coin.NewCoin(t.amount.Whole, t.amount.Fractional, "")
+	tags := []common.KVPair{
+		{Key: key(TransferSenderTag), Value: []byte(t.src.String())},
+		{Key: key(TransferRecipientTag), Value: []byte(t.dest.String())},
+		{Key: key(TransferAmountTag), Value: []byte(amount.String())},
+		{Key: key(TransferTickerTag), Value: []byte(t.amount.Ticker)},
+	}
+	if memoHash != "" {
+		tags = append(tags, common.KVPair{Key: key(TransferMemoHashTag), Value: []byte(memoHash)})
+	}
+	return tags
+}
+
+// TransferRecorder is implemented by stores that keep track of coin
+// movements. BaseController reports every successful movement to the store
+// that implements it. A store that wraps the store passed by TransferTagger
+// must implement it by passing the movement to the wrapped store, otherwise
+// the movement is not tagged.
+type TransferRecorder interface {
+	RecordTransfer(src weave.Address, dest weave.Address, amount coin.Coin)
+}
+
+// newTransferRecordingStore wraps given store and records all transfers
+// into the given list. Returned store supports cache wrapping if the
+// wrapped store does.
+func newTransferRecordingStore(db weave.KVStore, transfers *[]transfer) weave.KVStore {
+	if cached, ok := db.(weave.CacheableKVStore); ok {
+		return &cacheableTransferRecordingStore{
+			CacheableKVStore: cached,
+			transfers:        transfers,
+		}
+	}
+	return &transferRecordingStore{
+		KVStore:   db,
+		transfers: transfers,
+	}
+}
+
+type transferRecordingStore struct {
+	weave.KVStore
+	transfers *[]transfer
+}
+
+var _ TransferRecorder = (*transferRecordingStore)(nil)
+
+func (s *transferRecordingStore) RecordTransfer(src weave.Address, dest weave.Address, amount coin.Coin) {
+	*s.transfers = append(*s.transfers, transfer{src: src, dest: dest, amount: amount})
+}
+
+type cacheableTransferRecordingStore struct {
+	weave.CacheableKVStore
+	transfers *[]transfer
+}
+
+var _ TransferRecorder = (*cacheableTransferRecordingStore)(nil)
+
+func (s *cacheableTransferRecordingStore) RecordTransfer(src weave.Address, dest weave.Address, amount coin.Coin) {
+	*s.transfers = append(*s.transfers, transfer{src: src, dest: dest, amount: amount})
+}
+
+// CacheWrap makes sure that transfers done using the cache are recorded.
+func (s *cacheableTransferRecordingStore) CacheWrap() weave.KVCacheWrap {
+	return &transferRecordingCacheWrap{
+		KVCacheWrap: s.CacheableKVStore.CacheWrap(),
+		parent:      s.transfers,
+	}
+}
+
+// transferRecordingCacheWrap holds transfers until the cache is written.
+// Transfers are passed to the parent on Write and dropped on Discard.
+type transferRecordingCacheWrap struct {
+	weave.KVCacheWrap
+	parent    *[]transfer
+	transfers []transfer
+}
+
+var _ TransferRecorder = (*transferRecordingCacheWrap)(nil)
+
+func (c *transferRecordingCacheWrap) RecordTransfer(src weave.Address, dest weave.Address, amount coin.Coin) {
+	c.transfers = append(c.transfers, transfer{src: src, dest: dest, amount: amount})
+}
+
+// CacheWrap makes sure that transfers done using the nested cache are
+// recorded.
+func (c *transferRecordingCacheWrap) CacheWrap() weave.KVCacheWrap {
+	return &transferRecordingCacheWrap{
+		KVCacheWrap: c.KVCacheWrap.CacheWrap(),
+		parent:      &c.transfers,
+	}
+}
+
+// Write flushes the cache and passes recorded transfers to the parent.
+func (c *transferRecordingCacheWrap) Write() error {
+	if err := c.KVCacheWrap.Write(); err != nil {
+		return err
+	}
+	*c.parent = append(*c.parent, c.transfers...)
+	c.transfers = nil
+	return nil
+}
+
+// Discard drops the cache together with all recorded transfers.
+func (c *transferRecordingCacheWrap) Discard() {
+	c.KVCacheWrap.Discard()
+	c.transfers = nil
+}
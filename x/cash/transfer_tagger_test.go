@@ -0,0 +1,185 @@
+package cash
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+func TestTransferTagger(t *testing.T) {
+	ctx := context.Background()
+	src := weavetest.NewCondition()
+	dest := weavetest.NewCondition().Address()
+	other := weavetest.NewCondition().Address()
+
+	ctrl := NewController(NewBucket())
+	auth := &weavetest.Auth{Signer: src}
+
+	memoHash := sha256.Sum256([]byte("a memo"))
+
+	cases := map[string]struct {
+		handler  weave.Handler
+		msg      weave.Msg
+		wantErr  *errors.Error
+		wantTags []common.KVPair
+	}{
+		"send is tagged": {
+			handler: NewSendHandler(auth, ctrl),
+			msg: &SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      src.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(1, 500000000, "IOV"),
+				Memo:        "a memo",
+			},
+			wantTags: []common.KVPair{
+				{Key: []byte("transfer.0.sender"), Value: []byte(src.Address().String())},
+				{Key: []byte("transfer.0.recipient"), Value: []byte(dest.String())},
+				{Key: []byte("transfer.0.amount"), Value: []byte("1.5")},
+				{Key: []byte("transfer.0.ticker"), Value: []byte("IOV")},
+				{Key: []byte("transfer.0.memo_hash"), Value: []byte(hex.EncodeToString(memoHash[:]))},
+			},
+		},
+		"every transfer is tagged separately": {
+			handler: NewMultiSendHandler(auth, ctrl),
+			msg: &MultiSendMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Source:   src.Address(),
+				Outputs: []Output{
+					{Destination: dest, Amount: coin.NewCoinp(1, 0, "IOV")},
+					{Destination: other, Amount: coin.NewCoinp(2, 0, "IOV")},
+				},
+			},
+			wantTags: []common.KVPair{
+				{Key: []byte("transfer.0.sender"), Value: []byte(src.Address().String())},
+				{Key: []byte("transfer.0.recipient"), Value: []byte(dest.String())},
+				{Key: []byte("transfer.0.amount"), Value: []byte("1")},
+				{Key: []byte("transfer.0.ticker"), Value: []byte("IOV")},
+				{Key: []byte("transfer.1.sender"), Value: []byte(src.Address().String())},
+				{Key: []byte("transfer.1.recipient"), Value: []byte(other.String())},
+				{Key: []byte("transfer.1.amount"), Value: []byte("2")},
+				{Key: []byte("transfer.1.ticker"), Value: []byte("IOV")},
+			},
+		},
+		"failed transfer is not tagged": {
+			handler: NewSendHandler(auth, ctrl),
+			msg: &SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      src.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(1000, 0, "IOV"),
+			},
+			wantErr: errors.ErrAmount,
+		},
+		"transfer of a discarded cache is not tagged": {
+			handler: &discardingHandler{
+				handler: NewSendHandler(auth, ctrl),
+			},
+			msg: &SendMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Source:      src.Address(),
+				Destination: dest,
+				Amount:      coin.NewCoinp(1, 0, "IOV"),
+			},
+			wantTags: nil,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "cash")
+			if err := ctrl.CoinMint(ctx, db, src.Address(), coin.NewCoin(10, 0, "IOV")); err != nil {
+				t.Fatalf("cannot mint: %s", err)
+			}
+
+			h := weavetest.Decorate(tc.handler, NewTransferTagger())
+			res, err := h.Deliver(context.Background(), db, &weavetest.Tx{Msg: tc.msg})
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			if len(res.Tags) != len(tc.wantTags) {
+				t.Fatalf("want %d tags, got %d: %v", len(tc.wantTags), len(res.Tags), res.Tags)
+			}
+			for i, want := range tc.wantTags {
+				if got := res.Tags[i]; string(got.Key) != string(want.Key) || string(got.Value) != string(want.Value) {
+					t.Errorf("tag %d: want %s=%s, got %s=%s", i, want.Key, want.Value, got.Key, got.Value)
+				}
+			}
+		})
+	}
+}
+
+// discardingHandler delivers using a cache wrap that is discarded on
+// success.
+type discardingHandler struct {
+	handler weave.Handler
+}
+
+func (h *discardingHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	return h.handler.Check(ctx, db, tx)
+}
+
+func (h *discardingHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	cache := db.(weave.CacheableKVStore).CacheWrap()
+	defer cache.Discard()
+	return h.handler.Deliver(ctx, cache, tx)
+}
+
+func TestWalletCreationHeight(t *testing.T) {
+	src := weavetest.NewCondition()
+	dest := weavetest.NewCondition().Address()
+
+	bucket := NewBucket()
+	ctrl := NewController(bucket)
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cash")
+	if err := ctrl.CoinMint(weave.WithHeight(context.Background(), 3), db, src.Address(), coin.NewCoin(10, 0, "IOV")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+
+	// Creation height does not depend on the decorators used.
+	h := NewSendHandler(&weavetest.Auth{Signer: src}, ctrl)
+	send := &weavetest.Tx{
+		Msg: &SendMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Source:      src.Address(),
+			Destination: dest,
+			Amount:      coin.NewCoinp(1, 0, "IOV"),
+		},
+	}
+	for _, height := range []int64{5, 8} {
+		ctx := weave.WithHeight(context.Background(), height)
+		if _, err := h.Deliver(ctx, db, send); err != nil {
+			t.Fatalf("cannot deliver at height %d: %s", height, err)
+		}
+	}
+
+	assertCreationHeight(t, bucket, db, src.Address(), 3)
+	// Sending more coins must not update the creation height.
+	assertCreationHeight(t, bucket, db, dest, 5)
+}
+
+func assertCreationHeight(t testing.TB, bucket Bucket, db weave.KVStore, addr weave.Address, want int64) {
+	t.Helper()
+	obj, err := bucket.Get(db, addr)
+	if err != nil {
+		t.Fatalf("cannot get %s wallet: %s", addr, err)
+	}
+	if got := obj.Value().(*Set).CreationHeight; got != want {
+		t.Fatalf("want %s wallet creation height %d, got %d", addr, want, got)
+	}
+}
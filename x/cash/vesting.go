@@ -0,0 +1,169 @@
+package cash
+
+import (
+	"math/big"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+)
+
+func init() {
+	migration.MustRegister(1, &Vesting{}, migration.NoModification)
+}
+
+var _ orm.Model = (*Vesting)(nil)
+
+// Validate ensures the vesting is valid.
+func (v *Vesting) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", v.Metadata.Validate())
+	errs = errors.AppendField(errs, "Beneficiary", v.Beneficiary.Validate())
+	if amount := coin.Coins(v.Amount); !amount.IsPositive() {
+		errs = errors.Append(errs, errors.Field("Amount", errors.ErrAmount, "must be positive"))
+	} else {
+		errs = errors.AppendField(errs, "Amount", amount.Validate())
+	}
+	errs = errors.AppendField(errs, "Released", coin.Coins(v.Released).Validate())
+	errs = errors.Append(errs, validateSchedule(v.StartTime, v.CliffTime, v.EndTime))
+	return errs
+}
+
+// validateSchedule ensures that vesting schedule times are in order.
+func validateSchedule(start, cliff, end weave.UnixTime) error {
+	var errs error
+	if start == 0 {
+		errs = errors.Append(errs, errors.Field("StartTime", errors.ErrInput, "required"))
+	}
+	errs = errors.AppendField(errs, "StartTime", start.Validate())
+	errs = errors.AppendField(errs, "CliffTime", cliff.Validate())
+	errs = errors.AppendField(errs, "EndTime", end.Validate())
+	if cliff < start {
+		errs = errors.Append(errs, errors.Field("CliffTime", errors.ErrInput, "must not be before the start time"))
+	}
+	if end <= start {
+		errs = errors.Append(errs, errors.Field("EndTime", errors.ErrInput, "must be after the start time"))
+	}
+	if end < cliff {
+		errs = errors.Append(errs, errors.Field("EndTime", errors.ErrInput, "must not be before the cliff time"))
+	}
+	return errs
+}
+
+// Vested returns the total amount of coins that is released at the given
+// time, including the coins that were already unlocked.
+func (v *Vesting) Vested(now weave.UnixTime) (coin.Coins, error) {
+	switch {
+	case now < v.CliffTime:
+		return nil, nil
+	case now >= v.EndTime:
+		return coin.Coins(v.Amount).Clone(), nil
+	}
+
+	elapsed := big.NewInt(int64(now - v.StartTime))
+	duration := big.NewInt(int64(v.EndTime - v.StartTime))
+	frac := big.NewInt(coin.FracUnit)
+
+	var vested coin.Coins
+	for _, c := range v.Amount {
+		// Calculate using the smallest units to not lose precision.
+		units := new(big.Int).Mul(big.NewInt(c.Whole), frac)
+		units.Add(units, big.NewInt(c.Fractional))
+		units.Mul(units, elapsed)
+		units.Quo(units, duration)
+
+		whole, fractional := new(big.Int).QuoRem(units, frac, new(big.Int))
+		part := coin.NewCoin(whole.Int64(), fractional.Int64(), c.Ticker)
+		if part.IsZero() {
+			continue
+		}
+		var err error
+		if vested, err = vested.Add(part); err != nil {
+			return nil, errors.Wrap(err, "cannot sum vested coins")
+		}
+	}
+	return vested, nil
+}
+
+// Releasable returns the amount of coins that can be unlocked at the given
+// time.
+func (v *Vesting) Releasable(now weave.UnixTime) (coin.Coins, error) {
+	vested, err := v.Vested(now)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range v.Released {
+		if vested, err = vested.Subtract(*c); err != nil {
+			return nil, errors.Wrap(err, "cannot subtract released coins")
+		}
+	}
+	return vested, nil
+}
+
+// Locked returns the amount of coins that are not unlocked yet.
+func (v *Vesting) Locked() (coin.Coins, error) {
+	locked := coin.Coins(v.Amount).Clone()
+	for _, c := range v.Released {
+		var err error
+		if locked, err = locked.Subtract(*c); err != nil {
+			return nil, errors.Wrap(err, "cannot subtract released coins")
+		}
+	}
+	return locked, nil
+}
+
+// NewVestingBucket returns a bucket for keeping track of vesting schedules.
+// Vesting is indexed by the beneficiary address.
+func NewVestingBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("vesting", &Vesting{},
+		orm.WithIDSequence(vestingSeq),
+		orm.WithIndex("beneficiary", idxVestingBeneficiary, false),
+	)
+	return migration.NewModelBucket("cash", b)
+}
+
+var vestingSeq = orm.NewSequence("vesting", "id")
+
+func idxVestingBeneficiary(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	v, ok := obj.Value().(*Vesting)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "can only take index of Vesting")
+	}
+	return v.Beneficiary, nil
+}
+
+// ensureUnlocked returns an error if taking the amount from the balance of
+// the address would spend coins that are locked by a vesting.
+func ensureUnlocked(db weave.ReadOnlyKVStore, bucket orm.ModelBucket, addr weave.Address, balance coin.Coins, amount coin.Coin) error {
+	var vestings []Vesting
+	if _, err := bucket.ByIndex(db, "beneficiary", addr, &vestings); err != nil {
+		return errors.Wrap(err, "cannot load vestings")
+	}
+	if len(vestings) == 0 {
+		return nil
+	}
+	available, err := balance.Clone().Subtract(amount)
+	if err != nil {
+		return errors.Wrap(err, "cannot subtract amount")
+	}
+	for _, v := range vestings {
+		locked, err := v.Locked()
+		if err != nil {
+			return err
+		}
+		for _, c := range locked {
+			if !available.Contains(*c) {
+				return errors.Wrapf(errors.ErrAmount, "%s locked by vesting", c)
+			}
+			if available, err = available.Subtract(*c); err != nil {
+				return errors.Wrap(err, "cannot subtract locked coins")
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package cash
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+)
+
+func TestVestingVested(t *testing.T) {
+	v := Vesting{
+		Amount: []*coin.Coin{
+			coin.NewCoinp(10, 0, "BAR"),
+			coin.NewCoinp(100, 0, "FOO"),
+		},
+		StartTime: 1000,
+		CliffTime: 1200,
+		EndTime:   2000,
+	}
+
+	cases := map[string]struct {
+		now  weave.UnixTime
+		want coin.Coins
+	}{
+		"before start": {
+			now:  500,
+			want: nil,
+		},
+		"before cliff": {
+			now:  1199,
+			want: nil,
+		},
+		"at cliff": {
+			now: 1200,
+			want: coin.Coins{
+				coin.NewCoinp(2, 0, "BAR"),
+				coin.NewCoinp(20, 0, "FOO"),
+			},
+		},
+		"fractional release": {
+			now: 1255,
+			want: coin.Coins{
+				coin.NewCoinp(2, 550000000, "BAR"),
+				coin.NewCoinp(25, 500000000, "FOO"),
+			},
+		},
+		"at end": {
+			now:  2000,
+			want: coin.Coins(v.Amount),
+		},
+		"after end": {
+			now:  5000,
+			want: coin.Coins(v.Amount),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			got, err := v.Vested(tc.now)
+			if err != nil {
+				t.Fatalf("cannot compute vested amount: %s", err)
+			}
+			if !got.Equals(tc.want) {
+				t.Fatalf("want %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestVestingRelease(t *testing.T) {
+	ctx := context.Background()
+	source := weavetest.NewCondition()
+	beneficiary := weavetest.NewCondition().Address()
+	recipient := weavetest.NewCondition().Address()
+
+	controller := NewController(NewBucket())
+
+	kv := store.MemStore()
+	migration.MustInitPkg(kv, "cash")
+	if err := controller.CoinMint(ctx, kv, source.Address(), coin.NewCoin(100, 0, "FOO")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+	if err := controller.CoinMint(ctx, kv, beneficiary, coin.NewCoin(1, 0, "FOO")); err != nil {
+		t.Fatalf("cannot mint: %s", err)
+	}
+
+	start := weave.AsUnixTime(time.Now())
+	create := NewCreateVestingHandler(&weavetest.Auth{Signer: source}, controller)
+	tx := &weavetest.Tx{Msg: &CreateVestingMsg{
+		Metadata:    &weave.Metadata{Schema: 1},
+		Source:      source.Address(),
+		Beneficiary: beneficiary,
+		Amount:      []*coin.Coin{coin.NewCoinp(100, 0, "FOO")},
+		StartTime:   start,
+		CliffTime:   start.Add(10 * time.Second),
+		EndTime:     start.Add(100 * time.Second),
+	}}
+	res, err := create.Deliver(nil, kv, tx)
+	if err != nil {
+		t.Fatalf("cannot create vesting: %s", err)
+	}
+	vestingID := res.Data
+
+	// Vested coins are kept in the beneficiary wallet.
+	balance, err := controller.Balance(kv, beneficiary)
+	if err != nil {
+		t.Fatalf("cannot get balance: %s", err)
+	}
+	if want := (coin.Coins{coin.NewCoinp(101, 0, "FOO")}); !balance.Equals(want) {
+		t.Fatalf("want %v balance, got %v", want, balance)
+	}
+
+	release := NewReleaseVestingHandler()
+	steps := []struct {
+		after        time.Duration
+		wantErr      *errors.Error
+		wantUnlocked coin.Coin
+	}{
+		{after: 5 * time.Second, wantErr: errors.ErrState, wantUnlocked: coin.NewCoin(1, 0, "FOO")},
+		{after: 10 * time.Second, wantUnlocked: coin.NewCoin(11, 0, "FOO")},
+		{after: 10 * time.Second, wantErr: errors.ErrState, wantUnlocked: coin.NewCoin(11, 0, "FOO")},
+		{after: 50 * time.Second, wantUnlocked: coin.NewCoin(51, 0, "FOO")},
+		{after: 200 * time.Second, wantUnlocked: coin.NewCoin(101, 0, "FOO")},
+		{after: 300 * time.Second, wantErr: errors.ErrNotFound, wantUnlocked: coin.NewCoin(101, 0, "FOO")},
+	}
+	for i, step := range steps {
+		ctx := weave.WithBlockTime(context.Background(), start.Add(step.after).Time())
+		tx := &weavetest.Tx{Msg: &ReleaseVestingMsg{
+			Metadata:  &weave.Metadata{Schema: 1},
+			VestingId: vestingID,
+		}}
+		if _, err := release.Check(ctx, kv, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected check error: %+v", i, err)
+		}
+		if _, err := release.Deliver(ctx, kv, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected deliver error: %+v", i, err)
+		}
+
+		// Locked coins can neither be sent nor burned.
+		more, err := step.wantUnlocked.Add(coin.NewCoin(0, 1, "FOO"))
+		if err != nil {
+			t.Fatalf("step %d: cannot add: %s", i, err)
+		}
+		db := kv.CacheWrap()
+		if err := controller.MoveCoins(ctx, db, beneficiary, recipient, more); !errors.ErrAmount.Is(err) {
+			t.Fatalf("step %d: unexpected locked coins move error: %+v", i, err)
+		}
+		if err := controller.CoinBurn(db, beneficiary, more); !errors.ErrAmount.Is(err) {
+			t.Fatalf("step %d: unexpected locked coins burn error: %+v", i, err)
+		}
+		if err := controller.MoveCoins(ctx, db, beneficiary, recipient, step.wantUnlocked); err != nil {
+			t.Fatalf("step %d: cannot move unlocked coins: %+v", i, err)
+		}
+		db.Discard()
+	}
+}
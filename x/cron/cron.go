@@ -39,7 +39,7 @@ type Scheduler struct {
 	enc TaskMarshaler
 }
 
-var _ weave.Scheduler = (*Scheduler)(nil)
+var _ weave.HeightScheduler = (*Scheduler)(nil)
 
 // Schedule implements weave.Scheduler interface.
 //
@@ -110,6 +110,47 @@ func queueKey(t time.Time) []byte {
 	return append([]byte("_crontask:runat:"), rawTime...)
 }
 
+// ScheduleAtHeight implements weave.HeightScheduler interface.
+//
+// Transaction is executed at the beginning of the block with given height or,
+// if the ticker reached its per block execution limit, at the beginning of
+// the next block. Up to 100 tasks can be scheduled for the same height.
+func (s *Scheduler) ScheduleAtHeight(db weave.KVStore, height int64, auth []weave.Condition, msg weave.Msg) ([]byte, error) {
+	if height <= 0 {
+		return nil, errors.Wrap(errors.ErrInput, "height must be greater than zero")
+	}
+
+	raw, err := s.enc.MarshalTask(auth, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal task")
+	}
+
+	// Tasks scheduled for the same height are kept unique by their index
+	// within that height. Tasks are executed in order of scheduling.
+	const maxTries = 100
+	for index := uint16(0); index < maxTries; index++ {
+		key := heightQueueKey(height, index)
+		if ok, err := db.Has(key); err != nil {
+			return nil, errors.Wrap(err, "cannot check key existance")
+		} else if ok {
+			continue
+		}
+
+		if err := db.Set(key, raw); err != nil {
+			return nil, errors.Wrap(err, "cannot store in queue")
+		}
+		return key, nil
+	}
+	return nil, errors.Wrap(errors.ErrState, "too many tasks scheduled for this height")
+}
+
+func heightQueueKey(height int64, index uint16) []byte {
+	raw := make([]byte, 10)
+	binary.BigEndian.PutUint64(raw, uint64(height))
+	binary.BigEndian.PutUint16(raw[8:], index)
+	return append([]byte("_crontask:runheight:"), raw...)
+}
+
 // Delete implements weave.Scheduler interface.
 func (s *Scheduler) Delete(db weave.KVStore, taskID []byte) error {
 	if ok, err := db.Has(taskID); err != nil {
@@ -212,7 +253,7 @@ func (t *Ticker) tick(ctx context.Context, db store.CacheableKVStore) ([]common.
 	// run.
 	const maxExecuted = 50
 	for proc := 0; proc < maxExecuted; proc++ {
-		switch key, raw, err := peek(db, now); {
+		switch key, raw, err := peekDue(db, now, blockHeight); {
 		case err == nil:
 			// Each task is processed using its own cache instance
 			// to ensure changes are atomic and task processing
@@ -310,6 +351,40 @@ func peek(db weave.KVStore, now time.Time) (id, raw []byte, err error) {
 	}
 }
 
+// peekHeight reads from the queue a single task that reached its execution
+// height and returns it encoded value and ID. It returns ErrEmpty if there is
+// no message suitable for processing.
+// Tasks are consumed in order of execution height, starting with the lowest.
+func peekHeight(db weave.KVStore, height int64) (id, raw []byte, err error) {
+	since := heightQueueKey(0, 0)
+	until := heightQueueKey(height+1, 0)
+	it, err := db.Iterator(since, until)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot create iterator")
+	}
+	defer it.Release()
+
+	switch key, value, err := it.Next(); {
+	case err == nil:
+		return key, value, nil
+	case errors.ErrIteratorDone.Is(err):
+		return nil, nil, errors.ErrEmpty
+	default:
+		return nil, nil, errors.Wrap(err, "cannot get next item")
+	}
+}
+
+// peekDue returns a single task that is due either by its execution time or
+// by its execution height. Tasks scheduled by time are consumed first. It
+// returns ErrEmpty if there is no message suitable for processing.
+func peekDue(db weave.KVStore, now time.Time, height int64) (id, raw []byte, err error) {
+	id, raw, err = peek(db, now)
+	if !errors.ErrEmpty.Is(err) {
+		return id, raw, err
+	}
+	return peekHeight(db, height)
+}
+
 // taskTx is a weave.Tx implementation created for running
 // asynchronous tasks. It is a thin wrapper over the message.
 type taskTx struct {
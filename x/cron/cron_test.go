@@ -369,6 +369,75 @@ func TestTicker(t *testing.T) {
 	}
 }
 
+func TestHeightTicker(t *testing.T) {
+	now := time.Now()
+	db := store.MemStore()
+	migration.MustInitPkg(db, "cron")
+
+	enc := NewTestTaskMarshaler(&weavetest.Msg{})
+	scheduler := NewScheduler(enc)
+	ticker := NewTicker(&cronHandler{}, enc)
+
+	if _, err := scheduler.ScheduleAtHeight(db, 0, nil, &weavetest.Msg{}); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for zero height, got %+v", err)
+	}
+
+	schedule := func(height int64, path string) []byte {
+		t.Helper()
+		tid, err := scheduler.ScheduleAtHeight(db, height, nil, &weavetest.Msg{RoutePath: path})
+		if err != nil {
+			t.Fatalf("cannot schedule %q task: %s", path, err)
+		}
+		return tid
+	}
+	past := schedule(5, "test/past")
+	current := schedule(10, "test/current")
+	sameHeight := schedule(10, "test/same-height")
+	cancelled := schedule(10, "test/cancelled")
+	future := schedule(11, "test/future")
+	byTime, err := scheduler.Schedule(db, now.Add(-time.Hour), nil, &weavetest.Msg{RoutePath: "test/time"})
+	if err != nil {
+		t.Fatalf("cannot schedule time task: %s", err)
+	}
+
+	if err := scheduler.Delete(db, cancelled); err != nil {
+		t.Fatalf("cannot cancel task: %s", err)
+	}
+
+	ctx := weave.WithBlockTime(context.Background(), now)
+	ctx = weave.WithHeight(ctx, 10)
+	tags, _, err := ticker.tick(ctx, db)
+	if err != nil {
+		t.Fatalf("unexpected ticker error: %+v", err)
+	}
+
+	wantExec := map[string]bool{
+		"past":        true,
+		"current":     true,
+		"same height": true,
+		"cancelled":   false,
+		"future":      false,
+		"by time":     true,
+	}
+	ids := map[string][]byte{
+		"past":        past,
+		"current":     current,
+		"same height": sameHeight,
+		"cancelled":   cancelled,
+		"future":      future,
+		"by time":     byTime,
+	}
+	for name, want := range wantExec {
+		if got := containsPairValue(tags, ids[name]); got != want {
+			t.Fatalf("%s task: want executed %v, got %v", name, want, got)
+		}
+	}
+
+	if ok, err := db.Has(future); err != nil || !ok {
+		t.Fatalf("future task must remain queued: %v, %v", ok, err)
+	}
+}
+
 func containsPairValue(pairs []common.KVPair, item []byte) bool {
 	for _, p := range pairs {
 		if bytes.Equal(p.Value, item) {
@@ -4,5 +4,10 @@ Package cron implements delayed message execution.
 This package provides a queue implementation for scheduling message for
 execution in the future and weave.Ticker compatible task runner.
 
+A message can be scheduled for execution after a given time or at a given
+block height. Each message is executed at the beginning of a block with the
+authentication conditions provided when scheduling it. A scheduled task can be
+cancelled using its ID until it is executed. Result of each executed task is
+stored and available via the "/crontaskresults" query.
 */
 package cron
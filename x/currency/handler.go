@@ -7,8 +7,6 @@ import (
 	"github.com/iov-one/weave/x"
 )
 
-const newTokenInfoCost = 100
-
 func RegisterQuery(qr weave.QueryRouter) {
 	NewTokenInfoBucket().Register("tokens", qr)
 }
@@ -37,7 +35,7 @@ func (h *createTokenInfoHandler) Check(ctx weave.Context, db weave.KVStore, tx w
 	if _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: newTokenInfoCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h *createTokenInfoHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
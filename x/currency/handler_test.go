@@ -1,6 +1,7 @@
 package currency
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -118,3 +119,32 @@ func TestNewTokenInfoHandler(t *testing.T) {
 		})
 	}
 }
+
+func BenchmarkNewTokenInfoHandler(b *testing.B) {
+	perm := weavetest.NewCondition()
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "currency")
+	bucket := NewTokenInfoBucket()
+	// Populate the store with other tokens, so that the handler does not
+	// operate on an almost empty database.
+	for i := 0; i < 26*26; i++ {
+		ticker := string([]byte{'A' + byte(i/26), 'A' + byte(i%26), 'A'})
+		obj := orm.NewSimpleObj([]byte(ticker), &TokenInfo{
+			Metadata: &weave.Metadata{Schema: 1},
+			Name:     "token " + ticker,
+		})
+		if err := bucket.Save(db, obj); err != nil {
+			b.Fatalf("cannot save token: %s", err)
+		}
+	}
+
+	auth := &weavetest.Auth{Signer: perm}
+	h := newCreateTokenInfoHandler(auth, perm.Address())
+	tx := &weavetest.Tx{Msg: &CreateMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Ticker:   "TKR",
+		Name:     "tikr",
+	}}
+	weavetest.BenchmarkHandler(b, h, context.Background(), db, tx)
+}
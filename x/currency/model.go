@@ -12,6 +12,8 @@ import (
 
 func init() {
 	migration.MustRegister(1, &TokenInfo{}, migration.NoModification)
+
+	migration.MustRegisterBucket(NewTokenInfoBucket().Bucket)
 }
 
 var isTokenName = regexp.MustCompile(`^[A-Za-z0-9 \-_:]{3,32}$`).MatchString
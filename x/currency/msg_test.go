@@ -5,6 +5,7 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest"
 )
 
 func TestValidateCreateMsg(t *testing.T) {
@@ -38,3 +39,11 @@ func TestValidateCreateMsg(t *testing.T) {
 	}
 
 }
+
+func TestFuzzCreateMsg(t *testing.T) {
+	weavetest.FuzzValidate(t, &CreateMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		Ticker:   "IOV",
+		Name:     "mytoken",
+	}, 1000)
+}
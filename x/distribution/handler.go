@@ -9,12 +9,6 @@ import (
 	"github.com/iov-one/weave/x"
 )
 
-const (
-	newRevenueCost                 = 0
-	distributePerDestinationCost   = 0
-	resetRevenuePerDestinationCost = 0
-)
-
 // RegisterQuery registers feedlist buckets for querying.
 func RegisterQuery(qr weave.QueryRouter) {
 	NewRevenueBucket().Register("revenues", qr)
@@ -25,7 +19,7 @@ func RegisterQuery(qr weave.QueryRouter) {
 // Required functionality is implemented by the x/cash extension.
 type CashController interface {
 	Balance(weave.KVStore, weave.Address) (coin.Coins, error)
-	MoveCoins(weave.KVStore, weave.Address, weave.Address, coin.Coin) error
+	MoveCoins(weave.Context, weave.KVStore, weave.Address, weave.Address, coin.Coin) error
 }
 
 // RegisterRoutes registers handlers for feedlist message processing.
@@ -59,7 +53,7 @@ func (h *createRevenueHandler) Check(ctx weave.Context, db weave.KVStore, tx wea
 	if _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: newRevenueCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h *createRevenueHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
@@ -99,19 +93,10 @@ type distributeHandler struct {
 }
 
 func (h *distributeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	msg, err := h.validate(ctx, db, tx)
-	if err != nil {
+	if _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
-	var rev Revenue
-	if err := h.bucket.One(db, msg.RevenueID, &rev); err != nil {
-		return nil, errors.Wrap(err, "cannot load revenue from the store")
-	}
-
-	res := weave.CheckResult{
-		GasAllocated: distributePerDestinationCost * int64(len(rev.Destinations)),
-	}
-	return &res, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h *distributeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
@@ -124,7 +109,7 @@ func (h *distributeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weav
 	if err := h.bucket.One(db, msg.RevenueID, &rev); err != nil {
 		return nil, errors.Wrap(err, "cannot load revenue from the store")
 	}
-	if err := distribute(db, h.ctrl, rev.Address, rev.Destinations); err != nil {
+	if err := distribute(ctx, db, h.ctrl, rev.Address, rev.Destinations); err != nil {
 		return nil, errors.Wrap(err, "cannot distribute")
 	}
 	return &weave.DeliverResult{}, nil
@@ -153,16 +138,10 @@ func (h *resetRevenueHandler) Check(ctx weave.Context, db weave.KVStore, tx weav
 		return nil, err
 	}
 
-	var rev Revenue
-	if err := h.bucket.One(db, msg.RevenueID, &rev); err != nil {
+	if err := h.bucket.Has(db, msg.RevenueID); err != nil {
 		return nil, errors.Wrap(err, "cannot load revenue from the store")
 	}
-	// Reseting a revenue cost is counterd per destination, because this is a
-	// distribution operation as well.
-	res := weave.CheckResult{
-		GasAllocated: resetRevenuePerDestinationCost * int64(len(rev.Destinations)),
-	}
-	return &res, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h *resetRevenueHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
@@ -179,7 +158,7 @@ func (h *resetRevenueHandler) Deliver(ctx weave.Context, db weave.KVStore, tx we
 	// revenue with no funds can be updated, so that destinations trust us.
 	// Otherwise an admin could change who receives the money without the
 	// previously selected destinations ever being paid.
-	if err := distribute(db, h.ctrl, rev.Address, rev.Destinations); err != nil {
+	if err := distribute(ctx, db, h.ctrl, rev.Address, rev.Destinations); err != nil {
 		return nil, errors.Wrap(err, "cannot distribute")
 	}
 	rev.Destinations = msg.Destinations
@@ -203,7 +182,7 @@ func (h *resetRevenueHandler) validate(ctx weave.Context, db weave.KVStore, tx w
 //
 // It might be that not all funds can be distributed equally. Because of that a
 // small leftover can remain on the revenue account after this operation.
-func distribute(db weave.KVStore, ctrl CashController, source weave.Address, destinations []*Destination) error {
+func distribute(ctx weave.Context, db weave.KVStore, ctrl CashController, source weave.Address, destinations []*Destination) error {
 	var chunks int64
 	for _, r := range destinations {
 		chunks += int64(r.Weight)
@@ -264,7 +243,7 @@ func distribute(db weave.KVStore, ctrl CashController, source weave.Address, des
 			if amount.IsZero() {
 				continue
 			}
-			if err := ctrl.MoveCoins(db, source, r.Address, amount); err != nil {
+			if err := ctrl.MoveCoins(ctx, db, source, r.Address, amount); err != nil {
 				return errors.Wrap(err, "cannot move coins")
 			}
 		}
@@ -16,6 +16,7 @@ import (
 )
 
 func TestHandlers(t *testing.T) {
+	ctx := context.Background()
 	source := weavetest.NewCondition()
 	addr1 := weavetest.NewCondition().Address()
 	addr2 := weavetest.NewCondition().Address()
@@ -346,7 +347,7 @@ func TestHandlers(t *testing.T) {
 
 			for _, a := range tc.prepareAccounts {
 				for _, c := range a.coins {
-					if err := ctrl.CoinMint(db, a.address, *c); err != nil {
+					if err := ctrl.CoinMint(ctx, db, a.address, *c); err != nil {
 						t.Fatalf("cannot issue %q to %s: %s", c, a.address, err)
 					}
 				}
@@ -459,13 +460,17 @@ func TestFindGdc(t *testing.T) {
 }
 
 func TestDistribute(t *testing.T) {
+	source := weave.Address("address-source")
+
 	cases := map[string]struct {
 		destinations []*Destination
-		ctrl         *testController
-		// Each MoveCoins call on the testController result in creation
-		// of a movecall. Those can be used later to validate that
-		// certain MoveCoins calls were made.
-		wantMoves []movecall
+		balance      coin.Coins
+		// Outcomes of consecutive controller calls.
+		outcomes []error
+		// Each MoveCoins call on the controller is recorded. Those can
+		// be used later to validate that certain MoveCoins calls were
+		// made.
+		wantMoves []weavetest.CashCall
 		wantErr   *errors.Error
 	}{
 		"zero funds is not distributed": {
@@ -473,9 +478,7 @@ func TestDistribute(t *testing.T) {
 				{Address: weave.Address("address-1"), Weight: 1},
 				{Address: weave.Address("address-2"), Weight: 2},
 			},
-			ctrl: &testController{
-				balance: nil,
-			},
+			balance: nil,
 			wantErr: nil,
 		},
 		"tiny funds are not distributed if cannot be split": {
@@ -483,9 +486,7 @@ func TestDistribute(t *testing.T) {
 				{Address: weave.Address("address-1"), Weight: 1},
 				{Address: weave.Address("address-2"), Weight: 2},
 			},
-			ctrl: &testController{
-				balance: coin.Coins{coin.NewCoinp(0, 1, "ETH")},
-			},
+			balance: coin.Coins{coin.NewCoinp(0, 1, "ETH")},
 			wantErr: nil,
 		},
 		"simple distribute case": {
@@ -493,13 +494,11 @@ func TestDistribute(t *testing.T) {
 				{Address: weave.Address("address-1"), Weight: 1},
 				{Address: weave.Address("address-2"), Weight: 2},
 			},
-			ctrl: &testController{
-				balance: coin.Coins{coin.NewCoinp(3, 0, "BTC")},
-			},
+			balance: coin.Coins{coin.NewCoinp(3, 0, "BTC")},
 			wantErr: nil,
-			wantMoves: []movecall{
-				{dst: weave.Address("address-1"), amount: coin.NewCoin(1, 0, "BTC")},
-				{dst: weave.Address("address-2"), amount: coin.NewCoin(2, 0, "BTC")},
+			wantMoves: []weavetest.CashCall{
+				{Method: weavetest.CashMoveCoins, Src: source, Dest: weave.Address("address-1"), Amount: coin.NewCoin(1, 0, "BTC")},
+				{Method: weavetest.CashMoveCoins, Src: source, Dest: weave.Address("address-2"), Amount: coin.NewCoin(2, 0, "BTC")},
 			},
 		},
 		"distribution splits whole into fractional": {
@@ -507,15 +506,13 @@ func TestDistribute(t *testing.T) {
 				{Address: weave.Address("address-1"), Weight: 1},
 				{Address: weave.Address("address-2"), Weight: 2},
 			},
-			ctrl: &testController{
-				balance: coin.Coins{coin.NewCoinp(1, 0, "BTC")},
-			},
+			balance: coin.Coins{coin.NewCoinp(1, 0, "BTC")},
 			wantErr: nil,
-			wantMoves: []movecall{
+			wantMoves: []weavetest.CashCall{
 				// One cent is left on the revenue account,
 				// because it is too small to divide.
-				{dst: weave.Address("address-1"), amount: coin.NewCoin(0, 333333333, "BTC")},
-				{dst: weave.Address("address-2"), amount: coin.NewCoin(0, 666666666, "BTC")},
+				{Method: weavetest.CashMoveCoins, Src: source, Dest: weave.Address("address-1"), Amount: coin.NewCoin(0, 333333333, "BTC")},
+				{Method: weavetest.CashMoveCoins, Src: source, Dest: weave.Address("address-2"), Amount: coin.NewCoin(0, 666666666, "BTC")},
 			},
 		},
 		"whole split into fractions": {
@@ -523,29 +520,50 @@ func TestDistribute(t *testing.T) {
 				{Address: weave.Address("address-1"), Weight: 1},
 				{Address: weave.Address("address-2"), Weight: 2},
 			},
-			ctrl: &testController{
-				balance: coin.Coins{coin.NewCoinp(2, 0, "BTC")},
-			},
+			balance: coin.Coins{coin.NewCoinp(2, 0, "BTC")},
 			wantErr: nil,
-			wantMoves: []movecall{
+			wantMoves: []weavetest.CashCall{
 				// One cent is left on the revenue account,
 				// because it is too small to divide.
-				{dst: weave.Address("address-1"), amount: coin.NewCoin(0, 666666666, "BTC")},
-				{dst: weave.Address("address-2"), amount: coin.NewCoin(1, 333333332, "BTC")},
+				{Method: weavetest.CashMoveCoins, Src: source, Dest: weave.Address("address-1"), Amount: coin.NewCoin(0, 666666666, "BTC")},
+				{Method: weavetest.CashMoveCoins, Src: source, Dest: weave.Address("address-2"), Amount: coin.NewCoin(1, 333333332, "BTC")},
+			},
+		},
+		"failed move stops the distribution": {
+			destinations: []*Destination{
+				{Address: weave.Address("address-1"), Weight: 1},
+				{Address: weave.Address("address-2"), Weight: 2},
+				{Address: weave.Address("address-3"), Weight: 3},
+			},
+			balance: coin.Coins{coin.NewCoinp(6, 0, "BTC")},
+			// Balance and the first move succeed.
+			outcomes: []error{nil, nil, errors.ErrAmount},
+			wantErr:  errors.ErrAmount,
+			wantMoves: []weavetest.CashCall{
+				{Method: weavetest.CashMoveCoins, Src: source, Dest: weave.Address("address-1"), Amount: coin.NewCoin(1, 0, "BTC")},
+				{Method: weavetest.CashMoveCoins, Src: source, Dest: weave.Address("address-2"), Amount: coin.NewCoin(2, 0, "BTC"), Err: errors.ErrAmount},
 			},
 		},
 	}
 
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
-			source := weave.Address("address-source")
-			err := distribute(nil, tc.ctrl, source, tc.destinations)
+			ctrl := weavetest.NewCashController()
+			ctrl.SetBalance(source, tc.balance...)
+			ctrl.Outcomes = tc.outcomes
+			err := distribute(context.Background(), nil, ctrl, source, tc.destinations)
 			if !tc.wantErr.Is(err) {
 				t.Errorf("want %q error, got %q", tc.wantErr, err)
 			}
-			if !reflect.DeepEqual(tc.wantMoves, tc.ctrl.moves) {
-				t.Logf("got %d MoveCoins calls", len(tc.ctrl.moves))
-				for i, m := range tc.ctrl.moves {
+			var moves []weavetest.CashCall
+			for _, c := range ctrl.Calls {
+				if c.Method == weavetest.CashMoveCoins {
+					moves = append(moves, c)
+				}
+			}
+			if !reflect.DeepEqual(tc.wantMoves, moves) {
+				t.Logf("got %d MoveCoins calls", len(moves))
+				for i, m := range moves {
 					t.Logf("%d: %v", i, m)
 				}
 				t.Fatalf("unexpected MoveCoins calls")
@@ -553,23 +571,3 @@ func TestDistribute(t *testing.T) {
 		})
 	}
 }
-
-type testController struct {
-	balance coin.Coins
-	err     error
-	moves   []movecall
-}
-
-type movecall struct {
-	dst    weave.Address
-	amount coin.Coin
-}
-
-func (tc *testController) Balance(weave.KVStore, weave.Address) (coin.Coins, error) {
-	return tc.balance, tc.err
-}
-
-func (tc *testController) MoveCoins(db weave.KVStore, source, dst weave.Address, amount coin.Coin) error {
-	tc.moves = append(tc.moves, movecall{dst: dst, amount: amount})
-	return tc.err
-}
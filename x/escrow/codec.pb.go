@@ -25,6 +25,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// Action is the decision of an arbiter about an escrow.
+type Action int32
+
+const (
+	Action_Invalid Action = 0
+	// Release all coins to the destination.
+	Action_Release Action = 1
+	// Return all coins to the source.
+	Action_Return Action = 2
+)
+
+var Action_name = map[int32]string{
+	0: "ACTION_INVALID",
+	1: "ACTION_RELEASE",
+	2: "ACTION_RETURN",
+}
+
+var Action_value = map[string]int32{
+	"ACTION_INVALID": 0,
+	"ACTION_RELEASE": 1,
+	"ACTION_RETURN":  2,
+}
+
+func (x Action) String() string {
+	return proto.EnumName(Action_name, int32(x))
+}
+
+func (Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{0}
+}
+
 // Escrow holds some coins.
 // The arbiter or source can release them to the destination.
 // The destination can return them to the source.
@@ -46,6 +77,34 @@ type Escrow struct {
 	Memo string `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,7,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Milestones split the escrow into parts that are released separately.
+	// If milestones are declared, the escrow can be released only one
+	// milestone at a time using ReleaseMilestoneMsg.
+	Milestones []*Milestone `protobuf:"bytes,8,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	// Arbiters is a set of addresses that act as the arbiter together. It
+	// is used instead of the single arbiter address. Release or return is
+	// executed once the number of arbiter approvals reaches the threshold.
+	Arbiters         []github_com_iov_one_weave.Address `protobuf:"bytes,9,rep,name=arbiters,proto3,casttype=github.com/iov-one/weave.Address" json:"arbiters,omitempty"`
+	ArbiterThreshold uint32                             `protobuf:"varint,10,opt,name=arbiter_threshold,json=arbiterThreshold,proto3" json:"arbiter_threshold,omitempty"`
+	// Arbiters that approved releasing the escrow to the destination.
+	ReleaseApprovals []github_com_iov_one_weave.Address `protobuf:"bytes,11,rep,name=release_approvals,json=releaseApprovals,proto3,casttype=github.com/iov-one/weave.Address" json:"release_approvals,omitempty"`
+	// Arbiters that approved returning the escrow to the source.
+	ReturnApprovals []github_com_iov_one_weave.Address `protobuf:"bytes,12,rep,name=return_approvals,json=returnApprovals,proto3,casttype=github.com/iov-one/weave.Address" json:"return_approvals,omitempty"`
+	// ID of the cron task that returns the escrow to the source at timeout.
+	// It is empty if the automatic return was not scheduled.
+	ReturnTaskID []byte `protobuf:"bytes,13,opt,name=return_task_id,json=returnTaskId,proto3" json:"return_task_id,omitempty"`
+	// Optional fee paid to the arbiter when the escrow is released or
+	// returned.
+	ArbiterFee *ArbiterFee `protobuf:"bytes,14,opt,name=arbiter_fee,json=arbiterFee,proto3" json:"arbiter_fee,omitempty"`
+	// True once the flat arbiter fee was paid.
+	ArbiterFeePaid bool `protobuf:"varint,15,opt,name=arbiter_fee_paid,json=arbiterFeePaid,proto3" json:"arbiter_fee_paid,omitempty"`
+	// Disputed escrow is not returned at timeout and can be settled only by
+	// the arbiter using ResolveDisputeMsg.
+	Disputed bool `protobuf:"varint,16,opt,name=disputed,proto3" json:"disputed,omitempty"`
+	// Non fungible tokens held by the escrow in addition to the coins. They
+	// are transferred together with the last coins, when the escrow is
+	// released or returned.
+	Tokens []NonFungibleToken `protobuf:"bytes,17,rep,name=tokens,proto3" json:"tokens"`
 }
 
 func (m *Escrow) Reset()         { *m = Escrow{} }
@@ -130,6 +189,253 @@ func (m *Escrow) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Escrow) GetMilestones() []*Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *Escrow) GetArbiters() []github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Arbiters
+	}
+	return nil
+}
+
+func (m *Escrow) GetArbiterThreshold() uint32 {
+	if m != nil {
+		return m.ArbiterThreshold
+	}
+	return 0
+}
+
+func (m *Escrow) GetReleaseApprovals() []github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.ReleaseApprovals
+	}
+	return nil
+}
+
+func (m *Escrow) GetReturnApprovals() []github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.ReturnApprovals
+	}
+	return nil
+}
+
+func (m *Escrow) GetReturnTaskID() []byte {
+	if m != nil {
+		return m.ReturnTaskID
+	}
+	return nil
+}
+
+func (m *Escrow) GetArbiterFee() *ArbiterFee {
+	if m != nil {
+		return m.ArbiterFee
+	}
+	return nil
+}
+
+func (m *Escrow) GetArbiterFeePaid() bool {
+	if m != nil {
+		return m.ArbiterFeePaid
+	}
+	return false
+}
+
+func (m *Escrow) GetDisputed() bool {
+	if m != nil {
+		return m.Disputed
+	}
+	return false
+}
+
+func (m *Escrow) GetTokens() []NonFungibleToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+// NonFungibleToken references a token owned by the escrow, for example a
+// username.
+type NonFungibleToken struct {
+	// Kind of the token, for example "username".
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	ID   []byte `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *NonFungibleToken) Reset()         { *m = NonFungibleToken{} }
+func (m *NonFungibleToken) String() string { return proto.CompactTextString(m) }
+func (*NonFungibleToken) ProtoMessage()    {}
+func (*NonFungibleToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{1}
+}
+func (m *NonFungibleToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NonFungibleToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NonFungibleToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NonFungibleToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonFungibleToken.Merge(m, src)
+}
+func (m *NonFungibleToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *NonFungibleToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonFungibleToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonFungibleToken proto.InternalMessageInfo
+
+func (m *NonFungibleToken) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *NonFungibleToken) GetID() []byte {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+// ArbiterFee is paid to the arbiter out of the settled coins, each time the
+// escrow is released or returned. Only one of flat or basis points can be
+// set.
+type ArbiterFee struct {
+	// Flat fee is paid once, with the first release or return.
+	Flat *coin.Coin `protobuf:"bytes,1,opt,name=flat,proto3" json:"flat,omitempty"`
+	// Percentage of the settled coins expressed in basis points, where
+	// 10000 is 100%. Fee is rounded down.
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *ArbiterFee) Reset()         { *m = ArbiterFee{} }
+func (m *ArbiterFee) String() string { return proto.CompactTextString(m) }
+func (*ArbiterFee) ProtoMessage()    {}
+func (*ArbiterFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{2}
+}
+func (m *ArbiterFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArbiterFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArbiterFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArbiterFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArbiterFee.Merge(m, src)
+}
+func (m *ArbiterFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArbiterFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArbiterFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArbiterFee proto.InternalMessageInfo
+
+func (m *ArbiterFee) GetFlat() *coin.Coin {
+	if m != nil {
+		return m.Flat
+	}
+	return nil
+}
+
+func (m *ArbiterFee) GetBasisPoints() uint32 {
+	if m != nil {
+		return m.BasisPoints
+	}
+	return 0
+}
+
+// Milestone is a part of the escrow that can be released to the destination
+// independently of other milestones.
+type Milestone struct {
+	// max length 128 character
+	Description string       `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	Amount      []*coin.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
+	// Released is set once the milestone amount is sent to the destination.
+	Released bool `protobuf:"varint,3,opt,name=released,proto3" json:"released,omitempty"`
+}
+
+func (m *Milestone) Reset()         { *m = Milestone{} }
+func (m *Milestone) String() string { return proto.CompactTextString(m) }
+func (*Milestone) ProtoMessage()    {}
+func (*Milestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{3}
+}
+func (m *Milestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Milestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Milestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Milestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Milestone.Merge(m, src)
+}
+func (m *Milestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *Milestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_Milestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Milestone proto.InternalMessageInfo
+
+func (m *Milestone) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Milestone) GetAmount() []*coin.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *Milestone) GetReleased() bool {
+	if m != nil {
+		return m.Released
+	}
+	return false
+}
+
 // CreateMsg is a request to create an Escrow with some tokens.
 // If source is not defined, it defaults to the first signer
 // The rest must be defined
@@ -144,13 +450,27 @@ type CreateMsg struct {
 	Timeout github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=timeout,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"timeout,omitempty"`
 	// max length 128 character
 	Memo string `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Optional milestones. If provided, milestone amounts must sum up to the
+	// escrow amount and none of them can be released.
+	Milestones []*Milestone `protobuf:"bytes,8,rep,name=milestones,proto3" json:"milestones,omitempty"`
+	// Optional set of arbiters used instead of the single arbiter. The
+	// threshold is the number of arbiter approvals required to release or
+	// return the escrow.
+	Arbiters         []github_com_iov_one_weave.Address `protobuf:"bytes,9,rep,name=arbiters,proto3,casttype=github.com/iov-one/weave.Address" json:"arbiters,omitempty"`
+	ArbiterThreshold uint32                             `protobuf:"varint,10,opt,name=arbiter_threshold,json=arbiterThreshold,proto3" json:"arbiter_threshold,omitempty"`
+	// Optional fee paid to the arbiter when the escrow is released or
+	// returned. It cannot be used with milestones or multiple arbiters.
+	ArbiterFee *ArbiterFee `protobuf:"bytes,11,opt,name=arbiter_fee,json=arbiterFee,proto3" json:"arbiter_fee,omitempty"`
+	// Optional non fungible tokens that the source transfers to the escrow.
+	// It cannot be used with milestones.
+	Tokens []NonFungibleToken `protobuf:"bytes,12,rep,name=tokens,proto3" json:"tokens"`
 }
 
 func (m *CreateMsg) Reset()         { *m = CreateMsg{} }
 func (m *CreateMsg) String() string { return proto.CompactTextString(m) }
 func (*CreateMsg) ProtoMessage()    {}
 func (*CreateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36017ee554579951, []int{1}
+	return fileDescriptor_36017ee554579951, []int{4}
 }
 func (m *CreateMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -228,6 +548,41 @@ func (m *CreateMsg) GetMemo() string {
 	return ""
 }
 
+func (m *CreateMsg) GetMilestones() []*Milestone {
+	if m != nil {
+		return m.Milestones
+	}
+	return nil
+}
+
+func (m *CreateMsg) GetArbiters() []github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Arbiters
+	}
+	return nil
+}
+
+func (m *CreateMsg) GetArbiterThreshold() uint32 {
+	if m != nil {
+		return m.ArbiterThreshold
+	}
+	return 0
+}
+
+func (m *CreateMsg) GetArbiterFee() *ArbiterFee {
+	if m != nil {
+		return m.ArbiterFee
+	}
+	return nil
+}
+
+func (m *CreateMsg) GetTokens() []NonFungibleToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
 // ReleaseMsg releases the content to the destination.
 // Must be authorized by source or arbiter.
 // If amount not provided, defaults to entire escrow,
@@ -242,7 +597,7 @@ func (m *ReleaseMsg) Reset()         { *m = ReleaseMsg{} }
 func (m *ReleaseMsg) String() string { return proto.CompactTextString(m) }
 func (*ReleaseMsg) ProtoMessage()    {}
 func (*ReleaseMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36017ee554579951, []int{2}
+	return fileDescriptor_36017ee554579951, []int{5}
 }
 func (m *ReleaseMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,25 +647,28 @@ func (m *ReleaseMsg) GetAmount() []*coin.Coin {
 	return nil
 }
 
-// ReturnMsg returns the content to the source.
-// Must be authorized by the source or an expired timeout
-type ReturnMsg struct {
+// ReleaseMilestoneMsg releases the amount of a single milestone to the
+// destination. Must be authorized by the arbiter. Each milestone can be
+// released only once.
+type ReleaseMilestoneMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	EscrowId []byte          `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	// Index of the milestone, starting with zero.
+	Milestone uint32 `protobuf:"varint,3,opt,name=milestone,proto3" json:"milestone,omitempty"`
 }
 
-func (m *ReturnMsg) Reset()         { *m = ReturnMsg{} }
-func (m *ReturnMsg) String() string { return proto.CompactTextString(m) }
-func (*ReturnMsg) ProtoMessage()    {}
-func (*ReturnMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36017ee554579951, []int{3}
+func (m *ReleaseMilestoneMsg) Reset()         { *m = ReleaseMilestoneMsg{} }
+func (m *ReleaseMilestoneMsg) String() string { return proto.CompactTextString(m) }
+func (*ReleaseMilestoneMsg) ProtoMessage()    {}
+func (*ReleaseMilestoneMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{6}
 }
-func (m *ReturnMsg) XXX_Unmarshal(b []byte) error {
+func (m *ReleaseMilestoneMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReturnMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReleaseMilestoneMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReturnMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReleaseMilestoneMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -320,57 +678,62 @@ func (m *ReturnMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ReturnMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReturnMsg.Merge(m, src)
+func (m *ReleaseMilestoneMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseMilestoneMsg.Merge(m, src)
 }
-func (m *ReturnMsg) XXX_Size() int {
+func (m *ReleaseMilestoneMsg) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReturnMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReturnMsg.DiscardUnknown(m)
+func (m *ReleaseMilestoneMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseMilestoneMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReturnMsg proto.InternalMessageInfo
+var xxx_messageInfo_ReleaseMilestoneMsg proto.InternalMessageInfo
 
-func (m *ReturnMsg) GetMetadata() *weave.Metadata {
+func (m *ReleaseMilestoneMsg) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *ReturnMsg) GetEscrowId() []byte {
+func (m *ReleaseMilestoneMsg) GetEscrowId() []byte {
 	if m != nil {
 		return m.EscrowId
 	}
 	return nil
 }
 
-// UpdatePartiesMsg changes any of the parties of the escrow:
-// source, arbiter, destination. This must be authorized by the current
-// holder of that position (eg. only source can update source).
-//
-// Represents delegating responsibility
-type UpdatePartiesMsg struct {
-	Metadata    *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	EscrowId    []byte                           `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
-	Source      github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=source,proto3,casttype=github.com/iov-one/weave.Address" json:"source,omitempty"`
-	Arbiter     github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=arbiter,proto3,casttype=github.com/iov-one/weave.Address" json:"arbiter,omitempty"`
-	Destination github_com_iov_one_weave.Address `protobuf:"bytes,5,opt,name=destination,proto3,casttype=github.com/iov-one/weave.Address" json:"destination,omitempty"`
+func (m *ReleaseMilestoneMsg) GetMilestone() uint32 {
+	if m != nil {
+		return m.Milestone
+	}
+	return 0
 }
 
-func (m *UpdatePartiesMsg) Reset()         { *m = UpdatePartiesMsg{} }
-func (m *UpdatePartiesMsg) String() string { return proto.CompactTextString(m) }
-func (*UpdatePartiesMsg) ProtoMessage()    {}
-func (*UpdatePartiesMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36017ee554579951, []int{4}
+// ApproveMsg records the approval of a single arbiter of an escrow with
+// multiple arbiters. Must be authorized by the arbiter. Once the number of
+// approvals of an action reaches the arbiter threshold, the action is
+// executed and the escrow is deleted.
+type ApproveMsg struct {
+	Metadata *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	EscrowId []byte                           `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	Arbiter  github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=arbiter,proto3,casttype=github.com/iov-one/weave.Address" json:"arbiter,omitempty"`
+	Action   Action                           `protobuf:"varint,4,opt,name=action,proto3,enum=escrow.Action" json:"action,omitempty"`
 }
-func (m *UpdatePartiesMsg) XXX_Unmarshal(b []byte) error {
+
+func (m *ApproveMsg) Reset()         { *m = ApproveMsg{} }
+func (m *ApproveMsg) String() string { return proto.CompactTextString(m) }
+func (*ApproveMsg) ProtoMessage()    {}
+func (*ApproveMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{7}
+}
+func (m *ApproveMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdatePartiesMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApproveMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdatePartiesMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApproveMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -380,307 +743,540 @@ func (m *UpdatePartiesMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *UpdatePartiesMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdatePartiesMsg.Merge(m, src)
+func (m *ApproveMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveMsg.Merge(m, src)
 }
-func (m *UpdatePartiesMsg) XXX_Size() int {
+func (m *ApproveMsg) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdatePartiesMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdatePartiesMsg.DiscardUnknown(m)
+func (m *ApproveMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdatePartiesMsg proto.InternalMessageInfo
+var xxx_messageInfo_ApproveMsg proto.InternalMessageInfo
 
-func (m *UpdatePartiesMsg) GetMetadata() *weave.Metadata {
+func (m *ApproveMsg) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *UpdatePartiesMsg) GetEscrowId() []byte {
+func (m *ApproveMsg) GetEscrowId() []byte {
 	if m != nil {
 		return m.EscrowId
 	}
 	return nil
 }
 
-func (m *UpdatePartiesMsg) GetSource() github_com_iov_one_weave.Address {
-	if m != nil {
-		return m.Source
-	}
-	return nil
-}
-
-func (m *UpdatePartiesMsg) GetArbiter() github_com_iov_one_weave.Address {
+func (m *ApproveMsg) GetArbiter() github_com_iov_one_weave.Address {
 	if m != nil {
 		return m.Arbiter
 	}
 	return nil
 }
 
-func (m *UpdatePartiesMsg) GetDestination() github_com_iov_one_weave.Address {
+func (m *ApproveMsg) GetAction() Action {
 	if m != nil {
-		return m.Destination
+		return m.Action
 	}
-	return nil
+	return Action_Invalid
 }
 
-func init() {
-	proto.RegisterType((*Escrow)(nil), "escrow.Escrow")
-	proto.RegisterType((*CreateMsg)(nil), "escrow.CreateMsg")
-	proto.RegisterType((*ReleaseMsg)(nil), "escrow.ReleaseMsg")
-	proto.RegisterType((*ReturnMsg)(nil), "escrow.ReturnMsg")
-	proto.RegisterType((*UpdatePartiesMsg)(nil), "escrow.UpdatePartiesMsg")
+// ReturnMsg returns the content to the source.
+// Must be authorized by the source or an expired timeout
+type ReturnMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	EscrowId []byte          `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("x/escrow/codec.proto", fileDescriptor_36017ee554579951) }
+func (m *ReturnMsg) Reset()         { *m = ReturnMsg{} }
+func (m *ReturnMsg) String() string { return proto.CompactTextString(m) }
+func (*ReturnMsg) ProtoMessage()    {}
+func (*ReturnMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{8}
+}
+func (m *ReturnMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReturnMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReturnMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReturnMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReturnMsg.Merge(m, src)
+}
+func (m *ReturnMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReturnMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReturnMsg.DiscardUnknown(m)
+}
 
-var fileDescriptor_36017ee554579951 = []byte{
-	// 428 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x54, 0xc1, 0x6a, 0xdb, 0x40,
-	0x14, 0xb4, 0x2c, 0x47, 0x8e, 0x9f, 0x0b, 0x0d, 0x22, 0x07, 0xe1, 0x82, 0xa2, 0x8a, 0x16, 0x04,
-	0xa5, 0x12, 0xa4, 0xd7, 0xd2, 0x52, 0x87, 0x16, 0x7a, 0x08, 0x94, 0xa5, 0x3e, 0x97, 0xb5, 0xf6,
-	0xe1, 0x2e, 0x54, 0xfb, 0xc2, 0xee, 0x2a, 0x09, 0xfd, 0x8a, 0x7c, 0x43, 0xbf, 0xa6, 0xc7, 0x1c,
-	0x7b, 0x0a, 0xc5, 0xfe, 0x88, 0x42, 0x4e, 0xc5, 0x5a, 0xa7, 0xd1, 0x25, 0x07, 0x35, 0xbe, 0xe5,
-	0x36, 0x8c, 0x76, 0xde, 0x32, 0x6f, 0x46, 0x0b, 0xfb, 0xe7, 0x05, 0x9a, 0x52, 0xd3, 0x59, 0x51,
-	0x92, 0xc0, 0x32, 0x3f, 0xd1, 0x64, 0x29, 0x0c, 0x1c, 0x37, 0x19, 0xb7, 0xc8, 0xc9, 0x5e, 0x49,
-	0x52, 0xb5, 0x8f, 0x4d, 0xf6, 0x17, 0xb4, 0xa0, 0x06, 0x16, 0x6b, 0xe4, 0xd8, 0xf4, 0xc2, 0x87,
-	0xe0, 0x7d, 0xa3, 0x0f, 0x5f, 0xc0, 0x6e, 0x85, 0x96, 0x0b, 0x6e, 0x79, 0xe4, 0x25, 0x5e, 0x36,
-	0x3e, 0x7c, 0x9c, 0x9f, 0x21, 0x3f, 0xc5, 0xfc, 0x78, 0x43, 0xb3, 0x7f, 0x07, 0xc2, 0xd7, 0x10,
-	0x18, 0xaa, 0x75, 0x89, 0x51, 0x3f, 0xf1, 0xb2, 0x47, 0xd3, 0x67, 0xd7, 0x57, 0x07, 0xc9, 0x42,
-	0xda, 0xaf, 0xf5, 0x3c, 0x2f, 0xa9, 0x2a, 0x24, 0x9d, 0xbe, 0x24, 0x85, 0x85, 0x1b, 0xf0, 0x4e,
-	0x08, 0x8d, 0xc6, 0xb0, 0x8d, 0x26, 0x7c, 0x03, 0x43, 0xae, 0xe7, 0xd2, 0xa2, 0x8e, 0xfc, 0x0e,
-	0xf2, 0x1b, 0x51, 0xf8, 0x01, 0xc6, 0x02, 0x8d, 0x95, 0x8a, 0x5b, 0x49, 0x2a, 0x1a, 0x74, 0x98,
-	0xd1, 0x16, 0x86, 0x6f, 0x61, 0x68, 0x65, 0x85, 0x54, 0xdb, 0x68, 0x27, 0xf1, 0x32, 0x7f, 0xfa,
-	0xfc, 0xfa, 0xea, 0xe0, 0xe9, 0x9d, 0x33, 0x66, 0x4a, 0x9e, 0x7f, 0x96, 0x15, 0xb2, 0x1b, 0x55,
-	0x18, 0xc2, 0xa0, 0xc2, 0x8a, 0xa2, 0x20, 0xf1, 0xb2, 0x11, 0x6b, 0x70, 0x63, 0xce, 0x5d, 0x16,
-	0x0d, 0x3b, 0x99, 0x73, 0x20, 0xfd, 0xd3, 0x87, 0xd1, 0x91, 0x46, 0x6e, 0xf1, 0xd8, 0x2c, 0x1e,
-	0x62, 0x2a, 0x29, 0x04, 0xbc, 0xa2, 0x5a, 0xad, 0x43, 0xf1, 0xb3, 0xf1, 0x21, 0xe4, 0xeb, 0x32,
-	0xe7, 0x47, 0x24, 0x15, 0xdb, 0x7c, 0x69, 0x27, 0x17, 0xdc, 0x2b, 0xb9, 0xe1, 0x6d, 0x72, 0xe9,
-	0x77, 0x00, 0x86, 0xdf, 0x90, 0x9b, 0xee, 0x9b, 0x7f, 0x02, 0x23, 0xf7, 0x1b, 0x7e, 0x91, 0xc2,
-	0x2d, 0x9f, 0xed, 0x3a, 0xe2, 0xa3, 0x68, 0x19, 0xf2, 0xef, 0x32, 0x94, 0xce, 0x60, 0xc4, 0xd0,
-	0xd6, 0x5a, 0x6d, 0xf5, 0xea, 0xf4, 0x47, 0x1f, 0xf6, 0x66, 0x27, 0x82, 0x5b, 0xfc, 0xc4, 0xb5,
-	0x95, 0x68, 0xb6, 0xeb, 0xec, 0xb6, 0x70, 0xfe, 0xfd, 0x0a, 0x37, 0xd8, 0x42, 0xe1, 0x76, 0xfe,
-	0xb3, 0x70, 0xd3, 0xe8, 0xe7, 0x32, 0xf6, 0x2e, 0x97, 0xb1, 0xf7, 0x7b, 0x19, 0x7b, 0x17, 0xab,
-	0xb8, 0x77, 0xb9, 0x8a, 0x7b, 0xbf, 0x56, 0x71, 0x6f, 0x1e, 0x34, 0xaf, 0xe4, 0xab, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x4a, 0x80, 0x06, 0xba, 0x7a, 0x05, 0x00, 0x00,
+var xxx_messageInfo_ReturnMsg proto.InternalMessageInfo
+
+func (m *ReturnMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
 }
 
-func (m *Escrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *ReturnMsg) GetEscrowId() []byte {
+	if m != nil {
+		return m.EscrowId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+// UpdatePartiesMsg changes any of the parties of the escrow:
+// source, arbiter, destination. This must be authorized by the current
+// holder of that position (eg. only source can update source).
+//
+// Represents delegating responsibility
+type UpdatePartiesMsg struct {
+	Metadata    *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	EscrowId    []byte                           `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	Source      github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=source,proto3,casttype=github.com/iov-one/weave.Address" json:"source,omitempty"`
+	Arbiter     github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=arbiter,proto3,casttype=github.com/iov-one/weave.Address" json:"arbiter,omitempty"`
+	Destination github_com_iov_one_weave.Address `protobuf:"bytes,5,opt,name=destination,proto3,casttype=github.com/iov-one/weave.Address" json:"destination,omitempty"`
+}
+
+func (m *UpdatePartiesMsg) Reset()         { *m = UpdatePartiesMsg{} }
+func (m *UpdatePartiesMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdatePartiesMsg) ProtoMessage()    {}
+func (*UpdatePartiesMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{9}
+}
+func (m *UpdatePartiesMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdatePartiesMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdatePartiesMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
-	}
-	if len(m.Source) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
-		i += copy(dAtA[i:], m.Source)
-	}
-	if len(m.Arbiter) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Arbiter)))
-		i += copy(dAtA[i:], m.Arbiter)
+		return b[:n], nil
 	}
-	if len(m.Destination) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
-		i += copy(dAtA[i:], m.Destination)
+}
+func (m *UpdatePartiesMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdatePartiesMsg.Merge(m, src)
+}
+func (m *UpdatePartiesMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdatePartiesMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdatePartiesMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdatePartiesMsg proto.InternalMessageInfo
+
+func (m *UpdatePartiesMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if m.Timeout != 0 {
-		dAtA[i] = 0x28
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Timeout))
+	return nil
+}
+
+func (m *UpdatePartiesMsg) GetEscrowId() []byte {
+	if m != nil {
+		return m.EscrowId
 	}
-	if len(m.Memo) > 0 {
-		dAtA[i] = 0x32
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
-		i += copy(dAtA[i:], m.Memo)
+	return nil
+}
+
+func (m *UpdatePartiesMsg) GetSource() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Source
 	}
-	if len(m.Address) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
-		i += copy(dAtA[i:], m.Address)
+	return nil
+}
+
+func (m *UpdatePartiesMsg) GetArbiter() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Arbiter
 	}
-	return i, nil
+	return nil
 }
 
-func (m *CreateMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *UpdatePartiesMsg) GetDestination() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Destination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+// UpdateEscrowTimeoutMsg extends the timeout of an escrow. It must be
+// authorized by both the source and the destination and can be used only
+// before the escrow expires.
+type UpdateEscrowTimeoutMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	EscrowId []byte          `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	// New timeout must be after the current escrow timeout.
+	Timeout github_com_iov_one_weave.UnixTime `protobuf:"varint,3,opt,name=timeout,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"timeout,omitempty"`
+}
+
+func (m *UpdateEscrowTimeoutMsg) Reset()         { *m = UpdateEscrowTimeoutMsg{} }
+func (m *UpdateEscrowTimeoutMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateEscrowTimeoutMsg) ProtoMessage()    {}
+func (*UpdateEscrowTimeoutMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{10}
+}
+func (m *UpdateEscrowTimeoutMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateEscrowTimeoutMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateEscrowTimeoutMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
-		}
-		i += n2
-	}
-	if len(m.Source) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
-		i += copy(dAtA[i:], m.Source)
-	}
-	if len(m.Arbiter) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Arbiter)))
-		i += copy(dAtA[i:], m.Arbiter)
-	}
-	if len(m.Destination) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
-		i += copy(dAtA[i:], m.Destination)
-	}
-	if len(m.Amount) > 0 {
-		for _, msg := range m.Amount {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Timeout != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Timeout))
-	}
-	if len(m.Memo) > 0 {
-		dAtA[i] = 0x3a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
-		i += copy(dAtA[i:], m.Memo)
-	}
-	return i, nil
+}
+func (m *UpdateEscrowTimeoutMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateEscrowTimeoutMsg.Merge(m, src)
+}
+func (m *UpdateEscrowTimeoutMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateEscrowTimeoutMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateEscrowTimeoutMsg.DiscardUnknown(m)
 }
 
-func (m *ReleaseMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateEscrowTimeoutMsg proto.InternalMessageInfo
+
+func (m *UpdateEscrowTimeoutMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+func (m *UpdateEscrowTimeoutMsg) GetEscrowId() []byte {
+	if m != nil {
+		return m.EscrowId
+	}
+	return nil
+}
+
+func (m *UpdateEscrowTimeoutMsg) GetTimeout() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+// RaiseDisputeMsg marks an escrow as disputed. It must be signed by the
+// source or the destination before the escrow timeout.
+type RaiseDisputeMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	EscrowId []byte          `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+}
+
+func (m *RaiseDisputeMsg) Reset()         { *m = RaiseDisputeMsg{} }
+func (m *RaiseDisputeMsg) String() string { return proto.CompactTextString(m) }
+func (*RaiseDisputeMsg) ProtoMessage()    {}
+func (*RaiseDisputeMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{11}
+}
+func (m *RaiseDisputeMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RaiseDisputeMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RaiseDisputeMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n3
+		return b[:n], nil
 	}
-	if len(m.EscrowId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
-		i += copy(dAtA[i:], m.EscrowId)
+}
+func (m *RaiseDisputeMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaiseDisputeMsg.Merge(m, src)
+}
+func (m *RaiseDisputeMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RaiseDisputeMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaiseDisputeMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaiseDisputeMsg proto.InternalMessageInfo
+
+func (m *RaiseDisputeMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.Amount) > 0 {
-		for _, msg := range m.Amount {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return nil
+}
+
+func (m *RaiseDisputeMsg) GetEscrowId() []byte {
+	if m != nil {
+		return m.EscrowId
+	}
+	return nil
+}
+
+// ResolveDisputeMsg settles a disputed escrow. It must be signed by the
+// arbiter. Amounts paid to the source and to the destination must sum up to
+// the escrow balance.
+type ResolveDisputeMsg struct {
+	Metadata          *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	EscrowId          []byte          `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	SourceAmount      []*coin.Coin    `protobuf:"bytes,3,rep,name=source_amount,json=sourceAmount,proto3" json:"source_amount,omitempty"`
+	DestinationAmount []*coin.Coin    `protobuf:"bytes,4,rep,name=destination_amount,json=destinationAmount,proto3" json:"destination_amount,omitempty"`
+	// If true, tokens held by the escrow are transferred to the destination.
+	// Otherwise they are returned to the source.
+	ReleaseTokens bool `protobuf:"varint,5,opt,name=release_tokens,json=releaseTokens,proto3" json:"release_tokens,omitempty"`
+}
+
+func (m *ResolveDisputeMsg) Reset()         { *m = ResolveDisputeMsg{} }
+func (m *ResolveDisputeMsg) String() string { return proto.CompactTextString(m) }
+func (*ResolveDisputeMsg) ProtoMessage()    {}
+func (*ResolveDisputeMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{12}
+}
+func (m *ResolveDisputeMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolveDisputeMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolveDisputeMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *ResolveDisputeMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveDisputeMsg.Merge(m, src)
+}
+func (m *ResolveDisputeMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolveDisputeMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveDisputeMsg.DiscardUnknown(m)
 }
 
-func (m *ReturnMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ResolveDisputeMsg proto.InternalMessageInfo
+
+func (m *ResolveDisputeMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ReturnMsg) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+func (m *ResolveDisputeMsg) GetEscrowId() []byte {
+	if m != nil {
+		return m.EscrowId
+	}
+	return nil
+}
+
+func (m *ResolveDisputeMsg) GetSourceAmount() []*coin.Coin {
+	if m != nil {
+		return m.SourceAmount
+	}
+	return nil
+}
+
+func (m *ResolveDisputeMsg) GetDestinationAmount() []*coin.Coin {
+	if m != nil {
+		return m.DestinationAmount
+	}
+	return nil
+}
+
+func (m *ResolveDisputeMsg) GetReleaseTokens() bool {
+	if m != nil {
+		return m.ReleaseTokens
+	}
+	return false
+}
+
+// UpdateEscrowPartiesMsg replaces the arbiter or the destination of an
+// escrow. Unlike UpdatePartiesMsg it does not require the signature of the
+// replaced party, but both the source and the destination must sign it. This
+// allows to replace an arbiter that lost access to its key.
+type UpdateEscrowPartiesMsg struct {
+	Metadata    *weave.Metadata                  `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	EscrowId    []byte                           `protobuf:"bytes,2,opt,name=escrow_id,json=escrowId,proto3" json:"escrow_id,omitempty"`
+	Arbiter     github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=arbiter,proto3,casttype=github.com/iov-one/weave.Address" json:"arbiter,omitempty"`
+	Destination github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=destination,proto3,casttype=github.com/iov-one/weave.Address" json:"destination,omitempty"`
+}
+
+func (m *UpdateEscrowPartiesMsg) Reset()         { *m = UpdateEscrowPartiesMsg{} }
+func (m *UpdateEscrowPartiesMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateEscrowPartiesMsg) ProtoMessage()    {}
+func (*UpdateEscrowPartiesMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36017ee554579951, []int{13}
+}
+func (m *UpdateEscrowPartiesMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateEscrowPartiesMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateEscrowPartiesMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n4
+		return b[:n], nil
 	}
-	if len(m.EscrowId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
-		i += copy(dAtA[i:], m.EscrowId)
+}
+func (m *UpdateEscrowPartiesMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateEscrowPartiesMsg.Merge(m, src)
+}
+func (m *UpdateEscrowPartiesMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateEscrowPartiesMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateEscrowPartiesMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateEscrowPartiesMsg proto.InternalMessageInfo
+
+func (m *UpdateEscrowPartiesMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return i, nil
+	return nil
 }
 
-func (m *UpdatePartiesMsg) Marshal() (dAtA []byte, err error) {
+func (m *UpdateEscrowPartiesMsg) GetEscrowId() []byte {
+	if m != nil {
+		return m.EscrowId
+	}
+	return nil
+}
+
+func (m *UpdateEscrowPartiesMsg) GetArbiter() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Arbiter
+	}
+	return nil
+}
+
+func (m *UpdateEscrowPartiesMsg) GetDestination() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Destination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("escrow.Action", Action_name, Action_value)
+	proto.RegisterType((*Escrow)(nil), "escrow.Escrow")
+	proto.RegisterType((*NonFungibleToken)(nil), "escrow.NonFungibleToken")
+	proto.RegisterType((*ArbiterFee)(nil), "escrow.ArbiterFee")
+	proto.RegisterType((*Milestone)(nil), "escrow.Milestone")
+	proto.RegisterType((*CreateMsg)(nil), "escrow.CreateMsg")
+	proto.RegisterType((*ReleaseMsg)(nil), "escrow.ReleaseMsg")
+	proto.RegisterType((*ReleaseMilestoneMsg)(nil), "escrow.ReleaseMilestoneMsg")
+	proto.RegisterType((*ApproveMsg)(nil), "escrow.ApproveMsg")
+	proto.RegisterType((*ReturnMsg)(nil), "escrow.ReturnMsg")
+	proto.RegisterType((*UpdatePartiesMsg)(nil), "escrow.UpdatePartiesMsg")
+	proto.RegisterType((*UpdateEscrowTimeoutMsg)(nil), "escrow.UpdateEscrowTimeoutMsg")
+	proto.RegisterType((*RaiseDisputeMsg)(nil), "escrow.RaiseDisputeMsg")
+	proto.RegisterType((*ResolveDisputeMsg)(nil), "escrow.ResolveDisputeMsg")
+	proto.RegisterType((*UpdateEscrowPartiesMsg)(nil), "escrow.UpdateEscrowPartiesMsg")
+}
+
+func init() { proto.RegisterFile("x/escrow/codec.proto", fileDescriptor_36017ee554579951) }
+
+var fileDescriptor_36017ee554579951 = []byte{
+	// 1007 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4b, 0x8b, 0x1b, 0x47,
+	0x10, 0xde, 0x91, 0xb4, 0x23, 0xa9, 0xf4, 0xd8, 0x51, 0xc7, 0x98, 0x41, 0x49, 0xa4, 0xf1, 0x10,
+	0x07, 0x11, 0x13, 0x89, 0xd8, 0x60, 0x08, 0x04, 0x27, 0xda, 0x17, 0x08, 0xbc, 0x8f, 0x74, 0xb4,
+	0xb9, 0xe4, 0x20, 0x5a, 0x9a, 0xb6, 0xb6, 0x59, 0x69, 0x5a, 0x4c, 0xb7, 0x64, 0x13, 0xc8, 0x35,
+	0x07, 0x9f, 0xf2, 0x07, 0x7c, 0x49, 0xfe, 0x47, 0x20, 0x37, 0x1f, 0x7d, 0xcc, 0x49, 0x04, 0xed,
+	0x2d, 0xa7, 0x9c, 0x7d, 0x0a, 0xea, 0x9e, 0x19, 0x0d, 0x1b, 0x16, 0x2c, 0xaf, 0x0c, 0x86, 0xdc,
+	0x4a, 0xd5, 0xf5, 0x55, 0xcd, 0x54, 0xd5, 0xf7, 0xf5, 0x08, 0x6e, 0x3d, 0x6b, 0x51, 0x31, 0x08,
+	0xf8, 0xd3, 0xd6, 0x80, 0x7b, 0x74, 0xd0, 0x9c, 0x04, 0x5c, 0x72, 0x64, 0x6a, 0x5f, 0xb5, 0x90,
+	0x70, 0x56, 0xad, 0x01, 0x67, 0x7e, 0x32, 0xac, 0x7a, 0x6b, 0xc8, 0x87, 0x5c, 0x99, 0xad, 0xa5,
+	0xa5, 0xbd, 0xee, 0x1f, 0x59, 0x30, 0x0f, 0x14, 0x1e, 0xdd, 0x83, 0xdc, 0x98, 0x4a, 0xe2, 0x11,
+	0x49, 0x6c, 0xc3, 0x31, 0x1a, 0x85, 0xfb, 0x3b, 0xcd, 0xa7, 0x94, 0xcc, 0x68, 0xf3, 0x28, 0x74,
+	0xe3, 0x38, 0x00, 0x7d, 0x05, 0xa6, 0xe0, 0xd3, 0x60, 0x40, 0xed, 0x94, 0x63, 0x34, 0x8a, 0xbb,
+	0x9f, 0xbc, 0x9e, 0xd7, 0x9d, 0x21, 0x93, 0xe7, 0xd3, 0x7e, 0x73, 0xc0, 0xc7, 0x2d, 0xc6, 0x67,
+	0x9f, 0x73, 0x9f, 0xb6, 0x74, 0x82, 0xb6, 0xe7, 0x05, 0x54, 0x08, 0x1c, 0x62, 0xd0, 0x23, 0xc8,
+	0x92, 0xa0, 0xcf, 0x24, 0x0d, 0xec, 0xf4, 0x1a, 0xf0, 0x08, 0x84, 0x0e, 0xa1, 0xe0, 0x51, 0x21,
+	0x99, 0x4f, 0x24, 0xe3, 0xbe, 0x9d, 0x59, 0x23, 0x47, 0x12, 0x88, 0xbe, 0x86, 0xac, 0x64, 0x63,
+	0xca, 0xa7, 0xd2, 0xde, 0x76, 0x8c, 0x46, 0x7a, 0xf7, 0xee, 0xeb, 0x79, 0xfd, 0xce, 0xb5, 0x39,
+	0xce, 0x7c, 0xf6, 0xac, 0xcb, 0xc6, 0x14, 0x47, 0x28, 0x84, 0x20, 0x33, 0xa6, 0x63, 0x6e, 0x9b,
+	0x8e, 0xd1, 0xc8, 0x63, 0x65, 0xab, 0x97, 0xd3, 0xc5, 0xec, 0xec, 0x5a, 0x2f, 0xa7, 0x0d, 0xf4,
+	0x05, 0xc0, 0x98, 0x8d, 0xa8, 0x90, 0xdc, 0xa7, 0xc2, 0xce, 0x39, 0xe9, 0x46, 0xe1, 0x7e, 0xa5,
+	0xa9, 0x87, 0xdc, 0x3c, 0x8a, 0x4e, 0x70, 0x22, 0x08, 0x7d, 0x03, 0xb9, 0xb0, 0x35, 0xc2, 0xce,
+	0x3b, 0xe9, 0x37, 0xae, 0x19, 0xa3, 0xd0, 0x3d, 0xa8, 0x84, 0x76, 0x4f, 0x9e, 0x07, 0x54, 0x9c,
+	0xf3, 0x91, 0x67, 0x83, 0x63, 0x34, 0x4a, 0xd8, 0x0a, 0x0f, 0xba, 0x91, 0x1f, 0x7d, 0x0b, 0x95,
+	0x80, 0x8e, 0x28, 0x11, 0xb4, 0x47, 0x26, 0x93, 0x80, 0xcf, 0xc8, 0x48, 0xd8, 0x85, 0x35, 0xea,
+	0x5a, 0x21, 0xbc, 0x1d, 0xa1, 0xd1, 0x09, 0x58, 0x01, 0x95, 0xd3, 0xc0, 0x4f, 0x64, 0x2c, 0xae,
+	0x91, 0x71, 0x47, 0xa3, 0x57, 0x09, 0x1f, 0x42, 0x39, 0x4c, 0x28, 0x89, 0xb8, 0xe8, 0x31, 0xcf,
+	0x2e, 0xa9, 0x61, 0x58, 0x8b, 0x79, 0xbd, 0x88, 0xd5, 0x49, 0x97, 0x88, 0x8b, 0xce, 0x3e, 0x2e,
+	0x06, 0xab, 0x5f, 0x1e, 0x7a, 0x00, 0x85, 0xa8, 0x11, 0x4f, 0x28, 0xb5, 0xcb, 0x8a, 0x08, 0x28,
+	0x6a, 0x7f, 0x5b, 0x1f, 0x1d, 0x52, 0x8a, 0x81, 0xc4, 0x36, 0x6a, 0x80, 0x95, 0x00, 0xf5, 0x26,
+	0x84, 0x79, 0xf6, 0x8e, 0x63, 0x34, 0x72, 0xb8, 0xbc, 0x8a, 0x3a, 0x25, 0xcc, 0x43, 0x55, 0xc8,
+	0x79, 0x4c, 0x4c, 0xa6, 0x92, 0x7a, 0xb6, 0xa5, 0x22, 0xe2, 0xdf, 0xe8, 0x21, 0x98, 0x92, 0x5f,
+	0x50, 0x5f, 0xd8, 0x15, 0x35, 0x74, 0x3b, 0xaa, 0x7a, 0xcc, 0xfd, 0xc3, 0xa9, 0x3f, 0x64, 0xfd,
+	0x11, 0xed, 0x2e, 0x03, 0x76, 0x33, 0x2f, 0xe7, 0xf5, 0x2d, 0x1c, 0x46, 0xbb, 0x8f, 0xc0, 0xba,
+	0x1a, 0xb1, 0x5c, 0xcc, 0x0b, 0xe6, 0x7b, 0x8a, 0xc8, 0x79, 0xac, 0x6c, 0x74, 0x1b, 0x52, 0xcc,
+	0x0b, 0xf9, 0x6a, 0x2e, 0xe6, 0xf5, 0x54, 0x67, 0x1f, 0xa7, 0x98, 0xe7, 0x9e, 0x00, 0xac, 0xde,
+	0x0b, 0xd5, 0x20, 0xf3, 0x64, 0x44, 0x64, 0x28, 0x01, 0xd0, 0x5c, 0x0a, 0x49, 0x73, 0x8f, 0x33,
+	0x1f, 0x2b, 0x3f, 0xba, 0x03, 0xc5, 0x3e, 0x11, 0x4c, 0xf4, 0x26, 0x9c, 0xf9, 0x52, 0xa8, 0x7c,
+	0x25, 0x5c, 0x50, 0xbe, 0x53, 0xe5, 0x72, 0xc7, 0x90, 0x8f, 0xf7, 0x14, 0x39, 0x8a, 0xab, 0x83,
+	0x80, 0x4d, 0x14, 0x57, 0xf5, 0x03, 0x25, 0x5d, 0xc8, 0x05, 0x93, 0x8c, 0xf9, 0xd4, 0x97, 0x76,
+	0xca, 0x49, 0x5f, 0xa9, 0x19, 0x9e, 0x2c, 0xfb, 0x16, 0xee, 0x8c, 0xa7, 0x24, 0x23, 0x87, 0xe3,
+	0xdf, 0xee, 0xcf, 0xdb, 0x90, 0xdf, 0x0b, 0x28, 0x91, 0xf4, 0x48, 0x0c, 0xff, 0x8f, 0x32, 0xb6,
+	0x6a, 0xe0, 0xf6, 0xb5, 0x0d, 0x4c, 0x48, 0x9d, 0x79, 0x23, 0xa9, 0xcb, 0x26, 0xa4, 0xee, 0xfd,
+	0x97, 0xaa, 0x2b, 0x74, 0x2e, 0xbc, 0x11, 0x9d, 0x57, 0x44, 0x2c, 0xae, 0x45, 0xc4, 0x1f, 0x01,
+	0xb0, 0x5e, 0xca, 0xb5, 0x17, 0xf1, 0x43, 0xc8, 0xeb, 0x1a, 0xbd, 0x88, 0xa2, 0x38, 0xa7, 0x1d,
+	0x1d, 0x2f, 0x31, 0xdf, 0xf4, 0x75, 0xf3, 0x75, 0x7f, 0x82, 0x0f, 0xa2, 0xda, 0x51, 0xb3, 0x37,
+	0xfb, 0x10, 0x1f, 0x41, 0x3e, 0x1e, 0xa3, 0x5a, 0xf7, 0x12, 0x5e, 0x39, 0xdc, 0xdf, 0x0d, 0x00,
+	0x2d, 0xbe, 0x1b, 0x2e, 0x7b, 0x53, 0x8e, 0x7d, 0x0a, 0x26, 0x19, 0xc4, 0xf4, 0x2a, 0xdf, 0x2f,
+	0xc7, 0xb3, 0x57, 0x5e, 0x1c, 0x9e, 0xba, 0x67, 0x90, 0xd7, 0xb7, 0xc2, 0x46, 0x1f, 0xdf, 0xfd,
+	0x35, 0x05, 0xd6, 0xd9, 0xc4, 0x23, 0x92, 0x9e, 0x92, 0x40, 0x32, 0x2a, 0x36, 0xdb, 0x9d, 0x95,
+	0x7e, 0xa5, 0x6f, 0xa6, 0x5f, 0x99, 0x0d, 0xe8, 0xd7, 0xf6, 0x5b, 0xea, 0x97, 0xfb, 0x9b, 0x01,
+	0xb7, 0x75, 0x93, 0xf4, 0xa7, 0x68, 0x57, 0x4b, 0xce, 0x66, 0x5b, 0x95, 0x10, 0xc0, 0xf4, 0xdb,
+	0x08, 0xa0, 0xfb, 0x03, 0xec, 0x60, 0xc2, 0x04, 0xdd, 0xd7, 0xf7, 0xf5, 0x66, 0xf7, 0xe4, 0x1f,
+	0x03, 0x2a, 0x98, 0x0a, 0x3e, 0x9a, 0xbd, 0x93, 0xfc, 0xa8, 0x05, 0x25, 0x3d, 0xf4, 0xde, 0xb5,
+	0x4a, 0x52, 0xd4, 0x01, 0x6d, 0x7d, 0x5f, 0x7c, 0x09, 0x28, 0x31, 0xa2, 0x08, 0x95, 0xf9, 0x0f,
+	0xaa, 0x92, 0x88, 0x0a, 0xa1, 0x77, 0x97, 0x9f, 0x5e, 0xfa, 0xf3, 0x30, 0x94, 0xd1, 0x6d, 0x75,
+	0x63, 0x97, 0x42, 0x6f, 0x57, 0xab, 0xe5, 0xdf, 0x57, 0xa6, 0xfe, 0x4e, 0x08, 0xf2, 0x9e, 0x5c,
+	0xd1, 0x9f, 0x31, 0x30, 0xb5, 0xe0, 0xa0, 0x3a, 0x94, 0xdb, 0x7b, 0xdd, 0xce, 0xc9, 0x71, 0xaf,
+	0x73, 0xfc, 0x7d, 0xfb, 0x71, 0x67, 0xdf, 0xda, 0xaa, 0x16, 0x9e, 0xbf, 0x70, 0xb2, 0x1d, 0x7f,
+	0x46, 0x46, 0xcc, 0x4b, 0x04, 0xe0, 0x83, 0xc7, 0x07, 0xed, 0xef, 0x0e, 0x2c, 0x43, 0x07, 0x84,
+	0xfa, 0x8e, 0x3e, 0x86, 0x52, 0x1c, 0xd0, 0x3d, 0xc3, 0xc7, 0x56, 0xaa, 0x0a, 0xcf, 0x5f, 0x38,
+	0xa6, 0xd6, 0xaf, 0x5d, 0xfb, 0xe5, 0xa2, 0x66, 0xbc, 0x5a, 0xd4, 0x8c, 0xbf, 0x16, 0x35, 0xe3,
+	0x97, 0xcb, 0xda, 0xd6, 0xab, 0xcb, 0xda, 0xd6, 0x9f, 0x97, 0xb5, 0xad, 0xbe, 0xa9, 0xfe, 0xf3,
+	0x3d, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff, 0x34, 0xa1, 0x97, 0x23, 0x48, 0x0e, 0x00, 0x00,
+}
+
+func (m *Escrow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -690,7 +1286,7 @@ func (m *UpdatePartiesMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdatePartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Escrow) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -699,205 +1295,2867 @@ func (m *UpdatePartiesMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		n1, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
-	}
-	if len(m.EscrowId) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
-		i += copy(dAtA[i:], m.EscrowId)
+		i += n1
 	}
 	if len(m.Source) > 0 {
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
 		i += copy(dAtA[i:], m.Source)
 	}
 	if len(m.Arbiter) > 0 {
-		dAtA[i] = 0x22
+		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Arbiter)))
 		i += copy(dAtA[i:], m.Arbiter)
 	}
 	if len(m.Destination) > 0 {
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
 		i += copy(dAtA[i:], m.Destination)
 	}
-	return i, nil
-}
-
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.Timeout != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Timeout))
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *Escrow) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Milestones) > 0 {
+		for _, msg := range m.Milestones {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Arbiter)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Arbiters) > 0 {
+		for _, b := range m.Arbiters {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if m.ArbiterThreshold != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ArbiterThreshold))
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovCodec(uint64(m.Timeout))
+	if len(m.ReleaseApprovals) > 0 {
+		for _, b := range m.ReleaseApprovals {
+			dAtA[i] = 0x5a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.ReturnApprovals) > 0 {
+		for _, b := range m.ReturnApprovals {
+			dAtA[i] = 0x62
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.ReturnTaskID) > 0 {
+		dAtA[i] = 0x6a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ReturnTaskID)))
+		i += copy(dAtA[i:], m.ReturnTaskID)
 	}
-	return n
+	if m.ArbiterFee != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ArbiterFee.Size()))
+		n2, err := m.ArbiterFee.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if m.ArbiterFeePaid {
+		dAtA[i] = 0x78
+		i++
+		if m.ArbiterFeePaid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Disputed {
+		dAtA[i] = 0x80
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.Disputed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
 }
 
-func (m *CreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NonFungibleToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NonFungibleToken) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Kind) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Kind)))
+		i += copy(dAtA[i:], m.Kind)
 	}
-	l = len(m.Arbiter)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.ID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ID)))
+		i += copy(dAtA[i:], m.ID)
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	return i, nil
+}
+
+func (m *ArbiterFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *ArbiterFee) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Flat != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Flat.Size()))
+		n3, err := m.Flat.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n3
 	}
-	if m.Timeout != 0 {
-		n += 1 + sovCodec(uint64(m.Timeout))
-	}
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if m.BasisPoints != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.BasisPoints))
 	}
-	return n
+	return i, nil
 }
 
-func (m *ReleaseMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Milestone) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Milestone) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.EscrowId)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Description) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
 	}
 	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+		for _, msg := range m.Amount {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	if m.Released {
+		dAtA[i] = 0x18
+		i++
+		if m.Released {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
 }
 
-func (m *ReturnMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
-	l = len(m.EscrowId)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
 	}
-	return n
-}
-
-func (m *UpdatePartiesMsg) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Arbiter) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Arbiter)))
+		i += copy(dAtA[i:], m.Arbiter)
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
 	}
-	l = len(m.EscrowId)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Amount) > 0 {
+		for _, msg := range m.Amount {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if m.Timeout != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Timeout))
 	}
-	l = len(m.Arbiter)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Memo) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Memo)))
+		i += copy(dAtA[i:], m.Memo)
 	}
-	l = len(m.Destination)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Milestones) > 0 {
+		for _, msg := range m.Milestones {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Arbiters) > 0 {
+		for _, b := range m.Arbiters {
+			dAtA[i] = 0x4a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(len(b)))
+			i += copy(dAtA[i:], b)
+		}
+	}
+	if m.ArbiterThreshold != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ArbiterThreshold))
+	}
+	if m.ArbiterFee != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ArbiterFee.Size()))
+		n5, err := m.ArbiterFee.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.Tokens) > 0 {
+		for _, msg := range m.Tokens {
+			dAtA[i] = 0x62
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ReleaseMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.EscrowId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
+		i += copy(dAtA[i:], m.EscrowId)
+	}
+	if len(m.Amount) > 0 {
+		for _, msg := range m.Amount {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *ReleaseMilestoneMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseMilestoneMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.EscrowId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
+		i += copy(dAtA[i:], m.EscrowId)
+	}
+	if m.Milestone != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Milestone))
+	}
+	return i, nil
+}
+
+func (m *ApproveMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApproveMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n8, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.EscrowId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
+		i += copy(dAtA[i:], m.EscrowId)
+	}
+	if len(m.Arbiter) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Arbiter)))
+		i += copy(dAtA[i:], m.Arbiter)
+	}
+	if m.Action != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Action))
+	}
+	return i, nil
+}
+
+func (m *ReturnMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReturnMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if len(m.EscrowId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
+		i += copy(dAtA[i:], m.EscrowId)
+	}
+	return i, nil
+}
+
+func (m *UpdatePartiesMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdatePartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n10, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.EscrowId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
+		i += copy(dAtA[i:], m.EscrowId)
+	}
+	if len(m.Source) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Source)))
+		i += copy(dAtA[i:], m.Source)
+	}
+	if len(m.Arbiter) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Arbiter)))
+		i += copy(dAtA[i:], m.Arbiter)
+	}
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
+	}
+	return i, nil
+}
+
+func (m *UpdateEscrowTimeoutMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateEscrowTimeoutMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n11, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	if len(m.EscrowId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
+		i += copy(dAtA[i:], m.EscrowId)
+	}
+	if m.Timeout != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Timeout))
+	}
+	return i, nil
+}
+
+func (m *RaiseDisputeMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RaiseDisputeMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n12, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n12
+	}
+	if len(m.EscrowId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
+		i += copy(dAtA[i:], m.EscrowId)
+	}
+	return i, nil
+}
+
+func (m *ResolveDisputeMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolveDisputeMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n13, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	if len(m.EscrowId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
+		i += copy(dAtA[i:], m.EscrowId)
+	}
+	if len(m.SourceAmount) > 0 {
+		for _, msg := range m.SourceAmount {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.DestinationAmount) > 0 {
+		for _, msg := range m.DestinationAmount {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ReleaseTokens {
+		dAtA[i] = 0x28
+		i++
+		if m.ReleaseTokens {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *UpdateEscrowPartiesMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateEscrowPartiesMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n14, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if len(m.EscrowId) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.EscrowId)))
+		i += copy(dAtA[i:], m.EscrowId)
+	}
+	if len(m.Arbiter) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Arbiter)))
+		i += copy(dAtA[i:], m.Arbiter)
+	}
+	if len(m.Destination) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Destination)))
+		i += copy(dAtA[i:], m.Destination)
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Escrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovCodec(uint64(m.Timeout))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Arbiters) > 0 {
+		for _, b := range m.Arbiters {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ArbiterThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.ArbiterThreshold))
+	}
+	if len(m.ReleaseApprovals) > 0 {
+		for _, b := range m.ReleaseApprovals {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.ReturnApprovals) > 0 {
+		for _, b := range m.ReturnApprovals {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	l = len(m.ReturnTaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ArbiterFee != nil {
+		l = m.ArbiterFee.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ArbiterFeePaid {
+		n += 2
+	}
+	if m.Disputed {
+		n += 3
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 2 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NonFungibleToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ArbiterFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Flat != nil {
+		l = m.Flat.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.BasisPoints != 0 {
+		n += 1 + sovCodec(uint64(m.BasisPoints))
+	}
+	return n
+}
+
+func (m *Milestone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Released {
+		n += 2
+	}
+	return n
+}
+
+func (m *CreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovCodec(uint64(m.Timeout))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Milestones) > 0 {
+		for _, e := range m.Milestones {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.Arbiters) > 0 {
+		for _, b := range m.Arbiters {
+			l = len(b)
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ArbiterThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.ArbiterThreshold))
+	}
+	if m.ArbiterFee != nil {
+		l = m.ArbiterFee.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReleaseMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReleaseMilestoneMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Milestone != 0 {
+		n += 1 + sovCodec(uint64(m.Milestone))
+	}
+	return n
+}
+
+func (m *ApproveMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovCodec(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *ReturnMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdatePartiesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateEscrowTimeoutMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovCodec(uint64(m.Timeout))
+	}
+	return n
+}
+
+func (m *RaiseDisputeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ResolveDisputeMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.SourceAmount) > 0 {
+		for _, e := range m.SourceAmount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if len(m.DestinationAmount) > 0 {
+		for _, e := range m.DestinationAmount {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ReleaseTokens {
+		n += 2
+	}
+	return n
+}
+
+func (m *UpdateEscrowPartiesMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.EscrowId)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Escrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Escrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Escrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiter = append(m.Arbiter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Arbiter == nil {
+				m.Arbiter = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, &Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiters", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiters = append(m.Arbiters, make([]byte, postIndex-iNdEx))
+			copy(m.Arbiters[len(m.Arbiters)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterThreshold", wireType)
+			}
+			m.ArbiterThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArbiterThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseApprovals", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseApprovals = append(m.ReleaseApprovals, make([]byte, postIndex-iNdEx))
+			copy(m.ReleaseApprovals[len(m.ReleaseApprovals)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnApprovals", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnApprovals = append(m.ReturnApprovals, make([]byte, postIndex-iNdEx))
+			copy(m.ReturnApprovals[len(m.ReturnApprovals)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnTaskID = append(m.ReturnTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ReturnTaskID == nil {
+				m.ReturnTaskID = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArbiterFee == nil {
+				m.ArbiterFee = &ArbiterFee{}
+			}
+			if err := m.ArbiterFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterFeePaid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ArbiterFeePaid = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disputed = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, NonFungibleToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NonFungibleToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NonFungibleToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NonFungibleToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ID == nil {
+				m.ID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArbiterFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArbiterFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArbiterFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Flat == nil {
+				m.Flat = &coin.Coin{}
+			}
+			if err := m.Flat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Milestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Milestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Milestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, &coin.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Released = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiter = append(m.Arbiter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Arbiter == nil {
+				m.Arbiter = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, &coin.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestones", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Milestones = append(m.Milestones, &Milestone{})
+			if err := m.Milestones[len(m.Milestones)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiters", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiters = append(m.Arbiters, make([]byte, postIndex-iNdEx))
+			copy(m.Arbiters[len(m.Arbiters)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterThreshold", wireType)
+			}
+			m.ArbiterThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ArbiterThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbiterFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ArbiterFee == nil {
+				m.ArbiterFee = &ArbiterFee{}
+			}
+			if err := m.ArbiterFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, NonFungibleToken{})
+			if err := m.Tokens[len(m.Tokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowId = append(m.EscrowId[:0], dAtA[iNdEx:postIndex]...)
+			if m.EscrowId == nil {
+				m.EscrowId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, &coin.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseMilestoneMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseMilestoneMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseMilestoneMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowId = append(m.EscrowId[:0], dAtA[iNdEx:postIndex]...)
+			if m.EscrowId == nil {
+				m.EscrowId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestone", wireType)
+			}
+			m.Milestone = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Milestone |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Escrow) Unmarshal(dAtA []byte) error {
+func (m *ApproveMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -920,10 +4178,10 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Escrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApproveMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Escrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApproveMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -964,7 +4222,7 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -991,9 +4249,9 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
-			if m.Source == nil {
-				m.Source = []byte{}
+			m.EscrowId = append(m.EscrowId[:0], dAtA[iNdEx:postIndex]...)
+			if m.EscrowId == nil {
+				m.EscrowId = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
@@ -1031,10 +4289,10 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var byteLen int
+			m.Action = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1044,50 +4302,69 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Action |= Action(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthCodec
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
-			if m.Destination == nil {
-				m.Destination = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReturnMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Timeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReturnMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReturnMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1097,27 +4374,31 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1144,9 +4425,9 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.EscrowId = append(m.EscrowId[:0], dAtA[iNdEx:postIndex]...)
+			if m.EscrowId == nil {
+				m.EscrowId = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -1173,7 +4454,7 @@ func (m *Escrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateMsg) Unmarshal(dAtA []byte) error {
+func (m *UpdatePartiesMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1196,10 +4477,10 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdatePartiesMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdatePartiesMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1240,7 +4521,7 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1267,48 +4548,14 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
-			if m.Source == nil {
-				m.Source = []byte{}
+			m.EscrowId = append(m.EscrowId[:0], dAtA[iNdEx:postIndex]...)
+			if m.EscrowId == nil {
+				m.EscrowId = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Arbiter = append(m.Arbiter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Arbiter == nil {
-				m.Arbiter = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1333,52 +4580,18 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
-			if m.Destination == nil {
-				m.Destination = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, &coin.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
+			if m.Source == nil {
+				m.Source = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
 			}
-			m.Timeout = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1388,16 +4601,31 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiter = append(m.Arbiter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Arbiter == nil {
+				m.Arbiter = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1407,23 +4635,25 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = string(dAtA[iNdEx:postIndex])
+			m.Destination = append(m.Destination[:0], dAtA[iNdEx:postIndex]...)
+			if m.Destination == nil {
+				m.Destination = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1449,7 +4679,7 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReleaseMsg) Unmarshal(dAtA []byte) error {
+func (m *UpdateEscrowTimeoutMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1472,10 +4702,10 @@ func (m *ReleaseMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReleaseMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateEscrowTimeoutMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReleaseMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateEscrowTimeoutMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1549,10 +4779,10 @@ func (m *ReleaseMsg) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var msglen int
+			m.Timeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1562,26 +4792,11 @@ func (m *ReleaseMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Timeout |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, &coin.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1606,7 +4821,7 @@ func (m *ReleaseMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReturnMsg) Unmarshal(dAtA []byte) error {
+func (m *RaiseDisputeMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1629,10 +4844,10 @@ func (m *ReturnMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReturnMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: RaiseDisputeMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReturnMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RaiseDisputeMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1729,7 +4944,7 @@ func (m *ReturnMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdatePartiesMsg) Unmarshal(dAtA []byte) error {
+func (m *ResolveDisputeMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1752,10 +4967,10 @@ func (m *UpdatePartiesMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdatePartiesMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResolveDisputeMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdatePartiesMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResolveDisputeMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1830,7 +5045,184 @@ func (m *UpdatePartiesMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceAmount = append(m.SourceAmount, &coin.Coin{})
+			if err := m.SourceAmount[len(m.SourceAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationAmount = append(m.DestinationAmount, &coin.Coin{})
+			if err := m.DestinationAmount[len(m.DestinationAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTokens", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReleaseTokens = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateEscrowPartiesMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateEscrowPartiesMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateEscrowPartiesMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1857,12 +5249,12 @@ func (m *UpdatePartiesMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = append(m.Source[:0], dAtA[iNdEx:postIndex]...)
-			if m.Source == nil {
-				m.Source = []byte{}
+			m.EscrowId = append(m.EscrowId[:0], dAtA[iNdEx:postIndex]...)
+			if m.EscrowId == nil {
+				m.EscrowId = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
 			}
@@ -1896,7 +5288,7 @@ func (m *UpdatePartiesMsg) Unmarshal(dAtA []byte) error {
 				m.Arbiter = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
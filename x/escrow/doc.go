@@ -11,7 +11,38 @@ Package escrow implements an Escrow.
 Escrow holds funds.
 The arbiter or source (sender) can release them to the recipient.
 The recipient (destination) can return them to the sender (source).
-Upon timeout, they will be returned to the sender (source).
+Upon timeout, they will be returned to the sender (source). If the handlers
+are registered with a scheduler, the return is executed automatically by the
+cron and no ReturnMsg must be submitted.
+Before the timeout, the sender (source) and the recipient (destination) can
+together extend it. They can also together replace the arbiter or the
+recipient (destination) without the signature of the arbiter, for example
+when the arbiter lost access to its key.
+
+An escrow can be split into milestones. Each milestone is released to the
+recipient separately, and only by the arbiter. Funds of milestones that were
+not released before the timeout are returned to the sender (source).
+
+Instead of a single arbiter, an escrow can have a set of arbiters together
+with an approval threshold. Each arbiter approves either the release or the
+return of the escrow and the approvals are stored on chain. Once the number
+of approvals of an action reaches the threshold, the action is executed.
+
+An escrow with a single arbiter can declare an arbiter fee. The fee is either
+a flat amount, paid once with the first release or return, or a percentage
+of every released or returned amount. It is paid to the arbiter out of the
+escrow coins.
+
+Before the timeout, the sender (source) or the recipient (destination) can
+raise a dispute. A disputed escrow is not returned at timeout and cannot be
+released. It can only be settled by the arbiter, who splits the escrow coins
+between the sender (source) and the recipient (destination).
+
+Escrow can hold non fungible tokens, for example usernames, in addition to
+coins. The ownership of tokens is transferred to the escrow when it is
+created and to the recipient (destination) or the sender (source) together
+with the last coins. Token kinds are supported by the TokenMover passed to
+RegisterRoutes.
 
 
 */
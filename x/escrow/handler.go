@@ -10,52 +10,65 @@ import (
 	"github.com/iov-one/weave/x/cash"
 )
 
-const (
-	// pay escrow cost up-front
-	createEscrowCost  int64 = 300
-	returnEscrowCost  int64 = 0
-	releaseEscrowCost int64 = 0
-	updateEscrowCost  int64 = 50
-)
+// TokenMover moves the ownership of non fungible tokens. It allows escrows
+// to hold tokens, for example usernames, in addition to coins.
+type TokenMover interface {
+	// MoveToken changes the owner of the token of given kind and ID from
+	// the source to the destination address. It must fail if the token is
+	// not owned by the source or if the kind is not supported.
+	MoveToken(db weave.KVStore, kind string, id []byte, src, dest weave.Address) error
+}
 
 // RegisterRoutes will instantiate and register
-// all handlers in this package
-func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller) {
+// all handlers in this package.
+// If scheduler is not nil, each escrow is returned to the source
+// automatically by the cron at timeout. If tokens is nil, escrows can hold
+// coins only.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, cashctrl cash.Controller, scheduler weave.Scheduler, tokens TokenMover) {
 	r = migration.SchemaMigratingRegistry("escrow", r)
 	bucket := NewBucket()
 
-	r.Handle(&CreateMsg{}, CreateEscrowHandler{auth, bucket, cashctrl})
-	r.Handle(&ReleaseMsg{}, ReleaseEscrowHandler{auth, bucket, cashctrl})
-	r.Handle(&ReturnMsg{}, ReturnEscrowHandler{auth, bucket, cashctrl})
+	r.Handle(&CreateMsg{}, CreateEscrowHandler{auth, bucket, cashctrl, scheduler, tokens})
+	r.Handle(&ReleaseMsg{}, ReleaseEscrowHandler{auth, bucket, cashctrl, scheduler, tokens})
+	r.Handle(&ReleaseMilestoneMsg{}, ReleaseMilestoneHandler{auth, bucket, cashctrl, scheduler})
+	r.Handle(&ApproveMsg{}, ApproveEscrowHandler{auth, bucket, cashctrl, scheduler, tokens})
+	r.Handle(&ReturnMsg{}, ReturnEscrowHandler{auth, bucket, cashctrl, scheduler, tokens})
 	r.Handle(&UpdatePartiesMsg{}, UpdateEscrowHandler{auth, bucket})
+	r.Handle(&UpdateEscrowTimeoutMsg{}, UpdateEscrowTimeoutHandler{auth, bucket, scheduler})
+	r.Handle(&RaiseDisputeMsg{}, RaiseDisputeHandler{auth, bucket, scheduler})
+	r.Handle(&ResolveDisputeMsg{}, ResolveDisputeHandler{auth, bucket, cashctrl, scheduler, tokens})
+	r.Handle(&UpdateEscrowPartiesMsg{}, UpdateEscrowPartiesHandler{auth, bucket})
 }
 
-// RegisterQuery will register this bucket as "/escrows"
+// RegisterQuery will register this bucket as "/escrows". Escrows of a party
+// can be listed page by page using "/escrows/source/page",
+// "/escrows/destination/page" and "/escrows/arbiter/page".
 func RegisterQuery(qr weave.QueryRouter) {
 	NewBucket().Register("escrows", qr)
+	qr.Register("/escrows/source/page", NewSourceEscrowsQuery())
+	qr.Register("/escrows/destination/page", NewDestinationEscrowsQuery())
+	qr.Register("/escrows/arbiter/page", NewArbiterEscrowsQuery())
 }
 
 // CreateEscrowHandler will set a name for objects in this bucket
 type CreateEscrowHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.CoinMover
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.CoinMover
+	scheduler weave.Scheduler
+	tokens    TokenMover
 }
 
 var _ weave.Handler = CreateEscrowHandler{}
 
-// Check just verifies it is properly formed and returns
-// the cost of executing it.
+// Check just verifies it is properly formed.
 func (h CreateEscrowHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
 	_, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	res := &weave.CheckResult{
-		GasAllocated: createEscrowCost,
-	}
-	return res, nil
+	return &weave.CheckResult{}, nil
 }
 
 // Deliver moves the tokens from source to the escrow account if all
@@ -86,13 +99,26 @@ func (h CreateEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx wea
 		Timeout:     msg.Timeout,
 		Memo:        msg.Memo,
 		Address:     Condition(key).Address(),
+		Milestones:  msg.Milestones,
+
+		Arbiters:         msg.Arbiters,
+		ArbiterThreshold: msg.ArbiterThreshold,
+		ArbiterFee:       msg.ArbiterFee,
+		Tokens:           msg.Tokens,
+	}
+	escrow.ReturnTaskID, err = scheduleReturn(db, h.scheduler, key, escrow.Timeout)
+	if err != nil {
+		return nil, err
 	}
 	if _, err := h.bucket.Put(db, key, escrow); err != nil {
 		return nil, errors.Wrap(err, "cannot store escrow")
 	}
 
 	// Deposit to the escrow account.
-	if err := cash.MoveCoins(db, h.bank, escrow.Source, escrow.Address, msg.Amount); err != nil {
+	if err := cash.MoveCoins(ctx, db, h.bank, escrow.Source, escrow.Address, msg.Amount); err != nil {
+		return nil, err
+	}
+	if err := moveTokens(db, h.tokens, escrow.Tokens, escrow.Source, escrow.Address); err != nil {
 		return nil, err
 	}
 	return &weave.DeliverResult{Data: key}, nil
@@ -116,27 +142,32 @@ func (h CreateEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx we
 		}
 	}
 
+	if len(msg.Tokens) != 0 && h.tokens == nil {
+		return nil, errors.Wrap(errors.ErrInput, "non fungible tokens are not supported")
+	}
+
 	return &msg, nil
 }
 
 // ReleaseEscrowHandler will set a name for objects in this bucket.
 type ReleaseEscrowHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
+	tokens    TokenMover
 }
 
 var _ weave.Handler = ReleaseEscrowHandler{}
 
-// Check just verifies it is properly formed and returns
-// the cost of executing it
+// Check just verifies it is properly formed.
 func (h ReleaseEscrowHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
 	_, _, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &weave.CheckResult{GasAllocated: releaseEscrowCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 // Deliver moves the tokens from escrow account to the receiver if
@@ -151,26 +182,36 @@ func (h ReleaseEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx we
 	request := coin.Coins(msg.Amount)
 	if len(request) == 0 {
 		available, err := h.bank.Balance(db, escrow.Address)
-		if err != nil {
+		if err != nil && !errors.ErrNotFound.Is(err) {
 			return nil, err
 		}
 		request = available
 	}
 
 	// withdraw the money from escrow to recipient
-	if err := cash.MoveCoins(db, h.bank, escrow.Address, escrow.Destination, request); err != nil {
+	if err := settle(ctx, db, h.bank, escrow, escrow.Destination, request); err != nil {
 		return nil, err
 	}
 
 	remainingCoins, err := h.bank.Balance(db, escrow.Address)
-	if err != nil {
+	if err != nil && !errors.ErrNotFound.Is(err) {
 		return nil, err
 	}
 	if remainingCoins.IsPositive() {
+		if escrow.ArbiterFee != nil {
+			// Keep track of the paid flat fee.
+			if _, err := h.bucket.Put(db, msg.EscrowId, escrow); err != nil {
+				return nil, errors.Wrap(err, "cannot save")
+			}
+		}
 		return &weave.DeliverResult{Data: msg.EscrowId}, nil
 	}
+	// Tokens are released together with the last coins.
+	if err := moveTokens(db, h.tokens, escrow.Tokens, escrow.Address, escrow.Destination); err != nil {
+		return nil, err
+	}
 	// Delete escrow when empty.
-	if err := h.bucket.Delete(db, msg.EscrowId); err != nil {
+	if err := deleteEscrow(db, h.bucket, h.scheduler, msg.EscrowId, escrow); err != nil {
 		return nil, err
 	}
 	return &weave.DeliverResult{}, nil
@@ -186,6 +227,9 @@ func (h ReleaseEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx w
 	if err := h.bucket.One(db, msg.EscrowId, &escrow); err != nil {
 		return nil, nil, errors.Wrap(err, "cannot load escrow from the store")
 	}
+	if escrow.Disputed {
+		return nil, nil, errors.Wrap(errors.ErrState, "escrow is disputed")
+	}
 
 	// Arbiter or source must authorize this.
 	if !h.auth.HasAddress(ctx, escrow.Arbiter) && !h.auth.HasAddress(ctx, escrow.Source) {
@@ -197,27 +241,246 @@ func (h ReleaseEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx w
 		return nil, nil, err
 	}
 
+	// Escrow split into milestones can be released only one milestone
+	// at a time.
+	if len(escrow.Milestones) != 0 {
+		return nil, nil, errors.Wrap(errors.ErrState, "escrow with milestones must be released using milestone release")
+	}
+
+	return &msg, &escrow, nil
+}
+
+// ReleaseMilestoneHandler will release a single milestone of an escrow.
+type ReleaseMilestoneHandler struct {
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = ReleaseMilestoneHandler{}
+
+// Check just verifies it is properly formed.
+func (h ReleaseMilestoneHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver moves the milestone amount from the escrow account to the
+// receiver and marks the milestone as released. When all milestones are
+// released and the escrow account is empty, the escrow is deleted.
+func (h ReleaseMilestoneHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, escrow, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	milestone := escrow.Milestones[msg.Milestone]
+	if err := cash.MoveCoins(ctx, db, h.bank, escrow.Address, escrow.Destination, milestone.Amount); err != nil {
+		return nil, err
+	}
+	milestone.Released = true
+
+	if allReleased(escrow.Milestones) {
+		remainingCoins, err := h.bank.Balance(db, escrow.Address)
+		if err != nil && !errors.ErrNotFound.Is(err) {
+			return nil, err
+		}
+		// Coins sent to the escrow address apart from the milestones
+		// are kept until the timeout, so that they can be returned to
+		// the source.
+		if !remainingCoins.IsPositive() {
+			// Delete escrow when empty.
+			if err := deleteEscrow(db, h.bucket, h.scheduler, msg.EscrowId, escrow); err != nil {
+				return nil, err
+			}
+			return &weave.DeliverResult{}, nil
+		}
+	}
+	if _, err := h.bucket.Put(db, msg.EscrowId, escrow); err != nil {
+		return nil, errors.Wrap(err, "cannot save")
+	}
+	return &weave.DeliverResult{Data: msg.EscrowId}, nil
+}
+
+func allReleased(milestones []*Milestone) bool {
+	for _, m := range milestones {
+		if !m.Released {
+			return false
+		}
+	}
+	return true
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h ReleaseMilestoneHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ReleaseMilestoneMsg, *Escrow, error) {
+	var msg ReleaseMilestoneMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var escrow Escrow
+	if err := h.bucket.One(db, msg.EscrowId, &escrow); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load escrow from the store")
+	}
+	if escrow.Disputed {
+		return nil, nil, errors.Wrap(errors.ErrState, "escrow is disputed")
+	}
+
+	// Only the arbiter can decide that a milestone was reached.
+	if !h.auth.HasAddress(ctx, escrow.Arbiter) {
+		return nil, nil, errors.ErrUnauthorized
+	}
+
+	if weave.IsExpired(ctx, escrow.Timeout) {
+		err := errors.Wrapf(errors.ErrExpired, "escrow expired %v", escrow.Timeout)
+		return nil, nil, err
+	}
+
+	if int(msg.Milestone) >= len(escrow.Milestones) {
+		return nil, nil, errors.Wrapf(errors.ErrNotFound, "milestone %d", msg.Milestone)
+	}
+	if escrow.Milestones[msg.Milestone].Released {
+		return nil, nil, errors.Wrapf(errors.ErrState, "milestone %d already released", msg.Milestone)
+	}
+
+	return &msg, &escrow, nil
+}
+
+// ApproveEscrowHandler will record an approval of one of the escrow
+// arbiters.
+type ApproveEscrowHandler struct {
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
+	tokens    TokenMover
+}
+
+var _ weave.Handler = ApproveEscrowHandler{}
+
+// Check just verifies it is properly formed.
+func (h ApproveEscrowHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver stores the arbiter approval. If the number of approvals for the
+// action reaches the threshold, all coins are moved from the escrow account
+// to the destination (release) or to the source (return) and the escrow is
+// deleted.
+func (h ApproveEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, escrow, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		approvals int
+		dest      weave.Address
+	)
+	switch msg.Action {
+	case Action_Release:
+		escrow.ReleaseApprovals = append(escrow.ReleaseApprovals, msg.Arbiter)
+		approvals = len(escrow.ReleaseApprovals)
+		dest = escrow.Destination
+	case Action_Return:
+		escrow.ReturnApprovals = append(escrow.ReturnApprovals, msg.Arbiter)
+		approvals = len(escrow.ReturnApprovals)
+		dest = escrow.Source
+	}
+
+	if approvals < int(escrow.ArbiterThreshold) {
+		if _, err := h.bucket.Put(db, msg.EscrowId, escrow); err != nil {
+			return nil, errors.Wrap(err, "cannot save")
+		}
+		return &weave.DeliverResult{Data: msg.EscrowId}, nil
+	}
+
+	available, err := h.bank.Balance(db, escrow.Address)
+	if err != nil && !errors.ErrNotFound.Is(err) {
+		return nil, err
+	}
+	if err := cash.MoveCoins(ctx, db, h.bank, escrow.Address, dest, available); err != nil {
+		return nil, err
+	}
+	if err := moveTokens(db, h.tokens, escrow.Tokens, escrow.Address, dest); err != nil {
+		return nil, err
+	}
+	if err := deleteEscrow(db, h.bucket, h.scheduler, msg.EscrowId, escrow); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h ApproveEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ApproveMsg, *Escrow, error) {
+	var msg ApproveMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var escrow Escrow
+	if err := h.bucket.One(db, msg.EscrowId, &escrow); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load escrow from the store")
+	}
+	if escrow.Disputed {
+		return nil, nil, errors.Wrap(errors.ErrState, "escrow is disputed")
+	}
+
+	if len(escrow.Arbiters) == 0 {
+		return nil, nil, errors.Wrap(errors.ErrState, "escrow does not have multiple arbiters")
+	}
+	if !isArbiter(escrow.Arbiters, msg.Arbiter) {
+		return nil, nil, errors.Wrapf(errors.ErrUnauthorized, "%s is not an arbiter", msg.Arbiter)
+	}
+	if !h.auth.HasAddress(ctx, msg.Arbiter) {
+		return nil, nil, errors.ErrUnauthorized
+	}
+
+	if weave.IsExpired(ctx, escrow.Timeout) {
+		err := errors.Wrapf(errors.ErrExpired, "escrow expired %v", escrow.Timeout)
+		return nil, nil, err
+	}
+
+	approvals := escrow.ReleaseApprovals
+	if msg.Action == Action_Return {
+		approvals = escrow.ReturnApprovals
+	}
+	for _, a := range approvals {
+		if a.Equals(msg.Arbiter) {
+			return nil, nil, errors.Wrapf(errors.ErrDuplicate, "%s already approved", msg.Arbiter)
+		}
+	}
+
 	return &msg, &escrow, nil
 }
 
 // ReturnEscrowHandler will set a name for objects in this bucket
 type ReturnEscrowHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
-	bank   cash.Controller
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
+	tokens    TokenMover
 }
 
 var _ weave.Handler = ReturnEscrowHandler{}
 
-// Check just verifies it is properly formed and returns
-// the cost of executing it.
+// Check just verifies it is properly formed.
 func (h ReturnEscrowHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
 	_, _, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &weave.CheckResult{GasAllocated: returnEscrowCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 // Deliver moves all the tokens from the escrow to the defined source if
@@ -229,16 +492,19 @@ func (h ReturnEscrowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx wea
 	}
 
 	available, err := h.bank.Balance(db, escrow.Address)
-	if err != nil {
+	if err != nil && !errors.ErrNotFound.Is(err) {
 		return nil, err
 	}
 
 	// withdraw all coins from escrow to the defined "source"
 	dest := weave.Address(escrow.Source)
-	if err := cash.MoveCoins(db, h.bank, escrow.Address, dest, available); err != nil {
+	if err := settle(ctx, db, h.bank, escrow, dest, available); err != nil {
+		return nil, err
+	}
+	if err := moveTokens(db, h.tokens, escrow.Tokens, escrow.Address, dest); err != nil {
 		return nil, err
 	}
-	if err := h.bucket.Delete(db, key); err != nil {
+	if err := deleteEscrow(db, h.bucket, h.scheduler, key, escrow); err != nil {
 		return nil, err
 	}
 	return &weave.DeliverResult{}, nil
@@ -255,6 +521,9 @@ func (h ReturnEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx we
 	if err := h.bucket.One(db, msg.EscrowId, &escrow); err != nil {
 		return nil, nil, errors.Wrap(err, "cannot load escrow from the store")
 	}
+	if escrow.Disputed {
+		return nil, nil, errors.Wrap(errors.ErrState, "escrow is disputed")
+	}
 
 	if !weave.IsExpired(ctx, escrow.Timeout) {
 		return nil, nil, errors.Wrapf(errors.ErrState, "escrow not expired %v", escrow.Timeout)
@@ -271,15 +540,14 @@ type UpdateEscrowHandler struct {
 
 var _ weave.Handler = UpdateEscrowHandler{}
 
-// Check just verifies it is properly formed and returns
-// the cost of executing it.
+// Check just verifies it is properly formed.
 func (h UpdateEscrowHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
 	_, _, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &weave.CheckResult{GasAllocated: updateEscrowCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 // Deliver updates the any of the source, recipient or arbiter if
@@ -344,3 +612,370 @@ func (h UpdateEscrowHandler) validate(ctx weave.Context, db weave.KVStore, tx we
 
 	return &msg, &escrow, nil
 }
+
+// UpdateEscrowTimeoutHandler will extend the timeout of an escrow.
+type UpdateEscrowTimeoutHandler struct {
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = UpdateEscrowTimeoutHandler{}
+
+// Check just verifies it is properly formed.
+func (h UpdateEscrowTimeoutHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver sets the new timeout of the escrow if all preconditions are met.
+// No coins are moved.
+func (h UpdateEscrowTimeoutHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, escrow, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deleteReturnTask(db, h.scheduler, escrow); err != nil {
+		return nil, err
+	}
+	escrow.Timeout = msg.Timeout
+	escrow.ReturnTaskID, err = scheduleReturn(db, h.scheduler, msg.EscrowId, escrow.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.bucket.Put(db, msg.EscrowId, escrow); err != nil {
+		return nil, errors.Wrap(err, "cannot save")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h UpdateEscrowTimeoutHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UpdateEscrowTimeoutMsg, *Escrow, error) {
+	var msg UpdateEscrowTimeoutMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var escrow Escrow
+	if err := h.bucket.One(db, msg.EscrowId, &escrow); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load escrow from the store")
+	}
+	if escrow.Disputed {
+		return nil, nil, errors.Wrap(errors.ErrState, "escrow is disputed")
+	}
+
+	if weave.IsExpired(ctx, escrow.Timeout) {
+		return nil, nil, errors.Wrapf(errors.ErrExpired, "escrow expired %v", escrow.Timeout)
+	}
+
+	// Both parties must agree to wait longer.
+	if !h.auth.HasAddress(ctx, escrow.Source) || !h.auth.HasAddress(ctx, escrow.Destination) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "source and destination signatures required")
+	}
+
+	if msg.Timeout <= escrow.Timeout {
+		return nil, nil, errors.Wrapf(errors.ErrInput, "timeout must be after current timeout %v", escrow.Timeout)
+	}
+
+	return &msg, &escrow, nil
+}
+
+// UpdateEscrowPartiesHandler replaces the arbiter or the destination of an
+// escrow with the consent of both the source and the destination.
+type UpdateEscrowPartiesHandler struct {
+	auth   x.Authenticator
+	bucket orm.ModelBucket
+}
+
+var _ weave.Handler = UpdateEscrowPartiesHandler{}
+
+// Check just verifies it is properly formed.
+func (h UpdateEscrowPartiesHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver replaces the arbiter or the destination if all preconditions are
+// met. No coins are moved.
+func (h UpdateEscrowPartiesHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, escrow, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Arbiter != nil {
+		escrow.Arbiter = msg.Arbiter
+	}
+	if msg.Destination != nil {
+		escrow.Destination = msg.Destination
+	}
+	if _, err := h.bucket.Put(db, msg.EscrowId, escrow); err != nil {
+		return nil, errors.Wrap(err, "cannot save")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h UpdateEscrowPartiesHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UpdateEscrowPartiesMsg, *Escrow, error) {
+	var msg UpdateEscrowPartiesMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var escrow Escrow
+	if err := h.bucket.One(db, msg.EscrowId, &escrow); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load escrow from the store")
+	}
+	if msg.Arbiter != nil && len(escrow.Arbiters) != 0 {
+		return nil, nil, errors.Wrap(errors.ErrState, "arbiter of an escrow with multiple arbiters cannot be replaced")
+	}
+
+	// Disputed escrow is not returned at timeout and its arbiter must be
+	// replaceable until the dispute is resolved.
+	if !escrow.Disputed && weave.IsExpired(ctx, escrow.Timeout) {
+		return nil, nil, errors.Wrapf(errors.ErrExpired, "escrow expired %v", escrow.Timeout)
+	}
+
+	// The arbiter is not required to sign, so that it can be replaced
+	// even if it is no longer available.
+	if !h.auth.HasAddress(ctx, escrow.Source) || !h.auth.HasAddress(ctx, escrow.Destination) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "source and destination signatures required")
+	}
+
+	return &msg, &escrow, nil
+}
+
+// RaiseDisputeHandler marks an escrow as disputed.
+type RaiseDisputeHandler struct {
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = RaiseDisputeHandler{}
+
+// Check just verifies it is properly formed.
+func (h RaiseDisputeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver marks the escrow as disputed if all preconditions are met. The
+// scheduled return of the escrow is cancelled.
+func (h RaiseDisputeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, escrow, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := deleteReturnTask(db, h.scheduler, escrow); err != nil {
+		return nil, err
+	}
+	escrow.ReturnTaskID = nil
+	escrow.Disputed = true
+	if _, err := h.bucket.Put(db, msg.EscrowId, escrow); err != nil {
+		return nil, errors.Wrap(err, "cannot save")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h RaiseDisputeHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*RaiseDisputeMsg, *Escrow, error) {
+	var msg RaiseDisputeMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var escrow Escrow
+	if err := h.bucket.One(db, msg.EscrowId, &escrow); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load escrow from the store")
+	}
+	if escrow.Disputed {
+		return nil, nil, errors.Wrap(errors.ErrState, "escrow is already disputed")
+	}
+	if len(escrow.Arbiters) != 0 {
+		return nil, nil, errors.Wrap(errors.ErrState, "escrow with multiple arbiters cannot be disputed")
+	}
+
+	if weave.IsExpired(ctx, escrow.Timeout) {
+		return nil, nil, errors.Wrapf(errors.ErrExpired, "escrow expired %v", escrow.Timeout)
+	}
+
+	if !h.auth.HasAddress(ctx, escrow.Source) && !h.auth.HasAddress(ctx, escrow.Destination) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "source or destination signature required")
+	}
+
+	return &msg, &escrow, nil
+}
+
+// ResolveDisputeHandler splits the coins of a disputed escrow between the
+// source and the destination.
+type ResolveDisputeHandler struct {
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	bank      cash.Controller
+	scheduler weave.Scheduler
+	tokens    TokenMover
+}
+
+var _ weave.Handler = ResolveDisputeHandler{}
+
+// Check just verifies it is properly formed.
+func (h ResolveDisputeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	_, _, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &weave.CheckResult{}, nil
+}
+
+// Deliver moves the tokens from the escrow account to the source and the
+// destination as declared by the arbiter. The escrow is deleted afterwards.
+func (h ResolveDisputeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, escrow, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msg.SourceAmount) != 0 {
+		if err := settle(ctx, db, h.bank, escrow, escrow.Source, msg.SourceAmount); err != nil {
+			return nil, errors.Wrap(err, "source")
+		}
+	}
+	if len(msg.DestinationAmount) != 0 {
+		if err := settle(ctx, db, h.bank, escrow, escrow.Destination, msg.DestinationAmount); err != nil {
+			return nil, errors.Wrap(err, "destination")
+		}
+	}
+	tokensReceiver := escrow.Source
+	if msg.ReleaseTokens {
+		tokensReceiver = escrow.Destination
+	}
+	if err := moveTokens(db, h.tokens, escrow.Tokens, escrow.Address, tokensReceiver); err != nil {
+		return nil, err
+	}
+	if err := deleteEscrow(db, h.bucket, h.scheduler, msg.EscrowId, escrow); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+// validate does all common pre-processing between Check and Deliver.
+func (h ResolveDisputeHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ResolveDisputeMsg, *Escrow, error) {
+	var msg ResolveDisputeMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	var escrow Escrow
+	if err := h.bucket.One(db, msg.EscrowId, &escrow); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load escrow from the store")
+	}
+	if !escrow.Disputed {
+		return nil, nil, errors.Wrap(errors.ErrState, "escrow is not disputed")
+	}
+
+	if !h.auth.HasAddress(ctx, escrow.Arbiter) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "arbiter signature required")
+	}
+
+	total, err := coin.Coins(msg.SourceAmount).Combine(msg.DestinationAmount)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "cannot sum amounts")
+	}
+	available, err := h.bank.Balance(db, escrow.Address)
+	if err != nil && !errors.ErrNotFound.Is(err) {
+		return nil, nil, err
+	}
+	if !total.Equals(available) {
+		return nil, nil, errors.Wrapf(errors.ErrAmount, "amounts must sum up to the escrow balance %v", available)
+	}
+
+	return &msg, &escrow, nil
+}
+
+// settle moves given amount from the escrow to the receiver. If the escrow
+// declares an arbiter fee, it is paid to the arbiter first.
+func settle(ctx weave.Context, db weave.KVStore, bank cash.CoinMover, escrow *Escrow, receiver weave.Address, amount coin.Coins) error {
+	fee, rest, err := splitArbiterFee(escrow, amount)
+	if err != nil {
+		return err
+	}
+	if err := cash.MoveCoins(ctx, db, bank, escrow.Address, escrow.Arbiter, fee); err != nil {
+		return errors.Wrap(err, "cannot pay arbiter fee")
+	}
+	if escrow.ArbiterFee != nil && escrow.ArbiterFee.Flat != nil {
+		escrow.ArbiterFeePaid = true
+	}
+	return cash.MoveCoins(ctx, db, bank, escrow.Address, receiver, rest)
+}
+
+// moveTokens transfers the ownership of all given tokens from the source to
+// the destination address.
+func moveTokens(db weave.KVStore, mover TokenMover, tokens []NonFungibleToken, src, dest weave.Address) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	if mover == nil {
+		return errors.Wrap(errors.ErrInput, "non fungible tokens are not supported")
+	}
+	for _, t := range tokens {
+		if err := mover.MoveToken(db, t.Kind, t.ID, src, dest); err != nil {
+			return errors.Wrapf(err, "cannot move %s token %q", t.Kind, t.ID)
+		}
+	}
+	return nil
+}
+
+// scheduleReturn queues a message that returns the escrow to the source once
+// the timeout is reached. It returns the ID of the scheduled task or nil if
+// no scheduler is used.
+func scheduleReturn(db weave.KVStore, scheduler weave.Scheduler, escrowID []byte, timeout weave.UnixTime) ([]byte, error) {
+	if scheduler == nil {
+		return nil, nil
+	}
+	msg := &ReturnMsg{
+		Metadata: &weave.Metadata{Schema: 1},
+		EscrowId: escrowID,
+	}
+	// Returning an expired escrow does not require any signature.
+	taskID, err := scheduler.Schedule(db, timeout.Time(), nil, msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot schedule return task")
+	}
+	return taskID, nil
+}
+
+// deleteReturnTask removes the scheduled return task of given escrow, if
+// there is one. A task that was already executed is ignored.
+func deleteReturnTask(db weave.KVStore, scheduler weave.Scheduler, escrow *Escrow) error {
+	if scheduler == nil || len(escrow.ReturnTaskID) == 0 {
+		return nil
+	}
+	if err := scheduler.Delete(db, escrow.ReturnTaskID); err != nil && !errors.ErrNotFound.Is(err) {
+		return errors.Wrap(err, "cannot delete return task")
+	}
+	return nil
+}
+
+// deleteEscrow removes given escrow together with its scheduled return task.
+func deleteEscrow(db weave.KVStore, bucket orm.ModelBucket, scheduler weave.Scheduler, escrowID []byte, escrow *Escrow) error {
+	if err := deleteReturnTask(db, scheduler, escrow); err != nil {
+		return err
+	}
+	return bucket.Delete(db, escrowID)
+}
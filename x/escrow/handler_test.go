@@ -501,7 +501,7 @@ func TestHandler(t *testing.T) {
 	auth := authenticator()
 	// create handler objects and query objects
 	router := app.NewRouter()
-	RegisterRoutes(router, auth, ctrl)
+	RegisterRoutes(router, auth, ctrl, nil, nil)
 	cash.RegisterRoutes(router, auth, ctrl)
 	qr := weave.NewQueryRouter()
 	cash.RegisterQuery(qr)
@@ -627,3 +627,1106 @@ func mo(obj orm.Object, err error) orm.Object {
 	}
 	return obj
 }
+
+func TestReleaseMilestone(t *testing.T) {
+	source := weavetest.NewCondition()
+	dest := weavetest.NewCondition()
+	arbiter := weavetest.NewCondition()
+
+	escrowID := weavetest.SequenceID(1)
+	afterTimeout := Timeout.Time().Add(time.Second)
+
+	milestones := []*Milestone{
+		{Description: "design", Amount: mustCombineCoins(coin.NewCoin(30, 0, "FOO"))},
+		{Description: "delivery", Amount: mustCombineCoins(coin.NewCoin(70, 0, "FOO"))},
+	}
+	create := NewCreateMsg(source.Address(), dest.Address(), arbiter.Address(),
+		mustCombineCoins(coin.NewCoin(100, 0, "FOO")), Timeout, "")
+	create.Milestones = milestones
+
+	releaseMilestone := func(signer weave.Condition, milestone uint32) action {
+		return action{
+			perms: []weave.Condition{signer},
+			msg: &ReleaseMilestoneMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				EscrowId:  escrowID,
+				Milestone: milestone,
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		steps []action
+		// wantErr is the expected error of the last step. All other steps
+		// must succeed.
+		wantErr      *errors.Error
+		wantSource   coin.Coins
+		wantDest     coin.Coins
+		wantDeleted  bool
+		wantReleased []bool
+	}{
+		"release a single milestone": {
+			steps: []action{
+				releaseMilestone(arbiter, 0),
+			},
+			wantSource:   nil,
+			wantDest:     mustCombineCoins(coin.NewCoin(30, 0, "FOO")),
+			wantReleased: []bool{true, false},
+		},
+		"release all milestones deletes the escrow": {
+			steps: []action{
+				releaseMilestone(arbiter, 1),
+				releaseMilestone(arbiter, 0),
+			},
+			wantDest:    mustCombineCoins(coin.NewCoin(100, 0, "FOO")),
+			wantDeleted: true,
+		},
+		"source cannot release a milestone": {
+			steps: []action{
+				releaseMilestone(source, 0),
+			},
+			wantErr:      errors.ErrUnauthorized,
+			wantReleased: []bool{false, false},
+		},
+		"milestone cannot be released twice": {
+			steps: []action{
+				releaseMilestone(arbiter, 0),
+				releaseMilestone(arbiter, 0),
+			},
+			wantErr:      errors.ErrState,
+			wantDest:     mustCombineCoins(coin.NewCoin(30, 0, "FOO")),
+			wantReleased: []bool{true, false},
+		},
+		"unknown milestone": {
+			steps: []action{
+				releaseMilestone(arbiter, 2),
+			},
+			wantErr:      errors.ErrNotFound,
+			wantReleased: []bool{false, false},
+		},
+		"escrow with milestones cannot be released at once": {
+			steps: []action{
+				{
+					perms: []weave.Condition{arbiter},
+					msg: &ReleaseMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+					},
+				},
+			},
+			wantErr:      errors.ErrState,
+			wantReleased: []bool{false, false},
+		},
+		"milestone cannot be released after timeout": {
+			steps: []action{
+				{
+					perms:     []weave.Condition{arbiter},
+					msg:       releaseMilestone(arbiter, 0).msg,
+					blockTime: afterTimeout,
+				},
+			},
+			wantErr:      errors.ErrExpired,
+			wantReleased: []bool{false, false},
+		},
+		"remaining funds are returned at timeout": {
+			steps: []action{
+				releaseMilestone(arbiter, 0),
+				{
+					msg: &ReturnMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+					},
+					blockTime: afterTimeout,
+				},
+			},
+			wantSource:  mustCombineCoins(coin.NewCoin(70, 0, "FOO")),
+			wantDest:    mustCombineCoins(coin.NewCoin(30, 0, "FOO")),
+			wantDeleted: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "escrow", "cash")
+
+			bank := cash.NewBucket()
+			ctrl := cash.NewController(bank)
+			router := app.NewRouter()
+			RegisterRoutes(router, authenticator(), ctrl, nil, nil)
+
+			wallet, err := cash.WalletWith(source.Address(), coin.NewCoinp(100, 0, "FOO"))
+			assert.Nil(t, err)
+			assert.Nil(t, bank.Save(db, wallet))
+
+			steps := append([]action{{perms: []weave.Condition{source}, msg: create}}, tc.steps...)
+			for i, step := range steps {
+				_, err := router.Deliver(step.ctx(), db, step.tx())
+				if i == len(steps)-1 {
+					if !tc.wantErr.Is(err) {
+						t.Fatalf("unexpected error: %+v", err)
+					}
+				} else if err != nil {
+					t.Fatalf("step %d: %+v", i, err)
+				}
+			}
+
+			assertBalance(t, ctrl, db, source.Address(), tc.wantSource)
+			assertBalance(t, ctrl, db, dest.Address(), tc.wantDest)
+
+			var escrow Escrow
+			err = NewBucket().One(db, escrowID, &escrow)
+			if tc.wantDeleted {
+				if !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want escrow deleted, got %+v", err)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			for i, want := range tc.wantReleased {
+				if got := escrow.Milestones[i].Released; got != want {
+					t.Errorf("want milestone %d released %v, got %v", i, want, got)
+				}
+			}
+		})
+	}
+}
+
+func assertBalance(t testing.TB, ctrl cash.Controller, db weave.KVStore, addr weave.Address, want coin.Coins) {
+	t.Helper()
+	got, err := ctrl.Balance(db, addr)
+	if err != nil && !errors.ErrNotFound.Is(err) {
+		t.Fatalf("cannot get %s balance: %s", addr, err)
+	}
+	if !want.Equals(got) {
+		t.Fatalf("want %v balance of %s, got %v", want, addr, got)
+	}
+}
+
+func TestApproveEscrow(t *testing.T) {
+	source := weavetest.NewCondition()
+	dest := weavetest.NewCondition()
+	arb1 := weavetest.NewCondition()
+	arb2 := weavetest.NewCondition()
+	arb3 := weavetest.NewCondition()
+	stranger := weavetest.NewCondition()
+
+	escrowID := weavetest.SequenceID(1)
+	all := mustCombineCoins(coin.NewCoin(100, 0, "FOO"))
+
+	create := NewCreateMsg(source.Address(), dest.Address(), nil, all, Timeout, "")
+	create.Arbiters = []weave.Address{arb1.Address(), arb2.Address(), arb3.Address()}
+	create.ArbiterThreshold = 2
+
+	approve := func(signer weave.Condition, arbiter weave.Address, a Action) action {
+		return action{
+			perms: []weave.Condition{signer},
+			msg: &ApproveMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: escrowID,
+				Arbiter:  arbiter,
+				Action:   a,
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		steps []action
+		// wantErr is the expected error of the last step. All other steps
+		// must succeed.
+		wantErr     *errors.Error
+		wantSource  coin.Coins
+		wantDest    coin.Coins
+		wantDeleted bool
+	}{
+		"single approval is not enough": {
+			steps: []action{
+				approve(arb1, arb1.Address(), Action_Release),
+			},
+		},
+		"release once threshold is reached": {
+			steps: []action{
+				approve(arb1, arb1.Address(), Action_Release),
+				approve(arb3, arb3.Address(), Action_Release),
+			},
+			wantDest:    all,
+			wantDeleted: true,
+		},
+		"return once threshold is reached": {
+			steps: []action{
+				approve(arb2, arb2.Address(), Action_Return),
+				approve(arb1, arb1.Address(), Action_Release),
+				approve(arb1, arb1.Address(), Action_Return),
+			},
+			wantSource:  all,
+			wantDeleted: true,
+		},
+		"approvals of different actions are not combined": {
+			steps: []action{
+				approve(arb1, arb1.Address(), Action_Release),
+				approve(arb2, arb2.Address(), Action_Return),
+			},
+		},
+		"arbiter cannot approve twice": {
+			steps: []action{
+				approve(arb1, arb1.Address(), Action_Release),
+				approve(arb1, arb1.Address(), Action_Release),
+			},
+			wantErr: errors.ErrDuplicate,
+		},
+		"arbiter signature required": {
+			steps: []action{
+				approve(stranger, arb1.Address(), Action_Release),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"only arbiters can approve": {
+			steps: []action{
+				approve(stranger, stranger.Address(), Action_Release),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"single arbiter cannot release": {
+			steps: []action{
+				{
+					perms: []weave.Condition{arb1},
+					msg: &ReleaseMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+					},
+				},
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "escrow", "cash")
+
+			bank := cash.NewBucket()
+			ctrl := cash.NewController(bank)
+			router := app.NewRouter()
+			RegisterRoutes(router, authenticator(), ctrl, nil, nil)
+
+			wallet, err := cash.WalletWith(source.Address(), all...)
+			assert.Nil(t, err)
+			assert.Nil(t, bank.Save(db, wallet))
+
+			steps := append([]action{{perms: []weave.Condition{source}, msg: create}}, tc.steps...)
+			for i, step := range steps {
+				_, err := router.Deliver(step.ctx(), db, step.tx())
+				if i == len(steps)-1 {
+					if !tc.wantErr.Is(err) {
+						t.Fatalf("unexpected error: %+v", err)
+					}
+				} else if err != nil {
+					t.Fatalf("step %d: %+v", i, err)
+				}
+			}
+
+			assertBalance(t, ctrl, db, source.Address(), tc.wantSource)
+			assertBalance(t, ctrl, db, dest.Address(), tc.wantDest)
+
+			var escrow Escrow
+			err = NewBucket().One(db, escrowID, &escrow)
+			if tc.wantDeleted {
+				if !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want escrow deleted, got %+v", err)
+				}
+				return
+			}
+			assert.Nil(t, err)
+
+			// Escrow must be found using any of the arbiters.
+			for _, arb := range create.Arbiters {
+				var found []Escrow
+				_, err := NewBucket().ByIndex(db, "arbiter", arb, &found)
+				assert.Nil(t, err)
+				assert.Equal(t, 1, len(found))
+			}
+		})
+	}
+}
+
+func TestUpdateEscrowTimeout(t *testing.T) {
+	source := weavetest.NewCondition()
+	dest := weavetest.NewCondition()
+	arbiter := weavetest.NewCondition()
+
+	escrowID := weavetest.SequenceID(1)
+	all := mustCombineCoins(coin.NewCoin(100, 0, "FOO"))
+	extended := Timeout + 3600
+
+	update := func(timeout weave.UnixTime, signers ...weave.Condition) action {
+		return action{
+			perms: signers,
+			msg: &UpdateEscrowTimeoutMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: escrowID,
+				Timeout:  timeout,
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		do          action
+		wantErr     *errors.Error
+		wantTimeout weave.UnixTime
+	}{
+		"source and destination extend the timeout": {
+			do:          update(extended, source, dest),
+			wantTimeout: extended,
+		},
+		"source alone cannot extend the timeout": {
+			do:          update(extended, source),
+			wantErr:     errors.ErrUnauthorized,
+			wantTimeout: Timeout,
+		},
+		"arbiter cannot extend the timeout": {
+			do:          update(extended, source, arbiter),
+			wantErr:     errors.ErrUnauthorized,
+			wantTimeout: Timeout,
+		},
+		"timeout cannot be shortened": {
+			do:          update(Timeout-1, source, dest),
+			wantErr:     errors.ErrInput,
+			wantTimeout: Timeout,
+		},
+		"expired escrow cannot be extended": {
+			do: action{
+				perms:     []weave.Condition{source, dest},
+				msg:       update(extended, source, dest).msg,
+				blockTime: Timeout.Time(),
+			},
+			wantErr:     errors.ErrExpired,
+			wantTimeout: Timeout,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "escrow", "cash")
+
+			bank := cash.NewBucket()
+			router := app.NewRouter()
+			RegisterRoutes(router, authenticator(), cash.NewController(bank), nil, nil)
+
+			wallet, err := cash.WalletWith(source.Address(), all...)
+			assert.Nil(t, err)
+			assert.Nil(t, bank.Save(db, wallet))
+
+			create := createAction(source, dest, arbiter, all, "")
+			_, err = router.Deliver(create.ctx(), db, create.tx())
+			assert.Nil(t, err)
+
+			cache := db.CacheWrap()
+			if _, err := router.Check(tc.do.ctx(), cache, tc.do.tx()); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			cache.Discard()
+			if _, err := router.Deliver(tc.do.ctx(), db, tc.do.tx()); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+
+			var escrow Escrow
+			assert.Nil(t, NewBucket().One(db, escrowID, &escrow))
+			if escrow.Timeout != tc.wantTimeout {
+				t.Fatalf("want timeout %v, got %v", tc.wantTimeout, escrow.Timeout)
+			}
+		})
+	}
+}
+
+func TestAutomaticReturn(t *testing.T) {
+	source := weavetest.NewCondition()
+	dest := weavetest.NewCondition()
+	arbiter := weavetest.NewCondition()
+
+	escrowID := weavetest.SequenceID(1)
+	all := mustCombineCoins(coin.NewCoin(100, 0, "FOO"))
+	extended := Timeout + 3600
+
+	cases := map[string]struct {
+		do          action
+		wantRunAt   weave.UnixTime
+		wantDeleted bool
+		wantSource  coin.Coins
+	}{
+		"escrow is returned at timeout": {
+			wantRunAt: Timeout,
+		},
+		"extending the timeout reschedules the return": {
+			do: action{
+				perms: []weave.Condition{source, dest},
+				msg: &UpdateEscrowTimeoutMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					EscrowId: escrowID,
+					Timeout:  extended,
+				},
+			},
+			wantRunAt: extended,
+		},
+		"released escrow is not returned": {
+			do: action{
+				perms: []weave.Condition{arbiter},
+				msg: &ReleaseMsg{
+					Metadata: &weave.Metadata{Schema: 1},
+					EscrowId: escrowID,
+				},
+			},
+			wantDeleted: true,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "escrow", "cash")
+
+			bank := cash.NewBucket()
+			ctrl := cash.NewController(bank)
+			scheduler := &recordingScheduler{tasks: make(map[string]scheduledTask)}
+			router := app.NewRouter()
+			RegisterRoutes(router, authenticator(), ctrl, scheduler, nil)
+
+			wallet, err := cash.WalletWith(source.Address(), all...)
+			assert.Nil(t, err)
+			assert.Nil(t, bank.Save(db, wallet))
+
+			create := createAction(source, dest, arbiter, all, "")
+			_, err = router.Deliver(create.ctx(), db, create.tx())
+			assert.Nil(t, err)
+
+			if tc.do.msg != nil {
+				_, err := router.Deliver(tc.do.ctx(), db, tc.do.tx())
+				assert.Nil(t, err)
+			}
+
+			if tc.wantDeleted {
+				if len(scheduler.tasks) != 0 {
+					t.Fatalf("want no scheduled task, got %d", len(scheduler.tasks))
+				}
+				return
+			}
+			if len(scheduler.tasks) != 1 {
+				t.Fatalf("want one scheduled task, got %d", len(scheduler.tasks))
+			}
+
+			var escrow Escrow
+			assert.Nil(t, NewBucket().One(db, escrowID, &escrow))
+			task, ok := scheduler.tasks[string(escrow.ReturnTaskID)]
+			if !ok {
+				t.Fatalf("escrow return task %q not scheduled", escrow.ReturnTaskID)
+			}
+			if !task.runAt.Equal(tc.wantRunAt.Time()) {
+				t.Fatalf("want task to run at %v, got %v", tc.wantRunAt.Time(), task.runAt)
+			}
+
+			// Execute the task the same way the cron would.
+			run := action{msg: task.msg, blockTime: task.runAt}
+			_, err = router.Deliver(run.ctx(), db, run.tx())
+			assert.Nil(t, err)
+
+			assertBalance(t, ctrl, db, source.Address(), all)
+			if err := NewBucket().Has(db, escrowID); !errors.ErrNotFound.Is(err) {
+				t.Fatalf("want escrow to be deleted, got %+v", err)
+			}
+		})
+	}
+}
+
+func TestArbiterFee(t *testing.T) {
+	source := weavetest.NewCondition()
+	dest := weavetest.NewCondition()
+	arbiter := weavetest.NewCondition()
+
+	escrowID := weavetest.SequenceID(1)
+	all := mustCombineCoins(coin.NewCoin(100, 0, "FOO"))
+
+	release := func(amount ...coin.Coin) action {
+		return action{
+			perms: []weave.Condition{arbiter},
+			msg: &ReleaseMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: escrowID,
+				Amount:   mustCombineCoins(amount...),
+			},
+		}
+	}
+	ret := action{
+		msg: &ReturnMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			EscrowId: escrowID,
+		},
+		blockTime: Timeout.Time(),
+	}
+
+	cases := map[string]struct {
+		fee         *ArbiterFee
+		do          []action
+		wantErr     *errors.Error
+		wantSource  coin.Coins
+		wantDest    coin.Coins
+		wantArbiter coin.Coins
+	}{
+		"flat fee is paid on release": {
+			fee:         &ArbiterFee{Flat: coin.NewCoinp(1, 0, "FOO")},
+			do:          []action{release()},
+			wantDest:    mustCombineCoins(coin.NewCoin(99, 0, "FOO")),
+			wantArbiter: mustCombineCoins(coin.NewCoin(1, 0, "FOO")),
+		},
+		"flat fee is paid only once": {
+			fee: &ArbiterFee{Flat: coin.NewCoinp(1, 0, "FOO")},
+			do: []action{
+				release(coin.NewCoin(10, 0, "FOO")),
+				release(),
+			},
+			wantDest:    mustCombineCoins(coin.NewCoin(99, 0, "FOO")),
+			wantArbiter: mustCombineCoins(coin.NewCoin(1, 0, "FOO")),
+		},
+		"flat fee is paid on return": {
+			fee:         &ArbiterFee{Flat: coin.NewCoinp(1, 0, "FOO")},
+			do:          []action{ret},
+			wantSource:  mustCombineCoins(coin.NewCoin(99, 0, "FOO")),
+			wantArbiter: mustCombineCoins(coin.NewCoin(1, 0, "FOO")),
+		},
+		"percentage fee is paid on each release": {
+			fee: &ArbiterFee{BasisPoints: 250},
+			do: []action{
+				release(coin.NewCoin(40, 0, "FOO")),
+				release(),
+			},
+			wantDest:    mustCombineCoins(coin.NewCoin(97, 500000000, "FOO")),
+			wantArbiter: mustCombineCoins(coin.NewCoin(2, 500000000, "FOO")),
+		},
+		"percentage fee is paid on return": {
+			fee:         &ArbiterFee{BasisPoints: 250},
+			do:          []action{ret},
+			wantSource:  mustCombineCoins(coin.NewCoin(97, 500000000, "FOO")),
+			wantArbiter: mustCombineCoins(coin.NewCoin(2, 500000000, "FOO")),
+		},
+		"release must cover the flat fee": {
+			fee:     &ArbiterFee{Flat: coin.NewCoinp(5, 0, "FOO")},
+			do:      []action{release(coin.NewCoin(1, 0, "FOO"))},
+			wantErr: errors.ErrAmount,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "escrow", "cash")
+
+			bank := cash.NewBucket()
+			ctrl := cash.NewController(bank)
+			router := app.NewRouter()
+			RegisterRoutes(router, authenticator(), ctrl, nil, nil)
+
+			wallet, err := cash.WalletWith(source.Address(), all...)
+			assert.Nil(t, err)
+			assert.Nil(t, bank.Save(db, wallet))
+
+			create := createAction(source, dest, arbiter, all, "")
+			create.msg.(*CreateMsg).ArbiterFee = tc.fee
+			_, err = router.Deliver(create.ctx(), db, create.tx())
+			assert.Nil(t, err)
+
+			for i, a := range tc.do {
+				_, err := router.Deliver(a.ctx(), db, a.tx())
+				if i < len(tc.do)-1 {
+					assert.Nil(t, err)
+				} else if !tc.wantErr.Is(err) {
+					t.Fatalf("unexpected error: %+v", err)
+				}
+			}
+
+			assertBalance(t, ctrl, db, source.Address(), tc.wantSource)
+			assertBalance(t, ctrl, db, dest.Address(), tc.wantDest)
+			assertBalance(t, ctrl, db, arbiter.Address(), tc.wantArbiter)
+		})
+	}
+}
+
+func TestDispute(t *testing.T) {
+	source := weavetest.NewCondition()
+	dest := weavetest.NewCondition()
+	arbiter := weavetest.NewCondition()
+
+	escrowID := weavetest.SequenceID(1)
+	all := mustCombineCoins(coin.NewCoin(100, 0, "FOO"))
+
+	raise := func(signer weave.Condition) action {
+		return action{
+			perms: []weave.Condition{signer},
+			msg: &RaiseDisputeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: escrowID,
+			},
+		}
+	}
+	resolve := func(signer weave.Condition, toSource, toDest int64) action {
+		return action{
+			perms: []weave.Condition{signer},
+			msg: &ResolveDisputeMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				EscrowId:          escrowID,
+				SourceAmount:      mustCombineCoins(coin.NewCoin(toSource, 0, "FOO")),
+				DestinationAmount: mustCombineCoins(coin.NewCoin(toDest, 0, "FOO")),
+			},
+			// Resolution is not limited by the timeout.
+			blockTime: Timeout.Time().Add(time.Hour),
+		}
+	}
+
+	cases := map[string]struct {
+		do           []action
+		wantErr      *errors.Error
+		wantDisputed bool
+		wantSource   coin.Coins
+		wantDest     coin.Coins
+	}{
+		"source raises a dispute": {
+			do:           []action{raise(source)},
+			wantDisputed: true,
+		},
+		"destination raises a dispute": {
+			do:           []action{raise(dest)},
+			wantDisputed: true,
+		},
+		"arbiter cannot raise a dispute": {
+			do:      []action{raise(arbiter)},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"dispute cannot be raised twice": {
+			do:           []action{raise(source), raise(dest)},
+			wantErr:      errors.ErrState,
+			wantDisputed: true,
+		},
+		"expired escrow cannot be disputed": {
+			do: []action{
+				{
+					perms:     []weave.Condition{source},
+					msg:       raise(source).msg,
+					blockTime: Timeout.Time(),
+				},
+			},
+			wantErr: errors.ErrExpired,
+		},
+		"disputed escrow cannot be released": {
+			do: []action{
+				raise(dest),
+				{
+					perms: []weave.Condition{arbiter},
+					msg: &ReleaseMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+					},
+				},
+			},
+			wantErr:      errors.ErrState,
+			wantDisputed: true,
+		},
+		"disputed escrow is not returned at timeout": {
+			do: []action{
+				raise(dest),
+				{
+					msg: &ReturnMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+					},
+					blockTime: Timeout.Time(),
+				},
+			},
+			wantErr:      errors.ErrState,
+			wantDisputed: true,
+		},
+		"arbiter splits the escrow": {
+			do:         []action{raise(dest), resolve(arbiter, 30, 70)},
+			wantSource: mustCombineCoins(coin.NewCoin(30, 0, "FOO")),
+			wantDest:   mustCombineCoins(coin.NewCoin(70, 0, "FOO")),
+		},
+		"only arbiter can resolve a dispute": {
+			do:           []action{raise(dest), resolve(dest, 0, 100)},
+			wantErr:      errors.ErrUnauthorized,
+			wantDisputed: true,
+		},
+		"resolution must split the whole balance": {
+			do:           []action{raise(dest), resolve(arbiter, 30, 60)},
+			wantErr:      errors.ErrAmount,
+			wantDisputed: true,
+		},
+		"undisputed escrow cannot be resolved": {
+			do:      []action{resolve(arbiter, 30, 70)},
+			wantErr: errors.ErrState,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "escrow", "cash")
+
+			bank := cash.NewBucket()
+			ctrl := cash.NewController(bank)
+			scheduler := &recordingScheduler{tasks: make(map[string]scheduledTask)}
+			router := app.NewRouter()
+			RegisterRoutes(router, authenticator(), ctrl, scheduler, nil)
+
+			wallet, err := cash.WalletWith(source.Address(), all...)
+			assert.Nil(t, err)
+			assert.Nil(t, bank.Save(db, wallet))
+
+			create := createAction(source, dest, arbiter, all, "")
+			_, err = router.Deliver(create.ctx(), db, create.tx())
+			assert.Nil(t, err)
+
+			for i, a := range tc.do {
+				_, err := router.Deliver(a.ctx(), db, a.tx())
+				if i < len(tc.do)-1 {
+					assert.Nil(t, err)
+				} else if !tc.wantErr.Is(err) {
+					t.Fatalf("unexpected error: %+v", err)
+				}
+			}
+
+			assertBalance(t, ctrl, db, source.Address(), tc.wantSource)
+			assertBalance(t, ctrl, db, dest.Address(), tc.wantDest)
+
+			var escrow Escrow
+			if err := NewBucket().One(db, escrowID, &escrow); err != nil {
+				if !errors.ErrNotFound.Is(err) {
+					t.Fatalf("cannot load escrow: %s", err)
+				}
+				if tc.wantDisputed {
+					t.Fatal("disputed escrow was deleted")
+				}
+				return
+			}
+			if escrow.Disputed != tc.wantDisputed {
+				t.Fatalf("want disputed %v, got %v", tc.wantDisputed, escrow.Disputed)
+			}
+			if escrow.Disputed && len(scheduler.tasks) != 0 {
+				t.Fatalf("want return of a disputed escrow to be cancelled, got %d tasks", len(scheduler.tasks))
+			}
+		})
+	}
+}
+
+func TestUpdateEscrowParties(t *testing.T) {
+	source := weavetest.NewCondition()
+	dest := weavetest.NewCondition()
+	arbiter := weavetest.NewCondition()
+	newArbiter := weavetest.NewCondition().Address()
+	newDest := weavetest.NewCondition().Address()
+
+	escrowID := weavetest.SequenceID(1)
+	all := mustCombineCoins(coin.NewCoin(100, 0, "FOO"))
+
+	update := func(arbiter, dest weave.Address, signers ...weave.Condition) action {
+		return action{
+			perms: signers,
+			msg: &UpdateEscrowPartiesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				EscrowId:    escrowID,
+				Arbiter:     arbiter,
+				Destination: dest,
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		before      []action
+		do          action
+		wantErr     *errors.Error
+		wantArbiter weave.Address
+		wantDest    weave.Address
+	}{
+		"source and destination replace the arbiter": {
+			do:          update(newArbiter, nil, source, dest),
+			wantArbiter: newArbiter,
+			wantDest:    dest.Address(),
+		},
+		"source and destination replace the destination": {
+			do:          update(nil, newDest, source, dest),
+			wantArbiter: arbiter.Address(),
+			wantDest:    newDest,
+		},
+		"source alone cannot replace the arbiter": {
+			do:          update(newArbiter, nil, source),
+			wantErr:     errors.ErrUnauthorized,
+			wantArbiter: arbiter.Address(),
+			wantDest:    dest.Address(),
+		},
+		"arbiter cannot replace the destination": {
+			do:          update(nil, newDest, source, arbiter),
+			wantErr:     errors.ErrUnauthorized,
+			wantArbiter: arbiter.Address(),
+			wantDest:    dest.Address(),
+		},
+		"expired escrow cannot be updated": {
+			do: action{
+				perms:     []weave.Condition{source, dest},
+				msg:       update(newArbiter, nil).msg,
+				blockTime: Timeout.Time(),
+			},
+			wantErr:     errors.ErrExpired,
+			wantArbiter: arbiter.Address(),
+			wantDest:    dest.Address(),
+		},
+		"arbiter of an expired disputed escrow can be replaced": {
+			before: []action{
+				{
+					perms: []weave.Condition{dest},
+					msg: &RaiseDisputeMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+					},
+				},
+			},
+			do: action{
+				perms:     []weave.Condition{source, dest},
+				msg:       update(newArbiter, nil).msg,
+				blockTime: Timeout.Time(),
+			},
+			wantArbiter: newArbiter,
+			wantDest:    dest.Address(),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "escrow", "cash")
+
+			bank := cash.NewBucket()
+			router := app.NewRouter()
+			RegisterRoutes(router, authenticator(), cash.NewController(bank), nil, nil)
+
+			wallet, err := cash.WalletWith(source.Address(), all...)
+			assert.Nil(t, err)
+			assert.Nil(t, bank.Save(db, wallet))
+
+			create := createAction(source, dest, arbiter, all, "")
+			_, err = router.Deliver(create.ctx(), db, create.tx())
+			assert.Nil(t, err)
+			for _, a := range tc.before {
+				_, err := router.Deliver(a.ctx(), db, a.tx())
+				assert.Nil(t, err)
+			}
+
+			if _, err := router.Deliver(tc.do.ctx(), db, tc.do.tx()); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			var escrow Escrow
+			assert.Nil(t, NewBucket().One(db, escrowID, &escrow))
+			if !escrow.Arbiter.Equals(tc.wantArbiter) {
+				t.Fatalf("want arbiter %s, got %s", tc.wantArbiter, escrow.Arbiter)
+			}
+			if !escrow.Destination.Equals(tc.wantDest) {
+				t.Fatalf("want destination %s, got %s", tc.wantDest, escrow.Destination)
+			}
+		})
+	}
+}
+
+func TestEscrowTokens(t *testing.T) {
+	source := weavetest.NewCondition()
+	dest := weavetest.NewCondition()
+	arbiter := weavetest.NewCondition()
+	other := weavetest.NewCondition()
+
+	escrowID := weavetest.SequenceID(1)
+	escrowAddr := Condition(escrowID).Address()
+	token := NonFungibleToken{Kind: "username", ID: []byte("alice*iov")}
+
+	release := action{
+		perms: []weave.Condition{arbiter},
+		msg: &ReleaseMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			EscrowId: escrowID,
+		},
+	}
+	ret := action{
+		msg: &ReturnMsg{
+			Metadata: &weave.Metadata{Schema: 1},
+			EscrowId: escrowID,
+		},
+		blockTime: Timeout.Time(),
+	}
+
+	cases := map[string]struct {
+		owner     weave.Condition
+		amount    coin.Coins
+		do        []action
+		wantErr   *errors.Error
+		wantOwner weave.Address
+	}{
+		"token is held by the escrow": {
+			owner:     source,
+			wantOwner: escrowAddr,
+		},
+		"token is released to the destination": {
+			owner:     source,
+			do:        []action{release},
+			wantOwner: dest.Address(),
+		},
+		"token is released together with the last coins": {
+			owner:  source,
+			amount: mustCombineCoins(coin.NewCoin(10, 0, "FOO")),
+			do: []action{
+				{
+					perms: []weave.Condition{arbiter},
+					msg: &ReleaseMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+						Amount:   mustCombineCoins(coin.NewCoin(4, 0, "FOO")),
+					},
+				},
+			},
+			wantOwner: escrowAddr,
+		},
+		"token is returned to the source": {
+			owner:     source,
+			do:        []action{ret},
+			wantOwner: source.Address(),
+		},
+		"dispute resolution releases the token": {
+			owner: source,
+			do: []action{
+				{
+					perms: []weave.Condition{source},
+					msg: &RaiseDisputeMsg{
+						Metadata: &weave.Metadata{Schema: 1},
+						EscrowId: escrowID,
+					},
+				},
+				{
+					perms: []weave.Condition{arbiter},
+					msg: &ResolveDisputeMsg{
+						Metadata:      &weave.Metadata{Schema: 1},
+						EscrowId:      escrowID,
+						ReleaseTokens: true,
+					},
+				},
+			},
+			wantOwner: dest.Address(),
+		},
+		"token of another owner cannot be escrowed": {
+			owner:     other,
+			wantErr:   errors.ErrUnauthorized,
+			wantOwner: other.Address(),
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "escrow", "cash")
+
+			bank := cash.NewBucket()
+			tokens := &ownershipMover{owners: map[string]weave.Address{
+				string(token.ID): tc.owner.Address(),
+			}}
+			router := app.NewRouter()
+			RegisterRoutes(router, authenticator(), cash.NewController(bank), nil, tokens)
+
+			if len(tc.amount) != 0 {
+				wallet, err := cash.WalletWith(source.Address(), tc.amount...)
+				assert.Nil(t, err)
+				assert.Nil(t, bank.Save(db, wallet))
+			}
+
+			create := createAction(source, dest, arbiter, tc.amount, "")
+			create.msg.(*CreateMsg).Tokens = []NonFungibleToken{token}
+			_, err := router.Deliver(create.ctx(), db, create.tx())
+			if len(tc.do) == 0 {
+				if !tc.wantErr.Is(err) {
+					t.Fatalf("unexpected create error: %+v", err)
+				}
+			} else {
+				assert.Nil(t, err)
+			}
+
+			for i, a := range tc.do {
+				_, err := router.Deliver(a.ctx(), db, a.tx())
+				if i < len(tc.do)-1 {
+					assert.Nil(t, err)
+				} else if !tc.wantErr.Is(err) {
+					t.Fatalf("unexpected error: %+v", err)
+				}
+			}
+
+			if got := tokens.owners[string(token.ID)]; !got.Equals(tc.wantOwner) {
+				t.Fatalf("want token owner %s, got %s", tc.wantOwner, got)
+			}
+		})
+	}
+}
+
+func TestEscrowTokensNotSupported(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "escrow", "cash")
+
+	router := app.NewRouter()
+	RegisterRoutes(router, authenticator(), cash.NewController(cash.NewBucket()), nil, nil)
+
+	source := weavetest.NewCondition()
+	create := createAction(source, weavetest.NewCondition(), weavetest.NewCondition(), nil, "")
+	create.msg.(*CreateMsg).Tokens = []NonFungibleToken{{Kind: "username", ID: []byte("alice*iov")}}
+	if _, err := router.Deliver(create.ctx(), db, create.tx()); !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
+
+// ownershipMover is a TokenMover implementation that keeps the owner of
+// each token in memory.
+type ownershipMover struct {
+	owners map[string]weave.Address
+}
+
+var _ TokenMover = (*ownershipMover)(nil)
+
+func (m *ownershipMover) MoveToken(db weave.KVStore, kind string, id []byte, src, dest weave.Address) error {
+	owner, ok := m.owners[string(id)]
+	if !ok {
+		return errors.Wrap(errors.ErrNotFound, "no token")
+	}
+	if !owner.Equals(src) {
+		return errors.Wrap(errors.ErrUnauthorized, "not the owner")
+	}
+	m.owners[string(id)] = dest
+	return nil
+}
+
+// recordingScheduler is a weave.Scheduler implementation that keeps all
+// scheduled tasks in memory.
+type recordingScheduler struct {
+	tasks   map[string]scheduledTask
+	counter int
+}
+
+type scheduledTask struct {
+	runAt time.Time
+	msg   weave.Msg
+}
+
+var _ weave.Scheduler = (*recordingScheduler)(nil)
+
+func (s *recordingScheduler) Schedule(db weave.KVStore, runAt time.Time, auth []weave.Condition, msg weave.Msg) ([]byte, error) {
+	s.counter++
+	id := weavetest.SequenceID(uint64(s.counter))
+	s.tasks[string(id)] = scheduledTask{runAt: runAt, msg: msg}
+	return id, nil
+}
+
+func (s *recordingScheduler) Delete(db weave.KVStore, taskID []byte) error {
+	if _, ok := s.tasks[string(taskID)]; !ok {
+		return errors.Wrap(errors.ErrNotFound, "no task")
+	}
+	delete(s.tasks, string(taskID))
+	return nil
+}
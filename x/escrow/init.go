@@ -1,6 +1,8 @@
 package escrow
 
 import (
+	"context"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/x/cash"
@@ -44,8 +46,10 @@ func (i *Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams
 		if _, err := bucket.Put(kv, key, &escrow); err != nil {
 			return errors.Wrap(err, "cannot save escrow")
 		}
+		// Genesis has no block height, wallets are created at height zero.
+		ctx := context.Background()
 		for _, c := range e.Amount {
-			if err := i.Minter.CoinMint(kv, escrow.Address, *c); err != nil {
+			if err := i.Minter.CoinMint(ctx, kv, escrow.Address, *c); err != nil {
 				return errors.Wrap(err, "failed to issue coins")
 			}
 		}
@@ -1,6 +1,8 @@
 package escrow
 
 import (
+	"fmt"
+
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
@@ -19,7 +21,12 @@ func (e *Escrow) Validate() error {
 	var errs error
 	errs = errors.AppendField(errs, "Metadata", e.Metadata.Validate())
 	errs = errors.AppendField(errs, "Source", e.Source.Validate())
-	errs = errors.AppendField(errs, "Arbiter", e.Arbiter.Validate())
+	errs = errors.Append(errs, validateArbiters(e.Arbiter, e.Arbiters, e.ArbiterThreshold))
+	if len(e.Arbiters) != 0 && len(e.Milestones) != 0 {
+		errs = errors.Append(errs, errors.Field("Milestones", errors.ErrInput, "cannot be used with multiple arbiters"))
+	}
+	errs = errors.Append(errs, validateApprovals("ReleaseApprovals", e.ReleaseApprovals, e.Arbiters))
+	errs = errors.Append(errs, validateApprovals("ReturnApprovals", e.ReturnApprovals, e.Arbiters))
 	errs = errors.AppendField(errs, "Destination", e.Destination.Validate())
 	errs = errors.AppendField(errs, "Address", e.Address.Validate())
 	if e.Timeout == 0 {
@@ -32,9 +39,232 @@ func (e *Escrow) Validate() error {
 	if len(e.Memo) > maxMemoSize {
 		errs = errors.Append(errs, errors.Field("Memo", errors.ErrInput, "cannot be longer than %d", maxMemoSize))
 	}
+	errs = errors.Append(errs, validateMilestones(e.Milestones))
+	if e.ArbiterFee != nil {
+		errs = errors.Append(errs, validateArbiterFee(e.ArbiterFee, e.Arbiters, e.Milestones))
+	}
+	errs = errors.Append(errs, validateTokens(e.Tokens, e.Milestones))
+	return errs
+}
+
+// validateArbiters ensures that either a single arbiter or a set of
+// arbiters with a valid threshold is provided.
+func validateArbiters(arbiter weave.Address, arbiters []weave.Address, threshold uint32) error {
+	if len(arbiters) == 0 {
+		var errs error
+		errs = errors.AppendField(errs, "Arbiter", arbiter.Validate())
+		if threshold != 0 {
+			errs = errors.Append(errs, errors.Field("ArbiterThreshold", errors.ErrInput, "requires arbiters"))
+		}
+		return errs
+	}
+
+	if len(arbiter) != 0 {
+		return errors.Field("Arbiter", errors.ErrInput, "cannot be used with multiple arbiters")
+	}
+	if len(arbiters) > maxArbiters {
+		return errors.Field("Arbiters", errors.ErrInput, "cannot have more than %d", maxArbiters)
+	}
+	var errs error
+	seen := make(map[string]bool, len(arbiters))
+	for i, a := range arbiters {
+		name := fmt.Sprintf("Arbiters.%d", i)
+		if err := a.Validate(); err != nil {
+			errs = errors.AppendField(errs, name, err)
+			continue
+		}
+		if seen[a.String()] {
+			errs = errors.Append(errs, errors.Field(name, errors.ErrDuplicate, "arbiter already declared"))
+		}
+		seen[a.String()] = true
+	}
+	if threshold == 0 || int(threshold) > len(arbiters) {
+		errs = errors.Append(errs, errors.Field("ArbiterThreshold", errors.ErrInput,
+			"must be between 1 and the number of arbiters"))
+	}
+	return errs
+}
+
+// validateApprovals ensures that all approvals are given by arbiters and
+// that no arbiter approved twice.
+func validateApprovals(field string, approvals, arbiters []weave.Address) error {
+	var errs error
+	seen := make(map[string]bool, len(approvals))
+	for i, a := range approvals {
+		name := fmt.Sprintf("%s.%d", field, i)
+		if !isArbiter(arbiters, a) {
+			errs = errors.Append(errs, errors.Field(name, errors.ErrInput, "not an arbiter"))
+		}
+		if seen[a.String()] {
+			errs = errors.Append(errs, errors.Field(name, errors.ErrDuplicate, "already approved"))
+		}
+		seen[a.String()] = true
+	}
 	return errs
 }
 
+func isArbiter(arbiters []weave.Address, addr weave.Address) bool {
+	for _, a := range arbiters {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate ensures the arbiter fee is valid.
+func (f *ArbiterFee) Validate() error {
+	switch {
+	case f.Flat != nil && f.BasisPoints != 0:
+		return errors.Wrap(errors.ErrInput, "only one of flat or basis points fee can be set")
+	case f.Flat != nil:
+		if err := f.Flat.Validate(); err != nil {
+			return errors.Field("Flat", err, "invalid")
+		}
+		if !f.Flat.IsPositive() {
+			return errors.Field("Flat", errors.ErrAmount, "must be positive")
+		}
+	case f.BasisPoints == 0 || f.BasisPoints > maxBasisPoints:
+		return errors.Field("BasisPoints", errors.ErrInput, "must be between 1 and %d", maxBasisPoints)
+	}
+	return nil
+}
+
+// validateArbiterFee ensures that the fee is valid and that the escrow has
+// a single arbiter that it can be paid to.
+func validateArbiterFee(fee *ArbiterFee, arbiters []weave.Address, milestones []*Milestone) error {
+	var errs error
+	errs = errors.AppendField(errs, "ArbiterFee", fee.Validate())
+	if len(arbiters) != 0 {
+		errs = errors.Append(errs, errors.Field("ArbiterFee", errors.ErrInput, "cannot be used with multiple arbiters"))
+	}
+	if len(milestones) != 0 {
+		errs = errors.Append(errs, errors.Field("ArbiterFee", errors.ErrInput, "cannot be used with milestones"))
+	}
+	return errs
+}
+
+// splitArbiterFee splits the settled amount into the arbiter fee and the
+// rest that is paid to the receiver. Flat fee is charged only if it was not
+// paid yet.
+func splitArbiterFee(e *Escrow, amount coin.Coins) (fee, rest coin.Coins, err error) {
+	rest = amount.Clone()
+	if e.ArbiterFee == nil {
+		return nil, rest, nil
+	}
+
+	var charges []coin.Coin
+	if f := e.ArbiterFee.Flat; f != nil && !e.ArbiterFeePaid {
+		if !amount.Contains(*f) {
+			return nil, nil, errors.Wrapf(errors.ErrAmount, "settled amount does not cover the arbiter fee %s", f)
+		}
+		charges = append(charges, *f)
+	}
+	if bp := e.ArbiterFee.BasisPoints; bp != 0 {
+		for _, c := range amount {
+			one, _, err := c.Divide(maxBasisPoints)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "cannot divide")
+			}
+			charge, err := one.Multiply(int64(bp))
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "cannot multiply")
+			}
+			if !charge.IsZero() {
+				charges = append(charges, charge)
+			}
+		}
+	}
+
+	for _, c := range charges {
+		if fee, err = fee.Add(c); err != nil {
+			return nil, nil, errors.Wrap(err, "cannot add fee")
+		}
+		if rest, err = rest.Subtract(c); err != nil {
+			return nil, nil, errors.Wrap(err, "cannot subtract fee")
+		}
+	}
+	return fee, rest, nil
+}
+
+// Validate ensures the token reference is valid.
+func (t *NonFungibleToken) Validate() error {
+	var errs error
+	if t.Kind == "" {
+		errs = errors.Append(errs, errors.Field("Kind", errors.ErrEmpty, "required"))
+	}
+	if len(t.ID) == 0 {
+		errs = errors.Append(errs, errors.Field("ID", errors.ErrEmpty, "required"))
+	}
+	return errs
+}
+
+func validateTokens(tokens []NonFungibleToken, milestones []*Milestone) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	if len(tokens) > maxTokens {
+		return errors.Field("Tokens", errors.ErrInput, "cannot have more than %d", maxTokens)
+	}
+	var errs error
+	if len(milestones) != 0 {
+		errs = errors.Append(errs, errors.Field("Tokens", errors.ErrInput, "cannot be used with milestones"))
+	}
+	seen := make(map[string]bool, len(tokens))
+	for i, t := range tokens {
+		name := fmt.Sprintf("Tokens.%d", i)
+		if err := t.Validate(); err != nil {
+			errs = errors.AppendField(errs, name, err)
+			continue
+		}
+		key := t.Kind + ":" + string(t.ID)
+		if seen[key] {
+			errs = errors.Append(errs, errors.Field(name, errors.ErrDuplicate, "token already declared"))
+		}
+		seen[key] = true
+	}
+	return errs
+}
+
+// Validate ensures the milestone is valid.
+func (m *Milestone) Validate() error {
+	var errs error
+	if len(m.Description) > maxMemoSize {
+		errs = errors.Append(errs, errors.Field("Description", errors.ErrInput, "cannot be longer than %d", maxMemoSize))
+	}
+	errs = errors.AppendField(errs, "Amount", validateAmount(m.Amount))
+	return errs
+}
+
+func validateMilestones(milestones []*Milestone) error {
+	if len(milestones) > maxMilestones {
+		return errors.Field("Milestones", errors.ErrInput, "cannot have more than %d", maxMilestones)
+	}
+	var errs error
+	for i, m := range milestones {
+		name := fmt.Sprintf("Milestones.%d", i)
+		if m == nil {
+			errs = errors.Append(errs, errors.Field(name, errors.ErrEmpty, "required"))
+			continue
+		}
+		errs = errors.AppendField(errs, name, m.Validate())
+	}
+	return errs
+}
+
+// milestonesTotal returns the sum of all milestone amounts.
+func milestonesTotal(milestones []*Milestone) (coin.Coins, error) {
+	var total coin.Coins
+	for _, m := range milestones {
+		var err error
+		total, err = total.Combine(m.Amount)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return total, nil
+}
+
 // AsEscrow extracts an *Escrow value or nil from the object
 // Must be called on a Bucket result that is an *Escrow,
 // will panic on bad type.
@@ -78,7 +308,7 @@ func NewBucket() orm.ModelBucket {
 		orm.WithIDSequence(escrowSeq),
 		orm.WithIndex("source", idxSource, false),
 		orm.WithIndex("destination", idxDestination, false),
-		orm.WithIndex("arbiter", idxArbiter, false),
+		orm.WithMultiKeyIndex("arbiter", idxArbiter, false),
 	)
 	return migration.NewModelBucket("escrow", b)
 }
@@ -112,10 +342,19 @@ func idxDestination(obj orm.Object) ([]byte, error) {
 	return esc.Destination, nil
 }
 
-func idxArbiter(obj orm.Object) ([]byte, error) {
+// idxArbiter indexes the escrow by the arbiter or, if the escrow has
+// multiple arbiters, by each of them.
+func idxArbiter(obj orm.Object) ([][]byte, error) {
 	esc, err := toEscrow(obj)
 	if err != nil {
 		return nil, err
 	}
-	return esc.Arbiter, nil
+	if len(esc.Arbiters) == 0 {
+		return [][]byte{esc.Arbiter}, nil
+	}
+	keys := make([][]byte, len(esc.Arbiters))
+	for i, a := range esc.Arbiters {
+		keys[i] = a
+	}
+	return keys, nil
 }
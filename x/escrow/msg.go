@@ -1,6 +1,8 @@
 package escrow
 
 import (
+	"fmt"
+
 	"github.com/iov-one/weave"
 	coin "github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
@@ -10,12 +12,24 @@ import (
 func init() {
 	migration.MustRegister(1, &CreateMsg{}, migration.NoModification)
 	migration.MustRegister(1, &ReleaseMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ReleaseMilestoneMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ApproveMsg{}, migration.NoModification)
 	migration.MustRegister(1, &ReturnMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdatePartiesMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateEscrowTimeoutMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RaiseDisputeMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ResolveDisputeMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateEscrowPartiesMsg{}, migration.NoModification)
 }
 
 const (
-	maxMemoSize int = 128
+	maxMemoSize   int = 128
+	maxMilestones int = 32
+	maxArbiters   int = 20
+	maxTokens     int = 20
+
+	// maxBasisPoints is the arbiter fee of 100%.
+	maxBasisPoints = 10000
 )
 
 // NewCreateMsg is a helper to quickly build a create escrow message
@@ -48,7 +62,7 @@ func (CreateMsg) Path() string {
 func (m *CreateMsg) Validate() error {
 	var errs error
 	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
-	errs = errors.AppendField(errs, "Arbiter", m.Arbiter.Validate())
+	errs = errors.Append(errs, validateArbiters(m.Arbiter, m.Arbiters, m.ArbiterThreshold))
 	errs = errors.AppendField(errs, "Destination", m.Destination.Validate())
 	if m.Timeout == 0 {
 		// Zero timeout is a valid value that dates to 1970-01-01. We
@@ -60,7 +74,33 @@ func (m *CreateMsg) Validate() error {
 	if len(m.Memo) > maxMemoSize {
 		errs = errors.Append(errs, errors.Field("Memo", errors.ErrInput, "cannot be longer than %d", maxMemoSize))
 	}
-	errs = errors.AppendField(errs, "Amount", validateAmount(m.Amount))
+	// An escrow holding tokens does not have to hold any coins.
+	if len(m.Tokens) == 0 || len(m.Amount) != 0 {
+		errs = errors.AppendField(errs, "Amount", validateAmount(m.Amount))
+	}
+	errs = errors.Append(errs, validateTokens(m.Tokens, m.Milestones))
+	if len(m.Milestones) != 0 {
+		if len(m.Arbiters) != 0 {
+			errs = errors.Append(errs, errors.Field("Milestones", errors.ErrInput, "cannot be used with multiple arbiters"))
+		}
+		errs = errors.Append(errs, validateMilestones(m.Milestones))
+		for i, ms := range m.Milestones {
+			if ms != nil && ms.Released {
+				errs = errors.Append(errs, errors.Field(fmt.Sprintf("Milestones.%d.Released", i), errors.ErrInput, "must not be released"))
+			}
+		}
+		if total, err := milestonesTotal(m.Milestones); err != nil {
+			errs = errors.Append(errs, errors.Field("Milestones", err, "cannot sum amounts"))
+		} else if !total.Equals(m.Amount) {
+			errs = errors.Append(errs, errors.Field("Milestones", errors.ErrAmount, "amounts must sum up to the escrow amount"))
+		}
+	}
+	if m.ArbiterFee != nil {
+		errs = errors.Append(errs, validateArbiterFee(m.ArbiterFee, m.Arbiters, m.Milestones))
+		if f := m.ArbiterFee.Flat; f != nil && !coin.Coins(m.Amount).Contains(*f) {
+			errs = errors.Append(errs, errors.Field("ArbiterFee.Flat", errors.ErrAmount, "must be covered by the escrow amount"))
+		}
+	}
 	return errs
 }
 
@@ -81,6 +121,43 @@ func (m *ReleaseMsg) Validate() error {
 	return errs
 }
 
+var _ weave.Msg = (*ReleaseMilestoneMsg)(nil)
+
+func (ReleaseMilestoneMsg) Path() string {
+	return "escrow/release_milestone"
+}
+
+// Validate makes sure that this is sensible
+func (m *ReleaseMilestoneMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "EscrowID", validateEscrowID(m.EscrowId))
+	if m.Milestone >= uint32(maxMilestones) {
+		errs = errors.Append(errs, errors.Field("Milestone", errors.ErrInput, "out of range"))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*ApproveMsg)(nil)
+
+func (ApproveMsg) Path() string {
+	return "escrow/approve"
+}
+
+// Validate makes sure that this is sensible
+func (m *ApproveMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "EscrowID", validateEscrowID(m.EscrowId))
+	errs = errors.AppendField(errs, "Arbiter", m.Arbiter.Validate())
+	switch m.Action {
+	case Action_Release, Action_Return:
+	default:
+		errs = errors.Append(errs, errors.Field("Action", errors.ErrInput, "must be release or return"))
+	}
+	return errs
+}
+
 var _ weave.Msg = (*ReturnMsg)(nil)
 
 func (ReturnMsg) Path() string {
@@ -127,6 +204,81 @@ func (m *UpdatePartiesMsg) Validate() error {
 	return errs
 }
 
+var _ weave.Msg = (*UpdateEscrowTimeoutMsg)(nil)
+
+func (UpdateEscrowTimeoutMsg) Path() string {
+	return "escrow/update_timeout"
+}
+
+// Validate makes sure that this is sensible
+func (m *UpdateEscrowTimeoutMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "EscrowID", validateEscrowID(m.EscrowId))
+	if m.Timeout == 0 {
+		errs = errors.Append(errs, errors.Field("Timeout", errors.ErrInput, "required"))
+	}
+	errs = errors.AppendField(errs, "Timeout", m.Timeout.Validate())
+	return errs
+}
+
+var _ weave.Msg = (*RaiseDisputeMsg)(nil)
+
+func (RaiseDisputeMsg) Path() string {
+	return "escrow/raise_dispute"
+}
+
+// Validate makes sure that this is sensible
+func (m *RaiseDisputeMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "EscrowID", validateEscrowID(m.EscrowId))
+	return errs
+}
+
+var _ weave.Msg = (*ResolveDisputeMsg)(nil)
+
+func (ResolveDisputeMsg) Path() string {
+	return "escrow/resolve_dispute"
+}
+
+// Validate makes sure that this is sensible
+func (m *ResolveDisputeMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "EscrowID", validateEscrowID(m.EscrowId))
+	if len(m.SourceAmount) != 0 {
+		errs = errors.AppendField(errs, "SourceAmount", validateAmount(m.SourceAmount))
+	}
+	if len(m.DestinationAmount) != 0 {
+		errs = errors.AppendField(errs, "DestinationAmount", validateAmount(m.DestinationAmount))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*UpdateEscrowPartiesMsg)(nil)
+
+func (UpdateEscrowPartiesMsg) Path() string {
+	return "escrow/update_escrow_parties"
+}
+
+// Validate makes sure that this is sensible
+func (m *UpdateEscrowPartiesMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "EscrowID", validateEscrowID(m.EscrowId))
+	if m.Arbiter == nil && m.Destination == nil {
+		errs = errors.Append(errs, errors.Wrap(errors.ErrEmpty, "arbiter or destination required"))
+	}
+	if m.Arbiter != nil {
+		errs = errors.AppendField(errs, "Arbiter", m.Arbiter.Validate())
+	}
+	if m.Destination != nil {
+		errs = errors.AppendField(errs, "Destination", m.Destination.Validate())
+	}
+	return errs
+}
+
 func validateAmount(amount coin.Coins) error {
 	// we enforce this is positive
 	positive := amount.IsPositive()
@@ -118,6 +118,217 @@ func TestCreateMsg(t *testing.T) {
 			},
 			errors.ErrInput,
 		},
+		"milestones sum up to the amount": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				Milestones: []*Milestone{
+					{Description: "first", Amount: mustCombineCoins(coin.NewCoin(40, 0, "FOO"))},
+					{Description: "second", Amount: mustCombineCoins(coin.NewCoin(60, 0, "FOO"))},
+				},
+			},
+			nil,
+		},
+		"milestones do not sum up to the amount": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				Milestones: []*Milestone{
+					{Amount: mustCombineCoins(coin.NewCoin(40, 0, "FOO"))},
+				},
+			},
+			errors.ErrAmount,
+		},
+		"released milestone": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				Milestones: []*Milestone{
+					{Amount: plus, Released: true},
+				},
+			},
+			errors.ErrInput,
+		},
+		"multiple arbiters": {
+			&CreateMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Arbiters:         []weave.Address{a.Address(), b.Address()},
+				ArbiterThreshold: 2,
+				Destination:      c.Address(),
+				Amount:           plus,
+				Timeout:          timeout,
+			},
+			nil,
+		},
+		"threshold higher than the number of arbiters": {
+			&CreateMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Arbiters:         []weave.Address{a.Address(), b.Address()},
+				ArbiterThreshold: 3,
+				Destination:      c.Address(),
+				Amount:           plus,
+				Timeout:          timeout,
+			},
+			errors.ErrInput,
+		},
+		"duplicated arbiter": {
+			&CreateMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Arbiters:         []weave.Address{a.Address(), a.Address()},
+				ArbiterThreshold: 1,
+				Destination:      c.Address(),
+				Amount:           plus,
+				Timeout:          timeout,
+			},
+			errors.ErrDuplicate,
+		},
+		"both arbiter and arbiters": {
+			&CreateMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Arbiter:          b.Address(),
+				Arbiters:         []weave.Address{a.Address(), b.Address()},
+				ArbiterThreshold: 1,
+				Destination:      c.Address(),
+				Amount:           plus,
+				Timeout:          timeout,
+			},
+			errors.ErrInput,
+		},
+		"milestone without amount": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				Milestones: []*Milestone{
+					{Amount: plus},
+					{Description: "empty"},
+				},
+			},
+			errors.ErrAmount,
+		},
+		"flat arbiter fee": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				ArbiterFee:  &ArbiterFee{Flat: coin.NewCoinp(1, 0, "FOO")},
+			},
+			nil,
+		},
+		"percentage arbiter fee": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				ArbiterFee:  &ArbiterFee{BasisPoints: 250},
+			},
+			nil,
+		},
+		"flat arbiter fee not covered by the amount": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				ArbiterFee:  &ArbiterFee{Flat: coin.NewCoinp(1, 0, "BAR")},
+			},
+			errors.ErrAmount,
+		},
+		"both flat and percentage arbiter fee": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				ArbiterFee:  &ArbiterFee{Flat: coin.NewCoinp(1, 0, "FOO"), BasisPoints: 250},
+			},
+			errors.ErrInput,
+		},
+		"arbiter fee above 100%": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				ArbiterFee:  &ArbiterFee{BasisPoints: 10001},
+			},
+			errors.ErrInput,
+		},
+		"arbiter fee with multiple arbiters": {
+			&CreateMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Arbiters:         []weave.Address{a.Address(), b.Address()},
+				ArbiterThreshold: 1,
+				Destination:      c.Address(),
+				Amount:           plus,
+				Timeout:          timeout,
+				ArbiterFee:       &ArbiterFee{BasisPoints: 250},
+			},
+			errors.ErrInput,
+		},
+		"tokens without amount": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Timeout:     timeout,
+				Tokens:      []NonFungibleToken{{Kind: "username", ID: []byte("alice*iov")}},
+			},
+			nil,
+		},
+		"duplicated token": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Timeout:     timeout,
+				Tokens: []NonFungibleToken{
+					{Kind: "username", ID: []byte("alice*iov")},
+					{Kind: "username", ID: []byte("alice*iov")},
+				},
+			},
+			errors.ErrDuplicate,
+		},
+		"token without ID": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Timeout:     timeout,
+				Tokens:      []NonFungibleToken{{Kind: "username"}},
+			},
+			errors.ErrEmpty,
+		},
+		"arbiter fee with milestones": {
+			&CreateMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				Arbiter:     b.Address(),
+				Destination: c.Address(),
+				Amount:      plus,
+				Timeout:     timeout,
+				Milestones:  []*Milestone{{Amount: plus}},
+				ArbiterFee:  &ArbiterFee{BasisPoints: 250},
+			},
+			errors.ErrInput,
+		},
 	}
 
 	for name, tc := range cases {
@@ -206,6 +417,85 @@ func TestReleaseMsg(t *testing.T) {
 	}
 }
 
+func TestReleaseMilestoneMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg   *ReleaseMilestoneMsg
+		check error
+	}{
+		"valid": {
+			&ReleaseMilestoneMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				EscrowId:  weavetest.SequenceID(1),
+				Milestone: 3,
+			},
+			nil,
+		},
+		"missing id": {
+			&ReleaseMilestoneMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			errors.ErrInput,
+		},
+		"milestone out of range": {
+			&ReleaseMilestoneMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				EscrowId:  weavetest.SequenceID(1),
+				Milestone: uint32(maxMilestones),
+			},
+			errors.ErrInput,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			assert.IsErr(t, tc.check, err)
+		})
+	}
+}
+
+func TestApproveMsg(t *testing.T) {
+	arbiter := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		msg   *ApproveMsg
+		check error
+	}{
+		"valid": {
+			&ApproveMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: weavetest.SequenceID(1),
+				Arbiter:  arbiter,
+				Action:   Action_Return,
+			},
+			nil,
+		},
+		"missing action": {
+			&ApproveMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: weavetest.SequenceID(1),
+				Arbiter:  arbiter,
+			},
+			errors.ErrInput,
+		},
+		"missing arbiter": {
+			&ApproveMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: weavetest.SequenceID(1),
+				Action:   Action_Release,
+			},
+			errors.ErrEmpty,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			assert.IsErr(t, tc.check, err)
+		})
+	}
+}
+
 func TestReturnMsg(t *testing.T) {
 	// valid: fixed 8 byte id
 	escrow := []byte{0xff, 0, 1, 3, 6, 6, 6, 6}
@@ -308,3 +598,184 @@ func TestUpdateEscrowMsg(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateEscrowTimeoutMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg   *UpdateEscrowTimeoutMsg
+		check error
+	}{
+		"valid": {
+			&UpdateEscrowTimeoutMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: weavetest.SequenceID(1),
+				Timeout:  weave.AsUnixTime(time.Now()),
+			},
+			nil,
+		},
+		"missing timeout": {
+			&UpdateEscrowTimeoutMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: weavetest.SequenceID(1),
+			},
+			errors.ErrInput,
+		},
+		"missing id": {
+			&UpdateEscrowTimeoutMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Timeout:  weave.AsUnixTime(time.Now()),
+			},
+			errors.ErrInput,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			assert.IsErr(t, tc.check, err)
+		})
+	}
+}
+
+func TestRaiseDisputeMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg   *RaiseDisputeMsg
+		check error
+	}{
+		"valid message": {
+			&RaiseDisputeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: weavetest.SequenceID(1),
+			},
+			nil,
+		},
+		"missing escrow ID": {
+			&RaiseDisputeMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+			},
+			errors.ErrInput,
+		},
+		"missing metadata": {
+			&RaiseDisputeMsg{
+				EscrowId: weavetest.SequenceID(1),
+			},
+			errors.ErrMetadata,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			assert.IsErr(t, tc.check, err)
+		})
+	}
+}
+
+func TestResolveDisputeMsg(t *testing.T) {
+	cases := map[string]struct {
+		msg   *ResolveDisputeMsg
+		check error
+	}{
+		"valid message": {
+			&ResolveDisputeMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				EscrowId:          weavetest.SequenceID(1),
+				SourceAmount:      mustCombineCoins(coin.NewCoin(1, 0, "FOO")),
+				DestinationAmount: mustCombineCoins(coin.NewCoin(2, 0, "FOO")),
+			},
+			nil,
+		},
+		"everything to the destination": {
+			&ResolveDisputeMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				EscrowId:          weavetest.SequenceID(1),
+				DestinationAmount: mustCombineCoins(coin.NewCoin(2, 0, "FOO")),
+			},
+			nil,
+		},
+		"escrow holding only tokens": {
+			&ResolveDisputeMsg{
+				Metadata:      &weave.Metadata{Schema: 1},
+				EscrowId:      weavetest.SequenceID(1),
+				ReleaseTokens: true,
+			},
+			nil,
+		},
+		"negative amount": {
+			&ResolveDisputeMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				EscrowId:     weavetest.SequenceID(1),
+				SourceAmount: mustCombineCoins(coin.NewCoin(-1, 0, "FOO")),
+			},
+			errors.ErrAmount,
+		},
+		"missing escrow ID": {
+			&ResolveDisputeMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				SourceAmount: mustCombineCoins(coin.NewCoin(1, 0, "FOO")),
+			},
+			errors.ErrInput,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			assert.IsErr(t, tc.check, err)
+		})
+	}
+}
+
+func TestUpdateEscrowPartiesMsg(t *testing.T) {
+	addr := weavetest.NewCondition().Address()
+
+	cases := map[string]struct {
+		msg   *UpdateEscrowPartiesMsg
+		check error
+	}{
+		"replace arbiter": {
+			&UpdateEscrowPartiesMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: weavetest.SequenceID(1),
+				Arbiter:  addr,
+			},
+			nil,
+		},
+		"replace destination": {
+			&UpdateEscrowPartiesMsg{
+				Metadata:    &weave.Metadata{Schema: 1},
+				EscrowId:    weavetest.SequenceID(1),
+				Destination: addr,
+			},
+			nil,
+		},
+		"nothing to replace": {
+			&UpdateEscrowPartiesMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: weavetest.SequenceID(1),
+			},
+			errors.ErrEmpty,
+		},
+		"invalid arbiter": {
+			&UpdateEscrowPartiesMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				EscrowId: weavetest.SequenceID(1),
+				Arbiter:  weave.Address("invalid"),
+			},
+			errors.ErrInput,
+		},
+		"missing id": {
+			&UpdateEscrowPartiesMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Arbiter:  addr,
+			},
+			errors.ErrInput,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			assert.IsErr(t, tc.check, err)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package escrow
+
+import (
+	"bytes"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+// partyEscrowsPageSize is the maximum number of escrows returned by a single
+// party query.
+const partyEscrowsPageSize = 50
+
+// PartyEscrowsQuery lists escrows of a single party, using one of the
+// source, destination or arbiter indexes. Results are ordered by the escrow
+// ID and split into pages of at most 50 escrows.
+//
+// Query data is the address of the party, optionally followed by the ID of
+// the last escrow of the previous page. Use "<address>" to request the first
+// page and "<address><escrow ID>" to request the page that follows the given
+// escrow.
+type PartyEscrowsQuery struct {
+	index  orm.Index
+	bucket orm.Bucket
+}
+
+var _ weave.QueryHandler = PartyEscrowsQuery{}
+
+// NewSourceEscrowsQuery returns a query handler that lists escrows by the
+// source address.
+func NewSourceEscrowsQuery() PartyEscrowsQuery {
+	return newPartyEscrowsQuery(orm.NewIndex("esc_source", idxSource, false, nil))
+}
+
+// NewDestinationEscrowsQuery returns a query handler that lists escrows by
+// the destination address.
+func NewDestinationEscrowsQuery() PartyEscrowsQuery {
+	return newPartyEscrowsQuery(orm.NewIndex("esc_destination", idxDestination, false, nil))
+}
+
+// NewArbiterEscrowsQuery returns a query handler that lists escrows by the
+// arbiter address. Escrows with multiple arbiters are listed for each of
+// them.
+func NewArbiterEscrowsQuery() PartyEscrowsQuery {
+	return newPartyEscrowsQuery(orm.NewMultiKeyIndex("esc_arbiter", idxArbiter, false, nil))
+}
+
+// newPartyEscrowsQuery returns a query handler reading given index. Index
+// must be the same as the one registered on the escrow bucket by NewBucket.
+func newPartyEscrowsQuery(index orm.Index) PartyEscrowsQuery {
+	return PartyEscrowsQuery{
+		index:  index,
+		bucket: orm.NewBucket("esc", &Escrow{}),
+	}
+}
+
+// Query handles queries from the QueryRouter.
+func (q PartyEscrowsQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
+	}
+	if len(data) < weave.AddressLength {
+		return nil, errors.Wrap(errors.ErrInput, "address required")
+	}
+	addr, after := data[:weave.AddressLength], data[weave.AddressLength:]
+
+	refs, err := q.index.GetAt(db, addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read index")
+	}
+	// References are stored in order, so the page starts right after the
+	// last escrow of the previous page.
+	start := 0
+	if len(after) != 0 {
+		for start < len(refs) && bytes.Compare(refs[start], after) <= 0 {
+			start++
+		}
+	}
+	refs = refs[start:]
+	if len(refs) > partyEscrowsPageSize {
+		refs = refs[:partyEscrowsPageSize]
+	}
+
+	res := make([]weave.Model, 0, len(refs))
+	for _, id := range refs {
+		key := q.bucket.DBKey(id)
+		value, err := db.Get(key)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot load escrow")
+		}
+		res = append(res, weave.Model{Key: key, Value: value})
+	}
+	return res, nil
+}
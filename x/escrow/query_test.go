@@ -0,0 +1,129 @@
+package escrow
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestPartyEscrowsQuery(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "escrow")
+
+	source := weavetest.NewCondition().Address()
+	dest := weavetest.NewCondition().Address()
+	arbiter := weavetest.NewCondition().Address()
+	other := weavetest.NewCondition().Address()
+
+	bucket := NewBucket()
+	create := func(source, dest, arbiter weave.Address) {
+		t.Helper()
+		escrow := &Escrow{
+			Metadata:    &weave.Metadata{Schema: 1},
+			Source:      source,
+			Destination: dest,
+			Arbiter:     arbiter,
+			Timeout:     Timeout,
+			Address:     weavetest.NewCondition().Address(),
+		}
+		_, err := bucket.Put(db, nil, escrow)
+		assert.Nil(t, err)
+	}
+	for i := 0; i < 120; i++ {
+		create(source, dest, arbiter)
+	}
+	// Escrows of other parties must not be listed.
+	for i := 0; i < 10; i++ {
+		create(other, other, other)
+	}
+
+	cases := map[string]struct {
+		query PartyEscrowsQuery
+		party weave.Address
+		want  int
+	}{
+		"by source": {
+			query: NewSourceEscrowsQuery(),
+			party: source,
+			want:  120,
+		},
+		"by destination": {
+			query: NewDestinationEscrowsQuery(),
+			party: dest,
+			want:  120,
+		},
+		"by arbiter": {
+			query: NewArbiterEscrowsQuery(),
+			party: arbiter,
+			want:  120,
+		},
+		"unknown party": {
+			query: NewSourceEscrowsQuery(),
+			party: weavetest.NewCondition().Address(),
+			want:  0,
+		},
+	}
+
+	raw := rawBucket()
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			found := make(map[string]bool)
+			pages := 0
+			data := []byte(tc.party)
+			for {
+				models, err := tc.query.Query(db, weave.KeyQueryMod, data)
+				if err != nil {
+					t.Fatalf("cannot query: %s", err)
+				}
+				if len(models) == 0 {
+					break
+				}
+				if len(models) > partyEscrowsPageSize {
+					t.Fatalf("page too big: %d", len(models))
+				}
+				pages++
+				var last []byte
+				for _, m := range models {
+					obj, err := raw.Parse(m.Key, m.Value)
+					if err != nil {
+						t.Fatalf("cannot parse escrow: %s", err)
+					}
+					last = obj.Key()
+					if found[string(last)] {
+						t.Fatalf("escrow %x returned twice", last)
+					}
+					found[string(last)] = true
+					if !isParty(obj, tc.party) {
+						t.Fatalf("escrow %x does not belong to the party", last)
+					}
+				}
+				data = append(append([]byte{}, tc.party...), last...)
+			}
+			if len(found) != tc.want {
+				t.Fatalf("want %d escrows, got %d", tc.want, len(found))
+			}
+			if wantPages := (tc.want + partyEscrowsPageSize - 1) / partyEscrowsPageSize; pages != wantPages {
+				t.Fatalf("want %d pages, got %d", wantPages, pages)
+			}
+		})
+	}
+}
+
+func isParty(obj orm.Object, addr weave.Address) bool {
+	e := obj.Value().(*Escrow)
+	return e.Source.Equals(addr) || e.Destination.Equals(addr) || e.Arbiter.Equals(addr)
+}
+
+func TestPartyEscrowsQueryRequiresAddress(t *testing.T) {
+	db := store.MemStore()
+	_, err := NewSourceEscrowsQuery().Query(db, weave.KeyQueryMod, []byte("short"))
+	if !errors.ErrInput.Is(err) {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+}
@@ -0,0 +1,24 @@
+package feature
+
+import (
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	gconf.Register("feature", &Configuration{})
+	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+	migration.MustRegisterConfig("feature")
+}
+
+func (c *Configuration) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", c.Metadata.Validate())
+	// Owner field is optional.
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	errs = errors.AppendField(errs, "Admin", c.Admin.Validate())
+	return errs
+}
@@ -0,0 +1,60 @@
+package feature
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// Decorator rejects messages that are gated by a feature that is not active.
+// A message path is gated by a feature with the same name.
+type Decorator struct {
+	gated map[string]struct{}
+}
+
+var _ weave.Decorator = (*Decorator)(nil)
+
+// NewDecorator returns a decorator that allows processing messages with any
+// of the given paths only if a feature named after the path is active.
+// Messages with other paths are not affected.
+func NewDecorator(msgPaths ...string) *Decorator {
+	gated := make(map[string]struct{}, len(msgPaths))
+	for _, p := range msgPaths {
+		gated[p] = struct{}{}
+	}
+	return &Decorator{gated: gated}
+}
+
+func (d *Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	if err := d.allow(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return next.Check(ctx, store, tx)
+}
+
+func (d *Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	if err := d.allow(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return next.Deliver(ctx, store, tx)
+}
+
+func (d *Decorator) allow(ctx weave.Context, store weave.KVStore, tx weave.Tx) error {
+	if len(d.gated) == 0 {
+		return nil
+	}
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return errors.Wrap(err, "cannot get message")
+	}
+	path := msg.Path()
+	if _, ok := d.gated[path]; !ok {
+		return nil
+	}
+	switch active, err := IsActive(ctx, store, path); {
+	case err != nil:
+		return err
+	case !active:
+		return errors.Wrapf(errors.ErrNotFound, "message %q is not enabled", path)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package feature
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestDecorator(t *testing.T) {
+	cases := map[string]struct {
+		Height  int64
+		Path    string
+		WantErr *errors.Error
+	}{
+		"not gated message is allowed": {
+			Height: 1,
+			Path:   "test/other",
+		},
+		"gated message before the activation height": {
+			Height:  99,
+			Path:    "test/gated",
+			WantErr: errors.ErrNotFound,
+		},
+		"gated message at the activation height": {
+			Height: 100,
+			Path:   "test/gated",
+		},
+		"gated message after the activation height": {
+			Height: 101,
+			Path:   "test/gated",
+		},
+		"gated message without a scheduled feature": {
+			Height:  1000,
+			Path:    "test/unscheduled",
+			WantErr: errors.ErrNotFound,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "feature")
+			_, err := NewFeatureBucket().Put(db, []byte("test/gated"), &Feature{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Name:             "test/gated",
+				ActivationHeight: 100,
+			})
+			assert.Nil(t, err)
+
+			d := NewDecorator("test/gated", "test/unscheduled")
+			ctx := weave.WithHeight(context.Background(), tc.Height)
+			tx := &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: tc.Path}}
+
+			handler := &weavetest.Handler{}
+			_, err = d.Check(ctx, db, tx, handler)
+			assert.IsErr(t, tc.WantErr, err)
+			_, err = d.Deliver(ctx, db, tx, handler)
+			assert.IsErr(t, tc.WantErr, err)
+
+			wantCalls := 2
+			if tc.WantErr != nil {
+				wantCalls = 0
+			}
+			assert.Equal(t, wantCalls, handler.CallCount())
+		})
+	}
+}
@@ -0,0 +1,19 @@
+/*
+Package feature implements switches that activate a functionality at a
+configured block height.
+
+New code can be released before it is used by the network. A message handler
+or a behavior change is guarded by a feature and stays inactive until the
+chain reaches the activation height of that feature. This way all nodes can
+upgrade the binary in advance and switch deterministically at the same block.
+
+A feature that was never scheduled is not active. The activation height of a
+feature is set using the ScheduleFeatureMsg that must be signed by the admin
+declared in the "feature" configuration. Usually the admin is the governance
+address, so that features are scheduled by an election.
+
+Handlers can check if a feature is active using the IsActive function. The
+Decorator rejects messages with a gated path until a feature named after the
+message path is active.
+*/
+package feature
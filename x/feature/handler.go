@@ -0,0 +1,88 @@
+package feature
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x"
+)
+
+// RegisterRoutes registers handlers for feature message processing.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
+	r = migration.SchemaMigratingRegistry("feature", r)
+	r.Handle(&ScheduleFeatureMsg{}, &scheduleFeatureHandler{
+		auth:     auth,
+		features: NewFeatureBucket(),
+	})
+	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
+}
+
+type scheduleFeatureHandler struct {
+	auth     x.Authenticator
+	features orm.ModelBucket
+}
+
+func (h *scheduleFeatureHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *scheduleFeatureHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	f := Feature{
+		Metadata:         &weave.Metadata{},
+		Name:             msg.Name,
+		ActivationHeight: msg.ActivationHeight,
+	}
+	if _, err := h.features.Put(db, []byte(f.Name), &f); err != nil {
+		return nil, errors.Wrap(err, "cannot store feature")
+	}
+	return &weave.DeliverResult{Data: []byte(f.Name)}, nil
+}
+
+func (h *scheduleFeatureHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ScheduleFeatureMsg, error) {
+	var msg ScheduleFeatureMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	var conf Configuration
+	if err := gconf.Load(db, "feature", &conf); err != nil {
+		return nil, errors.Wrap(err, "load configuration")
+	}
+	if !h.auth.HasAddress(ctx, conf.Admin) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "feature admin authentication required")
+	}
+
+	height, ok := weave.GetHeight(ctx)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "height not in context")
+	}
+	if msg.ActivationHeight <= height {
+		return nil, errors.Wrapf(errors.ErrInput, "activation height must be greater than the current height %d", height)
+	}
+
+	// Once active, the activation height of a feature cannot be changed.
+	// Otherwise a feature could be deactivated, which can break the state
+	// created when it was active.
+	active, err := IsActive(ctx, db, msg.Name)
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		return nil, errors.Wrapf(errors.ErrState, "feature %q is already active", msg.Name)
+	}
+	return &msg, nil
+}
+
+func NewConfigHandler(auth x.Authenticator) weave.Handler {
+	var conf Configuration
+	return gconf.NewUpdateConfigurationHandler("feature", &conf, auth)
+}
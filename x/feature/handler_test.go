@@ -0,0 +1,149 @@
+package feature
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestScheduleFeatureHandler(t *testing.T) {
+	rt := app.NewRouter()
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	admin := weavetest.NewCondition()
+	RegisterRoutes(rt, auth)
+
+	adminCtx := func() context.Context {
+		ctx := context.WithValue(context.Background(), "auth", []weave.Condition{admin})
+		return weave.WithHeight(ctx, 100)
+	}
+
+	cases := map[string]struct {
+		Ctx            func() context.Context
+		Msg            *ScheduleFeatureMsg
+		WantCheckErr   *errors.Error
+		WantDeliverErr *errors.Error
+		WantHeights    map[string]int64
+	}{
+		"schedule a new feature": {
+			Ctx: adminCtx,
+			Msg: &ScheduleFeatureMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Name:             "test/new",
+				ActivationHeight: 150,
+			},
+			WantHeights: map[string]int64{
+				"test/new":     150,
+				"test/pending": 200,
+				"test/active":  50,
+			},
+		},
+		"reschedule a pending feature": {
+			Ctx: adminCtx,
+			Msg: &ScheduleFeatureMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Name:             "test/pending",
+				ActivationHeight: 101,
+			},
+			WantHeights: map[string]int64{
+				"test/pending": 101,
+			},
+		},
+		"active feature cannot be rescheduled": {
+			Ctx: adminCtx,
+			Msg: &ScheduleFeatureMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Name:             "test/active",
+				ActivationHeight: 300,
+			},
+			WantCheckErr:   errors.ErrState,
+			WantDeliverErr: errors.ErrState,
+			WantHeights: map[string]int64{
+				"test/active": 50,
+			},
+		},
+		"activation height must be in the future": {
+			Ctx: adminCtx,
+			Msg: &ScheduleFeatureMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Name:             "test/new",
+				ActivationHeight: 100,
+			},
+			WantCheckErr:   errors.ErrInput,
+			WantDeliverErr: errors.ErrInput,
+		},
+		"only an admin can schedule a feature": {
+			Ctx: func() context.Context {
+				// No authentication information attached.
+				return weave.WithHeight(context.Background(), 100)
+			},
+			Msg: &ScheduleFeatureMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Name:             "test/new",
+				ActivationHeight: 150,
+			},
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+		},
+		"name must be valid": {
+			Ctx: adminCtx,
+			Msg: &ScheduleFeatureMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				Name:             "",
+				ActivationHeight: 150,
+			},
+			WantCheckErr:   errors.ErrInput,
+			WantDeliverErr: errors.ErrInput,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "feature")
+
+			conf := Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+				Admin:    admin.Address(),
+			}
+			if err := gconf.Save(db, "feature", &conf); err != nil {
+				t.Fatalf("cannot save gconf configuration: %s", err)
+			}
+
+			features := NewFeatureBucket()
+			for name, height := range map[string]int64{"test/pending": 200, "test/active": 50} {
+				_, err := features.Put(db, []byte(name), &Feature{
+					Metadata:         &weave.Metadata{Schema: 1},
+					Name:             name,
+					ActivationHeight: height,
+				})
+				assert.Nil(t, err)
+			}
+
+			tx := &weavetest.Tx{Msg: tc.Msg}
+
+			cache := db.CacheWrap()
+			_, err := rt.Check(tc.Ctx(), cache, tx)
+			assert.IsErr(t, tc.WantCheckErr, err)
+			cache.Discard()
+
+			_, err = rt.Deliver(tc.Ctx(), db, tx)
+			assert.IsErr(t, tc.WantDeliverErr, err)
+
+			for name, height := range tc.WantHeights {
+				var f Feature
+				if err := features.One(db, []byte(name), &f); err != nil {
+					t.Fatalf("cannot fetch %q feature: %s", name, err)
+				}
+				assert.Equal(t, height, f.ActivationHeight)
+			}
+		})
+	}
+}
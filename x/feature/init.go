@@ -0,0 +1,55 @@
+package feature
+
+import (
+	"fmt"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+
+// FromGenesis stores features declared in the genesis and the configuration.
+// Both are optional.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	type feature struct {
+		Name             string `json:"name"`
+		ActivationHeight int64  `json:"activation_height"`
+	}
+	var features []*feature
+	if err := opts.ReadOptions("feature", &features); err != nil {
+		return errors.Wrap(err, "cannot load features")
+	}
+
+	bucket := NewFeatureBucket()
+	for i, f := range features {
+		feature := Feature{
+			Metadata:         &weave.Metadata{Schema: 1},
+			Name:             f.Name,
+			ActivationHeight: f.ActivationHeight,
+		}
+		if err := feature.Validate(); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("feature #%d is invalid", i))
+		}
+		if _, err := bucket.Put(kv, []byte(feature.Name), &feature); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("cannot store #%d feature", i))
+		}
+	}
+
+	var confs weave.Options
+	if err := opts.ReadOptions("conf", &confs); err != nil {
+		return errors.Wrap(err, "read conf")
+	}
+	if confs["feature"] == nil {
+		return nil
+	}
+	if err := migration.InitConfig(kv, opts, "feature", &Configuration{}); err != nil {
+		return errors.Wrap(err, "init config")
+	}
+	return nil
+}
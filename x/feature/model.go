@@ -0,0 +1,71 @@
+package feature
+
+import (
+	"regexp"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
+)
+
+func init() {
+	migration.MustRegister(1, &Feature{}, migration.NoModification)
+}
+
+// isName is the RegExp to ensure a feature name is valid. Message paths are
+// valid names.
+var isName = regexp.MustCompile(`^[a-zA-Z0-9_/\-]{1,128}$`).MatchString
+
+var _ orm.CloneableData = (*Feature)(nil)
+
+func (f *Feature) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", f.Metadata.Validate())
+	errs = errors.AppendField(errs, "Name", validateName(f.Name))
+	if f.ActivationHeight < 1 {
+		errs = errors.AppendField(errs, "ActivationHeight",
+			errors.Wrap(errors.ErrInput, "must be greater than zero"))
+	}
+	return errs
+}
+
+func validateName(name string) error {
+	if !isName(name) {
+		return errors.Wrapf(errors.ErrInput, "invalid name %q", name)
+	}
+	return nil
+}
+
+func (f *Feature) Copy() orm.CloneableData {
+	return &Feature{
+		Metadata:         f.Metadata.Copy(),
+		Name:             f.Name,
+		ActivationHeight: f.ActivationHeight,
+	}
+}
+
+// NewFeatureBucket returns a bucket for keeping track of features. Features
+// are indexed by their names.
+func NewFeatureBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("feature", &Feature{})
+	return migration.NewModelBucket("feature", b)
+}
+
+// IsActive returns true if the feature with given name is active at the
+// current block height. A feature that was never scheduled is not active.
+func IsActive(ctx weave.Context, db weave.ReadOnlyKVStore, name string) (bool, error) {
+	height, ok := weave.GetHeight(ctx)
+	if !ok {
+		return false, errors.Wrap(errors.ErrHuman, "height not in context")
+	}
+	var f Feature
+	switch err := NewFeatureBucket().One(db, []byte(name), &f); {
+	case err == nil:
+		return height >= f.ActivationHeight, nil
+	case errors.ErrNotFound.Is(err):
+		return false, nil
+	default:
+		return false, errors.Wrap(err, "cannot load feature")
+	}
+}
@@ -0,0 +1,52 @@
+package feature
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &ScheduleFeatureMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+}
+
+var _ weave.Msg = (*ScheduleFeatureMsg)(nil)
+
+func (m *ScheduleFeatureMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Name", validateName(m.Name))
+	if m.ActivationHeight < 1 {
+		errs = errors.AppendField(errs, "ActivationHeight",
+			errors.Wrap(errors.ErrInput, "must be greater than zero"))
+	}
+	return errs
+}
+
+func (*ScheduleFeatureMsg) Path() string {
+	return "feature/schedule"
+}
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+// Validate will skip any zero fields and validate the set ones.
+func (m *UpdateConfigurationMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	c := m.Patch
+	if c == nil {
+		return errors.AppendField(errs, "Patch", errors.ErrEmpty)
+	}
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	if len(c.Admin) != 0 {
+		errs = errors.AppendField(errs, "Admin", c.Admin.Validate())
+	}
+	return errs
+}
+
+func (*UpdateConfigurationMsg) Path() string {
+	return "feature/update_configuration"
+}
@@ -0,0 +1,49 @@
+package gas
+
+import (
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	gconf.Register("gas", &Configuration{})
+	migration.MustRegister(1, &Configuration{}, migration.NoModification)
+}
+
+func (c *Configuration) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", c.Metadata.Validate())
+	// Owner field is optional.
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	errs = errors.AppendField(errs, "ReadCost", validateCost(c.ReadCost))
+	errs = errors.AppendField(errs, "ReadByteCost", validateCost(c.ReadByteCost))
+	errs = errors.AppendField(errs, "WriteCost", validateCost(c.WriteCost))
+	errs = errors.AppendField(errs, "WriteByteCost", validateCost(c.WriteByteCost))
+	errs = errors.AppendField(errs, "SignatureCost", validateCost(c.SignatureCost))
+	errs = errors.AppendField(errs, "MaxGas", validateCost(c.MaxGas))
+	return errs
+}
+
+func validateCost(cost int64) error {
+	if cost < 0 {
+		return errors.Wrap(errors.ErrAmount, "must not be negative")
+	}
+	return nil
+}
+
+// loadConfiguration returns the price table or nil if the configuration does
+// not exist.
+func loadConfiguration(db gconf.ReadStore) (*Configuration, error) {
+	var conf Configuration
+	switch err := gconf.Load(db, "gas", &conf); {
+	case err == nil:
+		return &conf, nil
+	case errors.ErrNotFound.Is(err):
+		return nil, nil
+	default:
+		return nil, errors.Wrap(err, "load configuration")
+	}
+}
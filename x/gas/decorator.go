@@ -0,0 +1,110 @@
+package gas
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x/sigs"
+)
+
+// Decorator charges gas for store operations and signature verification
+// performed by the rest of the stack, using the price table from the "gas"
+// configuration. A transaction that uses more gas than the configured limit
+// fails with ErrOutOfGas and all its changes are discarded.
+type Decorator struct{}
+
+var _ weave.Decorator = Decorator{}
+
+// NewDecorator returns a gas metering decorator.
+func NewDecorator() Decorator {
+	return Decorator{}
+}
+
+// Check meters the rest of the stack and adds the charged gas to the
+// allocated gas of the result.
+func (Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	conf, err := loadConfiguration(store)
+	if err != nil {
+		return nil, err
+	}
+	if conf == nil {
+		return next.Check(ctx, store, tx)
+	}
+
+	m := &meter{conf: conf}
+	if err := m.charge(signaturesCost(conf, tx)); err != nil {
+		return nil, err
+	}
+	cache, err := cacheWrap(store)
+	if err != nil {
+		return nil, err
+	}
+	res, err := next.Check(ctx, newMeteringStore(cache, m), tx)
+	if err == nil {
+		// The rest of the stack could ignore a failed store operation.
+		err = m.check()
+	}
+	if err != nil {
+		cache.Discard()
+		return nil, err
+	}
+	if err := cache.Write(); err != nil {
+		return nil, errors.Wrap(err, "write cache")
+	}
+	res.GasAllocated += m.used
+	return res, nil
+}
+
+// Deliver meters the rest of the stack and adds the charged gas to the used
+// gas of the result.
+func (Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	conf, err := loadConfiguration(store)
+	if err != nil {
+		return nil, err
+	}
+	if conf == nil {
+		return next.Deliver(ctx, store, tx)
+	}
+
+	m := &meter{conf: conf}
+	if err := m.charge(signaturesCost(conf, tx)); err != nil {
+		return nil, err
+	}
+	cache, err := cacheWrap(store)
+	if err != nil {
+		return nil, err
+	}
+	res, err := next.Deliver(ctx, newMeteringStore(cache, m), tx)
+	if err == nil {
+		// The rest of the stack could ignore a failed store operation.
+		err = m.check()
+	}
+	if err != nil {
+		cache.Discard()
+		return nil, err
+	}
+	if err := cache.Write(); err != nil {
+		return nil, errors.Wrap(err, "write cache")
+	}
+	res.GasUsed += m.used
+	return res, nil
+}
+
+// cacheWrap returns a cache of the store, so that the changes of a
+// transaction that ran out of gas can be discarded.
+func cacheWrap(store weave.KVStore) (weave.KVCacheWrap, error) {
+	cstore, ok := store.(weave.CacheableKVStore)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "need cachable kvstore")
+	}
+	return cstore.CacheWrap(), nil
+}
+
+// signaturesCost returns the cost of verifying all signatures of the
+// transaction. Unsigned transactions are free.
+func signaturesCost(conf *Configuration, tx weave.Tx) int64 {
+	stx, ok := tx.(sigs.SignedTx)
+	if !ok {
+		return 0
+	}
+	return int64(sigs.CountSignatures(stx.GetSignatures())) * conf.SignatureCost
+}
@@ -0,0 +1,240 @@
+package gas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/sigs"
+)
+
+func TestDecorator(t *testing.T) {
+	conf := &Configuration{
+		Metadata:      &weave.Metadata{Schema: 1},
+		ReadCost:      10,
+		ReadByteCost:  1,
+		WriteCost:     100,
+		WriteByteCost: 2,
+		SignatureCost: 1000,
+	}
+
+	cases := map[string]struct {
+		Conf        *Configuration
+		Tx          weave.Tx
+		Handler     weave.Handler
+		WantErr     *errors.Error
+		WantCharged int64
+	}{
+		"no configuration": {
+			Tx:          &weavetest.Tx{},
+			Handler:     &storeHandler{},
+			WantCharged: 0,
+		},
+		"store operations are charged": {
+			Conf:    conf,
+			Tx:      &weavetest.Tx{},
+			Handler: &storeHandler{},
+			// Set "key"="value": 100 + 2*8
+			// Get "key": 10 + 1*8
+			// Iterate over one entry: 10 + 1*8
+			// Delete "key": 100 + 2*3
+			WantCharged: 116 + 18 + 18 + 106,
+		},
+		"signatures are charged": {
+			Conf:        conf,
+			Tx:          &signedTx{sigs: []*sigs.StdSignature{signature(), signature()}},
+			Handler:     &weavetest.Handler{},
+			WantCharged: 2000,
+		},
+		"gas limit": {
+			Conf:        withMaxGas(conf, 258),
+			Tx:          &weavetest.Tx{},
+			Handler:     &storeHandler{},
+			WantCharged: 258,
+		},
+		"store operations exceed gas limit": {
+			Conf:    withMaxGas(conf, 257),
+			Tx:      &weavetest.Tx{},
+			Handler: &storeHandler{},
+			WantErr: ErrOutOfGas,
+		},
+		"signatures exceed gas limit": {
+			Conf:    withMaxGas(conf, 1999),
+			Tx:      &signedTx{sigs: []*sigs.StdSignature{signature(), signature()}},
+			Handler: &weavetest.Handler{},
+			WantErr: ErrOutOfGas,
+		},
+		"failure": {
+			Conf:    conf,
+			Tx:      &weavetest.Tx{},
+			Handler: &weavetest.Handler{CheckErr: errors.ErrHuman, DeliverErr: errors.ErrHuman},
+			WantErr: errors.ErrHuman,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			if tc.Conf != nil {
+				assert.Nil(t, gconf.Save(db, "gas", tc.Conf))
+			}
+			d := NewDecorator()
+
+			cache := db.CacheWrap()
+			cres, err := d.Check(context.Background(), cache, tc.Tx, tc.Handler)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected check error: %s", err)
+			}
+			if err == nil {
+				assert.Equal(t, tc.WantCharged, cres.GasAllocated)
+			}
+			cache.Discard()
+
+			dres, err := d.Deliver(context.Background(), db, tc.Tx, tc.Handler)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %s", err)
+			}
+			if err == nil {
+				assert.Equal(t, tc.WantCharged, dres.GasUsed)
+			}
+		})
+	}
+}
+
+func TestDecoratorOutOfGasDiscardsChanges(t *testing.T) {
+	db := store.MemStore()
+	conf := &Configuration{
+		Metadata:  &weave.Metadata{Schema: 1},
+		WriteCost: 10,
+		MaxGas:    15,
+	}
+	assert.Nil(t, gconf.Save(db, "gas", conf))
+
+	// The first write is within the limit, the second one is not.
+	handler := &writeHandler{keys: []string{"a", "b"}}
+	d := NewDecorator()
+
+	if _, err := d.Check(context.Background(), db, &weavetest.Tx{}, handler); !ErrOutOfGas.Is(err) {
+		t.Fatalf("unexpected check error: %s", err)
+	}
+	if _, err := d.Deliver(context.Background(), db, &weavetest.Tx{}, handler); !ErrOutOfGas.Is(err) {
+		t.Fatalf("unexpected deliver error: %s", err)
+	}
+	for _, key := range handler.keys {
+		if ok, err := db.Has([]byte(key)); err != nil || ok {
+			t.Fatalf("key %q must not be written: %v", key, err)
+		}
+	}
+}
+
+func TestMeteringStoreCacheWrap(t *testing.T) {
+	m := &meter{conf: &Configuration{WriteCost: 1}}
+	db := newMeteringStore(store.MemStore(), m)
+	cached, ok := db.(weave.CacheableKVStore)
+	if !ok {
+		t.Fatal("metering store must be cacheable")
+	}
+
+	discarded := cached.CacheWrap()
+	assert.Nil(t, discarded.Set([]byte("a"), []byte("1")))
+	discarded.Discard()
+	assert.Equal(t, int64(0), m.used)
+
+	written := cached.CacheWrap()
+	assert.Nil(t, written.Set([]byte("a"), []byte("1")))
+	assert.Nil(t, written.Set([]byte("b"), []byte("2")))
+	assert.Nil(t, written.Write())
+	assert.Equal(t, int64(2), m.used)
+}
+
+// storeHandler sets, reads, iterates and deletes a single entry.
+type storeHandler struct{}
+
+func (h *storeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if err := h.run(db); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *storeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	if err := h.run(db); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *storeHandler) run(db weave.KVStore) error {
+	if err := db.Set([]byte("key"), []byte("value")); err != nil {
+		return err
+	}
+	if _, err := db.Get([]byte("key")); err != nil {
+		return err
+	}
+	it, err := db.Iterator([]byte("key"), []byte("kez"))
+	if err != nil {
+		return err
+	}
+	defer it.Release()
+	for {
+		if _, _, err := it.Next(); err != nil {
+			if errors.ErrIteratorDone.Is(err) {
+				break
+			}
+			return err
+		}
+	}
+	return db.Delete([]byte("key"))
+}
+
+// writeHandler sets all keys, ignoring any failure.
+type writeHandler struct {
+	keys []string
+}
+
+func (h *writeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	h.run(db)
+	return &weave.CheckResult{}, nil
+}
+
+func (h *writeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	h.run(db)
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *writeHandler) run(db weave.KVStore) {
+	for _, key := range h.keys {
+		_ = db.Set([]byte(key), []byte("value"))
+	}
+}
+
+func withMaxGas(conf *Configuration, maxGas int64) *Configuration {
+	c := *conf
+	c.MaxGas = maxGas
+	return &c
+}
+
+type signedTx struct {
+	weavetest.Tx
+	sigs []*sigs.StdSignature
+}
+
+func (tx *signedTx) GetSignBytes() ([]byte, error) {
+	return nil, nil
+}
+
+func (tx *signedTx) GetSignatures() []*sigs.StdSignature {
+	return tx.sigs
+}
+
+func signature() *sigs.StdSignature {
+	return &sigs.StdSignature{
+		Signature: &crypto.Signature{Sig: &crypto.Signature_Ed25519{Ed25519: []byte("sig")}},
+	}
+}
@@ -0,0 +1,25 @@
+/*
+Package gas provides a decorator that charges gas for the work done while
+processing a transaction.
+
+Instead of a fixed gas cost declared by each handler, the gas is computed from
+the operations that a transaction performs. Every store read and write as well
+as every signature verification is charged according to the price table kept
+in the "gas" configuration. The price table can be updated using the
+UpdateConfigurationMsg, so that the cost of the operations can be tuned
+without upgrading the application.
+
+Charged gas is added to the GasAllocated value of the check result and to the
+GasUsed value of the deliver result. If the configuration does not exist,
+no gas is charged.
+
+The configuration can limit the gas a single transaction can use. Once the
+limit is exceeded, every store operation fails, the transaction fails with
+ErrOutOfGas and all changes done by the rest of the stack are discarded.
+
+The decorator should be placed after the authentication and the fee
+decorators, so that the fee is charged for transactions that run out of gas.
+All store operations done by the handler and the decorators after it are
+metered.
+*/
+package gas
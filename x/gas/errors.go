@@ -0,0 +1,9 @@
+package gas
+
+import (
+	"github.com/iov-one/weave/errors"
+)
+
+var (
+	ErrOutOfGas = errors.Register(130, "out of gas")
+)
@@ -0,0 +1,29 @@
+package gas
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+// Initializer fulfils the Initializer interface to load data from the genesis
+// file
+type Initializer struct{}
+
+var _ weave.Initializer = (*Initializer)(nil)
+
+// FromGenesis stores the gas price table. The configuration is optional and
+// no gas is charged if it is not present.
+func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams, kv weave.KVStore) error {
+	var confs weave.Options
+	if err := opts.ReadOptions("conf", &confs); err != nil {
+		return errors.Wrap(err, "read conf")
+	}
+	if confs["gas"] == nil {
+		return nil
+	}
+	if err := migration.InitConfig(kv, opts, "gas", &Configuration{}); err != nil {
+		return errors.Wrap(err, "init config")
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package gas
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestGenesis(t *testing.T) {
+	cases := map[string]struct {
+		Genesis  string
+		WantConf *Configuration
+	}{
+		"configuration is optional": {
+			Genesis:  `{"conf": {}}`,
+			WantConf: nil,
+		},
+		"price table": {
+			Genesis: `{"conf": {"gas": {"read_cost": 1, "write_cost": 2, "signature_cost": 3}}}`,
+			WantConf: &Configuration{
+				Metadata:      &weave.Metadata{Schema: 1},
+				ReadCost:      1,
+				WriteCost:     2,
+				SignatureCost: 3,
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			var opts weave.Options
+			if err := json.Unmarshal([]byte(tc.Genesis), &opts); err != nil {
+				t.Fatalf("cannot unmarshal genesis: %s", err)
+			}
+			db := store.MemStore()
+			migration.MustInitPkg(db, "gas")
+
+			var ini Initializer
+			if err := ini.FromGenesis(opts, weave.GenesisParams{}, db); err != nil {
+				t.Fatalf("cannot load genesis: %s", err)
+			}
+
+			conf, err := loadConfiguration(db)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.WantConf, conf)
+		})
+	}
+}
+
+func TestConfigurationValidate(t *testing.T) {
+	c := Configuration{Metadata: &weave.Metadata{Schema: 1}, WriteCost: -1}
+	if err := c.Validate(); err == nil {
+		t.Fatal("negative cost must not be valid")
+	}
+	c.WriteCost = 0
+	assert.Nil(t, c.Validate())
+	assert.Nil(t, gconf.Save(store.MemStore(), "gas", &c))
+}
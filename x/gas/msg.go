@@ -0,0 +1,36 @@
+package gas
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+)
+
+func init() {
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+}
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+// Validate will skip any zero fields and validate the set ones.
+func (m *UpdateConfigurationMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	c := m.Patch
+	if c == nil {
+		return errors.AppendField(errs, "Patch", errors.ErrEmpty)
+	}
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	errs = errors.AppendField(errs, "ReadCost", validateCost(c.ReadCost))
+	errs = errors.AppendField(errs, "ReadByteCost", validateCost(c.ReadByteCost))
+	errs = errors.AppendField(errs, "WriteCost", validateCost(c.WriteCost))
+	errs = errors.AppendField(errs, "WriteByteCost", validateCost(c.WriteByteCost))
+	errs = errors.AppendField(errs, "SignatureCost", validateCost(c.SignatureCost))
+	return errs
+}
+
+func (*UpdateConfigurationMsg) Path() string {
+	return "gas/update_configuration"
+}
@@ -0,0 +1,238 @@
+package gas
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/x/cash"
+)
+
+// meter sums the gas cost of store operations according to the price table.
+// Once the used gas exceeds the configured limit, every charge fails.
+type meter struct {
+	conf *Configuration
+	used int64
+}
+
+func (m *meter) read(key, value []byte) error {
+	return m.charge(m.conf.ReadCost + m.conf.ReadByteCost*int64(len(key)+len(value)))
+}
+
+func (m *meter) write(key, value []byte) error {
+	return m.charge(m.writeCost(key, value))
+}
+
+func (m *meter) writeCost(key, value []byte) int64 {
+	return m.conf.WriteCost + m.conf.WriteByteCost*int64(len(key)+len(value))
+}
+
+func (m *meter) charge(gas int64) error {
+	m.used += gas
+	return m.check()
+}
+
+// check returns an error if the used gas exceeds the limit.
+func (m *meter) check() error {
+	if m.conf.MaxGas > 0 && m.used > m.conf.MaxGas {
+		return errors.Wrapf(ErrOutOfGas, "used %d, limit %d", m.used, m.conf.MaxGas)
+	}
+	return nil
+}
+
+// newMeteringStore wraps given store and charges all operations using the
+// meter. Returned store supports cache wrapping if the wrapped store does.
+// Writes done using a cache are charged when the cache is written, so
+// discarded changes are free.
+//
+// Coin movements recorded using the returned store are passed to the wrapped
+// store, so that they are tagged by cash.TransferTagger.
+func newMeteringStore(db weave.KVStore, m *meter) weave.KVStore {
+	s := &meteringStore{KVStore: db, meter: m}
+	if _, ok := db.(weave.CacheableKVStore); ok {
+		return &cacheableMeteringStore{meteringStore: s}
+	}
+	return s
+}
+
+type meteringStore struct {
+	weave.KVStore
+	meter *meter
+}
+
+var _ weave.KVStore = (*meteringStore)(nil)
+
+func (s *meteringStore) Get(key []byte) ([]byte, error) {
+	value, err := s.KVStore.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.meter.read(key, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func (s *meteringStore) Has(key []byte) (bool, error) {
+	ok, err := s.KVStore.Has(key)
+	if err != nil {
+		return false, err
+	}
+	if err := s.meter.read(key, nil); err != nil {
+		return false, err
+	}
+	return ok, nil
+}
+
+func (s *meteringStore) Iterator(start, end []byte) (weave.Iterator, error) {
+	it, err := s.KVStore.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &meteringIterator{Iterator: it, meter: s.meter}, nil
+}
+
+func (s *meteringStore) ReverseIterator(start, end []byte) (weave.Iterator, error) {
+	it, err := s.KVStore.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &meteringIterator{Iterator: it, meter: s.meter}, nil
+}
+
+func (s *meteringStore) Set(key, value []byte) error {
+	if err := s.meter.write(key, value); err != nil {
+		return err
+	}
+	return s.KVStore.Set(key, value)
+}
+
+func (s *meteringStore) Delete(key []byte) error {
+	if err := s.meter.write(key, nil); err != nil {
+		return err
+	}
+	return s.KVStore.Delete(key)
+}
+
+// NewBatch makes sure all batch writes are charged.
+func (s *meteringStore) NewBatch() weave.Batch {
+	return &meteringBatch{Batch: s.KVStore.NewBatch(), meter: s.meter}
+}
+
+// RecordTransfer passes the coin movement to the wrapped store.
+func (s *meteringStore) RecordTransfer(src weave.Address, dest weave.Address, amount coin.Coin) {
+	if r, ok := s.KVStore.(cash.TransferRecorder); ok {
+		r.RecordTransfer(src, dest, amount)
+	}
+}
+
+type cacheableMeteringStore struct {
+	*meteringStore
+}
+
+var _ weave.CacheableKVStore = (*cacheableMeteringStore)(nil)
+
+// CacheWrap makes sure that all cached reads and writes are charged. The
+// cache of the wrapped store is used, so that it can drop everything that
+// was recorded using a discarded cache.
+func (s *cacheableMeteringStore) CacheWrap() weave.KVCacheWrap {
+	cache := s.KVStore.(weave.CacheableKVStore).CacheWrap()
+	return &meteringCacheWrap{
+		meteringStore: &meteringStore{KVStore: cache, meter: s.meter},
+		cache:         cache,
+		charge:        s.meter.charge,
+	}
+}
+
+// meteringCacheWrap charges reads immediately and writes when the cache is
+// written.
+type meteringCacheWrap struct {
+	*meteringStore
+	cache weave.KVCacheWrap
+	// charge is called with the cost of all writes when the cache is
+	// written.
+	charge  func(gas int64) error
+	pending int64
+}
+
+var _ weave.KVCacheWrap = (*meteringCacheWrap)(nil)
+
+func (c *meteringCacheWrap) Set(key, value []byte) error {
+	c.pending += c.meter.writeCost(key, value)
+	return c.cache.Set(key, value)
+}
+
+func (c *meteringCacheWrap) Delete(key []byte) error {
+	c.pending += c.meter.writeCost(key, nil)
+	return c.cache.Delete(key)
+}
+
+// NewBatch makes sure all batch writes are charged when the cache is
+// written.
+func (c *meteringCacheWrap) NewBatch() weave.Batch {
+	return store.NewNonAtomicBatch(c)
+}
+
+// CacheWrap returns a nested cache. Writes of the nested cache are charged
+// when this cache is written.
+func (c *meteringCacheWrap) CacheWrap() weave.KVCacheWrap {
+	cache := c.cache.CacheWrap()
+	return &meteringCacheWrap{
+		meteringStore: &meteringStore{KVStore: cache, meter: c.meter},
+		cache:         cache,
+		charge: func(gas int64) error {
+			c.pending += gas
+			return nil
+		},
+	}
+}
+
+// Write charges all writes and flushes the cache.
+func (c *meteringCacheWrap) Write() error {
+	if err := c.charge(c.pending); err != nil {
+		return err
+	}
+	c.pending = 0
+	return c.cache.Write()
+}
+
+// Discard drops the cache. Discarded writes are not charged.
+func (c *meteringCacheWrap) Discard() {
+	c.pending = 0
+	c.cache.Discard()
+}
+
+type meteringIterator struct {
+	weave.Iterator
+	meter *meter
+}
+
+func (it *meteringIterator) Next() ([]byte, []byte, error) {
+	key, value, err := it.Iterator.Next()
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := it.meter.read(key, value); err != nil {
+		return nil, nil, err
+	}
+	return key, value, nil
+}
+
+type meteringBatch struct {
+	weave.Batch
+	meter *meter
+}
+
+func (b *meteringBatch) Set(key, value []byte) error {
+	if err := b.meter.write(key, value); err != nil {
+		return err
+	}
+	return b.Batch.Set(key, value)
+}
+
+func (b *meteringBatch) Delete(key []byte) error {
+	if err := b.meter.write(key, nil); err != nil {
+		return err
+	}
+	return b.Batch.Delete(key)
+}
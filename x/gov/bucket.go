@@ -75,15 +75,21 @@ type ProposalBucket struct {
 }
 
 const (
-	indexNameAuthor       = "author"
-	indexNameElectorateID = "electorate"
+	proposalBucketName = "proposal"
+
+	indexNameAuthor              = "author"
+	indexNameElectorateID        = "electorate"
+	indexNameStatus              = "status"
+	indexNameElectorateProposals = "electorate_proposals"
 )
 
 // NewProposalBucket returns a bucket for managing electorate.
 func NewProposalBucket() *ProposalBucket {
-	b := migration.NewBucket(packageName, "proposal", &Proposal{}).
+	b := migration.NewBucket(packageName, proposalBucketName, &Proposal{}).
 		WithIndex(indexNameAuthor, authorIndexer, false).
-		WithIndex(indexNameElectorateID, proposalElectorateIDIndexer, false)
+		WithIndex(indexNameElectorateID, proposalElectorateIDIndexer, false).
+		WithIndex(indexNameStatus, proposalStatusIndexer, true).
+		WithIndex(indexNameElectorateProposals, electorateProposalsIndexer, true)
 	return &ProposalBucket{
 		IDGenBucket: orm.WithSeqIDGenerator(b, "id"),
 	}
@@ -105,6 +111,34 @@ func proposalElectorateIDIndexer(obj orm.Object) ([]byte, error) {
 	return p.ElectorateRef.ID, nil
 }
 
+// proposalStatusIndexer indexes a proposal by its status. Index key is the
+// status name followed by the proposal ID, so that all proposals with the
+// same status are stored next to each other, ordered by ID.
+func proposalStatusIndexer(obj orm.Object) ([]byte, error) {
+	p, err := asProposal(obj)
+	if err != nil {
+		return nil, err
+	}
+	return pageKey([]byte(statusName(p.Status)), obj.Key()), nil
+}
+
+// electorateProposalsIndexer indexes a proposal by its electorate. Index key
+// is the electorate ID followed by the proposal ID, so that all proposals of
+// an electorate are stored next to each other, ordered by ID.
+func electorateProposalsIndexer(obj orm.Object) ([]byte, error) {
+	p, err := asProposal(obj)
+	if err != nil {
+		return nil, err
+	}
+	return pageKey(p.ElectorateRef.ID, obj.Key()), nil
+}
+
+// statusName returns the name of given status as used by the status index,
+// for example "submitted".
+func statusName(s Proposal_Status) string {
+	return enumName(s.String(), "PROPOSAL_STATUS_")
+}
+
 // GetProposal loads the proposal for the given id. If it does not exist then ErrNotFound is returned.
 func (b *ProposalBucket) GetProposal(db weave.KVStore, id []byte) (*Proposal, error) {
 	obj, err := b.Get(db, id)
@@ -256,3 +290,82 @@ func (b *VoteBucket) GetVote(db weave.KVStore, proposalID []byte, addr weave.Add
 	}
 	return v, nil
 }
+
+const (
+	indexNameDelegationElectorate = "electorate"
+)
+
+// DelegationBucket is the persistence bucket for vote delegations.
+type DelegationBucket struct {
+	orm.Bucket
+}
+
+// NewDelegationBucket returns a bucket for managing vote delegations.
+func NewDelegationBucket() *DelegationBucket {
+	b := migration.NewBucket(packageName, "delegation", &Delegation{}).
+		WithIndex(indexNameDelegationElectorate, delegationElectorateIDIndexer, false)
+	return &DelegationBucket{
+		Bucket: b,
+	}
+}
+
+func delegationElectorateIDIndexer(obj orm.Object) ([]byte, error) {
+	d, err := asDelegation(obj)
+	if err != nil {
+		return nil, err
+	}
+	return d.ElectorateID, nil
+}
+
+func delegationKey(electorateID []byte, delegator weave.Address) []byte {
+	key := make([]byte, 0, len(electorateID)+len(delegator))
+	key = append(key, electorateID...)
+	return append(key, delegator...)
+}
+
+// Build creates the orm object without storing it.
+func (b *DelegationBucket) Build(d Delegation) orm.Object {
+	return orm.NewSimpleObj(delegationKey(d.ElectorateID, d.Delegator), &d)
+}
+
+// GetDelegation loads the delegation of given delegator for the electorate.
+// Returns `errors.ErrNotFound` when not exists.
+func (b *DelegationBucket) GetDelegation(db weave.KVStore, electorateID []byte, delegator weave.Address) (*Delegation, error) {
+	obj, err := b.Get(db, delegationKey(electorateID, delegator))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load delegation")
+	}
+	return asDelegation(obj)
+}
+
+// DeleteDelegation removes the delegation of given delegator for the
+// electorate.
+func (b *DelegationBucket) DeleteDelegation(db weave.KVStore, electorateID []byte, delegator weave.Address) error {
+	return b.Delete(db, delegationKey(electorateID, delegator))
+}
+
+// ElectorateDelegations returns all delegations declared for the electorate.
+func (b *DelegationBucket) ElectorateDelegations(db weave.KVStore, electorateID []byte) ([]*Delegation, error) {
+	objs, err := b.GetIndexed(db, indexNameDelegationElectorate, electorateID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load delegations")
+	}
+	delegations := make([]*Delegation, len(objs))
+	for i, obj := range objs {
+		if delegations[i], err = asDelegation(obj); err != nil {
+			return nil, err
+		}
+	}
+	return delegations, nil
+}
+
+func asDelegation(obj orm.Object) (*Delegation, error) {
+	if obj == nil || obj.Value() == nil {
+		return nil, errors.Wrap(errors.ErrNotFound, "unknown delegation")
+	}
+	d, ok := obj.Value().(*Delegation)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrModel, "invalid type: %T", obj.Value())
+	}
+	return d, nil
+}
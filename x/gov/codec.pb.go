@@ -9,6 +9,7 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_iov_one_weave "github.com/iov-one/weave"
 	weave "github.com/iov-one/weave"
+	coin "github.com/iov-one/weave/coin"
 	orm "github.com/iov-one/weave/orm"
 	io "io"
 	math "math"
@@ -25,14 +26,49 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// WeightCurve defines the function that converts an elector weight into a
+// vote weight during the tally.
+type WeightCurve int32
+
+const (
+	// Linear uses the elector weight as it is. This is the default.
+	WeightCurve_Linear WeightCurve = 0
+	// Quadratic uses the integer square root of the elector weight, as in
+	// quadratic voting.
+	WeightCurve_Quadratic WeightCurve = 1
+	// Capped uses the elector weight but never more than the weight cap.
+	WeightCurve_Capped WeightCurve = 2
+)
+
+var WeightCurve_name = map[int32]string{
+	0: "WEIGHT_CURVE_LINEAR",
+	1: "WEIGHT_CURVE_QUADRATIC",
+	2: "WEIGHT_CURVE_CAPPED",
+}
+
+var WeightCurve_value = map[string]int32{
+	"WEIGHT_CURVE_LINEAR":    0,
+	"WEIGHT_CURVE_QUADRATIC": 1,
+	"WEIGHT_CURVE_CAPPED":    2,
+}
+
+func (x WeightCurve) String() string {
+	return proto.EnumName(WeightCurve_name, int32(x))
+}
+
+func (WeightCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{0}
+}
+
 // VoteOptions define possible values for a vote including the INVALID default.
 type VoteOption int32
 
 const (
-	VoteOption_Invalid VoteOption = 0
-	VoteOption_Yes     VoteOption = 1
-	VoteOption_No      VoteOption = 2
-	VoteOption_Abstain VoteOption = 3
+	VoteOption_Invalid    VoteOption = 0
+	VoteOption_Yes        VoteOption = 1
+	VoteOption_No         VoteOption = 2
+	VoteOption_Abstain    VoteOption = 3
+	VoteOption_NoWithVeto VoteOption = 4
 )
 
 var VoteOption_name = map[int32]string{
@@ -40,13 +76,15 @@ var VoteOption_name = map[int32]string{
 	1: "VOTE_OPTION_YES",
 	2: "VOTE_OPTION_NO",
 	3: "VOTE_OPTION_ABSTAIN",
+	4: "VOTE_OPTION_NO_WITH_VETO",
 }
 
 var VoteOption_value = map[string]int32{
-	"VOTE_OPTION_INVALID": 0,
-	"VOTE_OPTION_YES":     1,
-	"VOTE_OPTION_NO":      2,
-	"VOTE_OPTION_ABSTAIN": 3,
+	"VOTE_OPTION_INVALID":      0,
+	"VOTE_OPTION_YES":          1,
+	"VOTE_OPTION_NO":           2,
+	"VOTE_OPTION_ABSTAIN":      3,
+	"VOTE_OPTION_NO_WITH_VETO": 4,
 }
 
 func (x VoteOption) String() string {
@@ -54,7 +92,7 @@ func (x VoteOption) String() string {
 }
 
 func (VoteOption) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{0}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{1}
 }
 
 type Proposal_Status int32
@@ -341,6 +379,20 @@ type ElectionRule struct {
 	Quorum *Fraction `protobuf:"bytes,8,opt,name=quorum,proto3" json:"quorum,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,9,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Veto threshold when set is the fraction of all cast votes that must be
+	// exceeded by NoWithVeto votes to veto a proposal. A vetoed proposal is
+	// rejected regardless of the Yes votes and its deposit is burned.
+	//
+	// The valid range for the veto threshold value is `0` (exclusive) to `1`
+	// (inclusive). Vetoing is disabled when not set.
+	VetoThreshold *Fraction `protobuf:"bytes,10,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// Weight curve defines how the weight of an elector is converted into the
+	// weight of their vote. The total electorate weight is converted the same
+	// way so that quorum and thresholds remain meaningful.
+	WeightCurve WeightCurve `protobuf:"varint,11,opt,name=weight_curve,json=weightCurve,proto3,enum=gov.WeightCurve" json:"weight_curve,omitempty"`
+	// Weight cap is the maximal vote weight of a single elector. It must be set
+	// for the capped weight curve only.
+	WeightCap uint32 `protobuf:"varint,12,opt,name=weight_cap,json=weightCap,proto3" json:"weight_cap,omitempty"`
 }
 
 func (m *ElectionRule) Reset()         { *m = ElectionRule{} }
@@ -439,6 +491,27 @@ func (m *ElectionRule) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *ElectionRule) GetVetoThreshold() *Fraction {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return nil
+}
+
+func (m *ElectionRule) GetWeightCurve() WeightCurve {
+	if m != nil {
+		return m.WeightCurve
+	}
+	return WeightCurve_Linear
+}
+
+func (m *ElectionRule) GetWeightCap() uint32 {
+	if m != nil {
+		return m.WeightCap
+	}
+	return 0
+}
+
 // The Fraction type represents a numerator and denominator to enable higher precision thresholds in
 // the election rules. For example:
 // numerator: 1, denominator: 2 => > 50%
@@ -514,6 +587,9 @@ type Proposal struct {
 	// ElectionRuleRef is a reference to the election rule
 	ElectionRuleRef orm.VersionedIDRef `protobuf:"bytes,5,opt,name=election_rule_ref,json=electionRuleRef,proto3" json:"election_rule_ref"`
 	// Reference to the electorate to define the group of possible voters.
+	// The reference points to the electorate version that was the latest when
+	// the proposal was created. Electorate versions are immutable, so the
+	// electors and their weights cannot change during the voting period.
 	ElectorateRef orm.VersionedIDRef `protobuf:"bytes,6,opt,name=electorate_ref,json=electorateRef,proto3" json:"electorate_ref"`
 	// Unix timestamp of the block where the voting period starts. Header time of the votes must be greater than or equal
 	// to this start time.
@@ -536,6 +612,16 @@ type Proposal struct {
 	// Tally task ID holds the ID of the asynchronous task that is scheduled to
 	// create the tally once the voting period is over.
 	TallyTaskID []byte `protobuf:"bytes,15,opt,name=tally_task_id,json=tallyTaskId,proto3" json:"tally_task_id,omitempty"`
+	// Deposit is the amount of coins that the author paid when creating the
+	// proposal. It is held by the deposit address of the proposal until the
+	// tally. Deposit is refunded to the author if the quorum was reached and
+	// burned otherwise. Zero if no deposit was required.
+	Deposit coin.Coin `protobuf:"bytes,16,opt,name=deposit,proto3" json:"deposit"`
+	// ExecutionResults holds the outcome of every message executed as the
+	// result of an accepted proposal, in execution order. Messages are executed
+	// atomically, so if any of them fails, changes of all of them are
+	// discarded. Execution stops at the first failure.
+	ExecutionResults []ExecutionResult `protobuf:"bytes,17,rep,name=execution_results,json=executionResults,proto3" json:"execution_results"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
@@ -676,6 +762,86 @@ func (m *Proposal) GetTallyTaskID() []byte {
 	return nil
 }
 
+func (m *Proposal) GetDeposit() coin.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return coin.Coin{}
+}
+
+func (m *Proposal) GetExecutionResults() []ExecutionResult {
+	if m != nil {
+		return m.ExecutionResults
+	}
+	return nil
+}
+
+// ExecutionResult is the outcome of a single message executed as the result of
+// an accepted proposal.
+type ExecutionResult struct {
+	// Successful is true if the message was executed without an error.
+	Successful bool `protobuf:"varint,1,opt,name=successful,proto3" json:"successful,omitempty"`
+	// Data is the data returned by the message handler.
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// Log is the log returned by the message handler or the error message if
+	// the execution failed.
+	Log string `protobuf:"bytes,3,opt,name=log,proto3" json:"log,omitempty"`
+}
+
+func (m *ExecutionResult) Reset()         { *m = ExecutionResult{} }
+func (m *ExecutionResult) String() string { return proto.CompactTextString(m) }
+func (*ExecutionResult) ProtoMessage()    {}
+func (*ExecutionResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{5}
+}
+func (m *ExecutionResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionResult.Merge(m, src)
+}
+func (m *ExecutionResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionResult proto.InternalMessageInfo
+
+func (m *ExecutionResult) GetSuccessful() bool {
+	if m != nil {
+		return m.Successful
+	}
+	return false
+}
+
+func (m *ExecutionResult) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ExecutionResult) GetLog() string {
+	if m != nil {
+		return m.Log
+	}
+	return ""
+}
+
 // Resolution contains TextResolution and an electorate reference.
 type Resolution struct {
 	Metadata      *weave.Metadata    `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
@@ -688,7 +854,7 @@ func (m *Resolution) Reset()         { *m = Resolution{} }
 func (m *Resolution) String() string { return proto.CompactTextString(m) }
 func (*Resolution) ProtoMessage()    {}
 func (*Resolution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{5}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{6}
 }
 func (m *Resolution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -760,13 +926,24 @@ type TallyResult struct {
 	// Threshold is the fraction of Yes votes of a base value that needs to be exceeded to accept the proposal.
 	// The base value is either the total electorate weight or the sum of Yes/No weights when a quorum is defined.
 	Threshold Fraction `protobuf:"bytes,6,opt,name=threshold,proto3" json:"threshold"`
+	// TotalNoWithVeto is the sum of weights of all the voters that vetoed the
+	// proposal. Those votes are counted as No votes as well.
+	TotalNoWithVeto uint64 `protobuf:"varint,7,opt,name=total_no_with_veto,json=totalNoWithVeto,proto3" json:"total_no_with_veto,omitempty"`
+	// VetoThreshold when set is the fraction of all cast votes that must be
+	// exceeded by NoWithVeto votes to veto the proposal.
+	VetoThreshold *Fraction `protobuf:"bytes,8,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// WeightCurve converts elector weights into vote weights. Total electorate
+	// weight is already converted.
+	WeightCurve WeightCurve `protobuf:"varint,9,opt,name=weight_curve,json=weightCurve,proto3,enum=gov.WeightCurve" json:"weight_curve,omitempty"`
+	// WeightCap is the maximal vote weight when the capped curve is used.
+	WeightCap uint32 `protobuf:"varint,10,opt,name=weight_cap,json=weightCap,proto3" json:"weight_cap,omitempty"`
 }
 
 func (m *TallyResult) Reset()         { *m = TallyResult{} }
 func (m *TallyResult) String() string { return proto.CompactTextString(m) }
 func (*TallyResult) ProtoMessage()    {}
 func (*TallyResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{6}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{7}
 }
 func (m *TallyResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -837,6 +1014,34 @@ func (m *TallyResult) GetThreshold() Fraction {
 	return Fraction{}
 }
 
+func (m *TallyResult) GetTotalNoWithVeto() uint64 {
+	if m != nil {
+		return m.TotalNoWithVeto
+	}
+	return 0
+}
+
+func (m *TallyResult) GetVetoThreshold() *Fraction {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return nil
+}
+
+func (m *TallyResult) GetWeightCurve() WeightCurve {
+	if m != nil {
+		return m.WeightCurve
+	}
+	return WeightCurve_Linear
+}
+
+func (m *TallyResult) GetWeightCap() uint32 {
+	if m != nil {
+		return m.WeightCap
+	}
+	return 0
+}
+
 // Vote combines the elector and their voted option to archive them.
 // The proposalID and address is stored within the key.
 type Vote struct {
@@ -851,7 +1056,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{7}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{8}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -926,7 +1131,7 @@ func (m *CreateProposalMsg) Reset()         { *m = CreateProposalMsg{} }
 func (m *CreateProposalMsg) String() string { return proto.CompactTextString(m) }
 func (*CreateProposalMsg) ProtoMessage()    {}
 func (*CreateProposalMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{8}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{9}
 }
 func (m *CreateProposalMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1015,7 +1220,7 @@ func (m *DeleteProposalMsg) Reset()         { *m = DeleteProposalMsg{} }
 func (m *DeleteProposalMsg) String() string { return proto.CompactTextString(m) }
 func (*DeleteProposalMsg) ProtoMessage()    {}
 func (*DeleteProposalMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{9}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{10}
 }
 func (m *DeleteProposalMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,7 +1271,7 @@ type VoteMsg struct {
 	// voter address is an optional field. When not set the main signer will be used as default. The voter address
 	// must be included in the electorate for a valid vote.
 	Voter github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=voter,proto3,casttype=github.com/iov-one/weave.Address" json:"voter,omitempty"`
-	// Option for the vote. Must be Yes, No or Abstain for a valid vote.
+	// Option for the vote. Must be Yes, No, Abstain or NoWithVeto for a valid vote.
 	Selected VoteOption `protobuf:"varint,4,opt,name=selected,proto3,enum=gov.VoteOption" json:"selected,omitempty"`
 }
 
@@ -1074,7 +1279,7 @@ func (m *VoteMsg) Reset()         { *m = VoteMsg{} }
 func (m *VoteMsg) String() string { return proto.CompactTextString(m) }
 func (*VoteMsg) ProtoMessage()    {}
 func (*VoteMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{10}
+	return fileDescriptor_24f6e3c5f1b82a85, []int{11}
 }
 func (m *VoteMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,26 +1336,32 @@ func (m *VoteMsg) GetSelected() VoteOption {
 	return VoteOption_Invalid
 }
 
-// TallyMsg can be sent after the voting period has ended to do the final tally and trigger any state changes.
-// A final tally can be execute only once. A second submission will fail with an invalid state error.
-type TallyMsg struct {
+// Delegation is the declaration of an elector that their weight is to be
+// represented by another address in all elections of the electorate.
+// The delegator and the electorate ID are stored within the key.
+type Delegation struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// ProposalID is UUID of the proposal to close.
-	ProposalID []byte `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	// ElectorateID references the electorate the delegation is declared for.
+	ElectorateID []byte `protobuf:"bytes,2,opt,name=electorate_id,json=electorateId,proto3" json:"electorate_id,omitempty"`
+	// Delegator is the elector whose weight is delegated.
+	Delegator github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=delegator,proto3,casttype=github.com/iov-one/weave.Address" json:"delegator,omitempty"`
+	// Delegatee is the address whose vote is counted for the delegator, if the
+	// delegator did not vote. Delegations are not transitive.
+	Delegatee github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=delegatee,proto3,casttype=github.com/iov-one/weave.Address" json:"delegatee,omitempty"`
 }
 
-func (m *TallyMsg) Reset()         { *m = TallyMsg{} }
-func (m *TallyMsg) String() string { return proto.CompactTextString(m) }
-func (*TallyMsg) ProtoMessage()    {}
-func (*TallyMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{11}
+func (m *Delegation) Reset()         { *m = Delegation{} }
+func (m *Delegation) String() string { return proto.CompactTextString(m) }
+func (*Delegation) ProtoMessage()    {}
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{12}
 }
-func (m *TallyMsg) XXX_Unmarshal(b []byte) error {
+func (m *Delegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TallyMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TallyMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1160,52 +1371,73 @@ func (m *TallyMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TallyMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TallyMsg.Merge(m, src)
+func (m *Delegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegation.Merge(m, src)
 }
-func (m *TallyMsg) XXX_Size() int {
+func (m *Delegation) XXX_Size() int {
 	return m.Size()
 }
-func (m *TallyMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_TallyMsg.DiscardUnknown(m)
+func (m *Delegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TallyMsg proto.InternalMessageInfo
+var xxx_messageInfo_Delegation proto.InternalMessageInfo
 
-func (m *TallyMsg) GetMetadata() *weave.Metadata {
+func (m *Delegation) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *TallyMsg) GetProposalID() []byte {
+func (m *Delegation) GetElectorateID() []byte {
 	if m != nil {
-		return m.ProposalID
+		return m.ElectorateID
 	}
 	return nil
 }
 
-// TextResolutionMsg is only intended to be dispatched internally from election
-// results. It adds a resolution to the list of "approved" resolutions,
-// with a reference to the electorate that approved it
-type CreateTextResolutionMsg struct {
-	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Resolution string          `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
+func (m *Delegation) GetDelegator() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
 }
 
-func (m *CreateTextResolutionMsg) Reset()         { *m = CreateTextResolutionMsg{} }
-func (m *CreateTextResolutionMsg) String() string { return proto.CompactTextString(m) }
-func (*CreateTextResolutionMsg) ProtoMessage()    {}
-func (*CreateTextResolutionMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{12}
+func (m *Delegation) GetDelegatee() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Delegatee
+	}
+	return nil
 }
-func (m *CreateTextResolutionMsg) XXX_Unmarshal(b []byte) error {
+
+// DelegateVoteMsg delegates the voting weight of an elector to another
+// address. The delegatee vote is counted with the delegator weight at tally
+// time, for every proposal the delegator did not vote for. A new delegation
+// replaces the previous one.
+type DelegateVoteMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ElectorateID references the electorate the delegation is declared for.
+	ElectorateID []byte `protobuf:"bytes,2,opt,name=electorate_id,json=electorateId,proto3" json:"electorate_id,omitempty"`
+	// Delegator address is an optional field. When not set the main signer will be used as default. The delegator
+	// must be included in the electorate.
+	Delegator github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=delegator,proto3,casttype=github.com/iov-one/weave.Address" json:"delegator,omitempty"`
+	// Delegatee is the address that the voting weight is delegated to.
+	Delegatee github_com_iov_one_weave.Address `protobuf:"bytes,4,opt,name=delegatee,proto3,casttype=github.com/iov-one/weave.Address" json:"delegatee,omitempty"`
+}
+
+func (m *DelegateVoteMsg) Reset()         { *m = DelegateVoteMsg{} }
+func (m *DelegateVoteMsg) String() string { return proto.CompactTextString(m) }
+func (*DelegateVoteMsg) ProtoMessage()    {}
+func (*DelegateVoteMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{13}
+}
+func (m *DelegateVoteMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CreateTextResolutionMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelegateVoteMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CreateTextResolutionMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelegateVoteMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1215,53 +1447,68 @@ func (m *CreateTextResolutionMsg) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *CreateTextResolutionMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTextResolutionMsg.Merge(m, src)
+func (m *DelegateVoteMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateVoteMsg.Merge(m, src)
 }
-func (m *CreateTextResolutionMsg) XXX_Size() int {
+func (m *DelegateVoteMsg) XXX_Size() int {
 	return m.Size()
 }
-func (m *CreateTextResolutionMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTextResolutionMsg.DiscardUnknown(m)
+func (m *DelegateVoteMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateVoteMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTextResolutionMsg proto.InternalMessageInfo
+var xxx_messageInfo_DelegateVoteMsg proto.InternalMessageInfo
 
-func (m *CreateTextResolutionMsg) GetMetadata() *weave.Metadata {
+func (m *DelegateVoteMsg) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *CreateTextResolutionMsg) GetResolution() string {
+func (m *DelegateVoteMsg) GetElectorateID() []byte {
 	if m != nil {
-		return m.Resolution
+		return m.ElectorateID
 	}
-	return ""
+	return nil
 }
 
-type UpdateElectorateMsg struct {
+func (m *DelegateVoteMsg) GetDelegator() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Delegator
+	}
+	return nil
+}
+
+func (m *DelegateVoteMsg) GetDelegatee() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Delegatee
+	}
+	return nil
+}
+
+// RevokeDelegationMsg deletes the delegation of an elector. The revocation
+// applies to all proposals that are not tallied yet.
+type RevokeDelegationMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// ElectorateID is the reference to the electorate that defines the group of possible voters.
+	// ElectorateID references the electorate the delegation was declared for.
 	ElectorateID []byte `protobuf:"bytes,2,opt,name=electorate_id,json=electorateId,proto3" json:"electorate_id,omitempty"`
-	// DiffElectors contains the changes that should be applied. Adding an address should have a positive weight, removing
-	// with weight=0.
-	DiffElectors []Elector `protobuf:"bytes,3,rep,name=diff_electors,json=diffElectors,proto3" json:"diff_electors"`
+	// Delegator address is an optional field. When not set the main signer will be used as default.
+	Delegator github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=delegator,proto3,casttype=github.com/iov-one/weave.Address" json:"delegator,omitempty"`
 }
 
-func (m *UpdateElectorateMsg) Reset()         { *m = UpdateElectorateMsg{} }
-func (m *UpdateElectorateMsg) String() string { return proto.CompactTextString(m) }
-func (*UpdateElectorateMsg) ProtoMessage()    {}
-func (*UpdateElectorateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{13}
+func (m *RevokeDelegationMsg) Reset()         { *m = RevokeDelegationMsg{} }
+func (m *RevokeDelegationMsg) String() string { return proto.CompactTextString(m) }
+func (*RevokeDelegationMsg) ProtoMessage()    {}
+func (*RevokeDelegationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{14}
 }
-func (m *UpdateElectorateMsg) XXX_Unmarshal(b []byte) error {
+func (m *RevokeDelegationMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateElectorateMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RevokeDelegationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateElectorateMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RevokeDelegationMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1271,73 +1518,59 @@ func (m *UpdateElectorateMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *UpdateElectorateMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateElectorateMsg.Merge(m, src)
+func (m *RevokeDelegationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeDelegationMsg.Merge(m, src)
 }
-func (m *UpdateElectorateMsg) XXX_Size() int {
+func (m *RevokeDelegationMsg) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateElectorateMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateElectorateMsg.DiscardUnknown(m)
+func (m *RevokeDelegationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeDelegationMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateElectorateMsg proto.InternalMessageInfo
+var xxx_messageInfo_RevokeDelegationMsg proto.InternalMessageInfo
 
-func (m *UpdateElectorateMsg) GetMetadata() *weave.Metadata {
+func (m *RevokeDelegationMsg) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *UpdateElectorateMsg) GetElectorateID() []byte {
+func (m *RevokeDelegationMsg) GetElectorateID() []byte {
 	if m != nil {
 		return m.ElectorateID
 	}
 	return nil
 }
 
-func (m *UpdateElectorateMsg) GetDiffElectors() []Elector {
+func (m *RevokeDelegationMsg) GetDelegator() github_com_iov_one_weave.Address {
 	if m != nil {
-		return m.DiffElectors
+		return m.Delegator
 	}
 	return nil
 }
 
-type UpdateElectionRuleMsg struct {
+// TallyMsg can be sent after the voting period has ended to do the final tally and trigger any state changes.
+// A final tally can be execute only once. A second submission will fail with an invalid state error.
+type TallyMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// ElectionRuleID is a reference to the election rule
-	ElectionRuleID []byte `protobuf:"bytes,2,opt,name=election_rule_id,json=electionRuleId,proto3" json:"election_rule_id,omitempty"`
-	// Duration in seconds of how long the voting period will take place.
-	VotingPeriod github_com_iov_one_weave.UnixDuration `protobuf:"varint,3,opt,name=voting_period,json=votingPeriod,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"voting_period,omitempty"`
-	// Threshold is the fraction of all eligible voters, not only the ones who voted. To accept a
-	// proposal this value must be exceeded.
-	// The formula is `(yes*denominator) > (numerator*total_electors_weight)`.
-	// The valid range for the threshold value is `0.5` to `1` (inclusive) which allows any value between half and all
-	// of the eligible voters.
-	Threshold Fraction `protobuf:"bytes,4,opt,name=threshold,proto3" json:"threshold"`
-	// The quorum fraction of eligible voters is based on the total electorate
-	// weight and defines a threshold of votes that must be exceeded before the
-	// acceptance threshold is applied. This value requires any kind of votes
-	// and not only YES.
-	//
-	// The valid range for the threshold value is `0.5` to `1` (inclusive) which
-	// allows any value between half and all of the eligible voters.
-	Quorum *Fraction `protobuf:"bytes,5,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// ProposalID is UUID of the proposal to close.
+	ProposalID []byte `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
 }
 
-func (m *UpdateElectionRuleMsg) Reset()         { *m = UpdateElectionRuleMsg{} }
-func (m *UpdateElectionRuleMsg) String() string { return proto.CompactTextString(m) }
-func (*UpdateElectionRuleMsg) ProtoMessage()    {}
-func (*UpdateElectionRuleMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_24f6e3c5f1b82a85, []int{14}
+func (m *TallyMsg) Reset()         { *m = TallyMsg{} }
+func (m *TallyMsg) String() string { return proto.CompactTextString(m) }
+func (*TallyMsg) ProtoMessage()    {}
+func (*TallyMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{15}
 }
-func (m *UpdateElectionRuleMsg) XXX_Unmarshal(b []byte) error {
+func (m *TallyMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UpdateElectionRuleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TallyMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UpdateElectionRuleMsg.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TallyMsg.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalTo(b)
@@ -1347,538 +1580,744 @@ func (m *UpdateElectionRuleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *UpdateElectionRuleMsg) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateElectionRuleMsg.Merge(m, src)
+func (m *TallyMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TallyMsg.Merge(m, src)
 }
-func (m *UpdateElectionRuleMsg) XXX_Size() int {
+func (m *TallyMsg) XXX_Size() int {
 	return m.Size()
 }
-func (m *UpdateElectionRuleMsg) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateElectionRuleMsg.DiscardUnknown(m)
+func (m *TallyMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TallyMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateElectionRuleMsg proto.InternalMessageInfo
+var xxx_messageInfo_TallyMsg proto.InternalMessageInfo
 
-func (m *UpdateElectionRuleMsg) GetMetadata() *weave.Metadata {
+func (m *TallyMsg) GetMetadata() *weave.Metadata {
 	if m != nil {
 		return m.Metadata
 	}
 	return nil
 }
 
-func (m *UpdateElectionRuleMsg) GetElectionRuleID() []byte {
+func (m *TallyMsg) GetProposalID() []byte {
 	if m != nil {
-		return m.ElectionRuleID
+		return m.ProposalID
 	}
 	return nil
 }
 
-func (m *UpdateElectionRuleMsg) GetVotingPeriod() github_com_iov_one_weave.UnixDuration {
-	if m != nil {
-		return m.VotingPeriod
-	}
-	return 0
+// TextResolutionMsg is only intended to be dispatched internally from election
+// results. It adds a resolution to the list of "approved" resolutions,
+// with a reference to the electorate that approved it
+type CreateTextResolutionMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Resolution string          `protobuf:"bytes,2,opt,name=resolution,proto3" json:"resolution,omitempty"`
 }
 
-func (m *UpdateElectionRuleMsg) GetThreshold() Fraction {
-	if m != nil {
-		return m.Threshold
+func (m *CreateTextResolutionMsg) Reset()         { *m = CreateTextResolutionMsg{} }
+func (m *CreateTextResolutionMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateTextResolutionMsg) ProtoMessage()    {}
+func (*CreateTextResolutionMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{16}
+}
+func (m *CreateTextResolutionMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateTextResolutionMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateTextResolutionMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return Fraction{}
+}
+func (m *CreateTextResolutionMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTextResolutionMsg.Merge(m, src)
+}
+func (m *CreateTextResolutionMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateTextResolutionMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTextResolutionMsg.DiscardUnknown(m)
 }
 
-func (m *UpdateElectionRuleMsg) GetQuorum() *Fraction {
+var xxx_messageInfo_CreateTextResolutionMsg proto.InternalMessageInfo
+
+func (m *CreateTextResolutionMsg) GetMetadata() *weave.Metadata {
 	if m != nil {
-		return m.Quorum
+		return m.Metadata
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("gov.VoteOption", VoteOption_name, VoteOption_value)
-	proto.RegisterEnum("gov.Proposal_Status", Proposal_Status_name, Proposal_Status_value)
-	proto.RegisterEnum("gov.Proposal_Result", Proposal_Result_name, Proposal_Result_value)
-	proto.RegisterEnum("gov.Proposal_ExecutorResult", Proposal_ExecutorResult_name, Proposal_ExecutorResult_value)
-	proto.RegisterType((*Electorate)(nil), "gov.Electorate")
-	proto.RegisterType((*Elector)(nil), "gov.Elector")
-	proto.RegisterType((*ElectionRule)(nil), "gov.ElectionRule")
-	proto.RegisterType((*Fraction)(nil), "gov.Fraction")
-	proto.RegisterType((*Proposal)(nil), "gov.Proposal")
-	proto.RegisterType((*Resolution)(nil), "gov.Resolution")
-	proto.RegisterType((*TallyResult)(nil), "gov.TallyResult")
-	proto.RegisterType((*Vote)(nil), "gov.Vote")
-	proto.RegisterType((*CreateProposalMsg)(nil), "gov.CreateProposalMsg")
-	proto.RegisterType((*DeleteProposalMsg)(nil), "gov.DeleteProposalMsg")
-	proto.RegisterType((*VoteMsg)(nil), "gov.VoteMsg")
-	proto.RegisterType((*TallyMsg)(nil), "gov.TallyMsg")
-	proto.RegisterType((*CreateTextResolutionMsg)(nil), "gov.CreateTextResolutionMsg")
-	proto.RegisterType((*UpdateElectorateMsg)(nil), "gov.UpdateElectorateMsg")
-	proto.RegisterType((*UpdateElectionRuleMsg)(nil), "gov.UpdateElectionRuleMsg")
+func (m *CreateTextResolutionMsg) GetResolution() string {
+	if m != nil {
+		return m.Resolution
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("x/gov/codec.proto", fileDescriptor_24f6e3c5f1b82a85) }
-
-var fileDescriptor_24f6e3c5f1b82a85 = []byte{
-	// 1547 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6e, 0xe3, 0xc8,
-	0x11, 0x36, 0x25, 0x59, 0x3f, 0xa5, 0x5f, 0xf7, 0xcc, 0xee, 0x68, 0xb5, 0x13, 0x8b, 0x61, 0x66,
-	0x02, 0x67, 0x33, 0x91, 0xb3, 0x5e, 0x6c, 0x02, 0x04, 0x8b, 0x20, 0xfa, 0xe1, 0x20, 0x5c, 0x78,
-	0x24, 0xa7, 0x49, 0xd9, 0xd9, 0x13, 0x41, 0x8b, 0x6d, 0x99, 0x59, 0x89, 0xed, 0x25, 0x9b, 0xb2,
-	0xf7, 0x98, 0x5b, 0x60, 0x20, 0x40, 0x90, 0xbb, 0x1f, 0x20, 0x48, 0x4e, 0x79, 0x8a, 0x39, 0x04,
-	0xc1, 0x1c, 0x93, 0x8b, 0x10, 0x78, 0xde, 0x62, 0x90, 0x43, 0xc0, 0x6e, 0x4a, 0xa2, 0x6d, 0x8d,
-	0x62, 0x25, 0xbb, 0xc0, 0xdc, 0xc4, 0xea, 0xaf, 0xaa, 0xab, 0xab, 0xbe, 0xae, 0xaa, 0x16, 0x6c,
-	0x5d, 0xec, 0x0e, 0xe9, 0x64, 0x77, 0x40, 0x6d, 0x32, 0x68, 0x9c, 0x79, 0x94, 0x51, 0x94, 0x1c,
-	0xd2, 0x49, 0x2d, 0x1f, 0x93, 0xd4, 0x1e, 0x0e, 0xe9, 0x90, 0xf2, 0x9f, 0xbb, 0xe1, 0xaf, 0x48,
-	0x5a, 0xa6, 0xde, 0x38, 0xae, 0xa8, 0xfc, 0x3e, 0x01, 0xa0, 0x8e, 0xc8, 0x80, 0x51, 0xcf, 0x62,
-	0x04, 0xfd, 0x10, 0xb2, 0x63, 0xc2, 0x2c, 0xdb, 0x62, 0x56, 0x55, 0x92, 0xa5, 0x9d, 0xfc, 0x5e,
-	0xb9, 0x71, 0x4e, 0xac, 0x09, 0x69, 0xbc, 0x88, 0xc4, 0x78, 0x0e, 0x40, 0x55, 0xc8, 0x4c, 0x88,
-	0xe7, 0x3b, 0xd4, 0xad, 0x26, 0x64, 0x69, 0xa7, 0x88, 0x67, 0x9f, 0xe8, 0x67, 0xb0, 0x69, 0xd9,
-	0x63, 0xc7, 0xad, 0x26, 0x65, 0x69, 0xa7, 0xd0, 0x7a, 0xf2, 0x66, 0x5a, 0x97, 0x87, 0x0e, 0x3b,
-	0x0d, 0x8e, 0x1b, 0x03, 0x3a, 0xde, 0x75, 0xe8, 0xe4, 0x47, 0xd4, 0x25, 0xbb, 0xc2, 0x72, 0xd3,
-	0xb6, 0x3d, 0xe2, 0xfb, 0x58, 0xa8, 0xa0, 0x87, 0xb0, 0xc9, 0x1c, 0x36, 0x22, 0xd5, 0x94, 0x2c,
-	0xed, 0xe4, 0xb0, 0xf8, 0x40, 0x0d, 0xc8, 0x12, 0xe1, 0xa6, 0x5f, 0xdd, 0x94, 0x93, 0x3b, 0xf9,
-	0xbd, 0x42, 0x63, 0x48, 0x27, 0x8d, 0xc8, 0xf7, 0x56, 0xea, 0xe5, 0xb4, 0xbe, 0x81, 0xe7, 0x18,
-	0xf4, 0x13, 0x78, 0xc4, 0x28, 0xb3, 0x46, 0x26, 0x99, 0x1f, 0xce, 0x3c, 0x27, 0xce, 0xf0, 0x94,
-	0x55, 0xd3, 0xb2, 0xb4, 0x93, 0xc2, 0xef, 0xf1, 0xe5, 0xc5, 0xd1, 0x8f, 0xf8, 0xa2, 0x62, 0x41,
-	0x26, 0x92, 0xa1, 0x9f, 0x43, 0xc6, 0x12, 0xae, 0xf1, 0x50, 0xdc, 0xf7, 0x18, 0x33, 0x25, 0xf4,
-	0x3e, 0xa4, 0xa3, 0x1d, 0x45, 0x74, 0xa2, 0x2f, 0xe5, 0x65, 0x12, 0x0a, 0x7c, 0x0f, 0x87, 0xba,
-	0x38, 0x18, 0xbd, 0x13, 0x41, 0xff, 0x14, 0x8a, 0xb1, 0x40, 0x39, 0x36, 0x0f, 0x7e, 0xa1, 0x55,
-	0xb9, 0x9e, 0xd6, 0x0b, 0x8b, 0x18, 0x69, 0x1d, 0x5c, 0x58, 0xc0, 0x34, 0x7b, 0x91, 0xab, 0xcd,
-	0x78, 0xae, 0xba, 0x50, 0x9c, 0x50, 0xe6, 0xb8, 0x43, 0xf3, 0x8c, 0x78, 0x0e, 0xb5, 0x79, 0xc4,
-	0x8b, 0xad, 0x1f, 0xbc, 0x99, 0xd6, 0x9f, 0xbe, 0xd5, 0xa1, 0xbe, 0xeb, 0x5c, 0x74, 0x02, 0xcf,
-	0xe2, 0x51, 0x29, 0x08, 0xfd, 0x03, 0xae, 0x8e, 0x3e, 0x86, 0x1c, 0x3b, 0xf5, 0x88, 0x7f, 0x4a,
-	0x47, 0x76, 0x35, 0xc3, 0x03, 0x54, 0xe4, 0xc9, 0x7f, 0xee, 0x59, 0x3c, 0x8a, 0x51, 0xf6, 0x17,
-	0x28, 0xf4, 0x14, 0xd2, 0x5f, 0x05, 0xd4, 0x0b, 0xc6, 0xd5, 0xec, 0x12, 0x3c, 0x8e, 0x16, 0xe3,
-	0x29, 0xce, 0xfd, 0x0f, 0x29, 0x56, 0x3e, 0x87, 0xec, 0xcc, 0x26, 0x7a, 0x0c, 0x39, 0x37, 0x18,
-	0x13, 0xcf, 0x62, 0xd4, 0xe3, 0x69, 0x2c, 0xe2, 0x85, 0x00, 0xc9, 0x90, 0xb7, 0x89, 0x4b, 0xc7,
-	0x8e, 0xcb, 0xd7, 0x45, 0xea, 0xe2, 0x22, 0xe5, 0xb7, 0x79, 0xc8, 0x1e, 0x78, 0xf4, 0x8c, 0xfa,
-	0xd6, 0x68, 0x3d, 0x4a, 0xcc, 0xb3, 0x90, 0x88, 0x67, 0xe1, 0x3b, 0x00, 0x9e, 0x75, 0x6e, 0xd2,
-	0xb3, 0xd0, 0x3b, 0xc1, 0x09, 0x9c, 0xf3, 0xac, 0xf3, 0x1e, 0x17, 0x08, 0x87, 0xfc, 0x81, 0xe7,
-	0x88, 0x75, 0x71, 0xd9, 0xe2, 0x22, 0xa4, 0xc2, 0x16, 0x89, 0x68, 0x6a, 0x7a, 0xc1, 0x88, 0x98,
-	0x1e, 0x39, 0xe1, 0x89, 0xce, 0xef, 0x3d, 0x68, 0x50, 0x6f, 0xdc, 0x38, 0x14, 0xc4, 0x23, 0xb6,
-	0xd6, 0xc1, 0xe4, 0x24, 0x4a, 0x42, 0x99, 0xc4, 0xa8, 0x8d, 0xc9, 0x09, 0xfa, 0x05, 0x94, 0x62,
-	0xd4, 0x0a, 0x6d, 0xa4, 0xff, 0x9b, 0x8d, 0x18, 0x17, 0x43, 0x0b, 0xbf, 0x82, 0xad, 0x88, 0x4f,
-	0x3e, 0xb3, 0x3c, 0x66, 0x32, 0x67, 0x4c, 0x38, 0x0f, 0x92, 0xad, 0xa7, 0x6f, 0xa6, 0xf5, 0xef,
-	0xae, 0xe4, 0x94, 0xe1, 0x8c, 0x09, 0x2e, 0x0b, 0x7d, 0x3d, 0x54, 0x0f, 0x05, 0xe8, 0x05, 0x44,
-	0x22, 0x93, 0xb8, 0xb6, 0x30, 0x98, 0x5d, 0xc7, 0x60, 0x44, 0x70, 0xd5, 0xb5, 0xb9, 0xb9, 0x2e,
-	0x94, 0xfd, 0xe0, 0x78, 0xec, 0xf8, 0xe1, 0x59, 0x84, 0xb9, 0xdc, 0x3a, 0xe6, 0x4a, 0x0b, 0x6d,
-	0x6e, 0xef, 0x33, 0x48, 0x5b, 0x01, 0x3b, 0xa5, 0x5e, 0x15, 0xd6, 0xa0, 0x65, 0xa4, 0x83, 0x3e,
-	0x05, 0x98, 0x50, 0x46, 0xc2, 0x68, 0x31, 0x52, 0xcd, 0xf3, 0x68, 0x57, 0xf8, 0x05, 0x30, 0xac,
-	0xd1, 0xe8, 0x6b, 0x4c, 0xfc, 0x60, 0xc4, 0x66, 0x77, 0x26, 0x44, 0xea, 0x21, 0x10, 0x3d, 0x83,
-	0x74, 0xa8, 0x11, 0xf8, 0xd5, 0x82, 0x2c, 0xed, 0x94, 0xf6, 0x1e, 0x72, 0x95, 0x19, 0x25, 0x1b,
-	0x3a, 0x5f, 0xc3, 0x11, 0x26, 0x44, 0x7b, 0xdc, 0x50, 0xb5, 0xb8, 0x0c, 0x2d, 0x36, 0xc1, 0x11,
-	0x06, 0xa9, 0x50, 0x26, 0x17, 0x64, 0x10, 0x30, 0xea, 0x99, 0x91, 0x5a, 0x89, 0xab, 0x3d, 0xbe,
-	0xa9, 0xa6, 0x46, 0xa0, 0x48, 0xbd, 0x44, 0x6e, 0x7c, 0xa3, 0x4f, 0xa0, 0xc8, 0xc2, 0x23, 0x98,
-	0xcc, 0xf2, 0xbf, 0x0c, 0xcb, 0x54, 0x99, 0x87, 0xa7, 0x7c, 0x3d, 0xad, 0xe7, 0xf9, 0xd9, 0x0c,
-	0xcb, 0xff, 0x52, 0xeb, 0xe0, 0x3c, 0x9b, 0x7f, 0xd8, 0xca, 0x9f, 0x24, 0x48, 0x0b, 0xe7, 0xd1,
-	0x87, 0xf0, 0xe8, 0x00, 0xf7, 0x0e, 0x7a, 0x7a, 0x73, 0xdf, 0xd4, 0x8d, 0xa6, 0xd1, 0xd7, 0x4d,
-	0xad, 0x7b, 0xd8, 0xdc, 0xd7, 0x3a, 0x95, 0x0d, 0xf4, 0x0c, 0x3e, 0xb8, 0xbd, 0xa8, 0xf7, 0x5b,
-	0x2f, 0x34, 0xc3, 0x50, 0x3b, 0x15, 0xa9, 0x56, 0xbc, 0xbc, 0x92, 0x73, 0x7a, 0x98, 0x27, 0xc6,
-	0x88, 0x8d, 0xbe, 0x0f, 0xef, 0xdf, 0x46, 0xb7, 0xf7, 0x7b, 0xba, 0xda, 0xa9, 0x24, 0x6a, 0x70,
-	0x79, 0x25, 0xa7, 0xdb, 0x23, 0xea, 0x13, 0x7b, 0x99, 0xd5, 0x23, 0xcd, 0xf8, 0x65, 0x07, 0x37,
-	0x8f, 0xba, 0x95, 0xa4, 0xb0, 0x7a, 0xe4, 0xb0, 0x53, 0xdb, 0xb3, 0xce, 0x5d, 0xe5, 0xcf, 0x12,
-	0xa4, 0xa3, 0xb3, 0xc6, 0x7d, 0xc5, 0xaa, 0xde, 0xdf, 0x37, 0xde, 0xe2, 0x6b, 0xb4, 0xd8, 0xef,
-	0x76, 0xd4, 0xe7, 0x5a, 0x77, 0xe1, 0x6b, 0xdf, 0xb5, 0xc9, 0x89, 0xe3, 0x12, 0x1b, 0x7d, 0x04,
-	0xd5, 0xdb, 0xe8, 0x66, 0xbb, 0xad, 0x1e, 0x18, 0xdc, 0xdb, 0xc2, 0xe5, 0x95, 0x9c, 0x6d, 0x0e,
-	0x06, 0xe4, 0x8c, 0x2d, 0xc7, 0x62, 0xf5, 0x73, 0xb5, 0x1d, 0x62, 0x93, 0x02, 0x8b, 0xc9, 0x6f,
-	0xc8, 0x80, 0x11, 0x5b, 0xf9, 0xbb, 0x04, 0xa5, 0x9b, 0x19, 0x43, 0x4f, 0x40, 0x9e, 0xab, 0xab,
-	0xbf, 0x56, 0xdb, 0x7d, 0xa3, 0x87, 0xef, 0xba, 0xff, 0xe3, 0x15, 0xa8, 0x6e, 0xcf, 0x30, 0x71,
-	0xbf, 0x5b, 0x91, 0x44, 0x18, 0xbb, 0x94, 0xe1, 0xc0, 0x45, 0x1f, 0xaf, 0xd0, 0xd0, 0xfb, 0xed,
-	0xb6, 0xaa, 0xeb, 0x95, 0x44, 0x2d, 0x7f, 0x79, 0x25, 0x67, 0xf4, 0x60, 0x30, 0x08, 0xfb, 0xef,
-	0x2a, 0x95, 0xe7, 0x4d, 0x6d, 0xbf, 0x8f, 0xd5, 0x4a, 0x52, 0xa8, 0x3c, 0xb7, 0x9c, 0x51, 0xe0,
-	0x11, 0xe5, 0x6f, 0x12, 0x00, 0x26, 0x3e, 0x1d, 0x05, 0xbc, 0x02, 0xae, 0x55, 0x85, 0x77, 0x21,
-	0x7f, 0x16, 0xd1, 0x38, 0x64, 0x66, 0x82, 0x33, 0xb3, 0x74, 0x3d, 0xad, 0xc3, 0x8c, 0xdd, 0x5a,
-	0x07, 0xc3, 0x0c, 0xa2, 0xd9, 0x4b, 0x0a, 0x63, 0x72, 0xcd, 0xc2, 0xb8, 0x0d, 0xe0, 0xcd, 0xbd,
-	0x8d, 0x4a, 0x78, 0x4c, 0xa2, 0xfc, 0x5b, 0x82, 0x7c, 0xec, 0xca, 0xa3, 0x0f, 0x21, 0x27, 0x86,
-	0xa2, 0xaf, 0x89, 0x98, 0x69, 0x52, 0x38, 0xcb, 0x05, 0x5f, 0x10, 0x1f, 0x7d, 0x00, 0xe2, 0xb7,
-	0xe9, 0x52, 0xee, 0x7c, 0x0a, 0x67, 0xf8, 0x77, 0x97, 0xa2, 0xef, 0x41, 0x51, 0x2c, 0x59, 0xc7,
-	0x3e, 0xb3, 0xa2, 0x09, 0x23, 0x85, 0x0b, 0x5c, 0xd8, 0x14, 0xb2, 0x55, 0x13, 0x57, 0x6a, 0xc5,
-	0xc4, 0x15, 0x6b, 0xd5, 0x9b, 0xab, 0x5a, 0xf5, 0x8d, 0x21, 0x20, 0x7d, 0x9f, 0x21, 0x40, 0xf9,
-	0x9d, 0x04, 0xa9, 0x43, 0xba, 0xee, 0x54, 0xfb, 0x0c, 0x32, 0xd1, 0x09, 0x78, 0x18, 0x96, 0x0f,
-	0x9a, 0x33, 0x08, 0x7a, 0x0a, 0x9b, 0x61, 0x05, 0xb5, 0x79, 0x48, 0x4a, 0x7b, 0x65, 0x8e, 0x0d,
-	0x37, 0x15, 0x6d, 0x16, 0x8b, 0x55, 0xe5, 0x9f, 0x09, 0xd8, 0x6a, 0x7b, 0xc4, 0x62, 0x64, 0x46,
-	0x86, 0x17, 0xfe, 0xf0, 0x9d, 0xe8, 0xf2, 0x9f, 0x41, 0xe5, 0x66, 0x97, 0x77, 0x6c, 0x9e, 0x88,
-	0x42, 0x0b, 0x5d, 0x4f, 0xeb, 0xa5, 0xf8, 0xa0, 0xaa, 0x75, 0x70, 0x29, 0xde, 0xdd, 0x35, 0x1b,
-	0x75, 0x00, 0x62, 0x3d, 0x39, 0xbd, 0x4e, 0xcf, 0xcb, 0xf9, 0xf3, 0x6e, 0xbc, 0x68, 0x77, 0x99,
-	0xf5, 0xdb, 0x9d, 0xf2, 0x15, 0x6c, 0x75, 0xc8, 0x88, 0xfc, 0x1f, 0xa1, 0x5d, 0xf7, 0xea, 0x2a,
-	0xaf, 0x24, 0xc8, 0x84, 0x49, 0xfe, 0xd6, 0x77, 0x0a, 0x87, 0xfa, 0x90, 0x41, 0xde, 0x7a, 0x43,
-	0x3d, 0x57, 0x09, 0x3d, 0xf3, 0x79, 0xbe, 0x88, 0x98, 0xe7, 0x97, 0xd0, 0x73, 0x0e, 0x50, 0x4e,
-	0x21, 0xcb, 0x4b, 0xc5, 0xb7, 0x1f, 0xbc, 0x13, 0x78, 0x24, 0xae, 0x82, 0x41, 0x2e, 0xd8, 0xa2,
-	0xda, 0xae, 0xbd, 0xf1, 0xcd, 0xea, 0x97, 0xb8, 0x53, 0xfd, 0xfe, 0x2a, 0xc1, 0x83, 0xfe, 0x99,
-	0x6d, 0x31, 0xb2, 0xa8, 0x39, 0x6b, 0x6f, 0x72, 0xe7, 0x61, 0x94, 0xb8, 0xd7, 0xc3, 0xe8, 0xa7,
-	0x50, 0xb4, 0x9d, 0x93, 0x13, 0x73, 0xfe, 0x66, 0x4d, 0xbe, 0xf5, 0xcd, 0x5a, 0x08, 0x81, 0x91,
-	0xc8, 0x57, 0xfe, 0x92, 0x80, 0xf7, 0x62, 0x4e, 0x47, 0x37, 0x6d, 0x6d, 0xb7, 0x97, 0xdd, 0xea,
-	0xc4, 0xbd, 0x6f, 0xf5, 0x9d, 0x07, 0x5c, 0xf2, 0x1b, 0x7c, 0xc0, 0xa5, 0xd6, 0x7c, 0xc0, 0xad,
-	0xea, 0x0a, 0x1f, 0xfd, 0x51, 0x02, 0x58, 0xd0, 0x19, 0x3d, 0x81, 0x07, 0x87, 0x3d, 0x43, 0x35,
-	0x7b, 0x07, 0x86, 0xd6, 0xeb, 0x2e, 0x06, 0x0e, 0xd1, 0xe5, 0x35, 0x77, 0x62, 0x8d, 0x1c, 0x1b,
-	0x3d, 0x86, 0x72, 0x1c, 0xf5, 0x85, 0xaa, 0x57, 0xa4, 0x5a, 0xe6, 0xf2, 0x4a, 0x4e, 0x86, 0x7d,
-	0xb0, 0x06, 0xa5, 0xf8, 0x6a, 0xb7, 0x57, 0x49, 0xd4, 0xd2, 0x97, 0x57, 0x72, 0xa2, 0x4b, 0x6f,
-	0xdb, 0x6f, 0xb6, 0x74, 0xa3, 0xa9, 0x75, 0x67, 0x53, 0x44, 0xd4, 0x09, 0x5b, 0xd5, 0x97, 0xd7,
-	0xdb, 0xd2, 0xab, 0xeb, 0x6d, 0xe9, 0x5f, 0xd7, 0xdb, 0xd2, 0x1f, 0x5e, 0x6f, 0x6f, 0xbc, 0x7a,
-	0xbd, 0xbd, 0xf1, 0x8f, 0xd7, 0xdb, 0x1b, 0xc7, 0x69, 0xfe, 0xa7, 0xcb, 0x27, 0xff, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0x57, 0xd4, 0x04, 0x1d, 0xc2, 0x11, 0x00, 0x00,
+// ChangeParametersMsg is only intended to be dispatched internally from
+// election results. It updates configurations of extensions. Each
+// configuration must be owned by the election rule that accepted the
+// proposal.
+type ChangeParametersMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Changes are applied in order. All or none of them are applied.
+	Changes []ParameterChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes"`
 }
 
-func (m *Electorate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ChangeParametersMsg) Reset()         { *m = ChangeParametersMsg{} }
+func (m *ChangeParametersMsg) String() string { return proto.CompactTextString(m) }
+func (*ChangeParametersMsg) ProtoMessage()    {}
+func (*ChangeParametersMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{17}
 }
-
-func (m *Electorate) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+func (m *ChangeParametersMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeParametersMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeParametersMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n1
-	}
-	if m.Version != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Version))
+		return b[:n], nil
 	}
-	if len(m.Admin) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Admin)))
-		i += copy(dAtA[i:], m.Admin)
+}
+func (m *ChangeParametersMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeParametersMsg.Merge(m, src)
+}
+func (m *ChangeParametersMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeParametersMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeParametersMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeParametersMsg proto.InternalMessageInfo
+
+func (m *ChangeParametersMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.Title) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
-		i += copy(dAtA[i:], m.Title)
+	return nil
+}
+
+func (m *ChangeParametersMsg) GetChanges() []ParameterChange {
+	if m != nil {
+		return m.Changes
 	}
-	if len(m.Electors) > 0 {
-		for _, msg := range m.Electors {
-			dAtA[i] = 0x2a
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+	return nil
+}
+
+// ParameterChange is an update of a single extension configuration.
+type ParameterChange struct {
+	// Package is the name of the extension that the configuration belongs to.
+	Package string `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	// Patch is the protobuf serialized configuration of the extension. Only
+	// non zero fields are updated.
+	Patch []byte `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+}
+
+func (m *ParameterChange) Reset()         { *m = ParameterChange{} }
+func (m *ParameterChange) String() string { return proto.CompactTextString(m) }
+func (*ParameterChange) ProtoMessage()    {}
+func (*ParameterChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{18}
+}
+func (m *ParameterChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParameterChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParameterChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TotalElectorateWeight != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.TotalElectorateWeight))
-	}
-	return i, nil
+}
+func (m *ParameterChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParameterChange.Merge(m, src)
+}
+func (m *ParameterChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParameterChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParameterChange.DiscardUnknown(m)
 }
 
-func (m *Elector) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ParameterChange proto.InternalMessageInfo
+
+func (m *ParameterChange) GetPackage() string {
+	if m != nil {
+		return m.Package
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Elector) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
-		i += copy(dAtA[i:], m.Address)
-	}
-	if m.Weight != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Weight))
+func (m *ParameterChange) GetPatch() []byte {
+	if m != nil {
+		return m.Patch
 	}
-	return i, nil
+	return nil
 }
 
-func (m *ElectionRule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// TextProposalMsg is a signaling only proposal option. The proposal title and
+// description summarize the proposal, while the full document is referenced
+// by its hash or URL. Text proposals are never executed and an accepted text
+// proposal has no effect other than the recorded election result.
+type TextProposalMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// DocumentHash is the hash of the full proposal document.
+	DocumentHash []byte `protobuf:"bytes,2,opt,name=document_hash,json=documentHash,proto3" json:"document_hash,omitempty"`
+	// DocumentURL is the location of the full proposal document.
+	DocumentURL string `protobuf:"bytes,3,opt,name=document_url,json=documentUrl,proto3" json:"document_url,omitempty"`
 }
 
-func (m *ElectionRule) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+func (m *TextProposalMsg) Reset()         { *m = TextProposalMsg{} }
+func (m *TextProposalMsg) String() string { return proto.CompactTextString(m) }
+func (*TextProposalMsg) ProtoMessage()    {}
+func (*TextProposalMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{19}
+}
+func (m *TextProposalMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextProposalMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextProposalMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n2
+		return b[:n], nil
 	}
-	if m.Version != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Version))
+}
+func (m *TextProposalMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextProposalMsg.Merge(m, src)
+}
+func (m *TextProposalMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextProposalMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextProposalMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextProposalMsg proto.InternalMessageInfo
+
+func (m *TextProposalMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.Admin) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Admin)))
-		i += copy(dAtA[i:], m.Admin)
+	return nil
+}
+
+func (m *TextProposalMsg) GetDocumentHash() []byte {
+	if m != nil {
+		return m.DocumentHash
 	}
-	if len(m.ElectorateID) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectorateID)))
-		i += copy(dAtA[i:], m.ElectorateID)
-	}
-	if len(m.Title) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
-		i += copy(dAtA[i:], m.Title)
-	}
-	if m.VotingPeriod != 0 {
-		dAtA[i] = 0x30
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.VotingPeriod))
-	}
-	dAtA[i] = 0x3a
-	i++
-	i = encodeVarintCodec(dAtA, i, uint64(m.Threshold.Size()))
-	n3, err := m.Threshold.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return nil
+}
+
+func (m *TextProposalMsg) GetDocumentURL() string {
+	if m != nil {
+		return m.DocumentURL
 	}
-	i += n3
-	if m.Quorum != nil {
-		dAtA[i] = 0x42
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Quorum.Size()))
-		n4, err := m.Quorum.MarshalTo(dAtA[i:])
+	return ""
+}
+
+type UpdateElectorateMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ElectorateID is the reference to the electorate that defines the group of possible voters.
+	ElectorateID []byte `protobuf:"bytes,2,opt,name=electorate_id,json=electorateId,proto3" json:"electorate_id,omitempty"`
+	// DiffElectors contains the changes that should be applied. Adding an address should have a positive weight, removing
+	// with weight=0.
+	DiffElectors []Elector `protobuf:"bytes,3,rep,name=diff_electors,json=diffElectors,proto3" json:"diff_electors"`
+}
+
+func (m *UpdateElectorateMsg) Reset()         { *m = UpdateElectorateMsg{} }
+func (m *UpdateElectorateMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateElectorateMsg) ProtoMessage()    {}
+func (*UpdateElectorateMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{20}
+}
+func (m *UpdateElectorateMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateElectorateMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateElectorateMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n4
-	}
-	if len(m.Address) > 0 {
-		dAtA[i] = 0x4a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
-		i += copy(dAtA[i:], m.Address)
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *UpdateElectorateMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateElectorateMsg.Merge(m, src)
+}
+func (m *UpdateElectorateMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateElectorateMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateElectorateMsg.DiscardUnknown(m)
 }
 
-func (m *Fraction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UpdateElectorateMsg proto.InternalMessageInfo
+
+func (m *UpdateElectorateMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Fraction) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Numerator != 0 {
-		dAtA[i] = 0x8
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Numerator))
-	}
-	if m.Denominator != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Denominator))
+func (m *UpdateElectorateMsg) GetElectorateID() []byte {
+	if m != nil {
+		return m.ElectorateID
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Proposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *UpdateElectorateMsg) GetDiffElectors() []Elector {
+	if m != nil {
+		return m.DiffElectors
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+type UpdateElectionRuleMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// ElectionRuleID is a reference to the election rule
+	ElectionRuleID []byte `protobuf:"bytes,2,opt,name=election_rule_id,json=electionRuleId,proto3" json:"election_rule_id,omitempty"`
+	// Duration in seconds of how long the voting period will take place.
+	VotingPeriod github_com_iov_one_weave.UnixDuration `protobuf:"varint,3,opt,name=voting_period,json=votingPeriod,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"voting_period,omitempty"`
+	// Threshold is the fraction of all eligible voters, not only the ones who voted. To accept a
+	// proposal this value must be exceeded.
+	// The formula is `(yes*denominator) > (numerator*total_electors_weight)`.
+	// The valid range for the threshold value is `0.5` to `1` (inclusive) which allows any value between half and all
+	// of the eligible voters.
+	Threshold Fraction `protobuf:"bytes,4,opt,name=threshold,proto3" json:"threshold"`
+	// The quorum fraction of eligible voters is based on the total electorate
+	// weight and defines a threshold of votes that must be exceeded before the
+	// acceptance threshold is applied. This value requires any kind of votes
+	// and not only YES.
+	//
+	// The valid range for the threshold value is `0.5` to `1` (inclusive) which
+	// allows any value between half and all of the eligible voters.
+	Quorum *Fraction `protobuf:"bytes,5,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// Veto threshold is the fraction of all cast votes that must be exceeded
+	// by NoWithVeto votes to veto a proposal. Vetoing is disabled when not
+	// set.
+	//
+	// The valid range for the veto threshold value is `0` (exclusive) to `1`
+	// (inclusive).
+	VetoThreshold *Fraction `protobuf:"bytes,6,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// Weight curve converts elector weights into vote weights. Linear is used
+	// when not set.
+	WeightCurve WeightCurve `protobuf:"varint,7,opt,name=weight_curve,json=weightCurve,proto3,enum=gov.WeightCurve" json:"weight_curve,omitempty"`
+	// Weight cap must be set for the capped weight curve only.
+	WeightCap uint32 `protobuf:"varint,8,opt,name=weight_cap,json=weightCap,proto3" json:"weight_cap,omitempty"`
+}
+
+func (m *UpdateElectionRuleMsg) Reset()         { *m = UpdateElectionRuleMsg{} }
+func (m *UpdateElectionRuleMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateElectionRuleMsg) ProtoMessage()    {}
+func (*UpdateElectionRuleMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{21}
+}
+func (m *UpdateElectionRuleMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateElectionRuleMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateElectionRuleMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n5
+		return b[:n], nil
 	}
-	if len(m.Title) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
-		i += copy(dAtA[i:], m.Title)
+}
+func (m *UpdateElectionRuleMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateElectionRuleMsg.Merge(m, src)
+}
+func (m *UpdateElectionRuleMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateElectionRuleMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateElectionRuleMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateElectionRuleMsg proto.InternalMessageInfo
+
+func (m *UpdateElectionRuleMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.RawOption) > 0 {
-		dAtA[i] = 0x1a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.RawOption)))
-		i += copy(dAtA[i:], m.RawOption)
+	return nil
+}
+
+func (m *UpdateElectionRuleMsg) GetElectionRuleID() []byte {
+	if m != nil {
+		return m.ElectionRuleID
 	}
-	if len(m.Description) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+	return nil
+}
+
+func (m *UpdateElectionRuleMsg) GetVotingPeriod() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.VotingPeriod
 	}
-	dAtA[i] = 0x2a
-	i++
-	i = encodeVarintCodec(dAtA, i, uint64(m.ElectionRuleRef.Size()))
-	n6, err := m.ElectionRuleRef.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return 0
+}
+
+func (m *UpdateElectionRuleMsg) GetThreshold() Fraction {
+	if m != nil {
+		return m.Threshold
 	}
-	i += n6
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintCodec(dAtA, i, uint64(m.ElectorateRef.Size()))
-	n7, err := m.ElectorateRef.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	return Fraction{}
+}
+
+func (m *UpdateElectionRuleMsg) GetQuorum() *Fraction {
+	if m != nil {
+		return m.Quorum
 	}
-	i += n7
-	if m.VotingStartTime != 0 {
-		dAtA[i] = 0x38
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.VotingStartTime))
+	return nil
+}
+
+func (m *UpdateElectionRuleMsg) GetVetoThreshold() *Fraction {
+	if m != nil {
+		return m.VetoThreshold
 	}
-	if m.VotingEndTime != 0 {
-		dAtA[i] = 0x40
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.VotingEndTime))
+	return nil
+}
+
+func (m *UpdateElectionRuleMsg) GetWeightCurve() WeightCurve {
+	if m != nil {
+		return m.WeightCurve
 	}
-	if m.SubmissionTime != 0 {
-		dAtA[i] = 0x48
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.SubmissionTime))
-	}
-	if len(m.Author) > 0 {
-		dAtA[i] = 0x52
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Author)))
-		i += copy(dAtA[i:], m.Author)
-	}
-	dAtA[i] = 0x5a
-	i++
-	i = encodeVarintCodec(dAtA, i, uint64(m.VoteState.Size()))
-	n8, err := m.VoteState.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n8
-	if m.Status != 0 {
-		dAtA[i] = 0x60
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Status))
+	return WeightCurve_Linear
+}
+
+func (m *UpdateElectionRuleMsg) GetWeightCap() uint32 {
+	if m != nil {
+		return m.WeightCap
 	}
-	if m.Result != 0 {
-		dAtA[i] = 0x68
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Result))
+	return 0
+}
+
+// Configuration is the governance extension configuration.
+type Configuration struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Owner is present to implement gconf.OwnedConfig interface
+	// This defines the Address that is allowed to update the Configuration object and is
+	// needed to make use of gconf.NewUpdateConfigurationHandler
+	Owner github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
+	// Proposal deposit is the amount of coins that must be paid by the author
+	// when creating a proposal. The deposit is refunded if the proposal
+	// reaches the quorum and burned otherwise, which deters spam proposals.
+	// No deposit is required if not set.
+	ProposalDeposit coin.Coin `protobuf:"bytes,3,opt,name=proposal_deposit,json=proposalDeposit,proto3" json:"proposal_deposit"`
+}
+
+func (m *Configuration) Reset()         { *m = Configuration{} }
+func (m *Configuration) String() string { return proto.CompactTextString(m) }
+func (*Configuration) ProtoMessage()    {}
+func (*Configuration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{22}
+}
+func (m *Configuration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Configuration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Configuration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ExecutorResult != 0 {
-		dAtA[i] = 0x70
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ExecutorResult))
+}
+func (m *Configuration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Configuration.Merge(m, src)
+}
+func (m *Configuration) XXX_Size() int {
+	return m.Size()
+}
+func (m *Configuration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Configuration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Configuration proto.InternalMessageInfo
+
+func (m *Configuration) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	if len(m.TallyTaskID) > 0 {
-		dAtA[i] = 0x7a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.TallyTaskID)))
-		i += copy(dAtA[i:], m.TallyTaskID)
+	return nil
+}
+
+func (m *Configuration) GetOwner() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Owner
 	}
-	return i, nil
+	return nil
 }
 
-func (m *Resolution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *Configuration) GetProposalDeposit() coin.Coin {
+	if m != nil {
+		return m.ProposalDeposit
 	}
-	return dAtA[:n], nil
+	return coin.Coin{}
 }
 
-func (m *Resolution) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+// UpdateConfigurationMsg is used by the gconf extension to update the
+// configuration.
+type UpdateConfigurationMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
+}
+
+func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{} }
+func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
+func (*UpdateConfigurationMsg) ProtoMessage()    {}
+func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_24f6e3c5f1b82a85, []int{23}
+}
+func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateConfigurationMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateConfigurationMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i += n9
-	}
-	if len(m.ProposalID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
-		i += copy(dAtA[i:], m.ProposalID)
+		return b[:n], nil
 	}
-	dAtA[i] = 0x1a
-	i++
-	i = encodeVarintCodec(dAtA, i, uint64(m.ElectorateRef.Size()))
-	n10, err := m.ElectorateRef.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+}
+func (m *UpdateConfigurationMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateConfigurationMsg.Merge(m, src)
+}
+func (m *UpdateConfigurationMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateConfigurationMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateConfigurationMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateConfigurationMsg proto.InternalMessageInfo
+
+func (m *UpdateConfigurationMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	i += n10
-	if len(m.Resolution) > 0 {
-		dAtA[i] = 0x22
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Resolution)))
-		i += copy(dAtA[i:], m.Resolution)
+	return nil
+}
+
+func (m *UpdateConfigurationMsg) GetPatch() *Configuration {
+	if m != nil {
+		return m.Patch
 	}
-	return i, nil
+	return nil
 }
 
-func (m *TallyResult) Marshal() (dAtA []byte, err error) {
+func init() {
+	proto.RegisterEnum("gov.WeightCurve", WeightCurve_name, WeightCurve_value)
+	proto.RegisterEnum("gov.VoteOption", VoteOption_name, VoteOption_value)
+	proto.RegisterEnum("gov.Proposal_Status", Proposal_Status_name, Proposal_Status_value)
+	proto.RegisterEnum("gov.Proposal_Result", Proposal_Result_name, Proposal_Result_value)
+	proto.RegisterEnum("gov.Proposal_ExecutorResult", Proposal_ExecutorResult_name, Proposal_ExecutorResult_value)
+	proto.RegisterType((*Electorate)(nil), "gov.Electorate")
+	proto.RegisterType((*Elector)(nil), "gov.Elector")
+	proto.RegisterType((*ElectionRule)(nil), "gov.ElectionRule")
+	proto.RegisterType((*Fraction)(nil), "gov.Fraction")
+	proto.RegisterType((*Proposal)(nil), "gov.Proposal")
+	proto.RegisterType((*ExecutionResult)(nil), "gov.ExecutionResult")
+	proto.RegisterType((*Resolution)(nil), "gov.Resolution")
+	proto.RegisterType((*TallyResult)(nil), "gov.TallyResult")
+	proto.RegisterType((*Vote)(nil), "gov.Vote")
+	proto.RegisterType((*CreateProposalMsg)(nil), "gov.CreateProposalMsg")
+	proto.RegisterType((*DeleteProposalMsg)(nil), "gov.DeleteProposalMsg")
+	proto.RegisterType((*VoteMsg)(nil), "gov.VoteMsg")
+	proto.RegisterType((*Delegation)(nil), "gov.Delegation")
+	proto.RegisterType((*DelegateVoteMsg)(nil), "gov.DelegateVoteMsg")
+	proto.RegisterType((*RevokeDelegationMsg)(nil), "gov.RevokeDelegationMsg")
+	proto.RegisterType((*TallyMsg)(nil), "gov.TallyMsg")
+	proto.RegisterType((*CreateTextResolutionMsg)(nil), "gov.CreateTextResolutionMsg")
+	proto.RegisterType((*ChangeParametersMsg)(nil), "gov.ChangeParametersMsg")
+	proto.RegisterType((*ParameterChange)(nil), "gov.ParameterChange")
+	proto.RegisterType((*TextProposalMsg)(nil), "gov.TextProposalMsg")
+	proto.RegisterType((*UpdateElectorateMsg)(nil), "gov.UpdateElectorateMsg")
+	proto.RegisterType((*UpdateElectionRuleMsg)(nil), "gov.UpdateElectionRuleMsg")
+	proto.RegisterType((*Configuration)(nil), "gov.Configuration")
+	proto.RegisterType((*UpdateConfigurationMsg)(nil), "gov.UpdateConfigurationMsg")
+}
+
+func init() { proto.RegisterFile("x/gov/codec.proto", fileDescriptor_24f6e3c5f1b82a85) }
+
+var fileDescriptor_24f6e3c5f1b82a85 = []byte{
+	// 2091 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x92, 0x14, 0x3f, 0x1e, 0x3f, 0x35, 0x4a, 0xec, 0x8d, 0xe2, 0x48, 0xec, 0xda, 0x2e,
+	0x14, 0xc7, 0xa5, 0x1a, 0x39, 0x69, 0x81, 0x34, 0x28, 0xca, 0x8f, 0x75, 0xcc, 0x40, 0x26, 0xe5,
+	0x21, 0x29, 0x35, 0xa7, 0xc5, 0x9a, 0x3b, 0x22, 0xb7, 0x26, 0x77, 0x98, 0xdd, 0x59, 0x4a, 0xb9,
+	0xf6, 0x54, 0x08, 0x28, 0xd0, 0x5b, 0x4f, 0xfa, 0x03, 0x8a, 0xde, 0x5a, 0xb4, 0xb7, 0x02, 0x3d,
+	0xe6, 0x50, 0x14, 0x46, 0x4f, 0xed, 0x45, 0x28, 0xe4, 0xff, 0xa1, 0x28, 0x0c, 0x14, 0x28, 0x76,
+	0x66, 0x97, 0x5c, 0x4a, 0xb2, 0xea, 0x55, 0x9b, 0x22, 0xb9, 0x71, 0xdf, 0xfc, 0xde, 0xc7, 0xbc,
+	0x79, 0x33, 0xf3, 0x7b, 0x43, 0x58, 0x39, 0xda, 0x1a, 0xd0, 0xe9, 0x56, 0x9f, 0x1a, 0xa4, 0x5f,
+	0x99, 0xd8, 0x94, 0x51, 0x14, 0x1f, 0xd0, 0xe9, 0x5a, 0x36, 0x24, 0x59, 0x2b, 0xf5, 0xa9, 0x69,
+	0x85, 0x31, 0x6b, 0x6f, 0x0c, 0xe8, 0x80, 0xf2, 0x9f, 0x5b, 0xde, 0x2f, 0x5f, 0x5a, 0xa4, 0xf6,
+	0x38, 0x0c, 0x53, 0x7e, 0x1e, 0x03, 0x50, 0x47, 0xa4, 0xcf, 0xa8, 0xad, 0x33, 0x82, 0xde, 0x83,
+	0xf4, 0x98, 0x30, 0xdd, 0xd0, 0x99, 0x2e, 0x4b, 0x65, 0x69, 0x33, 0xbb, 0x5d, 0xac, 0x1c, 0x12,
+	0x7d, 0x4a, 0x2a, 0x8f, 0x7d, 0x31, 0x9e, 0x01, 0x90, 0x0c, 0xa9, 0x29, 0xb1, 0x1d, 0x93, 0x5a,
+	0x72, 0xac, 0x2c, 0x6d, 0xe6, 0x71, 0xf0, 0x89, 0x3e, 0x82, 0x65, 0xdd, 0x18, 0x9b, 0x96, 0x1c,
+	0x2f, 0x4b, 0x9b, 0xb9, 0xda, 0x9d, 0x97, 0xa7, 0x1b, 0xe5, 0x81, 0xc9, 0x86, 0xee, 0xd3, 0x4a,
+	0x9f, 0x8e, 0xb7, 0x4c, 0x3a, 0xfd, 0x0e, 0xb5, 0xc8, 0x96, 0xb0, 0x5c, 0x35, 0x0c, 0x9b, 0x38,
+	0x0e, 0x16, 0x2a, 0xe8, 0x0d, 0x58, 0x66, 0x26, 0x1b, 0x11, 0x39, 0x51, 0x96, 0x36, 0x33, 0x58,
+	0x7c, 0xa0, 0x0a, 0xa4, 0x89, 0x08, 0xd3, 0x91, 0x97, 0xcb, 0xf1, 0xcd, 0xec, 0x76, 0xae, 0x32,
+	0xa0, 0xd3, 0x8a, 0x1f, 0x7b, 0x2d, 0xf1, 0xe5, 0xe9, 0xc6, 0x12, 0x9e, 0x61, 0xd0, 0xf7, 0xe0,
+	0x26, 0xa3, 0x4c, 0x1f, 0x69, 0x64, 0x36, 0x39, 0xed, 0x90, 0x98, 0x83, 0x21, 0x93, 0x93, 0x65,
+	0x69, 0x33, 0x81, 0xdf, 0xe4, 0xc3, 0xf3, 0xa9, 0xef, 0xf3, 0x41, 0x45, 0x87, 0x94, 0x2f, 0x43,
+	0x3f, 0x84, 0x94, 0x2e, 0x42, 0x93, 0xa5, 0x08, 0xd3, 0x08, 0x94, 0xd0, 0x0d, 0x48, 0xfa, 0x1e,
+	0x45, 0x76, 0xfc, 0x2f, 0xe5, 0x2f, 0x09, 0xc8, 0x71, 0x1f, 0x26, 0xb5, 0xb0, 0x3b, 0xfa, 0x5a,
+	0x24, 0xfd, 0x43, 0xc8, 0x87, 0x12, 0x65, 0x1a, 0x3c, 0xf9, 0xb9, 0x5a, 0xe9, 0xec, 0x74, 0x23,
+	0x37, 0xcf, 0x51, 0xb3, 0x81, 0x73, 0x73, 0x58, 0xd3, 0x98, 0xaf, 0xd5, 0x72, 0x78, 0xad, 0x5a,
+	0x90, 0x9f, 0x52, 0x66, 0x5a, 0x03, 0x6d, 0x42, 0x6c, 0x93, 0x1a, 0x3c, 0xe3, 0xf9, 0xda, 0xbb,
+	0x2f, 0x4f, 0x37, 0xee, 0xbe, 0x32, 0xa0, 0x9e, 0x65, 0x1e, 0x35, 0x5c, 0x5b, 0xe7, 0x59, 0xc9,
+	0x09, 0xfd, 0x5d, 0xae, 0x8e, 0xde, 0x87, 0x0c, 0x1b, 0xda, 0xc4, 0x19, 0xd2, 0x91, 0x21, 0xa7,
+	0x78, 0x82, 0xf2, 0x7c, 0xf1, 0x1f, 0xda, 0x3a, 0xcf, 0xa2, 0xbf, 0xfa, 0x73, 0x14, 0xba, 0x0b,
+	0xc9, 0xcf, 0x5d, 0x6a, 0xbb, 0x63, 0x39, 0x7d, 0x09, 0x1e, 0xfb, 0x83, 0xe1, 0x25, 0xce, 0x5c,
+	0x67, 0x89, 0x3f, 0x80, 0xc2, 0x94, 0x30, 0xaa, 0xcd, 0xc3, 0x83, 0xcb, 0xdc, 0xe5, 0x3d, 0x50,
+	0x77, 0x16, 0xdc, 0x03, 0xc8, 0x89, 0x52, 0xd0, 0xfa, 0xae, 0x3d, 0x25, 0x72, 0xb6, 0x2c, 0x6d,
+	0x16, 0xb6, 0x4b, 0x5c, 0x47, 0x94, 0x61, 0xdd, 0x93, 0xe3, 0xec, 0xe1, 0xfc, 0x03, 0xbd, 0x03,
+	0x10, 0x28, 0xe9, 0x13, 0x39, 0xc7, 0x97, 0x3e, 0xe3, 0x03, 0xf4, 0x89, 0xf2, 0x29, 0xa4, 0x03,
+	0x77, 0xe8, 0x16, 0x64, 0x2c, 0x77, 0x4c, 0x6c, 0x9d, 0x51, 0x9b, 0x17, 0x54, 0x1e, 0xcf, 0x05,
+	0xa8, 0x0c, 0x59, 0x83, 0x58, 0x74, 0x6c, 0x5a, 0x7c, 0x5c, 0x14, 0x51, 0x58, 0xa4, 0xfc, 0x23,
+	0x0b, 0xe9, 0x5d, 0x9b, 0x4e, 0xa8, 0xa3, 0x8f, 0xa2, 0x15, 0xe7, 0xac, 0x1e, 0x62, 0xe1, 0x7a,
+	0x78, 0x07, 0xc0, 0xd6, 0x0f, 0x35, 0x3a, 0xf1, 0xa2, 0x13, 0xd5, 0x89, 0x33, 0xb6, 0x7e, 0xd8,
+	0xe6, 0x02, 0x11, 0x90, 0xd3, 0xb7, 0x4d, 0x31, 0x2e, 0xb6, 0x7d, 0x58, 0x84, 0x54, 0x58, 0x21,
+	0xfe, 0x86, 0xd1, 0x6c, 0x77, 0x44, 0x34, 0x9b, 0x1c, 0xf0, 0x92, 0xcb, 0x6e, 0xaf, 0x56, 0xa8,
+	0x3d, 0xae, 0xec, 0x89, 0x2d, 0x40, 0x8c, 0x66, 0x03, 0x93, 0x03, 0xbf, 0x1c, 0x8a, 0x24, 0xb4,
+	0xc9, 0x30, 0x39, 0x40, 0x3f, 0x82, 0x42, 0xa8, 0xc8, 0x3d, 0x1b, 0xc9, 0xff, 0x64, 0x23, 0xb4,
+	0x2b, 0x3c, 0x0b, 0x4f, 0x60, 0xc5, 0xaf, 0x6c, 0x87, 0xe9, 0x36, 0xd3, 0x98, 0x39, 0x26, 0xbc,
+	0x22, 0xe3, 0xb5, 0xbb, 0x2f, 0x4f, 0x37, 0xbe, 0x75, 0x65, 0x75, 0x77, 0xcd, 0x31, 0xc1, 0x45,
+	0xa1, 0xdf, 0xf1, 0xd4, 0x3d, 0x01, 0x7a, 0x0c, 0xbe, 0x48, 0x23, 0x96, 0x21, 0x0c, 0xa6, 0xa3,
+	0x18, 0xf4, 0xb7, 0x9a, 0x6a, 0x19, 0xdc, 0x5c, 0x0b, 0x8a, 0x8e, 0xfb, 0x74, 0x6c, 0x3a, 0xde,
+	0x5c, 0x84, 0xb9, 0x4c, 0x14, 0x73, 0x85, 0xb9, 0x36, 0xb7, 0xf7, 0x31, 0x24, 0x75, 0x97, 0x0d,
+	0xa9, 0x2d, 0x43, 0x84, 0x0d, 0xe2, 0xeb, 0xa0, 0x0f, 0x01, 0xa6, 0x94, 0x11, 0x2f, 0x5b, 0x4c,
+	0xd4, 0x79, 0xd6, 0xaf, 0xf3, 0xae, 0x3e, 0x1a, 0x7d, 0x81, 0x89, 0xe3, 0x8e, 0x58, 0xb0, 0x7b,
+	0x3d, 0x64, 0xc7, 0x03, 0xa2, 0xfb, 0x90, 0xf4, 0x34, 0x5c, 0x87, 0xd7, 0x79, 0x61, 0xfb, 0x0d,
+	0xae, 0x12, 0x94, 0x64, 0xa5, 0xc3, 0xc7, 0xb0, 0x8f, 0xf1, 0xd0, 0x36, 0x37, 0x24, 0xe7, 0x2f,
+	0x43, 0x0b, 0x27, 0xd8, 0xc7, 0x20, 0x15, 0x8a, 0xe4, 0x88, 0xf4, 0x5d, 0x46, 0x6d, 0xcd, 0x57,
+	0x2b, 0x70, 0xb5, 0x5b, 0x8b, 0x6a, 0xaa, 0x0f, 0xf2, 0xd5, 0x0b, 0x64, 0xe1, 0x1b, 0x3d, 0x80,
+	0x3c, 0xf3, 0xa6, 0xa0, 0x31, 0xdd, 0x79, 0xe6, 0x1d, 0x98, 0x45, 0x9e, 0x9e, 0xe2, 0xd9, 0xe9,
+	0x46, 0x96, 0xcf, 0xad, 0xab, 0x3b, 0xcf, 0x9a, 0x0d, 0x9c, 0x65, 0xb3, 0x0f, 0x03, 0xdd, 0x83,
+	0x94, 0x41, 0x26, 0xd4, 0x31, 0x99, 0x5c, 0xe2, 0xb9, 0x80, 0x8a, 0x77, 0x6f, 0x57, 0xea, 0xd4,
+	0x0c, 0xce, 0xb0, 0x00, 0x80, 0x3e, 0x81, 0x15, 0xe1, 0x92, 0x17, 0x3d, 0x77, 0xea, 0xc8, 0x2b,
+	0xfc, 0xe6, 0x13, 0x13, 0x54, 0x83, 0xd1, 0x85, 0x2c, 0x96, 0xc8, 0xa2, 0xd8, 0x51, 0x7e, 0x25,
+	0x41, 0x52, 0x64, 0x0c, 0xbd, 0x0d, 0x37, 0x77, 0x71, 0x7b, 0xb7, 0xdd, 0xa9, 0xee, 0x68, 0x9d,
+	0x6e, 0xb5, 0xdb, 0xeb, 0x68, 0xcd, 0xd6, 0x5e, 0x75, 0xa7, 0xd9, 0x28, 0x2d, 0xa1, 0xfb, 0xf0,
+	0xd6, 0xf9, 0xc1, 0x4e, 0xaf, 0xf6, 0xb8, 0xd9, 0xed, 0xaa, 0x8d, 0x92, 0xb4, 0x96, 0x3f, 0x3e,
+	0x29, 0x67, 0x3a, 0x5e, 0x71, 0x30, 0x46, 0x0c, 0xf4, 0x6d, 0xb8, 0x71, 0x1e, 0x5d, 0xdf, 0x69,
+	0x77, 0xd4, 0x46, 0x29, 0xb6, 0x06, 0xc7, 0x27, 0xe5, 0x64, 0x7d, 0x44, 0x1d, 0x62, 0x5c, 0x66,
+	0x75, 0xbf, 0xd9, 0x7d, 0xd4, 0xc0, 0xd5, 0xfd, 0x56, 0x29, 0x2e, 0xac, 0xee, 0x9b, 0x6c, 0x68,
+	0xd8, 0xfa, 0xa1, 0xa5, 0xfc, 0x5a, 0x82, 0xa4, 0x9f, 0xe0, 0x70, 0xac, 0x58, 0xed, 0xf4, 0x76,
+	0xba, 0xaf, 0x88, 0xd5, 0x1f, 0xec, 0xb5, 0x1a, 0xea, 0xc3, 0x66, 0x6b, 0x1e, 0x6b, 0xcf, 0x32,
+	0xc8, 0x81, 0x69, 0x11, 0x2f, 0xed, 0xf2, 0x79, 0x74, 0xb5, 0x5e, 0x57, 0x77, 0xbb, 0x3c, 0xda,
+	0xdc, 0xf1, 0x49, 0x39, 0x5d, 0xed, 0xf7, 0xc9, 0x84, 0x5d, 0x8e, 0xc5, 0xea, 0xa7, 0x6a, 0xdd,
+	0xc3, 0xc6, 0x05, 0x16, 0x93, 0x9f, 0x90, 0x3e, 0x23, 0x86, 0xf2, 0x67, 0x09, 0x0a, 0x8b, 0x65,
+	0x82, 0xee, 0x40, 0x79, 0xa6, 0xae, 0xfe, 0x58, 0xad, 0xf7, 0xba, 0x6d, 0x7c, 0x31, 0xfc, 0xef,
+	0x5e, 0x81, 0x6a, 0xb5, 0xbb, 0x1a, 0xee, 0xb5, 0x4a, 0x92, 0x48, 0x63, 0x8b, 0x32, 0xec, 0x5a,
+	0xe8, 0xfd, 0x2b, 0x34, 0x3a, 0xbd, 0x7a, 0x5d, 0xed, 0x74, 0x4a, 0xb1, 0xb5, 0xec, 0xf1, 0x49,
+	0x39, 0xd5, 0x71, 0xfb, 0x7d, 0xef, 0x6e, 0xba, 0x4a, 0xe5, 0x61, 0xb5, 0xb9, 0xd3, 0xc3, 0x6a,
+	0x29, 0x2e, 0x54, 0x1e, 0xea, 0xe6, 0xc8, 0xb5, 0x89, 0xb2, 0x0f, 0xc5, 0x73, 0x55, 0x85, 0xd6,
+	0x01, 0x1c, 0x61, 0xf0, 0xc0, 0x1d, 0xf1, 0x0b, 0x20, 0x8d, 0x43, 0x12, 0x84, 0x20, 0xc1, 0xaf,
+	0x86, 0x18, 0x3f, 0xd5, 0xf9, 0x6f, 0x54, 0x82, 0xf8, 0x88, 0x0e, 0xf8, 0x41, 0x9f, 0xc1, 0xde,
+	0x4f, 0xe5, 0x4f, 0x12, 0x00, 0x26, 0x0e, 0x1d, 0x71, 0xd3, 0xd1, 0xee, 0x94, 0x2d, 0xc8, 0x4e,
+	0xfc, 0x4d, 0xe9, 0xed, 0x33, 0xee, 0xa8, 0x56, 0x38, 0x3b, 0xdd, 0x80, 0x60, 0xaf, 0x36, 0x1b,
+	0x18, 0x02, 0x48, 0xd3, 0xb8, 0xe4, 0x98, 0x8f, 0x47, 0x3c, 0xe6, 0xd7, 0x01, 0xec, 0x59, 0xb4,
+	0xfe, 0x85, 0x14, 0x92, 0x28, 0xbf, 0x8d, 0x43, 0x36, 0x74, 0x80, 0xa1, 0xb7, 0x21, 0x23, 0xc8,
+	0xe6, 0x17, 0x44, 0x70, 0xc5, 0x04, 0x4e, 0x73, 0xc1, 0x67, 0xc4, 0x41, 0x6f, 0x81, 0xf8, 0xad,
+	0x59, 0x94, 0x07, 0x9f, 0xc0, 0x29, 0xfe, 0xdd, 0xa2, 0xe8, 0x36, 0xe4, 0xc5, 0x90, 0xfe, 0xd4,
+	0x61, 0xba, 0xcf, 0xdc, 0x12, 0x38, 0xc7, 0x85, 0x55, 0x21, 0xbb, 0x8a, 0xc9, 0x26, 0xae, 0x60,
+	0xb2, 0x21, 0x0a, 0xb4, 0x7c, 0x15, 0x05, 0x5a, 0x20, 0x57, 0xc9, 0xd7, 0x22, 0x57, 0xef, 0x01,
+	0x0a, 0x66, 0xa4, 0x1d, 0x9a, 0x6c, 0xa8, 0x79, 0xf4, 0x86, 0x5f, 0x83, 0x09, 0x5c, 0xf4, 0xe7,
+	0xe6, 0xed, 0xe9, 0x3d, 0xc2, 0xe8, 0x25, 0x14, 0x29, 0x7d, 0x0d, 0x8a, 0x94, 0x89, 0x4e, 0x91,
+	0xe0, 0x3c, 0x45, 0xfa, 0x99, 0x04, 0x89, 0x3d, 0x1a, 0xb5, 0xc9, 0xb9, 0x0f, 0x29, 0x3f, 0xf1,
+	0x7c, 0xf5, 0x2e, 0xef, 0x3b, 0x02, 0x08, 0xba, 0x0b, 0xcb, 0xde, 0x35, 0x66, 0xf0, 0x95, 0x2c,
+	0x6c, 0x17, 0x39, 0xd6, 0x73, 0x2a, 0xb8, 0x0e, 0x16, 0xa3, 0xca, 0xdf, 0x62, 0xb0, 0x52, 0xb7,
+	0x89, 0xce, 0x48, 0x50, 0xc3, 0x8f, 0x9d, 0xc1, 0xd7, 0x82, 0x6a, 0x7d, 0x0c, 0xa5, 0x45, 0xaa,
+	0x65, 0x1a, 0xbc, 0x7e, 0x72, 0x35, 0x74, 0x76, 0xba, 0x51, 0x08, 0xf7, 0x2d, 0xcd, 0x06, 0x2e,
+	0x84, 0x29, 0x56, 0xd3, 0x40, 0x0d, 0x80, 0x10, 0x31, 0x4a, 0x46, 0x21, 0x1e, 0x19, 0x67, 0x46,
+	0x89, 0xe6, 0x9c, 0x23, 0x15, 0x9d, 0x73, 0x28, 0x9f, 0xc3, 0x4a, 0x83, 0x8c, 0xc8, 0x7f, 0x91,
+	0xda, 0xa8, 0x27, 0x8e, 0xf2, 0x5c, 0x82, 0x94, 0xb7, 0xc8, 0x5f, 0xb9, 0x27, 0xaf, 0xc7, 0xf3,
+	0x2a, 0xc8, 0x8e, 0xd6, 0xe3, 0x71, 0x15, 0x2f, 0x32, 0x87, 0xaf, 0x17, 0x11, 0xed, 0xdd, 0x25,
+	0xe5, 0x39, 0x03, 0x28, 0xff, 0x94, 0x00, 0xbc, 0x34, 0x0e, 0xf4, 0xe8, 0x27, 0xf6, 0x85, 0x66,
+	0x32, 0xf6, 0x5a, 0xcd, 0x64, 0x0d, 0x32, 0x86, 0xf0, 0x48, 0xa3, 0xcd, 0x6f, 0xae, 0x16, 0xb2,
+	0x41, 0x88, 0x9c, 0xb8, 0x86, 0x0d, 0x42, 0x94, 0x7f, 0x49, 0x50, 0xf4, 0xa7, 0x4e, 0xae, 0xb5,
+	0xaa, 0xdf, 0xf0, 0xf9, 0xff, 0x41, 0x82, 0x55, 0x4c, 0xa6, 0xf4, 0x19, 0x99, 0x17, 0xc0, 0x37,
+	0x28, 0x07, 0xca, 0x10, 0xd2, 0xfc, 0x72, 0xfe, 0xea, 0xf7, 0xfd, 0x01, 0xdc, 0x14, 0xa7, 0x78,
+	0x97, 0x1c, 0xb1, 0x39, 0xbf, 0x89, 0xec, 0x78, 0x91, 0x6f, 0xc4, 0x2e, 0xf0, 0x8d, 0x23, 0x58,
+	0xad, 0x0f, 0x75, 0x6b, 0x40, 0x76, 0x75, 0x5b, 0x1f, 0x13, 0x46, 0x6c, 0x27, 0xb2, 0x8f, 0x0f,
+	0x20, 0xd5, 0xe7, 0x36, 0x1c, 0x39, 0x16, 0xea, 0x22, 0x66, 0x16, 0x85, 0x83, 0xe0, 0x3e, 0xf3,
+	0xa1, 0x4a, 0x15, 0x8a, 0xe7, 0x10, 0xde, 0x03, 0xd4, 0x44, 0xef, 0x3f, 0xd3, 0x07, 0x84, 0x3b,
+	0xcd, 0xe0, 0xe0, 0xd3, 0xbb, 0x92, 0x26, 0x3a, 0xeb, 0x0f, 0x7d, 0x32, 0x28, 0x3e, 0x94, 0x5f,
+	0x4a, 0x50, 0xf4, 0xf2, 0x73, 0xed, 0xe3, 0xf8, 0x36, 0xe4, 0x0d, 0xda, 0x77, 0xc7, 0xc4, 0x62,
+	0xda, 0x50, 0x77, 0x02, 0xf3, 0xb9, 0x40, 0xf8, 0x48, 0x77, 0x86, 0x68, 0x1b, 0x66, 0xdf, 0x9a,
+	0x6b, 0x8f, 0x04, 0xf9, 0x14, 0xed, 0x58, 0xc3, 0x97, 0xf7, 0xf0, 0x0e, 0xce, 0x06, 0xa0, 0x9e,
+	0x3d, 0x52, 0x7e, 0x23, 0xc1, 0x6a, 0x6f, 0x62, 0xe8, 0x8c, 0xcc, 0x2b, 0xf2, 0xff, 0x55, 0xe8,
+	0xdf, 0x87, 0xbc, 0x61, 0x1e, 0x1c, 0x68, 0xb3, 0x47, 0xcd, 0xf8, 0x2b, 0x1f, 0x35, 0x73, 0x1e,
+	0xd0, 0x17, 0x39, 0xca, 0xef, 0xe3, 0xf0, 0x66, 0x28, 0x68, 0xff, 0xee, 0x8d, 0x1c, 0xf6, 0x65,
+	0xf7, 0x7c, 0xec, 0xb5, 0xef, 0xf9, 0x0b, 0x2f, 0x7c, 0xf1, 0xff, 0xe1, 0x0b, 0x5f, 0x22, 0xe2,
+	0x0b, 0xdf, 0x95, 0xf4, 0xf6, 0x22, 0xfd, 0x4c, 0x5e, 0x83, 0x7e, 0xa6, 0xa2, 0xd3, 0xcf, 0xf4,
+	0x79, 0xfa, 0xf9, 0x3b, 0x09, 0xf2, 0x75, 0x6a, 0x1d, 0x98, 0x03, 0x3f, 0x07, 0xd1, 0x16, 0xec,
+	0x23, 0x58, 0xa6, 0x87, 0x16, 0xb1, 0xe5, 0x58, 0x84, 0x53, 0x51, 0xa8, 0xa0, 0x1f, 0x40, 0x69,
+	0x76, 0xb0, 0x05, 0x0f, 0x10, 0xf1, 0x57, 0x3c, 0x40, 0x14, 0x03, 0x64, 0x43, 0x00, 0x15, 0x0a,
+	0x37, 0x44, 0xbd, 0x2d, 0x04, 0x1f, 0xb9, 0xe0, 0x36, 0xc3, 0x87, 0x43, 0x76, 0x1b, 0xf1, 0x5c,
+	0x2e, 0x98, 0xf4, 0x0f, 0x8c, 0x7b, 0x3f, 0x95, 0x20, 0x1b, 0x4a, 0x32, 0xba, 0x0d, 0xab, 0xfb,
+	0x6a, 0xf3, 0x93, 0x47, 0x5d, 0xad, 0xde, 0xc3, 0x7b, 0xaa, 0xb6, 0xd3, 0x6c, 0xa9, 0x55, 0x5c,
+	0x5a, 0x12, 0x0d, 0xf2, 0x8e, 0x69, 0x11, 0xdd, 0x46, 0xef, 0xc2, 0x8d, 0x05, 0xd0, 0x93, 0x5e,
+	0xb5, 0x81, 0xab, 0xdd, 0x66, 0x3d, 0x78, 0x0e, 0x78, 0xe2, 0xea, 0x86, 0xe7, 0xa7, 0x7f, 0xc1,
+	0x5e, 0xbd, 0xba, 0xbb, 0x1b, 0x7a, 0xb7, 0xd0, 0x27, 0x13, 0x62, 0xdc, 0xfb, 0xa3, 0x04, 0x30,
+	0x27, 0x46, 0xe8, 0x0e, 0xac, 0xee, 0xb5, 0xbb, 0xaa, 0xd6, 0xde, 0xed, 0x36, 0xdb, 0xad, 0x79,
+	0x2b, 0x2f, 0xfa, 0xe7, 0xa6, 0x35, 0xd5, 0x47, 0xa6, 0x81, 0x6e, 0x41, 0x31, 0x8c, 0xfa, 0x4c,
+	0xed, 0x94, 0xa4, 0xb5, 0xd4, 0xf1, 0x49, 0x39, 0xee, 0x35, 0x82, 0x6b, 0x50, 0x08, 0x8f, 0xb6,
+	0xda, 0xa5, 0xd8, 0x5a, 0xf2, 0xf8, 0xa4, 0x1c, 0x6b, 0xd1, 0xf3, 0xf6, 0xab, 0xb5, 0x4e, 0xb7,
+	0xda, 0x6c, 0x05, 0xfd, 0x79, 0xd0, 0x0a, 0xde, 0x07, 0x79, 0xd1, 0x02, 0x7f, 0x4b, 0xd1, 0xf6,
+	0xd4, 0x6e, 0xbb, 0x94, 0x58, 0x2b, 0x1c, 0x9f, 0x94, 0x61, 0xde, 0x79, 0xd5, 0xe4, 0x2f, 0xcf,
+	0xd6, 0xa5, 0xe7, 0x67, 0xeb, 0xd2, 0xdf, 0xcf, 0xd6, 0xa5, 0x5f, 0xbc, 0x58, 0x5f, 0x7a, 0xfe,
+	0x62, 0x7d, 0xe9, 0xaf, 0x2f, 0xd6, 0x97, 0x9e, 0x26, 0xf9, 0x7f, 0x3f, 0x0f, 0xfe, 0x1d, 0x00,
+	0x00, 0xff, 0xff, 0x0a, 0xf5, 0x65, 0xef, 0x5b, 0x1a, 0x00, 0x00,
+}
+
+func (m *Electorate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1888,53 +2327,59 @@ func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *Electorate) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.TotalYes != 0 {
-		dAtA[i] = 0x8
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.TotalYes))
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n1
 	}
-	if m.TotalNo != 0 {
+	if m.Version != 0 {
 		dAtA[i] = 0x10
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.TotalNo))
+		i = encodeVarintCodec(dAtA, i, uint64(m.Version))
 	}
-	if m.TotalAbstain != 0 {
-		dAtA[i] = 0x18
+	if len(m.Admin) > 0 {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.TotalAbstain))
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Admin)))
+		i += copy(dAtA[i:], m.Admin)
 	}
-	if m.TotalElectorateWeight != 0 {
-		dAtA[i] = 0x20
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.TotalElectorateWeight))
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
 	}
-	if m.Quorum != nil {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Quorum.Size()))
-		n11, err := m.Quorum.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+	if len(m.Electors) > 0 {
+		for _, msg := range m.Electors {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
-		i += n11
 	}
-	dAtA[i] = 0x32
-	i++
-	i = encodeVarintCodec(dAtA, i, uint64(m.Threshold.Size()))
-	n12, err := m.Threshold.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+	if m.TotalElectorateWeight != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TotalElectorateWeight))
 	}
-	i += n12
 	return i, nil
 }
 
-func (m *Vote) Marshal() (dAtA []byte, err error) {
+func (m *Elector) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1944,38 +2389,26 @@ func (m *Vote) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
+func (m *Elector) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
+	if len(m.Address) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n13, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n13
-	}
-	dAtA[i] = 0x12
-	i++
-	i = encodeVarintCodec(dAtA, i, uint64(m.Elector.Size()))
-	n14, err := m.Elector.MarshalTo(dAtA[i:])
-	if err != nil {
-		return 0, err
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
 	}
-	i += n14
-	if m.Voted != 0 {
-		dAtA[i] = 0x18
+	if m.Weight != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Voted))
+		i = encodeVarintCodec(dAtA, i, uint64(m.Weight))
 	}
 	return i, nil
 }
 
-func (m *CreateProposalMsg) Marshal() (dAtA []byte, err error) {
+func (m *ElectionRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -1985,7 +2418,7 @@ func (m *CreateProposalMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ElectionRule) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -1994,51 +2427,88 @@ func (m *CreateProposalMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n15, err := m.Metadata.MarshalTo(dAtA[i:])
+		n2, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n2
 	}
-	if len(m.Title) > 0 {
-		dAtA[i] = 0x12
+	if m.Version != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
-		i += copy(dAtA[i:], m.Title)
+		i = encodeVarintCodec(dAtA, i, uint64(m.Version))
 	}
-	if len(m.RawOption) > 0 {
+	if len(m.Admin) > 0 {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.RawOption)))
-		i += copy(dAtA[i:], m.RawOption)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Admin)))
+		i += copy(dAtA[i:], m.Admin)
 	}
-	if len(m.Description) > 0 {
+	if len(m.ElectorateID) > 0 {
 		dAtA[i] = 0x22
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
-		i += copy(dAtA[i:], m.Description)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectorateID)))
+		i += copy(dAtA[i:], m.ElectorateID)
 	}
-	if len(m.ElectionRuleID) > 0 {
+	if len(m.Title) > 0 {
 		dAtA[i] = 0x2a
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectionRuleID)))
-		i += copy(dAtA[i:], m.ElectionRuleID)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
 	}
-	if m.StartTime != 0 {
+	if m.VotingPeriod != 0 {
 		dAtA[i] = 0x30
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.StartTime))
+		i = encodeVarintCodec(dAtA, i, uint64(m.VotingPeriod))
 	}
-	if len(m.Author) > 0 {
-		dAtA[i] = 0x3a
+	dAtA[i] = 0x3a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Threshold.Size()))
+	n3, err := m.Threshold.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n3
+	if m.Quorum != nil {
+		dAtA[i] = 0x42
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Author)))
-		i += copy(dAtA[i:], m.Author)
+		i = encodeVarintCodec(dAtA, i, uint64(m.Quorum.Size()))
+		n4, err := m.Quorum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
+	}
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.VetoThreshold != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.VetoThreshold.Size()))
+		n5, err := m.VetoThreshold.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if m.WeightCurve != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.WeightCurve))
+	}
+	if m.WeightCap != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.WeightCap))
 	}
 	return i, nil
 }
 
-func (m *DeleteProposalMsg) Marshal() (dAtA []byte, err error) {
+func (m *Fraction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2048,31 +2518,25 @@ func (m *DeleteProposalMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Fraction) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
+	if m.Numerator != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n16, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n16
+		i = encodeVarintCodec(dAtA, i, uint64(m.Numerator))
 	}
-	if len(m.ProposalID) > 0 {
-		dAtA[i] = 0x12
+	if m.Denominator != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
-		i += copy(dAtA[i:], m.ProposalID)
+		i = encodeVarintCodec(dAtA, i, uint64(m.Denominator))
 	}
 	return i, nil
 }
 
-func (m *VoteMsg) Marshal() (dAtA []byte, err error) {
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2082,7 +2546,7 @@ func (m *VoteMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VoteMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2091,33 +2555,124 @@ func (m *VoteMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n17, err := m.Metadata.MarshalTo(dAtA[i:])
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n6
 	}
-	if len(m.ProposalID) > 0 {
+	if len(m.Title) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
-		i += copy(dAtA[i:], m.ProposalID)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
 	}
-	if len(m.Voter) > 0 {
+	if len(m.RawOption) > 0 {
 		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Voter)))
-		i += copy(dAtA[i:], m.Voter)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.RawOption)))
+		i += copy(dAtA[i:], m.RawOption)
 	}
-	if m.Selected != 0 {
-		dAtA[i] = 0x20
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x22
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Selected))
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
+	}
+	dAtA[i] = 0x2a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.ElectionRuleRef.Size()))
+	n7, err := m.ElectionRuleRef.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.ElectorateRef.Size()))
+	n8, err := m.ElectorateRef.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	if m.VotingStartTime != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.VotingStartTime))
+	}
+	if m.VotingEndTime != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.VotingEndTime))
+	}
+	if m.SubmissionTime != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.SubmissionTime))
+	}
+	if len(m.Author) > 0 {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Author)))
+		i += copy(dAtA[i:], m.Author)
+	}
+	dAtA[i] = 0x5a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.VoteState.Size()))
+	n9, err := m.VoteState.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n9
+	if m.Status != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Status))
+	}
+	if m.Result != 0 {
+		dAtA[i] = 0x68
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Result))
+	}
+	if m.ExecutorResult != 0 {
+		dAtA[i] = 0x70
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExecutorResult))
+	}
+	if len(m.TallyTaskID) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TallyTaskID)))
+		i += copy(dAtA[i:], m.TallyTaskID)
+	}
+	dAtA[i] = 0x82
+	i++
+	dAtA[i] = 0x1
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Deposit.Size()))
+	n10, err := m.Deposit.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n10
+	if len(m.ExecutionResults) > 0 {
+		for _, msg := range m.ExecutionResults {
+			dAtA[i] = 0x8a
+			i++
+			dAtA[i] = 0x1
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
 	return i, nil
 }
 
-func (m *TallyMsg) Marshal() (dAtA []byte, err error) {
+func (m *ExecutionResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2127,31 +2682,37 @@ func (m *TallyMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TallyMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *ExecutionResult) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
+	if m.Successful {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n18, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		if m.Successful {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n18
+		i++
 	}
-	if len(m.ProposalID) > 0 {
+	if len(m.Data) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
-		i += copy(dAtA[i:], m.ProposalID)
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Data)))
+		i += copy(dAtA[i:], m.Data)
+	}
+	if len(m.Log) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Log)))
+		i += copy(dAtA[i:], m.Log)
 	}
 	return i, nil
 }
 
-func (m *CreateTextResolutionMsg) Marshal() (dAtA []byte, err error) {
+func (m *Resolution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2161,7 +2722,7 @@ func (m *CreateTextResolutionMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Resolution) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2170,22 +2731,36 @@ func (m *CreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n19, err := m.Metadata.MarshalTo(dAtA[i:])
+		n11, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n11
 	}
-	if len(m.Resolution) > 0 {
+	if len(m.ProposalID) > 0 {
 		dAtA[i] = 0x12
 		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
+		i += copy(dAtA[i:], m.ProposalID)
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.ElectorateRef.Size()))
+	n12, err := m.ElectorateRef.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n12
+	if len(m.Resolution) > 0 {
+		dAtA[i] = 0x22
+		i++
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.Resolution)))
 		i += copy(dAtA[i:], m.Resolution)
 	}
 	return i, nil
 }
 
-func (m *UpdateElectorateMsg) Marshal() (dAtA []byte, err error) {
+func (m *TallyResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2195,43 +2770,78 @@ func (m *UpdateElectorateMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *TallyResult) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
+	if m.TotalYes != 0 {
+		dAtA[i] = 0x8
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n20, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n20
+		i = encodeVarintCodec(dAtA, i, uint64(m.TotalYes))
 	}
-	if len(m.ElectorateID) > 0 {
-		dAtA[i] = 0x12
+	if m.TotalNo != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectorateID)))
-		i += copy(dAtA[i:], m.ElectorateID)
+		i = encodeVarintCodec(dAtA, i, uint64(m.TotalNo))
 	}
-	if len(m.DiffElectors) > 0 {
-		for _, msg := range m.DiffElectors {
-			dAtA[i] = 0x1a
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+	if m.TotalAbstain != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TotalAbstain))
 	}
-	return i, nil
+	if m.TotalElectorateWeight != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TotalElectorateWeight))
+	}
+	if m.Quorum != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Quorum.Size()))
+		n13, err := m.Quorum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
+	}
+	dAtA[i] = 0x32
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Threshold.Size()))
+	n14, err := m.Threshold.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n14
+	if m.TotalNoWithVeto != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.TotalNoWithVeto))
+	}
+	if m.VetoThreshold != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.VetoThreshold.Size()))
+		n15, err := m.VetoThreshold.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n15
+	}
+	if m.WeightCurve != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.WeightCurve))
+	}
+	if m.WeightCap != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.WeightCap))
+	}
+	return i, nil
 }
 
-func (m *UpdateElectionRuleMsg) Marshal() (dAtA []byte, err error) {
+func (m *Vote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -2241,7 +2851,7 @@ func (m *UpdateElectionRuleMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Vote) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -2250,458 +2860,2150 @@ func (m *UpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n21, err := m.Metadata.MarshalTo(dAtA[i:])
+		n16, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
-	}
-	if len(m.ElectionRuleID) > 0 {
-		dAtA[i] = 0x12
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectionRuleID)))
-		i += copy(dAtA[i:], m.ElectionRuleID)
-	}
-	if m.VotingPeriod != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.VotingPeriod))
+		i += n16
 	}
-	dAtA[i] = 0x22
+	dAtA[i] = 0x12
 	i++
-	i = encodeVarintCodec(dAtA, i, uint64(m.Threshold.Size()))
-	n22, err := m.Threshold.MarshalTo(dAtA[i:])
+	i = encodeVarintCodec(dAtA, i, uint64(m.Elector.Size()))
+	n17, err := m.Elector.MarshalTo(dAtA[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
-	if m.Quorum != nil {
-		dAtA[i] = 0x2a
+	i += n17
+	if m.Voted != 0 {
+		dAtA[i] = 0x18
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Quorum.Size()))
-		n23, err := m.Quorum.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n23
+		i = encodeVarintCodec(dAtA, i, uint64(m.Voted))
 	}
 	return i, nil
 }
 
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CreateProposalMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
+	return dAtA[:n], nil
 }
-func (m *Electorate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *CreateProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n18, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n18
 	}
-	if m.Version != 0 {
-		n += 1 + sovCodec(uint64(m.Version))
+	if len(m.Title) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Title)))
+		i += copy(dAtA[i:], m.Title)
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.RawOption) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.RawOption)))
+		i += copy(dAtA[i:], m.RawOption)
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Description) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Description)))
+		i += copy(dAtA[i:], m.Description)
 	}
-	if len(m.Electors) > 0 {
-		for _, e := range m.Electors {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
-		}
+	if len(m.ElectionRuleID) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectionRuleID)))
+		i += copy(dAtA[i:], m.ElectionRuleID)
 	}
-	if m.TotalElectorateWeight != 0 {
-		n += 1 + sovCodec(uint64(m.TotalElectorateWeight))
+	if m.StartTime != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.StartTime))
 	}
-	return n
+	if len(m.Author) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Author)))
+		i += copy(dAtA[i:], m.Author)
+	}
+	return i, nil
 }
 
-func (m *Elector) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if m.Weight != 0 {
-		n += 1 + sovCodec(uint64(m.Weight))
+func (m *DeleteProposalMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ElectionRule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *DeleteProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if m.Version != 0 {
-		n += 1 + sovCodec(uint64(m.Version))
-	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.ElectorateID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if m.VotingPeriod != 0 {
-		n += 1 + sovCodec(uint64(m.VotingPeriod))
-	}
-	l = m.Threshold.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	if m.Quorum != nil {
-		l = m.Quorum.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n19, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n19
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.ProposalID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
+		i += copy(dAtA[i:], m.ProposalID)
 	}
-	return n
+	return i, nil
 }
 
-func (m *Fraction) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Numerator != 0 {
-		n += 1 + sovCodec(uint64(m.Numerator))
-	}
-	if m.Denominator != 0 {
-		n += 1 + sovCodec(uint64(m.Denominator))
+func (m *VoteMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Proposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VoteMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n20, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n20
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.RawOption)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = m.ElectionRuleRef.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	l = m.ElectorateRef.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	if m.VotingStartTime != 0 {
-		n += 1 + sovCodec(uint64(m.VotingStartTime))
-	}
-	if m.VotingEndTime != 0 {
-		n += 1 + sovCodec(uint64(m.VotingEndTime))
-	}
-	if m.SubmissionTime != 0 {
-		n += 1 + sovCodec(uint64(m.SubmissionTime))
-	}
-	l = len(m.Author)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = m.VoteState.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovCodec(uint64(m.Status))
-	}
-	if m.Result != 0 {
-		n += 1 + sovCodec(uint64(m.Result))
+	if len(m.ProposalID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
+		i += copy(dAtA[i:], m.ProposalID)
 	}
-	if m.ExecutorResult != 0 {
-		n += 1 + sovCodec(uint64(m.ExecutorResult))
+	if len(m.Voter) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Voter)))
+		i += copy(dAtA[i:], m.Voter)
 	}
-	l = len(m.TallyTaskID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if m.Selected != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Selected))
 	}
-	return n
+	return i, nil
 }
 
-func (m *Resolution) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n21, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n21
 	}
-	l = len(m.ProposalID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.ElectorateID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectorateID)))
+		i += copy(dAtA[i:], m.ElectorateID)
 	}
-	l = m.ElectorateRef.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	l = len(m.Resolution)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Delegator) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Delegator)))
+		i += copy(dAtA[i:], m.Delegator)
 	}
-	return n
+	if len(m.Delegatee) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Delegatee)))
+		i += copy(dAtA[i:], m.Delegatee)
+	}
+	return i, nil
 }
 
-func (m *TallyResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DelegateVoteMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateVoteMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.TotalYes != 0 {
-		n += 1 + sovCodec(uint64(m.TotalYes))
-	}
-	if m.TotalNo != 0 {
-		n += 1 + sovCodec(uint64(m.TotalNo))
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n22, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n22
 	}
-	if m.TotalAbstain != 0 {
-		n += 1 + sovCodec(uint64(m.TotalAbstain))
+	if len(m.ElectorateID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectorateID)))
+		i += copy(dAtA[i:], m.ElectorateID)
 	}
-	if m.TotalElectorateWeight != 0 {
-		n += 1 + sovCodec(uint64(m.TotalElectorateWeight))
+	if len(m.Delegator) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Delegator)))
+		i += copy(dAtA[i:], m.Delegator)
 	}
-	if m.Quorum != nil {
-		l = m.Quorum.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Delegatee) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Delegatee)))
+		i += copy(dAtA[i:], m.Delegatee)
 	}
-	l = m.Threshold.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	return n
+	return i, nil
 }
 
-func (m *Vote) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RevokeDelegationMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokeDelegationMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n23, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n23
 	}
-	l = m.Elector.Size()
-	n += 1 + l + sovCodec(uint64(l))
-	if m.Voted != 0 {
-		n += 1 + sovCodec(uint64(m.Voted))
+	if len(m.ElectorateID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectorateID)))
+		i += copy(dAtA[i:], m.ElectorateID)
 	}
-	return n
+	if len(m.Delegator) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Delegator)))
+		i += copy(dAtA[i:], m.Delegator)
+	}
+	return i, nil
 }
 
-func (m *CreateProposalMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TallyMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TallyMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.RawOption)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.ElectionRuleID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	if m.StartTime != 0 {
-		n += 1 + sovCodec(uint64(m.StartTime))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n24, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n24
 	}
-	l = len(m.Author)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.ProposalID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
+		i += copy(dAtA[i:], m.ProposalID)
 	}
-	return n
+	return i, nil
 }
 
-func (m *DeleteProposalMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateTextResolutionMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateTextResolutionMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n25, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n25
 	}
-	l = len(m.ProposalID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Resolution) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Resolution)))
+		i += copy(dAtA[i:], m.Resolution)
 	}
-	return n
+	return i, nil
 }
 
-func (m *VoteMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ChangeParametersMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeParametersMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.ProposalID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
-	}
-	l = len(m.Voter)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n26, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n26
 	}
-	if m.Selected != 0 {
-		n += 1 + sovCodec(uint64(m.Selected))
+	if len(m.Changes) > 0 {
+		for _, msg := range m.Changes {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *TallyMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParameterChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ParameterChange) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Package) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Package)))
+		i += copy(dAtA[i:], m.Package)
 	}
-	l = len(m.ProposalID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Patch) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Patch)))
+		i += copy(dAtA[i:], m.Patch)
 	}
-	return n
+	return i, nil
 }
 
-func (m *CreateTextResolutionMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TextProposalMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *TextProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n27, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n27
 	}
-	l = len(m.Resolution)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.DocumentHash) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.DocumentHash)))
+		i += copy(dAtA[i:], m.DocumentHash)
 	}
-	return n
+	if len(m.DocumentURL) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.DocumentURL)))
+		i += copy(dAtA[i:], m.DocumentURL)
+	}
+	return i, nil
 }
 
-func (m *UpdateElectorateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateElectorateMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateElectorateMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n28, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n28
 	}
-	l = len(m.ElectorateID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.ElectorateID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectorateID)))
+		i += copy(dAtA[i:], m.ElectorateID)
 	}
 	if len(m.DiffElectors) > 0 {
-		for _, e := range m.DiffElectors {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+		for _, msg := range m.DiffElectors {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	return n
+	return i, nil
 }
 
-func (m *UpdateElectionRuleMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateElectionRuleMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateElectionRuleMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n29, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n29
 	}
-	l = len(m.ElectionRuleID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.ElectionRuleID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ElectionRuleID)))
+		i += copy(dAtA[i:], m.ElectionRuleID)
 	}
 	if m.VotingPeriod != 0 {
-		n += 1 + sovCodec(uint64(m.VotingPeriod))
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.VotingPeriod))
 	}
-	l = m.Threshold.Size()
-	n += 1 + l + sovCodec(uint64(l))
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Threshold.Size()))
+	n30, err := m.Threshold.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n30
 	if m.Quorum != nil {
-		l = m.Quorum.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Quorum.Size()))
+		n31, err := m.Quorum.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n31
 	}
-	return n
+	if m.VetoThreshold != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.VetoThreshold.Size()))
+		n32, err := m.VetoThreshold.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n32
+	}
+	if m.WeightCurve != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.WeightCurve))
+	}
+	if m.WeightCap != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.WeightCap))
+	}
+	return i, nil
 }
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
+func (m *Configuration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n33, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n33
+	}
+	if len(m.Owner) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Owner)))
+		i += copy(dAtA[i:], m.Owner)
+	}
+	dAtA[i] = 0x1a
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.ProposalDeposit.Size()))
+	n34, err := m.ProposalDeposit.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n34
+	return i, nil
 }
-func (m *Electorate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *UpdateConfigurationMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n35, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	if m.Patch != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
+		n36, err := m.Patch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Electorate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovCodec(uint64(m.Version))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Electors) > 0 {
+		for _, e := range m.Electors {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.TotalElectorateWeight != 0 {
+		n += 1 + sovCodec(uint64(m.TotalElectorateWeight))
+	}
+	return n
+}
+
+func (m *Elector) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovCodec(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *ElectionRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovCodec(uint64(m.Version))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ElectorateID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.VotingPeriod != 0 {
+		n += 1 + sovCodec(uint64(m.VotingPeriod))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.VetoThreshold != nil {
+		l = m.VetoThreshold.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.WeightCurve != 0 {
+		n += 1 + sovCodec(uint64(m.WeightCurve))
+	}
+	if m.WeightCap != 0 {
+		n += 1 + sovCodec(uint64(m.WeightCap))
+	}
+	return n
+}
+
+func (m *Fraction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Numerator != 0 {
+		n += 1 + sovCodec(uint64(m.Numerator))
+	}
+	if m.Denominator != 0 {
+		n += 1 + sovCodec(uint64(m.Denominator))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.RawOption)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.ElectionRuleRef.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = m.ElectorateRef.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.VotingStartTime != 0 {
+		n += 1 + sovCodec(uint64(m.VotingStartTime))
+	}
+	if m.VotingEndTime != 0 {
+		n += 1 + sovCodec(uint64(m.VotingEndTime))
+	}
+	if m.SubmissionTime != 0 {
+		n += 1 + sovCodec(uint64(m.SubmissionTime))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.VoteState.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovCodec(uint64(m.Status))
+	}
+	if m.Result != 0 {
+		n += 1 + sovCodec(uint64(m.Result))
+	}
+	if m.ExecutorResult != 0 {
+		n += 1 + sovCodec(uint64(m.ExecutorResult))
+	}
+	l = len(m.TallyTaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 2 + l + sovCodec(uint64(l))
+	if len(m.ExecutionResults) > 0 {
+		for _, e := range m.ExecutionResults {
+			l = e.Size()
+			n += 2 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ExecutionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Successful {
+		n += 2
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Log)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Resolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ProposalID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.ElectorateRef.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalYes != 0 {
+		n += 1 + sovCodec(uint64(m.TotalYes))
+	}
+	if m.TotalNo != 0 {
+		n += 1 + sovCodec(uint64(m.TotalNo))
+	}
+	if m.TotalAbstain != 0 {
+		n += 1 + sovCodec(uint64(m.TotalAbstain))
+	}
+	if m.TotalElectorateWeight != 0 {
+		n += 1 + sovCodec(uint64(m.TotalElectorateWeight))
+	}
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.TotalNoWithVeto != 0 {
+		n += 1 + sovCodec(uint64(m.TotalNoWithVeto))
+	}
+	if m.VetoThreshold != nil {
+		l = m.VetoThreshold.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.WeightCurve != 0 {
+		n += 1 + sovCodec(uint64(m.WeightCurve))
+	}
+	if m.WeightCap != 0 {
+		n += 1 + sovCodec(uint64(m.WeightCap))
+	}
+	return n
+}
+
+func (m *Vote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Elector.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.Voted != 0 {
+		n += 1 + sovCodec(uint64(m.Voted))
+	}
+	return n
+}
+
+func (m *CreateProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.RawOption)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ElectionRuleID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovCodec(uint64(m.StartTime))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *DeleteProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ProposalID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *VoteMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ProposalID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Selected != 0 {
+		n += 1 + sovCodec(uint64(m.Selected))
+	}
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ElectorateID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateVoteMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ElectorateID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Delegatee)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *RevokeDelegationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ElectorateID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TallyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ProposalID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *CreateTextResolutionMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Resolution)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ChangeParametersMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ParameterChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Package)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Patch)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *TextProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.DocumentHash)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.DocumentURL)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateElectorateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ElectorateID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.DiffElectors) > 0 {
+		for _, e := range m.DiffElectors {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateElectionRuleMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ElectionRuleID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.VotingPeriod != 0 {
+		n += 1 + sovCodec(uint64(m.VotingPeriod))
+	}
+	l = m.Threshold.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.VetoThreshold != nil {
+		l = m.VetoThreshold.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.WeightCurve != 0 {
+		n += 1 + sovCodec(uint64(m.WeightCurve))
+	}
+	if m.WeightCap != 0 {
+		n += 1 + sovCodec(uint64(m.WeightCap))
+	}
+	return n
+}
+
+func (m *Configuration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.ProposalDeposit.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	return n
+}
+
+func (m *UpdateConfigurationMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Patch != nil {
+		l = m.Patch.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Electorate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Electorate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Electorate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Electors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Electors = append(m.Electors, Elector{})
+			if err := m.Electors[len(m.Electors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalElectorateWeight", wireType)
+			}
+			m.TotalElectorateWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalElectorateWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Elector) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Elector: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Elector: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ElectionRule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ElectionRule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ElectionRule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ElectorateID = append(m.ElectorateID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectorateID == nil {
+				m.ElectorateID = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			}
+			m.VotingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPeriod |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quorum == nil {
+				m.Quorum = &Fraction{}
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VetoThreshold == nil {
+				m.VetoThreshold = &Fraction{}
+			}
+			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightCurve", wireType)
+			}
+			m.WeightCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightCurve |= WeightCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightCap", wireType)
+			}
+			m.WeightCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Fraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+			}
+			m.Numerator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Numerator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+			}
+			m.Denominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Denominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Proposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -2710,28 +5012,319 @@ func (m *Electorate) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowCodec
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawOption", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawOption = append(m.RawOption[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawOption == nil {
+				m.RawOption = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionRuleRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ElectionRuleRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ElectorateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
+			}
+			m.VotingStartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingStartTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+			}
+			m.VotingEndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingEndTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionTime", wireType)
+			}
+			m.SubmissionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmissionTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Author = append(m.Author[:0], dAtA[iNdEx:postIndex]...)
+			if m.Author == nil {
+				m.Author = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Electorate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Electorate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoteState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2758,18 +5351,226 @@ func (m *Electorate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &weave.Metadata{}
+			if err := m.VoteState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Proposal_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			m.Result = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Result |= Proposal_Result(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
+			}
+			m.ExecutorResult = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutorResult |= Proposal_ExecutorResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TallyTaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TallyTaskID = append(m.TallyTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TallyTaskID == nil {
+				m.TallyTaskID = []byte{}
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionResults = append(m.ExecutionResults, ExecutionResult{})
+			if err := m.ExecutionResults[len(m.ExecutionResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Successful", wireType)
 			}
-			m.Version = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -2779,14 +5580,15 @@ func (m *Electorate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			m.Successful = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2813,14 +5615,14 @@ func (m *Electorate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Log", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2848,61 +5650,8 @@ func (m *Electorate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Electors", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Electors = append(m.Electors, Elector{})
-			if err := m.Electors[len(m.Electors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Log = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalElectorateWeight", wireType)
-			}
-			m.TotalElectorateWeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalElectorateWeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -2927,7 +5676,7 @@ func (m *Electorate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Elector) Unmarshal(dAtA []byte) error {
+func (m *Resolution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2950,15 +5699,51 @@ func (m *Elector) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Elector: wiretype end group for non-group")
+			return fmt.Errorf("proto: Resolution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Elector: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Resolution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2985,16 +5770,16 @@ func (m *Elector) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateRef", wireType)
 			}
-			m.Weight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3004,11 +5789,57 @@ func (m *Elector) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ElectorateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resolution = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -3033,7 +5864,7 @@ func (m *Elector) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ElectionRule) Unmarshal(dAtA []byte) error {
+func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3056,17 +5887,17 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ElectionRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ElectionRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalYes", wireType)
 			}
-			var msglen int
+			m.TotalYes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3076,33 +5907,16 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalYes |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &weave.Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNo", wireType)
 			}
-			m.Version = 0
+			m.TotalNo = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3112,16 +5926,16 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				m.TotalNo |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAbstain", wireType)
 			}
-			var byteLen int
+			m.TotalAbstain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3131,31 +5945,35 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TotalAbstain |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalElectorateWeight", wireType)
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			m.TotalElectorateWeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalElectorateWeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3165,31 +5983,33 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ElectorateID = append(m.ElectorateID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ElectorateID == nil {
-				m.ElectorateID = []byte{}
+			if m.Quorum == nil {
+				m.Quorum = &Fraction{}
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3199,29 +6019,30 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNoWithVeto", wireType)
 			}
-			m.VotingPeriod = 0
+			m.TotalNoWithVeto = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3231,14 +6052,14 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPeriod |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				m.TotalNoWithVeto |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3265,15 +6086,18 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VetoThreshold == nil {
+				m.VetoThreshold = &Fraction{}
+			}
+			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightCurve", wireType)
 			}
-			var msglen int
+			m.WeightCurve = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3283,33 +6107,16 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WeightCurve |= WeightCurve(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Quorum == nil {
-				m.Quorum = &Fraction{}
-			}
-			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightCap", wireType)
 			}
-			var byteLen int
+			m.WeightCap = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3319,26 +6126,11 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.WeightCap |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -3363,7 +6155,7 @@ func (m *ElectionRule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Fraction) Unmarshal(dAtA []byte) error {
+func (m *Vote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3386,17 +6178,17 @@ func (m *Fraction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Fraction: wiretype end group for non-group")
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Fraction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Numerator", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.Numerator = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3406,16 +6198,66 @@ func (m *Fraction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Numerator |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Elector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denominator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
 			}
-			m.Denominator = 0
+			m.Voted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3425,7 +6267,7 @@ func (m *Fraction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Denominator |= uint32(b&0x7F) << shift
+				m.Voted |= VoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3454,7 +6296,7 @@ func (m *Fraction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Proposal) Unmarshal(dAtA []byte) error {
+func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3477,10 +6319,10 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateProposalMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3619,94 +6461,9 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElectionRuleRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ElectionRuleRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateRef", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ElectorateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingStartTime", wireType)
-			}
-			m.VotingStartTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.VotingStartTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingEndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionRuleID", wireType)
 			}
-			m.VotingEndTime = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3716,16 +6473,31 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingEndTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ElectionRuleID = append(m.ElectionRuleID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectionRuleID == nil {
+				m.ElectionRuleID = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.SubmissionTime = 0
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3735,12 +6507,12 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SubmissionTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				m.StartTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
@@ -3774,9 +6546,62 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				m.Author = []byte{}
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteProposalMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteProposalMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoteState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3803,15 +6628,18 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VoteState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
-			m.Status = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3821,16 +6649,84 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Proposal_Status(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
 			}
-			m.Result = 0
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoteMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoteMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoteMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3840,16 +6736,33 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Result |= Proposal_Result(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorResult", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
 			}
-			m.ExecutorResult = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+			}
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -3859,14 +6772,29 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecutorResult |= Proposal_ExecutorResult(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TallyTaskID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3878,26 +6806,45 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
+			if m.Voter == nil {
+				m.Voter = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selected", wireType)
+			}
+			m.Selected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Selected |= VoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthCodec
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TallyTaskID = append(m.TallyTaskID[:0], dAtA[iNdEx:postIndex]...)
-			if m.TallyTaskID == nil {
-				m.TallyTaskID = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -3922,7 +6869,7 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Resolution) Unmarshal(dAtA []byte) error {
+func (m *Delegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3945,10 +6892,10 @@ func (m *Resolution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Resolution: wiretype end group for non-group")
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Resolution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3989,7 +6936,7 @@ func (m *Resolution) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4016,16 +6963,16 @@ func (m *Resolution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposalID == nil {
-				m.ProposalID = []byte{}
+			m.ElectorateID = append(m.ElectorateID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectorateID == nil {
+				m.ElectorateID = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4035,30 +6982,31 @@ func (m *Resolution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ElectorateRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4068,23 +7016,25 @@ func (m *Resolution) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resolution = string(dAtA[iNdEx:postIndex])
+			m.Delegatee = append(m.Delegatee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegatee == nil {
+				m.Delegatee = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4110,7 +7060,7 @@ func (m *Resolution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyResult) Unmarshal(dAtA []byte) error {
+func (m *DelegateVoteMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4133,17 +7083,17 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegateVoteMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegateVoteMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalYes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			m.TotalYes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4153,16 +7103,33 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalYes |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalNo", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateID", wireType)
 			}
-			m.TotalNo = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4172,54 +7139,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalNo |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAbstain", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
 			}
-			m.TotalAbstain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalAbstain |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalElectorateWeight", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.TotalElectorateWeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalElectorateWeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.ElectorateID = append(m.ElectorateID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectorateID == nil {
+				m.ElectorateID = []byte{}
 			}
-		case 5:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4229,33 +7173,31 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Quorum == nil {
-				m.Quorum = &Fraction{}
-			}
-			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
 			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegatee", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4265,23 +7207,24 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Delegatee = append(m.Delegatee[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegatee == nil {
+				m.Delegatee = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4308,7 +7251,7 @@ func (m *TallyResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *RevokeDelegationMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4331,10 +7274,10 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: RevokeDelegationMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RevokeDelegationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4375,9 +7318,9 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Elector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4387,30 +7330,31 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Elector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ElectorateID = append(m.ElectorateID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectorateID == nil {
+				m.ElectorateID = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
-			m.Voted = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4420,11 +7364,26 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Voted |= VoteOption(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = append(m.Delegator[:0], dAtA[iNdEx:postIndex]...)
+			if m.Delegator == nil {
+				m.Delegator = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -4449,7 +7408,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
+func (m *TallyMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4472,10 +7431,10 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateProposalMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: TallyMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TallyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4516,9 +7475,9 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4528,29 +7487,84 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateTextResolutionMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateTextResolutionMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateTextResolutionMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawOption", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4560,29 +7574,31 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawOption = append(m.RawOption[:0], dAtA[iNdEx:postIndex]...)
-			if m.RawOption == nil {
-				m.RawOption = []byte{}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4610,13 +7626,66 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Resolution = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeParametersMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeParametersMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeParametersMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElectionRuleID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4626,50 +7695,33 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ElectionRuleID = append(m.ElectionRuleID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ElectionRuleID == nil {
-				m.ElectionRuleID = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
 			}
-			m.StartTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartTime |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		case 7:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4679,24 +7731,24 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = append(m.Author[:0], dAtA[iNdEx:postIndex]...)
-			if m.Author == nil {
-				m.Author = []byte{}
+			m.Changes = append(m.Changes, ParameterChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4723,7 +7775,7 @@ func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteProposalMsg) Unmarshal(dAtA []byte) error {
+func (m *ParameterChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4746,17 +7798,17 @@ func (m *DeleteProposalMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteProposalMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParameterChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParameterChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Package", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4766,31 +7818,27 @@ func (m *DeleteProposalMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &weave.Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Package = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4817,9 +7865,9 @@ func (m *DeleteProposalMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposalID == nil {
-				m.ProposalID = []byte{}
+			m.Patch = append(m.Patch[:0], dAtA[iNdEx:postIndex]...)
+			if m.Patch == nil {
+				m.Patch = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -4846,7 +7894,7 @@ func (m *DeleteProposalMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VoteMsg) Unmarshal(dAtA []byte) error {
+func (m *TextProposalMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4869,10 +7917,10 @@ func (m *VoteMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VoteMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: TextProposalMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VoteMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TextProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4913,7 +7961,7 @@ func (m *VoteMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4940,16 +7988,16 @@ func (m *VoteMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposalID == nil {
-				m.ProposalID = []byte{}
+			m.DocumentHash = append(m.DocumentHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.DocumentHash == nil {
+				m.DocumentHash = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DocumentURL", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -4959,45 +8007,24 @@ func (m *VoteMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = append(m.Voter[:0], dAtA[iNdEx:postIndex]...)
-			if m.Voter == nil {
-				m.Voter = []byte{}
-			}
+			m.DocumentURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selected", wireType)
-			}
-			m.Selected = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Selected |= VoteOption(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -5022,7 +8049,7 @@ func (m *VoteMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TallyMsg) Unmarshal(dAtA []byte) error {
+func (m *UpdateElectorateMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5045,10 +8072,10 @@ func (m *TallyMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TallyMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateElectorateMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TallyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateElectorateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5089,7 +8116,7 @@ func (m *TallyMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5116,9 +8143,43 @@ func (m *TallyMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProposalID == nil {
-				m.ProposalID = []byte{}
+			m.ElectorateID = append(m.ElectorateID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectorateID == nil {
+				m.ElectorateID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiffElectors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiffElectors = append(m.DiffElectors, Elector{})
+			if err := m.DiffElectors[len(m.DiffElectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -5145,7 +8206,7 @@ func (m *TallyMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateTextResolutionMsg) Unmarshal(dAtA []byte) error {
+func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5168,10 +8229,10 @@ func (m *CreateTextResolutionMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateTextResolutionMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateElectionRuleMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateTextResolutionMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateElectionRuleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5212,9 +8273,9 @@ func (m *CreateTextResolutionMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionRuleID", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -5224,80 +8285,48 @@ func (m *CreateTextResolutionMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resolution = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCodec(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthCodec
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *UpdateElectorateMsg) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCodec
+			m.ElectionRuleID = append(m.ElectionRuleID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectionRuleID == nil {
+				m.ElectionRuleID = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.VotingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPeriod |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateElectorateMsg: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateElectorateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5324,18 +8353,15 @@ func (m *UpdateElectorateMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &weave.Metadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElectorateID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -5345,29 +8371,31 @@ func (m *UpdateElectorateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ElectorateID = append(m.ElectorateID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ElectorateID == nil {
-				m.ElectorateID = []byte{}
+			if m.Quorum == nil {
+				m.Quorum = &Fraction{}
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiffElectors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5394,11 +8422,51 @@ func (m *UpdateElectorateMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DiffElectors = append(m.DiffElectors, Elector{})
-			if err := m.DiffElectors[len(m.DiffElectors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VetoThreshold == nil {
+				m.VetoThreshold = &Fraction{}
+			}
+			if err := m.VetoThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightCurve", wireType)
+			}
+			m.WeightCurve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightCurve |= WeightCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightCap", wireType)
+			}
+			m.WeightCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -5423,7 +8491,7 @@ func (m *UpdateElectorateMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
+func (m *Configuration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5446,10 +8514,10 @@ func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateElectionRuleMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: Configuration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateElectionRuleMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Configuration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5490,7 +8558,7 @@ func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElectionRuleID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5517,16 +8585,16 @@ func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ElectionRuleID = append(m.ElectionRuleID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ElectionRuleID == nil {
-				m.ElectionRuleID = []byte{}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VotingPeriod", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalDeposit", wireType)
 			}
-			m.VotingPeriod = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -5536,14 +8604,81 @@ func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VotingPeriod |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposalDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateConfigurationMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateConfigurationMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateConfigurationMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5570,13 +8705,16 @@ func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Patch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5603,10 +8741,10 @@ func (m *UpdateElectionRuleMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Quorum == nil {
-				m.Quorum = &Fraction{}
+			if m.Patch == nil {
+				m.Patch = &Configuration{}
 			}
-			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Patch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,40 @@
+package gov
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+)
+
+func init() {
+	gconf.Register(packageName, &Configuration{})
+}
+
+func (c *Configuration) Validate() error {
+	var errs error
+	// Owner field is optional.
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	// Proposal deposit is optional, no deposit is required when not set.
+	if !c.ProposalDeposit.IsZero() {
+		errs = errors.AppendField(errs, "ProposalDeposit", c.ProposalDeposit.Validate())
+		if !c.ProposalDeposit.IsPositive() {
+			errs = errors.Append(errs, errors.Field("ProposalDeposit", errors.ErrAmount, "must be positive"))
+		}
+	}
+	return errs
+}
+
+// proposalDeposit returns the amount of coins that must be deposited when
+// creating a proposal. Zero is returned if the configuration does not exist.
+func proposalDeposit(db gconf.ReadStore) (coin.Coin, error) {
+	return gconf.Coin(db, packageName, "ProposalDeposit", coin.Coin{})
+}
+
+// DepositAddress returns the address that holds the deposit of the proposal
+// with given ID until the proposal is tallied.
+func DepositAddress(proposalID []byte) weave.Address {
+	return weave.NewCondition(packageName, "deposit", proposalID).Address()
+}
@@ -6,28 +6,27 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
-)
-
-const (
-	proposalCost           = 0
-	deleteProposalCost     = 0
-	voteCost               = 0
-	updateElectorateCost   = 0
-	updateElectionRuleCost = 0
-	textResolutionCost     = 0
+	"github.com/iov-one/weave/x/batch"
+	"github.com/iov-one/weave/x/cash"
 )
 
 const packageName = "gov"
 
-// RegisterQuery registers governance buckets for querying.
+// RegisterQuery registers governance buckets for querying. Proposals can be
+// listed page by page using "/proposals/status/page" and
+// "/proposals/electorate/page".
 func RegisterQuery(qr weave.QueryRouter) {
 	NewElectionRulesBucket().Register("electionrules", qr)
 	NewElectorateBucket().Register("electorates", qr)
 	NewProposalBucket().Register("proposals", qr)
+	qr.Register("/proposals/status/page", NewProposalsByStatusQuery())
+	qr.Register("/proposals/electorate/page", NewProposalsByElectorateQuery())
 	NewVoteBucket().Register("votes", qr)
+	NewDelegationBucket().Register("delegations", qr)
 }
 
 // RegisterRoutes registers handlers for governance message processing.
@@ -37,13 +36,17 @@ func RegisterRoutes(
 	decoder OptionDecoder,
 	executor Executor,
 	scheduler weave.Scheduler,
+	control cash.Controller,
 ) {
 	r = migration.SchemaMigratingRegistry(packageName, r)
 	r.Handle(&VoteMsg{}, newVoteHandler(auth))
-	r.Handle(&CreateProposalMsg{}, newCreateProposalHandler(auth, decoder, scheduler))
-	r.Handle(&DeleteProposalMsg{}, newDeleteProposalHandler(auth, scheduler))
+	r.Handle(&DelegateVoteMsg{}, newDelegateVoteHandler(auth))
+	r.Handle(&RevokeDelegationMsg{}, newRevokeDelegationHandler(auth))
+	r.Handle(&CreateProposalMsg{}, newCreateProposalHandler(auth, decoder, scheduler, control))
+	r.Handle(&DeleteProposalMsg{}, newDeleteProposalHandler(auth, scheduler, control))
 	r.Handle(&UpdateElectorateMsg{}, newUpdateElectorateHandler(auth))
 	r.Handle(&UpdateElectionRuleMsg{}, newUpdateElectionRuleHandler(auth))
+	r.Handle(&UpdateConfigurationMsg{}, NewConfigHandler(auth))
 	// We do NOT register the TextResultionHandler here... this is only for the proposal Executor
 }
 
@@ -52,8 +55,9 @@ func RegisterCronRoutes(
 	auth x.Authenticator,
 	decoder OptionDecoder,
 	executor Executor,
+	control cash.Controller,
 ) {
-	r.Handle(&TallyMsg{}, newTallyHandler(auth, decoder, executor))
+	r.Handle(&TallyMsg{}, newTallyHandler(auth, decoder, executor, control))
 }
 
 // RegisterBasicProposalRouters register the routes we accept for executing governance decisions.
@@ -62,6 +66,7 @@ func RegisterBasicProposalRouters(r weave.Registry, auth x.Authenticator) {
 	r.Handle(&UpdateElectorateMsg{}, newUpdateElectorateHandler(auth))
 	r.Handle(&UpdateElectionRuleMsg{}, newUpdateElectionRuleHandler(auth))
 	r.Handle(&CreateTextResolutionMsg{}, newCreateTextResolutionHandler(auth))
+	r.Handle(&ChangeParametersMsg{}, newChangeParametersHandler(auth))
 }
 
 type VoteHandler struct {
@@ -84,7 +89,7 @@ func (h VoteHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*w
 	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: voteCost}, nil
+	return &weave.CheckResult{}, nil
 
 }
 
@@ -166,21 +171,146 @@ func (h VoteHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx)
 	return &msg, proposal, vote, nil
 }
 
+type DelegateVoteHandler struct {
+	auth        x.Authenticator
+	elecBucket  *ElectorateBucket
+	delegBucket *DelegationBucket
+}
+
+func newDelegateVoteHandler(auth x.Authenticator) *DelegateVoteHandler {
+	return &DelegateVoteHandler{
+		auth:        auth,
+		elecBucket:  NewElectorateBucket(),
+		delegBucket: NewDelegationBucket(),
+	}
+}
+
+func (h DelegateVoteHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h DelegateVoteHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	delegation, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	// A new delegation replaces the previous one.
+	if err := h.delegBucket.Save(db, h.delegBucket.Build(*delegation)); err != nil {
+		return nil, errors.Wrap(err, "failed to store delegation")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h DelegateVoteHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*Delegation, error) {
+	var msg DelegateVoteMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	delegator := msg.Delegator
+	if delegator == nil {
+		delegator = x.MainSigner(ctx, h.auth).Address()
+	}
+	_, obj, err := h.elecBucket.GetLatestVersion(db, msg.ElectorateID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load electorate")
+	}
+	elect, err := asElectorate(obj)
+	if err != nil {
+		return nil, errors.Wrap(err, "electorate")
+	}
+	if _, ok := elect.Elector(delegator); !ok {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "not in participants list")
+	}
+	if !h.auth.HasAddress(ctx, delegator) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "delegator must sign msg")
+	}
+	delegation := &Delegation{
+		Metadata:     &weave.Metadata{Schema: 1},
+		ElectorateID: msg.ElectorateID,
+		Delegator:    delegator,
+		Delegatee:    msg.Delegatee,
+	}
+	if err := delegation.Validate(); err != nil {
+		return nil, err
+	}
+	return delegation, nil
+}
+
+type RevokeDelegationHandler struct {
+	auth        x.Authenticator
+	delegBucket *DelegationBucket
+}
+
+func newRevokeDelegationHandler(auth x.Authenticator) *RevokeDelegationHandler {
+	return &RevokeDelegationHandler{
+		auth:        auth,
+		delegBucket: NewDelegationBucket(),
+	}
+}
+
+func (h RevokeDelegationHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h RevokeDelegationHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	delegation, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.delegBucket.DeleteDelegation(db, delegation.ElectorateID, delegation.Delegator); err != nil {
+		return nil, errors.Wrap(err, "failed to delete delegation")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h RevokeDelegationHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*Delegation, error) {
+	var msg RevokeDelegationMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+
+	delegator := msg.Delegator
+	if delegator == nil {
+		delegator = x.MainSigner(ctx, h.auth).Address()
+	}
+	if !h.auth.HasAddress(ctx, delegator) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "delegator must sign msg")
+	}
+	delegation, err := h.delegBucket.GetDelegation(db, msg.ElectorateID, delegator)
+	if err != nil {
+		return nil, err
+	}
+	return delegation, nil
+}
+
 type TallyHandler struct {
-	auth       x.Authenticator
-	propBucket *ProposalBucket
-	elecBucket *ElectorateBucket
-	decoder    OptionDecoder
-	executor   Executor
+	auth        x.Authenticator
+	propBucket  *ProposalBucket
+	elecBucket  *ElectorateBucket
+	voteBucket  *VoteBucket
+	delegBucket *DelegationBucket
+	decoder     OptionDecoder
+	executor    Executor
+	control     cash.Controller
 }
 
-func newTallyHandler(auth x.Authenticator, decoder OptionDecoder, executor Executor) *TallyHandler {
+func newTallyHandler(auth x.Authenticator, decoder OptionDecoder, executor Executor, control cash.Controller) *TallyHandler {
 	return &TallyHandler{
-		auth:       auth,
-		propBucket: NewProposalBucket(),
-		elecBucket: NewElectorateBucket(),
-		decoder:    decoder,
-		executor:   executor,
+		auth:        auth,
+		propBucket:  NewProposalBucket(),
+		elecBucket:  NewElectorateBucket(),
+		voteBucket:  NewVoteBucket(),
+		delegBucket: NewDelegationBucket(),
+		decoder:     decoder,
+		executor:    executor,
+		control:     control,
 	}
 }
 
@@ -188,20 +318,43 @@ func (h TallyHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*
 	return nil, errors.Wrap(errors.ErrHuman, "tally handler is to be executed by cron only")
 }
 
-func (h TallyHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (resOut *weave.DeliverResult, errOut error) {
+func (h TallyHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
 	msg, proposal, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
+	res, err := h.tally(ctx, db, msg, proposal)
+	if err != nil {
+		return nil, err
+	}
+	res.Tags = append(res.Tags, tallyTags(msg.ProposalID, proposal)...)
+	return res, nil
+}
+
+// tally counts the votes of given proposal and executes it if it was
+// accepted. The updated proposal is persisted regardless of the election and
+// execution results.
+func (h TallyHandler) tally(ctx weave.Context, db weave.KVStore, msg *TallyMsg, proposal *Proposal) (resOut *weave.DeliverResult, errOut error) {
 	common := proposal
 	if common == nil {
 		return nil, errors.Wrap(errors.ErrState, "missing base proposal information")
 	}
 
+	if err := h.countDelegatedVotes(db, msg.ProposalID, common); err != nil {
+		return nil, err
+	}
 	if err := common.Tally(); err != nil {
 		return nil, err
 	}
 
+	// The deposit is settled independently of the election result. Only
+	// proposals that failed to reach the quorum or were vetoed lose their
+	// deposit.
+	refund := common.VoteState.QuorumReached() && !common.VoteState.Vetoed()
+	if err := releaseDeposit(ctx, db, h.control, msg.ProposalID, proposal, refund); err != nil {
+		return nil, err
+	}
+
 	// store the proposal when done processing it, via whatever path
 	defer func() {
 		if err := h.propBucket.Update(db, msg.ProposalID, proposal); err != nil {
@@ -225,6 +378,10 @@ func (h TallyHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx)
 	if err := opts.Validate(); err != nil {
 		return &weave.DeliverResult{Log: "Proposal accepted: error: options invalid"}, nil
 	}
+	// Text proposals are signaling only and there is nothing to execute.
+	if _, ok := opts.(*TextProposalMsg); ok {
+		return &weave.DeliverResult{Log: "Proposal accepted: signaling only"}, nil
+	}
 
 	// we add the vote ctx here, to authenticate results in the executor
 	// ensure that the gov.Authenticator is used in those Handlers
@@ -235,14 +392,35 @@ func (h TallyHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx)
 		proposal.ExecutorResult = Proposal_Failure
 		return &weave.DeliverResult{Log: "Proposal accepted: error: need cachable kvstore"}, nil
 	}
-	subDB := cstore.CacheWrap()
+	// Messages of a batch are executed one by one, so that the result of
+	// each of them can be recorded. All messages share the same cache, so
+	// the execution remains atomic.
+	msgs := []weave.Msg{opts}
+	if b, ok := opts.(batch.Msg); ok {
+		if msgs, err = b.MsgList(); err != nil {
+			proposal.ExecutorResult = Proposal_Failure
+			return &weave.DeliverResult{Log: "Proposal accepted: error: cannot list batch messages"}, nil
+		}
+	}
 
-	res, err := h.executor(voteCtx, subDB, opts)
-	if err != nil {
-		subDB.Discard()
-		log := fmt.Sprintf("Proposal accepted: execution error: %v", err)
-		proposal.ExecutorResult = Proposal_Failure
-		return &weave.DeliverResult{Log: log}, nil
+	subDB := cstore.CacheWrap()
+	res := &weave.DeliverResult{}
+	for i, m := range msgs {
+		r, err := h.executor(voteCtx, subDB, m)
+		if err != nil {
+			subDB.Discard()
+			proposal.ExecutionResults = append(proposal.ExecutionResults, ExecutionResult{Log: err.Error()})
+			log := fmt.Sprintf("Proposal accepted: execution error: message %d: %v", i, err)
+			proposal.ExecutorResult = Proposal_Failure
+			return &weave.DeliverResult{Log: log}, nil
+		}
+		proposal.ExecutionResults = append(proposal.ExecutionResults, ExecutionResult{
+			Successful: true,
+			Data:       r.Data,
+			Log:        r.Log,
+		})
+		res.Tags = append(res.Tags, r.Tags...)
+		res.Diff = append(res.Diff, r.Diff...)
 	}
 	if err := subDB.Write(); err != nil {
 		log := fmt.Sprintf("Proposal accepted: commit error: %v", err)
@@ -255,6 +433,53 @@ func (h TallyHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx)
 	return res, nil
 }
 
+// countDelegatedVotes adds the weight of every elector that did not vote to
+// the option voted by their delegatee. Delegations are not transitive, so
+// only a direct vote of the delegatee is taken into account.
+func (h TallyHandler) countDelegatedVotes(db weave.KVStore, proposalID []byte, proposal *Proposal) error {
+	delegations, err := h.delegBucket.ElectorateDelegations(db, proposal.ElectorateRef.ID)
+	if err != nil {
+		return err
+	}
+	if len(delegations) == 0 {
+		return nil
+	}
+	obj, err := h.elecBucket.GetVersion(db, proposal.ElectorateRef)
+	if err != nil {
+		return errors.Wrap(err, "failed to load electorate")
+	}
+	elect, err := asElectorate(obj)
+	if err != nil {
+		return errors.Wrap(err, "electorate")
+	}
+	for _, d := range delegations {
+		// Only electors of the electorate version used by the
+		// proposal have a weight to delegate.
+		elector, ok := elect.Elector(d.Delegator)
+		if !ok {
+			continue
+		}
+		// An own vote always takes precedence over the delegation.
+		switch voted, err := h.voteBucket.HasVoted(db, proposalID, d.Delegator); {
+		case err != nil:
+			return err
+		case voted:
+			continue
+		}
+		vote, err := h.voteBucket.GetVote(db, proposalID, d.Delegatee)
+		switch {
+		case errors.ErrNotFound.Is(err):
+			continue
+		case err != nil:
+			return err
+		}
+		if err := proposal.CountVote(Vote{Elector: *elector, Voted: vote.Voted}); err != nil {
+			return errors.Wrap(err, "delegated vote")
+		}
+	}
+	return nil
+}
+
 func (h TallyHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*TallyMsg, *Proposal, error) {
 	var msg TallyMsg
 	if err := weave.LoadMsg(tx, &msg); err != nil {
@@ -284,9 +509,10 @@ type CreateProposalHandler struct {
 	propBucket  *ProposalBucket
 	rulesBucket *ElectionRulesBucket
 	scheduler   weave.Scheduler
+	control     cash.Controller
 }
 
-func newCreateProposalHandler(auth x.Authenticator, decoder OptionDecoder, scheduler weave.Scheduler) *CreateProposalHandler {
+func newCreateProposalHandler(auth x.Authenticator, decoder OptionDecoder, scheduler weave.Scheduler, control cash.Controller) *CreateProposalHandler {
 	return &CreateProposalHandler{
 		auth:        auth,
 		decoder:     decoder,
@@ -294,6 +520,7 @@ func newCreateProposalHandler(auth x.Authenticator, decoder OptionDecoder, sched
 		propBucket:  NewProposalBucket(),
 		rulesBucket: NewElectionRulesBucket(),
 		scheduler:   scheduler,
+		control:     control,
 	}
 }
 
@@ -301,7 +528,7 @@ func (h CreateProposalHandler) Check(ctx weave.Context, db weave.KVStore, tx wea
 	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: proposalCost}, nil
+	return &weave.CheckResult{}, nil
 
 }
 
@@ -316,6 +543,15 @@ func (h CreateProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 	}
 
 	votingEnd := msg.StartTime.Add(rule.VotingPeriod.Duration())
+	voteState := NewTallyResult(rule.Quorum, rule.Threshold, electorate.TotalElectorateWeight)
+	voteState.VetoThreshold = rule.VetoThreshold
+	voteState.WeightCurve = rule.WeightCurve
+	voteState.WeightCap = rule.WeightCap
+	// Total weight must be converted the same way as the vote weights.
+	voteState.TotalElectorateWeight = 0
+	for _, e := range electorate.Electors {
+		voteState.TotalElectorateWeight += voteState.Weight(e.Weight)
+	}
 	proposal := &Proposal{
 		Metadata:        &weave.Metadata{Schema: 1},
 		Title:           msg.Title,
@@ -327,7 +563,7 @@ func (h CreateProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 		VotingEndTime:   votingEnd,
 		SubmissionTime:  weave.AsUnixTime(blockTime),
 		Author:          msg.Author,
-		VoteState:       NewTallyResult(rule.Quorum, rule.Threshold, electorate.TotalElectorateWeight),
+		VoteState:       voteState,
 		Status:          Proposal_Submitted,
 		Result:          Proposal_Undefined,
 		ExecutorResult:  Proposal_NotRun,
@@ -351,6 +587,19 @@ func (h CreateProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 		return nil, errors.Wrap(err, "cannot schedule tally task")
 	}
 
+	// The deposit is held by an address unique to the proposal until
+	// the tally, so the proposal must be persisted first.
+	deposit, err := proposalDeposit(db)
+	if err != nil {
+		return nil, err
+	}
+	if !deposit.IsZero() {
+		if err := h.control.MoveCoins(ctx, db, msg.Author, DepositAddress(obj.Key()), deposit); err != nil {
+			return nil, errors.Wrap(err, "cannot pay proposal deposit")
+		}
+		proposal.Deposit = deposit
+	}
+
 	// Update the proposal with the task ID. We need the task ID in order
 	// to check the task state and if needed to delete the scheduled job.
 	proposal.TallyTaskID = taskID
@@ -431,13 +680,15 @@ type DeleteProposalHandler struct {
 	auth       x.Authenticator
 	propBucket *ProposalBucket
 	scheduler  weave.Scheduler
+	control    cash.Controller
 }
 
-func newDeleteProposalHandler(auth x.Authenticator, scheduler weave.Scheduler) *DeleteProposalHandler {
+func newDeleteProposalHandler(auth x.Authenticator, scheduler weave.Scheduler, control cash.Controller) *DeleteProposalHandler {
 	return &DeleteProposalHandler{
 		auth:       auth,
 		propBucket: NewProposalBucket(),
 		scheduler:  scheduler,
+		control:    control,
 	}
 }
 
@@ -468,7 +719,7 @@ func (h DeleteProposalHandler) Check(ctx weave.Context, db weave.KVStore, tx wea
 	if _, _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: deleteProposalCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h DeleteProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
@@ -479,6 +730,11 @@ func (h DeleteProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 
 	prop.Status = Proposal_Withdrawn
 
+	// A withdrawn proposal never reaches the quorum.
+	if err := releaseDeposit(ctx, db, h.control, msg.ProposalID, prop, false); err != nil {
+		return nil, err
+	}
+
 	if err := h.propBucket.Update(db, msg.ProposalID, prop); err != nil {
 		return nil, errors.Wrap(err, "failed to persist proposal")
 	}
@@ -496,6 +752,25 @@ func (h DeleteProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx w
 	return &weave.DeliverResult{}, nil
 }
 
+// releaseDeposit releases the deposit held for given proposal. The deposit is
+// refunded to the author if refund is true and burned otherwise.
+func releaseDeposit(ctx weave.Context, db weave.KVStore, control cash.Controller, proposalID []byte, p *Proposal, refund bool) error {
+	if p.Deposit.IsZero() {
+		return nil
+	}
+	src := DepositAddress(proposalID)
+	if refund {
+		if err := control.MoveCoins(ctx, db, src, p.Author, p.Deposit); err != nil {
+			return errors.Wrap(err, "cannot refund deposit")
+		}
+		return nil
+	}
+	if err := control.CoinBurn(db, src, p.Deposit); err != nil {
+		return errors.Wrap(err, "cannot burn deposit")
+	}
+	return nil
+}
+
 type UpdateElectorateHandler struct {
 	auth       x.Authenticator
 	propBucket *ProposalBucket
@@ -515,7 +790,7 @@ func (h UpdateElectorateHandler) Check(ctx weave.Context, db weave.KVStore, tx w
 	if err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: updateElectorateCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h UpdateElectorateHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
@@ -577,7 +852,7 @@ func (h UpdateElectionRuleHandler) Check(ctx weave.Context, db weave.KVStore, tx
 	if err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: updateElectionRuleCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h UpdateElectionRuleHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
@@ -588,6 +863,9 @@ func (h UpdateElectionRuleHandler) Deliver(ctx weave.Context, db weave.KVStore,
 	rule.Threshold = msg.Threshold
 	rule.VotingPeriod = msg.VotingPeriod
 	rule.Quorum = msg.Quorum
+	rule.VetoThreshold = msg.VetoThreshold
+	rule.WeightCurve = msg.WeightCurve
+	rule.WeightCap = msg.WeightCap
 	if _, err := h.ruleBucket.Update(db, msg.ElectionRuleID, rule); err != nil {
 		return nil, errors.Wrap(err, "failed to store update")
 	}
@@ -630,7 +908,7 @@ func (h createTextResolutionHandler) Check(ctx weave.Context, db weave.KVStore,
 	if err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: textResolutionCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h createTextResolutionHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
@@ -666,3 +944,54 @@ func (h createTextResolutionHandler) validate(ctx weave.Context, db weave.KVStor
 	// No auth, this can only be executed by gov proposal, and that info is stored alongside the resolution
 	return &msg, nil
 }
+
+type changeParametersHandler struct {
+	auth x.Authenticator
+}
+
+func newChangeParametersHandler(auth x.Authenticator) *changeParametersHandler {
+	return &changeParametersHandler{auth: auth}
+}
+
+func (h changeParametersHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h changeParametersHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	// Executor runs on a cached store, so a failing change discards
+	// all previous ones.
+	var res weave.DeliverResult
+	for i, c := range msg.Changes {
+		tags, err := gconf.UpdateRegistered(ctx, db, h.auth, c.Package, c.Patch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "change %d: %q configuration", i, c.Package)
+		}
+		res.Tags = append(res.Tags, tags...)
+	}
+	return &res, nil
+}
+
+func (h changeParametersHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ChangeParametersMsg, error) {
+	var msg ChangeParametersMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	// Authorization is done by each configuration update. The
+	// configuration owner must be the election rule that accepted the
+	// proposal.
+	return &msg, nil
+}
+
+// NewConfigHandler returns a handler that allows to update the governance
+// configuration.
+func NewConfigHandler(auth x.Authenticator) weave.Handler {
+	var conf Configuration
+	return gconf.NewUpdateConfigurationHandler(packageName, &conf, auth)
+}
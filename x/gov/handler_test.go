@@ -2,6 +2,7 @@ package gov
 
 import (
 	"context"
+	"encoding/json"
 	"math"
 	"reflect"
 	"strings"
@@ -10,12 +11,16 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
+	"github.com/iov-one/weave/x/cash"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 var (
@@ -296,6 +301,46 @@ func TestCreateTextProposal(t *testing.T) {
 			WantCheckErr:   errors.ErrUnauthorized,
 			WantDeliverErr: errors.ErrUnauthorized,
 		},
+		"Weight curve is applied to the total electorate weight": {
+			Init: func(t *testing.T, db weave.KVStore) {
+				rule := withElectionRule(t, db)
+				rule.WeightCurve = WeightCurve_Quadratic
+				_, err := NewElectionRulesBucket().Update(db, weavetest.SequenceID(1), rule)
+				assert.Nil(t, err)
+			},
+			Msg: CreateProposalMsg{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          "my proposal",
+				Description:    "my description",
+				StartTime:      now.Add(time.Hour),
+				ElectionRuleID: weavetest.SequenceID(1),
+				Author:         hBobby,
+				RawOption:      textOption,
+			},
+			Signers: []weave.Condition{hAliceCond, hBobbyCond},
+			Exp: Proposal{
+				Metadata:        &weave.Metadata{Schema: 1},
+				Title:           "my proposal",
+				Description:     "my description",
+				ElectionRuleRef: orm.VersionedIDRef{ID: weavetest.SequenceID(1), Version: 2},
+				ElectorateRef:   orm.VersionedIDRef{ID: weavetest.SequenceID(1), Version: 1},
+				VotingStartTime: now.Add(time.Hour),
+				VotingEndTime:   now.Add(2 * time.Hour),
+				Status:          Proposal_Submitted,
+				Result:          Proposal_Undefined,
+				ExecutorResult:  Proposal_NotRun,
+				SubmissionTime:  now,
+				Author:          hBobby,
+				VoteState: TallyResult{
+					Threshold: Fraction{Numerator: 1, Denominator: 2},
+					// Square roots of weights 1 and 10 are summed.
+					TotalElectorateWeight: 4,
+					WeightCurve:           WeightCurve_Quadratic,
+				},
+				RawOption: textOption,
+			},
+			ExpProposer: hBobby,
+		},
 		"A proposal creation can be signed by any electorate member": {
 			Init: func(t *testing.T, db weave.KVStore) {
 				createElectorate(t, db, []weave.Address{
@@ -352,7 +397,7 @@ func TestCreateTextProposal(t *testing.T) {
 			rt := app.NewRouter()
 			cron := &weavetest.Cron{}
 			// We don't run the executor here, so we can safely pass in nil.
-			RegisterRoutes(rt, auth, decodeProposalOptions, nil, cron)
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, cron, nil)
 
 			db := store.MemStore()
 			migration.MustInitPkg(db, packageName)
@@ -468,7 +513,7 @@ func TestDeleteProposal(t *testing.T) {
 				Signer: spec.SignedBy,
 			}
 			rt := app.NewRouter()
-			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{})
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
 
 			// given
 			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
@@ -577,6 +622,140 @@ func TestCreateResolution(t *testing.T) {
 	}
 }
 
+func TestChangeParameters(t *testing.T) {
+	ruleID := weavetest.SequenceID(1)
+	ruleAddr := ElectionCondition(ruleID).Address()
+
+	patch := func(c testConfig) []byte {
+		raw, err := json.Marshal(c)
+		if err != nil {
+			t.Fatalf("cannot serialize patch: %s", err)
+		}
+		return raw
+	}
+
+	specs := map[string]struct {
+		Init           testConfig
+		Msg            ChangeParametersMsg
+		WantCheckErr   *errors.Error
+		WantDeliverErr *errors.Error
+		WantConfig     testConfig
+		WantTags       []common.KVPair
+	}{
+		"Happy path": {
+			Init: testConfig{Owner: ruleAddr, Limit: 1, Name: "foo"},
+			Msg: ChangeParametersMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Changes: []ParameterChange{
+					{Package: "govtest", Patch: patch(testConfig{Limit: 5})},
+				},
+			},
+			WantConfig: testConfig{Owner: ruleAddr, Limit: 5, Name: "foo"},
+			WantTags: []common.KVPair{
+				{Key: []byte("gconf.update"), Value: []byte("govtest")},
+				{Key: []byte("gconf.govtest.fields"), Value: []byte("Limit")},
+				{Key: []byte("gconf.govtest.updater"), Value: []byte(ruleAddr.String())},
+			},
+		},
+		"Configuration not owned by the election rule": {
+			Init: testConfig{Owner: hAlice, Limit: 1, Name: "foo"},
+			Msg: ChangeParametersMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Changes: []ParameterChange{
+					{Package: "govtest", Patch: patch(testConfig{Limit: 5})},
+				},
+			},
+			WantDeliverErr: errors.ErrUnauthorized,
+			WantConfig:     testConfig{Owner: hAlice, Limit: 1, Name: "foo"},
+		},
+		"Configuration not registered": {
+			Init: testConfig{Owner: ruleAddr, Limit: 1, Name: "foo"},
+			Msg: ChangeParametersMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Changes: []ParameterChange{
+					{Package: "unknown", Patch: patch(testConfig{Limit: 5})},
+				},
+			},
+			WantDeliverErr: errors.ErrNotFound,
+			WantConfig:     testConfig{Owner: ruleAddr, Limit: 1, Name: "foo"},
+		},
+		"Invalid configuration": {
+			Init: testConfig{Owner: ruleAddr, Limit: 1, Name: "foo"},
+			Msg: ChangeParametersMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Changes: []ParameterChange{
+					{Package: "govtest", Patch: patch(testConfig{Limit: -1})},
+				},
+			},
+			WantDeliverErr: errors.ErrInput,
+			WantConfig:     testConfig{Owner: ruleAddr, Limit: 1, Name: "foo"},
+		},
+		"No changes": {
+			Init:           testConfig{Owner: ruleAddr, Limit: 1, Name: "foo"},
+			Msg:            ChangeParametersMsg{Metadata: &weave.Metadata{Schema: 1}},
+			WantCheckErr:   errors.ErrEmpty,
+			WantDeliverErr: errors.ErrEmpty,
+			WantConfig:     testConfig{Owner: ruleAddr, Limit: 1, Name: "foo"},
+		},
+	}
+
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, packageName)
+			assert.Nil(t, gconf.Save(db, "govtest", &spec.Init))
+
+			rt := app.NewRouter()
+			RegisterBasicProposalRouters(rt, Authenticate{})
+			ctx := withElectionSuccess(context.Background(), ruleID)
+
+			tx := &weavetest.Tx{Msg: &spec.Msg}
+			cache := db.CacheWrap()
+			if _, err := rt.Check(ctx, cache, tx); !spec.WantCheckErr.Is(err) {
+				t.Fatalf("check expected: %+v  but got %+v", spec.WantCheckErr, err)
+			}
+			cache.Discard()
+
+			cache = db.CacheWrap()
+			res, err := rt.Deliver(ctx, cache, tx)
+			if !spec.WantDeliverErr.Is(err) {
+				t.Fatalf("deliver expected: %+v  but got %+v", spec.WantDeliverErr, err)
+			}
+			if spec.WantDeliverErr == nil {
+				assert.Nil(t, cache.Write())
+				assert.Equal(t, spec.WantTags, res.Tags)
+			}
+
+			var got testConfig
+			assert.Nil(t, gconf.Load(db, "govtest", &got))
+			assert.Equal(t, spec.WantConfig, got)
+		})
+	}
+}
+
+func init() {
+	gconf.Register("govtest", &testConfig{})
+}
+
+// testConfig is an extension configuration that can be changed by
+// governance.
+type testConfig struct {
+	Owner weave.Address
+	Limit int64
+	Name  string
+}
+
+func (c *testConfig) GetOwner() weave.Address    { return c.Owner }
+func (c *testConfig) Marshal() ([]byte, error)   { return json.Marshal(c) }
+func (c *testConfig) Unmarshal(raw []byte) error { return json.Unmarshal(raw, c) }
+
+func (c *testConfig) Validate() error {
+	if c.Limit < 0 {
+		return errors.Wrap(errors.ErrInput, "negative limit")
+	}
+	return nil
+}
+
 func TestVote(t *testing.T) {
 	proposalID := weavetest.SequenceID(1)
 	nonElectorCond := weavetest.NewCondition()
@@ -775,7 +954,7 @@ func TestVote(t *testing.T) {
 				Signer: spec.SignedBy,
 			}
 			rt := app.NewRouter()
-			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{})
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
 
 			// given
 			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
@@ -821,12 +1000,82 @@ func TestVote(t *testing.T) {
 	}
 }
 
+func TestVoteWithElectorateSnapshot(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+	withElectorate(t, db)
+	withElectionRule(t, db)
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
+
+	now := weave.AsUnixTime(time.Now().Round(time.Second))
+	ctx := weave.WithBlockTime(context.Background(), now.Time())
+	res, err := rt.Deliver(auth.SetConditions(ctx, hBobbyCond), db, &weavetest.Tx{Msg: &CreateProposalMsg{
+		Metadata:       &weave.Metadata{Schema: 1},
+		Title:          "my proposal",
+		Description:    "my description",
+		StartTime:      now.Add(time.Hour),
+		ElectionRuleID: weavetest.SequenceID(1),
+		Author:         hBobby,
+		RawOption:      genTextOptions(t),
+	}})
+	if err != nil {
+		t.Fatalf("cannot create proposal: %s", err)
+	}
+	proposalID := res.Data
+
+	// Membership changes after the proposal was created must not
+	// influence the election.
+	_, err = rt.Deliver(auth.SetConditions(ctx, hBobbyCond), db, &weavetest.Tx{Msg: &UpdateElectorateMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		ElectorateID: weavetest.SequenceID(1),
+		DiffElectors: []Elector{
+			{Address: hAlice, Weight: 0},
+			{Address: hBobby, Weight: 20},
+			{Address: hCharlie, Weight: 5},
+		},
+	}})
+	if err != nil {
+		t.Fatalf("cannot update electorate: %s", err)
+	}
+
+	voteCtx := weave.WithBlockTime(context.Background(), now.Add(time.Hour+time.Second).Time())
+	vote := func(voter weave.Condition) error {
+		_, err := rt.Deliver(auth.SetConditions(voteCtx, voter), db, &weavetest.Tx{Msg: &VoteMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ProposalID: proposalID,
+			Selected:   VoteOption_Yes,
+		}})
+		return err
+	}
+	if err := vote(hAliceCond); err != nil {
+		t.Fatalf("removed elector must be able to vote: %s", err)
+	}
+	if err := vote(hBobbyCond); err != nil {
+		t.Fatalf("cannot vote: %s", err)
+	}
+	if err := vote(hCharlieCond); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("added elector must not be able to vote: %+v", err)
+	}
+
+	p, err := NewProposalBucket().GetProposal(db, proposalID)
+	if err != nil {
+		t.Fatalf("cannot load proposal: %s", err)
+	}
+	assert.Equal(t, uint32(1), p.ElectorateRef.Version)
+	assert.Equal(t, uint64(11), p.VoteState.TotalYes)
+	assert.Equal(t, uint64(11), p.VoteState.TotalElectorateWeight)
+}
+
 func TestTally(t *testing.T) {
 	type tallySetup struct {
-		quorum                *Fraction
-		threshold             Fraction
-		totalWeightElectorate uint64
-		yes, no, abstain      uint64
+		quorum                 *Fraction
+		threshold              Fraction
+		vetoThreshold          *Fraction
+		totalWeightElectorate  uint64
+		yes, no, abstain, veto uint64
 	}
 	specs := map[string]struct {
 		Mods              func(weave.Context, *Proposal)
@@ -856,6 +1105,42 @@ func TestTally(t *testing.T) {
 				assert.Equal(t, res.Resolution, fixtureResolution)
 			},
 		},
+		"Rejected when vetoed": {
+			Src: tallySetup{
+				yes:                   6,
+				veto:                  4,
+				threshold:             Fraction{Numerator: 1, Denominator: 2},
+				vetoThreshold:         &Fraction{Numerator: 1, Denominator: 3},
+				totalWeightElectorate: 11,
+			},
+			ExpResult:         Proposal_Rejected,
+			ExpExecutorResult: Proposal_NotRun,
+			WantDeliverLog:    "Proposal not accepted",
+		},
+		"Accepted when veto threshold is not exceeded": {
+			Src: tallySetup{
+				yes:                   6,
+				veto:                  3,
+				threshold:             Fraction{Numerator: 1, Denominator: 2},
+				vetoThreshold:         &Fraction{Numerator: 1, Denominator: 3},
+				totalWeightElectorate: 11,
+			},
+			ExpResult:         Proposal_Accepted,
+			ExpExecutorResult: Proposal_Success,
+			WantDeliverLog:    "Proposal accepted: execution success",
+		},
+		"Vetoes are counted as No votes with a quorum": {
+			Src: tallySetup{
+				yes:                   5,
+				veto:                  5,
+				quorum:                &Fraction{Numerator: 1, Denominator: 2},
+				threshold:             Fraction{Numerator: 1, Denominator: 2},
+				totalWeightElectorate: 11,
+			},
+			ExpResult:         Proposal_Rejected,
+			ExpExecutorResult: Proposal_NotRun,
+			WantDeliverLog:    "Proposal not accepted",
+		},
 		"Accepted with all yes votes required": {
 			Src: tallySetup{
 				yes:                   9,
@@ -1251,7 +1536,7 @@ func TestTally(t *testing.T) {
 	}
 	rt := app.NewRouter()
 	// Tally is registered for the cron, not for the usual routes.
-	RegisterCronRoutes(rt, nil, decodeProposalOptions, proposalOptionsExecutor())
+	RegisterCronRoutes(rt, nil, decodeProposalOptions, proposalOptionsExecutor(), nil)
 
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -1261,9 +1546,11 @@ func TestTally(t *testing.T) {
 			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
 			setupForTally := func(_ weave.Context, p *Proposal) {
 				p.VoteState = NewTallyResult(spec.Src.quorum, spec.Src.threshold, spec.Src.totalWeightElectorate)
+				p.VoteState.VetoThreshold = spec.Src.vetoThreshold
 				p.VoteState.TotalYes = spec.Src.yes
 				p.VoteState.TotalNo = spec.Src.no
 				p.VoteState.TotalAbstain = spec.Src.abstain
+				p.VoteState.TotalNoWithVeto = spec.Src.veto
 				p.VotingEndTime = unixBlockTime(t, ctx) - 1
 			}
 			pBucket := withTextProposal(t, db, ctx, append([]ctxAwareMutator{setupForTally}, spec.Mods)...)
@@ -1288,6 +1575,9 @@ func TestTally(t *testing.T) {
 			if spec.WantDeliverLog != "" && !strings.HasPrefix(dres.Log, spec.WantDeliverLog) {
 				t.Errorf("want Log: %s\ngot Log: %s", spec.WantDeliverLog, dres.Log)
 			}
+			if len(dres.Tags) == 0 || string(dres.Tags[0].Key) != "gov.tally" {
+				t.Errorf("tally tag not found: %v", dres.Tags)
+			}
 
 			// check persisted result
 			p, err := pBucket.GetProposal(db, weavetest.SequenceID(1))
@@ -1310,6 +1600,536 @@ func TestTally(t *testing.T) {
 	}
 }
 
+func TestTallyTextProposal(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+
+	ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
+	accepted := func(_ weave.Context, p *Proposal) {
+		p.VoteState = NewTallyResult(nil, Fraction{Numerator: 1, Denominator: 2}, 11)
+		p.VoteState.TotalYes = 10
+		p.VotingEndTime = unixBlockTime(t, ctx) - 1
+	}
+	pBucket := withTextProposal(t, db, ctx, accepted)
+
+	decoder := func(raw []byte) (weave.Msg, error) {
+		return &TextProposalMsg{
+			Metadata:    &weave.Metadata{Schema: 1},
+			DocumentURL: "https://example.com/proposal.pdf",
+		}, nil
+	}
+	executor := func(weave.Context, weave.KVStore, weave.Msg) (*weave.DeliverResult, error) {
+		t.Fatal("text proposal must not be executed")
+		return nil, nil
+	}
+	rt := app.NewRouter()
+	RegisterCronRoutes(rt, nil, decoder, executor, nil)
+
+	res, err := rt.Deliver(ctx, db, &weavetest.Tx{
+		Msg: &TallyMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ProposalID: weavetest.SequenceID(1),
+		},
+	})
+	if err != nil {
+		t.Fatalf("cannot tally: %s", err)
+	}
+	assert.Equal(t, "Proposal accepted: signaling only", res.Log)
+
+	p, err := pBucket.GetProposal(db, weavetest.SequenceID(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	assert.Equal(t, Proposal_Accepted, p.Result)
+	assert.Equal(t, Proposal_NotRun, p.ExecutorResult)
+}
+
+// testBatchMsg is a batch message used to test the execution of proposals
+// containing multiple messages.
+type testBatchMsg struct {
+	weavetest.Msg
+	msgs []weave.Msg
+}
+
+func (m *testBatchMsg) MsgList() ([]weave.Msg, error) {
+	return m.msgs, nil
+}
+
+func TestTallyBatchExecution(t *testing.T) {
+	specs := map[string]struct {
+		Paths             []string
+		ExpExecutorResult Proposal_ExecutorResult
+		ExpResults        []ExecutionResult
+		ExpStored         []string
+	}{
+		"All messages are executed": {
+			Paths:             []string{"first", "second"},
+			ExpExecutorResult: Proposal_Success,
+			ExpResults: []ExecutionResult{
+				{Successful: true, Data: []byte("first"), Log: "executed first"},
+				{Successful: true, Data: []byte("second"), Log: "executed second"},
+			},
+			ExpStored: []string{"first", "second"},
+		},
+		"A failure discards changes of all messages": {
+			Paths:             []string{"first", "fail", "second"},
+			ExpExecutorResult: Proposal_Failure,
+			ExpResults: []ExecutionResult{
+				{Successful: true, Data: []byte("first"), Log: "executed first"},
+				{Successful: false, Log: "fail: invalid state"},
+			},
+		},
+	}
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, packageName)
+
+			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
+			accepted := func(_ weave.Context, p *Proposal) {
+				p.VoteState = NewTallyResult(nil, Fraction{Numerator: 1, Denominator: 2}, 11)
+				p.VoteState.TotalYes = 10
+				p.VotingEndTime = unixBlockTime(t, ctx) - 1
+			}
+			pBucket := withTextProposal(t, db, ctx, accepted)
+
+			decoder := func(raw []byte) (weave.Msg, error) {
+				var msgs []weave.Msg
+				for _, p := range spec.Paths {
+					msgs = append(msgs, &weavetest.Msg{RoutePath: p})
+				}
+				return &testBatchMsg{msgs: msgs}, nil
+			}
+			// Executor stores the path of every executed message.
+			executor := func(ctx weave.Context, db weave.KVStore, msg weave.Msg) (*weave.DeliverResult, error) {
+				if msg.Path() == "fail" {
+					return nil, errors.Wrap(errors.ErrState, "fail")
+				}
+				if err := db.Set([]byte("executed:"+msg.Path()), []byte(msg.Path())); err != nil {
+					return nil, err
+				}
+				return &weave.DeliverResult{Data: []byte(msg.Path()), Log: "executed " + msg.Path()}, nil
+			}
+			rt := app.NewRouter()
+			RegisterCronRoutes(rt, nil, decoder, executor, nil)
+
+			_, err := rt.Deliver(ctx, db, &weavetest.Tx{
+				Msg: &TallyMsg{
+					Metadata:   &weave.Metadata{Schema: 1},
+					ProposalID: weavetest.SequenceID(1),
+				},
+			})
+			if err != nil {
+				t.Fatalf("cannot tally: %s", err)
+			}
+
+			p, err := pBucket.GetProposal(db, weavetest.SequenceID(1))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			assert.Equal(t, spec.ExpExecutorResult, p.ExecutorResult)
+			assert.Equal(t, spec.ExpResults, p.ExecutionResults)
+
+			for _, path := range spec.Paths {
+				ok, err := db.Has([]byte("executed:" + path))
+				assert.Nil(t, err)
+				want := false
+				for _, s := range spec.ExpStored {
+					want = want || s == path
+				}
+				if ok != want {
+					t.Errorf("message %q changes stored: %v", path, ok)
+				}
+			}
+		})
+	}
+}
+
+func TestTallyTags(t *testing.T) {
+	specs := map[string]struct {
+		Result         Proposal_Result
+		ExecutorResult Proposal_ExecutorResult
+		WantTags       []common.KVPair
+	}{
+		"Rejected": {
+			Result:         Proposal_Rejected,
+			ExecutorResult: Proposal_NotRun,
+			WantTags: []common.KVPair{
+				{Key: []byte("gov.tally"), Value: []byte("0000000000000001")},
+				{Key: []byte("gov.tally.0000000000000001"), Value: []byte("rejected")},
+			},
+		},
+		"Accepted and executed": {
+			Result:         Proposal_Accepted,
+			ExecutorResult: Proposal_Success,
+			WantTags: []common.KVPair{
+				{Key: []byte("gov.tally"), Value: []byte("0000000000000001")},
+				{Key: []byte("gov.tally.0000000000000001"), Value: []byte("accepted")},
+				{Key: []byte("gov.execute.0000000000000001"), Value: []byte("success")},
+			},
+		},
+		"Accepted but execution failed": {
+			Result:         Proposal_Accepted,
+			ExecutorResult: Proposal_Failure,
+			WantTags: []common.KVPair{
+				{Key: []byte("gov.tally"), Value: []byte("0000000000000001")},
+				{Key: []byte("gov.tally.0000000000000001"), Value: []byte("accepted")},
+				{Key: []byte("gov.execute.0000000000000001"), Value: []byte("failure")},
+			},
+		},
+	}
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			p := &Proposal{Result: spec.Result, ExecutorResult: spec.ExecutorResult}
+			tags := tallyTags(weavetest.SequenceID(1), p)
+			if !reflect.DeepEqual(spec.WantTags, tags) {
+				t.Fatalf("unexpected tags: %v", tags)
+			}
+		})
+	}
+}
+
+func TestProposalDeposit(t *testing.T) {
+	ctx := context.Background()
+	deposit := coin.NewCoin(5, 0, "IOV")
+
+	specs := map[string]struct {
+		Quorum   *Fraction
+		Votes    uint64
+		Vetoes   uint64
+		Withdraw bool
+		// Expected balance of the author after the deposit was settled.
+		WantAuthorBalance coin.Coins
+	}{
+		"Refund when quorum is reached": {
+			Quorum:            &Fraction{Numerator: 1, Denominator: 2},
+			Votes:             10,
+			WantAuthorBalance: coin.Coins{coin.NewCoinp(7, 0, "IOV")},
+		},
+		"Refund without a quorum": {
+			Quorum:            nil,
+			Votes:             0,
+			WantAuthorBalance: coin.Coins{coin.NewCoinp(7, 0, "IOV")},
+		},
+		"Burn when quorum is not reached": {
+			Quorum:            &Fraction{Numerator: 1, Denominator: 2},
+			Votes:             1,
+			WantAuthorBalance: coin.Coins{coin.NewCoinp(2, 0, "IOV")},
+		},
+		"Burn when vetoed": {
+			Quorum:            &Fraction{Numerator: 1, Denominator: 2},
+			Votes:             1,
+			Vetoes:            10,
+			WantAuthorBalance: coin.Coins{coin.NewCoinp(2, 0, "IOV")},
+		},
+		"Burn when proposal is withdrawn": {
+			Withdraw:          true,
+			WantAuthorBalance: coin.Coins{coin.NewCoinp(2, 0, "IOV")},
+		},
+	}
+
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, packageName, "cash")
+
+			ctrl := cash.NewController(cash.NewBucket())
+			assert.Nil(t, ctrl.CoinMint(ctx, db, hAlice, coin.NewCoin(7, 0, "IOV")))
+			conf := Configuration{
+				Metadata:        &weave.Metadata{Schema: 1},
+				ProposalDeposit: deposit,
+			}
+			assert.Nil(t, gconf.Save(db, packageName, &conf))
+			withElectionRule(t, db)
+			withElectorate(t, db)
+
+			auth := &weavetest.Auth{Signer: hAliceCond}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, ctrl)
+			cronRt := app.NewRouter()
+			RegisterCronRoutes(cronRt, nil, decodeProposalOptions, proposalOptionsExecutor(), ctrl)
+
+			now := weave.AsUnixTime(time.Now())
+			ctx := weave.WithBlockTime(context.Background(), now.Time())
+			res, err := rt.Deliver(ctx, db, &weavetest.Tx{Msg: &CreateProposalMsg{
+				Metadata:       &weave.Metadata{Schema: 1},
+				Title:          "my proposal",
+				Description:    "my description",
+				StartTime:      now.Add(time.Hour),
+				ElectionRuleID: weavetest.SequenceID(1),
+				RawOption:      genTextOptions(t),
+			}})
+			if err != nil {
+				t.Fatalf("cannot create proposal: %s", err)
+			}
+			proposalID := res.Data
+
+			// The deposit is held until the proposal is settled.
+			assertBalance(t, ctrl, db, hAlice, coin.Coins{coin.NewCoinp(2, 0, "IOV")})
+			assertBalance(t, ctrl, db, DepositAddress(proposalID), coin.Coins{&deposit})
+			p, err := NewProposalBucket().GetProposal(db, proposalID)
+			assert.Nil(t, err)
+			assert.Equal(t, deposit, p.Deposit)
+
+			if spec.Withdraw {
+				_, err := rt.Deliver(ctx, db, &weavetest.Tx{Msg: &DeleteProposalMsg{
+					Metadata:   &weave.Metadata{Schema: 1},
+					ProposalID: proposalID,
+				}})
+				if err != nil {
+					t.Fatalf("cannot delete proposal: %s", err)
+				}
+			} else {
+				p.VoteState.Quorum = spec.Quorum
+				p.VoteState.VetoThreshold = &Fraction{Numerator: 1, Denominator: 3}
+				p.VoteState.TotalAbstain = spec.Votes
+				p.VoteState.TotalNoWithVeto = spec.Vetoes
+				assert.Nil(t, NewProposalBucket().Update(db, proposalID, p))
+
+				tallyCtx := weave.WithBlockTime(context.Background(), now.Add(3*time.Hour).Time())
+				_, err := cronRt.Deliver(tallyCtx, db, &weavetest.Tx{Msg: &TallyMsg{
+					Metadata:   &weave.Metadata{Schema: 1},
+					ProposalID: proposalID,
+				}})
+				if err != nil {
+					t.Fatalf("cannot tally proposal: %s", err)
+				}
+			}
+
+			assertBalance(t, ctrl, db, hAlice, spec.WantAuthorBalance)
+			assertBalance(t, ctrl, db, DepositAddress(proposalID), nil)
+		})
+	}
+}
+
+func assertBalance(t testing.TB, ctrl cash.Controller, db weave.KVStore, addr weave.Address, want coin.Coins) {
+	t.Helper()
+	got, err := ctrl.Balance(db, addr)
+	if err != nil && !errors.ErrNotFound.Is(err) {
+		t.Fatalf("cannot get %s balance: %s", addr, err)
+	}
+	if !want.Equals(got) {
+		t.Fatalf("want %v balance of %s, got %v", want, addr, got)
+	}
+}
+
+func TestDelegateVote(t *testing.T) {
+	electorateID := weavetest.SequenceID(1)
+
+	specs := map[string]struct {
+		Msg            weave.Msg
+		SignedBy       weave.Condition
+		WantCheckErr   *errors.Error
+		WantDeliverErr *errors.Error
+		ExpDelegatee   weave.Address
+	}{
+		"Happy path": {
+			Msg: &DelegateVoteMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: electorateID,
+				Delegatee:    hBobby,
+			},
+			SignedBy:     hAliceCond,
+			ExpDelegatee: hBobby,
+		},
+		"Delegator must be an elector": {
+			Msg: &DelegateVoteMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: electorateID,
+				Delegatee:    hBobby,
+			},
+			SignedBy:       hCharlieCond,
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+		},
+		"Delegator must sign": {
+			Msg: &DelegateVoteMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: electorateID,
+				Delegator:    hAlice,
+				Delegatee:    hBobby,
+			},
+			SignedBy:       hBobbyCond,
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+		},
+		"Delegation to self": {
+			Msg: &DelegateVoteMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: electorateID,
+				Delegatee:    hAlice,
+			},
+			SignedBy:       hAliceCond,
+			WantCheckErr:   errors.ErrInput,
+			WantDeliverErr: errors.ErrInput,
+		},
+		"Unknown electorate": {
+			Msg: &DelegateVoteMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: weavetest.SequenceID(2),
+				Delegatee:    hBobby,
+			},
+			SignedBy:       hAliceCond,
+			WantCheckErr:   errors.ErrNotFound,
+			WantDeliverErr: errors.ErrNotFound,
+		},
+		"Revoke delegation": {
+			Msg: &RevokeDelegationMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: electorateID,
+			},
+			SignedBy: hBobbyCond,
+		},
+		"Revoke not existing delegation": {
+			Msg: &RevokeDelegationMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: electorateID,
+			},
+			SignedBy:       hAliceCond,
+			WantCheckErr:   errors.ErrNotFound,
+			WantDeliverErr: errors.ErrNotFound,
+		},
+	}
+
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, packageName)
+			withElectorate(t, db)
+
+			// Bobby always delegates to Alice.
+			delegations := NewDelegationBucket()
+			err := delegations.Save(db, delegations.Build(Delegation{
+				Metadata:     &weave.Metadata{Schema: 1},
+				ElectorateID: electorateID,
+				Delegator:    hBobby,
+				Delegatee:    hAlice,
+			}))
+			assert.Nil(t, err)
+
+			auth := &weavetest.Auth{Signer: spec.SignedBy}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
+
+			ctx := weave.WithBlockTime(context.Background(), time.Now().Round(time.Second))
+			tx := &weavetest.Tx{Msg: spec.Msg}
+			cache := db.CacheWrap()
+			if _, err := rt.Check(ctx, cache, tx); !spec.WantCheckErr.Is(err) {
+				t.Fatalf("check expected: %+v  but got %+v", spec.WantCheckErr, err)
+			}
+			cache.Discard()
+			if _, err := rt.Deliver(ctx, db, tx); !spec.WantDeliverErr.Is(err) {
+				t.Fatalf("deliver expected: %+v  but got %+v", spec.WantDeliverErr, err)
+			}
+			if spec.WantDeliverErr != nil {
+				return // skip further checks on expected error
+			}
+
+			d, err := delegations.GetDelegation(db, electorateID, spec.SignedBy.Address())
+			if spec.ExpDelegatee == nil {
+				if !errors.ErrNotFound.Is(err) {
+					t.Fatalf("want delegation to be deleted, got %+v", err)
+				}
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, spec.ExpDelegatee, d.Delegatee)
+		})
+	}
+}
+
+func TestTallyWithDelegations(t *testing.T) {
+	type vote struct {
+		Voter  weave.Condition
+		Option VoteOption
+	}
+	specs := map[string]struct {
+		Delegate bool
+		Revoke   bool
+		Votes    []vote
+		ExpYes   uint64
+		ExpNo    uint64
+	}{
+		"Delegatee vote is counted for the delegator": {
+			Delegate: true,
+			Votes:    []vote{{Voter: hBobbyCond, Option: VoteOption_Yes}},
+			ExpYes:   11,
+		},
+		"Own vote takes precedence over the delegation": {
+			Delegate: true,
+			Votes: []vote{
+				{Voter: hBobbyCond, Option: VoteOption_Yes},
+				{Voter: hAliceCond, Option: VoteOption_No},
+			},
+			ExpYes: 10,
+			ExpNo:  1,
+		},
+		"Nothing is counted when the delegatee did not vote": {
+			Delegate: true,
+		},
+		"Revoked delegation is ignored": {
+			Delegate: true,
+			Revoke:   true,
+			Votes:    []vote{{Voter: hBobbyCond, Option: VoteOption_Yes}},
+			ExpYes:   10,
+		},
+	}
+
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, packageName)
+
+			now := time.Now().Round(time.Second)
+			ctx := weave.WithBlockTime(context.Background(), now)
+			withTextProposal(t, db, ctx)
+
+			auth := &weavetest.CtxAuth{Key: "auth"}
+			rt := app.NewRouter()
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
+			cronRt := app.NewRouter()
+			RegisterCronRoutes(cronRt, nil, decodeProposalOptions, proposalOptionsExecutor(), nil)
+
+			aliceCtx := auth.SetConditions(ctx, hAliceCond)
+			if spec.Delegate {
+				_, err := rt.Deliver(aliceCtx, db, &weavetest.Tx{Msg: &DelegateVoteMsg{
+					Metadata:     &weave.Metadata{Schema: 1},
+					ElectorateID: weavetest.SequenceID(1),
+					Delegatee:    hBobby,
+				}})
+				assert.Nil(t, err)
+			}
+			if spec.Revoke {
+				_, err := rt.Deliver(aliceCtx, db, &weavetest.Tx{Msg: &RevokeDelegationMsg{
+					Metadata:     &weave.Metadata{Schema: 1},
+					ElectorateID: weavetest.SequenceID(1),
+				}})
+				assert.Nil(t, err)
+			}
+			for _, v := range spec.Votes {
+				_, err := rt.Deliver(auth.SetConditions(ctx, v.Voter), db, &weavetest.Tx{Msg: &VoteMsg{
+					Metadata:   &weave.Metadata{Schema: 1},
+					ProposalID: weavetest.SequenceID(1),
+					Selected:   v.Option,
+				}})
+				assert.Nil(t, err)
+			}
+
+			tallyCtx := weave.WithBlockTime(context.Background(), now.Add(2*time.Minute))
+			_, err := cronRt.Deliver(tallyCtx, db, &weavetest.Tx{Msg: &TallyMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: weavetest.SequenceID(1),
+			}})
+			assert.Nil(t, err)
+
+			p, err := NewProposalBucket().GetProposal(db, weavetest.SequenceID(1))
+			assert.Nil(t, err)
+			assert.Equal(t, spec.ExpYes, p.VoteState.TotalYes)
+			assert.Equal(t, spec.ExpNo, p.VoteState.TotalNo)
+		})
+	}
+}
+
 func TestUpdateElectorate(t *testing.T) {
 	electorateID := weavetest.SequenceID(1)
 
@@ -1426,7 +2246,7 @@ func TestUpdateElectorate(t *testing.T) {
 				Signer: spec.SignedBy,
 			}
 			rt := app.NewRouter()
-			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{})
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
 			db := store.MemStore()
 			migration.MustInitPkg(db, packageName)
 
@@ -1495,6 +2315,41 @@ func TestUpdateElectionRules(t *testing.T) {
 				Address:      Condition(electionRulesID).Address(),
 			},
 		},
+		"Update weight curve": {
+			Msg: UpdateElectionRuleMsg{
+				Metadata:       &weave.Metadata{Schema: 1},
+				ElectionRuleID: electionRulesID,
+				VotingPeriod:   weave.AsUnixDuration(12 * time.Hour),
+				Threshold:      Fraction{Numerator: 2, Denominator: 3},
+				WeightCurve:    WeightCurve_Capped,
+				WeightCap:      5,
+			},
+			SignedBy: hBobbyCond,
+			ExpModel: &ElectionRule{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Version:      2,
+				Admin:        hBobby,
+				ElectorateID: weavetest.SequenceID(1),
+				Title:        "barr",
+				VotingPeriod: weave.AsUnixDuration(12 * time.Hour),
+				Threshold:    Fraction{Numerator: 2, Denominator: 3},
+				WeightCurve:  WeightCurve_Capped,
+				WeightCap:    5,
+				Address:      Condition(electionRulesID).Address(),
+			},
+		},
+		"Capped weight curve without a cap should fail": {
+			Msg: UpdateElectionRuleMsg{
+				Metadata:       &weave.Metadata{Schema: 1},
+				ElectionRuleID: electionRulesID,
+				VotingPeriod:   weave.AsUnixDuration(12 * time.Hour),
+				Threshold:      Fraction{Numerator: 2, Denominator: 3},
+				WeightCurve:    WeightCurve_Capped,
+			},
+			SignedBy:       hBobbyCond,
+			WantCheckErr:   errors.ErrInput,
+			WantDeliverErr: errors.ErrInput,
+		},
 		"Update with max voting time": {
 			Msg: UpdateElectionRuleMsg{
 				Metadata:       &weave.Metadata{Schema: 1},
@@ -1608,7 +2463,7 @@ func TestUpdateElectionRules(t *testing.T) {
 				Signer: spec.SignedBy,
 			}
 			rt := app.NewRouter()
-			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{})
+			RegisterRoutes(rt, auth, decodeProposalOptions, nil, &weavetest.Cron{}, nil)
 			db := store.MemStore()
 			migration.MustInitPkg(db, packageName)
 
@@ -30,12 +30,15 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			} `json:"electors"`
 		} `json:"electorate"`
 		Rules []struct {
-			Admin        weave.Address      `json:"admin"`
-			ElectorateID uint64             `json:"electorate_id"`
-			Title        string             `json:"title"`
-			VotingPeriod weave.UnixDuration `json:"voting_period"`
-			Quorum       fraction           `json:"quorum"`
-			Threshold    fraction           `json:"threshold"`
+			Admin         weave.Address      `json:"admin"`
+			ElectorateID  uint64             `json:"electorate_id"`
+			Title         string             `json:"title"`
+			VotingPeriod  weave.UnixDuration `json:"voting_period"`
+			Quorum        fraction           `json:"quorum"`
+			Threshold     fraction           `json:"threshold"`
+			VetoThreshold fraction           `json:"veto_threshold"`
+			WeightCurve   string             `json:"weight_curve"`
+			WeightCap     uint32             `json:"weight_cap"`
 		} `json:"rules"`
 	}
 	if err := opts.ReadOptions("governance", &governance); err != nil {
@@ -95,6 +98,15 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 		if r.Quorum.Numerator != 0 || r.Quorum.Denominator != 0 {
 			rule.Quorum = &Fraction{Numerator: r.Quorum.Numerator, Denominator: r.Quorum.Denominator}
 		}
+		if r.VetoThreshold.Numerator != 0 || r.VetoThreshold.Denominator != 0 {
+			rule.VetoThreshold = &Fraction{Numerator: r.VetoThreshold.Numerator, Denominator: r.VetoThreshold.Denominator}
+		}
+		curve, err := parseWeightCurve(r.WeightCurve)
+		if err != nil {
+			return errors.Wrapf(err, "electionRule #%d", i)
+		}
+		rule.WeightCurve = curve
+		rule.WeightCap = r.WeightCap
 		if err := rule.Validate(); err != nil {
 			return errors.Wrapf(err, "electionRule #%d is invalid", i)
 		}
@@ -107,6 +119,20 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 	return nil
 }
 
+// parseWeightCurve returns the weight curve for its genesis name. An empty
+// name means linear curve.
+func parseWeightCurve(name string) (WeightCurve, error) {
+	switch name {
+	case "", "linear":
+		return WeightCurve_Linear, nil
+	case "quadratic":
+		return WeightCurve_Quadratic, nil
+	case "capped":
+		return WeightCurve_Capped, nil
+	}
+	return 0, errors.Wrapf(errors.ErrInput, "unknown weight curve %q", name)
+}
+
 func encodeSequence(val uint64) []byte {
 	bz := make([]byte, 8)
 	binary.BigEndian.PutUint64(bz, val)
@@ -67,6 +67,12 @@ func TestInitFromGenesis(t *testing.T) {
 						"numerator": 2,
 						"denominator": 3
 					},
+					"veto_threshold": {
+						"numerator": 1,
+						"denominator": 3
+					},
+					"weight_curve": "capped",
+					"weight_cap": 5,
 					"electorate_id": 2
 				}
 			]
@@ -167,6 +173,9 @@ func TestInitFromGenesis(t *testing.T) {
 	if r.Quorum != nil {
 		t.Errorf("expected nil but got %v", r.Quorum)
 	}
+	if r.VetoThreshold != nil {
+		t.Errorf("expected nil but got %v", r.VetoThreshold)
+	}
 	if exp, got := weavetest.SequenceID(1), r.ElectorateID; !bytes.Equal(exp, got) {
 		t.Errorf("expected %v but got %v", exp, got)
 	}
@@ -203,6 +212,15 @@ func TestInitFromGenesis(t *testing.T) {
 	if exp, got := (Fraction{Numerator: 2, Denominator: 3}), *r.Quorum; exp != got {
 		t.Errorf("expected %#v but got %#v", exp, got)
 	}
+	if exp, got := (Fraction{Numerator: 1, Denominator: 3}), *r.VetoThreshold; exp != got {
+		t.Errorf("expected %#v but got %#v", exp, got)
+	}
+	if exp, got := WeightCurve_Capped, r.WeightCurve; exp != got {
+		t.Errorf("expected %v but got %v", exp, got)
+	}
+	if exp, got := uint32(5), r.WeightCap; exp != got {
+		t.Errorf("expected %v but got %v", exp, got)
+	}
 	if exp, got := weavetest.SequenceID(2), r.ElectorateID; !bytes.Equal(exp, got) {
 		t.Errorf("expected %v but got %v", exp, got)
 	}
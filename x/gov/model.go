@@ -21,6 +21,14 @@ func init() {
 	migration.MustRegister(1, &Proposal{}, migration.NoModification)
 	migration.MustRegister(1, &Resolution{}, migration.NoModification)
 	migration.MustRegister(1, &Vote{}, migration.NoModification)
+	migration.MustRegister(1, &Delegation{}, migration.NoModification)
+
+	migration.MustRegisterBucket(NewElectorateBucket().Bucket)
+	migration.MustRegisterBucket(NewElectionRulesBucket().Bucket)
+	migration.MustRegisterBucket(NewProposalBucket().Bucket)
+	migration.MustRegisterBucket(NewResolutionBucket().Bucket)
+	migration.MustRegisterBucket(NewVoteBucket().Bucket)
+	migration.MustRegisterBucket(NewDelegationBucket().Bucket)
 }
 
 // Condition calculates the address of an election rule given
@@ -123,6 +131,14 @@ func (m ElectionRule) Validate() error {
 			return errors.Wrap(err, "quorum")
 		}
 	}
+	if m.VetoThreshold != nil {
+		if err := validateVetoThreshold(*m.VetoThreshold); err != nil {
+			return errors.Wrap(err, "veto threshold")
+		}
+	}
+	if err := validateWeightCurve(m.WeightCurve, m.WeightCap); err != nil {
+		return errors.Wrap(err, "weight curve")
+	}
 	if err := m.Address.Validate(); err != nil {
 		return errors.Wrap(err, "address")
 	}
@@ -145,6 +161,53 @@ func (m Fraction) Validate() error {
 	return nil
 }
 
+// validateVetoThreshold returns an error if given fraction is not a valid veto
+// threshold. Unlike other fractions, a veto threshold can be lower than 0.5.
+func validateVetoThreshold(m Fraction) error {
+	if m.Numerator == 0 {
+		return errors.Wrap(errors.ErrInput, "numerator must not be 0")
+	}
+	if m.Denominator == 0 {
+		return errors.Wrap(errors.ErrInput, "denominator must not be 0")
+	}
+	if m.Numerator > m.Denominator {
+		return errors.Wrap(errors.ErrInput, "must not be greater 1")
+	}
+	return nil
+}
+
+// validateWeightCurve returns an error if given curve is not known or if the
+// weight cap is not set for the capped curve only.
+func validateWeightCurve(curve WeightCurve, cap uint32) error {
+	switch curve {
+	case WeightCurve_Linear, WeightCurve_Quadratic:
+		if cap != 0 {
+			return errors.Wrap(errors.ErrInput, "weight cap is supported by the capped curve only")
+		}
+	case WeightCurve_Capped:
+		if cap == 0 {
+			return errors.Wrap(errors.ErrInput, "weight cap must not be 0")
+		}
+	default:
+		return errors.Wrapf(errors.ErrInput, "unknown weight curve %d", curve)
+	}
+	return nil
+}
+
+// curveWeight returns the vote weight of an elector with given weight
+// converted with the curve.
+func curveWeight(curve WeightCurve, cap uint32, weight uint32) uint64 {
+	switch curve {
+	case WeightCurve_Quadratic:
+		return new(big.Int).Sqrt(new(big.Int).SetUint64(uint64(weight))).Uint64()
+	case WeightCurve_Capped:
+		if weight > cap {
+			return uint64(cap)
+		}
+	}
+	return uint64(weight)
+}
+
 const (
 	minDescriptionLength = 3
 	maxDescriptionLength = 5000
@@ -192,19 +255,27 @@ func (m *Proposal) Validate() error {
 	if err := m.ElectorateRef.Validate(); err != nil {
 		return errors.Wrap(err, "electorate reference")
 	}
+	if !m.Deposit.IsZero() {
+		if err := m.Deposit.Validate(); err != nil {
+			return errors.Wrap(err, "deposit")
+		}
+	}
 	return m.VoteState.Validate()
 }
 
 // CountVote updates the intermediate tally result by adding the new vote weight.
 func (m *Proposal) CountVote(vote Vote) error {
 	oldTotal := m.VoteState.TotalVotes()
+	weight := m.VoteState.Weight(vote.Elector.Weight)
 	switch vote.Voted {
 	case VoteOption_Yes:
-		m.VoteState.TotalYes += uint64(vote.Elector.Weight)
+		m.VoteState.TotalYes += weight
 	case VoteOption_No:
-		m.VoteState.TotalNo += uint64(vote.Elector.Weight)
+		m.VoteState.TotalNo += weight
 	case VoteOption_Abstain:
-		m.VoteState.TotalAbstain += uint64(vote.Elector.Weight)
+		m.VoteState.TotalAbstain += weight
+	case VoteOption_NoWithVeto:
+		m.VoteState.TotalNoWithVeto += weight
 	default:
 		return errors.Wrapf(errors.ErrInput, "%q", m.String())
 	}
@@ -217,13 +288,16 @@ func (m *Proposal) CountVote(vote Vote) error {
 // UndoCountVote updates the intermediate tally result by subtracting the given vote weight.
 func (m *Proposal) UndoCountVote(vote Vote) error {
 	oldTotal := m.VoteState.TotalVotes()
+	weight := m.VoteState.Weight(vote.Elector.Weight)
 	switch vote.Voted {
 	case VoteOption_Yes:
-		m.VoteState.TotalYes -= uint64(vote.Elector.Weight)
+		m.VoteState.TotalYes -= weight
 	case VoteOption_No:
-		m.VoteState.TotalNo -= uint64(vote.Elector.Weight)
+		m.VoteState.TotalNo -= weight
 	case VoteOption_Abstain:
-		m.VoteState.TotalAbstain -= uint64(vote.Elector.Weight)
+		m.VoteState.TotalAbstain -= weight
+	case VoteOption_NoWithVeto:
+		m.VoteState.TotalNoWithVeto -= weight
 	default:
 		return errors.Wrapf(errors.ErrInput, "%q", m.String())
 	}
@@ -277,27 +351,22 @@ func NewTallyResult(quorum *Fraction, threshold Fraction, totalElectorateWeight
 
 //Accepted returns the result of the calculation if a proposal got enough votes or not.
 func (m TallyResult) Accepted() bool {
+	if m.Vetoed() {
+		return false
+	}
 	if m.TotalYes == m.TotalElectorateWeight { // handles 1/1 threshold
 		return true
 	}
 
-	total := m.TotalVotes()
-	bTotalElectorateWeight := new(big.Int).SetUint64(m.TotalElectorateWeight)
-	bBaseWeight := bTotalElectorateWeight
+	if !m.QuorumReached() {
+		return false
+	}
+
+	bBaseWeight := new(big.Int).SetUint64(m.TotalElectorateWeight)
 	if m.Quorum != nil {
-		// new base = total Yes + total No
+		// new base = total Yes + total No, where vetoes are No votes too
 		bBaseWeight = new(big.Int).Add(new(big.Int).SetUint64(m.TotalYes), new(big.Int).SetUint64(m.TotalNo))
-
-		if total != m.TotalElectorateWeight { // handles non 1/1 quorums only
-			// quorum reached when
-			// totalVotes * quorumDenominator > electorate * quorumNumerator
-			bTotalVotes := new(big.Int).SetUint64(total)
-			p1 := new(big.Int).Mul(bTotalVotes, big.NewInt(int64(m.Quorum.Denominator)))
-			p2 := new(big.Int).Mul(bTotalElectorateWeight, big.NewInt(int64(m.Quorum.Numerator)))
-			if p1.Cmp(p2) < 1 {
-				return false
-			}
-		}
+		bBaseWeight.Add(bBaseWeight, new(big.Int).SetUint64(m.TotalNoWithVeto))
 	}
 
 	// (yes * denominator) > (base * numerator) with base total electorate weight or YesNo votes in case of quorum set
@@ -307,9 +376,45 @@ func (m TallyResult) Accepted() bool {
 	return p1.Cmp(p2) > 0
 }
 
-// TotalVotes returns the sum of yes, no, abstain votes weights.
+// QuorumReached returns true if enough votes were cast for the quorum to be
+// reached. A result without a quorum always reaches it.
+func (m TallyResult) QuorumReached() bool {
+	if m.Quorum == nil {
+		return true
+	}
+	total := m.TotalVotes()
+	if total == m.TotalElectorateWeight { // handles 1/1 quorums
+		return true
+	}
+	// quorum reached when
+	// totalVotes * quorumDenominator > electorate * quorumNumerator
+	p1 := new(big.Int).Mul(new(big.Int).SetUint64(total), big.NewInt(int64(m.Quorum.Denominator)))
+	p2 := new(big.Int).Mul(new(big.Int).SetUint64(m.TotalElectorateWeight), big.NewInt(int64(m.Quorum.Numerator)))
+	return p1.Cmp(p2) > 0
+}
+
+// Weight returns the vote weight of an elector with given weight, converted
+// with the weight curve of the result.
+func (m TallyResult) Weight(electorWeight uint32) uint64 {
+	return curveWeight(m.WeightCurve, m.WeightCap, electorWeight)
+}
+
+// Vetoed returns true if the NoWithVeto votes exceed the veto threshold of
+// all cast votes. A result without a veto threshold is never vetoed.
+func (m TallyResult) Vetoed() bool {
+	if m.VetoThreshold == nil || m.TotalNoWithVeto == 0 {
+		return false
+	}
+	// vetoed when
+	// totalNoWithVeto * vetoDenominator > totalVotes * vetoNumerator
+	p1 := new(big.Int).Mul(new(big.Int).SetUint64(m.TotalNoWithVeto), big.NewInt(int64(m.VetoThreshold.Denominator)))
+	p2 := new(big.Int).Mul(new(big.Int).SetUint64(m.TotalVotes()), big.NewInt(int64(m.VetoThreshold.Numerator)))
+	return p1.Cmp(p2) > 0
+}
+
+// TotalVotes returns the sum of yes, no, abstain and no with veto votes weights.
 func (m TallyResult) TotalVotes() uint64 {
-	return m.TotalYes + m.TotalNo + m.TotalAbstain
+	return m.TotalYes + m.TotalNo + m.TotalAbstain + m.TotalNoWithVeto
 }
 
 func (m TallyResult) Validate() error {
@@ -317,6 +422,10 @@ func (m TallyResult) Validate() error {
 	if m.Quorum != nil {
 		errs = errors.AppendField(errs, "Quorum", m.Quorum.Validate())
 	}
+	if m.VetoThreshold != nil {
+		errs = errors.AppendField(errs, "VetoThreshold", validateVetoThreshold(*m.VetoThreshold))
+	}
+	errs = errors.AppendField(errs, "WeightCurve", validateWeightCurve(m.WeightCurve, m.WeightCap))
 	if m.TotalElectorateWeight == 0 {
 		errs = errors.Append(errs, errors.Field("TotalElectorateWeight", errors.ErrState, "must not be zero"))
 	}
@@ -337,3 +446,19 @@ func (m Vote) Validate() error {
 	}
 	return errs
 }
+
+// Validate ensures the delegation is complete and does not delegate to the
+// delegator.
+func (m Delegation) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.ElectorateID) != 8 {
+		errs = errors.AppendField(errs, "ElectorateID", errors.ErrInput)
+	}
+	errs = errors.AppendField(errs, "Delegator", m.Delegator.Validate())
+	errs = errors.AppendField(errs, "Delegatee", m.Delegatee.Validate())
+	if m.Delegator.Equals(m.Delegatee) {
+		errs = errors.Append(errs, errors.Field("Delegatee", errors.ErrInput, "must not be the delegator"))
+	}
+	return errs
+}
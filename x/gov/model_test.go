@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/weavetest"
@@ -383,6 +384,109 @@ func TestElectionRuleValidation(t *testing.T) {
 			},
 			Exp: errors.ErrInput,
 		},
+		"Veto threshold can be lower than 0.5": {
+			Src: ElectionRule{
+				Metadata:      &weave.Metadata{Schema: 1},
+				Title:         "My election rule",
+				Admin:         alice,
+				VotingPeriod:  weave.AsUnixDuration(time.Hour),
+				Threshold:     Fraction{Numerator: 1, Denominator: 2},
+				VetoThreshold: &Fraction{Numerator: 1, Denominator: 3},
+				ElectorateID:  weavetest.SequenceID(5),
+				Address:       Condition(weavetest.SequenceID(6)).Address(),
+			},
+		},
+		"Veto threshold must not be higher than 1": {
+			Src: ElectionRule{
+				Metadata:      &weave.Metadata{Schema: 1},
+				Title:         "My election rule",
+				Admin:         alice,
+				VotingPeriod:  weave.AsUnixDuration(time.Hour),
+				Threshold:     Fraction{Numerator: 1, Denominator: 2},
+				VetoThreshold: &Fraction{Numerator: 4, Denominator: 3},
+				ElectorateID:  weavetest.SequenceID(5),
+				Address:       Condition(weavetest.SequenceID(6)).Address(),
+			},
+			Exp: errors.ErrInput,
+		},
+		"Veto threshold must not contain 0 numerator": {
+			Src: ElectionRule{
+				Metadata:      &weave.Metadata{Schema: 1},
+				Title:         "My election rule",
+				Admin:         alice,
+				VotingPeriod:  weave.AsUnixDuration(time.Hour),
+				Threshold:     Fraction{Numerator: 1, Denominator: 2},
+				VetoThreshold: &Fraction{Numerator: 0, Denominator: 3},
+				ElectorateID:  weavetest.SequenceID(5),
+				Address:       Condition(weavetest.SequenceID(6)).Address(),
+			},
+			Exp: errors.ErrInput,
+		},
+		"Quadratic weight curve": {
+			Src: ElectionRule{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Title:        "My election rule",
+				Admin:        alice,
+				VotingPeriod: weave.AsUnixDuration(time.Hour),
+				Threshold:    Fraction{Numerator: 1, Denominator: 2},
+				WeightCurve:  WeightCurve_Quadratic,
+				ElectorateID: weavetest.SequenceID(5),
+				Address:      Condition(weavetest.SequenceID(6)).Address(),
+			},
+		},
+		"Capped weight curve": {
+			Src: ElectionRule{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Title:        "My election rule",
+				Admin:        alice,
+				VotingPeriod: weave.AsUnixDuration(time.Hour),
+				Threshold:    Fraction{Numerator: 1, Denominator: 2},
+				WeightCurve:  WeightCurve_Capped,
+				WeightCap:    5,
+				ElectorateID: weavetest.SequenceID(5),
+				Address:      Condition(weavetest.SequenceID(6)).Address(),
+			},
+		},
+		"Capped weight curve requires a cap": {
+			Src: ElectionRule{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Title:        "My election rule",
+				Admin:        alice,
+				VotingPeriod: weave.AsUnixDuration(time.Hour),
+				Threshold:    Fraction{Numerator: 1, Denominator: 2},
+				WeightCurve:  WeightCurve_Capped,
+				ElectorateID: weavetest.SequenceID(5),
+				Address:      Condition(weavetest.SequenceID(6)).Address(),
+			},
+			Exp: errors.ErrInput,
+		},
+		"Weight cap is not allowed for linear curve": {
+			Src: ElectionRule{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Title:        "My election rule",
+				Admin:        alice,
+				VotingPeriod: weave.AsUnixDuration(time.Hour),
+				Threshold:    Fraction{Numerator: 1, Denominator: 2},
+				WeightCurve:  WeightCurve_Linear,
+				WeightCap:    5,
+				ElectorateID: weavetest.SequenceID(5),
+				Address:      Condition(weavetest.SequenceID(6)).Address(),
+			},
+			Exp: errors.ErrInput,
+		},
+		"Unknown weight curve": {
+			Src: ElectionRule{
+				Metadata:     &weave.Metadata{Schema: 1},
+				Title:        "My election rule",
+				Admin:        alice,
+				VotingPeriod: weave.AsUnixDuration(time.Hour),
+				Threshold:    Fraction{Numerator: 1, Denominator: 2},
+				WeightCurve:  WeightCurve(42),
+				ElectorateID: weavetest.SequenceID(5),
+				Address:      Condition(weavetest.SequenceID(6)).Address(),
+			},
+			Exp: errors.ErrInput,
+		},
 		"Admin must not be invalid": {
 			Src: ElectionRule{
 				Metadata:     &weave.Metadata{Schema: 1},
@@ -518,6 +622,12 @@ func TestProposalValidation(t *testing.T) {
 			}),
 			Exp: errors.ErrState,
 		},
+		"Invalid deposit": {
+			Src: proposalFixture(t, alice, func(p *Proposal) {
+				p.Deposit = coin.NewCoin(1, 0, "x")
+			}),
+			Exp: errors.ErrCurrency,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
@@ -625,3 +735,28 @@ func TestResolutionValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestTallyResultWeight(t *testing.T) {
+	specs := map[string]struct {
+		Curve  WeightCurve
+		Cap    uint32
+		Weight uint32
+		Exp    uint64
+	}{
+		"Linear":                 {Curve: WeightCurve_Linear, Weight: 10, Exp: 10},
+		"Quadratic":              {Curve: WeightCurve_Quadratic, Weight: 16, Exp: 4},
+		"Quadratic rounds down":  {Curve: WeightCurve_Quadratic, Weight: 10, Exp: 3},
+		"Quadratic max weight":   {Curve: WeightCurve_Quadratic, Weight: math.MaxUint32, Exp: math.MaxUint16},
+		"Capped above the cap":   {Curve: WeightCurve_Capped, Cap: 5, Weight: 10, Exp: 5},
+		"Capped below the cap":   {Curve: WeightCurve_Capped, Cap: 5, Weight: 3, Exp: 3},
+		"Zero weight stays zero": {Curve: WeightCurve_Quadratic, Weight: 0, Exp: 0},
+	}
+	for testName, spec := range specs {
+		t.Run(testName, func(t *testing.T) {
+			r := TallyResult{WeightCurve: spec.Curve, WeightCap: spec.Cap}
+			if got := r.Weight(spec.Weight); got != spec.Exp {
+				t.Fatalf("expected %d but got %d", spec.Exp, got)
+			}
+		})
+	}
+}
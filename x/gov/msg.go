@@ -2,6 +2,7 @@ package gov
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
@@ -15,6 +16,11 @@ func init() {
 	migration.MustRegister(1, &DeleteProposalMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateElectionRuleMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateElectorateMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ChangeParametersMsg{}, migration.NoModification)
+	migration.MustRegister(1, &UpdateConfigurationMsg{}, migration.NoModification)
+	migration.MustRegister(1, &DelegateVoteMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RevokeDelegationMsg{}, migration.NoModification)
+	migration.MustRegister(1, &TextProposalMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*CreateProposalMsg)(nil)
@@ -77,7 +83,7 @@ func (VoteMsg) Path() string {
 func (m VoteMsg) Validate() error {
 	var errs error
 	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
-	if m.Selected != VoteOption_Yes && m.Selected != VoteOption_No && m.Selected != VoteOption_Abstain {
+	if m.Selected != VoteOption_Yes && m.Selected != VoteOption_No && m.Selected != VoteOption_Abstain && m.Selected != VoteOption_NoWithVeto {
 		errs = errors.AppendField(errs, "Selected", errors.ErrInput)
 	}
 	if len(m.ProposalID) == 0 {
@@ -89,6 +95,43 @@ func (m VoteMsg) Validate() error {
 	return errs
 }
 
+var _ weave.Msg = (*DelegateVoteMsg)(nil)
+
+func (DelegateVoteMsg) Path() string {
+	return "gov/delegate_vote"
+}
+
+func (m DelegateVoteMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.ElectorateID) != 8 {
+		errs = errors.Append(errs, errors.Field("ElectorateID", errors.ErrInput, "electorate ID must be 8 bytes (sequence)"))
+	}
+	if m.Delegator != nil {
+		errs = errors.AppendField(errs, "Delegator", m.Delegator.Validate())
+	}
+	errs = errors.AppendField(errs, "Delegatee", m.Delegatee.Validate())
+	return errs
+}
+
+var _ weave.Msg = (*RevokeDelegationMsg)(nil)
+
+func (RevokeDelegationMsg) Path() string {
+	return "gov/revoke_delegation"
+}
+
+func (m RevokeDelegationMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.ElectorateID) != 8 {
+		errs = errors.Append(errs, errors.Field("ElectorateID", errors.ErrInput, "electorate ID must be 8 bytes (sequence)"))
+	}
+	if m.Delegator != nil {
+		errs = errors.AppendField(errs, "Delegator", m.Delegator.Validate())
+	}
+	return errs
+}
+
 var _ weave.Msg = (*TallyMsg)(nil)
 
 func (TallyMsg) Path() string {
@@ -124,6 +167,10 @@ func (m UpdateElectionRuleMsg) Validate() error {
 	if m.Quorum != nil {
 		errs = errors.AppendField(errs, "Quorum", m.Quorum.Validate())
 	}
+	if m.VetoThreshold != nil {
+		errs = errors.AppendField(errs, "VetoThreshold", validateVetoThreshold(*m.VetoThreshold))
+	}
+	errs = errors.AppendField(errs, "WeightCurve", validateWeightCurve(m.WeightCurve, m.WeightCap))
 	errs = errors.AppendField(errs, "Threshold", m.Threshold.Validate())
 	return errs
 }
@@ -143,6 +190,75 @@ func (m CreateTextResolutionMsg) Validate() error {
 	return errs
 }
 
+var _ weave.Msg = (*TextProposalMsg)(nil)
+
+func (TextProposalMsg) Path() string {
+	return "gov/text_proposal"
+}
+
+const (
+	maxDocumentHashLength = 64
+	maxDocumentURLLength  = 2048
+)
+
+func (m TextProposalMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.DocumentHash) == 0 && len(m.DocumentURL) == 0 {
+		errs = errors.Append(errs, errors.Wrap(errors.ErrEmpty, "document hash or URL is required"))
+	}
+	if len(m.DocumentHash) > maxDocumentHashLength {
+		errs = errors.AppendField(errs, "DocumentHash", errors.Wrapf(errors.ErrInput, "must not be longer than %d bytes", maxDocumentHashLength))
+	}
+	if len(m.DocumentURL) != 0 {
+		errs = errors.AppendField(errs, "DocumentURL", validateDocumentURL(m.DocumentURL))
+	}
+	return errs
+}
+
+// validateDocumentURL returns an error if given value is not an absolute URL.
+func validateDocumentURL(raw string) error {
+	if len(raw) > maxDocumentURLLength {
+		return errors.Wrapf(errors.ErrInput, "must not be longer than %d characters", maxDocumentURLLength)
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return errors.Wrap(errors.ErrInput, err.Error())
+	}
+	if !u.IsAbs() {
+		return errors.Wrap(errors.ErrInput, "must be an absolute URL")
+	}
+	return nil
+}
+
+var _ weave.Msg = (*ChangeParametersMsg)(nil)
+
+func (ChangeParametersMsg) Path() string {
+	return "gov/change_parameters"
+}
+
+func (m ChangeParametersMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	if len(m.Changes) == 0 {
+		errs = errors.AppendField(errs, "Changes", errors.ErrEmpty)
+	}
+	seen := make(map[string]struct{}, len(m.Changes))
+	for i, c := range m.Changes {
+		field := fmt.Sprintf("Changes.%d", i)
+		if len(c.Package) == 0 {
+			errs = errors.AppendField(errs, field+".Package", errors.ErrEmpty)
+		} else if _, ok := seen[c.Package]; ok {
+			errs = errors.AppendField(errs, field+".Package", errors.ErrDuplicate)
+		}
+		seen[c.Package] = struct{}{}
+		if len(c.Patch) == 0 {
+			errs = errors.AppendField(errs, field+".Patch", errors.ErrEmpty)
+		}
+	}
+	return errs
+}
+
 var _ weave.Msg = (*UpdateElectorateMsg)(nil)
 
 func (UpdateElectorateMsg) Path() string {
@@ -166,3 +282,28 @@ func (m UpdateElectorateMsg) Validate() error {
 	}
 	return errs
 }
+
+var _ weave.Msg = (*UpdateConfigurationMsg)(nil)
+
+// Validate will skip any zero fields and validate the set ones.
+func (m *UpdateConfigurationMsg) Validate() error {
+	c := m.Patch
+	if c == nil {
+		return errors.Field("Patch", errors.ErrEmpty, "required")
+	}
+	var errs error
+	if len(c.Owner) != 0 {
+		errs = errors.AppendField(errs, "Owner", c.Owner.Validate())
+	}
+	if !c.ProposalDeposit.IsZero() {
+		errs = errors.AppendField(errs, "ProposalDeposit", c.ProposalDeposit.Validate())
+		if !c.ProposalDeposit.IsPositive() {
+			errs = errors.Append(errs, errors.Field("ProposalDeposit", errors.ErrAmount, "must be positive"))
+		}
+	}
+	return errs
+}
+
+func (*UpdateConfigurationMsg) Path() string {
+	return "gov/update_configuration"
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/weavetest"
 )
@@ -20,6 +21,9 @@ func TestVoteMsg(t *testing.T) {
 		"Happy path": {
 			Msg: VoteMsg{ProposalID: weavetest.SequenceID(1), Selected: VoteOption_Yes, Voter: alice, Metadata: &weave.Metadata{Schema: 1}},
 		},
+		"No with veto": {
+			Msg: VoteMsg{ProposalID: weavetest.SequenceID(1), Selected: VoteOption_NoWithVeto, Voter: alice, Metadata: &weave.Metadata{Schema: 1}},
+		},
 		"Voter optional": {
 			Msg: VoteMsg{ProposalID: weavetest.SequenceID(1), Selected: VoteOption_Yes, Metadata: &weave.Metadata{Schema: 1}},
 		},
@@ -54,6 +58,77 @@ func TestVoteMsg(t *testing.T) {
 	}
 }
 
+func TestDelegateVoteMsg(t *testing.T) {
+	alice := weavetest.NewCondition().Address()
+	bobby := weavetest.NewCondition().Address()
+
+	specs := map[string]struct {
+		Msg DelegateVoteMsg
+		Exp *errors.Error
+	}{
+		"Happy path": {
+			Msg: DelegateVoteMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(1), Delegator: alice, Delegatee: bobby},
+		},
+		"Delegator optional": {
+			Msg: DelegateVoteMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(1), Delegatee: bobby},
+		},
+		"Delegatee missing": {
+			Msg: DelegateVoteMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(1), Delegator: alice},
+			Exp: errors.ErrEmpty,
+		},
+		"Invalid electorate ID": {
+			Msg: DelegateVoteMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: []byte{1}, Delegatee: bobby},
+			Exp: errors.ErrInput,
+		},
+		"Invalid delegator address": {
+			Msg: DelegateVoteMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(1), Delegator: weave.Address([]byte{0}), Delegatee: bobby},
+			Exp: errors.ErrInput,
+		},
+		"Metadata missing": {
+			Msg: DelegateVoteMsg{ElectorateID: weavetest.SequenceID(1), Delegatee: bobby},
+			Exp: errors.ErrMetadata,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.Msg.Validate()
+			if !spec.Exp.Is(err) {
+				t.Fatalf("check expected: %v  but got %+v", spec.Exp, err)
+			}
+		})
+	}
+}
+
+func TestRevokeDelegationMsg(t *testing.T) {
+	specs := map[string]struct {
+		Msg RevokeDelegationMsg
+		Exp *errors.Error
+	}{
+		"Happy path": {
+			Msg: RevokeDelegationMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(1)},
+		},
+		"Invalid electorate ID": {
+			Msg: RevokeDelegationMsg{Metadata: &weave.Metadata{Schema: 1}},
+			Exp: errors.ErrInput,
+		},
+		"Invalid delegator address": {
+			Msg: RevokeDelegationMsg{Metadata: &weave.Metadata{Schema: 1}, ElectorateID: weavetest.SequenceID(1), Delegator: weave.Address([]byte{0})},
+			Exp: errors.ErrInput,
+		},
+		"Metadata missing": {
+			Msg: RevokeDelegationMsg{ElectorateID: weavetest.SequenceID(1)},
+			Exp: errors.ErrMetadata,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.Msg.Validate()
+			if !spec.Exp.Is(err) {
+				t.Fatalf("check expected: %v  but got %+v", spec.Exp, err)
+			}
+		})
+	}
+}
 func TestTallyMsg(t *testing.T) {
 	specs := map[string]struct {
 		Msg TallyMsg
@@ -247,6 +322,167 @@ func TestCreateTextResolutionMsg(t *testing.T) {
 	}
 }
 
+func TestTextProposalMsg(t *testing.T) {
+	specs := map[string]struct {
+		Msg TextProposalMsg
+		Exp *errors.Error
+	}{
+		"Happy path": {
+			Msg: TextProposalMsg{
+				Metadata:     &weave.Metadata{Schema: 1},
+				DocumentHash: []byte("a document hash"),
+				DocumentURL:  "https://example.com/proposal.pdf",
+			},
+		},
+		"Hash only": {
+			Msg: TextProposalMsg{Metadata: &weave.Metadata{Schema: 1}, DocumentHash: []byte("a document hash")},
+		},
+		"URL only": {
+			Msg: TextProposalMsg{Metadata: &weave.Metadata{Schema: 1}, DocumentURL: "ipfs://QmT5NvUtoM5nWFfrQdVrFtvGfKFmG7AHE8P34isapyhCxX"},
+		},
+		"Document reference missing": {
+			Msg: TextProposalMsg{Metadata: &weave.Metadata{Schema: 1}},
+			Exp: errors.ErrEmpty,
+		},
+		"Hash too long": {
+			Msg: TextProposalMsg{Metadata: &weave.Metadata{Schema: 1}, DocumentHash: make([]byte, 65)},
+			Exp: errors.ErrInput,
+		},
+		"Relative URL": {
+			Msg: TextProposalMsg{Metadata: &weave.Metadata{Schema: 1}, DocumentURL: "proposal.pdf"},
+			Exp: errors.ErrInput,
+		},
+		"URL too long": {
+			Msg: TextProposalMsg{Metadata: &weave.Metadata{Schema: 1}, DocumentURL: "https://example.com/" + BigString(2048)},
+			Exp: errors.ErrInput,
+		},
+		"Metadata missing": {
+			Msg: TextProposalMsg{DocumentHash: []byte("a document hash")},
+			Exp: errors.ErrMetadata,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.Msg.Validate()
+			if !spec.Exp.Is(err) {
+				t.Fatalf("check expected: %v  but got %+v", spec.Exp, err)
+			}
+		})
+	}
+}
+
+func TestChangeParametersMsg(t *testing.T) {
+	specs := map[string]struct {
+		Msg ChangeParametersMsg
+		Exp *errors.Error
+	}{
+		"Happy path": {
+			Msg: ChangeParametersMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Changes: []ParameterChange{
+					{Package: "cash", Patch: []byte("patch")},
+					{Package: "msgfee", Patch: []byte("patch")},
+				},
+			},
+		},
+		"No changes": {
+			Msg: ChangeParametersMsg{Metadata: &weave.Metadata{Schema: 1}},
+			Exp: errors.ErrEmpty,
+		},
+		"Empty package": {
+			Msg: ChangeParametersMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Changes:  []ParameterChange{{Patch: []byte("patch")}},
+			},
+			Exp: errors.ErrEmpty,
+		},
+		"Empty patch": {
+			Msg: ChangeParametersMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Changes:  []ParameterChange{{Package: "cash"}},
+			},
+			Exp: errors.ErrEmpty,
+		},
+		"Duplicated package": {
+			Msg: ChangeParametersMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Changes: []ParameterChange{
+					{Package: "cash", Patch: []byte("patch")},
+					{Package: "cash", Patch: []byte("patch")},
+				},
+			},
+			Exp: errors.ErrDuplicate,
+		},
+		"Metadata missing": {
+			Msg: ChangeParametersMsg{
+				Changes: []ParameterChange{{Package: "cash", Patch: []byte("patch")}},
+			},
+			Exp: errors.ErrMetadata,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.Msg.Validate()
+			if !spec.Exp.Is(err) {
+				t.Fatalf("check expected: %v  but got %+v", spec.Exp, err)
+			}
+		})
+	}
+}
+
+func TestUpdateConfigurationMsg(t *testing.T) {
+	specs := map[string]struct {
+		Msg UpdateConfigurationMsg
+		Exp *errors.Error
+	}{
+		"Happy path": {
+			Msg: UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					Owner:           weavetest.NewCondition().Address(),
+					ProposalDeposit: coin.NewCoin(1, 0, "IOV"),
+				},
+			},
+		},
+		"Zero fields are not updated": {
+			Msg: UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &Configuration{},
+			},
+		},
+		"Patch missing": {
+			Msg: UpdateConfigurationMsg{Metadata: &weave.Metadata{Schema: 1}},
+			Exp: errors.ErrEmpty,
+		},
+		"Negative deposit": {
+			Msg: UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					ProposalDeposit: coin.NewCoin(-1, 0, "IOV"),
+				},
+			},
+			Exp: errors.ErrAmount,
+		},
+		"Invalid deposit currency": {
+			Msg: UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch: &Configuration{
+					ProposalDeposit: coin.NewCoin(1, 0, "x"),
+				},
+			},
+			Exp: errors.ErrCurrency,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			err := spec.Msg.Validate()
+			if !spec.Exp.Is(err) {
+				t.Fatalf("check expected: %v  but got %+v", spec.Exp, err)
+			}
+		})
+	}
+}
+
 func BigString(n int) string {
 	const randomChar = "a"
 	var r string
@@ -0,0 +1,109 @@
+package gov
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/orm"
+)
+
+const (
+	// proposalsPageSize is the maximum number of proposals returned by a
+	// single paginated proposals query.
+	proposalsPageSize = 100
+
+	// proposalIDLength is the length of a proposal ID generated by the
+	// sequence of the proposal bucket.
+	proposalIDLength = 8
+)
+
+// pageKey returns an index key that groups all proposals with the same filter
+// value next to each other, ordered by proposal ID.
+func pageKey(filter, proposalID []byte) []byte {
+	key := make([]byte, 0, len(filter)+1+len(proposalID))
+	key = append(key, filter...)
+	key = append(key, ':')
+	return append(key, proposalID...)
+}
+
+// ProposalsQuery lists proposals using one of the paginated proposal
+// indexes. Results are ordered by the proposal ID and split into pages of at
+// most 100 proposals.
+//
+// Query data is the filter value, optionally followed by a colon and the ID
+// of the last proposal of the previous page. For the status query the filter
+// is the status name, for example "submitted" to request the first page and
+// "submitted:<proposal ID>" to request the page that follows the given
+// proposal. For the electorate query the filter is the electorate ID.
+type ProposalsQuery struct {
+	index orm.Index
+}
+
+var _ weave.QueryHandler = ProposalsQuery{}
+
+// NewProposalsByStatusQuery returns a query handler that lists proposals with
+// a given status.
+func NewProposalsByStatusQuery() ProposalsQuery {
+	return newProposalsQuery(indexNameStatus, proposalStatusIndexer)
+}
+
+// NewProposalsByElectorateQuery returns a query handler that lists proposals
+// created for a given electorate.
+func NewProposalsByElectorateQuery() ProposalsQuery {
+	return newProposalsQuery(indexNameElectorateProposals, electorateProposalsIndexer)
+}
+
+func newProposalsQuery(indexName string, indexer orm.Indexer) ProposalsQuery {
+	// This must be the same index as the one registered on the proposal
+	// bucket by NewProposalBucket.
+	index := orm.NewIndex(proposalBucketName+"_"+indexName, indexer, true, nil)
+	return ProposalsQuery{index: index}
+}
+
+// Query handles queries from the QueryRouter.
+func (q ProposalsQuery) Query(db weave.ReadOnlyKVStore, mod string, data []byte) ([]weave.Model, error) {
+	if mod != weave.KeyQueryMod {
+		return nil, errors.Wrapf(errors.ErrInput, "unknown mod: %s", mod)
+	}
+
+	filter := data
+	start := q.index.IndexKey(pageKey(filter, nil))
+	// Proposal IDs have a fixed length, so the cursor can be found even if
+	// the filter contains a colon.
+	if n := len(data) - proposalIDLength - 1; n > 0 && data[n] == ':' {
+		filter = data[:n]
+		// Appending a zero byte creates the smallest key that is
+		// greater than the key of the last returned proposal.
+		start = append(q.index.IndexKey(data), 0)
+	}
+	if len(filter) == 0 {
+		return nil, errors.Wrap(errors.ErrInput, "filter required")
+	}
+	// Colon is followed by a semicolon in ASCII, so this is the first key
+	// after all keys with the filter prefix.
+	end := q.index.IndexKey(append(append([]byte{}, filter...), ';'))
+
+	it, err := db.Iterator(start, end)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot create iterator")
+	}
+	defer it.Release()
+
+	proposals := NewProposalBucket()
+	var res []weave.Model
+	for len(res) < proposalsPageSize {
+		_, id, err := it.Next()
+		if err != nil {
+			if errors.ErrIteratorDone.Is(err) {
+				break
+			}
+			return nil, errors.Wrap(err, "cannot read index")
+		}
+		key := proposals.DBKey(id)
+		value, err := db.Get(key)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot load proposal")
+		}
+		res = append(res, weave.Model{Key: key, Value: value})
+	}
+	return res, nil
+}
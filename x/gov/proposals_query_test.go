@@ -0,0 +1,121 @@
+package gov
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestProposalsQuery(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, packageName)
+
+	bucket := NewProposalBucket()
+	create := func(status Proposal_Status, electorateID []byte) []byte {
+		t.Helper()
+		p := proposalFixture(t, hAlice, func(p *Proposal) {
+			p.Status = status
+			p.ElectorateRef.ID = electorateID
+		})
+		obj, err := bucket.Create(db, &p)
+		assert.Nil(t, err)
+		return obj.Key()
+	}
+	var first []byte
+	for i := 0; i < 210; i++ {
+		id := create(Proposal_Submitted, weavetest.SequenceID(1))
+		if first == nil {
+			first = id
+		}
+	}
+	for i := 0; i < 10; i++ {
+		create(Proposal_Closed, weavetest.SequenceID(2))
+	}
+	// A status change must move the proposal to a different index
+	// entry.
+	p, err := bucket.GetProposal(db, first)
+	assert.Nil(t, err)
+	p.Status = Proposal_Withdrawn
+	assert.Nil(t, bucket.Update(db, first, p))
+
+	cases := map[string]struct {
+		query  ProposalsQuery
+		filter []byte
+		want   int
+	}{
+		"submitted": {
+			query:  NewProposalsByStatusQuery(),
+			filter: []byte("submitted"),
+			want:   209,
+		},
+		"closed": {
+			query:  NewProposalsByStatusQuery(),
+			filter: []byte("closed"),
+			want:   10,
+		},
+		"withdrawn": {
+			query:  NewProposalsByStatusQuery(),
+			filter: []byte("withdrawn"),
+			want:   1,
+		},
+		"unknown status": {
+			query:  NewProposalsByStatusQuery(),
+			filter: []byte("foo"),
+			want:   0,
+		},
+		"first electorate": {
+			query:  NewProposalsByElectorateQuery(),
+			filter: weavetest.SequenceID(1),
+			want:   210,
+		},
+		"second electorate": {
+			query:  NewProposalsByElectorateQuery(),
+			filter: weavetest.SequenceID(2),
+			want:   10,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			found := make(map[string]bool)
+			data := tc.filter
+			for {
+				models, err := tc.query.Query(db, weave.KeyQueryMod, data)
+				assert.Nil(t, err)
+				if len(models) == 0 {
+					break
+				}
+				if len(models) > proposalsPageSize {
+					t.Fatalf("page too big: %d", len(models))
+				}
+				var last []byte
+				for _, m := range models {
+					if _, err := bucket.Parse(m.Key, m.Value); err != nil {
+						t.Fatalf("cannot parse proposal: %s", err)
+					}
+					// Model key is prefixed with the bucket name.
+					last = m.Key[len(proposalBucketName)+1:]
+					if found[string(last)] {
+						t.Fatalf("proposal %X returned twice", last)
+					}
+					found[string(last)] = true
+				}
+				data = pageKey(tc.filter, last)
+			}
+			if len(found) != tc.want {
+				t.Fatalf("want %d proposals, got %d", tc.want, len(found))
+			}
+		})
+	}
+
+	if _, err := NewProposalsByStatusQuery().Query(db, weave.KeyQueryMod, nil); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for missing filter, got %+v", err)
+	}
+	if _, err := NewProposalsByStatusQuery().Query(db, weave.PrefixQueryMod, []byte("closed")); !errors.ErrInput.Is(err) {
+		t.Fatalf("want input error for prefix query, got %+v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package gov
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/iov-one/weave"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+// tallyTags returns tags describing the result of a proposal tally. The hex
+// encoded proposal ID is tagged with the "gov.tally" key. The result of the
+// election is tagged with the "gov.tally.<proposal ID>" key and, for an
+// accepted proposal, the result of its execution is tagged with the
+// "gov.execute.<proposal ID>" key.
+//
+// Tendermint collapses multiple tags with the same key, so if several
+// proposals are tallied within a single block only one of the proposal IDs is
+// tagged with the "gov.tally" key. Use the per proposal keys to look up the
+// result of a given proposal.
+func tallyTags(proposalID []byte, p *Proposal) []common.KVPair {
+	id := fmt.Sprintf("%X", proposalID)
+	tags := weave.NewTags("gov").
+		ID("tally", proposalID).
+		String("tally."+id, enumName(p.Result.String(), "PROPOSAL_RESULT_"))
+	if p.Result == Proposal_Accepted {
+		tags.String("execute."+id, enumName(p.ExecutorResult.String(), "PROPOSAL_EXECUTOR_RESULT_"))
+	}
+	return tags.KVPairs()
+}
+
+// enumName returns a lower case representation of an enum name without its
+// common prefix, for example "PROPOSAL_RESULT_ACCEPTED" becomes "accepted".
+func enumName(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
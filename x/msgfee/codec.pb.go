@@ -158,6 +158,12 @@ type Configuration struct {
 	Owner github_com_iov_one_weave.Address `protobuf:"bytes,2,opt,name=owner,proto3,casttype=github.com/iov-one/weave.Address" json:"owner,omitempty"`
 	// FeeAdmin is an address that is allowed to change the fee.
 	FeeAdmin github_com_iov_one_weave.Address `protobuf:"bytes,3,opt,name=fee_admin,json=feeAdmin,proto3,casttype=github.com/iov-one/weave.Address" json:"fee_admin,omitempty"`
+	// AntispamFee is the minimum fee that must be paid for processing any
+	// message. Zero value disables the chain wide minimum.
+	AntispamFee coin.Coin `protobuf:"bytes,4,opt,name=antispam_fee,json=antispamFee,proto3" json:"antispam_fee"`
+	// AntispamPathFees overwrites the chain wide minimum fee for messages with
+	// a given path. Use zero value fee to exempt a message path.
+	AntispamPathFees []PathFee `protobuf:"bytes,5,rep,name=antispam_path_fees,json=antispamPathFees,proto3" json:"antispam_path_fees"`
 }
 
 func (m *Configuration) Reset()         { *m = Configuration{} }
@@ -214,6 +220,73 @@ func (m *Configuration) GetFeeAdmin() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Configuration) GetAntispamFee() coin.Coin {
+	if m != nil {
+		return m.AntispamFee
+	}
+	return coin.Coin{}
+}
+
+func (m *Configuration) GetAntispamPathFees() []PathFee {
+	if m != nil {
+		return m.AntispamPathFees
+	}
+	return nil
+}
+
+// PathFee declares a minimum fee for a single message path.
+type PathFee struct {
+	MsgPath string    `protobuf:"bytes,1,opt,name=msg_path,json=msgPath,proto3" json:"msg_path,omitempty"`
+	Fee     coin.Coin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *PathFee) Reset()         { *m = PathFee{} }
+func (m *PathFee) String() string { return proto.CompactTextString(m) }
+func (*PathFee) ProtoMessage()    {}
+func (*PathFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef6e9ad0e6ca0f39, []int{3}
+}
+func (m *PathFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PathFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PathFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PathFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PathFee.Merge(m, src)
+}
+func (m *PathFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PathFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PathFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PathFee proto.InternalMessageInfo
+
+func (m *PathFee) GetMsgPath() string {
+	if m != nil {
+		return m.MsgPath
+	}
+	return ""
+}
+
+func (m *PathFee) GetFee() coin.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return coin.Coin{}
+}
+
 type UpdateConfigurationMsg struct {
 	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Patch    *Configuration  `protobuf:"bytes,2,opt,name=patch,proto3" json:"patch,omitempty"`
@@ -223,7 +296,7 @@ func (m *UpdateConfigurationMsg) Reset()         { *m = UpdateConfigurationMsg{}
 func (m *UpdateConfigurationMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdateConfigurationMsg) ProtoMessage()    {}
 func (*UpdateConfigurationMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ef6e9ad0e6ca0f39, []int{3}
+	return fileDescriptor_ef6e9ad0e6ca0f39, []int{4}
 }
 func (m *UpdateConfigurationMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -270,35 +343,40 @@ func init() {
 	proto.RegisterType((*MsgFee)(nil), "msgfee.MsgFee")
 	proto.RegisterType((*SetMsgFeeMsg)(nil), "msgfee.SetMsgFeeMsg")
 	proto.RegisterType((*Configuration)(nil), "msgfee.Configuration")
+	proto.RegisterType((*PathFee)(nil), "msgfee.PathFee")
 	proto.RegisterType((*UpdateConfigurationMsg)(nil), "msgfee.UpdateConfigurationMsg")
 }
 
 func init() { proto.RegisterFile("x/msgfee/codec.proto", fileDescriptor_ef6e9ad0e6ca0f39) }
 
 var fileDescriptor_ef6e9ad0e6ca0f39 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xc1, 0x4e, 0xfa, 0x40,
-	0x10, 0xc6, 0xbb, 0x7f, 0xfe, 0x20, 0x2c, 0x18, 0x4d, 0x83, 0xa6, 0x72, 0x28, 0xa4, 0xf1, 0x40,
-	0x42, 0xdc, 0x26, 0x78, 0xf3, 0x06, 0x24, 0xde, 0x48, 0x4c, 0x8d, 0x67, 0xb2, 0xb4, 0xd3, 0x65,
-	0x0f, 0xdd, 0x6d, 0xba, 0x0b, 0x18, 0x9f, 0xc2, 0xf7, 0xf1, 0x05, 0x38, 0x72, 0xf4, 0x44, 0x0c,
-	0xbc, 0x85, 0x27, 0xd3, 0xae, 0x31, 0x70, 0x93, 0x8b, 0xb7, 0xc9, 0xb7, 0xdf, 0x37, 0xbf, 0xd9,
-	0xc9, 0xe0, 0xe6, 0xb3, 0x9f, 0x28, 0x16, 0x03, 0xf8, 0xa1, 0x8c, 0x20, 0x24, 0x69, 0x26, 0xb5,
-	0xb4, 0x2b, 0x46, 0x6b, 0xd5, 0xf7, 0xc4, 0xd6, 0x79, 0x28, 0xb9, 0xd8, 0xb7, 0xb5, 0x9a, 0x4c,
-	0x32, 0x59, 0x94, 0x7e, 0x5e, 0x19, 0xd5, 0xd3, 0xb8, 0x32, 0x56, 0xec, 0x1e, 0xc0, 0xee, 0xe1,
-	0x6a, 0x02, 0x9a, 0x46, 0x54, 0x53, 0x07, 0x75, 0x50, 0xb7, 0xde, 0x3f, 0x23, 0x4b, 0xa0, 0x0b,
-	0x20, 0xe3, 0x6f, 0x39, 0xf8, 0x31, 0xd8, 0x57, 0xb8, 0x9a, 0x28, 0x36, 0x49, 0xa9, 0x9e, 0x39,
-	0xff, 0x3a, 0xa8, 0x5b, 0x0b, 0x4e, 0x12, 0xc5, 0x1e, 0xa8, 0x9e, 0xd9, 0x1e, 0x2e, 0xc5, 0x00,
-	0x4e, 0xa9, 0x68, 0x81, 0x49, 0x3e, 0x07, 0x19, 0x49, 0x2e, 0x86, 0xff, 0x57, 0x9b, 0xb6, 0x15,
-	0xe4, 0x8f, 0xde, 0x0b, 0x6e, 0x3c, 0x82, 0x36, 0xe0, 0xb1, 0x62, 0x7f, 0xca, 0x7e, 0x43, 0xf8,
-	0x74, 0x24, 0x45, 0xcc, 0xd9, 0x3c, 0xa3, 0x9a, 0x4b, 0x71, 0x1c, 0xfd, 0x0e, 0x97, 0xe5, 0x52,
-	0x40, 0x56, 0xa0, 0x1b, 0xc3, 0xeb, 0xcf, 0x4d, 0xbb, 0xc3, 0xb8, 0x9e, 0xcd, 0xa7, 0x24, 0x94,
-	0x89, 0xcf, 0xe5, 0xe2, 0x46, 0x0a, 0xf0, 0x4d, 0x7e, 0x10, 0x45, 0x19, 0x28, 0x15, 0x98, 0x88,
-	0x3d, 0xc0, 0xb5, 0x18, 0x60, 0x42, 0xa3, 0x84, 0x8b, 0x62, 0xc8, 0xdf, 0xe6, 0xab, 0x31, 0xc0,
-	0x20, 0x4f, 0x79, 0x19, 0xbe, 0x7c, 0x4a, 0x23, 0xaa, 0xe1, 0xe0, 0x0b, 0x47, 0xef, 0xb0, 0x87,
-	0xcb, 0x29, 0xd5, 0xa1, 0x59, 0x60, 0xbd, 0x7f, 0x41, 0xcc, 0x0d, 0x91, 0x83, 0xae, 0x81, 0xf1,
-	0x0c, 0x9d, 0xd5, 0xd6, 0x45, 0xeb, 0xad, 0x8b, 0x3e, 0xb6, 0x2e, 0x7a, 0xdd, 0xb9, 0xd6, 0x7a,
-	0xe7, 0x5a, 0xef, 0x3b, 0xd7, 0x9a, 0x56, 0x8a, 0x23, 0xba, 0xfd, 0x0a, 0x00, 0x00, 0xff, 0xff,
-	0xf3, 0x47, 0xe8, 0xaf, 0x99, 0x02, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0xcf, 0xca, 0xda, 0x40,
+	0x14, 0xc5, 0x13, 0xf3, 0xf9, 0x6f, 0xb4, 0x54, 0x82, 0x2d, 0xa9, 0x8b, 0x18, 0x42, 0x17, 0x82,
+	0x34, 0x81, 0xba, 0xeb, 0x4e, 0x05, 0xe9, 0x46, 0x28, 0x29, 0x5d, 0xcb, 0x98, 0xdc, 0x4c, 0x66,
+	0x91, 0x99, 0x90, 0x19, 0xb5, 0xf4, 0x29, 0xfa, 0x32, 0x7d, 0x07, 0x97, 0x2e, 0xbb, 0x92, 0xa2,
+	0x6f, 0xd1, 0x55, 0x99, 0x24, 0x8a, 0x42, 0x5b, 0x70, 0xf3, 0xed, 0x2e, 0x27, 0xf7, 0x37, 0x27,
+	0x77, 0xce, 0x1d, 0xd4, 0xff, 0xea, 0xa7, 0x82, 0xc4, 0x00, 0x7e, 0xc8, 0x23, 0x08, 0xbd, 0x2c,
+	0xe7, 0x92, 0x9b, 0x8d, 0x52, 0x1b, 0x74, 0x6e, 0xc4, 0x41, 0x2f, 0xe4, 0x94, 0xdd, 0xb6, 0x0d,
+	0xfa, 0x84, 0x13, 0x5e, 0x94, 0xbe, 0xaa, 0x4a, 0xd5, 0x95, 0xa8, 0xb1, 0x14, 0x64, 0x01, 0x60,
+	0x8e, 0x51, 0x2b, 0x05, 0x89, 0x23, 0x2c, 0xb1, 0xa5, 0x3b, 0xfa, 0xa8, 0xf3, 0xfe, 0xa5, 0xb7,
+	0x03, 0xbc, 0x05, 0x6f, 0x59, 0xc9, 0xc1, 0xb5, 0xc1, 0x7c, 0x83, 0x5a, 0xa9, 0x20, 0xab, 0x0c,
+	0xcb, 0xc4, 0xaa, 0x39, 0xfa, 0xa8, 0x1d, 0x34, 0x53, 0x41, 0x3e, 0x61, 0x99, 0x98, 0x2e, 0x32,
+	0x62, 0x00, 0xcb, 0x28, 0x8e, 0x40, 0x9e, 0xfa, 0x0f, 0x6f, 0xce, 0x29, 0x9b, 0x3d, 0xed, 0x8f,
+	0x43, 0x2d, 0x50, 0x1f, 0xdd, 0x6f, 0xa8, 0xfb, 0x19, 0x64, 0x69, 0xbc, 0x14, 0xe4, 0x59, 0xbd,
+	0x7f, 0xd4, 0xd0, 0x8b, 0x39, 0x67, 0x31, 0x25, 0x9b, 0x1c, 0x4b, 0xca, 0xd9, 0x63, 0xee, 0x1f,
+	0x50, 0x9d, 0xef, 0x18, 0xe4, 0x85, 0x75, 0x77, 0xf6, 0xf6, 0xf7, 0x71, 0xe8, 0x10, 0x2a, 0x93,
+	0xcd, 0xda, 0x0b, 0x79, 0xea, 0x53, 0xbe, 0x7d, 0xc7, 0x19, 0xf8, 0x25, 0x3f, 0x8d, 0xa2, 0x1c,
+	0x84, 0x08, 0x4a, 0xc4, 0x9c, 0xa2, 0x76, 0x0c, 0xb0, 0xc2, 0x51, 0x4a, 0x99, 0x65, 0x3c, 0xc0,
+	0xb7, 0x62, 0x80, 0xa9, 0xa2, 0xcc, 0x09, 0xea, 0x62, 0x26, 0xa9, 0xc8, 0x70, 0xba, 0x52, 0xa3,
+	0x3e, 0xfd, 0x63, 0xd4, 0xce, 0xa5, 0x4b, 0x45, 0x3b, 0x47, 0xe6, 0x15, 0x52, 0xd7, 0xa6, 0x48,
+	0x61, 0xd5, 0x1d, 0xa3, 0x18, 0xb5, 0x5c, 0x1f, 0x4f, 0x5d, 0xe0, 0x02, 0xa0, 0xe2, 0x7b, 0x17,
+	0xa0, 0x92, 0x85, 0xfb, 0x11, 0x35, 0xab, 0xfa, 0x2e, 0x01, 0xfd, 0xaf, 0x09, 0xd4, 0xfe, 0x97,
+	0x40, 0x8e, 0x5e, 0x7f, 0xc9, 0x22, 0x2c, 0xe1, 0x2e, 0x86, 0x87, 0xf7, 0x60, 0x8c, 0xea, 0x19,
+	0x96, 0x61, 0x52, 0x99, 0xbd, 0xba, 0x0c, 0x72, 0x77, 0x6a, 0x50, 0xf6, 0xcc, 0xac, 0xfd, 0xc9,
+	0xd6, 0x0f, 0x27, 0x5b, 0xff, 0x75, 0xb2, 0xf5, 0xef, 0x67, 0x5b, 0x3b, 0x9c, 0x6d, 0xed, 0xe7,
+	0xd9, 0xd6, 0xd6, 0x8d, 0xe2, 0x21, 0x4c, 0xfe, 0x04, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x87, 0x51,
+	0x71, 0x5d, 0x03, 0x00, 0x00,
 }
 
 func (m *MsgFee) Marshal() (dAtA []byte, err error) {
@@ -422,6 +500,58 @@ func (m *Configuration) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintCodec(dAtA, i, uint64(len(m.FeeAdmin)))
 		i += copy(dAtA[i:], m.FeeAdmin)
 	}
+	dAtA[i] = 0x22
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.AntispamFee.Size()))
+	n6, err := m.AntispamFee.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n6
+	if len(m.AntispamPathFees) > 0 {
+		for _, msg := range m.AntispamPathFees {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *PathFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PathFee) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgPath) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.MsgPath)))
+		i += copy(dAtA[i:], m.MsgPath)
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Fee.Size()))
+	n7, err := m.Fee.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
 	return i, nil
 }
 
@@ -444,21 +574,21 @@ func (m *UpdateConfigurationMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+		n8, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n6
+		i += n8
 	}
 	if m.Patch != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Patch.Size()))
-		n7, err := m.Patch.MarshalTo(dAtA[i:])
+		n9, err := m.Patch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n9
 	}
 	return i, nil
 }
@@ -528,6 +658,29 @@ func (m *Configuration) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	l = m.AntispamFee.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	if len(m.AntispamPathFees) > 0 {
+		for _, e := range m.AntispamPathFees {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PathFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgPath)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovCodec(uint64(l))
 	return n
 }
 
@@ -1002,6 +1155,191 @@ func (m *Configuration) Unmarshal(dAtA []byte) error {
 				m.FeeAdmin = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntispamFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AntispamFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntispamPathFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AntispamPathFees = append(m.AntispamPathFees, PathFee{})
+			if err := m.AntispamPathFees[len(m.AntispamPathFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PathFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PathFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PathFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
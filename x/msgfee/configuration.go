@@ -1,9 +1,17 @@
 package msgfee
 
 import (
+	"fmt"
+
+	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
 )
 
+func init() {
+	gconf.Register("msgfee", &Configuration{})
+}
+
 func (c *Configuration) Validate() error {
 	var errs error
 	// Owner field is optional.
@@ -14,5 +22,38 @@ func (c *Configuration) Validate() error {
 	if len(c.FeeAdmin) != 0 {
 		errs = errors.AppendField(errs, "FeeAdmin", c.FeeAdmin.Validate())
 	}
+	errs = errors.AppendField(errs, "AntispamFee", validateAntispamFee(c.AntispamFee))
+	errs = errors.Append(errs, validatePathFees(c.AntispamPathFees))
+	return errs
+}
+
+// validateAntispamFee allows a zero value fee, that disables the minimum fee.
+func validateAntispamFee(fee coin.Coin) error {
+	if fee.IsZero() {
+		return nil
+	}
+	if err := fee.Validate(); err != nil {
+		return err
+	}
+	if !fee.IsNonNegative() {
+		return errors.Wrap(errors.ErrAmount, "must be non negative")
+	}
+	return nil
+}
+
+// validatePathFees requires each path to be declared at most once.
+func validatePathFees(fees []PathFee) error {
+	var errs error
+	paths := make(map[string]struct{}, len(fees))
+	for i, f := range fees {
+		if len(f.MsgPath) == 0 {
+			errs = errors.AppendField(errs, fmt.Sprintf("AntispamPathFees.%d.MsgPath", i), errors.ErrEmpty)
+		} else if _, ok := paths[f.MsgPath]; ok {
+			errs = errors.AppendField(errs, fmt.Sprintf("AntispamPathFees.%d.MsgPath", i),
+				errors.Wrapf(errors.ErrDuplicate, "path %q", f.MsgPath))
+		}
+		paths[f.MsgPath] = struct{}{}
+		errs = errors.AppendField(errs, fmt.Sprintf("AntispamPathFees.%d.Fee", i), validateAntispamFee(f.Fee))
+	}
 	return errs
 }
@@ -12,5 +12,11 @@ This extension does not know of supported (installed) message paths and
 therefore cannot validate for their existence. Make sure that when registering
 a new message fee the path is set correctly.
 
+Additionally, a minimal antispam fee can be declared in the msgfee
+configuration. The configuration holds a chain wide minimal fee and per message
+path minimal fees that take precedence over the chain wide one. Transactions
+that declare a lower fee are rejected before the handler is called. The
+configuration can be updated via governance.
+
 */
 package msgfee
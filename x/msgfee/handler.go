@@ -9,10 +9,6 @@ import (
 	"github.com/iov-one/weave/x"
 )
 
-const (
-	setMsgFeeCost = 0
-)
-
 // RegisterRoutes registers handlers for feedlist message processing.
 func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
 	r = migration.SchemaMigratingRegistry("msgfee", r)
@@ -34,7 +30,7 @@ func (h *setMsgFeeHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.T
 	if _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: setMsgFeeCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h *setMsgFeeHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
@@ -78,5 +74,5 @@ func (h *setMsgFeeHandler) validate(ctx weave.Context, db weave.KVStore, tx weav
 
 func NewConfigHandler(auth x.Authenticator) weave.Handler {
 	var conf Configuration
-	return gconf.NewUpdateConfigurationHandler("cash", &conf, auth)
+	return gconf.NewUpdateConfigurationHandler("msgfee", &conf, auth)
 }
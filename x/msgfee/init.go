@@ -6,7 +6,7 @@ import (
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/coin"
 	"github.com/iov-one/weave/errors"
-	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
 )
 
 // Initializer fulfils the Initializer interface to load data from the genesis
@@ -42,7 +42,7 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 		}
 	}
 
-	if err := gconf.InitConfig(kv, opts, "msgfee", &Configuration{}); err != nil {
+	if err := migration.InitConfig(kv, opts, "msgfee", &Configuration{}); err != nil {
 		return errors.Wrap(err, "init config")
 	}
 
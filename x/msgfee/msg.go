@@ -42,6 +42,12 @@ func (m *UpdateConfigurationMsg) Validate() error {
 	if len(c.FeeAdmin) != 0 {
 		errs = errors.AppendField(errs, "FeeAdmin", c.FeeAdmin.Validate())
 	}
+	if !c.AntispamFee.IsZero() {
+		errs = errors.AppendField(errs, "AntispamFee", validateAntispamFee(c.AntispamFee))
+	}
+	if len(c.AntispamPathFees) != 0 {
+		errs = errors.Append(errs, validatePathFees(c.AntispamPathFees))
+	}
 	return errs
 }
 
@@ -0,0 +1,123 @@
+package msgfee
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/x/cash"
+)
+
+// PathAntispamFeeDecorator implements a decorator that for each processed
+// transaction asks for a minimal fee, depending on the path of the processed
+// message. Unlike AntispamFeeDecorator, fees are declared in the msgfee
+// configuration and can be updated via governance.
+//
+// A fee declared for a message path takes precedence over the chain wide
+// antispam fee. If no fee applies to a message (zero value), this decorator
+// is a noop.
+//
+// A transaction that declares a fee lower than the minimum is rejected before
+// the handler is called. After the handler is called, the required fee of the
+// result is raised to the minimum if it is less.
+type PathAntispamFeeDecorator struct{}
+
+var _ weave.Decorator = (*PathAntispamFeeDecorator)(nil)
+
+// NewPathAntispamFeeDecorator returns a PathAntispamFeeDecorator
+func NewPathAntispamFeeDecorator() *PathAntispamFeeDecorator {
+	return &PathAntispamFeeDecorator{}
+}
+
+func (d *PathAntispamFeeDecorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	fee, err := d.minFee(store, tx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := next.Check(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+	if res.RequiredFee, err = raiseFee(res.RequiredFee, fee); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (d *PathAntispamFeeDecorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	fee, err := d.minFee(store, tx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := next.Deliver(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+	if res.RequiredFee, err = raiseFee(res.RequiredFee, fee); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// minFee returns the minimal fee configured for the message of the given
+// transaction. It returns an error if the transaction declares a lower fee.
+func (d *PathAntispamFeeDecorator) minFee(store weave.KVStore, tx weave.Tx) (coin.Coin, error) {
+	var conf Configuration
+	switch err := gconf.Load(store, "msgfee", &conf); {
+	case err == nil:
+		// All good.
+	case errors.ErrNotFound.Is(err):
+		return coin.Coin{}, nil
+	default:
+		return coin.Coin{}, errors.Wrap(err, "cannot load configuration")
+	}
+
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return coin.Coin{}, errors.Wrap(err, "cannot get message")
+	}
+	fee := conf.AntispamFee
+	for _, pf := range conf.AntispamPathFees {
+		if pf.MsgPath == msg.Path() {
+			fee = pf.Fee
+			break
+		}
+	}
+	if fee.IsZero() {
+		return fee, nil
+	}
+
+	var paid coin.Coin
+	if ftx, ok := tx.(cash.FeeTx); ok {
+		if c := ftx.GetFees().GetFees(); c != nil {
+			paid = *c
+		}
+	}
+	if !paid.IsZero() && !paid.SameType(fee) {
+		return coin.Coin{}, errors.Wrapf(errors.ErrCurrency,
+			"antispam fee has the wrong type: expected %q, got %q", fee.Ticker, paid.Ticker)
+	}
+	if !paid.IsGTE(fee) {
+		return coin.Coin{}, errors.Wrapf(errors.ErrAmount,
+			"fee %v is less than the minimal fee %v required for %q", paid, fee, msg.Path())
+	}
+	return fee, nil
+}
+
+// raiseFee returns the required fee raised to the minimal fee.
+func raiseFee(required, min coin.Coin) (coin.Coin, error) {
+	if min.IsZero() {
+		return required, nil
+	}
+	if required.IsZero() {
+		return min, nil
+	}
+	if !required.SameType(min) {
+		return required, errors.Wrapf(errors.ErrCurrency,
+			"antispam fee has the wrong type: expected %q, got %q", min.Ticker, required.Ticker)
+	}
+	if !required.IsGTE(min) {
+		return min, nil
+	}
+	return required, nil
+}
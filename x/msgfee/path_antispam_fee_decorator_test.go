@@ -0,0 +1,232 @@
+package msgfee
+
+import (
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/coin"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/gconf"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/x/cash"
+)
+
+func TestPathAntispamFeeDecorator(t *testing.T) {
+	conf := &Configuration{
+		Metadata:    &weave.Metadata{Schema: 1},
+		AntispamFee: coin.NewCoin(1, 0, "IOV"),
+		AntispamPathFees: []PathFee{
+			{MsgPath: "foo/expensive", Fee: coin.NewCoin(5, 0, "IOV")},
+			{MsgPath: "foo/free", Fee: coin.Coin{}},
+		},
+	}
+
+	cases := map[string]struct {
+		Conf           *Configuration
+		Tx             weave.Tx
+		Handler        *weavetest.Handler
+		WantCheckErr   *errors.Error
+		WantCheckFee   coin.Coin
+		WantDeliverErr *errors.Error
+		WantDeliverFee coin.Coin
+	}{
+		"no configuration": {
+			Tx:             &feeTx{Tx: weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "foo/bar"}}},
+			Handler:        &weavetest.Handler{},
+			WantCheckFee:   coin.Coin{},
+			WantDeliverFee: coin.Coin{},
+		},
+		"chain wide fee": {
+			Conf:           conf,
+			Tx:             newFeeTx("foo/bar", coin.NewCoin(1, 0, "IOV")),
+			Handler:        &weavetest.Handler{},
+			WantCheckFee:   coin.NewCoin(1, 0, "IOV"),
+			WantDeliverFee: coin.NewCoin(1, 0, "IOV"),
+		},
+		"path fee overwrites chain wide fee": {
+			Conf:           conf,
+			Tx:             newFeeTx("foo/expensive", coin.NewCoin(5, 0, "IOV")),
+			Handler:        &weavetest.Handler{},
+			WantCheckFee:   coin.NewCoin(5, 0, "IOV"),
+			WantDeliverFee: coin.NewCoin(5, 0, "IOV"),
+		},
+		"zero path fee exempts a message": {
+			Conf:           conf,
+			Tx:             &feeTx{Tx: weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "foo/free"}}},
+			Handler:        &weavetest.Handler{},
+			WantCheckFee:   coin.Coin{},
+			WantDeliverFee: coin.Coin{},
+		},
+		"higher required fee is not changed": {
+			Conf: conf,
+			Tx:   newFeeTx("foo/bar", coin.NewCoin(3, 0, "IOV")),
+			Handler: &weavetest.Handler{
+				CheckResult:   weave.CheckResult{RequiredFee: coin.NewCoin(3, 0, "IOV")},
+				DeliverResult: weave.DeliverResult{RequiredFee: coin.NewCoin(3, 0, "IOV")},
+			},
+			WantCheckFee:   coin.NewCoin(3, 0, "IOV"),
+			WantDeliverFee: coin.NewCoin(3, 0, "IOV"),
+		},
+		"lower required fee is raised": {
+			Conf: conf,
+			Tx:   newFeeTx("foo/expensive", coin.NewCoin(5, 0, "IOV")),
+			Handler: &weavetest.Handler{
+				CheckResult:   weave.CheckResult{RequiredFee: coin.NewCoin(2, 0, "IOV")},
+				DeliverResult: weave.DeliverResult{RequiredFee: coin.NewCoin(2, 0, "IOV")},
+			},
+			WantCheckFee:   coin.NewCoin(5, 0, "IOV"),
+			WantDeliverFee: coin.NewCoin(5, 0, "IOV"),
+		},
+		"paid fee too low": {
+			Conf:           conf,
+			Tx:             newFeeTx("foo/expensive", coin.NewCoin(4, 0, "IOV")),
+			Handler:        &weavetest.Handler{},
+			WantCheckErr:   errors.ErrAmount,
+			WantDeliverErr: errors.ErrAmount,
+		},
+		"no fee paid": {
+			Conf:           conf,
+			Tx:             &feeTx{Tx: weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "foo/bar"}}},
+			Handler:        &weavetest.Handler{},
+			WantCheckErr:   errors.ErrAmount,
+			WantDeliverErr: errors.ErrAmount,
+		},
+		"paid fee with a different ticker": {
+			Conf:           conf,
+			Tx:             newFeeTx("foo/bar", coin.NewCoin(10, 0, "DOGE")),
+			Handler:        &weavetest.Handler{},
+			WantCheckErr:   errors.ErrCurrency,
+			WantDeliverErr: errors.ErrCurrency,
+		},
+		"required fee with a different ticker": {
+			Conf: conf,
+			Tx:   newFeeTx("foo/bar", coin.NewCoin(1, 0, "IOV")),
+			Handler: &weavetest.Handler{
+				CheckResult:   weave.CheckResult{RequiredFee: coin.NewCoin(1, 0, "BTC")},
+				DeliverResult: weave.DeliverResult{RequiredFee: coin.NewCoin(1, 0, "BTC")},
+			},
+			WantCheckErr:   errors.ErrCurrency,
+			WantDeliverErr: errors.ErrCurrency,
+		},
+		"handler failure": {
+			Conf: conf,
+			Tx:   newFeeTx("foo/bar", coin.NewCoin(1, 0, "IOV")),
+			Handler: &weavetest.Handler{
+				CheckErr:   errors.ErrUnauthorized,
+				DeliverErr: errors.ErrUnauthorized,
+			},
+			WantCheckErr:   errors.ErrUnauthorized,
+			WantDeliverErr: errors.ErrUnauthorized,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "msgfee")
+			if tc.Conf != nil {
+				if err := gconf.Save(db, "msgfee", tc.Conf); err != nil {
+					t.Fatalf("cannot save configuration: %s", err)
+				}
+			}
+
+			decorator := NewPathAntispamFeeDecorator()
+
+			cres, err := decorator.Check(nil, db, tc.Tx, tc.Handler)
+			if !tc.WantCheckErr.Is(err) {
+				t.Fatalf("check returned an unexpected error: %v", err)
+			}
+			if tc.WantCheckErr == nil && !tc.WantCheckFee.Equals(cres.RequiredFee) {
+				t.Fatalf("unexpected check fee: %v", cres.RequiredFee)
+			}
+
+			dres, err := decorator.Deliver(nil, db, tc.Tx, tc.Handler)
+			if !tc.WantDeliverErr.Is(err) {
+				t.Fatalf("deliver returned an unexpected error: %v", err)
+			}
+			if tc.WantDeliverErr == nil && !tc.WantDeliverFee.Equals(dres.RequiredFee) {
+				t.Fatalf("unexpected deliver fee: %v", dres.RequiredFee)
+			}
+		})
+	}
+}
+
+func TestConfigurationValidation(t *testing.T) {
+	cases := map[string]struct {
+		Conf    Configuration
+		WantErr *errors.Error
+	}{
+		"valid configuration": {
+			Conf: Configuration{
+				Metadata:    &weave.Metadata{Schema: 1},
+				AntispamFee: coin.NewCoin(1, 0, "IOV"),
+				AntispamPathFees: []PathFee{
+					{MsgPath: "foo/bar", Fee: coin.NewCoin(2, 0, "IOV")},
+					{MsgPath: "foo/free"},
+				},
+			},
+		},
+		"negative antispam fee": {
+			Conf: Configuration{
+				Metadata:    &weave.Metadata{Schema: 1},
+				AntispamFee: coin.NewCoin(-1, 0, "IOV"),
+			},
+			WantErr: errors.ErrAmount,
+		},
+		"empty path": {
+			Conf: Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+				AntispamPathFees: []PathFee{
+					{MsgPath: "", Fee: coin.NewCoin(2, 0, "IOV")},
+				},
+			},
+			WantErr: errors.ErrEmpty,
+		},
+		"duplicated path": {
+			Conf: Configuration{
+				Metadata: &weave.Metadata{Schema: 1},
+				AntispamPathFees: []PathFee{
+					{MsgPath: "foo/bar", Fee: coin.NewCoin(2, 0, "IOV")},
+					{MsgPath: "foo/bar", Fee: coin.NewCoin(3, 0, "IOV")},
+				},
+			},
+			WantErr: errors.ErrDuplicate,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Conf.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+			msg := UpdateConfigurationMsg{
+				Metadata: &weave.Metadata{Schema: 1},
+				Patch:    &tc.Conf,
+			}
+			if err := msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected message validation error: %s", err)
+			}
+		})
+	}
+}
+
+// feeTx is a transaction that declares the paid fee.
+type feeTx struct {
+	weavetest.Tx
+	fees *cash.FeeInfo
+}
+
+var _ cash.FeeTx = (*feeTx)(nil)
+
+func newFeeTx(path string, fee coin.Coin) *feeTx {
+	return &feeTx{
+		Tx:   weavetest.Tx{Msg: &weavetest.Msg{RoutePath: path}},
+		fees: &cash.FeeInfo{Fees: &fee},
+	}
+}
+
+func (tx *feeTx) GetFees() *cash.FeeInfo {
+	return tx.fees
+}
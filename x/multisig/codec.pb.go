@@ -39,6 +39,24 @@ type Contract struct {
 	AdminThreshold Weight `protobuf:"varint,4,opt,name=admin_threshold,json=adminThreshold,proto3,casttype=Weight" json:"admin_threshold,omitempty"`
 	// Address of this entity. Set during creation and does not change.
 	Address github_com_iov_one_weave.Address `protobuf:"bytes,5,opt,name=address,proto3,casttype=github.com/iov-one/weave.Address" json:"address,omitempty"`
+	// Update delay defines how long after an update is submitted it takes
+	// effect. During this time any participant can veto the update. Zero value
+	// means that updates take effect immediately.
+	UpdateDelay github_com_iov_one_weave.UnixDuration `protobuf:"varint,6,opt,name=update_delay,json=updateDelay,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"update_delay,omitempty"`
+	// Pending update is the update that takes effect once the update delay
+	// has passed, unless it is vetoed before.
+	PendingUpdate *PendingUpdate `protobuf:"bytes,7,opt,name=pending_update,json=pendingUpdate,proto3" json:"pending_update,omitempty"`
+	// Message thresholds override the activation threshold for messages with
+	// given paths. This allows to require a different weight for example to
+	// authorize a payment and to administrate other entities.
+	MessageThresholds []MessageThreshold `protobuf:"bytes,8,rep,name=message_thresholds,json=messageThresholds,proto3" json:"message_thresholds"`
+	// Proposal TTL defines how long a proposal is stored before it expires and
+	// is deleted. Zero value means that proposals do not expire.
+	ProposalTTL github_com_iov_one_weave.UnixDuration `protobuf:"varint,9,opt,name=proposal_ttl,json=proposalTtl,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"proposal_ttl,omitempty"`
+	// Approval TTL defines how long an approval of a proposal is valid. Expired
+	// approvals do not count towards the threshold. Zero value means that
+	// approvals do not expire.
+	ApprovalTTL github_com_iov_one_weave.UnixDuration `protobuf:"varint,10,opt,name=approval_ttl,json=approvalTtl,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"approval_ttl,omitempty"`
 }
 
 func (m *Contract) Reset()         { *m = Contract{} }
@@ -109,6 +127,208 @@ func (m *Contract) GetAddress() github_com_iov_one_weave.Address {
 	return nil
 }
 
+func (m *Contract) GetUpdateDelay() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.UpdateDelay
+	}
+	return 0
+}
+
+func (m *Contract) GetPendingUpdate() *PendingUpdate {
+	if m != nil {
+		return m.PendingUpdate
+	}
+	return nil
+}
+
+func (m *Contract) GetMessageThresholds() []MessageThreshold {
+	if m != nil {
+		return m.MessageThresholds
+	}
+	return nil
+}
+
+func (m *Contract) GetProposalTTL() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.ProposalTTL
+	}
+	return 0
+}
+
+func (m *Contract) GetApprovalTTL() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.ApprovalTTL
+	}
+	return 0
+}
+
+// MessageThreshold defines the minimal weight value that must be provided from
+// participants in order to activate the contract for a message with given
+// path.
+type MessageThreshold struct {
+	MsgPath   string `protobuf:"bytes,1,opt,name=msg_path,json=msgPath,proto3" json:"msg_path,omitempty"`
+	Threshold Weight `protobuf:"varint,2,opt,name=threshold,proto3,casttype=Weight" json:"threshold,omitempty"`
+}
+
+func (m *MessageThreshold) Reset()         { *m = MessageThreshold{} }
+func (m *MessageThreshold) String() string { return proto.CompactTextString(m) }
+func (*MessageThreshold) ProtoMessage()    {}
+func (*MessageThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{1}
+}
+func (m *MessageThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MessageThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MessageThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MessageThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MessageThreshold.Merge(m, src)
+}
+func (m *MessageThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *MessageThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_MessageThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MessageThreshold proto.InternalMessageInfo
+
+func (m *MessageThreshold) GetMsgPath() string {
+	if m != nil {
+		return m.MsgPath
+	}
+	return ""
+}
+
+func (m *MessageThreshold) GetThreshold() Weight {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// PendingUpdate is a contract configuration change that is waiting for the
+// update delay to pass.
+type PendingUpdate struct {
+	Participants        []*Participant                        `protobuf:"bytes,1,rep,name=participants,proto3" json:"participants,omitempty"`
+	ActivationThreshold Weight                                `protobuf:"varint,2,opt,name=activation_threshold,json=activationThreshold,proto3,casttype=Weight" json:"activation_threshold,omitempty"`
+	AdminThreshold      Weight                                `protobuf:"varint,3,opt,name=admin_threshold,json=adminThreshold,proto3,casttype=Weight" json:"admin_threshold,omitempty"`
+	UpdateDelay         github_com_iov_one_weave.UnixDuration `protobuf:"varint,4,opt,name=update_delay,json=updateDelay,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"update_delay,omitempty"`
+	// Apply at is the time after which the update takes effect.
+	ApplyAt github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=apply_at,json=applyAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"apply_at,omitempty"`
+	// Task ID is the ID of the cron task that applies the update.
+	TaskID            []byte                                `protobuf:"bytes,6,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	MessageThresholds []MessageThreshold                    `protobuf:"bytes,7,rep,name=message_thresholds,json=messageThresholds,proto3" json:"message_thresholds"`
+	ProposalTTL       github_com_iov_one_weave.UnixDuration `protobuf:"varint,8,opt,name=proposal_ttl,json=proposalTtl,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"proposal_ttl,omitempty"`
+	ApprovalTTL       github_com_iov_one_weave.UnixDuration `protobuf:"varint,9,opt,name=approval_ttl,json=approvalTtl,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"approval_ttl,omitempty"`
+}
+
+func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
+func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingUpdate) ProtoMessage()    {}
+func (*PendingUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{2}
+}
+func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingUpdate.Merge(m, src)
+}
+func (m *PendingUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingUpdate proto.InternalMessageInfo
+
+func (m *PendingUpdate) GetParticipants() []*Participant {
+	if m != nil {
+		return m.Participants
+	}
+	return nil
+}
+
+func (m *PendingUpdate) GetActivationThreshold() Weight {
+	if m != nil {
+		return m.ActivationThreshold
+	}
+	return 0
+}
+
+func (m *PendingUpdate) GetAdminThreshold() Weight {
+	if m != nil {
+		return m.AdminThreshold
+	}
+	return 0
+}
+
+func (m *PendingUpdate) GetUpdateDelay() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.UpdateDelay
+	}
+	return 0
+}
+
+func (m *PendingUpdate) GetApplyAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ApplyAt
+	}
+	return 0
+}
+
+func (m *PendingUpdate) GetTaskID() []byte {
+	if m != nil {
+		return m.TaskID
+	}
+	return nil
+}
+
+func (m *PendingUpdate) GetMessageThresholds() []MessageThreshold {
+	if m != nil {
+		return m.MessageThresholds
+	}
+	return nil
+}
+
+func (m *PendingUpdate) GetProposalTTL() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.ProposalTTL
+	}
+	return 0
+}
+
+func (m *PendingUpdate) GetApprovalTTL() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.ApprovalTTL
+	}
+	return 0
+}
+
 // Participant clubs together a signature with a weight. The greater the weight
 // the greater the power of a signature.
 type Participant struct {
@@ -120,7 +340,7 @@ func (m *Participant) Reset()         { *m = Participant{} }
 func (m *Participant) String() string { return proto.CompactTextString(m) }
 func (*Participant) ProtoMessage()    {}
 func (*Participant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5080d98b87cf9a7, []int{1}
+	return fileDescriptor_e5080d98b87cf9a7, []int{3}
 }
 func (m *Participant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -164,17 +384,21 @@ func (m *Participant) GetWeight() Weight {
 }
 
 type CreateMsg struct {
-	Metadata            *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	Participants        []*Participant  `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
-	ActivationThreshold Weight          `protobuf:"varint,3,opt,name=activation_threshold,json=activationThreshold,proto3,casttype=Weight" json:"activation_threshold,omitempty"`
-	AdminThreshold      Weight          `protobuf:"varint,4,opt,name=admin_threshold,json=adminThreshold,proto3,casttype=Weight" json:"admin_threshold,omitempty"`
+	Metadata            *weave.Metadata                       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Participants        []*Participant                        `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	ActivationThreshold Weight                                `protobuf:"varint,3,opt,name=activation_threshold,json=activationThreshold,proto3,casttype=Weight" json:"activation_threshold,omitempty"`
+	AdminThreshold      Weight                                `protobuf:"varint,4,opt,name=admin_threshold,json=adminThreshold,proto3,casttype=Weight" json:"admin_threshold,omitempty"`
+	UpdateDelay         github_com_iov_one_weave.UnixDuration `protobuf:"varint,5,opt,name=update_delay,json=updateDelay,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"update_delay,omitempty"`
+	MessageThresholds   []MessageThreshold                    `protobuf:"bytes,6,rep,name=message_thresholds,json=messageThresholds,proto3" json:"message_thresholds"`
+	ProposalTTL         github_com_iov_one_weave.UnixDuration `protobuf:"varint,7,opt,name=proposal_ttl,json=proposalTtl,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"proposal_ttl,omitempty"`
+	ApprovalTTL         github_com_iov_one_weave.UnixDuration `protobuf:"varint,8,opt,name=approval_ttl,json=approvalTtl,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"approval_ttl,omitempty"`
 }
 
 func (m *CreateMsg) Reset()         { *m = CreateMsg{} }
 func (m *CreateMsg) String() string { return proto.CompactTextString(m) }
 func (*CreateMsg) ProtoMessage()    {}
 func (*CreateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5080d98b87cf9a7, []int{2}
+	return fileDescriptor_e5080d98b87cf9a7, []int{4}
 }
 func (m *CreateMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -231,19 +455,51 @@ func (m *CreateMsg) GetAdminThreshold() Weight {
 	return 0
 }
 
+func (m *CreateMsg) GetUpdateDelay() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.UpdateDelay
+	}
+	return 0
+}
+
+func (m *CreateMsg) GetMessageThresholds() []MessageThreshold {
+	if m != nil {
+		return m.MessageThresholds
+	}
+	return nil
+}
+
+func (m *CreateMsg) GetProposalTTL() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.ProposalTTL
+	}
+	return 0
+}
+
+func (m *CreateMsg) GetApprovalTTL() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.ApprovalTTL
+	}
+	return 0
+}
+
 type UpdateMsg struct {
-	Metadata            *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	ContractID          []byte          `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
-	Participants        []*Participant  `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
-	ActivationThreshold Weight          `protobuf:"varint,4,opt,name=activation_threshold,json=activationThreshold,proto3,casttype=Weight" json:"activation_threshold,omitempty"`
-	AdminThreshold      Weight          `protobuf:"varint,5,opt,name=admin_threshold,json=adminThreshold,proto3,casttype=Weight" json:"admin_threshold,omitempty"`
+	Metadata            *weave.Metadata                       `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ContractID          []byte                                `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	Participants        []*Participant                        `protobuf:"bytes,3,rep,name=participants,proto3" json:"participants,omitempty"`
+	ActivationThreshold Weight                                `protobuf:"varint,4,opt,name=activation_threshold,json=activationThreshold,proto3,casttype=Weight" json:"activation_threshold,omitempty"`
+	AdminThreshold      Weight                                `protobuf:"varint,5,opt,name=admin_threshold,json=adminThreshold,proto3,casttype=Weight" json:"admin_threshold,omitempty"`
+	UpdateDelay         github_com_iov_one_weave.UnixDuration `protobuf:"varint,6,opt,name=update_delay,json=updateDelay,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"update_delay,omitempty"`
+	MessageThresholds   []MessageThreshold                    `protobuf:"bytes,7,rep,name=message_thresholds,json=messageThresholds,proto3" json:"message_thresholds"`
+	ProposalTTL         github_com_iov_one_weave.UnixDuration `protobuf:"varint,8,opt,name=proposal_ttl,json=proposalTtl,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"proposal_ttl,omitempty"`
+	ApprovalTTL         github_com_iov_one_weave.UnixDuration `protobuf:"varint,9,opt,name=approval_ttl,json=approvalTtl,proto3,casttype=github.com/iov-one/weave.UnixDuration" json:"approval_ttl,omitempty"`
 }
 
 func (m *UpdateMsg) Reset()         { *m = UpdateMsg{} }
 func (m *UpdateMsg) String() string { return proto.CompactTextString(m) }
 func (*UpdateMsg) ProtoMessage()    {}
 func (*UpdateMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e5080d98b87cf9a7, []int{3}
+	return fileDescriptor_e5080d98b87cf9a7, []int{5}
 }
 func (m *UpdateMsg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -307,131 +563,552 @@ func (m *UpdateMsg) GetAdminThreshold() Weight {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Contract)(nil), "multisig.Contract")
-	proto.RegisterType((*Participant)(nil), "multisig.Participant")
-	proto.RegisterType((*CreateMsg)(nil), "multisig.CreateMsg")
-	proto.RegisterType((*UpdateMsg)(nil), "multisig.UpdateMsg")
+func (m *UpdateMsg) GetUpdateDelay() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.UpdateDelay
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("x/multisig/codec.proto", fileDescriptor_e5080d98b87cf9a7) }
+func (m *UpdateMsg) GetMessageThresholds() []MessageThreshold {
+	if m != nil {
+		return m.MessageThresholds
+	}
+	return nil
+}
 
-var fileDescriptor_e5080d98b87cf9a7 = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x93, 0xcf, 0x8e, 0x9a, 0x50,
-	0x14, 0xc6, 0xbd, 0xf8, 0xa7, 0x7a, 0xb0, 0x9a, 0x50, 0xdb, 0x10, 0x17, 0x48, 0x48, 0x17, 0x24,
-	0x4d, 0x21, 0xd1, 0x55, 0x17, 0x6d, 0x52, 0xec, 0xc6, 0x85, 0x49, 0x43, 0xda, 0x74, 0x69, 0xae,
-	0xdc, 0x1b, 0xb8, 0x89, 0x70, 0x09, 0x5c, 0xb4, 0x8f, 0xd1, 0x65, 0x9f, 0xa0, 0xcf, 0xd2, 0xa5,
-	0xcb, 0xae, 0x4c, 0x83, 0x8b, 0xbe, 0x83, 0xab, 0x66, 0x50, 0x06, 0x27, 0x2e, 0x66, 0xe2, 0xec,
-	0x66, 0x77, 0xf2, 0x9d, 0xdf, 0x07, 0xe7, 0x7c, 0x1c, 0xe0, 0xd5, 0x77, 0x3b, 0xcc, 0x56, 0x82,
-	0xa5, 0xcc, 0xb7, 0x3d, 0x4e, 0xa8, 0x67, 0xc5, 0x09, 0x17, 0x5c, 0x69, 0x97, 0xea, 0x50, 0x3e,
-	0x93, 0x87, 0x03, 0x9f, 0xfb, 0xbc, 0x28, 0xed, 0x9b, 0xea, 0xa8, 0x1a, 0xbf, 0x24, 0x68, 0x4f,
-	0x79, 0x24, 0x12, 0xec, 0x09, 0xe5, 0x0d, 0xb4, 0x43, 0x2a, 0x30, 0xc1, 0x02, 0xab, 0x48, 0x47,
-	0xa6, 0x3c, 0xee, 0x5b, 0x1b, 0x8a, 0xd7, 0xd4, 0x9a, 0x9f, 0x64, 0xf7, 0x16, 0x50, 0xde, 0x41,
-	0x37, 0xc6, 0x89, 0x60, 0x1e, 0x8b, 0x71, 0x24, 0x52, 0x55, 0xd2, 0xeb, 0xa6, 0x3c, 0x7e, 0x69,
-	0x95, 0x6f, 0xb7, 0x3e, 0x57, 0x5d, 0xf7, 0x0e, 0xaa, 0xbc, 0x87, 0x01, 0xf6, 0x04, 0x5b, 0x63,
-	0xc1, 0x78, 0xb4, 0x10, 0x41, 0x42, 0xd3, 0x80, 0xaf, 0x88, 0x5a, 0xd7, 0x91, 0xf9, 0xdc, 0x81,
-	0xc3, 0x6e, 0xd4, 0xfa, 0x46, 0x99, 0x1f, 0x08, 0xf7, 0x45, 0xc5, 0x7d, 0x29, 0x31, 0x65, 0x02,
-	0x7d, 0x4c, 0x42, 0x76, 0xee, 0x6c, 0x5c, 0x38, 0x7b, 0x05, 0x52, 0x99, 0x3e, 0xc0, 0x33, 0x4c,
-	0x48, 0x42, 0xd3, 0x54, 0x6d, 0xea, 0xc8, 0xec, 0x3a, 0xaf, 0x0f, 0xbb, 0x91, 0xee, 0x33, 0x11,
-	0x64, 0x4b, 0xcb, 0xe3, 0xa1, 0xcd, 0xf8, 0xfa, 0x2d, 0x8f, 0xa8, 0x7d, 0x5c, 0xf8, 0xe3, 0x91,
-	0x75, 0x4b, 0x93, 0x91, 0x81, 0x7c, 0xb6, 0x90, 0xe2, 0x40, 0x27, 0x65, 0x7e, 0x84, 0x45, 0x96,
-	0xd0, 0x22, 0xab, 0x87, 0x3e, 0xb0, 0xb2, 0x29, 0x06, 0xb4, 0x36, 0xc5, 0xb0, 0xaa, 0x74, 0x31,
-	0xfe, 0xa9, 0x63, 0xfc, 0x43, 0xd0, 0x99, 0x26, 0x14, 0x0b, 0x3a, 0x4f, 0xfd, 0xa7, 0xfc, 0x81,
-	0x8c, 0x9f, 0x12, 0x74, 0xbe, 0xc6, 0xe4, 0x9a, 0x4d, 0x6d, 0x90, 0xbd, 0xd3, 0x0d, 0x2f, 0x18,
-	0x29, 0xd2, 0xec, 0x3a, 0xbd, 0x7c, 0x37, 0x82, 0xf2, 0xb4, 0x67, 0x9f, 0x5c, 0x28, 0x91, 0x19,
-	0xb9, 0x88, 0xa6, 0xfe, 0xf8, 0x68, 0x1a, 0x57, 0x47, 0xd3, 0xbc, 0x2f, 0x1a, 0x47, 0xfd, 0x9d,
-	0x6b, 0x68, 0x9b, 0x6b, 0xe8, 0x6f, 0xae, 0xa1, 0x1f, 0x7b, 0xad, 0xb6, 0xdd, 0x6b, 0xb5, 0x3f,
-	0x7b, 0xad, 0xb6, 0x6c, 0x15, 0x7f, 0xf1, 0xe4, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd6, 0xdc,
-	0x6e, 0x9f, 0x0c, 0x04, 0x00, 0x00,
+func (m *UpdateMsg) GetProposalTTL() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.ProposalTTL
+	}
+	return 0
 }
 
-func (m *Contract) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+func (m *UpdateMsg) GetApprovalTTL() github_com_iov_one_weave.UnixDuration {
+	if m != nil {
+		return m.ApprovalTTL
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Contract) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n1, err := m.Metadata.MarshalTo(dAtA[i:])
+// ApplyUpdateMsg applies the pending update of a contract once its update
+// delay has passed. It does not require any signature and is scheduled to be
+// executed by the cron.
+type ApplyUpdateMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ContractID []byte          `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (m *ApplyUpdateMsg) Reset()         { *m = ApplyUpdateMsg{} }
+func (m *ApplyUpdateMsg) String() string { return proto.CompactTextString(m) }
+func (*ApplyUpdateMsg) ProtoMessage()    {}
+func (*ApplyUpdateMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{6}
+}
+func (m *ApplyUpdateMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplyUpdateMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplyUpdateMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
 		if err != nil {
-			return 0, err
-		}
-		i += n1
-	}
-	if len(m.Participants) > 0 {
-		for _, msg := range m.Participants {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ActivationThreshold != 0 {
-		dAtA[i] = 0x18
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.ActivationThreshold))
-	}
-	if m.AdminThreshold != 0 {
-		dAtA[i] = 0x20
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.AdminThreshold))
-	}
-	if len(m.Address) > 0 {
-		dAtA[i] = 0x2a
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
-		i += copy(dAtA[i:], m.Address)
-	}
-	return i, nil
+}
+func (m *ApplyUpdateMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyUpdateMsg.Merge(m, src)
+}
+func (m *ApplyUpdateMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplyUpdateMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyUpdateMsg.DiscardUnknown(m)
 }
 
-func (m *Participant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ApplyUpdateMsg proto.InternalMessageInfo
+
+func (m *ApplyUpdateMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Participant) MarshalTo(dAtA []byte) (int, error) {
-	var i int
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		dAtA[i] = 0xa
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.Signature)))
-		i += copy(dAtA[i:], m.Signature)
+func (m *ApplyUpdateMsg) GetContractID() []byte {
+	if m != nil {
+		return m.ContractID
 	}
-	if m.Weight != 0 {
-		dAtA[i] = 0x10
-		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Weight))
+	return nil
+}
+
+// VetoUpdateMsg cancels the pending update of a contract. It must be signed
+// by any participant of the contract.
+type VetoUpdateMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ContractID []byte          `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+}
+
+func (m *VetoUpdateMsg) Reset()         { *m = VetoUpdateMsg{} }
+func (m *VetoUpdateMsg) String() string { return proto.CompactTextString(m) }
+func (*VetoUpdateMsg) ProtoMessage()    {}
+func (*VetoUpdateMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{7}
+}
+func (m *VetoUpdateMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VetoUpdateMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VetoUpdateMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return i, nil
+}
+func (m *VetoUpdateMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VetoUpdateMsg.Merge(m, src)
+}
+func (m *VetoUpdateMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *VetoUpdateMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_VetoUpdateMsg.DiscardUnknown(m)
 }
 
-func (m *CreateMsg) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_VetoUpdateMsg proto.InternalMessageInfo
+
+func (m *VetoUpdateMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *VetoUpdateMsg) GetContractID() []byte {
+	if m != nil {
+		return m.ContractID
+	}
+	return nil
+}
+
+// Proposal is a transaction stored on chain until participants of a multisig
+// contract approve it. Once the weight of all approvals reaches the contract
+// activation threshold, the transaction is executed using the contract
+// authority.
+type Proposal struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Contract ID is the ID of the multisig contract that executes the
+	// proposal.
+	ContractID []byte `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	// Raw option is the serialized message executed once the proposal is
+	// approved. It is decoded using the application specific decoder.
+	RawOption []byte `protobuf:"bytes,3,opt,name=raw_option,json=rawOption,proto3" json:"raw_option,omitempty"`
+	// Approvals is a list of participants that approved the proposal.
+	Approvals []Approval `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals"`
+	// Executed is set once the proposal message was executed.
+	Executed bool `protobuf:"varint,5,opt,name=executed,proto3" json:"executed,omitempty"`
+	// Expires at is the time after which the proposal is deleted. Zero value
+	// means that the proposal does not expire.
+	ExpiresAt github_com_iov_one_weave.UnixTime `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"expires_at,omitempty"`
+	// Expiration task ID is the ID of the cron task that deletes the proposal.
+	ExpirationTaskID []byte `protobuf:"bytes,7,opt,name=expiration_task_id,json=expirationTaskId,proto3" json:"expiration_task_id,omitempty"`
+}
+
+func (m *Proposal) Reset()         { *m = Proposal{} }
+func (m *Proposal) String() string { return proto.CompactTextString(m) }
+func (*Proposal) ProtoMessage()    {}
+func (*Proposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{8}
+}
+func (m *Proposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Proposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proposal.Merge(m, src)
+}
+func (m *Proposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proposal proto.InternalMessageInfo
+
+func (m *Proposal) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Proposal) GetContractID() []byte {
+	if m != nil {
+		return m.ContractID
+	}
+	return nil
+}
+
+func (m *Proposal) GetRawOption() []byte {
+	if m != nil {
+		return m.RawOption
+	}
+	return nil
+}
+
+func (m *Proposal) GetApprovals() []Approval {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *Proposal) GetExecuted() bool {
+	if m != nil {
+		return m.Executed
+	}
+	return false
+}
+
+func (m *Proposal) GetExpiresAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *Proposal) GetExpirationTaskID() []byte {
+	if m != nil {
+		return m.ExpirationTaskID
+	}
+	return nil
+}
+
+// Approval is a single approval of a proposal given by a participant.
+type Approval struct {
+	Signature  github_com_iov_one_weave.Address  `protobuf:"bytes,1,opt,name=signature,proto3,casttype=github.com/iov-one/weave.Address" json:"signature,omitempty"`
+	ApprovedAt github_com_iov_one_weave.UnixTime `protobuf:"varint,2,opt,name=approved_at,json=approvedAt,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"approved_at,omitempty"`
+}
+
+func (m *Approval) Reset()         { *m = Approval{} }
+func (m *Approval) String() string { return proto.CompactTextString(m) }
+func (*Approval) ProtoMessage()    {}
+func (*Approval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{9}
+}
+func (m *Approval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Approval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Approval.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Approval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Approval.Merge(m, src)
+}
+func (m *Approval) XXX_Size() int {
+	return m.Size()
+}
+func (m *Approval) XXX_DiscardUnknown() {
+	xxx_messageInfo_Approval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Approval proto.InternalMessageInfo
+
+func (m *Approval) GetSignature() github_com_iov_one_weave.Address {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *Approval) GetApprovedAt() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ApprovedAt
+	}
+	return 0
+}
+
+// CreateProposalMsg stores a new proposal for the given contract. All
+// participants that signed the transaction approve the proposal.
+type CreateProposalMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ContractID []byte          `protobuf:"bytes,2,opt,name=contract_id,json=contractId,proto3" json:"contract_id,omitempty"`
+	RawOption  []byte          `protobuf:"bytes,3,opt,name=raw_option,json=rawOption,proto3" json:"raw_option,omitempty"`
+}
+
+func (m *CreateProposalMsg) Reset()         { *m = CreateProposalMsg{} }
+func (m *CreateProposalMsg) String() string { return proto.CompactTextString(m) }
+func (*CreateProposalMsg) ProtoMessage()    {}
+func (*CreateProposalMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{10}
+}
+func (m *CreateProposalMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateProposalMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateProposalMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateProposalMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProposalMsg.Merge(m, src)
+}
+func (m *CreateProposalMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateProposalMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProposalMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateProposalMsg proto.InternalMessageInfo
+
+func (m *CreateProposalMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *CreateProposalMsg) GetContractID() []byte {
+	if m != nil {
+		return m.ContractID
+	}
+	return nil
+}
+
+func (m *CreateProposalMsg) GetRawOption() []byte {
+	if m != nil {
+		return m.RawOption
+	}
+	return nil
+}
+
+// ApproveProposalMsg adds approvals of all participants that signed the
+// transaction to the proposal.
+type ApproveProposalMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ProposalID []byte          `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *ApproveProposalMsg) Reset()         { *m = ApproveProposalMsg{} }
+func (m *ApproveProposalMsg) String() string { return proto.CompactTextString(m) }
+func (*ApproveProposalMsg) ProtoMessage()    {}
+func (*ApproveProposalMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{11}
+}
+func (m *ApproveProposalMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApproveProposalMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApproveProposalMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApproveProposalMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveProposalMsg.Merge(m, src)
+}
+func (m *ApproveProposalMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApproveProposalMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveProposalMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApproveProposalMsg proto.InternalMessageInfo
+
+func (m *ApproveProposalMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ApproveProposalMsg) GetProposalID() []byte {
+	if m != nil {
+		return m.ProposalID
+	}
+	return nil
+}
+
+// ExpireProposalMsg deletes a proposal once it expired. It does not require
+// any signature and is scheduled to be executed by the cron.
+type ExpireProposalMsg struct {
+	Metadata   *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ProposalID []byte          `protobuf:"bytes,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *ExpireProposalMsg) Reset()         { *m = ExpireProposalMsg{} }
+func (m *ExpireProposalMsg) String() string { return proto.CompactTextString(m) }
+func (*ExpireProposalMsg) ProtoMessage()    {}
+func (*ExpireProposalMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5080d98b87cf9a7, []int{12}
+}
+func (m *ExpireProposalMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpireProposalMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpireProposalMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpireProposalMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpireProposalMsg.Merge(m, src)
+}
+func (m *ExpireProposalMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpireProposalMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpireProposalMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpireProposalMsg proto.InternalMessageInfo
+
+func (m *ExpireProposalMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ExpireProposalMsg) GetProposalID() []byte {
+	if m != nil {
+		return m.ProposalID
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Contract)(nil), "multisig.Contract")
+	proto.RegisterType((*MessageThreshold)(nil), "multisig.MessageThreshold")
+	proto.RegisterType((*PendingUpdate)(nil), "multisig.PendingUpdate")
+	proto.RegisterType((*Participant)(nil), "multisig.Participant")
+	proto.RegisterType((*CreateMsg)(nil), "multisig.CreateMsg")
+	proto.RegisterType((*UpdateMsg)(nil), "multisig.UpdateMsg")
+	proto.RegisterType((*ApplyUpdateMsg)(nil), "multisig.ApplyUpdateMsg")
+	proto.RegisterType((*VetoUpdateMsg)(nil), "multisig.VetoUpdateMsg")
+	proto.RegisterType((*Proposal)(nil), "multisig.Proposal")
+	proto.RegisterType((*Approval)(nil), "multisig.Approval")
+	proto.RegisterType((*CreateProposalMsg)(nil), "multisig.CreateProposalMsg")
+	proto.RegisterType((*ApproveProposalMsg)(nil), "multisig.ApproveProposalMsg")
+	proto.RegisterType((*ExpireProposalMsg)(nil), "multisig.ExpireProposalMsg")
+}
+
+func init() { proto.RegisterFile("x/multisig/codec.proto", fileDescriptor_e5080d98b87cf9a7) }
+
+var fileDescriptor_e5080d98b87cf9a7 = []byte{
+	// 914 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x97, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xc0, 0xeb, 0x24, 0x75, 0xec, 0x97, 0xb4, 0xdb, 0x0e, 0x05, 0x4c, 0x25, 0xe2, 0x60, 0x58,
+	0x29, 0x08, 0x91, 0x48, 0xbb, 0x12, 0x82, 0x03, 0x2b, 0x92, 0x0d, 0x48, 0x91, 0xb6, 0xda, 0xca,
+	0xca, 0xb2, 0x07, 0x0e, 0xd1, 0x6c, 0x3c, 0x72, 0xac, 0xb5, 0x3d, 0xc6, 0x33, 0x69, 0xbb, 0xdf,
+	0x62, 0x4f, 0x7c, 0x1d, 0x38, 0xee, 0x71, 0x8f, 0x9c, 0x2c, 0x94, 0x8a, 0x0f, 0xc0, 0xb5, 0x07,
+	0x84, 0x3c, 0xf6, 0xc4, 0x69, 0xbb, 0xbb, 0x28, 0x4d, 0xd2, 0x03, 0xdc, 0xe2, 0xf7, 0x6f, 0xe6,
+	0xbd, 0xf9, 0xbd, 0x37, 0x13, 0xf8, 0xe0, 0xac, 0x13, 0x4c, 0x7d, 0xee, 0x31, 0xcf, 0xed, 0x8c,
+	0xa9, 0x43, 0xc6, 0xed, 0x28, 0xa6, 0x9c, 0x22, 0x4d, 0x4a, 0x0f, 0x6b, 0x0b, 0xe2, 0xc3, 0x03,
+	0x97, 0xba, 0x54, 0xfc, 0xec, 0xa4, 0xbf, 0x32, 0xa9, 0xf5, 0xeb, 0x36, 0x68, 0x0f, 0x69, 0xc8,
+	0x63, 0x3c, 0xe6, 0xe8, 0x0b, 0xd0, 0x02, 0xc2, 0xb1, 0x83, 0x39, 0x36, 0x94, 0xa6, 0xd2, 0xaa,
+	0xdd, 0xbb, 0xd3, 0x3e, 0x25, 0xf8, 0x84, 0xb4, 0x8f, 0x72, 0xb1, 0x3d, 0x37, 0x40, 0xdf, 0x40,
+	0x3d, 0xc2, 0x31, 0xf7, 0xc6, 0x5e, 0x84, 0x43, 0xce, 0x8c, 0x52, 0xb3, 0xdc, 0xaa, 0xdd, 0x7b,
+	0xbf, 0x2d, 0x57, 0x6f, 0x1f, 0x17, 0x5a, 0xfb, 0x92, 0x29, 0xfa, 0x16, 0x0e, 0xf0, 0x98, 0x7b,
+	0x27, 0x98, 0x7b, 0x34, 0x1c, 0xf1, 0x49, 0x4c, 0xd8, 0x84, 0xfa, 0x8e, 0x51, 0x6e, 0x2a, 0xad,
+	0x9d, 0x1e, 0x5c, 0x24, 0xa6, 0xfa, 0x94, 0x78, 0xee, 0x84, 0xdb, 0xef, 0x15, 0x76, 0x43, 0x69,
+	0x86, 0xee, 0xc3, 0x1d, 0xec, 0x04, 0xde, 0xa2, 0x67, 0xe5, 0x9a, 0xe7, 0xae, 0x30, 0x29, 0x9c,
+	0x1e, 0x40, 0x15, 0x3b, 0x4e, 0x4c, 0x18, 0x33, 0xb6, 0x9b, 0x4a, 0xab, 0xde, 0xfb, 0xec, 0x22,
+	0x31, 0x9b, 0xae, 0xc7, 0x27, 0xd3, 0x67, 0xed, 0x31, 0x0d, 0x3a, 0x1e, 0x3d, 0xf9, 0x92, 0x86,
+	0xa4, 0x93, 0x25, 0xdc, 0xcd, 0x6c, 0x6d, 0xe9, 0x84, 0x1e, 0x41, 0x7d, 0x1a, 0x39, 0x98, 0x93,
+	0x91, 0x43, 0x7c, 0xfc, 0xc2, 0x50, 0xc5, 0x8a, 0x9f, 0x5f, 0x24, 0xe6, 0xdd, 0xb7, 0x06, 0x79,
+	0x12, 0x7a, 0x67, 0xfd, 0x69, 0x2c, 0xb6, 0x6f, 0xd7, 0x32, 0xf7, 0x7e, 0xea, 0x8d, 0x1e, 0xc0,
+	0x6e, 0x44, 0x42, 0xc7, 0x0b, 0xdd, 0x51, 0x26, 0x36, 0xaa, 0xa2, 0xde, 0x1f, 0x2e, 0x94, 0x2f,
+	0xd3, 0x3f, 0x11, 0x6a, 0x7b, 0x27, 0x5a, 0xfc, 0x44, 0x8f, 0x01, 0x05, 0x84, 0x31, 0xec, 0x92,
+	0xa2, 0x08, 0xcc, 0xd0, 0xc4, 0x11, 0x1c, 0x16, 0x31, 0x8e, 0x32, 0x9b, 0x79, 0x15, 0x7a, 0x95,
+	0x57, 0x89, 0xb9, 0x65, 0xef, 0x07, 0x57, 0xe4, 0x0c, 0xfd, 0x04, 0xf5, 0x28, 0xa6, 0x11, 0x65,
+	0xd8, 0x1f, 0x71, 0xee, 0x1b, 0xba, 0x48, 0xef, 0xeb, 0x59, 0x62, 0xd6, 0x8e, 0x73, 0xf9, 0x70,
+	0xf8, 0x68, 0x89, 0x6c, 0x65, 0xb4, 0x21, 0xf7, 0xd3, 0xe0, 0x38, 0x8a, 0x62, 0x7a, 0x92, 0x07,
+	0x87, 0x22, 0x78, 0x37, 0x97, 0x2f, 0x17, 0x5c, 0x46, 0x1b, 0x72, 0xdf, 0x7a, 0x0a, 0x7b, 0x57,
+	0xd3, 0x44, 0x1f, 0x81, 0x16, 0x30, 0x77, 0x14, 0x61, 0x3e, 0x11, 0x20, 0xeb, 0x76, 0x35, 0x60,
+	0xee, 0x31, 0xe6, 0x13, 0xd4, 0x02, 0xbd, 0xc0, 0xa6, 0x74, 0x0d, 0x9b, 0x42, 0x69, 0xfd, 0x5d,
+	0x81, 0x9d, 0x4b, 0x87, 0x70, 0x0d, 0x79, 0x65, 0x75, 0xe4, 0x4b, 0x37, 0x46, 0xbe, 0xfc, 0xaf,
+	0xc8, 0x5f, 0x45, 0xb6, 0xb2, 0x12, 0xb2, 0xdf, 0x81, 0x86, 0xa3, 0xc8, 0x7f, 0x31, 0xc2, 0x5c,
+	0x74, 0x50, 0xb9, 0x77, 0xf7, 0x22, 0x31, 0x3f, 0x79, 0x67, 0xa4, 0xa1, 0x17, 0x10, 0xbb, 0x2a,
+	0xdc, 0xba, 0x1c, 0x7d, 0x0a, 0x55, 0x8e, 0xd9, 0xf3, 0x91, 0xe7, 0x88, 0xee, 0xa9, 0xf7, 0x60,
+	0x96, 0x98, 0xea, 0x10, 0xb3, 0xe7, 0x83, 0xbe, 0xad, 0xa6, 0xaa, 0x81, 0xf3, 0x16, 0xb2, 0xab,
+	0xeb, 0x23, 0x5b, 0xdb, 0x24, 0xd9, 0xfa, 0x3a, 0xc9, 0x9e, 0x42, 0x6d, 0x01, 0x28, 0xd4, 0x03,
+	0x9d, 0x79, 0x6e, 0x88, 0xf9, 0x34, 0x26, 0x86, 0xb2, 0xc4, 0x0c, 0x2b, 0xdc, 0x90, 0x05, 0xea,
+	0xa9, 0x80, 0xe5, 0x0d, 0xe0, 0xe5, 0x1a, 0xeb, 0xb7, 0x0a, 0xe8, 0x0f, 0x63, 0x82, 0x39, 0x39,
+	0x62, 0xee, 0x7f, 0xfa, 0x4e, 0xb8, 0xda, 0x20, 0xdb, 0x2b, 0x35, 0xc8, 0x9b, 0xc9, 0x55, 0xd7,
+	0x47, 0x6e, 0x75, 0x93, 0xe4, 0x6a, 0xeb, 0x24, 0xf7, 0xaf, 0x0a, 0xe8, 0xd9, 0xcc, 0x5c, 0x1a,
+	0xa1, 0x0e, 0xd4, 0xc6, 0xf9, 0x7b, 0x24, 0x1d, 0x14, 0x25, 0xc1, 0xf9, 0xee, 0x2c, 0x31, 0x41,
+	0x3e, 0x53, 0x06, 0x7d, 0x1b, 0xa4, 0xc9, 0xc0, 0xb9, 0xc6, 0x5c, 0x79, 0x75, 0xe6, 0x2a, 0x37,
+	0x66, 0x6e, 0x7b, 0x69, 0xe6, 0xd4, 0x0d, 0x30, 0xf7, 0xbf, 0x9c, 0x96, 0x21, 0xec, 0x76, 0xd3,
+	0x8b, 0xe6, 0x96, 0xb8, 0xb3, 0x02, 0xd8, 0xf9, 0x91, 0x70, 0x7a, 0x5b, 0xcb, 0xfd, 0x59, 0x02,
+	0x4d, 0x9e, 0xc0, 0x86, 0x3b, 0xea, 0x63, 0x80, 0x18, 0x9f, 0x8e, 0x68, 0x94, 0x16, 0x59, 0x0c,
+	0xe0, 0xba, 0xad, 0xc7, 0xf8, 0xf4, 0xb1, 0x10, 0xa0, 0xaf, 0x40, 0x97, 0x75, 0x67, 0x46, 0x45,
+	0xa0, 0x86, 0x0a, 0xd4, 0xe4, 0x41, 0xe6, 0x88, 0x15, 0xa6, 0xe8, 0x10, 0x34, 0x72, 0x46, 0xc6,
+	0x53, 0x4e, 0xb2, 0x3e, 0xd1, 0xec, 0xf9, 0x37, 0xea, 0x03, 0x90, 0xb3, 0xc8, 0x8b, 0x09, 0x4b,
+	0x9f, 0x17, 0xea, 0x32, 0xcf, 0x0b, 0x3d, 0x77, 0xec, 0xa6, 0x37, 0x24, 0x12, 0x1f, 0x79, 0x3f,
+	0xe7, 0x6f, 0x8d, 0xaa, 0x48, 0xf8, 0x60, 0x96, 0x98, 0x7b, 0xdf, 0xcf, 0xb5, 0xf9, 0xab, 0x63,
+	0x8f, 0x5c, 0x96, 0x38, 0xd6, 0x2f, 0x0a, 0x68, 0x32, 0x87, 0xb5, 0x5c, 0xb9, 0x3f, 0x40, 0x8e,
+	0x29, 0x71, 0xd2, 0xdc, 0x4a, 0xcb, 0xe4, 0x06, 0xd2, 0xb3, 0xcb, 0xad, 0x97, 0x0a, 0xec, 0x67,
+	0xd7, 0xb2, 0xc4, 0x60, 0xf3, 0xb3, 0xf5, 0xdd, 0x24, 0x58, 0x31, 0xa0, 0xac, 0x54, 0x2b, 0x6d,
+	0x69, 0x3e, 0x6f, 0x2e, 0x6f, 0x49, 0x86, 0x4c, 0xb7, 0x24, 0x4d, 0x06, 0x8e, 0xf5, 0x33, 0xec,
+	0x8b, 0x53, 0xbc, 0xbd, 0x25, 0x7b, 0xc6, 0xab, 0x59, 0x43, 0x79, 0x3d, 0x6b, 0x28, 0x7f, 0xcc,
+	0x1a, 0xca, 0xcb, 0xf3, 0xc6, 0xd6, 0xeb, 0xf3, 0xc6, 0xd6, 0xef, 0xe7, 0x8d, 0xad, 0x67, 0xaa,
+	0xf8, 0x13, 0x7d, 0xff, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf1, 0x97, 0xe4, 0x65, 0x8b, 0x0f,
+	0x00, 0x00,
+}
+
+func (m *Contract) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -441,7 +1118,7 @@ func (m *CreateMsg) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *Contract) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -450,11 +1127,11 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n2, err := m.Metadata.MarshalTo(dAtA[i:])
+		n1, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n2
+		i += n1
 	}
 	if len(m.Participants) > 0 {
 		for _, msg := range m.Participants {
@@ -478,43 +1155,99 @@ func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AdminThreshold))
 	}
-	return i, nil
-}
-
-func (m *UpdateMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalTo(dAtA)
-	if err != nil {
+	if len(m.Address) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Address)))
+		i += copy(dAtA[i:], m.Address)
+	}
+	if m.UpdateDelay != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateDelay))
+	}
+	if m.PendingUpdate != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.PendingUpdate.Size()))
+		n2, err := m.PendingUpdate.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n2
+	}
+	if len(m.MessageThresholds) > 0 {
+		for _, msg := range m.MessageThresholds {
+			dAtA[i] = 0x42
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ProposalTTL != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ProposalTTL))
+	}
+	if m.ApprovalTTL != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ApprovalTTL))
+	}
+	return i, nil
+}
+
+func (m *MessageThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *UpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+func (m *MessageThreshold) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Metadata != nil {
+	if len(m.MsgPath) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n3, err := m.Metadata.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
-		}
-		i += n3
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.MsgPath)))
+		i += copy(dAtA[i:], m.MsgPath)
 	}
-	if len(m.ContractID) > 0 {
-		dAtA[i] = 0x12
+	if m.Threshold != 0 {
+		dAtA[i] = 0x10
 		i++
-		i = encodeVarintCodec(dAtA, i, uint64(len(m.ContractID)))
-		i += copy(dAtA[i:], m.ContractID)
+		i = encodeVarintCodec(dAtA, i, uint64(m.Threshold))
+	}
+	return i, nil
+}
+
+func (m *PendingUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingUpdate) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
 	if len(m.Participants) > 0 {
 		for _, msg := range m.Participants {
-			dAtA[i] = 0x1a
+			dAtA[i] = 0xa
 			i++
 			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -525,140 +1258,2480 @@ func (m *UpdateMsg) MarshalTo(dAtA []byte) (int, error) {
 		}
 	}
 	if m.ActivationThreshold != 0 {
-		dAtA[i] = 0x20
+		dAtA[i] = 0x10
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.ActivationThreshold))
 	}
 	if m.AdminThreshold != 0 {
-		dAtA[i] = 0x28
+		dAtA[i] = 0x18
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.AdminThreshold))
 	}
-	return i, nil
-}
-
-func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.UpdateDelay != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateDelay))
 	}
-	dAtA[offset] = uint8(v)
-	return offset + 1
-}
-func (m *Contract) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ApplyAt != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ApplyAt))
 	}
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.TaskID) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.TaskID)))
+		i += copy(dAtA[i:], m.TaskID)
 	}
-	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+	if len(m.MessageThresholds) > 0 {
+		for _, msg := range m.MessageThresholds {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
-	if m.ActivationThreshold != 0 {
-		n += 1 + sovCodec(uint64(m.ActivationThreshold))
-	}
-	if m.AdminThreshold != 0 {
-		n += 1 + sovCodec(uint64(m.AdminThreshold))
+	if m.ProposalTTL != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ProposalTTL))
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if m.ApprovalTTL != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ApprovalTTL))
 	}
-	return n
+	return i, nil
 }
 
-func (m *Participant) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Participant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Participant) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
 	}
 	if m.Weight != 0 {
-		n += 1 + sovCodec(uint64(m.Weight))
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Weight))
 	}
-	return n
+	return i, nil
 }
 
-func (m *CreateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreateMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
 	}
 	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+		for _, msg := range m.Participants {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.ActivationThreshold != 0 {
-		n += 1 + sovCodec(uint64(m.ActivationThreshold))
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ActivationThreshold))
 	}
 	if m.AdminThreshold != 0 {
-		n += 1 + sovCodec(uint64(m.AdminThreshold))
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AdminThreshold))
 	}
-	return n
+	if m.UpdateDelay != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateDelay))
+	}
+	if len(m.MessageThresholds) > 0 {
+		for _, msg := range m.MessageThresholds {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ProposalTTL != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ProposalTTL))
+	}
+	if m.ApprovalTTL != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ApprovalTTL))
+	}
+	return i, nil
 }
 
-func (m *UpdateMsg) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
 	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovCodec(uint64(l))
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n4, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n4
 	}
-	l = len(m.ContractID)
-	if l > 0 {
-		n += 1 + l + sovCodec(uint64(l))
+	if len(m.ContractID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ContractID)))
+		i += copy(dAtA[i:], m.ContractID)
 	}
 	if len(m.Participants) > 0 {
-		for _, e := range m.Participants {
-			l = e.Size()
-			n += 1 + l + sovCodec(uint64(l))
+		for _, msg := range m.Participants {
+			dAtA[i] = 0x1a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
 		}
 	}
 	if m.ActivationThreshold != 0 {
-		n += 1 + sovCodec(uint64(m.ActivationThreshold))
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ActivationThreshold))
 	}
 	if m.AdminThreshold != 0 {
-		n += 1 + sovCodec(uint64(m.AdminThreshold))
-	}
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.AdminThreshold))
+	}
+	if m.UpdateDelay != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.UpdateDelay))
+	}
+	if len(m.MessageThresholds) > 0 {
+		for _, msg := range m.MessageThresholds {
+			dAtA[i] = 0x3a
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.ProposalTTL != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ProposalTTL))
+	}
+	if m.ApprovalTTL != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ApprovalTTL))
+	}
+	return i, nil
+}
+
+func (m *ApplyUpdateMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	if len(m.ContractID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ContractID)))
+		i += copy(dAtA[i:], m.ContractID)
+	}
+	return i, nil
+}
+
+func (m *VetoUpdateMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VetoUpdateMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n6, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.ContractID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ContractID)))
+		i += copy(dAtA[i:], m.ContractID)
+	}
+	return i, nil
+}
+
+func (m *Proposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proposal) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n7, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if len(m.ContractID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ContractID)))
+		i += copy(dAtA[i:], m.ContractID)
+	}
+	if len(m.RawOption) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.RawOption)))
+		i += copy(dAtA[i:], m.RawOption)
+	}
+	if len(m.Approvals) > 0 {
+		for _, msg := range m.Approvals {
+			dAtA[i] = 0x22
+			i++
+			i = encodeVarintCodec(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.Executed {
+		dAtA[i] = 0x28
+		i++
+		if m.Executed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.ExpiresAt != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ExpiresAt))
+	}
+	if len(m.ExpirationTaskID) > 0 {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ExpirationTaskID)))
+		i += copy(dAtA[i:], m.ExpirationTaskID)
+	}
+	return i, nil
+}
+
+func (m *Approval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Approval) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Signature)))
+		i += copy(dAtA[i:], m.Signature)
+	}
+	if m.ApprovedAt != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ApprovedAt))
+	}
+	return i, nil
+}
+
+func (m *CreateProposalMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n8, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if len(m.ContractID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ContractID)))
+		i += copy(dAtA[i:], m.ContractID)
+	}
+	if len(m.RawOption) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.RawOption)))
+		i += copy(dAtA[i:], m.RawOption)
+	}
+	return i, nil
+}
+
+func (m *ApproveProposalMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApproveProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if len(m.ProposalID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
+		i += copy(dAtA[i:], m.ProposalID)
+	}
+	return i, nil
+}
+
+func (m *ExpireProposalMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpireProposalMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n10, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if len(m.ProposalID) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProposalID)))
+		i += copy(dAtA[i:], m.ProposalID)
+	}
+	return i, nil
+}
+
+func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return offset + 1
+}
+func (m *Contract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ActivationThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.ActivationThreshold))
+	}
+	if m.AdminThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.AdminThreshold))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.UpdateDelay != 0 {
+		n += 1 + sovCodec(uint64(m.UpdateDelay))
+	}
+	if m.PendingUpdate != nil {
+		l = m.PendingUpdate.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.MessageThresholds) > 0 {
+		for _, e := range m.MessageThresholds {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ProposalTTL != 0 {
+		n += 1 + sovCodec(uint64(m.ProposalTTL))
+	}
+	if m.ApprovalTTL != 0 {
+		n += 1 + sovCodec(uint64(m.ApprovalTTL))
+	}
+	return n
+}
+
+func (m *MessageThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgPath)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovCodec(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *PendingUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ActivationThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.ActivationThreshold))
+	}
+	if m.AdminThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.AdminThreshold))
+	}
+	if m.UpdateDelay != 0 {
+		n += 1 + sovCodec(uint64(m.UpdateDelay))
+	}
+	if m.ApplyAt != 0 {
+		n += 1 + sovCodec(uint64(m.ApplyAt))
+	}
+	l = len(m.TaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.MessageThresholds) > 0 {
+		for _, e := range m.MessageThresholds {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ProposalTTL != 0 {
+		n += 1 + sovCodec(uint64(m.ProposalTTL))
+	}
+	if m.ApprovalTTL != 0 {
+		n += 1 + sovCodec(uint64(m.ApprovalTTL))
+	}
+	return n
+}
+
+func (m *Participant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovCodec(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *CreateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ActivationThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.ActivationThreshold))
+	}
+	if m.AdminThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.AdminThreshold))
+	}
+	if m.UpdateDelay != 0 {
+		n += 1 + sovCodec(uint64(m.UpdateDelay))
+	}
+	if len(m.MessageThresholds) > 0 {
+		for _, e := range m.MessageThresholds {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ProposalTTL != 0 {
+		n += 1 + sovCodec(uint64(m.ProposalTTL))
+	}
+	if m.ApprovalTTL != 0 {
+		n += 1 + sovCodec(uint64(m.ApprovalTTL))
+	}
+	return n
+}
+
+func (m *UpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ContractID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Participants) > 0 {
+		for _, e := range m.Participants {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ActivationThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.ActivationThreshold))
+	}
+	if m.AdminThreshold != 0 {
+		n += 1 + sovCodec(uint64(m.AdminThreshold))
+	}
+	if m.UpdateDelay != 0 {
+		n += 1 + sovCodec(uint64(m.UpdateDelay))
+	}
+	if len(m.MessageThresholds) > 0 {
+		for _, e := range m.MessageThresholds {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.ProposalTTL != 0 {
+		n += 1 + sovCodec(uint64(m.ProposalTTL))
+	}
+	if m.ApprovalTTL != 0 {
+		n += 1 + sovCodec(uint64(m.ApprovalTTL))
+	}
+	return n
+}
+
+func (m *ApplyUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ContractID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *VetoUpdateMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ContractID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Proposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ContractID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.RawOption)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovCodec(uint64(l))
+		}
+	}
+	if m.Executed {
+		n += 2
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovCodec(uint64(m.ExpiresAt))
+	}
+	l = len(m.ExpirationTaskID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *Approval) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.ApprovedAt != 0 {
+		n += 1 + sovCodec(uint64(m.ApprovedAt))
+	}
+	return n
+}
+
+func (m *CreateProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ContractID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.RawOption)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ApproveProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ProposalID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *ExpireProposalMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ProposalID)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func sovCodec(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
 	return n
 }
+func sozCodec(x uint64) (n int) {
+	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Contract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &Participant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThreshold", wireType)
+			}
+			m.ActivationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationThreshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+			}
+			m.AdminThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminThreshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDelay", wireType)
+			}
+			m.UpdateDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateDelay |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingUpdate == nil {
+				m.PendingUpdate = &PendingUpdate{}
+			}
+			if err := m.PendingUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageThresholds = append(m.MessageThresholds, MessageThreshold{})
+			if err := m.MessageThresholds[len(m.MessageThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTTL", wireType)
+			}
+			m.ProposalTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalTTL |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalTTL", wireType)
+			}
+			m.ApprovalTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalTTL |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &Participant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThreshold", wireType)
+			}
+			m.ActivationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationThreshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+			}
+			m.AdminThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminThreshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDelay", wireType)
+			}
+			m.UpdateDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateDelay |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApplyAt", wireType)
+			}
+			m.ApplyAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApplyAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskID = append(m.TaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskID == nil {
+				m.TaskID = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageThresholds = append(m.MessageThresholds, MessageThreshold{})
+			if err := m.MessageThresholds[len(m.MessageThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTTL", wireType)
+			}
+			m.ProposalTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalTTL |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalTTL", wireType)
+			}
+			m.ApprovalTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalTTL |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Participant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Participant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Participant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &Participant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThreshold", wireType)
+			}
+			m.ActivationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationThreshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+			}
+			m.AdminThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminThreshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDelay", wireType)
+			}
+			m.UpdateDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateDelay |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageThresholds = append(m.MessageThresholds, MessageThreshold{})
+			if err := m.MessageThresholds[len(m.MessageThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTTL", wireType)
+			}
+			m.ProposalTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalTTL |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalTTL", wireType)
+			}
+			m.ApprovalTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalTTL |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractID = append(m.ContractID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContractID == nil {
+				m.ContractID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Participants = append(m.Participants, &Participant{})
+			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThreshold", wireType)
+			}
+			m.ActivationThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationThreshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+			}
+			m.AdminThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AdminThreshold |= Weight(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateDelay", wireType)
+			}
+			m.UpdateDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateDelay |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageThresholds = append(m.MessageThresholds, MessageThreshold{})
+			if err := m.MessageThresholds[len(m.MessageThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalTTL", wireType)
+			}
+			m.ProposalTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalTTL |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalTTL", wireType)
+			}
+			m.ApprovalTTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApprovalTTL |= github_com_iov_one_weave.UnixDuration(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplyUpdateMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplyUpdateMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplyUpdateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractID = append(m.ContractID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContractID == nil {
+				m.ContractID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovCodec(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VetoUpdateMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VetoUpdateMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VetoUpdateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractID = append(m.ContractID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContractID == nil {
+				m.ContractID = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-func sozCodec(x uint64) (n int) {
-	return sovCodec(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Contract) Unmarshal(dAtA []byte) error {
+func (m *Proposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -681,10 +3754,10 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Contract: wiretype end group for non-group")
+			return fmt.Errorf("proto: Proposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Contract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Proposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -725,7 +3798,75 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractID", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractID = append(m.ContractID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContractID == nil {
+				m.ContractID = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawOption", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawOption = append(m.RawOption[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawOption == nil {
+				m.RawOption = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -752,16 +3893,16 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participants = append(m.Participants, &Participant{})
-			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Approvals = append(m.Approvals, Approval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executed", wireType)
 			}
-			m.ActivationThreshold = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -771,16 +3912,17 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActivationThreshold |= Weight(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.Executed = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.AdminThreshold = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -790,14 +3932,14 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AdminThreshold |= Weight(b&0x7F) << shift
+				m.ExpiresAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTaskID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -824,9 +3966,9 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.ExpirationTaskID = append(m.ExpirationTaskID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExpirationTaskID == nil {
+				m.ExpirationTaskID = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -853,7 +3995,7 @@ func (m *Contract) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Participant) Unmarshal(dAtA []byte) error {
+func (m *Approval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -876,10 +4018,10 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Participant: wiretype end group for non-group")
+			return fmt.Errorf("proto: Approval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Participant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Approval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -918,9 +4060,9 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
 			}
-			m.Weight = 0
+			m.ApprovedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -930,7 +4072,7 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Weight |= Weight(b&0x7F) << shift
+				m.ApprovedAt |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -959,7 +4101,7 @@ func (m *Participant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateMsg) Unmarshal(dAtA []byte) error {
+func (m *CreateProposalMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -982,10 +4124,10 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateProposalMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1026,9 +4168,9 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractID", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1038,31 +4180,31 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthCodec
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCodec
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participants = append(m.Participants, &Participant{})
-			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ContractID = append(m.ContractID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContractID == nil {
+				m.ContractID = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThreshold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawOption", wireType)
 			}
-			m.ActivationThreshold = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1072,30 +4214,26 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActivationThreshold |= Weight(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
 			}
-			m.AdminThreshold = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AdminThreshold |= Weight(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawOption = append(m.RawOption[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawOption == nil {
+				m.RawOption = []byte{}
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -1120,7 +4258,7 @@ func (m *CreateMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateMsg) Unmarshal(dAtA []byte) error {
+func (m *ApproveProposalMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1143,10 +4281,10 @@ func (m *UpdateMsg) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateMsg: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApproveProposalMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApproveProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1187,7 +4325,7 @@ func (m *UpdateMsg) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1214,14 +4352,67 @@ func (m *UpdateMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractID = append(m.ContractID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContractID == nil {
-				m.ContractID = []byte{}
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpireProposalMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpireProposalMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpireProposalMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Participants", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1248,16 +4439,18 @@ func (m *UpdateMsg) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Participants = append(m.Participants, &Participant{})
-			if err := m.Participants[len(m.Participants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationThreshold", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalID", wireType)
 			}
-			m.ActivationThreshold = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCodec
@@ -1267,30 +4460,26 @@ func (m *UpdateMsg) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActivationThreshold |= Weight(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminThreshold", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
 			}
-			m.AdminThreshold = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCodec
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AdminThreshold |= Weight(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ProposalID = append(m.ProposalID[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalID == nil {
+				m.ProposalID = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
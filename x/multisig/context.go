@@ -54,3 +54,11 @@ func (a Authenticate) HasAddress(ctx weave.Context, addr weave.Address) bool {
 	}
 	return false
 }
+
+// withProposalMultisig returns a context that is authenticated by the
+// multisig contract executing a proposal. All multisig conditions set
+// before are dropped, so that the proposal message is executed only with the
+// authority of its contract.
+func withProposalMultisig(ctx weave.Context, contractID []byte) weave.Context {
+	return context.WithValue(ctx, contextKeyMultisig, []weave.Condition{MultiSigCondition(contractID)})
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 const (
@@ -26,7 +27,7 @@ func NewDecorator(auth x.Authenticator) Decorator {
 
 // Check enforce multisig contract before calling down the stack
 func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
-	newCtx, cost, err := d.authMultisig(ctx, store, tx)
+	newCtx, cost, _, err := d.authMultisig(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -39,24 +40,48 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 	return res, nil
 }
 
-// Deliver enforces multisig contract before calling down the stack
+// Deliver enforces multisig contract before calling down the stack. Every
+// activated contract is tagged together with the participants that signed
+// the transaction.
 func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
-	newCtx, _, err := d.authMultisig(ctx, store, tx)
+	newCtx, _, tags, err := d.authMultisig(ctx, store, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	return next.Deliver(newCtx, store, tx)
+	res, err := next.Deliver(newCtx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+	res.Tags = append(res.Tags, tags...)
+	return res, nil
 }
 
-func (d Decorator) authMultisig(ctx weave.Context, store weave.KVStore, tx weave.Tx) (weave.Context, int64, error) {
+// authMultisig activates all contracts listed by the transaction. Weight
+// required to activate a contract depends on the transaction message. A
+// contract can be a participant of another contract. Contracts are activated
+// in as many rounds as needed, so that the order in which they are listed
+// does not matter. Returned tags describe all activated contracts.
+func (d Decorator) authMultisig(ctx weave.Context, store weave.KVStore, tx weave.Tx) (weave.Context, int64, []common.KVPair, error) {
 	multisigContract, ok := tx.(MultiSigTx)
 	if !ok {
-		return ctx, 0, nil
+		return ctx, 0, nil, nil
 	}
-
-	var gasCost int64
 	ids := multisigContract.GetMultisig()
+	if len(ids) == 0 {
+		return ctx, 0, nil, nil
+	}
+	msg, err := tx.GetMsg()
+	if err != nil {
+		return ctx, 0, nil, errors.Wrap(err, "cannot get message")
+	}
+
+	type pendingContract struct {
+		id       []byte
+		contract Contract
+		required Weight
+	}
+	var pending []pendingContract
 	for _, contractID := range ids {
 		if contractID == nil {
 			continue
@@ -69,24 +94,61 @@ func (d Decorator) authMultisig(ctx weave.Context, store weave.KVStore, tx weave
 
 		var contract Contract
 		if err := d.bucket.One(store, contractID, &contract); err != nil {
-			return ctx, 0, errors.Wrap(err, "cannot load contract from the store")
+			return ctx, 0, nil, errors.Wrap(err, "cannot load contract from the store")
+		}
+		required, err := contract.RequiredWeight(msg)
+		if err != nil {
+			return ctx, 0, nil, err
 		}
+		pending = append(pending, pendingContract{id: contractID, contract: contract, required: required})
+	}
+
+	var (
+		gasCost int64
+		tags    []common.KVPair
+	)
+	for len(pending) > 0 {
+		var (
+			notActivated []pendingContract
+			firstErr     error
+		)
+		for _, p := range pending {
+			// The same contract can be listed more than once.
+			if d.auth.HasAddress(ctx, MultiSigCondition(p.id).Address()) {
+				continue
+			}
 
-		var weight Weight
-		for _, p := range contract.Participants {
-			if d.auth.HasAddress(ctx, p.Signature) {
-				weight += p.Weight
-				gasCost += multisigParticipantGasCost
+			var (
+				weight  Weight
+				signers []weave.Address
+			)
+			for _, participant := range p.contract.Participants {
+				if d.auth.HasAddress(ctx, participant.Signature) {
+					weight += participant.Weight
+					signers = append(signers, participant.Signature)
+				}
+			}
+			if weight < p.required {
+				if firstErr == nil {
+					firstErr = errors.Wrapf(errors.ErrUnauthorized,
+						"%d weight is not enough to activate %q", weight, p.id)
+				}
+				notActivated = append(notActivated, p)
+				continue
 			}
+
+			gasCost += int64(len(signers)) * multisigParticipantGasCost
+			tags = append(tags, actionTags(AuthorizeAction, p.id, signers)...)
+			ctx = withMultisig(ctx, p.id)
 		}
-		if weight < contract.ActivationThreshold {
-			err := errors.Wrapf(errors.ErrUnauthorized,
-				"%d weight is not enough to activate %q", weight, contractID)
-			return ctx, 0, err
+		// Activation of a contract might allow to activate another
+		// contract that it is a participant of. Stop only when there
+		// is no progress.
+		if len(notActivated) == len(pending) {
+			return ctx, 0, nil, firstErr
 		}
-
-		ctx = withMultisig(ctx, contractID)
+		pending = notActivated
 	}
 
-	return ctx, gasCost, nil
+	return ctx, gasCost, tags, nil
 }
@@ -2,6 +2,8 @@ package multisig
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/iov-one/weave"
@@ -10,6 +12,7 @@ import (
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/x"
+	"github.com/tendermint/tendermint/libs/common"
 )
 
 func TestDecorator(t *testing.T) {
@@ -58,6 +61,38 @@ func TestDecorator(t *testing.T) {
 		AdminThreshold:      2,
 	})
 
+	// contractID4 requires activation of contractID3, which can be
+	// activated by contractID2.
+	contractID4 := createContract(t, db, Contract{
+		Metadata: &weave.Metadata{Schema: 1},
+		Participants: []*Participant{
+			{Weight: 1, Signature: MultiSigCondition(contractID3).Address()},
+		},
+		ActivationThreshold: 1,
+		AdminThreshold:      1,
+	})
+
+	// contractID5 requires a different weight depending on the message.
+	contractID5 := createContract(t, db, Contract{
+		Metadata: &weave.Metadata{Schema: 1},
+		Participants: []*Participant{
+			{Weight: 1, Signature: a.Address()},
+			{Weight: 1, Signature: b.Address()},
+			{Weight: 1, Signature: c.Address()},
+		},
+		ActivationThreshold: 2,
+		AdminThreshold:      3,
+		MessageThresholds: []MessageThreshold{
+			{MsgPath: "test/admin", Threshold: 3},
+			{MsgPath: "test/cheap", Threshold: 1},
+		},
+	})
+	pathTx := func(msg weave.Msg, multisig ...[]byte) ContractTx {
+		return ContractTx{Tx: &weavetest.Tx{Msg: msg}, MultisigID: multisig}
+	}
+	adminMsg := &weavetest.Msg{RoutePath: "test/admin"}
+	cheapMsg := &weavetest.Msg{RoutePath: "test/cheap"}
+
 	multisigTx := func(payload []byte, multisig ...[]byte) ContractTx {
 		tx := &weavetest.Tx{Msg: &weavetest.Msg{Serialized: payload}}
 		return ContractTx{Tx: tx, MultisigID: multisig}
@@ -100,6 +135,45 @@ func TestDecorator(t *testing.T) {
 			perms:   []weave.Condition{MultiSigCondition(contractID2), MultiSigCondition(contractID3)},
 			wantGas: multisigParticipantGasCost * 3,
 		},
+		"contractID3 is activated by contractID2 listed after it": {
+			tx:      multisigTx([]byte("foo"), contractID3, contractID2),
+			signers: []weave.Condition{d, e},
+			perms:   []weave.Condition{MultiSigCondition(contractID2), MultiSigCondition(contractID3)},
+			wantGas: multisigParticipantGasCost * 3,
+		},
+		"contractID4 is activated through two levels of nesting": {
+			tx:      multisigTx([]byte("foo"), contractID4, contractID3, contractID2),
+			signers: []weave.Condition{d, e},
+			perms:   []weave.Condition{MultiSigCondition(contractID2), MultiSigCondition(contractID3), MultiSigCondition(contractID4)},
+			wantGas: multisigParticipantGasCost * 4,
+		},
+		"contractID4 is not activated without contractID2": {
+			tx:      multisigTx([]byte("foo"), contractID4, contractID3),
+			signers: []weave.Condition{d, e},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"message threshold is used instead of the activation threshold": {
+			tx:      pathTx(cheapMsg, contractID5),
+			signers: []weave.Condition{a},
+			perms:   []weave.Condition{MultiSigCondition(contractID5)},
+			wantGas: multisigParticipantGasCost * 1,
+		},
+		"message threshold higher than the activation threshold": {
+			tx:      pathTx(adminMsg, contractID5),
+			signers: []weave.Condition{a, b},
+			wantErr: errors.ErrUnauthorized,
+		},
+		"message threshold higher than the activation threshold is reached": {
+			tx:      pathTx(adminMsg, contractID5),
+			signers: []weave.Condition{a, b, c},
+			perms:   []weave.Condition{MultiSigCondition(contractID5)},
+			wantGas: multisigParticipantGasCost * 3,
+		},
+		"batch requires the highest threshold of its messages": {
+			tx:      pathTx(&batchMsg{msgs: []weave.Msg{cheapMsg, adminMsg}}, contractID5),
+			signers: []weave.Condition{a, b},
+			wantErr: errors.ErrUnauthorized,
+		},
 		"contractID3 is activated by a": {
 			tx:      multisigTx([]byte("foo"), contractID3),
 			signers: []weave.Condition{a},
@@ -140,6 +214,48 @@ func TestDecorator(t *testing.T) {
 	}
 }
 
+func TestDecoratorTags(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "multisig")
+
+	a := weavetest.NewCondition()
+	b := weavetest.NewCondition()
+	c := weavetest.NewCondition()
+
+	contractID := createContract(t, db, Contract{
+		Metadata: &weave.Metadata{Schema: 1},
+		Participants: []*Participant{
+			{Weight: 1, Signature: a.Address()},
+			{Weight: 1, Signature: b.Address()},
+			{Weight: 1, Signature: c.Address()},
+		},
+		ActivationThreshold: 2,
+		AdminThreshold:      3,
+	})
+
+	auth := &weavetest.CtxAuth{Key: "authKey"}
+	ctx := auth.SetConditions(context.Background(), a, c)
+	d := NewDecorator(x.ChainAuth(auth, Authenticate{}))
+	var hn MultisigCheckHandler
+	stack := weavetest.Decorate(&hn, d)
+
+	tx := ContractTx{Tx: &weavetest.Tx{}, MultisigID: [][]byte{contractID}}
+	res, err := stack.Deliver(ctx, db, tx)
+	if err != nil {
+		t.Fatalf("cannot deliver: %s", err)
+	}
+
+	id := []byte(fmt.Sprintf("%X", contractID))
+	wantTags := []common.KVPair{
+		{Key: []byte("multisig.authorize"), Value: id},
+		{Key: []byte("multisig.authorize." + a.Address().String()), Value: id},
+		{Key: []byte("multisig.authorize." + c.Address().String()), Value: id},
+	}
+	if !reflect.DeepEqual(wantTags, res.Tags) {
+		t.Fatalf("unexpected tags: %v", res.Tags)
+	}
+}
+
 // MultisigCheckHandler stores the seen permissions on each call
 // for this extension's authenticator (ie. multisig.Authenticate)
 type MultisigCheckHandler struct {
@@ -158,6 +274,16 @@ func (s *MultisigCheckHandler) Deliver(ctx weave.Context, store weave.KVStore, t
 	return &weave.DeliverResult{}, nil
 }
 
+// batchMsg fulfills the batch.Msg interface.
+type batchMsg struct {
+	weavetest.Msg
+	msgs []weave.Msg
+}
+
+func (m *batchMsg) MsgList() ([]weave.Msg, error) {
+	return m.msgs, nil
+}
+
 // ContractTx fulfills the MultiSigTx interface to satisfy the decorator
 type ContractTx struct {
 	weave.Tx
@@ -2,15 +2,45 @@
 > Multisignature (multi-signature) is a digital signature scheme which allows a group of users to sign a single document.
 https://en.wikipedia.org/wiki/Multisignature
 
-Thi multisig package contains a mutable contract model where multiple signatures can be stored together with a threshold
+This multisig package contains a mutable contract model where multiple signatures can be stored together with a threshold
 for activation.
 
+Each participant of a contract has a weight. Both the activation and the admin thresholds are expressed as a sum of
+weights rather than a number of signatures, so a single participant can hold more power than the others. A contract is
+activated when the weights of all signing participants add up to at least the activation threshold. The admin threshold
+must be reached to update the contract.
+
 A `Decorator` is designed as middleware to load and validate the signatures of a transaction for a given contract ID.
 When the threshold is reached a `MultiSigCondition` is stored into the request context.
+A contract can define message thresholds that replace the activation threshold for messages with a given path, for
+example to require more weight to administrate other entities than to pay invoices. A batch of messages requires the
+highest threshold of all its messages.
 This condition can be resolved to an address by the multisig `Authenticator` when authenticating the request in a handler.
 
+The address of a multisig contract can be a participant of another contract. This allows to build hierarchical
+structures, for example a company contract with a participant for each department contract. All contracts required for
+activation must be listed by the transaction, but the order does not matter. The decorator activates contracts in rounds
+until no more contracts can be activated.
+
 An `Initializer` can be instrumented to define multisig contracts in the Genesis file and load them on startup.
 The transaction `Handlers` provide functionality for persistent updates and new contracts.
 
+Instead of collecting signatures off-chain, participants can store a proposal on chain. A `CreateProposalMsg` contains a
+serialized message that is executed using the authority of the contract. Participants that sign the transaction approve
+the proposal. Other participants approve it over time with `ApproveProposalMsg`. Once the weight of all approvals reaches
+the activation threshold, the message is decoded and processed by the application provided `Executor`.
+
+A contract can define an update delay to protect against a compromised admin key. An update of such contract does not
+take effect immediately. It is stored as pending and applied by the cron once the delay has passed. Until then, any
+participant of the contract can cancel the update by submitting a `VetoUpdateMsg`.
+
+A contract can define a proposal and an approval time to live. A proposal that was not executed before its expiration
+time cannot be approved anymore and is removed by the cron with `ExpireProposalMsg`. An expired approval is no longer
+counted, so that the participant must approve the proposal again.
+
+Contract creation, updates, proposals and authorizations are tagged so that monitoring systems can alert participants
+about pending and executed actions. Each action is tagged with the contract ID under the "multisig.<action>" key and
+under the "multisig.<action>.<address>" key of every participant involved.
+
 */
 package multisig
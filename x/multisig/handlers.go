@@ -9,17 +9,36 @@ import (
 )
 
 // RegisterRoutes will instantiate and register
-// all handlers in this package
-func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
+// all handlers in this package. Decoder and executor are used to process
+// messages of proposals once they are approved. Scheduler is used to apply
+// delayed contract updates and to expire proposals. If nil, pending updates
+// must be applied by submitting ApplyUpdateMsg and expired proposals must be
+// removed by submitting ExpireProposalMsg.
+func RegisterRoutes(r weave.Registry, auth x.Authenticator, decoder OptionDecoder, executor Executor, scheduler weave.Scheduler) {
 	r = migration.SchemaMigratingRegistry("multisig", r)
 	bucket := NewContractBucket()
 	r.Handle(&CreateMsg{}, CreateMsgHandler{auth, bucket})
-	r.Handle(&UpdateMsg{}, UpdateMsgHandler{auth, bucket})
+	r.Handle(&UpdateMsg{}, UpdateMsgHandler{auth, bucket, scheduler})
+	r.Handle(&ApplyUpdateMsg{}, ApplyUpdateHandler{bucket})
+	r.Handle(&VetoUpdateMsg{}, VetoUpdateHandler{auth, bucket, scheduler})
+	r.Handle(&CreateProposalMsg{}, newCreateProposalHandler(auth, decoder, executor, scheduler))
+	r.Handle(&ApproveProposalMsg{}, newApproveProposalHandler(auth, decoder, executor))
+	r.Handle(&ExpireProposalMsg{}, ExpireProposalHandler{NewProposalBucket()})
 }
 
-// RegisterQuery register queries from buckets in this package
+// RegisterCronRoutes will instantiate and register all handlers for
+// messages that are scheduled to be executed by the cron.
+func RegisterCronRoutes(r weave.Registry) {
+	r = migration.SchemaMigratingRegistry("multisig", r)
+	r.Handle(&ApplyUpdateMsg{}, ApplyUpdateHandler{NewContractBucket()})
+	r.Handle(&ExpireProposalMsg{}, ExpireProposalHandler{NewProposalBucket()})
+}
+
+// RegisterQuery register queries from buckets in this package. Contracts
+// can be listed by a participant address using "/contracts/participant".
 func RegisterQuery(qr weave.QueryRouter) {
 	NewContractBucket().Register("contracts", qr)
+	NewProposalBucket().Register("msproposals", qr)
 }
 
 type CreateMsgHandler struct {
@@ -35,7 +54,7 @@ func (h CreateMsgHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx
 		return nil, err
 	}
 
-	return &weave.CheckResult{GasAllocated: creationCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h CreateMsgHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
@@ -55,12 +74,17 @@ func (h CreateMsgHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.
 		ActivationThreshold: msg.ActivationThreshold,
 		AdminThreshold:      msg.AdminThreshold,
 		Address:             MultiSigCondition(key).Address(),
+		UpdateDelay:         msg.UpdateDelay,
+		MessageThresholds:   msg.MessageThresholds,
+		ProposalTTL:         msg.ProposalTTL,
+		ApprovalTTL:         msg.ApprovalTTL,
 	}
 
 	if _, err = h.bucket.Put(db, key, contract); err != nil {
 		return nil, errors.Wrap(err, "cannot save contract")
 	}
-	return &weave.DeliverResult{Data: key}, nil
+	tags := actionTags(CreateAction, key, participantAddresses(contract.Participants))
+	return &weave.DeliverResult{Data: key, Tags: tags}, nil
 }
 
 // validate does all common pre-processing between Check and Deliver.
@@ -80,45 +104,81 @@ func (h CreateMsgHandler) validate(ctx weave.Context, db weave.KVStore, tx weave
 }
 
 type UpdateMsgHandler struct {
-	auth   x.Authenticator
-	bucket orm.ModelBucket
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	scheduler weave.Scheduler
 }
 
-var _ weave.Handler = CreateMsgHandler{}
+var _ weave.Handler = UpdateMsgHandler{}
 
 func (h UpdateMsgHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	_, err := h.validate(ctx, db, tx)
+	_, _, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: updateCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
+// Deliver updates the contract. If the contract defines an update delay, the
+// update is stored as pending and applied once the delay has passed.
 func (h UpdateMsgHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	msg, err := h.validate(ctx, db, tx)
+	msg, contract, err := h.validate(ctx, db, tx)
 	if err != nil {
 		return nil, err
 	}
 
-	contract := &Contract{
-		Metadata:            &weave.Metadata{Schema: 1},
+	if contract.UpdateDelay == 0 {
+		tags := actionTags(UpdateAction, msg.ContractID, participantAddresses(contract.Participants, msg.Participants))
+		contract.Participants = msg.Participants
+		contract.ActivationThreshold = msg.ActivationThreshold
+		contract.AdminThreshold = msg.AdminThreshold
+		contract.UpdateDelay = msg.UpdateDelay
+		contract.MessageThresholds = msg.MessageThresholds
+		contract.ProposalTTL = msg.ProposalTTL
+		contract.ApprovalTTL = msg.ApprovalTTL
+		if _, err := h.bucket.Put(db, msg.ContractID, contract); err != nil {
+			return nil, errors.Wrap(err, "cannot update contract")
+		}
+		return &weave.DeliverResult{Tags: tags}, nil
+	}
+
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+	contract.PendingUpdate = &PendingUpdate{
 		Participants:        msg.Participants,
 		ActivationThreshold: msg.ActivationThreshold,
 		AdminThreshold:      msg.AdminThreshold,
-		Address:             MultiSigCondition(msg.ContractID).Address(),
+		UpdateDelay:         msg.UpdateDelay,
+		MessageThresholds:   msg.MessageThresholds,
+		ProposalTTL:         msg.ProposalTTL,
+		ApprovalTTL:         msg.ApprovalTTL,
+		ApplyAt:             weave.AsUnixTime(now.Add(contract.UpdateDelay.Duration())),
+	}
+	if h.scheduler != nil {
+		applyMsg := &ApplyUpdateMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ContractID: msg.ContractID,
+		}
+		// Applying an update does not require any signature.
+		taskID, err := h.scheduler.Schedule(db, contract.PendingUpdate.ApplyAt.Time(), nil, applyMsg)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot schedule update task")
+		}
+		contract.PendingUpdate.TaskID = taskID
 	}
-
 	if _, err := h.bucket.Put(db, msg.ContractID, contract); err != nil {
 		return nil, errors.Wrap(err, "cannot update contract")
 	}
-
-	return &weave.DeliverResult{}, nil
+	tags := actionTags(PendingUpdateAction, msg.ContractID, participantAddresses(contract.Participants, msg.Participants))
+	return &weave.DeliverResult{Tags: tags}, nil
 }
 
-func (h UpdateMsgHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UpdateMsg, error) {
+func (h UpdateMsgHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*UpdateMsg, *Contract, error) {
 	var msg UpdateMsg
 	if err := weave.LoadMsg(tx, &msg); err != nil {
-		return nil, errors.Wrap(err, "load msg")
+		return nil, nil, errors.Wrap(err, "load msg")
 	}
 
 	// Using current version of the contract, ensure that enoguht
@@ -126,7 +186,7 @@ func (h UpdateMsgHandler) validate(ctx weave.Context, db weave.KVStore, tx weave
 	// order to run functionality that requires admin rights.
 	var contract Contract
 	if err := h.bucket.One(db, msg.ContractID, &contract); err != nil {
-		return nil, errors.Wrap(err, "cannot load contract from the store")
+		return nil, nil, errors.Wrap(err, "cannot load contract from the store")
 	}
 	var weight Weight
 	for _, p := range contract.Participants {
@@ -135,8 +195,428 @@ func (h UpdateMsgHandler) validate(ctx weave.Context, db weave.KVStore, tx weave
 		}
 	}
 	if weight < contract.AdminThreshold {
-		return &msg, errors.Wrapf(errors.ErrUnauthorized,
+		return &msg, nil, errors.Wrapf(errors.ErrUnauthorized,
 			"%d weight is not enough to administrate %q", weight, msg.ContractID)
 	}
+	if contract.PendingUpdate != nil {
+		return nil, nil, errors.Wrap(errors.ErrState, "contract update already pending")
+	}
+	return &msg, &contract, nil
+}
+
+// ApplyUpdateHandler applies the pending update of a contract once the
+// update delay has passed.
+type ApplyUpdateHandler struct {
+	bucket orm.ModelBucket
+}
+
+var _ weave.Handler = ApplyUpdateHandler{}
+
+func (h ApplyUpdateHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h ApplyUpdateHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, contract, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	u := contract.PendingUpdate
+	tags := actionTags(UpdateAction, msg.ContractID, participantAddresses(contract.Participants, u.Participants))
+	contract.Participants = u.Participants
+	contract.ActivationThreshold = u.ActivationThreshold
+	contract.AdminThreshold = u.AdminThreshold
+	contract.UpdateDelay = u.UpdateDelay
+	contract.MessageThresholds = u.MessageThresholds
+	contract.ProposalTTL = u.ProposalTTL
+	contract.ApprovalTTL = u.ApprovalTTL
+	contract.PendingUpdate = nil
+	if _, err := h.bucket.Put(db, msg.ContractID, contract); err != nil {
+		return nil, errors.Wrap(err, "cannot update contract")
+	}
+	return &weave.DeliverResult{Tags: tags}, nil
+}
+
+func (h ApplyUpdateHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ApplyUpdateMsg, *Contract, error) {
+	var msg ApplyUpdateMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var contract Contract
+	if err := h.bucket.One(db, msg.ContractID, &contract); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load contract from the store")
+	}
+	if contract.PendingUpdate == nil {
+		return nil, nil, errors.Wrap(errors.ErrState, "no pending update")
+	}
+	if weave.InTheFuture(ctx, contract.PendingUpdate.ApplyAt.Time()) {
+		return nil, nil, errors.Wrap(errors.ErrState, "update delay not passed")
+	}
+	return &msg, &contract, nil
+}
+
+// VetoUpdateHandler cancels the pending update of a contract. Any participant
+// can veto an update, regardless of the weight.
+type VetoUpdateHandler struct {
+	auth      x.Authenticator
+	bucket    orm.ModelBucket
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = VetoUpdateHandler{}
+
+func (h VetoUpdateHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h VetoUpdateHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, contract, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if h.scheduler != nil && len(contract.PendingUpdate.TaskID) != 0 {
+		switch err := h.scheduler.Delete(db, contract.PendingUpdate.TaskID); {
+		case err == nil:
+			// All good.
+		case errors.ErrNotFound.Is(err):
+			// The task was already processed but applying the
+			// update failed.
+		default:
+			return nil, errors.Wrap(err, "cannot delete update task")
+		}
+	}
+	contract.PendingUpdate = nil
+	if _, err := h.bucket.Put(db, msg.ContractID, contract); err != nil {
+		return nil, errors.Wrap(err, "cannot update contract")
+	}
+	tags := actionTags(VetoUpdateAction, msg.ContractID, participantAddresses(contract.Participants))
+	return &weave.DeliverResult{Tags: tags}, nil
+}
+
+func (h VetoUpdateHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*VetoUpdateMsg, *Contract, error) {
+	var msg VetoUpdateMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var contract Contract
+	if err := h.bucket.One(db, msg.ContractID, &contract); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load contract from the store")
+	}
+	if contract.PendingUpdate == nil {
+		return nil, nil, errors.Wrap(errors.ErrState, "no pending update")
+	}
+	var signed bool
+	for _, p := range contract.Participants {
+		if h.auth.HasAddress(ctx, p.Signature) {
+			signed = true
+			break
+		}
+	}
+	if !signed {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "participant signature required")
+	}
+	return &msg, &contract, nil
+}
+
+type CreateProposalHandler struct {
+	auth      x.Authenticator
+	contracts orm.ModelBucket
+	proposals orm.ModelBucket
+	decoder   OptionDecoder
+	executor  Executor
+	scheduler weave.Scheduler
+}
+
+var _ weave.Handler = CreateProposalHandler{}
+
+func newCreateProposalHandler(auth x.Authenticator, decoder OptionDecoder, executor Executor, scheduler weave.Scheduler) CreateProposalHandler {
+	return CreateProposalHandler{
+		auth:      auth,
+		contracts: NewContractBucket(),
+		proposals: NewProposalBucket(),
+		decoder:   decoder,
+		executor:  executor,
+		scheduler: scheduler,
+	}
+}
+
+func (h CreateProposalHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h CreateProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	proposal, contract, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := proposalSeq.NextVal(db)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot acquire ID")
+	}
+	res, err := executeApproved(ctx, db, h.decoder, h.executor, contract, proposal)
+	if err != nil {
+		return nil, err
+	}
+	if !proposal.Executed && proposal.ExpiresAt != 0 && h.scheduler != nil {
+		expireMsg := &ExpireProposalMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ProposalID: key,
+		}
+		// Expiring a proposal does not require any signature.
+		taskID, err := h.scheduler.Schedule(db, proposal.ExpiresAt.Time(), nil, expireMsg)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot schedule expiration task")
+		}
+		proposal.ExpirationTaskID = taskID
+	}
+	if _, err := h.proposals.Put(db, key, proposal); err != nil {
+		return nil, errors.Wrap(err, "cannot save proposal")
+	}
+	res.Data = key
+	res.Tags = append(res.Tags, actionTags(ProposeAction, proposal.ContractID, participantAddresses(contract.Participants))...)
+	return res, nil
+}
+
+// validate returns a new proposal, approved by all participants that signed
+// the transaction, together with its contract.
+func (h CreateProposalHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*Proposal, *Contract, error) {
+	var msg CreateProposalMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+	var contract Contract
+	if err := h.contracts.One(db, msg.ContractID, &contract); err != nil {
+		return nil, nil, errors.Wrap(err, "cannot load contract from the store")
+	}
+	opt, err := h.decoder(msg.RawOption)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "raw option")
+	}
+	if err := opt.Validate(); err != nil {
+		return nil, nil, errors.Wrap(err, "raw option")
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "block time")
+	}
+	proposal := &Proposal{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ContractID: msg.ContractID,
+		RawOption:  msg.RawOption,
+	}
+	if contract.ProposalTTL != 0 {
+		proposal.ExpiresAt = weave.AsUnixTime(now.Add(contract.ProposalTTL.Duration()))
+	}
+	if err := approve(ctx, h.auth, &contract, proposal, weave.AsUnixTime(now)); err != nil {
+		return nil, nil, err
+	}
+	return proposal, &contract, nil
+}
+
+type ApproveProposalHandler struct {
+	auth      x.Authenticator
+	contracts orm.ModelBucket
+	proposals orm.ModelBucket
+	decoder   OptionDecoder
+	executor  Executor
+}
+
+var _ weave.Handler = ApproveProposalHandler{}
+
+func newApproveProposalHandler(auth x.Authenticator, decoder OptionDecoder, executor Executor) ApproveProposalHandler {
+	return ApproveProposalHandler{
+		auth:      auth,
+		contracts: NewContractBucket(),
+		proposals: NewProposalBucket(),
+		decoder:   decoder,
+		executor:  executor,
+	}
+}
+
+func (h ApproveProposalHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h ApproveProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, proposal, contract, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := executeApproved(ctx, db, h.decoder, h.executor, contract, proposal)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := h.proposals.Put(db, msg.ProposalID, proposal); err != nil {
+		return nil, errors.Wrap(err, "cannot save proposal")
+	}
+	return res, nil
+}
+
+// validate loads the proposal together with its contract and adds approvals
+// of all participants that signed the transaction.
+func (h ApproveProposalHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ApproveProposalMsg, *Proposal, *Contract, error) {
+	var msg ApproveProposalMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "load msg")
+	}
+	var proposal Proposal
+	if err := h.proposals.One(db, msg.ProposalID, &proposal); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load proposal from the store")
+	}
+	if proposal.Executed {
+		return nil, nil, nil, errors.Wrap(errors.ErrState, "proposal already executed")
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, nil, nil, errors.Wrap(err, "block time")
+	}
+	if proposal.IsExpired(weave.AsUnixTime(now)) {
+		return nil, nil, nil, errors.Wrap(errors.ErrExpired, "proposal expired")
+	}
+	var contract Contract
+	if err := h.contracts.One(db, proposal.ContractID, &contract); err != nil {
+		return nil, nil, nil, errors.Wrap(err, "cannot load contract from the store")
+	}
+	// Expired approvals are removed so that a participant must approve
+	// again in order for the approval to count.
+	proposal.DropExpiredApprovals(contract.ApprovalTTL, weave.AsUnixTime(now))
+	if err := approve(ctx, h.auth, &contract, &proposal, weave.AsUnixTime(now)); err != nil {
+		return nil, nil, nil, err
+	}
+	return &msg, &proposal, &contract, nil
+}
+
+// approve adds all participants of the contract that signed the transaction
+// to the proposal approvals. It fails if no new approval was given. Expired
+// approvals are renewed.
+func approve(ctx weave.Context, auth x.Authenticator, contract *Contract, proposal *Proposal, now weave.UnixTime) error {
+	var signed, approved int
+	for _, p := range contract.Participants {
+		if !auth.HasAddress(ctx, p.Signature) {
+			continue
+		}
+		signed++
+		if proposal.HasApproval(p.Signature, contract.ApprovalTTL, now) {
+			continue
+		}
+		proposal.Approve(p.Signature, now)
+		approved++
+	}
+	switch {
+	case signed == 0:
+		return errors.Wrap(errors.ErrUnauthorized, "participant signature required")
+	case approved == 0:
+		return errors.Wrap(errors.ErrDuplicate, "proposal already approved")
+	}
+	return nil
+}
+
+// executeApproved executes the proposal message if the weight of approvals
+// reached the weight required by the contract to authorize that message.
+// Message is executed with the authority of the contract. Failed execution
+// results in an error, so that the approval is not stored and can be
+// repeated.
+func executeApproved(
+	ctx weave.Context,
+	db weave.KVStore,
+	decoder OptionDecoder,
+	executor Executor,
+	contract *Contract,
+	proposal *Proposal,
+) (*weave.DeliverResult, error) {
+	opt, err := decoder(proposal.RawOption)
+	if err != nil {
+		return nil, errors.Wrap(err, "raw option")
+	}
+	if err := opt.Validate(); err != nil {
+		return nil, errors.Wrap(err, "raw option")
+	}
+	required, err := contract.RequiredWeight(opt)
+	if err != nil {
+		return nil, err
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+	if proposal.ApprovedWeight(contract, weave.AsUnixTime(now)) < required {
+		return &weave.DeliverResult{}, nil
+	}
+	res, err := executor(withProposalMultisig(ctx, proposal.ContractID), db, opt)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot execute proposal")
+	}
+	if res == nil {
+		res = &weave.DeliverResult{}
+	}
+	var approvers []weave.Address
+	for _, p := range contract.Participants {
+		if proposal.HasApproval(p.Signature, contract.ApprovalTTL, weave.AsUnixTime(now)) {
+			approvers = append(approvers, p.Signature)
+		}
+	}
+	res.Tags = append(res.Tags, actionTags(AuthorizeAction, proposal.ContractID, approvers)...)
+	proposal.Executed = true
+	return res, nil
+}
+
+// ExpireProposalHandler removes a proposal that was not executed before its
+// expiration time.
+type ExpireProposalHandler struct {
+	bucket orm.ModelBucket
+}
+
+var _ weave.Handler = ExpireProposalHandler{}
+
+func (h ExpireProposalHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h ExpireProposalHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.bucket.Delete(db, msg.ProposalID); err != nil {
+		return nil, errors.Wrap(err, "cannot delete proposal")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h ExpireProposalHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*ExpireProposalMsg, error) {
+	var msg ExpireProposalMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	var proposal Proposal
+	if err := h.bucket.One(db, msg.ProposalID, &proposal); err != nil {
+		return nil, errors.Wrap(err, "cannot load proposal from the store")
+	}
+	if proposal.Executed {
+		return nil, errors.Wrap(errors.ErrState, "proposal already executed")
+	}
+	now, err := weave.BlockTime(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "block time")
+	}
+	if !proposal.IsExpired(weave.AsUnixTime(now)) {
+		return nil, errors.Wrap(errors.ErrState, "proposal not expired")
+	}
 	return &msg, nil
 }
@@ -3,6 +3,7 @@ package multisig
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
@@ -87,7 +88,7 @@ func TestCreateContractHandler(t *testing.T) {
 		Signer: weavetest.NewCondition(), // Any signer will do.
 	}
 	rt := app.NewRouter()
-	RegisterRoutes(rt, auth)
+	RegisterRoutes(rt, auth, nil, nil, nil)
 
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
@@ -250,7 +251,7 @@ func TestUpdateContractHandler(t *testing.T) {
 
 	auth := &weavetest.CtxAuth{Key: "auth"}
 	rt := app.NewRouter()
-	RegisterRoutes(rt, auth)
+	RegisterRoutes(rt, auth, nil, nil, nil)
 
 	for testName, tc := range cases {
 		t.Run(testName, func(t *testing.T) {
@@ -300,3 +301,487 @@ func TestUpdateContractHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestProposalHandlers(t *testing.T) {
+	aliceCond := weavetest.NewCondition()
+	bobbyCond := weavetest.NewCondition()
+	cindyCond := weavetest.NewCondition()
+	outsider := weavetest.NewCondition()
+
+	// Executor records every executed message, together with the
+	// information if it was authorized by the first contract.
+	var executed []string
+	contractAddr := MultiSigCondition(weavetest.SequenceID(1)).Address()
+	decoder := func(raw []byte) (weave.Msg, error) {
+		if string(raw) == "invalid" {
+			return nil, errors.Wrap(errors.ErrInput, "cannot decode")
+		}
+		return &weavetest.Msg{RoutePath: "test/proposal", Serialized: raw}, nil
+	}
+	executor := func(ctx weave.Context, db weave.KVStore, msg weave.Msg) (*weave.DeliverResult, error) {
+		if !(Authenticate{}).HasAddress(ctx, contractAddr) {
+			return nil, errors.Wrap(errors.ErrUnauthorized, "contract authority required")
+		}
+		if (Authenticate{}).HasAddress(ctx, MultiSigCondition(weavetest.SequenceID(2)).Address()) {
+			return nil, errors.Wrap(errors.ErrUnauthorized, "only proposal contract authority expected")
+		}
+		raw, _ := msg.Marshal()
+		if string(raw) == "failing" {
+			return nil, errors.Wrap(errors.ErrHuman, "execution failed")
+		}
+		executed = append(executed, string(raw))
+		return &weave.DeliverResult{}, nil
+	}
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, decoder, executor, nil)
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "multisig")
+	contract := &Contract{
+		Metadata: &weave.Metadata{Schema: 1},
+		Participants: []*Participant{
+			{Weight: 1, Signature: aliceCond.Address()},
+			{Weight: 2, Signature: bobbyCond.Address()},
+			{Weight: 3, Signature: cindyCond.Address()},
+		},
+		ActivationThreshold: 4,
+		AdminThreshold:      6,
+		Address:             contractAddr,
+	}
+	_, err := NewContractBucket().Put(db, nil, contract)
+	assert.Nil(t, err)
+
+	steps := []struct {
+		conditions []weave.Condition
+		msg        weave.Msg
+		wantErr    *errors.Error
+		wantExec   []string
+	}{
+		{
+			conditions: []weave.Condition{outsider},
+			msg: &CreateProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ContractID: weavetest.SequenceID(1),
+				RawOption:  []byte("first"),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		{
+			conditions: []weave.Condition{aliceCond},
+			msg: &CreateProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ContractID: weavetest.SequenceID(1),
+				RawOption:  []byte("invalid"),
+			},
+			wantErr: errors.ErrInput,
+		},
+		{
+			conditions: []weave.Condition{aliceCond},
+			msg: &CreateProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ContractID: weavetest.SequenceID(1),
+				RawOption:  []byte("first"),
+			},
+		},
+		{
+			conditions: []weave.Condition{aliceCond},
+			msg: &ApproveProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: weavetest.SequenceID(1),
+			},
+			wantErr: errors.ErrDuplicate,
+		},
+		{
+			conditions: []weave.Condition{outsider},
+			msg: &ApproveProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: weavetest.SequenceID(1),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		{
+			conditions: []weave.Condition{bobbyCond},
+			msg: &ApproveProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: weavetest.SequenceID(1),
+			},
+		},
+		{
+			// Total weight of approvals reaches the activation
+			// threshold. Proposal must be executed.
+			conditions: []weave.Condition{cindyCond},
+			msg: &ApproveProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: weavetest.SequenceID(1),
+			},
+			wantExec: []string{"first"},
+		},
+		{
+			conditions: []weave.Condition{aliceCond},
+			msg: &ApproveProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: weavetest.SequenceID(1),
+			},
+			wantErr:  errors.ErrState,
+			wantExec: []string{"first"},
+		},
+		{
+			// Signatures with enough weight execute the proposal
+			// right away.
+			conditions: []weave.Condition{bobbyCond, cindyCond},
+			msg: &CreateProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ContractID: weavetest.SequenceID(1),
+				RawOption:  []byte("second"),
+			},
+			wantExec: []string{"first", "second"},
+		},
+		{
+			conditions: []weave.Condition{cindyCond},
+			msg: &CreateProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ContractID: weavetest.SequenceID(1),
+				RawOption:  []byte("failing"),
+			},
+			wantExec: []string{"first", "second"},
+		},
+		{
+			// Failed execution fails the approval.
+			conditions: []weave.Condition{aliceCond},
+			msg: &ApproveProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ProposalID: weavetest.SequenceID(3),
+			},
+			wantErr:  errors.ErrHuman,
+			wantExec: []string{"first", "second"},
+		},
+	}
+
+	for i, step := range steps {
+		ctx := weave.WithBlockTime(context.Background(), time.Now())
+		ctx = auth.SetConditions(ctx, step.conditions...)
+		// Executed message must be authorized only by the
+		// proposal contract.
+		ctx = withMultisig(ctx, weavetest.SequenceID(2))
+		tx := &weavetest.Tx{Msg: step.msg}
+
+		cache := db.CacheWrap()
+		if _, err := rt.Deliver(ctx, cache, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected error: %+v", i, err)
+		}
+		if step.wantErr == nil {
+			assert.Nil(t, cache.Write())
+		}
+		cache.Discard()
+
+		assert.Equal(t, step.wantExec, executed)
+	}
+
+	var proposal Proposal
+	assert.Nil(t, NewProposalBucket().One(db, weavetest.SequenceID(1), &proposal))
+	if !proposal.Executed {
+		t.Fatal("proposal not marked as executed")
+	}
+	assert.Nil(t, NewProposalBucket().One(db, weavetest.SequenceID(3), &proposal))
+	if proposal.Executed || len(proposal.Approvals) != 1 {
+		t.Fatalf("unexpected failed proposal state: %+v", proposal)
+	}
+}
+
+func TestExpiringProposals(t *testing.T) {
+	aliceCond := weavetest.NewCondition()
+	bobbyCond := weavetest.NewCondition()
+
+	var executed []string
+	decoder := func(raw []byte) (weave.Msg, error) {
+		return &weavetest.Msg{RoutePath: "test/proposal", Serialized: raw}, nil
+	}
+	executor := func(ctx weave.Context, db weave.KVStore, msg weave.Msg) (*weave.DeliverResult, error) {
+		raw, _ := msg.Marshal()
+		executed = append(executed, string(raw))
+		return &weave.DeliverResult{}, nil
+	}
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	cron := &weavetest.Cron{}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, decoder, executor, cron)
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "multisig")
+	_, err := NewContractBucket().Put(db, nil, &Contract{
+		Metadata: &weave.Metadata{Schema: 1},
+		Participants: []*Participant{
+			{Weight: 1, Signature: aliceCond.Address()},
+			{Weight: 2, Signature: bobbyCond.Address()},
+		},
+		ActivationThreshold: 3,
+		AdminThreshold:      3,
+		Address:             MultiSigCondition(weavetest.SequenceID(1)).Address(),
+		ProposalTTL:         weave.AsUnixDuration(2 * time.Hour),
+		ApprovalTTL:         weave.AsUnixDuration(time.Hour),
+	})
+	assert.Nil(t, err)
+
+	now := time.Now().UTC()
+	approve := func(proposalID uint64) weave.Msg {
+		return &ApproveProposalMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ProposalID: weavetest.SequenceID(proposalID),
+		}
+	}
+	expire := func(proposalID uint64) weave.Msg {
+		return &ExpireProposalMsg{
+			Metadata:   &weave.Metadata{Schema: 1},
+			ProposalID: weavetest.SequenceID(proposalID),
+		}
+	}
+
+	steps := []struct {
+		conditions []weave.Condition
+		blockTime  time.Time
+		msg        weave.Msg
+		wantErr    *errors.Error
+		wantExec   []string
+	}{
+		{
+			conditions: []weave.Condition{aliceCond},
+			blockTime:  now,
+			msg: &CreateProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ContractID: weavetest.SequenceID(1),
+				RawOption:  []byte("first"),
+			},
+		},
+		{
+			// Approval of alice expired and is not counted.
+			conditions: []weave.Condition{bobbyCond},
+			blockTime:  now.Add(90 * time.Minute),
+			msg:        approve(1),
+		},
+		{
+			// Expired approval can be given again.
+			conditions: []weave.Condition{aliceCond},
+			blockTime:  now.Add(100 * time.Minute),
+			msg:        approve(1),
+			wantExec:   []string{"first"},
+		},
+		{
+			// Executed proposal is never expired.
+			blockTime: now.Add(3 * time.Hour),
+			msg:       expire(1),
+			wantErr:   errors.ErrState,
+			wantExec:  []string{"first"},
+		},
+		{
+			conditions: []weave.Condition{aliceCond},
+			blockTime:  now.Add(3 * time.Hour),
+			msg: &CreateProposalMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ContractID: weavetest.SequenceID(1),
+				RawOption:  []byte("second"),
+			},
+			wantExec: []string{"first"},
+		},
+		{
+			blockTime: now.Add(4 * time.Hour),
+			msg:       expire(2),
+			wantErr:   errors.ErrState,
+			wantExec:  []string{"first"},
+		},
+		{
+			conditions: []weave.Condition{bobbyCond},
+			blockTime:  now.Add(5 * time.Hour),
+			msg:        approve(2),
+			wantErr:    errors.ErrExpired,
+			wantExec:   []string{"first"},
+		},
+		{
+			blockTime: now.Add(5 * time.Hour),
+			msg:       expire(2),
+			wantExec:  []string{"first"},
+		},
+		{
+			blockTime: now.Add(5 * time.Hour),
+			msg:       expire(2),
+			wantErr:   errors.ErrNotFound,
+			wantExec:  []string{"first"},
+		},
+	}
+
+	for i, step := range steps {
+		ctx := weave.WithBlockTime(context.Background(), step.blockTime)
+		ctx = auth.SetConditions(ctx, step.conditions...)
+		tx := &weavetest.Tx{Msg: step.msg}
+
+		cache := db.CacheWrap()
+		if _, err := rt.Deliver(ctx, cache, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected error: %+v", i, err)
+		}
+		if step.wantErr == nil {
+			assert.Nil(t, cache.Write())
+		}
+		cache.Discard()
+
+		assert.Equal(t, step.wantExec, executed)
+	}
+
+	var proposal Proposal
+	assert.Nil(t, NewProposalBucket().One(db, weavetest.SequenceID(1), &proposal))
+	if !proposal.Executed || len(proposal.ExpirationTaskID) == 0 {
+		t.Fatalf("unexpected executed proposal state: %+v", proposal)
+	}
+}
+
+func TestDelayedContractUpdate(t *testing.T) {
+	aliceCond := weavetest.NewCondition()
+	bobbyCond := weavetest.NewCondition()
+	cindyCond := weavetest.NewCondition()
+	outsider := weavetest.NewCondition()
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	cron := &weavetest.Cron{}
+	rt := app.NewRouter()
+	RegisterRoutes(rt, auth, nil, nil, cron)
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "multisig")
+	_, err := NewContractBucket().Put(db, nil, &Contract{
+		Metadata: &weave.Metadata{Schema: 1},
+		Participants: []*Participant{
+			{Weight: 1, Signature: aliceCond.Address()},
+			{Weight: 2, Signature: bobbyCond.Address()},
+			{Weight: 3, Signature: cindyCond.Address()},
+		},
+		ActivationThreshold: 2,
+		AdminThreshold:      3,
+		Address:             MultiSigCondition(weavetest.SequenceID(1)).Address(),
+		UpdateDelay:         weave.AsUnixDuration(time.Hour),
+	})
+	assert.Nil(t, err)
+
+	now := time.Now().UTC()
+	update := &UpdateMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ContractID: weavetest.SequenceID(1),
+		Participants: []*Participant{
+			{Weight: 5, Signature: aliceCond.Address()},
+		},
+		ActivationThreshold: 5,
+		AdminThreshold:      5,
+	}
+	apply := &ApplyUpdateMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ContractID: weavetest.SequenceID(1),
+	}
+	veto := &VetoUpdateMsg{
+		Metadata:   &weave.Metadata{Schema: 1},
+		ContractID: weavetest.SequenceID(1),
+	}
+
+	steps := []struct {
+		conditions []weave.Condition
+		blockTime  time.Time
+		msg        weave.Msg
+		wantErr    *errors.Error
+		wantAdmin  Weight
+		wantUpdate bool
+	}{
+		{
+			conditions: []weave.Condition{cindyCond},
+			blockTime:  now,
+			msg:        update,
+			wantAdmin:  3,
+			wantUpdate: true,
+		},
+		{
+			conditions: []weave.Condition{cindyCond},
+			blockTime:  now,
+			msg:        update,
+			wantErr:    errors.ErrState,
+			wantAdmin:  3,
+			wantUpdate: true,
+		},
+		{
+			blockTime:  now.Add(30 * time.Minute),
+			msg:        apply,
+			wantErr:    errors.ErrState,
+			wantAdmin:  3,
+			wantUpdate: true,
+		},
+		{
+			conditions: []weave.Condition{outsider},
+			blockTime:  now.Add(30 * time.Minute),
+			msg:        veto,
+			wantErr:    errors.ErrUnauthorized,
+			wantAdmin:  3,
+			wantUpdate: true,
+		},
+		{
+			// Any participant can veto, regardless of the weight.
+			conditions: []weave.Condition{aliceCond},
+			blockTime:  now.Add(30 * time.Minute),
+			msg:        veto,
+			wantAdmin:  3,
+		},
+		{
+			blockTime: now.Add(2 * time.Hour),
+			msg:       apply,
+			wantErr:   errors.ErrState,
+			wantAdmin: 3,
+		},
+		{
+			conditions: []weave.Condition{cindyCond},
+			blockTime:  now.Add(2 * time.Hour),
+			msg:        update,
+			wantAdmin:  3,
+			wantUpdate: true,
+		},
+		{
+			blockTime: now.Add(3 * time.Hour),
+			msg:       apply,
+			wantAdmin: 5,
+		},
+		{
+			// Updated contract has no update delay.
+			conditions: []weave.Condition{aliceCond},
+			blockTime:  now.Add(3 * time.Hour),
+			msg: &UpdateMsg{
+				Metadata:   &weave.Metadata{Schema: 1},
+				ContractID: weavetest.SequenceID(1),
+				Participants: []*Participant{
+					{Weight: 4, Signature: aliceCond.Address()},
+				},
+				ActivationThreshold: 4,
+				AdminThreshold:      4,
+			},
+			wantAdmin: 4,
+		},
+	}
+
+	for i, step := range steps {
+		ctx := weave.WithBlockTime(context.Background(), step.blockTime)
+		ctx = auth.SetConditions(ctx, step.conditions...)
+		tx := &weavetest.Tx{Msg: step.msg}
+
+		cache := db.CacheWrap()
+		if _, err := rt.Deliver(ctx, cache, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected error: %+v", i, err)
+		}
+		if step.wantErr == nil {
+			assert.Nil(t, cache.Write())
+		}
+		cache.Discard()
+
+		var c Contract
+		assert.Nil(t, NewContractBucket().One(db, weavetest.SequenceID(1), &c))
+		if c.AdminThreshold != step.wantAdmin {
+			t.Fatalf("step %d: want admin threshold %d, got %d", i, step.wantAdmin, c.AdminThreshold)
+		}
+		if got := c.PendingUpdate != nil; got != step.wantUpdate {
+			t.Fatalf("step %d: want pending update %v, got %v", i, step.wantUpdate, got)
+		}
+	}
+}
@@ -19,8 +19,15 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			Signature weave.Address `json:"signature"`
 			Weight    Weight        `json:"weight"`
 		} `json:"participants"`
-		ActivationThreshold Weight `json:"activation_threshold"`
-		AdminThreshold      Weight `json:"admin_threshold"`
+		ActivationThreshold Weight             `json:"activation_threshold"`
+		AdminThreshold      Weight             `json:"admin_threshold"`
+		UpdateDelay         weave.UnixDuration `json:"update_delay"`
+		ProposalTTL         weave.UnixDuration `json:"proposal_ttl"`
+		ApprovalTTL         weave.UnixDuration `json:"approval_ttl"`
+		MessageThresholds   []struct {
+			MsgPath   string `json:"msg_path"`
+			Threshold Weight `json:"threshold"`
+		} `json:"message_thresholds"`
 	}
 	if err := opts.ReadOptions("multisig", &contracts); err != nil {
 		return err
@@ -35,6 +42,13 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 				Weight:    p.Weight,
 			})
 		}
+		mts := make([]MessageThreshold, 0, len(c.MessageThresholds))
+		for _, mt := range c.MessageThresholds {
+			mts = append(mts, MessageThreshold{
+				MsgPath:   mt.MsgPath,
+				Threshold: mt.Threshold,
+			})
+		}
 		key, err := contractSeq.NextVal(kv)
 		if err != nil {
 			return errors.Wrap(err, "cannot acquire ID")
@@ -45,6 +59,10 @@ func (*Initializer) FromGenesis(opts weave.Options, params weave.GenesisParams,
 			ActivationThreshold: c.ActivationThreshold,
 			AdminThreshold:      c.AdminThreshold,
 			Address:             MultiSigCondition(key).Address(),
+			UpdateDelay:         c.UpdateDelay,
+			MessageThresholds:   mts,
+			ProposalTTL:         c.ProposalTTL,
+			ApprovalTTL:         c.ApprovalTTL,
 		}
 		if _, err := bucket.Put(kv, key, &contract); err != nil {
 			return errors.Wrapf(err, "cannot save #%d contract", i)
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/migration"
@@ -25,7 +26,10 @@ func TestGenesisKey(t *testing.T) {
 						{"weight": 7, "signature": "91d66344d78599b66e1b504db958b1b07a8f5049"}
 					],
 					"activation_threshold": 2,
-					"admin_threshold": 3
+					"admin_threshold": 3,
+					"update_delay": "24h",
+					"proposal_ttl": "72h",
+					"approval_ttl": "12h"
 				}
 			]
 		}
@@ -54,6 +58,15 @@ func TestGenesisKey(t *testing.T) {
 	if want, got := Weight(3), c.AdminThreshold; want != got {
 		t.Errorf("want admin threshold %d, got %d", want, got)
 	}
+	if want, got := weave.AsUnixDuration(24*time.Hour), c.UpdateDelay; want != got {
+		t.Errorf("want update delay %s, got %s", want, got)
+	}
+	if want, got := weave.AsUnixDuration(72*time.Hour), c.ProposalTTL; want != got {
+		t.Errorf("want proposal TTL %s, got %s", want, got)
+	}
+	if want, got := weave.AsUnixDuration(12*time.Hour), c.ApprovalTTL; want != got {
+		t.Errorf("want approval TTL %s, got %s", want, got)
+	}
 	wantParticipants := []*Participant{
 		{Weight: 1, Signature: fromHex(t, "e4c7e4c71a3b301a2521753ddd1d2c26fd6fe1bf")},
 		{Weight: 2, Signature: fromHex(t, "904bc35e341b428d4faa535022b553efbc443d49")},
@@ -0,0 +1,14 @@
+package multisig
+
+import (
+	"github.com/iov-one/weave"
+)
+
+// OptionDecoder is needed to parse the raw_option data of a proposal.
+type OptionDecoder func(raw []byte) (weave.Msg, error)
+
+// Executor processes the message of a proposal once it is approved. The
+// context passed to the executor is authenticated only by the multisig
+// contract of the proposal, so the executor must use the multisig
+// Authenticate in order to recognize it.
+type Executor func(ctx weave.Context, store weave.KVStore, msg weave.Msg) (*weave.DeliverResult, error)
@@ -1,13 +1,18 @@
 package multisig
 
 import (
+	"fmt"
+
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
+	"github.com/iov-one/weave/x/batch"
 )
 
 func init() {
 	migration.MustRegister(1, &Contract{}, migration.NoModification)
+	migration.MustRegister(1, &Proposal{}, migration.NoModification)
 }
 
 const (
@@ -45,15 +50,190 @@ func (c *Contract) Validate() error {
 	}
 	errs = errors.AppendField(errs, "Address", c.Address.Validate())
 	errs = errors.Append(errs, validateWeights(errors.ErrModel, c.Participants, c.ActivationThreshold, c.AdminThreshold))
+	errs = errors.AppendField(errs, "UpdateDelay", validateUpdateDelay(c.UpdateDelay))
+	errs = errors.AppendField(errs, "MessageThresholds", validateMessageThresholds(errors.ErrModel, c.MessageThresholds))
+	errs = errors.AppendField(errs, "ProposalTTL", validateTTL(c.ProposalTTL))
+	errs = errors.AppendField(errs, "ApprovalTTL", validateTTL(c.ApprovalTTL))
+	if c.PendingUpdate != nil {
+		errs = errors.AppendField(errs, "PendingUpdate", c.PendingUpdate.Validate())
+	}
+
+	return errs
+}
+
+// RequiredWeight returns the minimal weight of participants required to
+// activate the contract in order to authorize given message. If the contract
+// defines a threshold for the message path it is used instead of the
+// activation threshold. Authorizing a batch requires the highest weight
+// required by any of its messages.
+func (c *Contract) RequiredWeight(msg weave.Msg) (Weight, error) {
+	if b, ok := msg.(batch.Msg); ok {
+		msgs, err := b.MsgList()
+		if err != nil {
+			return 0, errors.Wrap(err, "cannot retrieve batch messages")
+		}
+		var required Weight
+		for _, m := range msgs {
+			w, err := c.RequiredWeight(m)
+			if err != nil {
+				return 0, err
+			}
+			if w > required {
+				required = w
+			}
+		}
+		return required, nil
+	}
+	for _, mt := range c.MessageThresholds {
+		if mt.MsgPath == msg.Path() {
+			return mt.Threshold, nil
+		}
+	}
+	return c.ActivationThreshold, nil
+}
 
+func (u *PendingUpdate) Validate() error {
+	var errs error
+	switch n := len(u.Participants); {
+	case n == 0:
+		errs = errors.Append(errs, errors.Field("Participants", errors.ErrModel, "no participants"))
+	case n > maxParticipantsAllowed:
+		errs = errors.Append(errs, errors.Field("Participants", errors.ErrModel, "too many participants, max %d allowed", maxParticipantsAllowed))
+	}
+	errs = errors.Append(errs, validateWeights(errors.ErrModel, u.Participants, u.ActivationThreshold, u.AdminThreshold))
+	errs = errors.AppendField(errs, "UpdateDelay", validateUpdateDelay(u.UpdateDelay))
+	errs = errors.AppendField(errs, "MessageThresholds", validateMessageThresholds(errors.ErrModel, u.MessageThresholds))
+	errs = errors.AppendField(errs, "ProposalTTL", validateTTL(u.ProposalTTL))
+	errs = errors.AppendField(errs, "ApprovalTTL", validateTTL(u.ApprovalTTL))
+	if u.ApplyAt == 0 {
+		errs = errors.Append(errs, errors.Field("ApplyAt", errors.ErrModel, "required"))
+	}
+	errs = errors.AppendField(errs, "ApplyAt", u.ApplyAt.Validate())
 	return errs
 }
 
+// NewContractBucket returns a bucket for storing multisig contracts.
+// Contracts are indexed by the address of each participant.
 func NewContractBucket() orm.ModelBucket {
 	b := orm.NewModelBucket("contracts", &Contract{},
 		orm.WithIDSequence(contractSeq),
+		orm.WithMultiKeyIndex("participant", idxParticipant, false),
 	)
 	return migration.NewModelBucket("multisig", b)
 }
 
+func idxParticipant(obj orm.Object) ([][]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	c, ok := obj.Value().(*Contract)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrType, "can only take index of contract, got %T", obj.Value())
+	}
+	keys := make([][]byte, 0, len(c.Participants))
+	for _, p := range c.Participants {
+		keys = append(keys, p.Signature)
+	}
+	return keys, nil
+}
+
 var contractSeq = orm.NewSequence("contracts", "id")
+
+var _ orm.CloneableData = (*Proposal)(nil)
+
+func (p *Proposal) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", p.Metadata.Validate())
+	errs = errors.AppendField(errs, "ContractID", validateID(p.ContractID))
+	if len(p.RawOption) == 0 {
+		errs = errors.Append(errs, errors.Field("RawOption", errors.ErrModel, "required"))
+	}
+	if len(p.Approvals) > maxParticipantsAllowed {
+		errs = errors.Append(errs, errors.Field("Approvals", errors.ErrModel, "too many approvals, max %d allowed", maxParticipantsAllowed))
+	}
+	for i, a := range p.Approvals {
+		errs = errors.AppendField(errs, fmt.Sprintf("Approvals.%d", i), a.Validate())
+	}
+	errs = errors.AppendField(errs, "ExpiresAt", p.ExpiresAt.Validate())
+	return errs
+}
+
+// HasApproval returns true if given address approved the proposal and the
+// approval did not expire at given time.
+func (p *Proposal) HasApproval(addr weave.Address, ttl weave.UnixDuration, now weave.UnixTime) bool {
+	for _, a := range p.Approvals {
+		if a.Signature.Equals(addr) {
+			return !a.IsExpired(ttl, now)
+		}
+	}
+	return false
+}
+
+// Approve records an approval of given address at given time. Previous
+// approval of the same address is replaced.
+func (p *Proposal) Approve(addr weave.Address, now weave.UnixTime) {
+	for i, a := range p.Approvals {
+		if a.Signature.Equals(addr) {
+			p.Approvals[i].ApprovedAt = now
+			return
+		}
+	}
+	p.Approvals = append(p.Approvals, Approval{Signature: addr, ApprovedAt: now})
+}
+
+// DropExpiredApprovals removes all approvals that expired at given time, so
+// that they cannot be counted ever again.
+func (p *Proposal) DropExpiredApprovals(ttl weave.UnixDuration, now weave.UnixTime) {
+	valid := p.Approvals[:0]
+	for _, a := range p.Approvals {
+		if !a.IsExpired(ttl, now) {
+			valid = append(valid, a)
+		}
+	}
+	p.Approvals = valid
+}
+
+// ApprovedWeight returns the total weight of all participants of given
+// contract that approved the proposal and whose approval is valid at given
+// time. Approvals of signatures that are no longer participants of the
+// contract are ignored.
+func (p *Proposal) ApprovedWeight(c *Contract, now weave.UnixTime) Weight {
+	var weight Weight
+	for _, participant := range c.Participants {
+		if p.HasApproval(participant.Signature, c.ApprovalTTL, now) {
+			weight += participant.Weight
+		}
+	}
+	return weight
+}
+
+// IsExpired returns true if the proposal expired at given time.
+func (p *Proposal) IsExpired(now weave.UnixTime) bool {
+	return p.ExpiresAt != 0 && p.ExpiresAt <= now
+}
+
+func (a *Approval) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Signature", a.Signature.Validate())
+	if a.ApprovedAt == 0 {
+		errs = errors.Append(errs, errors.Field("ApprovedAt", errors.ErrModel, "required"))
+	}
+	errs = errors.AppendField(errs, "ApprovedAt", a.ApprovedAt.Validate())
+	return errs
+}
+
+// IsExpired returns true if the approval is no longer valid at given time,
+// given the approval TTL of a contract. Approvals never expire if the TTL is
+// zero.
+func (a *Approval) IsExpired(ttl weave.UnixDuration, now weave.UnixTime) bool {
+	return ttl != 0 && a.ApprovedAt.Add(ttl.Duration()) <= now
+}
+
+func NewProposalBucket() orm.ModelBucket {
+	b := orm.NewModelBucket("msproposals", &Proposal{},
+		orm.WithIDSequence(proposalSeq),
+	)
+	return migration.NewModelBucket("multisig", b)
+}
+
+var proposalSeq = orm.NewSequence("msproposals", "id")
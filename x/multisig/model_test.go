@@ -5,7 +5,10 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
 )
 
 func TestValidateContract(t *testing.T) {
@@ -61,3 +64,55 @@ func TestValidateContract(t *testing.T) {
 	}
 
 }
+
+func TestContractParticipantIndex(t *testing.T) {
+	db := store.MemStore()
+	migration.MustInitPkg(db, "multisig")
+
+	alice := weavetest.NewCondition().Address()
+	bobby := weavetest.NewCondition().Address()
+	cindy := weavetest.NewCondition().Address()
+
+	first := createContract(t, db, Contract{
+		Metadata: &weave.Metadata{Schema: 1},
+		Participants: []*Participant{
+			{Weight: 1, Signature: alice},
+			{Weight: 1, Signature: bobby},
+		},
+		ActivationThreshold: 1,
+		AdminThreshold:      2,
+	})
+	second := createContract(t, db, Contract{
+		Metadata: &weave.Metadata{Schema: 1},
+		Participants: []*Participant{
+			{Weight: 1, Signature: bobby},
+			{Weight: 1, Signature: cindy},
+		},
+		ActivationThreshold: 1,
+		AdminThreshold:      2,
+	})
+
+	assertContracts := func(participant weave.Address, want ...[]byte) {
+		t.Helper()
+		var found []Contract
+		keys, err := NewContractBucket().ByIndex(db, "participant", participant, &found)
+		if err != nil && !errors.ErrNotFound.Is(err) {
+			t.Fatalf("cannot list contracts: %s", err)
+		}
+		assert.Equal(t, want, keys)
+	}
+
+	assertContracts(alice, first)
+	assertContracts(bobby, first, second)
+	assertContracts(cindy, second)
+
+	// Removing a participant must update the index.
+	var c Contract
+	assert.Nil(t, NewContractBucket().One(db, first, &c))
+	c.Participants = c.Participants[1:]
+	_, err := NewContractBucket().Put(db, first, &c)
+	assert.Nil(t, err)
+
+	assertContracts(alice)
+	assertContracts(bobby, first, second)
+}
@@ -9,15 +9,20 @@ import (
 func init() {
 	migration.MustRegister(1, &CreateMsg{}, migration.NoModification)
 	migration.MustRegister(1, &UpdateMsg{}, migration.NoModification)
+	migration.MustRegister(1, &CreateProposalMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ApproveProposalMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ApplyUpdateMsg{}, migration.NoModification)
+	migration.MustRegister(1, &VetoUpdateMsg{}, migration.NoModification)
+	migration.MustRegister(1, &ExpireProposalMsg{}, migration.NoModification)
 }
 
 const (
-	creationCost int64 = 300 // 3x more expensive than SendMsg
-	updateCost   int64 = 150 // Half the creation cost
-
 	// To avoid burning CPU, this is the maximum number of participants
 	// allowed to be part of a single contract.
 	maxParticipantsAllowed = 100
+
+	// Maximum number of message thresholds a single contract can define.
+	maxMessageThresholds = 20
 )
 
 var _ weave.Msg = (*CreateMsg)(nil)
@@ -38,6 +43,10 @@ func (c *CreateMsg) Validate() error {
 		errs = errors.Append(errs, errors.Field("Participants", errors.ErrModel, "too many participants, max %d allowed", maxParticipantsAllowed))
 	}
 	errs = errors.Append(errs, validateWeights(errors.ErrMsg, c.Participants, c.ActivationThreshold, c.AdminThreshold))
+	errs = errors.AppendField(errs, "UpdateDelay", validateUpdateDelay(c.UpdateDelay))
+	errs = errors.AppendField(errs, "MessageThresholds", validateMessageThresholds(errors.ErrMsg, c.MessageThresholds))
+	errs = errors.AppendField(errs, "ProposalTTL", validateTTL(c.ProposalTTL))
+	errs = errors.AppendField(errs, "ApprovalTTL", validateTTL(c.ApprovalTTL))
 	return errs
 }
 
@@ -59,6 +68,10 @@ func (c *UpdateMsg) Validate() error {
 		errs = errors.Append(errs, errors.Field("Participants", errors.ErrModel, "too many participants, max %d allowed", maxParticipantsAllowed))
 	}
 	errs = errors.Append(errs, validateWeights(errors.ErrMsg, c.Participants, c.ActivationThreshold, c.AdminThreshold))
+	errs = errors.AppendField(errs, "UpdateDelay", validateUpdateDelay(c.UpdateDelay))
+	errs = errors.AppendField(errs, "MessageThresholds", validateMessageThresholds(errors.ErrMsg, c.MessageThresholds))
+	errs = errors.AppendField(errs, "ProposalTTL", validateTTL(c.ProposalTTL))
+	errs = errors.AppendField(errs, "ApprovalTTL", validateTTL(c.ApprovalTTL))
 	return errs
 }
 
@@ -111,3 +124,131 @@ func validateWeights(
 
 	return nil
 }
+
+var _ weave.Msg = (*CreateProposalMsg)(nil)
+
+// Path fulfills weave.Msg interface to allow routing.
+func (CreateProposalMsg) Path() string {
+	return "multisig/create_proposal"
+}
+
+// Validate ensures the contract and the proposed message are provided.
+func (m *CreateProposalMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "ContractID", validateID(m.ContractID))
+	if len(m.RawOption) == 0 {
+		errs = errors.Append(errs, errors.Field("RawOption", errors.ErrEmpty, "required"))
+	}
+	return errs
+}
+
+var _ weave.Msg = (*ApproveProposalMsg)(nil)
+
+// Path fulfills weave.Msg interface to allow routing.
+func (ApproveProposalMsg) Path() string {
+	return "multisig/approve_proposal"
+}
+
+// Validate ensures the proposal ID is provided.
+func (m *ApproveProposalMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "ProposalID", validateID(m.ProposalID))
+	return errs
+}
+
+var _ weave.Msg = (*ApplyUpdateMsg)(nil)
+
+// Path fulfills weave.Msg interface to allow routing.
+func (ApplyUpdateMsg) Path() string {
+	return "multisig/apply_update"
+}
+
+// Validate ensures the contract ID is provided.
+func (m *ApplyUpdateMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "ContractID", validateID(m.ContractID))
+	return errs
+}
+
+var _ weave.Msg = (*VetoUpdateMsg)(nil)
+
+// Path fulfills weave.Msg interface to allow routing.
+func (VetoUpdateMsg) Path() string {
+	return "multisig/veto_update"
+}
+
+// Validate ensures the contract ID is provided.
+func (m *VetoUpdateMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "ContractID", validateID(m.ContractID))
+	return errs
+}
+
+// validateMessageThresholds returns an error if given message thresholds
+// are not valid. Each message path can be configured only once.
+func validateMessageThresholds(baseErr error, mts []MessageThreshold) error {
+	if len(mts) > maxMessageThresholds {
+		return errors.Wrapf(baseErr, "too many message thresholds, max %d allowed", maxMessageThresholds)
+	}
+	paths := make(map[string]struct{}, len(mts))
+	for _, mt := range mts {
+		if mt.MsgPath == "" {
+			return errors.Wrap(baseErr, "message path required")
+		}
+		if _, ok := paths[mt.MsgPath]; ok {
+			return errors.Wrapf(baseErr, "duplicated message path %q", mt.MsgPath)
+		}
+		paths[mt.MsgPath] = struct{}{}
+		if err := mt.Threshold.Validate(); err != nil {
+			return errors.Wrapf(err, "threshold of %q", mt.MsgPath)
+		}
+	}
+	return nil
+}
+
+var _ weave.Msg = (*ExpireProposalMsg)(nil)
+
+// Path fulfills weave.Msg interface to allow routing.
+func (ExpireProposalMsg) Path() string {
+	return "multisig/expire_proposal"
+}
+
+// Validate ensures the proposal ID is provided.
+func (m *ExpireProposalMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "ProposalID", validateID(m.ProposalID))
+	return errs
+}
+
+// validateTTL returns an error if given proposal or approval time to live is
+// not valid.
+func validateTTL(d weave.UnixDuration) error {
+	if d < 0 {
+		return errors.Wrap(errors.ErrInput, "must not be negative")
+	}
+	return nil
+}
+
+// validateUpdateDelay returns an error if given update delay is not valid.
+func validateUpdateDelay(d weave.UnixDuration) error {
+	if d < 0 {
+		return errors.Wrap(errors.ErrInput, "must not be negative")
+	}
+	return nil
+}
+
+// validateID returns an error if given value is not a valid sequence ID.
+func validateID(id []byte) error {
+	switch n := len(id); {
+	case n == 0:
+		return errors.Wrap(errors.ErrEmpty, "required")
+	case n != 8:
+		return errors.Wrapf(errors.ErrInput, "invalid length: %d", n)
+	}
+	return nil
+}
@@ -35,6 +35,48 @@ func TestValidateCreateMsg(t *testing.T) {
 			},
 			WantErr: errors.ErrMetadata,
 		},
+		"negative update delay": {
+			Msg: &CreateMsg{
+				Metadata:            &weave.Metadata{Schema: 1},
+				ActivationThreshold: 2,
+				AdminThreshold:      3,
+				Participants: []*Participant{
+					{Weight: 1, Signature: weavetest.NewCondition().Address()},
+					{Weight: 2, Signature: weavetest.NewCondition().Address()},
+				},
+				UpdateDelay: -1,
+			},
+			WantErr: errors.ErrInput,
+		},
+		"negative approval TTL": {
+			Msg: &CreateMsg{
+				Metadata:            &weave.Metadata{Schema: 1},
+				ActivationThreshold: 2,
+				AdminThreshold:      3,
+				Participants: []*Participant{
+					{Weight: 1, Signature: weavetest.NewCondition().Address()},
+					{Weight: 2, Signature: weavetest.NewCondition().Address()},
+				},
+				ApprovalTTL: -1,
+			},
+			WantErr: errors.ErrInput,
+		},
+		"duplicated message threshold": {
+			Msg: &CreateMsg{
+				Metadata:            &weave.Metadata{Schema: 1},
+				ActivationThreshold: 2,
+				AdminThreshold:      3,
+				Participants: []*Participant{
+					{Weight: 1, Signature: weavetest.NewCondition().Address()},
+					{Weight: 2, Signature: weavetest.NewCondition().Address()},
+				},
+				MessageThresholds: []MessageThreshold{
+					{MsgPath: "cash/send", Threshold: 1},
+					{MsgPath: "cash/send", Threshold: 3},
+				},
+			},
+			WantErr: errors.ErrMsg,
+		},
 	}
 
 	for testName, tc := range cases {
@@ -73,6 +115,32 @@ func TestValidateUpdateMsg(t *testing.T) {
 			},
 			WantErr: errors.ErrMetadata,
 		},
+		"negative update delay": {
+			Msg: &UpdateMsg{
+				Metadata:            &weave.Metadata{Schema: 1},
+				ActivationThreshold: 2,
+				AdminThreshold:      3,
+				Participants: []*Participant{
+					{Weight: 1, Signature: weavetest.NewCondition().Address()},
+					{Weight: 2, Signature: weavetest.NewCondition().Address()},
+				},
+				UpdateDelay: -1,
+			},
+			WantErr: errors.ErrInput,
+		},
+		"negative proposal TTL": {
+			Msg: &UpdateMsg{
+				Metadata:            &weave.Metadata{Schema: 1},
+				ActivationThreshold: 2,
+				AdminThreshold:      3,
+				Participants: []*Participant{
+					{Weight: 1, Signature: weavetest.NewCondition().Address()},
+					{Weight: 2, Signature: weavetest.NewCondition().Address()},
+				},
+				ProposalTTL: -1,
+			},
+			WantErr: errors.ErrInput,
+		},
 	}
 
 	for testName, tc := range cases {
@@ -0,0 +1,66 @@
+package multisig
+
+import (
+	"github.com/iov-one/weave"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+const (
+	// CreateAction is the tag action used when a contract is created. All
+	// participants of the new contract are tagged.
+	CreateAction = "create"
+	// UpdateAction is the tag action used when a contract update takes
+	// effect. Participants of both the previous and the updated contract
+	// are tagged.
+	UpdateAction = "update"
+	// PendingUpdateAction is the tag action used when a contract update is
+	// stored to be applied after the update delay. Participants of both the
+	// current and the updated contract are tagged, so that they can veto
+	// the update.
+	PendingUpdateAction = "pending_update"
+	// VetoUpdateAction is the tag action used when a pending contract
+	// update is vetoed. All participants of the contract are tagged.
+	VetoUpdateAction = "veto_update"
+	// ProposeAction is the tag action used when a proposal is created. All
+	// participants of the contract are tagged, so that they can approve
+	// the proposal.
+	ProposeAction = "propose"
+	// AuthorizeAction is the tag action used when a contract authorizes a
+	// transaction or executes a proposal. Participants that signed the
+	// transaction or approved the proposal are tagged.
+	AuthorizeAction = "authorize"
+)
+
+// actionTags returns tags describing an action performed on a contract. The
+// hex encoded contract ID is tagged with the "multisig.<action>" key and
+// again with the "multisig.<action>.<address>" key for every participant
+// address, so that monitoring systems can alert participants of all actions
+// that involve them.
+//
+// Tendermint collapses multiple tags with the same key, so if the same action
+// is performed on several contracts within a single transaction only one of
+// the contract IDs is tagged with the action key.
+func actionTags(action string, contractID []byte, participants []weave.Address) []common.KVPair {
+	tags := weave.NewTags("multisig").ID(action, contractID)
+	seen := make(map[string]struct{}, len(participants))
+	for _, p := range participants {
+		name := action + "." + p.String()
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+		tags.ID(name, contractID)
+	}
+	return tags.KVPairs()
+}
+
+// participantAddresses returns addresses of all given participants.
+func participantAddresses(participants ...[]*Participant) []weave.Address {
+	var addrs []weave.Address
+	for _, ps := range participants {
+		for _, p := range ps {
+			addrs = append(addrs, p.Signature)
+		}
+	}
+	return addrs
+}
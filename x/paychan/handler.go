@@ -10,17 +10,14 @@ import (
 	"github.com/iov-one/weave/x/cash"
 )
 
-const (
-	createPaymentChannelCost   int64 = 300
-	transferPaymentChannelCost int64 = 5
-)
-
 // RegisterQuery registers payment channel bucket under /paychans.
 func RegisterQuery(qr weave.QueryRouter) {
 	NewPaymentChannelBucket().Register("paychans", qr)
 }
 
 // RegisterRouters registers payment channel message handelers in given registry.
+// Handlers do not declare a gas cost. Use gas.Decorator to charge gas for the
+// work done by payment channel transactions.
 func RegisterRoutes(r weave.Registry, auth x.Authenticator, cash cash.Controller) {
 	r = migration.SchemaMigratingRegistry("paychan", r)
 
@@ -46,7 +43,7 @@ func (h *createPaymentChannelHandler) Check(ctx weave.Context, db weave.KVStore,
 		return nil, err
 	}
 
-	return &weave.CheckResult{GasAllocated: createPaymentChannelCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h *createPaymentChannelHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*CreateMsg, error) {
@@ -93,7 +90,7 @@ func (h *createPaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStor
 
 	// Move coins from source account and deposit total amount available on
 	// that channels account.
-	if err := h.cash.MoveCoins(db, msg.Source, pc.Address, *msg.Total); err != nil {
+	if err := h.cash.MoveCoins(ctx, db, msg.Source, pc.Address, *msg.Total); err != nil {
 		return nil, errors.Wrap(err, "cannot move coins")
 	}
 	return &weave.DeliverResult{Data: key}, nil
@@ -120,7 +117,7 @@ func (h *transferPaymentChannelHandler) Check(ctx weave.Context, db weave.KVStor
 	if _, err := h.validate(ctx, db, tx); err != nil {
 		return nil, err
 	}
-	return &weave.CheckResult{GasAllocated: transferPaymentChannelCost}, nil
+	return &weave.CheckResult{}, nil
 }
 
 func (h *transferPaymentChannelHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*TransferMsg, error) {
@@ -182,7 +179,7 @@ func (h *transferPaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVSt
 		return nil, errors.Wrap(errors.ErrMsg, "invalid amount")
 	}
 
-	if err := h.cash.MoveCoins(db, pc.Address, pc.Destination, diff); err != nil {
+	if err := h.cash.MoveCoins(ctx, db, pc.Address, pc.Destination, diff); err != nil {
 		return nil, err
 	}
 
@@ -258,7 +255,7 @@ func (h *closePaymentChannelHandler) Deliver(ctx weave.Context, db weave.KVStore
 	if err != nil {
 		return nil, err
 	}
-	if err := h.cash.MoveCoins(db, pc.Address, pc.Source, diff); err != nil {
+	if err := h.cash.MoveCoins(ctx, db, pc.Address, pc.Source, diff); err != nil {
 		return nil, err
 	}
 	if err := h.bucket.Delete(db, msg.ChannelID); err != nil {
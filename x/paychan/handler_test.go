@@ -16,6 +16,7 @@ import (
 	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
 	"github.com/iov-one/weave/x/cash"
 )
 
@@ -542,6 +543,72 @@ func TestPaymentChannelHandlers(t *testing.T) {
 	}
 }
 
+func TestCreatePaymentChannelAllocatesFunds(t *testing.T) {
+	source := weavetest.NewCondition()
+	msg := &CreateMsg{
+		Metadata:     &weave.Metadata{Schema: 1},
+		Source:       source.Address(),
+		Destination:  weavetest.NewCondition().Address(),
+		SourcePubkey: weavetest.NewKey().PublicKey(),
+		Total:        dogeCoin(10, 0),
+		Timeout:      weave.AsUnixTime(inOneHour),
+	}
+
+	cases := map[string]struct {
+		balance     *coin.Coin
+		outcomes    []error
+		wantErr     *errors.Error
+		wantChannel coin.Coins
+	}{
+		"funds allocated": {
+			balance:     dogeCoin(10, 0),
+			wantChannel: coin.Coins{dogeCoin(10, 0)},
+		},
+		"source has not enough funds": {
+			balance: dogeCoin(5, 0),
+			wantErr: errors.ErrAmount,
+		},
+		"transfer fails": {
+			balance:  dogeCoin(10, 0),
+			outcomes: []error{errors.ErrDatabase},
+			wantErr:  errors.ErrDatabase,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "paychan")
+
+			ctrl := weavetest.NewCashController()
+			ctrl.SetBalance(source.Address(), tc.balance)
+			ctrl.Outcomes = tc.outcomes
+			auth := &weavetest.Auth{Signer: source}
+			h := &createPaymentChannelHandler{auth: auth, bucket: NewPaymentChannelBucket(), cash: ctrl}
+
+			ctx := weave.WithBlockTime(context.Background(), now)
+			if _, err := h.Deliver(ctx, db, &weavetest.Tx{Msg: msg}); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+
+			if len(ctrl.Calls) != 1 || ctrl.Calls[0].Method != weavetest.CashMoveCoins {
+				t.Fatalf("want a single move, got %v", ctrl.Calls)
+			}
+			move := ctrl.Calls[0]
+			assert.Equal(t, source.Address(), move.Src)
+			assert.Equal(t, paymentChannelAccount(weavetest.SequenceID(1)), move.Dest)
+			if tc.wantErr != nil {
+				return
+			}
+			balance, err := ctrl.Balance(nil, move.Dest)
+			assert.Nil(t, err)
+			if !tc.wantChannel.Equals(balance) {
+				t.Fatalf("want %v channel balance, got %v", tc.wantChannel, balance)
+			}
+		})
+	}
+}
+
 func dogeCoin(w, f int64) *coin.Coin {
 	c := coin.NewCoin(w, f, "DOGE")
 	return &c
@@ -5,7 +5,9 @@ package sigs
 
 import (
 	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_iov_one_weave "github.com/iov-one/weave"
 	weave "github.com/iov-one/weave"
 	crypto "github.com/iov-one/weave/crypto"
 	io "io"
@@ -32,6 +34,13 @@ type UserData struct {
 	Metadata *weave.Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	Pubkey   *crypto.PublicKey `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	Sequence int64             `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Sequence values greater than the current sequence that were already used.
+	// This is used only when the decorator accepts sequence values within a
+	// window. Values are sorted in ascending order.
+	UsedSequences []int64 `protobuf:"varint,4,rep,packed,name=used_sequences,json=usedSequences,proto3" json:"used_sequences,omitempty"`
+	// Public key that signatures are verified with, if the key of the account
+	// was rotated. The account address is always derived from the pubkey.
+	RotatedPubkey *crypto.PublicKey `protobuf:"bytes,5,opt,name=rotated_pubkey,json=rotatedPubkey,proto3" json:"rotated_pubkey,omitempty"`
 }
 
 func (m *UserData) Reset()         { *m = UserData{} }
@@ -88,16 +97,38 @@ func (m *UserData) GetSequence() int64 {
 	return 0
 }
 
+func (m *UserData) GetUsedSequences() []int64 {
+	if m != nil {
+		return m.UsedSequences
+	}
+	return nil
+}
+
+func (m *UserData) GetRotatedPubkey() *crypto.PublicKey {
+	if m != nil {
+		return m.RotatedPubkey
+	}
+	return nil
+}
+
 // StdSignature represents the signature, the identity of the signer
 // (the Pubkey), and a sequence number to prevent replay attacks.
 //
 // A given signer must submit transactions with the sequence number
 // increasing by 1 each time (starting at 0)
+//
+// Optionally, a signature can declare a time and a block height after which
+// it is no longer valid. Both values are covered by the signature.
 type StdSignature struct {
 	Sequence int64             `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	Pubkey   *crypto.PublicKey `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
 	// Removed Address, Pubkey is more powerful
 	Signature *crypto.Signature `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// Signature is not valid after this time. Zero value means no expiration.
+	ValidUntil github_com_iov_one_weave.UnixTime `protobuf:"varint,5,opt,name=valid_until,json=validUntil,proto3,casttype=github.com/iov-one/weave.UnixTime" json:"valid_until,omitempty"`
+	// Signature is not valid after this block height. Zero value means no
+	// expiration.
+	ValidUntilHeight int64 `protobuf:"varint,6,opt,name=valid_until_height,json=validUntilHeight,proto3" json:"valid_until_height,omitempty"`
 }
 
 func (m *StdSignature) Reset()         { *m = StdSignature{} }
@@ -154,6 +185,20 @@ func (m *StdSignature) GetSignature() *crypto.Signature {
 	return nil
 }
 
+func (m *StdSignature) GetValidUntil() github_com_iov_one_weave.UnixTime {
+	if m != nil {
+		return m.ValidUntil
+	}
+	return 0
+}
+
+func (m *StdSignature) GetValidUntilHeight() int64 {
+	if m != nil {
+		return m.ValidUntilHeight
+	}
+	return 0
+}
+
 // BumpSequenceMsg increments a sequence counter by given amount for a user
 // that signed the transaction.
 type BumpSequenceMsg struct {
@@ -213,34 +258,111 @@ func (m *BumpSequenceMsg) GetIncrement() uint32 {
 	return 0
 }
 
+// RotateKeyMsg binds a new public key to the account of the main signer. The
+// account address and the sequence do not change. Once rotated, signatures of
+// the account are verified with the new key only.
+type RotateKeyMsg struct {
+	Metadata  *weave.Metadata   `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	NewPubkey *crypto.PublicKey `protobuf:"bytes,2,opt,name=new_pubkey,json=newPubkey,proto3" json:"new_pubkey,omitempty"`
+	// Signature of the RotateKeySignBytes created with the new key. This proves
+	// that the new key is owned by the account owner.
+	NewKeySignature *crypto.Signature `protobuf:"bytes,3,opt,name=new_key_signature,json=newKeySignature,proto3" json:"new_key_signature,omitempty"`
+}
+
+func (m *RotateKeyMsg) Reset()         { *m = RotateKeyMsg{} }
+func (m *RotateKeyMsg) String() string { return proto.CompactTextString(m) }
+func (*RotateKeyMsg) ProtoMessage()    {}
+func (*RotateKeyMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f3400434997a8ae, []int{3}
+}
+func (m *RotateKeyMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RotateKeyMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RotateKeyMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RotateKeyMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateKeyMsg.Merge(m, src)
+}
+func (m *RotateKeyMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *RotateKeyMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateKeyMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateKeyMsg proto.InternalMessageInfo
+
+func (m *RotateKeyMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *RotateKeyMsg) GetNewPubkey() *crypto.PublicKey {
+	if m != nil {
+		return m.NewPubkey
+	}
+	return nil
+}
+
+func (m *RotateKeyMsg) GetNewKeySignature() *crypto.Signature {
+	if m != nil {
+		return m.NewKeySignature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UserData)(nil), "sigs.UserData")
 	proto.RegisterType((*StdSignature)(nil), "sigs.StdSignature")
 	proto.RegisterType((*BumpSequenceMsg)(nil), "sigs.BumpSequenceMsg")
+	proto.RegisterType((*RotateKeyMsg)(nil), "sigs.RotateKeyMsg")
 }
 
 func init() { proto.RegisterFile("x/sigs/codec.proto", fileDescriptor_1f3400434997a8ae) }
 
 var fileDescriptor_1f3400434997a8ae = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xaa, 0xd0, 0x2f, 0xce,
-	0x4c, 0x2f, 0xd6, 0x4f, 0xce, 0x4f, 0x49, 0x4d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62,
-	0x01, 0x89, 0x48, 0x71, 0x23, 0x09, 0x49, 0x09, 0x27, 0x17, 0x55, 0x16, 0x94, 0xe4, 0xeb, 0xe7,
-	0xe6, 0xa7, 0xa4, 0xe6, 0x14, 0x43, 0x04, 0x95, 0xea, 0xb8, 0x38, 0x42, 0x8b, 0x53, 0x8b, 0x5c,
-	0x12, 0x4b, 0x12, 0x85, 0xb4, 0xb9, 0x38, 0x72, 0x53, 0x4b, 0x12, 0x53, 0x12, 0x4b, 0x12, 0x25,
-	0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xf8, 0xf5, 0xca, 0x53, 0x13, 0xcb, 0x52, 0xf5, 0x7c, 0xa1,
-	0xc2, 0x41, 0x70, 0x05, 0x42, 0x9a, 0x5c, 0x6c, 0x05, 0xa5, 0x49, 0xd9, 0xa9, 0x95, 0x12, 0x4c,
-	0x60, 0xa5, 0x82, 0x7a, 0x10, 0xe3, 0xf5, 0x02, 0x4a, 0x93, 0x72, 0x32, 0x93, 0xbd, 0x53, 0x2b,
-	0x83, 0xa0, 0x0a, 0x84, 0xa4, 0xb8, 0x38, 0x8a, 0x53, 0x0b, 0x4b, 0x53, 0xf3, 0x92, 0x53, 0x25,
-	0x98, 0x15, 0x18, 0x35, 0x98, 0x83, 0xe0, 0x7c, 0xa5, 0x36, 0x46, 0x2e, 0x9e, 0xe0, 0x92, 0x94,
-	0xe0, 0xcc, 0xf4, 0xbc, 0xc4, 0x92, 0xd2, 0xa2, 0x54, 0x14, 0xc5, 0x4c, 0xa8, 0x8a, 0x91, 0xec,
-	0x64, 0x26, 0x64, 0xa7, 0x3e, 0x17, 0x67, 0x31, 0xcc, 0x4c, 0x09, 0x16, 0x54, 0xd5, 0x70, 0xcb,
-	0x82, 0x10, 0x6a, 0x94, 0x62, 0xb8, 0xf8, 0x9d, 0x4a, 0x73, 0x0b, 0x82, 0xa1, 0x76, 0xf9, 0x16,
-	0xa7, 0x93, 0x16, 0x1e, 0x32, 0x5c, 0x9c, 0x99, 0x79, 0xc9, 0x45, 0xa9, 0xb9, 0xa9, 0x79, 0x25,
-	0x60, 0x87, 0xf3, 0x06, 0x21, 0x04, 0x9c, 0x24, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e,
-	0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58,
-	0x8e, 0x21, 0x89, 0x0d, 0x1c, 0x0f, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3b, 0x12, 0xae,
-	0x63, 0xc5, 0x01, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x3b, 0x9b, 0x5a, 0xda, 0xd7, 0xed, 0xd6, 0x1d, 0x3d, 0x84, 0x22, 0xb1, 0x16, 0x16,
+	0x2a, 0x6a, 0x22, 0x7a, 0xf1, 0xe2, 0xa5, 0x88, 0x08, 0x65, 0x61, 0x49, 0xed, 0x4d, 0x08, 0xd3,
+	0xe4, 0x91, 0x0e, 0xdb, 0xcc, 0xd4, 0xcc, 0xa4, 0xdd, 0x7c, 0x0b, 0x3f, 0x88, 0x1f, 0xc4, 0xe3,
+	0x1e, 0x3d, 0xa9, 0xb4, 0xdf, 0x42, 0x3c, 0x48, 0xa7, 0x69, 0xb3, 0xbb, 0xb0, 0x2c, 0x7b, 0x9b,
+	0xfc, 0xf8, 0xbf, 0xf7, 0x7f, 0xef, 0x3f, 0x19, 0xa0, 0x17, 0x9e, 0xe2, 0xb1, 0xf2, 0x42, 0x19,
+	0x61, 0xe8, 0xce, 0x53, 0xa9, 0x25, 0xad, 0x6e, 0x48, 0xa7, 0x79, 0x05, 0x75, 0x1e, 0x85, 0x69,
+	0x3e, 0xd7, 0xd2, 0x4b, 0x64, 0x84, 0x33, 0x55, 0xc0, 0xc7, 0xb1, 0x8c, 0xa5, 0x39, 0x7a, 0x9b,
+	0xd3, 0x96, 0xf6, 0x7e, 0x13, 0xa8, 0x8f, 0x15, 0xa6, 0x1f, 0x98, 0x66, 0xf4, 0x05, 0xd4, 0x13,
+	0xd4, 0x2c, 0x62, 0x9a, 0xd9, 0xa4, 0x4b, 0xfa, 0xcd, 0x37, 0x6d, 0x77, 0x89, 0x6c, 0x81, 0xee,
+	0x69, 0x81, 0xfd, 0xbd, 0x80, 0x3e, 0x87, 0xda, 0x3c, 0x9b, 0x9c, 0x63, 0x6e, 0x1f, 0x18, 0xe9,
+	0xb1, 0xbb, 0x75, 0x75, 0xcf, 0xb2, 0xc9, 0x8c, 0x87, 0x43, 0xcc, 0xfd, 0x42, 0x40, 0x3b, 0x50,
+	0x57, 0xf8, 0x35, 0x43, 0x11, 0xa2, 0x6d, 0x75, 0x49, 0xdf, 0xf2, 0xf7, 0xdf, 0xf4, 0x04, 0x8e,
+	0x32, 0x85, 0x51, 0xb0, 0x03, 0xca, 0xae, 0x76, 0xad, 0xbe, 0xe5, 0xb7, 0x36, 0x74, 0xb4, 0x83,
+	0xf4, 0x1d, 0x1c, 0xa5, 0x52, 0x33, 0x8d, 0x51, 0x50, 0xb8, 0x3e, 0xb8, 0xcd, 0xb5, 0x55, 0x08,
+	0xcf, 0x8c, 0xae, 0xf7, 0x8f, 0xc0, 0xe1, 0x48, 0x47, 0x23, 0x1e, 0x0b, 0xa6, 0xb3, 0x14, 0xaf,
+	0x4d, 0x73, 0x70, 0x63, 0x9a, 0x72, 0x29, 0xeb, 0xae, 0xa5, 0x3c, 0x68, 0xa8, 0x5d, 0x4f, 0xbb,
+	0x7a, 0x5d, 0xbd, 0x37, 0xf3, 0x4b, 0x0d, 0xfd, 0x08, 0xcd, 0x05, 0x9b, 0xf1, 0x28, 0xc8, 0x84,
+	0xe6, 0x33, 0x33, 0xbf, 0x35, 0x38, 0xf9, 0xfb, 0xeb, 0xe9, 0xb3, 0x98, 0xeb, 0x69, 0x36, 0x71,
+	0x43, 0x99, 0x78, 0x5c, 0x2e, 0x5e, 0x49, 0x81, 0xde, 0x36, 0xf6, 0xb1, 0xe0, 0x17, 0x9f, 0x79,
+	0x82, 0x3e, 0x98, 0xca, 0xf1, 0xa6, 0x90, 0xbe, 0x04, 0x7a, 0xa5, 0x4f, 0x30, 0x45, 0x1e, 0x4f,
+	0xb5, 0x5d, 0x33, 0x9b, 0x3c, 0x2c, 0x75, 0x9f, 0x0c, 0xef, 0x7d, 0x81, 0xf6, 0x20, 0x4b, 0xe6,
+	0xbb, 0x24, 0x4f, 0x55, 0x7c, 0xbf, 0x6b, 0x7e, 0x02, 0x0d, 0x2e, 0xc2, 0x14, 0x13, 0x14, 0xda,
+	0xc4, 0xd5, 0xf2, 0x4b, 0xd0, 0xfb, 0x4e, 0xe0, 0xd0, 0x37, 0x71, 0x0f, 0x31, 0xbf, 0x77, 0xef,
+	0xd7, 0x00, 0x02, 0x97, 0xc1, 0x5d, 0xbf, 0x51, 0x43, 0xe0, 0x72, 0x7b, 0x99, 0xf4, 0x3d, 0x1c,
+	0x6f, 0x2a, 0xce, 0x31, 0x0f, 0xca, 0xf0, 0xad, 0xdb, 0xc2, 0x6f, 0x0b, 0x5c, 0x0e, 0x31, 0xdf,
+	0x83, 0x81, 0xfd, 0x63, 0xe5, 0x90, 0xcb, 0x95, 0x43, 0xfe, 0xac, 0x1c, 0xf2, 0x6d, 0xed, 0x54,
+	0x2e, 0xd7, 0x4e, 0xe5, 0xe7, 0xda, 0xa9, 0x4c, 0x6a, 0xe6, 0x39, 0xbc, 0xfd, 0x1f, 0x00, 0x00,
+	0xff, 0xff, 0x40, 0xe1, 0xbc, 0x76, 0x62, 0x03, 0x00, 0x00,
 }
 
 func (m *UserData) Marshal() (dAtA []byte, err error) {
@@ -283,6 +405,34 @@ func (m *UserData) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Sequence))
 	}
+	if len(m.UsedSequences) > 0 {
+		dAtA4 := make([]byte, len(m.UsedSequences)*10)
+		var j3 int
+		for _, num1 := range m.UsedSequences {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(j3))
+		i += copy(dAtA[i:], dAtA4[:j3])
+	}
+	if m.RotatedPubkey != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.RotatedPubkey.Size()))
+		n5, err := m.RotatedPubkey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
 	return i, nil
 }
 
@@ -310,21 +460,31 @@ func (m *StdSignature) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Pubkey.Size()))
-		n3, err := m.Pubkey.MarshalTo(dAtA[i:])
+		n6, err := m.Pubkey.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n3
+		i += n6
 	}
 	if m.Signature != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Signature.Size()))
-		n4, err := m.Signature.MarshalTo(dAtA[i:])
+		n7, err := m.Signature.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n4
+		i += n7
+	}
+	if m.ValidUntil != 0 {
+		dAtA[i] = 0x28
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidUntil))
+	}
+	if m.ValidUntilHeight != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.ValidUntilHeight))
 	}
 	return i, nil
 }
@@ -348,11 +508,11 @@ func (m *BumpSequenceMsg) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
-		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		n8, err := m.Metadata.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n5
+		i += n8
 	}
 	if m.Increment != 0 {
 		dAtA[i] = 0x10
@@ -362,6 +522,54 @@ func (m *BumpSequenceMsg) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RotateKeyMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RotateKeyMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n9, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.NewPubkey != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.NewPubkey.Size()))
+		n10, err := m.NewPubkey.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.NewKeySignature != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.NewKeySignature.Size()))
+		n11, err := m.NewKeySignature.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -388,6 +596,17 @@ func (m *UserData) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovCodec(uint64(m.Sequence))
 	}
+	if len(m.UsedSequences) > 0 {
+		l = 0
+		for _, e := range m.UsedSequences {
+			l += sovCodec(uint64(e))
+		}
+		n += 1 + sovCodec(uint64(l)) + l
+	}
+	if m.RotatedPubkey != nil {
+		l = m.RotatedPubkey.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
 	return n
 }
 
@@ -408,6 +627,12 @@ func (m *StdSignature) Size() (n int) {
 		l = m.Signature.Size()
 		n += 1 + l + sovCodec(uint64(l))
 	}
+	if m.ValidUntil != 0 {
+		n += 1 + sovCodec(uint64(m.ValidUntil))
+	}
+	if m.ValidUntilHeight != 0 {
+		n += 1 + sovCodec(uint64(m.ValidUntilHeight))
+	}
 	return n
 }
 
@@ -427,6 +652,27 @@ func (m *BumpSequenceMsg) Size() (n int) {
 	return n
 }
 
+func (m *RotateKeyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.NewPubkey != nil {
+		l = m.NewPubkey.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	if m.NewKeySignature != nil {
+		l = m.NewKeySignature.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -560,6 +806,118 @@ func (m *UserData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UsedSequences = append(m.UsedSequences, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCodec
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthCodec
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthCodec
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UsedSequences) == 0 {
+					m.UsedSequences = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCodec
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UsedSequences = append(m.UsedSequences, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedSequences", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotatedPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RotatedPubkey == nil {
+				m.RotatedPubkey = &crypto.PublicKey{}
+			}
+			if err := m.RotatedPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -704,6 +1062,44 @@ func (m *StdSignature) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntil", wireType)
+			}
+			m.ValidUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntil |= github_com_iov_one_weave.UnixTime(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidUntilHeight", wireType)
+			}
+			m.ValidUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidUntilHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCodec(dAtA[iNdEx:])
@@ -836,6 +1232,167 @@ func (m *BumpSequenceMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RotateKeyMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RotateKeyMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RotateKeyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPubkey == nil {
+				m.NewPubkey = &crypto.PublicKey{}
+			}
+			if err := m.NewPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKeySignature", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewKeySignature == nil {
+				m.NewKeySignature = &crypto.Signature{}
+			}
+			if err := m.NewKeySignature.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCodec(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
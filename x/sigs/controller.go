@@ -2,7 +2,6 @@ package sigs
 
 import (
 	"crypto/sha512"
-	"encoding/binary"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/crypto"
@@ -13,6 +12,14 @@ import (
 // a signature
 var SignCodeV1 = []byte{0, 0xCA, 0xFE, 0}
 
+// SignCodeV2 is the prefix of the bytes we use to build a signature that
+// expires.
+var SignCodeV2 = []byte{0, 0xCA, 0xFE, 1}
+
+// SignCodeRotateKey is the prefix of the bytes that a new key must sign in
+// order to be bound to an account.
+var SignCodeRotateKey = []byte{0, 0xCA, 0xFE, 0xFF}
+
 //----------------- Controller ------------------
 //
 // Place actual business logic here.
@@ -31,6 +38,19 @@ var SignCodeV1 = []byte{0, 0xCA, 0xFE, 0}
 // or error if any signature is invalid
 func VerifyTxSignatures(store weave.KVStore, tx SignedTx,
 	chainID string) ([]weave.Condition, error) {
+	return verifyTxSignatures(store, tx, chainID, 0, false)
+}
+
+// verifyTxSignatures checks all the signatures on the tx. Sequence of each
+// signature must be within given window. Account state is modified only if
+// all signatures are valid.
+//
+// If batch is true, all signatures are verified at once using batch
+// verification, which is much faster for transactions carrying many
+// signatures. If the batch fails, each signature is verified separately, so
+// that a transaction is not rejected because of the batch verification rules.
+func verifyTxSignatures(store weave.KVStore, tx SignedTx,
+	chainID string, window int64, batch bool) ([]weave.Condition, error) {
 
 	bz, err := tx.GetSignBytes()
 	if err != nil {
@@ -38,10 +58,13 @@ func VerifyTxSignatures(store weave.KVStore, tx SignedTx,
 	}
 	sigs := tx.GetSignatures()
 
+	if err := verifySignatures(store, sigs, bz, chainID, batch); err != nil {
+		return nil, err
+	}
+
 	signers := make([]weave.Condition, 0, len(sigs))
 	for _, sig := range sigs {
-		// TODO: separate into own function (verify one sig)
-		signer, err := VerifySignature(store, sig, bz, chainID)
+		signer, err := useSignature(store, sig, window)
 		if err != nil {
 			return nil, err
 		}
@@ -55,13 +78,49 @@ func VerifyTxSignatures(store weave.KVStore, tx SignedTx,
 // check chain and updates state in the store
 func VerifySignature(db weave.KVStore, sig *StdSignature,
 	signBytes []byte, chainID string) (weave.Condition, error) {
-
-	// we guarantee sequence makes sense and pubkey or address is there
-	err := sig.Validate()
-	if err != nil {
+	if err := verifySignatures(db, []*StdSignature{sig}, signBytes, chainID, false); err != nil {
 		return nil, err
 	}
+	return useSignature(db, sig, 0)
+}
+
+// verifySignatures checks all given signatures against signbytes. Account
+// state is not modified. If batch is true, signatures are verified using a
+// crypto.BatchVerifier.
+func verifySignatures(db weave.KVStore, sigs []*StdSignature,
+	signBytes []byte, chainID string, batch bool) error {
+
+	bucket := NewBucket()
+	bv := crypto.NewBatchVerifier()
+	for _, sig := range sigs {
+		// we guarantee sequence makes sense and pubkey or address is there
+		if err := sig.Validate(); err != nil {
+			return err
+		}
+		obj, err := bucket.GetOrCreate(db, sig.Pubkey)
+		if err != nil {
+			return err
+		}
+		toSign, err := BuildExpiringSignBytes(signBytes, chainID, sig.Sequence, sig.ValidUntil, sig.ValidUntilHeight)
+		if err != nil {
+			return err
+		}
+		pubkey := AsUser(obj).SigningPubkey()
+		if batch {
+			bv.Add(pubkey, toSign, sig.Signature)
+		} else if !pubkey.Verify(toSign, sig.Signature) {
+			return errors.Wrap(errors.ErrUnauthorized, "invalid signature")
+		}
+	}
+	if batch && !bv.Verify() {
+		return errors.Wrap(errors.ErrUnauthorized, "invalid signature")
+	}
+	return nil
+}
 
+// useSignature updates the account of the signer with the sequence of a
+// verified signature. Sequence of the signature must be within given window.
+func useSignature(db weave.KVStore, sig *StdSignature, window int64) (weave.Condition, error) {
 	bucket := NewBucket()
 
 	// load account
@@ -70,17 +129,8 @@ func VerifySignature(db weave.KVStore, sig *StdSignature,
 		return nil, err
 	}
 
-	toSign, err := BuildSignBytes(signBytes, chainID, sig.Sequence)
-	if err != nil {
-		return nil, err
-	}
-
 	user := AsUser(obj)
-	if !user.Pubkey.Verify(toSign, sig.Signature) {
-		return nil, errors.Wrap(errors.ErrUnauthorized, "invalid signature")
-	}
-
-	err = user.CheckAndIncrementSequence(sig.Sequence)
+	err = user.UseSequence(sig.Sequence, window)
 	if err != nil {
 		return nil, err
 	}
@@ -91,59 +141,90 @@ func VerifySignature(db weave.KVStore, sig *StdSignature,
 	return user.Pubkey.Condition(), nil
 }
 
+// BuildSignBytes combines all info on the actual tx before signing. The
+// result is the hash of the version 1 SignDoc, that must be signed.
+func BuildSignBytes(signBytes []byte, chainID string, seq int64) ([]byte, error) {
+	doc := SignDoc{
+		ChainID:  chainID,
+		Sequence: seq,
+		Tx:       signBytes,
+	}
+	return doc.Hash()
+}
+
+// BuildExpiringSignBytes combines all info on the actual tx before signing,
+// including the time and the block height after which the signature is no
+// longer valid. The result is the hash of the SignDoc, that must be signed.
+//
+// If neither expiration time nor height is provided, the result is the same
+// as BuildSignBytes.
+func BuildExpiringSignBytes(signBytes []byte, chainID string, seq int64, validUntil weave.UnixTime, validUntilHeight int64) ([]byte, error) {
+	doc := SignDoc{
+		ChainID:          chainID,
+		Sequence:         seq,
+		ValidUntil:       validUntil,
+		ValidUntilHeight: validUntilHeight,
+		Tx:               signBytes,
+	}
+	return doc.Hash()
+}
+
 /*
-BuildSignBytes combines all info on the actual tx before signing
+RotateKeySignBytes returns the bytes that a new key must sign in order to
+prove that it is owned by the owner of given account.
 
-As specified in https://github.com/iov-one/weave/issues/70,
-we use the following format:
+We use the following format:
 
-version | len(chainID) | chainID      | nonce             | signBytes
-4bytes  | uint8        | ascii string | int64 (bigendian) | serialized transaction
+version | len(chainID) | chainID      | account address
+4bytes  | uint8        | ascii string | 20 bytes
 
 This is then prehashed with sha512 before fed into
 the public key signing/verification step
 */
-func BuildSignBytes(signBytes []byte, chainID string, seq int64) ([]byte, error) {
-	if seq < 0 {
-		return nil, errors.Wrap(ErrInvalidSequence, "negative")
-	}
+func RotateKeySignBytes(chainID string, account weave.Address) ([]byte, error) {
 	if !weave.IsValidChainID(chainID) {
 		return nil, errors.Wrapf(errors.ErrInput, "chain id: %v", chainID)
 	}
+	if err := account.Validate(); err != nil {
+		return nil, errors.Wrap(err, "account")
+	}
 
-	// encode nonce as 8 byte, big-endian
-	nonce := make([]byte, 8)
-	binary.BigEndian.PutUint64(nonce, uint64(seq))
-
-	// concatentate everything
-	output := make([]byte, 0, 4+1+len(chainID)+8+len(signBytes))
-	output = append(output, []byte(SignCodeV1)...)
+	output := make([]byte, 0, 4+1+len(chainID)+len(account))
+	output = append(output, []byte(SignCodeRotateKey)...)
 	output = append(output, uint8(len(chainID)))
 	output = append(output, []byte(chainID)...)
-	output = append(output, nonce...)
-	output = append(output, signBytes...)
+	output = append(output, account...)
 
-	// now, we take the sha512 hash of the result,
-	// so we have a constant length output to feed into eddsa
-	// which we need so ledger can support this as well
 	hashed := sha512.Sum512(output)
 	return hashed[:], nil
 }
 
 // BuildSignBytesTx calculates the sign bytes given a tx
 func BuildSignBytesTx(tx SignedTx, chainID string, seq int64) ([]byte, error) {
-	signBytes, err := tx.GetSignBytes()
+	doc, err := NewSignDoc(tx, chainID, seq)
 	if err != nil {
 		return nil, err
 	}
-	return BuildSignBytes(signBytes, chainID, seq)
+	return doc.Hash()
 }
 
 // SignTx creates a signature for the given tx
 func SignTx(signer crypto.Signer, tx SignedTx, chainID string,
 	seq int64) (*StdSignature, error) {
+	return SignExpiringTx(signer, tx, chainID, seq, 0, 0)
+}
+
+// SignExpiringTx creates a signature for the given tx that is not valid
+// after given time or block height. Use zero value to not limit the
+// signature by time or by height.
+func SignExpiringTx(signer crypto.Signer, tx SignedTx, chainID string,
+	seq int64, validUntil weave.UnixTime, validUntilHeight int64) (*StdSignature, error) {
 
-	signBytes, err := BuildSignBytesTx(tx, chainID, seq)
+	raw, err := tx.GetSignBytes()
+	if err != nil {
+		return nil, err
+	}
+	signBytes, err := BuildExpiringSignBytes(raw, chainID, seq, validUntil, validUntilHeight)
 	if err != nil {
 		return nil, err
 	}
@@ -155,9 +236,11 @@ func SignTx(signer crypto.Signer, tx SignedTx, chainID string,
 	pub := signer.PublicKey()
 
 	res := &StdSignature{
-		Pubkey:    pub,
-		Signature: sig,
-		Sequence:  seq,
+		Pubkey:           pub,
+		Signature:        sig,
+		Sequence:         seq,
+		ValidUntil:       validUntil,
+		ValidUntilHeight: validUntilHeight,
 	}
 
 	return res, nil
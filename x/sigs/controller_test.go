@@ -57,6 +57,21 @@ func TestSignBytes(t *testing.T) {
 	if bytes.Equal(c1, c3) {
 		t.Fatal("signature reproduced")
 	}
+
+	// make sure expiration is covered only if set
+	e0, err := BuildExpiringSignBytes(bz, chainID, 17, 0, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, c1, e0)
+	e1, err := BuildExpiringSignBytes(bz, chainID, 17, 1000, 0)
+	assert.Nil(t, err)
+	if bytes.Equal(c1, e1) {
+		t.Fatal("signature reproduced")
+	}
+	e2, err := BuildExpiringSignBytes(bz, chainID, 17, 0, 1000)
+	assert.Nil(t, err)
+	if bytes.Equal(e1, e2) {
+		t.Fatal("signature reproduced")
+	}
 }
 
 func TestVerifySignature(t *testing.T) {
@@ -6,6 +6,8 @@ and maintain nonces for replay protection.
 package sigs
 
 import (
+	"fmt"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 )
@@ -15,6 +17,10 @@ const (
 )
 
 // RegisterQuery will register this bucket as "/auth"
+//
+// Querying an account by address can be done with a merkle proof of the
+// returned sequence, or of the account absence. This allows to fetch the
+// sequence from a node that is not trusted.
 func RegisterQuery(qr weave.QueryRouter) {
 	NewBucket().Register("auth", qr)
 }
@@ -27,6 +33,7 @@ func RegisterQuery(qr weave.QueryRouter) {
 // Decorator verifies the signatures and adds them to the context
 type Decorator struct {
 	allowMissingSigs bool
+	sequenceWindow   int64
 }
 
 var _ weave.Decorator = Decorator{}
@@ -46,6 +53,18 @@ func (d Decorator) AllowMissingSigs() Decorator {
 	return d
 }
 
+// WithSequenceWindow allows signatures with a sequence value greater than the
+// current one by at most given size. Each value can be used only once. This
+// allows clients to broadcast several transactions concurrently. Zero size
+// requires sequence values to be used strictly in order.
+func (d Decorator) WithSequenceWindow(size int64) Decorator {
+	if size < 0 || size > MaxSequenceWindow {
+		panic(fmt.Sprintf("sequence window size must be between 0 and %d", MaxSequenceWindow))
+	}
+	d.sequenceWindow = size
+	return d
+}
+
 // Check verifies signatures before calling down the stack.
 func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
 	stx, ok := tx.(SignedTx)
@@ -53,8 +72,14 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 		return next.Check(ctx, store, tx)
 	}
 
+	if err := checkExpiration(ctx, stx); err != nil {
+		return nil, err
+	}
 	chainID := weave.GetChainID(ctx)
-	signers, err := VerifyTxSignatures(store, stx, chainID)
+	// Signatures are verified in batch, which is significantly faster
+	// for transactions carrying many signatures. Deliver verifies each
+	// signature separately.
+	signers, err := verifyTxSignatures(store, stx, chainID, d.sequenceWindow, true)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot verify signatures")
 	}
@@ -71,10 +96,33 @@ func (d Decorator) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, ne
 	// The most expensive operation is the signature validation. We must
 	// charge gas proportionally to the effort. We only charge for the
 	// valid signatures. Invalid signatures are ignored.
-	res.GasPayment += int64(len(signers) * signatureVerifyCost)
+	res.GasPayment += signaturesCost(stx.GetSignatures())
 	return res, nil
 }
 
+// signaturesCost returns the gas cost of verifying given signatures.
+func signaturesCost(sigs []*StdSignature) int64 {
+	return int64(CountSignatures(sigs) * signatureVerifyCost)
+}
+
+// CountSignatures returns the number of signatures that must be verified. A
+// multisig signature is counted once for each member signature it contains.
+func CountSignatures(sigs []*StdSignature) int {
+	var n int
+	for _, sig := range sigs {
+		if msig := sig.Signature.GetMultisig(); msig != nil {
+			for _, s := range msig.Signatures {
+				if s.GetSig() != nil {
+					n++
+				}
+			}
+		} else {
+			n++
+		}
+	}
+	return n
+}
+
 // Deliver verifies signatures before calling down the stack.
 func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
 	stx, ok := tx.(SignedTx)
@@ -82,8 +130,11 @@ func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 		return next.Deliver(ctx, store, tx)
 	}
 
+	if err := checkExpiration(ctx, stx); err != nil {
+		return nil, err
+	}
 	chainID := weave.GetChainID(ctx)
-	signers, err := VerifyTxSignatures(store, stx, chainID)
+	signers, err := verifyTxSignatures(store, stx, chainID, d.sequenceWindow, false)
 	if err != nil {
 		return nil, errors.Wrap(err, "cannot verify signatures")
 	}
@@ -94,3 +145,19 @@ func (d Decorator) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx,
 	ctx = withSigners(ctx, signers)
 	return next.Deliver(ctx, store, tx)
 }
+
+// checkExpiration returns an error if any of the transaction signatures is no
+// longer valid. Signed but not broadcasted transaction cannot be replayed
+// after its signatures expired.
+func checkExpiration(ctx weave.Context, tx SignedTx) error {
+	for i, sig := range tx.GetSignatures() {
+		expired, err := sig.IsExpired(ctx)
+		if err != nil {
+			return errors.Wrapf(err, "signature %d", i)
+		}
+		if expired {
+			return errors.Wrapf(errors.ErrExpired, "signature %d", i)
+		}
+	}
+	return nil
+}
@@ -3,8 +3,10 @@ package sigs
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
@@ -109,6 +111,231 @@ func TestDecorator(t *testing.T) {
 
 }
 
+func TestDecoratorSignatureExpiration(t *testing.T) {
+	chainID := "deco-rate"
+	now := time.Now()
+	ctx := weave.WithChainID(context.Background(), chainID)
+	ctx = weave.WithBlockTime(ctx, now)
+	ctx = weave.WithHeight(ctx, 100)
+
+	priv := weavetest.NewKey()
+	tx := NewStdTx([]byte("art"))
+
+	mustSign := func(validUntil weave.UnixTime, validUntilHeight int64) *StdSignature {
+		sig, err := SignExpiringTx(priv, tx, chainID, 0, validUntil, validUntilHeight)
+		assert.Nil(t, err)
+		return sig
+	}
+	prolonged := mustSign(weave.AsUnixTime(now.Add(-time.Hour)), 0)
+	prolonged.ValidUntil = weave.AsUnixTime(now.Add(time.Hour))
+
+	cases := map[string]struct {
+		sig     *StdSignature
+		wantErr *errors.Error
+	}{
+		"not expiring": {
+			sig: mustSign(0, 0),
+		},
+		"valid until a future time": {
+			sig: mustSign(weave.AsUnixTime(now.Add(time.Hour)), 0),
+		},
+		"valid until the current time": {
+			sig: mustSign(weave.AsUnixTime(now), 0),
+		},
+		"valid until a past time": {
+			sig:     mustSign(weave.AsUnixTime(now.Add(-time.Hour)), 0),
+			wantErr: errors.ErrExpired,
+		},
+		"valid until the current height": {
+			sig: mustSign(0, 100),
+		},
+		"valid until a past height": {
+			sig:     mustSign(weave.AsUnixTime(now.Add(time.Hour)), 99),
+			wantErr: errors.ErrExpired,
+		},
+		"expiration time changed after signing": {
+			sig:     prolonged,
+			wantErr: errors.ErrUnauthorized,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			migration.MustInitPkg(db, "sigs")
+			tx.Signatures = []*StdSignature{tc.sig}
+
+			d := NewDecorator()
+			if _, err := d.Check(ctx, db.CacheWrap(), tx, &SigCheckHandler{}); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected check error: %+v", err)
+			}
+			if _, err := d.Deliver(ctx, db, tx, &SigCheckHandler{}); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected deliver error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestDecoratorSequenceWindow(t *testing.T) {
+	chainID := "deco-rate"
+	ctx := weave.WithChainID(context.Background(), chainID)
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "sigs")
+	d := NewDecorator().WithSequenceWindow(2)
+
+	priv := weavetest.NewKey()
+	tx := NewStdTx([]byte("art"))
+
+	steps := []struct {
+		seq     int64
+		wantErr *errors.Error
+	}{
+		{seq: 1},
+		{seq: 1, wantErr: ErrInvalidSequence},
+		{seq: 3, wantErr: ErrInvalidSequence},
+		{seq: 2},
+		{seq: 0},
+		// Sequence was incremented above all used values.
+		{seq: 3},
+		{seq: 5},
+		{seq: 4},
+		{seq: 6},
+	}
+	for i, step := range steps {
+		sig, err := SignTx(priv, tx, chainID, step.seq)
+		assert.Nil(t, err)
+		tx.Signatures = []*StdSignature{sig}
+		if _, err := d.Deliver(ctx, db, tx, &SigCheckHandler{}); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected error: %+v", i, err)
+		}
+	}
+
+	seq, err := NextNonce(db, priv.PublicKey().Address())
+	assert.Nil(t, err)
+	assert.Equal(t, int64(7), seq)
+}
+
+func TestDecoratorBatchVerification(t *testing.T) {
+	chainID := "deco-rate"
+	ctx := weave.WithChainID(context.Background(), chainID)
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "sigs")
+	d := NewDecorator()
+
+	keys := []crypto.Signer{
+		weavetest.NewKey(),
+		weavetest.NewKey(),
+		crypto.GenPrivKeySr25519(),
+		weavetest.NewKey(),
+	}
+	tx := NewStdTx([]byte("many signers"))
+	for _, k := range keys {
+		sig, err := SignTx(k, tx, chainID, 0)
+		assert.Nil(t, err)
+		tx.Signatures = append(tx.Signatures, sig)
+	}
+
+	// Replace one of the signatures with a signature of a different
+	// transaction.
+	valid := tx.Signatures[1]
+	invalid, err := SignTx(keys[1], NewStdTx([]byte("other")), chainID, 0)
+	assert.Nil(t, err)
+	tx.Signatures[1] = invalid
+
+	var h SigCheckHandler
+	if _, err := d.Check(ctx, db, tx, &h); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected check error: %+v", err)
+	}
+	if _, err := d.Deliver(ctx, db, tx, &h); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected deliver error: %+v", err)
+	}
+	// Failed verification must not modify any account.
+	for i, k := range keys {
+		seq, err := NextNonce(db, k.PublicKey().Address())
+		assert.Nil(t, err)
+		if seq != 0 {
+			t.Fatalf("signer %d: want sequence 0, got %d", i, seq)
+		}
+	}
+
+	tx.Signatures[1] = valid
+	if _, err := d.Check(ctx, db, tx, &h); err != nil {
+		t.Fatalf("cannot check: %+v", err)
+	}
+	if got, want := len(h.Signers), len(keys); got != want {
+		t.Fatalf("want %d signers, got %d", want, got)
+	}
+	for i, k := range keys {
+		seq, err := NextNonce(db, k.PublicKey().Address())
+		assert.Nil(t, err)
+		if seq != 1 {
+			t.Fatalf("signer %d: want sequence 1, got %d", i, seq)
+		}
+	}
+}
+
+func TestDecoratorMultisigPublicKey(t *testing.T) {
+	chainID := "deco-rate"
+	ctx := weave.WithChainID(context.Background(), chainID)
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "sigs")
+	d := NewDecorator()
+
+	a, b, c := crypto.GenPrivKeyEd25519(), crypto.GenPrivKeyEd25519(), crypto.GenPrivKeySr25519()
+	pubkey, err := crypto.NewMultisigPublicKey(2, a.PublicKey(), b.PublicKey(), c.PublicKey())
+	assert.Nil(t, err)
+
+	cases := map[string]struct {
+		signers []crypto.Signer
+		seq     int64
+		wantErr *errors.Error
+		wantGas int64
+	}{
+		"threshold signers": {
+			signers: []crypto.Signer{a, nil, c},
+			seq:     0,
+			wantGas: 2 * signatureVerifyCost,
+		},
+		"all signers": {
+			signers: []crypto.Signer{a, b, c},
+			seq:     1,
+			wantGas: 3 * signatureVerifyCost,
+		},
+		"sequence is shared by all signers": {
+			signers: []crypto.Signer{nil, b, c},
+			seq:     1,
+			wantErr: ErrInvalidSequence,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			signer, err := crypto.NewMultisigSigner(pubkey, tc.signers...)
+			assert.Nil(t, err)
+			tx := NewStdTx([]byte("multisig"))
+			sig, err := SignTx(signer, tx, chainID, tc.seq)
+			assert.Nil(t, err)
+			tx.Signatures = []*StdSignature{sig}
+
+			var h SigCheckHandler
+			res, err := d.Check(ctx, db, tx, &h)
+			if !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			if len(h.Signers) != 1 || !h.Signers[0].Equals(pubkey.Condition()) {
+				t.Fatalf("unexpected signers: %v", h.Signers)
+			}
+			if res.GasPayment != tc.wantGas {
+				t.Fatalf("want %d gas payment, got %d", tc.wantGas, res.GasPayment)
+			}
+		})
+	}
+}
+
 // SigCheckHandler stores the seen signers on each call
 type SigCheckHandler struct {
 	Signers []weave.Condition
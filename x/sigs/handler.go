@@ -14,6 +14,11 @@ func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
 			b:    NewBucket(),
 			auth: auth,
 		}))
+	r.Handle(&RotateKeyMsg{}, migration.SchemaMigratingHandler("sigs",
+		&rotateKeyHandler{
+			b:    NewBucket(),
+			auth: auth,
+		}))
 }
 
 type bumpSequenceHandler struct {
@@ -42,6 +47,7 @@ func (h *bumpSequenceHandler) Deliver(ctx weave.Context, db weave.KVStore, tx we
 		return &weave.DeliverResult{}, nil
 	}
 	user.Sequence += incr
+	user.compactUsedSequences()
 	obj := orm.NewSimpleObj(user.Pubkey.Address(), user)
 	if err := h.b.Save(db, obj); err != nil {
 		return nil, errors.Wrap(err, "save user")
@@ -76,3 +82,79 @@ func (h *bumpSequenceHandler) validate(ctx weave.Context, db weave.KVStore, tx w
 
 	return user, &msg, nil
 }
+
+type rotateKeyHandler struct {
+	auth x.Authenticator
+	b    Bucket
+}
+
+func (h *rotateKeyHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, _, err := h.validate(ctx, db, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *rotateKeyHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	obj, msg, err := h.validate(ctx, db, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	user := AsUser(obj)
+	if msg.NewPubkey.Address().Equals(user.Pubkey.Address()) {
+		// Rotating back to the original key.
+		user.RotatedPubkey = nil
+	} else {
+		user.RotatedPubkey = msg.NewPubkey
+	}
+	if err := h.b.Save(db, obj); err != nil {
+		return nil, errors.Wrap(err, "save user")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *rotateKeyHandler) validate(ctx weave.Context, db weave.KVStore, tx weave.Tx) (orm.Object, *RotateKeyMsg, error) {
+	var msg RotateKeyMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, nil, errors.Wrap(err, "load msg")
+	}
+
+	signer := x.MainSigner(ctx, h.auth)
+	if signer == nil {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "missing signature")
+	}
+	obj, err := h.b.Get(db, signer.Address())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "bucket")
+	}
+	if obj == nil {
+		return nil, nil, errors.Wrap(errors.ErrNotFound, "no account")
+	}
+	user := AsUser(obj)
+
+	newAddr := msg.NewPubkey.Address()
+	if newAddr.Equals(user.SigningPubkey().Address()) {
+		return nil, nil, errors.Wrap(errors.ErrDuplicate, "key already in use")
+	}
+	// Original key of the account can always be restored. Any other key
+	// must not be used by another account.
+	if !newAddr.Equals(user.Pubkey.Address()) {
+		other, err := h.b.GetBySigner(db, newAddr)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "bucket")
+		}
+		if other != nil {
+			return nil, nil, errors.Wrap(errors.ErrDuplicate, "key used by another account")
+		}
+	}
+
+	signBytes, err := RotateKeySignBytes(weave.GetChainID(ctx), signer.Address())
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "sign bytes")
+	}
+	if !msg.NewPubkey.Verify(signBytes, msg.NewKeySignature) {
+		return nil, nil, errors.Wrap(errors.ErrUnauthorized, "invalid new key signature")
+	}
+	return obj, &msg, nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/orm"
@@ -173,3 +174,109 @@ func TestBumpSequence(t *testing.T) {
 		})
 	}
 }
+
+func TestRotateKey(t *testing.T) {
+	const chainID = "rotate-key"
+
+	var (
+		key1 = weavetest.NewKey()
+		key2 = weavetest.NewKey()
+		key3 = weavetest.NewKey()
+	)
+	account := key1.PublicKey().Address()
+
+	bucket := NewBucket()
+	db := store.MemStore()
+	migration.MustInitPkg(db, "sigs")
+	for _, u := range []*UserData{
+		{Metadata: &weave.Metadata{Schema: 1}, Pubkey: key1.PublicKey(), Sequence: 3},
+		{Metadata: &weave.Metadata{Schema: 1}, Pubkey: key3.PublicKey(), Sequence: 0},
+	} {
+		if err := bucket.Save(db, orm.NewSimpleObj(u.Pubkey.Address(), u)); err != nil {
+			t.Fatalf("cannot save user: %s", err)
+		}
+	}
+
+	rotate := func(newKey, proofKey crypto.Signer) *RotateKeyMsg {
+		signBytes, err := RotateKeySignBytes(chainID, account)
+		if err != nil {
+			t.Fatalf("cannot build sign bytes: %s", err)
+		}
+		sig, err := proofKey.Sign(signBytes)
+		if err != nil {
+			t.Fatalf("cannot sign: %s", err)
+		}
+		return &RotateKeyMsg{
+			Metadata:        &weave.Metadata{Schema: 1},
+			NewPubkey:       newKey.PublicKey(),
+			NewKeySignature: sig,
+		}
+	}
+
+	auth := &weavetest.CtxAuth{Key: "auth"}
+	handler := rotateKeyHandler{b: bucket, auth: auth}
+	ctx := weave.WithChainID(context.Background(), chainID)
+	ctx = auth.SetConditions(ctx, key1.PublicKey().Condition())
+
+	steps := []struct {
+		msg     *RotateKeyMsg
+		wantErr *errors.Error
+	}{
+		{msg: rotate(key2, key3), wantErr: errors.ErrUnauthorized},
+		{msg: rotate(key3, key3), wantErr: errors.ErrDuplicate},
+		{msg: rotate(key1, key1), wantErr: errors.ErrDuplicate},
+		{msg: rotate(key2, key2)},
+		{msg: rotate(key2, key2), wantErr: errors.ErrDuplicate},
+	}
+	for i, step := range steps {
+		tx := weavetest.Tx{Msg: step.msg}
+		if _, err := handler.Check(ctx, db.CacheWrap(), &tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected check error: %+v", i, err)
+		}
+		if _, err := handler.Deliver(ctx, db, &tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected deliver error: %+v", i, err)
+		}
+	}
+
+	// Account signatures are verified with the new key only and the
+	// sequence is preserved.
+	seq, err := NextNonce(db, key2.PublicKey().Address())
+	if err != nil {
+		t.Fatalf("cannot get nonce: %s", err)
+	}
+	if seq != 3 {
+		t.Fatalf("want sequence 3, got %d", seq)
+	}
+	tx := NewStdTx([]byte("payload"))
+	oldSig, err := SignTx(key1, tx, chainID, 3)
+	if err != nil {
+		t.Fatalf("cannot sign: %s", err)
+	}
+	if _, err := VerifySignature(db, oldSig, []byte("payload"), chainID); !errors.ErrUnauthorized.Is(err) {
+		t.Fatalf("unexpected old key verification error: %+v", err)
+	}
+	newSig, err := SignTx(key2, tx, chainID, 3)
+	if err != nil {
+		t.Fatalf("cannot sign: %s", err)
+	}
+	cond, err := VerifySignature(db, newSig, []byte("payload"), chainID)
+	if err != nil {
+		t.Fatalf("cannot verify new key signature: %+v", err)
+	}
+	if !cond.Address().Equals(account) {
+		t.Fatalf("new key signature authenticates %s", cond.Address())
+	}
+
+	// The original key can be restored.
+	tx2 := weavetest.Tx{Msg: rotate(key1, key1)}
+	if _, err := handler.Deliver(ctx, db, &tx2); err != nil {
+		t.Fatalf("cannot restore the original key: %+v", err)
+	}
+	restoredSig, err := SignTx(key1, tx, chainID, 4)
+	if err != nil {
+		t.Fatalf("cannot sign: %s", err)
+	}
+	if _, err := VerifySignature(db, restoredSig, []byte("payload"), chainID); err != nil {
+		t.Fatalf("cannot verify restored key signature: %+v", err)
+	}
+}
@@ -1,6 +1,9 @@
 package sigs
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
@@ -10,6 +13,8 @@ import (
 
 func init() {
 	migration.MustRegister(1, &UserData{}, migration.NoModification)
+
+	migration.MustRegisterBucket(NewBucket().Bucket)
 }
 
 // BucketName is where we store the accounts
@@ -29,34 +34,96 @@ func (u *UserData) Validate() error {
 	} else if seq > 0 && u.Pubkey == nil {
 		errs = errors.Append(errs, errors.Field("Sequence", ErrInvalidSequence, "needs Pubkey"))
 	}
+	if len(u.UsedSequences) > MaxSequenceWindow {
+		errs = errors.Append(errs, errors.Field("UsedSequences", ErrInvalidSequence, "too many values, max %d allowed", MaxSequenceWindow))
+	}
+	prev := u.Sequence
+	for i, seq := range u.UsedSequences {
+		if seq <= prev {
+			errs = errors.Append(errs, errors.Field(fmt.Sprintf("UsedSequences.%d", i), ErrInvalidSequence, "must be greater than the sequence and sorted"))
+		}
+		prev = seq
+	}
 	return errs
 }
 
+// MaxSequenceWindow is the greatest allowed size of the sequence window.
+const MaxSequenceWindow = 1000
+
+// maxSequenceValue is limited by the client. The greatest supported
+// nonce value at client side is Number.MAX_SAFE_INTEGER, which is
+// 9007199254740991 = 2^53 - 1. If greater values must be supported, we get
+// much more complicated client code.
+const maxSequenceValue = (1 << 53) - 1
+
 // CheckAndIncrementSequence implements check and increment operation.
 // If current sequence value is the same as given expected value then it is
 // incremented. Otherwise an error is returned.
 // Before incrementing the sequence, this function is testing for a value
 // overflow.
 func (u *UserData) CheckAndIncrementSequence(expected int64) error {
-	if u.Sequence != expected {
-		return errors.Wrapf(ErrInvalidSequence, "mismatch expected %d, got %d", expected, u.Sequence)
-	}
+	return u.UseSequence(expected, 0)
+}
 
-	next := u.Sequence + 1
+// UseSequence marks given sequence value as used. A value is accepted if it
+// is equal to the current sequence or greater by at most window, and it was
+// not used before. Using the current sequence value increments it, skipping
+// all greater values that were already used.
+// With a zero window this is a strict check and increment operation.
+func (u *UserData) UseSequence(seq int64, window int64) error {
+	if seq == u.Sequence {
+		next := u.Sequence + 1
+		if next <= 0 || next > maxSequenceValue {
+			return errors.Wrap(errors.ErrOverflow, "sequence out of range")
+		}
+		u.Sequence = next
+		u.compactUsedSequences()
+		return nil
+	}
 
-	// maxSequenceValue is limited by the client. The greatest supported
-	// nonce value at client side is
-	//   Number.MAX_SAFE_INTEGER = 9007199254740991 = 2^53 −  1
-	// If greater values must be supported, we get much more complicated
-	// client code.
-	const maxSequenceValue = (1 << 53) - 1
-	if next <= 0 || next > maxSequenceValue {
+	if window == 0 {
+		return errors.Wrapf(ErrInvalidSequence, "mismatch expected %d, got %d", seq, u.Sequence)
+	}
+	if seq < u.Sequence || seq > u.Sequence+window {
+		return errors.Wrapf(ErrInvalidSequence, "%d not within the [%d, %d] window", seq, u.Sequence, u.Sequence+window)
+	}
+	if seq > maxSequenceValue {
 		return errors.Wrap(errors.ErrOverflow, "sequence out of range")
 	}
-	u.Sequence = next
+	i := sort.Search(len(u.UsedSequences), func(i int) bool { return u.UsedSequences[i] >= seq })
+	if i < len(u.UsedSequences) && u.UsedSequences[i] == seq {
+		return errors.Wrapf(ErrInvalidSequence, "%d already used", seq)
+	}
+	u.UsedSequences = append(u.UsedSequences, 0)
+	copy(u.UsedSequences[i+1:], u.UsedSequences[i:])
+	u.UsedSequences[i] = seq
 	return nil
 }
 
+// compactUsedSequences removes all used sequence values that are not greater
+// than the current sequence. If the current sequence value was already used,
+// it is incremented until an unused value is found.
+func (u *UserData) compactUsedSequences() {
+	for len(u.UsedSequences) > 0 && u.UsedSequences[0] <= u.Sequence {
+		if u.UsedSequences[0] == u.Sequence {
+			u.Sequence++
+		}
+		u.UsedSequences = u.UsedSequences[1:]
+	}
+	if len(u.UsedSequences) == 0 {
+		u.UsedSequences = nil
+	}
+}
+
+// SigningPubkey returns the public key that signatures of this account must
+// be verified with.
+func (u *UserData) SigningPubkey() *crypto.PublicKey {
+	if u.RotatedPubkey != nil {
+		return u.RotatedPubkey
+	}
+	return u.Pubkey
+}
+
 // SetPubkey will try to set the Pubkey or panic on an illegal operation.
 // It is illegal to reset an already set key
 // Otherwise, we don't control
@@ -96,18 +163,53 @@ type Bucket struct {
 	orm.Bucket
 }
 
-// NewBucket creates the proper bucket for this extension
+// NewBucket creates the proper bucket for this extension. Accounts are
+// indexed by the address of the rotated key.
 func NewBucket() Bucket {
+	b := migration.NewBucket("sigs", BucketName, &UserData{}).
+		WithIndex(rotatedKeyIndex, idxRotatedKey, true)
 	return Bucket{
-		Bucket: migration.NewBucket("sigs", BucketName, &UserData{}),
+		Bucket: b,
 	}
 }
 
-// GetOrCreate initializes a UserData if none exist for that key
+const rotatedKeyIndex = "rotated"
+
+func idxRotatedKey(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	u, ok := obj.Value().(*UserData)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "can only take index of UserData")
+	}
+	if u.RotatedPubkey == nil {
+		return nil, nil
+	}
+	return u.RotatedPubkey.Address(), nil
+}
+
+// GetOrCreate initializes a UserData if none exist for that key. If the key
+// was bound to another account by rotation, that account is returned.
 func (b Bucket) GetOrCreate(db weave.KVStore, pubkey *crypto.PublicKey) (orm.Object, error) {
-	obj, err := b.Get(db, pubkey.Address())
+	obj, err := b.GetBySigner(db, pubkey.Address())
 	if err == nil && obj == nil {
 		obj = NewUser(pubkey)
 	}
 	return obj, err
 }
+
+// GetBySigner returns the account that signatures created by the key with
+// given address are verified against. This is the account that the key was
+// bound to by rotation, or the account of that address. Nil is returned if
+// no account exists.
+func (b Bucket) GetBySigner(db weave.ReadOnlyKVStore, signer weave.Address) (orm.Object, error) {
+	objs, err := b.GetIndexed(db, rotatedKeyIndex, signer)
+	if err != nil {
+		return nil, errors.Wrap(err, "rotated key index")
+	}
+	if len(objs) != 0 {
+		return objs[0], nil
+	}
+	return b.Get(db, signer)
+}
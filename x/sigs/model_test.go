@@ -109,6 +109,95 @@ func TestUserCheckAndIncrementSequence(t *testing.T) {
 	}
 }
 
+func TestUserUseSequence(t *testing.T) {
+	cases := map[string]struct {
+		User     *UserData
+		Seq      int64
+		Window   int64
+		WantErr  *errors.Error
+		WantSeq  int64
+		WantUsed []int64
+	}{
+		"current sequence with a window": {
+			User:    &UserData{Sequence: 10},
+			Seq:     10,
+			Window:  5,
+			WantSeq: 11,
+		},
+		"sequence within the window": {
+			User:     &UserData{Sequence: 10},
+			Seq:      13,
+			Window:   5,
+			WantSeq:  10,
+			WantUsed: []int64{13},
+		},
+		"sequence at the end of the window": {
+			User:     &UserData{Sequence: 10, UsedSequences: []int64{11, 12}},
+			Seq:      15,
+			Window:   5,
+			WantSeq:  10,
+			WantUsed: []int64{11, 12, 15},
+		},
+		"used values are kept sorted": {
+			User:     &UserData{Sequence: 10, UsedSequences: []int64{11, 14}},
+			Seq:      12,
+			Window:   5,
+			WantSeq:  10,
+			WantUsed: []int64{11, 12, 14},
+		},
+		"sequence beyond the window": {
+			User:     &UserData{Sequence: 10, UsedSequences: []int64{12}},
+			Seq:      16,
+			Window:   5,
+			WantErr:  ErrInvalidSequence,
+			WantSeq:  10,
+			WantUsed: []int64{12},
+		},
+		"sequence before the window": {
+			User:    &UserData{Sequence: 10},
+			Seq:     9,
+			Window:  5,
+			WantErr: ErrInvalidSequence,
+			WantSeq: 10,
+		},
+		"sequence already used": {
+			User:     &UserData{Sequence: 10, UsedSequences: []int64{12}},
+			Seq:      12,
+			Window:   5,
+			WantErr:  ErrInvalidSequence,
+			WantSeq:  10,
+			WantUsed: []int64{12},
+		},
+		"current sequence skips used values": {
+			User:     &UserData{Sequence: 10, UsedSequences: []int64{11, 12, 14}},
+			Seq:      10,
+			Window:   5,
+			WantSeq:  13,
+			WantUsed: []int64{14},
+		},
+		"zero size window requires the current sequence": {
+			User:    &UserData{Sequence: 10},
+			Seq:     11,
+			Window:  0,
+			WantErr: ErrInvalidSequence,
+			WantSeq: 10,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			err := tc.User.UseSequence(tc.Seq, tc.Window)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if tc.WantSeq != tc.User.Sequence {
+				t.Fatalf("want %v user sequence, got %v", tc.WantSeq, tc.User.Sequence)
+			}
+			assert.Equal(t, tc.WantUsed, tc.User.UsedSequences)
+		})
+	}
+}
+
 func TestUserValidation(t *testing.T) {
 	cases := map[string]struct {
 		User    *UserData
@@ -144,6 +233,32 @@ func TestUserValidation(t *testing.T) {
 			},
 			WantErr: ErrInvalidSequence,
 		},
+		"valid used sequences": {
+			User: &UserData{
+				Metadata:      &weave.Metadata{Schema: 1},
+				Pubkey:        weavetest.NewKey().PublicKey(),
+				Sequence:      5,
+				UsedSequences: []int64{6, 8},
+			},
+		},
+		"used sequence not greater than the sequence": {
+			User: &UserData{
+				Metadata:      &weave.Metadata{Schema: 1},
+				Pubkey:        weavetest.NewKey().PublicKey(),
+				Sequence:      5,
+				UsedSequences: []int64{5, 8},
+			},
+			WantErr: ErrInvalidSequence,
+		},
+		"used sequences not sorted": {
+			User: &UserData{
+				Metadata:      &weave.Metadata{Schema: 1},
+				Pubkey:        weavetest.NewKey().PublicKey(),
+				Sequence:      5,
+				UsedSequences: []int64{8, 6},
+			},
+			WantErr: ErrInvalidSequence,
+		},
 	}
 
 	for testName, tc := range cases {
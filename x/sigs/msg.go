@@ -8,6 +8,7 @@ import (
 
 func init() {
 	migration.MustRegister(1, &BumpSequenceMsg{}, migration.NoModification)
+	migration.MustRegister(1, &RotateKeyMsg{}, migration.NoModification)
 }
 
 const (
@@ -34,3 +35,21 @@ func (msg *BumpSequenceMsg) Validate() error {
 func (BumpSequenceMsg) Path() string {
 	return "sigs/bump_sequence"
 }
+
+var _ weave.Msg = (*RotateKeyMsg)(nil)
+
+func (msg *RotateKeyMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", msg.Metadata.Validate())
+	if msg.NewPubkey == nil || msg.NewPubkey.Condition() == nil {
+		errs = errors.Append(errs, errors.Field("NewPubkey", errors.ErrEmpty, "required"))
+	}
+	if msg.NewKeySignature == nil || msg.NewKeySignature.GetSig() == nil {
+		errs = errors.Append(errs, errors.Field("NewKeySignature", errors.ErrEmpty, "required"))
+	}
+	return errs
+}
+
+func (RotateKeyMsg) Path() string {
+	return "sigs/rotate_key"
+}
@@ -11,7 +11,7 @@ import (
 // nonce for the signer. You can get the signers address by calling
 //   address := <crypto.Signer>.PublicKey().Address()
 func NextNonce(db weave.ReadOnlyKVStore, signer weave.Address) (int64, error) {
-	obj, err := NewBucket().Get(db, signer)
+	obj, err := NewBucket().GetBySigner(db, signer)
 	if err != nil {
 		return 0, errors.Wrap(err, "bucket get")
 	}
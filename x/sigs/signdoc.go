@@ -0,0 +1,127 @@
+package sigs
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+/*
+SignDoc contains all data that a transaction signature is created for. It
+defines the canonical, versioned format of the signed bytes, so that any
+wallet can create signatures compatible with this extension.
+
+The transaction is included in its serialized form, as returned by
+SignedTx.GetSignBytes. This is the protobuf encoding of the transaction
+with all signatures removed, therefore the fee and the message are always
+covered by the signature.
+
+Two versions of the format exist. If neither ValidUntil nor
+ValidUntilHeight is set, version 1 is used:
+
+version    | len(chainID) | chainID      | sequence          | tx
+0x00CAFE00 | uint8        | ascii string | int64 (bigendian) | serialized transaction
+
+Otherwise version 2 is used:
+
+version    | len(chainID) | chainID      | sequence          | valid until       | valid until height | tx
+0x00CAFE01 | uint8        | ascii string | int64 (bigendian) | int64 (bigendian) | int64 (bigendian)  | serialized transaction
+
+The sha512 hash of the serialized document is what the private key signs.
+Prehashing provides a constant length input, which allows hardware wallets
+with limited memory to sign transactions of any size.
+*/
+type SignDoc struct {
+	// ChainID is the ID of the chain the transaction is valid for.
+	ChainID string
+	// Sequence is the sequence number of the signer account.
+	Sequence int64
+	// ValidUntil is the time after which the signature is no longer
+	// valid. Zero value means no time limit.
+	ValidUntil weave.UnixTime
+	// ValidUntilHeight is the block height after which the signature is
+	// no longer valid. Zero value means no height limit.
+	ValidUntilHeight int64
+	// Tx is the serialized transaction without signatures.
+	Tx []byte
+}
+
+// NewSignDoc returns a document to be signed in order to authorize given
+// transaction.
+func NewSignDoc(tx SignedTx, chainID string, seq int64) (*SignDoc, error) {
+	raw, err := tx.GetSignBytes()
+	if err != nil {
+		return nil, errors.Wrap(err, "sign bytes")
+	}
+	return &SignDoc{
+		ChainID:  chainID,
+		Sequence: seq,
+		Tx:       raw,
+	}, nil
+}
+
+// Validate returns an error if this document cannot be serialized.
+func (d *SignDoc) Validate() error {
+	if d.Sequence < 0 {
+		return errors.Wrap(ErrInvalidSequence, "negative")
+	}
+	if d.ValidUntil < 0 || d.ValidUntilHeight < 0 {
+		return errors.Wrap(errors.ErrInput, "negative expiration")
+	}
+	if !weave.IsValidChainID(d.ChainID) {
+		return errors.Wrapf(errors.ErrInput, "chain id: %v", d.ChainID)
+	}
+	return nil
+}
+
+// Version returns the prefix of the serialized document. It depends on which
+// fields are set.
+func (d *SignDoc) Version() []byte {
+	if d.expiring() {
+		return SignCodeV2
+	}
+	return SignCodeV1
+}
+
+// expiring returns true if the signature validity is limited by time or by
+// block height.
+func (d *SignDoc) expiring() bool {
+	return d.ValidUntil != 0 || d.ValidUntilHeight != 0
+}
+
+// Bytes returns the canonical serialization of this document.
+func (d *SignDoc) Bytes() ([]byte, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+
+	version := d.Version()
+	nums := make([]byte, 8, 24)
+	binary.BigEndian.PutUint64(nums, uint64(d.Sequence))
+	if d.expiring() {
+		nums = nums[:24]
+		binary.BigEndian.PutUint64(nums[8:], uint64(d.ValidUntil))
+		binary.BigEndian.PutUint64(nums[16:], uint64(d.ValidUntilHeight))
+	}
+
+	output := make([]byte, 0, len(version)+1+len(d.ChainID)+len(nums)+len(d.Tx))
+	output = append(output, version...)
+	output = append(output, uint8(len(d.ChainID)))
+	output = append(output, []byte(d.ChainID)...)
+	output = append(output, nums...)
+	output = append(output, d.Tx...)
+	return output, nil
+}
+
+// Hash returns the sha512 hash of the serialized document. This is the
+// message that must be signed.
+func (d *SignDoc) Hash() ([]byte, error) {
+	raw, err := d.Bytes()
+	if err != nil {
+		return nil, err
+	}
+	hashed := sha512.Sum512(raw)
+	return hashed[:], nil
+}
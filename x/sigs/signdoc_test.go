@@ -0,0 +1,123 @@
+package sigs
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+// TestSignDocVectors ensures that the serialization format does not change.
+// Those vectors can be used to test compatible implementations.
+func TestSignDocVectors(t *testing.T) {
+	cases := map[string]struct {
+		doc       SignDoc
+		wantBytes string
+		wantHash  string
+	}{
+		"version 1 empty transaction": {
+			doc: SignDoc{
+				ChainID:  "test-chain",
+				Sequence: 0,
+			},
+			wantBytes: "00cafe000a746573742d636861696e0000000000000000",
+			wantHash:  "68214075d179c6858c7fb3b4d294dc448302e86d494315130950a1f70389ed5fd304a91ea81333a0d82b659bff06ed2c2869103c2e80e76fb36e99ca180e2928",
+		},
+		"version 1": {
+			doc: SignDoc{
+				ChainID:  "test-chain",
+				Sequence: 17,
+				Tx:       []byte("\x0a\x03foo"),
+			},
+			wantBytes: "00cafe000a746573742d636861696e00000000000000110a03666f6f",
+			wantHash:  "bfd879f60a7995fd0ade4844381948692e2951ea21e6df9e9b3e34a1d418e07dd1f0de4aea0c467648e07050832ce516215747ce3bbadf8b9aa04664fe7c4b20",
+		},
+		"version 2 valid until time": {
+			doc: SignDoc{
+				ChainID:    "iov-mainnet",
+				Sequence:   5,
+				ValidUntil: 1577836800,
+				Tx:         []byte("\x0a\x03foo"),
+			},
+			wantBytes: "00cafe010b696f762d6d61696e6e65740000000000000005000000005e0be10000000000000000000a03666f6f",
+			wantHash:  "676a90154623bd188672708f26199825fc0403d7326facf1470771dc3f03b2edac60bcdcb030f24f08eeb3c4542ba3e333e734263184c820f8a0e96cd1dda417",
+		},
+		"version 2 valid until height": {
+			doc: SignDoc{
+				ChainID:          "iov-mainnet",
+				Sequence:         5,
+				ValidUntilHeight: 1000,
+				Tx:               []byte("\x0a\x03foo"),
+			},
+			wantBytes: "00cafe010b696f762d6d61696e6e65740000000000000005000000000000000000000000000003e80a03666f6f",
+			wantHash:  "34a42c9c57f3f0f431aaeb8b005601280ef2567489e83054de534eb029c22292378aaae78e13801531b9608b4de527a3683c6033da3fa93f84b77ed62bc6e187",
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			raw, err := tc.doc.Bytes()
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantBytes, hex.EncodeToString(raw))
+
+			hash, err := tc.doc.Hash()
+			assert.Nil(t, err)
+			assert.Equal(t, tc.wantHash, hex.EncodeToString(hash))
+
+			built, err := BuildExpiringSignBytes(tc.doc.Tx, tc.doc.ChainID, tc.doc.Sequence, tc.doc.ValidUntil, tc.doc.ValidUntilHeight)
+			assert.Nil(t, err)
+			assert.Equal(t, hash, built)
+		})
+	}
+}
+
+func TestSignDocValidation(t *testing.T) {
+	cases := map[string]struct {
+		doc     SignDoc
+		wantErr *errors.Error
+	}{
+		"valid": {
+			doc:     SignDoc{ChainID: "test-chain", Sequence: 1},
+			wantErr: nil,
+		},
+		"negative sequence": {
+			doc:     SignDoc{ChainID: "test-chain", Sequence: -1},
+			wantErr: ErrInvalidSequence,
+		},
+		"negative valid until": {
+			doc:     SignDoc{ChainID: "test-chain", ValidUntil: -1},
+			wantErr: errors.ErrInput,
+		},
+		"negative valid until height": {
+			doc:     SignDoc{ChainID: "test-chain", ValidUntilHeight: -1},
+			wantErr: errors.ErrInput,
+		},
+		"invalid chain ID": {
+			doc:     SignDoc{ChainID: "x"},
+			wantErr: errors.ErrInput,
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.doc.Validate(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if _, err := tc.doc.Bytes(); !tc.wantErr.Is(err) {
+				t.Fatalf("unexpected serialization error: %+v", err)
+			}
+		})
+	}
+}
+
+func TestNewSignDoc(t *testing.T) {
+	tx := NewStdTx([]byte("foobar"))
+	doc, err := NewSignDoc(tx, "test-chain", 3)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("foobar"), doc.Tx)
+
+	hash, err := doc.Hash()
+	assert.Nil(t, err)
+	want, err := BuildSignBytesTx(tx, "test-chain", 3)
+	assert.Nil(t, err)
+	assert.Equal(t, want, hash)
+}
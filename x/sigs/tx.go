@@ -1,6 +1,7 @@
 package sigs
 
 import (
+	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 )
 
@@ -30,6 +31,36 @@ func (s *StdSignature) Validate() error {
 	if s.Signature == nil {
 		return errors.Wrap(errors.ErrUnauthorized, "missing signature")
 	}
+	if s.ValidUntil < 0 {
+		return errors.Wrap(errors.ErrInput, "negative valid until time")
+	}
+	if s.ValidUntilHeight < 0 {
+		return errors.Wrap(errors.ErrInput, "negative valid until height")
+	}
 
 	return nil
 }
+
+// IsExpired returns true if the signature is no longer valid at the time and
+// the block height of given context.
+func (s *StdSignature) IsExpired(ctx weave.Context) (bool, error) {
+	if s.ValidUntil != 0 {
+		now, err := weave.BlockTime(ctx)
+		if err != nil {
+			return false, errors.Wrap(err, "block time")
+		}
+		if weave.AsUnixTime(now) > s.ValidUntil {
+			return true, nil
+		}
+	}
+	if s.ValidUntilHeight != 0 {
+		height, ok := weave.GetHeight(ctx)
+		if !ok {
+			return false, errors.Wrap(errors.ErrHuman, "block height not present in context")
+		}
+		if height > s.ValidUntilHeight {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,180 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+)
+
+// Deadline is a decorator that limits the wall-clock time a transaction can
+// be checked for.
+//
+// The context passed down the stack has a deadline set, so that long
+// running handlers can check it. Additionally, every store operation fails
+// once the deadline is exceeded. When the deadline is exceeded, all changes
+// are discarded, even if the processing succeeded, and an ErrTimeout error is
+// returned. The error does not depend on the moment of the execution when the
+// deadline was exceeded.
+//
+// The wall-clock time depends on the hardware of each node and is not
+// deterministic. Delivering a transaction must give the same result on all
+// nodes, so the deadline is enforced only when a transaction is checked
+// before it is included in the mempool. Deliver is never limited.
+type Deadline struct {
+	timeout time.Duration
+}
+
+var _ weave.Decorator = (*Deadline)(nil)
+
+// NewDeadline returns a decorator that aborts checking of a transaction
+// that takes longer than the timeout. If timeout is not greater than zero,
+// nil is returned and the decorator is ignored.
+func NewDeadline(timeout time.Duration) *Deadline {
+	if timeout <= 0 {
+		return nil
+	}
+	return &Deadline{timeout: timeout}
+}
+
+// Check aborts the check if it exceeds the deadline. Changes are written only
+// if the check succeeded.
+func (d *Deadline) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	if d == nil { // Since NewDeadline can return nil, let's be graceful here
+		return next.Check(ctx, store, tx)
+	}
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	cache, db := cacheWrap(store)
+	res, err := next.Check(ctx, newDeadlineStore(ctx, db), tx)
+	if ctx.Err() != nil {
+		discardCache(cache)
+		return nil, errDeadline
+	}
+	if err != nil {
+		discardCache(cache)
+		return nil, err
+	}
+	if err := writeCache(cache); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Deliver calls down the stack without any time limit, because the wall-clock
+// time is not deterministic and must not influence the consensus.
+func (d *Deadline) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	return next.Deliver(ctx, store, tx)
+}
+
+// errDeadline is always returned when the deadline is exceeded so that the
+// result does not depend on where the execution was aborted.
+var errDeadline = errors.Wrap(errors.ErrTimeout, "execution deadline exceeded")
+
+func discardCache(cache weave.KVCacheWrap) {
+	if cache != nil {
+		cache.Discard()
+	}
+}
+
+// newDeadlineStore wraps given store so that all operations fail once the
+// context deadline is exceeded. Returned store supports cache wrapping if the
+// wrapped store does.
+func newDeadlineStore(ctx weave.Context, db weave.KVStore) weave.KVStore {
+	s := &deadlineStore{KVStore: db, ctx: ctx}
+	if _, ok := db.(weave.CacheableKVStore); ok {
+		return &cacheableDeadlineStore{deadlineStore: s}
+	}
+	return s
+}
+
+type deadlineStore struct {
+	weave.KVStore
+	ctx weave.Context
+}
+
+var _ weave.KVStore = (*deadlineStore)(nil)
+
+func (s *deadlineStore) err() error {
+	if s.ctx.Err() != nil {
+		return errDeadline
+	}
+	return nil
+}
+
+func (s *deadlineStore) Get(key []byte) ([]byte, error) {
+	if err := s.err(); err != nil {
+		return nil, err
+	}
+	return s.KVStore.Get(key)
+}
+
+func (s *deadlineStore) Has(key []byte) (bool, error) {
+	if err := s.err(); err != nil {
+		return false, err
+	}
+	return s.KVStore.Has(key)
+}
+
+func (s *deadlineStore) Iterator(start, end []byte) (weave.Iterator, error) {
+	if err := s.err(); err != nil {
+		return nil, err
+	}
+	it, err := s.KVStore.Iterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &deadlineIterator{Iterator: it, store: s}, nil
+}
+
+func (s *deadlineStore) ReverseIterator(start, end []byte) (weave.Iterator, error) {
+	if err := s.err(); err != nil {
+		return nil, err
+	}
+	it, err := s.KVStore.ReverseIterator(start, end)
+	if err != nil {
+		return nil, err
+	}
+	return &deadlineIterator{Iterator: it, store: s}, nil
+}
+
+func (s *deadlineStore) Set(key, value []byte) error {
+	if err := s.err(); err != nil {
+		return err
+	}
+	return s.KVStore.Set(key, value)
+}
+
+func (s *deadlineStore) Delete(key []byte) error {
+	if err := s.err(); err != nil {
+		return err
+	}
+	return s.KVStore.Delete(key)
+}
+
+type cacheableDeadlineStore struct {
+	*deadlineStore
+}
+
+var _ weave.CacheableKVStore = (*cacheableDeadlineStore)(nil)
+
+// CacheWrap makes sure that cached reads that reach the wrapped store are
+// checked against the deadline as well.
+func (s *cacheableDeadlineStore) CacheWrap() weave.KVCacheWrap {
+	return store.NewBTreeCacheWrap(s.deadlineStore, s.NewBatch(), nil)
+}
+
+type deadlineIterator struct {
+	weave.Iterator
+	store *deadlineStore
+}
+
+func (it *deadlineIterator) Next() ([]byte, []byte, error) {
+	if err := it.store.err(); err != nil {
+		return nil, nil, err
+	}
+	return it.Iterator.Next()
+}
@@ -0,0 +1,143 @@
+package utils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestDeadline(t *testing.T) {
+	cases := map[string]struct {
+		Handler   weave.Handler
+		WantErr   *errors.Error
+		WantKeys  [][]byte
+		WantNoKey [][]byte
+	}{
+		"fast handler changes are written": {
+			Handler:  &writeHandler{key: []byte("fast"), value: []byte("1")},
+			WantKeys: [][]byte{[]byte("fast")},
+		},
+		"handler error is returned and changes are discarded": {
+			Handler:   &writeHandler{key: []byte("fast"), value: []byte("1"), err: errors.ErrUnauthorized},
+			WantErr:   errors.ErrUnauthorized,
+			WantNoKey: [][]byte{[]byte("fast")},
+		},
+		"slow handler changes are discarded": {
+			Handler:   &slowHandler{},
+			WantErr:   errors.ErrTimeout,
+			WantNoKey: [][]byte{[]byte("before"), []byte("after")},
+		},
+		"slow handler ignoring store errors fails": {
+			Handler:   &slowHandler{ignoreErr: true},
+			WantErr:   errors.ErrTimeout,
+			WantNoKey: [][]byte{[]byte("before"), []byte("after")},
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			d := NewDeadline(20 * time.Millisecond)
+
+			db := store.MemStore()
+			_, err := d.Check(context.Background(), db, &weavetest.Tx{}, tc.Handler)
+			if !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertKeys(t, db, tc.WantKeys, tc.WantNoKey)
+		})
+	}
+}
+
+func TestDeadlineDoesNotLimitDeliver(t *testing.T) {
+	d := NewDeadline(time.Nanosecond)
+
+	db := store.MemStore()
+	h := &deadlineRecorder{}
+	_, err := d.Deliver(context.Background(), db, &weavetest.Tx{}, h)
+	assert.Nil(t, err)
+	if h.hasDeadline {
+		t.Fatal("deliver context must not have a deadline")
+	}
+	assertKeys(t, db, [][]byte{[]byte("delivered")}, nil)
+}
+
+func TestDisabledDeadline(t *testing.T) {
+	d := NewDeadline(0)
+	if d != nil {
+		t.Fatalf("zero timeout must disable the decorator")
+	}
+	db := store.MemStore()
+	h := &writeHandler{key: []byte("key"), value: []byte("1")}
+	_, err := d.Deliver(context.Background(), db, &weavetest.Tx{}, h)
+	assert.Nil(t, err)
+	assertKeys(t, db, [][]byte{[]byte("key")}, nil)
+}
+
+func assertKeys(t testing.TB, db weave.KVStore, present, missing [][]byte) {
+	t.Helper()
+	for _, k := range present {
+		if ok, err := db.Has(k); err != nil || !ok {
+			t.Errorf("key %q must be present: %v", k, err)
+		}
+	}
+	for _, k := range missing {
+		if ok, err := db.Has(k); err != nil || ok {
+			t.Errorf("key %q must not be present: %v", k, err)
+		}
+	}
+}
+
+// slowHandler writes a key, exceeds the context deadline and then writes
+// another key.
+type slowHandler struct {
+	ignoreErr bool
+}
+
+var _ weave.Handler = (*slowHandler)(nil)
+
+func (h *slowHandler) Check(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if err := h.run(ctx, db); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h *slowHandler) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	if err := h.run(ctx, db); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h *slowHandler) run(ctx weave.Context, db weave.KVStore) error {
+	if err := db.Set([]byte("before"), []byte("1")); err != nil {
+		return err
+	}
+	<-ctx.Done()
+	if err := db.Set([]byte("after"), []byte("1")); err != nil && !h.ignoreErr {
+		return err
+	}
+	return nil
+}
+
+// deadlineRecorder writes a key and records if the context has a deadline.
+type deadlineRecorder struct {
+	hasDeadline bool
+}
+
+var _ weave.Deliverer = (*deadlineRecorder)(nil)
+
+func (h *deadlineRecorder) Deliver(ctx weave.Context, db weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	_, h.hasDeadline = ctx.Deadline()
+	time.Sleep(time.Millisecond)
+	if err := db.Set([]byte("delivered"), []byte("1")); err != nil {
+		return nil, err
+	}
+	return &weave.DeliverResult{}, nil
+}
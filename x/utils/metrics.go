@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"expvar"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+)
+
+// handlerMetrics holds statistics per message path. The statistics are
+// published by the expvar package as "weave_handlers".
+var handlerMetrics = expvar.NewMap("weave_handlers")
+
+// handlerMetricsMu guards creation of per path statistics.
+var handlerMetricsMu sync.Mutex
+
+// Metrics is a decorator that records statistics of processing each message
+// path, so that it is possible to find out which extensions dominate the
+// block processing time.
+//
+// For both check and deliver, the number of processed transactions, the
+// total execution time in microseconds, the total gas and the number of
+// failures per ABCI error code is recorded. For example, after a single
+// successful delivery of a message with "cash/send" path the following
+// statistics are published:
+//
+//   "weave_handlers": {
+//     "cash/send": {"deliver_count": 1, "deliver_gas": 0, "deliver_time_us": 412}
+//   }
+//
+// A failed delivery additionally increments a counter named after the error
+// code, for example "deliver_error_13".
+type Metrics struct{}
+
+var _ weave.Decorator = Metrics{}
+
+// NewMetrics creates a Metrics decorator
+func NewMetrics() Metrics {
+	return Metrics{}
+}
+
+// Check records the statistics of the check execution.
+func (Metrics) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	start := time.Now()
+	res, err := next.Check(ctx, store, tx)
+	var gas int64
+	if res != nil {
+		gas = res.GasAllocated
+	}
+	recordMetrics(msgPath(tx), "check", time.Since(start), gas, err)
+	return res, err
+}
+
+// Deliver records the statistics of the deliver execution.
+func (Metrics) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	start := time.Now()
+	res, err := next.Deliver(ctx, store, tx)
+	var gas int64
+	if res != nil {
+		gas = res.GasUsed
+	}
+	recordMetrics(msgPath(tx), "deliver", time.Since(start), gas, err)
+	return res, err
+}
+
+func recordMetrics(path, kind string, duration time.Duration, gas int64, err error) {
+	m := pathMetrics(path)
+	m.Add(kind+"_count", 1)
+	m.Add(kind+"_time_us", int64(duration/time.Microsecond))
+	m.Add(kind+"_gas", gas)
+	if err != nil {
+		code, _ := errors.ABCIInfo(err, false)
+		m.Add(fmt.Sprintf("%s_error_%d", kind, code), 1)
+	}
+}
+
+// pathMetrics returns the statistics of a message path, creating them if
+// needed.
+func pathMetrics(path string) *expvar.Map {
+	if m, ok := handlerMetrics.Get(path).(*expvar.Map); ok {
+		return m
+	}
+	handlerMetricsMu.Lock()
+	defer handlerMetricsMu.Unlock()
+	if m, ok := handlerMetrics.Get(path).(*expvar.Map); ok {
+		return m
+	}
+	m := new(expvar.Map).Init()
+	handlerMetrics.Set(path, m)
+	return m
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"context"
+	"expvar"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	m := NewMetrics()
+	ctx := context.Background()
+	db := store.MemStore()
+
+	good := &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "test/metrics_good"}}
+	bad := &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "test/metrics_bad"}}
+
+	handler := &weavetest.Handler{
+		CheckResult:   weave.CheckResult{GasAllocated: 7},
+		DeliverResult: weave.DeliverResult{GasUsed: 5},
+	}
+	for i := 0; i < 2; i++ {
+		_, err := m.Check(ctx, db, good, handler)
+		assert.Nil(t, err)
+		_, err = m.Deliver(ctx, db, good, handler)
+		assert.Nil(t, err)
+	}
+
+	failing := &weavetest.Handler{
+		CheckErr:   errors.ErrAmount,
+		DeliverErr: errors.ErrUnauthorized,
+	}
+	_, err := m.Check(ctx, db, bad, failing)
+	assert.IsErr(t, errors.ErrAmount, err)
+	_, err = m.Deliver(ctx, db, bad, failing)
+	assert.IsErr(t, errors.ErrUnauthorized, err)
+
+	assert.Equal(t, int64(2), metricValue("test/metrics_good", "check_count"))
+	assert.Equal(t, int64(14), metricValue("test/metrics_good", "check_gas"))
+	assert.Equal(t, int64(2), metricValue("test/metrics_good", "deliver_count"))
+	assert.Equal(t, int64(10), metricValue("test/metrics_good", "deliver_gas"))
+	assert.Equal(t, int64(0), metricValue("test/metrics_good", "deliver_error_2"))
+
+	assert.Equal(t, int64(1), metricValue("test/metrics_bad", "check_count"))
+	assert.Equal(t, int64(1), metricValue("test/metrics_bad", "check_error_13"))
+	assert.Equal(t, int64(1), metricValue("test/metrics_bad", "deliver_count"))
+	assert.Equal(t, int64(1), metricValue("test/metrics_bad", "deliver_error_2"))
+}
+
+func metricValue(path, name string) int64 {
+	m, ok := handlerMetrics.Get(path).(*expvar.Map)
+	if !ok {
+		return 0
+	}
+	if n, ok := m.Get(name).(*expvar.Int); ok {
+		return n.Value()
+	}
+	return 0
+}
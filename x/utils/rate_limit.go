@@ -0,0 +1,158 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/x"
+)
+
+// RateLimit is a decorator that limits the number of transactions that a
+// single signer can submit within a sliding window of blocks.
+//
+// The limit is enforced only in CheckTx, so that a node operator can protect
+// the mempool from spam without changing fees. Each node counts transactions
+// accepted into its own mempool in memory, so the limit is not part of the
+// consensus and Deliver is never affected.
+//
+// Tendermint checks all pending transactions again after each block. A
+// transaction is identified by its hash, so that checking it again within the
+// window does not count it twice.
+type RateLimit struct {
+	auth   x.Authenticator
+	limit  int
+	window int64
+
+	mu sync.Mutex
+	// height is the most recent block height seen.
+	height int64
+	// seen holds heights of recently accepted transactions per signer
+	// address, in ascending order.
+	seen map[string][]int64
+	// txs holds heights of recently accepted transactions by their hash.
+	txs map[string]int64
+}
+
+var _ weave.Decorator = (*RateLimit)(nil)
+
+// NewRateLimit returns a decorator that allows at most limit transactions
+// signed by the same address within window blocks. If limit is not greater
+// than zero, nil is returned and the decorator is ignored.
+func NewRateLimit(auth x.Authenticator, limit int, window int64) *RateLimit {
+	if limit <= 0 {
+		return nil
+	}
+	if window < 1 {
+		window = 1
+	}
+	return &RateLimit{
+		auth:   auth,
+		limit:  limit,
+		window: window,
+		seen:   make(map[string][]int64),
+		txs:    make(map[string]int64),
+	}
+}
+
+// Check rejects a transaction if any of its signers exceeded the limit.
+func (r *RateLimit) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (*weave.CheckResult, error) {
+	if r == nil { // Since NewRateLimit can return nil, let's be graceful here
+		return next.Check(ctx, store, tx)
+	}
+	height, ok := weave.GetHeight(ctx)
+	if !ok {
+		return next.Check(ctx, store, tx)
+	}
+	signers := x.GetAddresses(ctx, r.auth)
+	hash := txHash(tx)
+
+	counted, err := r.allow(height, hash, signers)
+	if err != nil {
+		return nil, err
+	}
+	res, err := next.Check(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !counted {
+		r.record(height, hash, signers)
+	}
+	return res, nil
+}
+
+// Deliver is not rate limited.
+func (r *RateLimit) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (*weave.DeliverResult, error) {
+	return next.Deliver(ctx, store, tx)
+}
+
+// txHash returns the hash of the serialized transaction. An empty string is
+// returned if the transaction cannot be serialized.
+func txHash(tx weave.Tx) string {
+	raw, err := tx.Marshal()
+	if err != nil {
+		return ""
+	}
+	hash := sha256.Sum256(raw)
+	return string(hash[:])
+}
+
+// allow returns an error if any of the signers already submitted the maximum
+// number of transactions within the window ending at the given height. True
+// is returned if the transaction was already counted within the window.
+func (r *RateLimit) allow(height int64, hash string, signers []weave.Address) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.prune(height)
+	if _, ok := r.txs[hash]; ok {
+		return true, nil
+	}
+	for _, s := range signers {
+		if len(r.seen[string(s)]) >= r.limit {
+			return false, errors.Wrapf(errors.ErrState,
+				"rate limit of %d transactions per %d blocks exceeded by %s", r.limit, r.window, s)
+		}
+	}
+	return false, nil
+}
+
+// record counts a transaction accepted at the given height for all signers.
+func (r *RateLimit) record(height int64, hash string, signers []weave.Address) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if hash != "" {
+		r.txs[hash] = height
+	}
+	for _, s := range signers {
+		r.seen[string(s)] = append(r.seen[string(s)], height)
+	}
+}
+
+// prune drops all transactions that are outside of the window ending at the
+// given height. Pruning is done once per block.
+func (r *RateLimit) prune(height int64) {
+	if height == r.height {
+		return
+	}
+	r.height = height
+	oldest := height - r.window + 1
+	for addr, heights := range r.seen {
+		i := 0
+		for i < len(heights) && heights[i] < oldest {
+			i++
+		}
+		if i == len(heights) {
+			delete(r.seen, addr)
+		} else {
+			r.seen[addr] = heights[i:]
+		}
+	}
+	for hash, h := range r.txs {
+		if h < oldest {
+			delete(r.txs, hash)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/errors"
+	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	alice := weavetest.NewCondition()
+	bob := weavetest.NewCondition()
+
+	type submit struct {
+		height int64
+		// tx identifies the transaction. Unique content is used if
+		// not set.
+		tx      string
+		signers []weave.Condition
+		handler weave.Handler
+		wantErr *errors.Error
+	}
+	ok := &weavetest.Handler{}
+
+	cases := map[string]struct {
+		limit   int
+		window  int64
+		submits []submit
+	}{
+		"limit is per signer": {
+			limit:  2,
+			window: 3,
+			submits: []submit{
+				{height: 1, signers: []weave.Condition{alice}, handler: ok},
+				{height: 1, signers: []weave.Condition{alice}, handler: ok},
+				{height: 1, signers: []weave.Condition{alice}, handler: ok, wantErr: errors.ErrState},
+				{height: 1, signers: []weave.Condition{bob}, handler: ok},
+				{height: 2, signers: []weave.Condition{alice, bob}, handler: ok, wantErr: errors.ErrState},
+				{height: 2, signers: []weave.Condition{bob}, handler: ok},
+			},
+		},
+		"window slides": {
+			limit:  1,
+			window: 2,
+			submits: []submit{
+				{height: 1, signers: []weave.Condition{alice}, handler: ok},
+				{height: 2, signers: []weave.Condition{alice}, handler: ok, wantErr: errors.ErrState},
+				{height: 3, signers: []weave.Condition{alice}, handler: ok},
+				{height: 4, signers: []weave.Condition{alice}, handler: ok, wantErr: errors.ErrState},
+			},
+		},
+		"failed transactions are not counted": {
+			limit:  1,
+			window: 10,
+			submits: []submit{
+				{height: 1, signers: []weave.Condition{alice}, handler: &weavetest.Handler{CheckErr: errors.ErrHuman}, wantErr: errors.ErrHuman},
+				{height: 1, signers: []weave.Condition{alice}, handler: ok},
+				{height: 1, signers: []weave.Condition{alice}, handler: ok, wantErr: errors.ErrState},
+			},
+		},
+		"rechecked transaction is counted once": {
+			limit:  1,
+			window: 3,
+			submits: []submit{
+				{height: 1, tx: "a", signers: []weave.Condition{alice}, handler: ok},
+				{height: 2, tx: "a", signers: []weave.Condition{alice}, handler: ok},
+				{height: 2, tx: "b", signers: []weave.Condition{alice}, handler: ok, wantErr: errors.ErrState},
+				{height: 3, tx: "a", signers: []weave.Condition{alice}, handler: ok},
+				// Outside of the window the transaction is counted again.
+				{height: 4, tx: "a", signers: []weave.Condition{alice}, handler: ok},
+				{height: 4, tx: "b", signers: []weave.Condition{alice}, handler: ok, wantErr: errors.ErrState},
+			},
+		},
+		"unsigned transactions are not limited": {
+			limit:  1,
+			window: 10,
+			submits: []submit{
+				{height: 1, handler: ok},
+				{height: 1, handler: ok},
+			},
+		},
+	}
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			auth := &weavetest.CtxAuth{Key: "auth"}
+			r := NewRateLimit(auth, tc.limit, tc.window)
+			db := store.MemStore()
+			for i, s := range tc.submits {
+				ctx := weave.WithHeight(context.Background(), s.height)
+				ctx = auth.SetConditions(ctx, s.signers...)
+				content := s.tx
+				if content == "" {
+					content = fmt.Sprintf("tx %d", i)
+				}
+				tx := &weavetest.Tx{Msg: &weavetest.Msg{Serialized: []byte(content)}}
+				if _, err := r.Check(ctx, db, tx, s.handler); !s.wantErr.Is(err) {
+					t.Fatalf("submit %d: unexpected error: %+v", i, err)
+				}
+				// Deliver is never limited.
+				_, err := r.Deliver(ctx, db, tx, ok)
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestRateLimitDisabled(t *testing.T) {
+	if r := NewRateLimit(&weavetest.CtxAuth{Key: "auth"}, 0, 10); r != nil {
+		t.Fatal("zero limit must disable the decorator")
+	}
+}
@@ -1,12 +1,26 @@
 package utils
 
 import (
+	"expvar"
+	"fmt"
+	"runtime/debug"
+
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 )
 
+// panics counts recovered panics per message path. The counter is published
+// by the expvar package as "weave_panics".
+var panics = expvar.NewMap("weave_panics")
+
 // Recovery is a decorator to recover from panics in transactions,
 // so we can log them as errors
+//
+// A panic is converted into an ErrPanic error that contains the path of the
+// processed message and the type of the panic value. The panic value itself
+// can contain sensitive system information, so it is logged together with
+// the stack trace instead of being returned. All changes made to a cacheable
+// store by a panicking handler are discarded.
 type Recovery struct{}
 
 var _ weave.Decorator = Recovery{}
@@ -18,12 +32,86 @@ func NewRecovery() Recovery {
 
 // Check turns panics into normal errors
 func (r Recovery) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Checker) (_ *weave.CheckResult, err error) {
-	defer errors.Recover(&err)
-	return next.Check(ctx, store, tx)
+	cache, store := cacheWrap(store)
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicError(ctx, tx, p, cache)
+		}
+	}()
+	res, err := next.Check(ctx, store, tx)
+	if werr := writeCache(cache); werr != nil {
+		return nil, werr
+	}
+	return res, err
 }
 
 // Deliver turns panics into normal errors
 func (r Recovery) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx, next weave.Deliverer) (_ *weave.DeliverResult, err error) {
-	defer errors.Recover(&err)
-	return next.Deliver(ctx, store, tx)
+	cache, store := cacheWrap(store)
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicError(ctx, tx, p, cache)
+		}
+	}()
+	res, err := next.Deliver(ctx, store, tx)
+	if werr := writeCache(cache); werr != nil {
+		return nil, werr
+	}
+	return res, err
+}
+
+// cacheWrap returns a cache of the given store, so that changes made before
+// a panic can be discarded. A store that cannot be cached is returned as it
+// is together with a nil cache.
+func cacheWrap(store weave.KVStore) (weave.KVCacheWrap, weave.KVStore) {
+	cstore, ok := store.(weave.CacheableKVStore)
+	if !ok {
+		return nil, store
+	}
+	cache := cstore.CacheWrap()
+	return cache, cache
+}
+
+func writeCache(cache weave.KVCacheWrap) error {
+	if cache == nil {
+		return nil
+	}
+	if err := cache.Write(); err != nil {
+		return errors.Wrap(err, "write cache")
+	}
+	return nil
+}
+
+// panicError discards all changes, logs and counts the panic and returns an
+// error describing it.
+func panicError(ctx weave.Context, tx weave.Tx, p interface{}, cache weave.KVCacheWrap) error {
+	if cache != nil {
+		cache.Discard()
+	}
+	path := msgPath(tx)
+	panics.Add(path, 1)
+	weave.GetLogger(ctx).Error("Recovered from panic",
+		"path", path,
+		"panic", fmt.Sprint(p),
+		"stack", string(debug.Stack()))
+	return errors.Wrapf(errors.ErrPanic, "%s: %T", path, p)
+}
+
+// msgPath returns the path of the message carried by the transaction or
+// "unknown" if the message cannot be loaded.
+func msgPath(tx weave.Tx) (path string) {
+	// A broken transaction can panic as well.
+	defer func() {
+		if recover() != nil {
+			path = "unknown"
+		}
+	}()
+	if tx == nil {
+		return "unknown"
+	}
+	msg, err := tx.GetMsg()
+	if err != nil || msg == nil {
+		return "unknown"
+	}
+	return msg.Path()
 }
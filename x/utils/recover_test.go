@@ -2,11 +2,14 @@ package utils
 
 import (
 	"context"
+	"expvar"
+	"strings"
 	"testing"
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/store"
+	"github.com/iov-one/weave/weavetest"
 	"github.com/iov-one/weave/weavetest/assert"
 )
 
@@ -29,14 +32,77 @@ func TestRecovery(t *testing.T) {
 	assert.IsErr(t, errors.ErrPanic, err)
 }
 
+func TestRecoveryRollback(t *testing.T) {
+	r := NewRecovery()
+	ctx := context.Background()
+	tx := &weavetest.Tx{Msg: &weavetest.Msg{RoutePath: "test/recovery"}}
+
+	cases := map[string]func(weave.KVStore) error{
+		"check": func(db weave.KVStore) error {
+			_, err := r.Check(ctx, db, tx, panicHandler{})
+			return err
+		},
+		"deliver": func(db weave.KVStore) error {
+			_, err := r.Deliver(ctx, db, tx, panicHandler{})
+			return err
+		},
+	}
+	for testName, run := range cases {
+		t.Run(testName, func(t *testing.T) {
+			db := store.MemStore()
+			before := panicCount("test/recovery")
+
+			err := run(db)
+			assert.IsErr(t, errors.ErrPanic, err)
+			if !strings.Contains(err.Error(), "test/recovery: string") {
+				t.Fatalf("error must contain path and panic type: %s", err)
+			}
+			if strings.Contains(err.Error(), "secret") {
+				t.Fatalf("error must not contain the panic value: %s", err)
+			}
+
+			if ok, err := db.Has([]byte("panic")); err != nil || ok {
+				t.Fatalf("changes must be discarded: %v, %v", ok, err)
+			}
+			assert.Equal(t, before+1, panicCount("test/recovery"))
+		})
+	}
+}
+
+func TestRecoveryWritesChanges(t *testing.T) {
+	r := NewRecovery()
+	ctx := context.Background()
+	db := store.MemStore()
+
+	h := writeHandler{key: []byte("write"), value: []byte("1")}
+	_, err := r.Deliver(ctx, db, &weavetest.Tx{}, h)
+	assert.Nil(t, err)
+	if ok, err := db.Has([]byte("write")); err != nil || !ok {
+		t.Fatalf("changes must be written: %v, %v", ok, err)
+	}
+}
+
 type panicHandler struct{}
 
 var _ weave.Handler = panicHandler{}
 
 func (p panicHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
-	panic("check panic")
+	if err := store.Set([]byte("panic"), []byte("1")); err != nil {
+		return nil, err
+	}
+	panic("check panic secret")
 }
 
 func (p panicHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
-	panic("deliver panic")
+	if err := store.Set([]byte("panic"), []byte("1")); err != nil {
+		return nil, err
+	}
+	panic("deliver panic secret")
+}
+
+func panicCount(path string) int64 {
+	if n, ok := panics.Get(path).(*expvar.Int); ok {
+		return n.Value()
+	}
+	return 0
 }
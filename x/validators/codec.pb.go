@@ -129,30 +129,178 @@ func (m *Accounts) GetAddresses() [][]byte {
 	return nil
 }
 
+// BLSKey is a BLS12-381 public key registered for a validator. It is used to
+// verify signatures created by the validator that can be aggregated.
+type BLSKey struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Validator is the consensus public key of the validator.
+	Validator weave.PubKey `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator"`
+	// Pubkey is the compressed BLS12-381 public key.
+	Pubkey []byte `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+}
+
+func (m *BLSKey) Reset()         { *m = BLSKey{} }
+func (m *BLSKey) String() string { return proto.CompactTextString(m) }
+func (*BLSKey) ProtoMessage()    {}
+func (*BLSKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_596edf0ef2fd1c32, []int{2}
+}
+func (m *BLSKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BLSKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BLSKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BLSKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BLSKey.Merge(m, src)
+}
+func (m *BLSKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *BLSKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_BLSKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BLSKey proto.InternalMessageInfo
+
+func (m *BLSKey) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *BLSKey) GetValidator() weave.PubKey {
+	if m != nil {
+		return m.Validator
+	}
+	return weave.PubKey{}
+}
+
+func (m *BLSKey) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+// SetBLSKeyMsg registers a BLS12-381 public key for a validator. The validator
+// must be a member of the current validator set. Registering a key replaces
+// the previously registered key of the validator.
+type SetBLSKeyMsg struct {
+	Metadata *weave.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Validator is the consensus public key of the validator.
+	Validator weave.PubKey `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator"`
+	// Pubkey is the compressed BLS12-381 public key.
+	Pubkey []byte `protobuf:"bytes,3,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	// ProofOfPossession is a proof of possession of the BLS12-381 private key,
+	// as created by crypto.SignBLS12381ProofOfPossession. It protects
+	// aggregated signatures against rogue key attacks.
+	ProofOfPossession []byte `protobuf:"bytes,4,opt,name=proof_of_possession,json=proofOfPossession,proto3" json:"proof_of_possession,omitempty"`
+}
+
+func (m *SetBLSKeyMsg) Reset()         { *m = SetBLSKeyMsg{} }
+func (m *SetBLSKeyMsg) String() string { return proto.CompactTextString(m) }
+func (*SetBLSKeyMsg) ProtoMessage()    {}
+func (*SetBLSKeyMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_596edf0ef2fd1c32, []int{3}
+}
+func (m *SetBLSKeyMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBLSKeyMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBLSKeyMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalTo(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetBLSKeyMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBLSKeyMsg.Merge(m, src)
+}
+func (m *SetBLSKeyMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBLSKeyMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBLSKeyMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBLSKeyMsg proto.InternalMessageInfo
+
+func (m *SetBLSKeyMsg) GetMetadata() *weave.Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SetBLSKeyMsg) GetValidator() weave.PubKey {
+	if m != nil {
+		return m.Validator
+	}
+	return weave.PubKey{}
+}
+
+func (m *SetBLSKeyMsg) GetPubkey() []byte {
+	if m != nil {
+		return m.Pubkey
+	}
+	return nil
+}
+
+func (m *SetBLSKeyMsg) GetProofOfPossession() []byte {
+	if m != nil {
+		return m.ProofOfPossession
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ApplyDiffMsg)(nil), "validators.ApplyDiffMsg")
 	proto.RegisterType((*Accounts)(nil), "validators.Accounts")
+	proto.RegisterType((*BLSKey)(nil), "validators.BLSKey")
+	proto.RegisterType((*SetBLSKeyMsg)(nil), "validators.SetBLSKeyMsg")
 }
 
 func init() { proto.RegisterFile("x/validators/codec.proto", fileDescriptor_596edf0ef2fd1c32) }
 
 var fileDescriptor_596edf0ef2fd1c32 = []byte{
-	// 226 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xa8, 0xd0, 0x2f, 0x4b,
-	0xcc, 0xc9, 0x4c, 0x49, 0x2c, 0xc9, 0x2f, 0x2a, 0xd6, 0x4f, 0xce, 0x4f, 0x49, 0x4d, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x42, 0x88, 0x4b, 0x71, 0x23, 0x49, 0x48, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0xa9, 0x8d, 0x91, 0x8b, 0xc7, 0xb1, 0xa0,
-	0x20, 0xa7, 0xd2, 0x25, 0x33, 0x2d, 0xcd, 0xb7, 0x38, 0x5d, 0x48, 0x9b, 0x8b, 0x23, 0x37, 0xb5,
-	0x24, 0x31, 0x25, 0xb1, 0x24, 0x51, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x88, 0x5f, 0xaf, 0x3c,
-	0x35, 0xb1, 0x2c, 0x55, 0xcf, 0x17, 0x2a, 0x1c, 0x04, 0x57, 0x20, 0xe4, 0xc9, 0x25, 0x08, 0xb7,
-	0x2e, 0xbe, 0xb4, 0x20, 0x25, 0xb1, 0x24, 0xb5, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48,
-	0x0c, 0xaa, 0x2b, 0x0c, 0x26, 0x1f, 0x0a, 0x96, 0x76, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x48,
-	0xa0, 0x0c, 0x55, 0xb8, 0x58, 0x29, 0x94, 0x8b, 0xc3, 0x31, 0x39, 0x39, 0xbf, 0x34, 0xaf, 0xa4,
-	0x98, 0x34, 0x37, 0xc8, 0x70, 0x71, 0x26, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x43, 0xed, 0xe6,
-	0x09, 0x42, 0x08, 0x38, 0x49, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47,
-	0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x12,
-	0x1b, 0x38, 0x00, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x18, 0x21, 0xae, 0x4b, 0x01,
-	0x00, 0x00,
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x4e, 0xfa, 0x40,
+	0x10, 0xc6, 0xbb, 0x40, 0x08, 0x0c, 0xfd, 0xe7, 0x2f, 0xd5, 0x90, 0x0d, 0x31, 0x95, 0xf4, 0x44,
+	0x62, 0x52, 0x22, 0x3e, 0x01, 0xc4, 0x8b, 0x41, 0x22, 0x29, 0xc1, 0x2b, 0x59, 0xda, 0x2d, 0x21,
+	0x22, 0xb3, 0xe9, 0x6e, 0xd1, 0xde, 0x3c, 0x79, 0xf6, 0x69, 0x7c, 0x06, 0x8e, 0x1c, 0x3d, 0x19,
+	0x03, 0x2f, 0x62, 0x28, 0xb5, 0xe8, 0xb1, 0x89, 0xb7, 0xd9, 0xef, 0xfb, 0xed, 0xce, 0xb7, 0x93,
+	0x01, 0xfa, 0xd4, 0x5a, 0xb2, 0xf9, 0xcc, 0x63, 0x0a, 0x03, 0xd9, 0x72, 0xd1, 0xe3, 0xae, 0x2d,
+	0x02, 0x54, 0x68, 0xc0, 0x41, 0xaf, 0x57, 0x7e, 0x18, 0xf5, 0x93, 0x29, 0x4e, 0x31, 0x2e, 0x5b,
+	0xbb, 0x6a, 0xaf, 0x5a, 0x2f, 0x04, 0xf4, 0x8e, 0x10, 0xf3, 0xe8, 0x6a, 0xe6, 0xfb, 0x7d, 0x39,
+	0x35, 0xce, 0xa1, 0xf4, 0xc0, 0x15, 0xf3, 0x98, 0x62, 0x94, 0x34, 0x48, 0xb3, 0xd2, 0xfe, 0x6f,
+	0x3f, 0x72, 0xb6, 0xe4, 0x76, 0x3f, 0x91, 0x9d, 0x14, 0x30, 0xae, 0xa1, 0x9a, 0xb6, 0x1b, 0x87,
+	0xc2, 0x63, 0x8a, 0x4b, 0x9a, 0x6b, 0xe4, 0x9b, 0x95, 0x76, 0x2d, 0xb9, 0x75, 0xf7, 0xed, 0x8f,
+	0x62, 0xbb, 0x5b, 0x58, 0x7d, 0x9c, 0x69, 0xce, 0xd1, 0xf2, 0xb7, 0x2c, 0xad, 0x11, 0x94, 0x3a,
+	0xae, 0x8b, 0xe1, 0x42, 0xc9, 0x6c, 0x19, 0x4e, 0xa1, 0xcc, 0x3c, 0x2f, 0xe0, 0x52, 0x26, 0xbd,
+	0x75, 0xe7, 0x20, 0x58, 0xcf, 0x04, 0x8a, 0xdd, 0x9b, 0x61, 0x8f, 0x47, 0xd9, 0x5e, 0xbd, 0x80,
+	0x72, 0x1a, 0x91, 0xe6, 0x62, 0xfa, 0x5f, 0x42, 0x0f, 0xc2, 0x49, 0x8f, 0x47, 0xc9, 0x47, 0x0e,
+	0x94, 0x51, 0x83, 0xa2, 0x08, 0x27, 0xf7, 0x3c, 0xa2, 0xf9, 0x06, 0x69, 0xea, 0x4e, 0x72, 0xb2,
+	0xde, 0x08, 0xe8, 0x43, 0xae, 0xf6, 0x29, 0x32, 0x8f, 0xf8, 0xef, 0x82, 0x18, 0x36, 0x1c, 0x8b,
+	0x00, 0xd1, 0x1f, 0xa3, 0x3f, 0x16, 0xb8, 0x1b, 0x8f, 0x9c, 0xe1, 0x82, 0x16, 0x62, 0xa8, 0x1a,
+	0x5b, 0xb7, 0xfe, 0x20, 0x35, 0xba, 0x74, 0xb5, 0x31, 0xc9, 0x7a, 0x63, 0x92, 0xcf, 0x8d, 0x49,
+	0x5e, 0xb7, 0xa6, 0xb6, 0xde, 0x9a, 0xda, 0xfb, 0xd6, 0xd4, 0x26, 0xc5, 0x78, 0x79, 0x2e, 0xbf,
+	0x02, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xae, 0xfd, 0xe7, 0x87, 0x02, 0x00, 0x00,
 }
 
 func (m *ApplyDiffMsg) Marshal() (dAtA []byte, err error) {
@@ -231,6 +379,96 @@ func (m *Accounts) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *BLSKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BLSKey) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n3, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n3
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Validator.Size()))
+	n4, err := m.Validator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n4
+	if len(m.Pubkey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Pubkey)))
+		i += copy(dAtA[i:], m.Pubkey)
+	}
+	return i, nil
+}
+
+func (m *SetBLSKeyMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetBLSKeyMsg) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(m.Metadata.Size()))
+		n5, err := m.Metadata.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
+	}
+	dAtA[i] = 0x12
+	i++
+	i = encodeVarintCodec(dAtA, i, uint64(m.Validator.Size()))
+	n6, err := m.Validator.MarshalTo(dAtA[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n6
+	if len(m.Pubkey) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.Pubkey)))
+		i += copy(dAtA[i:], m.Pubkey)
+	}
+	if len(m.ProofOfPossession) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintCodec(dAtA, i, uint64(len(m.ProofOfPossession)))
+		i += copy(dAtA[i:], m.ProofOfPossession)
+	}
+	return i, nil
+}
+
 func encodeVarintCodec(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -278,6 +516,48 @@ func (m *Accounts) Size() (n int) {
 	return n
 }
 
+func (m *BLSKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Validator.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
+func (m *SetBLSKeyMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = m.Validator.Size()
+	n += 1 + l + sovCodec(uint64(l))
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	l = len(m.ProofOfPossession)
+	if l > 0 {
+		n += 1 + l + sovCodec(uint64(l))
+	}
+	return n
+}
+
 func sovCodec(x uint64) (n int) {
 	for {
 		n++
@@ -535,6 +815,352 @@ func (m *Accounts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BLSKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BLSKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BLSKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = append(m.Pubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pubkey == nil {
+				m.Pubkey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetBLSKeyMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCodec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetBLSKeyMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetBLSKeyMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &weave.Metadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = append(m.Pubkey[:0], dAtA[iNdEx:postIndex]...)
+			if m.Pubkey == nil {
+				m.Pubkey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOfPossession", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCodec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCodec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofOfPossession = append(m.ProofOfPossession[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProofOfPossession == nil {
+				m.ProofOfPossession = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCodec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthCodec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCodec(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
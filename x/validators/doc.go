@@ -9,6 +9,10 @@ Power represents the voting power of the validator. To remove a validator the po
 Any operation requires a valid signature. The whitelist of addresses which is used for authz should be set in the genesis file
 and is persisted during init phase. It is recommended to use MultiSig contracts for managing validator operations.
 
+A validator can additionally register a BLS12-381 public key with the `SetBLSKeyMsg` message. The key must come with
+a proof of possession of the private key, so that signatures of many validators can be safely aggregated. Registered
+keys are removed together with the validator.
+
 */
 
 package validators
@@ -1,9 +1,13 @@
 package validators
 
 import (
+	"bytes"
+
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
+	"github.com/iov-one/weave/orm"
 	"github.com/iov-one/weave/x"
 )
 
@@ -11,20 +15,30 @@ import (
 // all handlers in this package.
 func RegisterRoutes(r weave.Registry, auth x.Authenticator) {
 	bucket := NewAccountBucket()
+	keys := NewBLSKeyBucket()
 	r.Handle(&ApplyDiffMsg{}, migration.SchemaMigratingHandler("validators", &updateHandler{
 		auth:   auth,
 		bucket: bucket,
+		keys:   keys,
+	}))
+	r.Handle(&SetBLSKeyMsg{}, migration.SchemaMigratingHandler("validators", &setBLSKeyHandler{
+		auth:   auth,
+		bucket: bucket,
+		keys:   keys,
 	}))
 }
 
-// RegisterQuery will register this bucket as "/validators".
+// RegisterQuery will register this bucket as "/validators" and the BLS12-381
+// keys bucket as "/blskeys".
 func RegisterQuery(qr weave.QueryRouter) {
 	NewAccountBucket().Register("validators", qr)
+	NewBLSKeyBucket().Register("blskeys", qr)
 }
 
 type updateHandler struct {
 	auth   x.Authenticator
 	bucket *AccountBucket
+	keys   *BLSKeyBucket
 }
 
 var _ weave.Handler = (*updateHandler)(nil)
@@ -45,6 +59,15 @@ func (h updateHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.
 	if err != nil {
 		return nil, errors.Wrap(err, "store validator updates")
 	}
+	// BLS12-381 keys of removed validators are no longer relevant.
+	for _, v := range diff {
+		if v.Power != 0 {
+			continue
+		}
+		if err := h.keys.Delete(store, v.PubKey.Data); err != nil && !errors.ErrNotFound.Is(err) {
+			return nil, errors.Wrap(err, "delete bls key")
+		}
+	}
 
 	return &weave.DeliverResult{Diff: diff}, nil
 }
@@ -63,22 +86,10 @@ func (h updateHandler) validate(ctx weave.Context, store weave.KVStore, tx weave
 		return nil, resUpdates, errors.Wrap(errors.ErrEmpty, "diff")
 	}
 
-	accounts, err := h.bucket.GetAccounts(store)
-	if err != nil {
+	if err := hasPermission(ctx, store, h.auth, h.bucket); err != nil {
 		return nil, resUpdates, err
 	}
 
-	var hasPermission bool
-	for _, addr := range accounts.Addresses {
-		if h.auth.HasAddress(ctx, addr) {
-			hasPermission = true
-			break
-		}
-	}
-	if !hasPermission {
-		return nil, resUpdates, errors.Wrap(errors.ErrUnauthorized, "no permission")
-	}
-
 	updates, err := weave.GetValidatorUpdates(store)
 	if err != nil {
 		return nil, resUpdates, errors.Wrap(err, "failed to query validators")
@@ -105,3 +116,88 @@ func (h updateHandler) validate(ctx weave.Context, store weave.KVStore, tx weave
 	// Deduplicate updates for storage.
 	return diff, resUpdates.Deduplicate(true), nil
 }
+
+// hasPermission returns an error if none of the accounts allowed to update
+// validators is authenticated.
+func hasPermission(ctx weave.Context, store weave.KVStore, auth x.Authenticator, bucket *AccountBucket) error {
+	accounts, err := bucket.GetAccounts(store)
+	if err != nil {
+		return err
+	}
+	for _, addr := range accounts.Addresses {
+		if auth.HasAddress(ctx, addr) {
+			return nil
+		}
+	}
+	return errors.Wrap(errors.ErrUnauthorized, "no permission")
+}
+
+type setBLSKeyHandler struct {
+	auth   x.Authenticator
+	bucket *AccountBucket
+	keys   *BLSKeyBucket
+}
+
+var _ weave.Handler = (*setBLSKeyHandler)(nil)
+
+func (h setBLSKeyHandler) Check(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.CheckResult, error) {
+	if _, err := h.validate(ctx, store, tx); err != nil {
+		return nil, err
+	}
+	return &weave.CheckResult{}, nil
+}
+
+func (h setBLSKeyHandler) Deliver(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*weave.DeliverResult, error) {
+	msg, err := h.validate(ctx, store, tx)
+	if err != nil {
+		return nil, err
+	}
+	key := &BLSKey{
+		Metadata:  &weave.Metadata{Schema: 1},
+		Validator: msg.Validator,
+		Pubkey:    msg.Pubkey,
+	}
+	if err := h.keys.Save(store, orm.NewSimpleObj(msg.Validator.Data, key)); err != nil {
+		return nil, errors.Wrap(err, "save bls key")
+	}
+	return &weave.DeliverResult{}, nil
+}
+
+func (h setBLSKeyHandler) validate(ctx weave.Context, store weave.KVStore, tx weave.Tx) (*SetBLSKeyMsg, error) {
+	var msg SetBLSKeyMsg
+	if err := weave.LoadMsg(tx, &msg); err != nil {
+		return nil, errors.Wrap(err, "load msg")
+	}
+	if err := hasPermission(ctx, store, h.auth, h.bucket); err != nil {
+		return nil, err
+	}
+
+	updates, err := weave.GetValidatorUpdates(store)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query validators")
+	}
+	if v, _, ok := updates.Get(msg.Validator); !ok || v.Power == 0 {
+		return nil, errors.Wrap(errors.ErrNotFound, "validator")
+	}
+
+	used, err := h.keys.GetIndexed(store, "pubkey", msg.Pubkey)
+	if err != nil {
+		return nil, errors.Wrap(err, "bls key index")
+	}
+	for _, obj := range used {
+		if !bytes.Equal(obj.Key(), msg.Validator.Data) {
+			return nil, errors.Wrap(errors.ErrDuplicate, "bls key registered by another validator")
+		}
+	}
+
+	pubkey := &crypto.PublicKey{
+		Pub: &crypto.PublicKey_Bls12381{Bls12381: msg.Pubkey},
+	}
+	proof := &crypto.Signature{
+		Sig: &crypto.Signature_Bls12381{Bls12381: msg.ProofOfPossession},
+	}
+	if !crypto.VerifyBLS12381ProofOfPossession(pubkey, proof) {
+		return nil, errors.Wrap(errors.ErrUnauthorized, "invalid proof of possession")
+	}
+	return &msg, nil
+}
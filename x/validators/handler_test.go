@@ -7,10 +7,12 @@ import (
 
 	"github.com/iov-one/weave"
 	"github.com/iov-one/weave/app"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/migration"
 	"github.com/iov-one/weave/store"
 	"github.com/iov-one/weave/weavetest"
+	"github.com/iov-one/weave/weavetest/assert"
 )
 
 func TestHandler(t *testing.T) {
@@ -181,3 +183,147 @@ func TestHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestSetBLSKey(t *testing.T) {
+	alice := weavetest.NewKey()
+	bobby := weavetest.NewKey()
+
+	validator := weave.PubKey{Data: weavetest.NewKey().PublicKey().GetEd25519(), Type: "ed25519"}
+	validator2 := weave.PubKey{Data: weavetest.NewKey().PublicKey().GetEd25519(), Type: "ed25519"}
+	notValidator := weave.PubKey{Data: weavetest.NewKey().PublicKey().GetEd25519(), Type: "ed25519"}
+
+	blsKey := crypto.GenPrivKeyBls12381()
+	blsKey2 := crypto.GenPrivKeyBls12381()
+	proof := func(key *crypto.PrivateKey) []byte {
+		p, err := crypto.SignBLS12381ProofOfPossession(key)
+		if err != nil {
+			t.Fatalf("cannot create proof of possession: %s", err)
+		}
+		return p.GetBls12381()
+	}
+
+	db := store.MemStore()
+	migration.MustInitPkg(db, "validators")
+	err := NewAccountBucket().Save(db, AccountsWith(WeaveAccounts{Addresses: []weave.Address{alice.PublicKey().Address()}}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = weave.StoreValidatorUpdates(db, weave.ValidatorUpdates{ValidatorUpdates: []weave.ValidatorUpdate{
+		{PubKey: validator, Power: 1},
+		{PubKey: validator2, Power: 1},
+	}})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	rt := app.NewRouter()
+	RegisterRoutes(rt, &weavetest.CtxAuth{Key: "auth"})
+	keys := NewBLSKeyBucket()
+
+	steps := []struct {
+		signer  weave.Condition
+		msg     weave.Msg
+		wantErr *errors.Error
+		// wantKey is the BLS key expected to be registered for the
+		// first validator after the step.
+		wantKey *crypto.PrivateKey
+	}{
+		{
+			signer: bobby.PublicKey().Condition(),
+			msg: &SetBLSKeyMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				Validator:         validator,
+				Pubkey:            blsKey.PublicKey().GetBls12381(),
+				ProofOfPossession: proof(blsKey),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		{
+			signer: alice.PublicKey().Condition(),
+			msg: &SetBLSKeyMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				Validator:         notValidator,
+				Pubkey:            blsKey.PublicKey().GetBls12381(),
+				ProofOfPossession: proof(blsKey),
+			},
+			wantErr: errors.ErrNotFound,
+		},
+		{
+			signer: alice.PublicKey().Condition(),
+			msg: &SetBLSKeyMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				Validator:         validator,
+				Pubkey:            blsKey.PublicKey().GetBls12381(),
+				ProofOfPossession: proof(blsKey2),
+			},
+			wantErr: errors.ErrUnauthorized,
+		},
+		{
+			signer: alice.PublicKey().Condition(),
+			msg: &SetBLSKeyMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				Validator:         validator,
+				Pubkey:            blsKey.PublicKey().GetBls12381(),
+				ProofOfPossession: proof(blsKey),
+			},
+			wantKey: blsKey,
+		},
+		{
+			// The same key cannot be used by two validators.
+			signer: alice.PublicKey().Condition(),
+			msg: &SetBLSKeyMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				Validator:         validator2,
+				Pubkey:            blsKey.PublicKey().GetBls12381(),
+				ProofOfPossession: proof(blsKey),
+			},
+			wantErr: errors.ErrDuplicate,
+			wantKey: blsKey,
+		},
+		{
+			// Registering a new key replaces the old one.
+			signer: alice.PublicKey().Condition(),
+			msg: &SetBLSKeyMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				Validator:         validator,
+				Pubkey:            blsKey2.PublicKey().GetBls12381(),
+				ProofOfPossession: proof(blsKey2),
+			},
+			wantKey: blsKey2,
+		},
+		{
+			// Removing the validator removes its key.
+			signer: alice.PublicKey().Condition(),
+			msg: &ApplyDiffMsg{
+				Metadata:         &weave.Metadata{Schema: 1},
+				ValidatorUpdates: []weave.ValidatorUpdate{{PubKey: validator, Power: 0}},
+			},
+			wantKey: nil,
+		},
+	}
+	for i, step := range steps {
+		ctx := (&weavetest.CtxAuth{Key: "auth"}).SetConditions(context.Background(), step.signer)
+		tx := &weavetest.Tx{Msg: step.msg}
+
+		cache := db.CacheWrap()
+		if _, err := rt.Check(ctx, cache, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected check error: %+v", i, err)
+		}
+		cache.Discard()
+		if _, err := rt.Deliver(ctx, db, tx); !step.wantErr.Is(err) {
+			t.Fatalf("step %d: unexpected deliver error: %+v", i, err)
+		}
+
+		key, err := keys.GetBLSKey(db, validator)
+		if step.wantKey == nil {
+			if !errors.ErrNotFound.Is(err) {
+				t.Fatalf("step %d: want no key, got %v, %+v", i, key, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("step %d: cannot get key: %s", i, err)
+		}
+		assert.Equal(t, step.wantKey.PublicKey().GetBls12381(), key.Pubkey)
+	}
+}
@@ -11,6 +11,10 @@ import (
 
 func init() {
 	migration.MustRegister(1, &Accounts{}, migration.NoModification)
+	migration.MustRegister(1, &BLSKey{}, migration.NoModification)
+
+	migration.MustRegisterBucket(NewAccountBucket().Bucket)
+	migration.MustRegisterBucket(NewBLSKeyBucket().Bucket)
 }
 
 const (
@@ -88,3 +92,70 @@ func AccountsWith(acct WeaveAccounts) orm.Object {
 	acc := AsAccounts(acct)
 	return orm.NewSimpleObj([]byte(accountListKey), acc)
 }
+
+var _ orm.Model = (*BLSKey)(nil)
+
+func (m *BLSKey) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Validator", validateValidatorKey(m.Validator))
+	errs = errors.AppendField(errs, "Pubkey", validateBLSKey(m.Pubkey))
+	return errs
+}
+
+// validateValidatorKey returns an error if given key cannot be used as a
+// validator consensus key.
+func validateValidatorKey(key weave.PubKey) error {
+	return weave.ValidatorUpdate{PubKey: key}.Validate()
+}
+
+// blsKeySize is the length of a compressed BLS12-381 public key.
+const blsKeySize = 48
+
+func validateBLSKey(key []byte) error {
+	if len(key) != blsKeySize {
+		return errors.Wrapf(errors.ErrInput, "invalid BLS12-381 public key length %d", len(key))
+	}
+	return nil
+}
+
+// BLSKeyBucket stores BLS12-381 public keys of validators. Each key is stored
+// under the consensus public key of the validator.
+type BLSKeyBucket struct {
+	orm.Bucket
+}
+
+// NewBLSKeyBucket returns a bucket for storing BLS12-381 keys of validators.
+// The same BLS12-381 key cannot be registered by more than one validator.
+func NewBLSKeyBucket() *BLSKeyBucket {
+	b := migration.NewBucket("validators", "blskey", &BLSKey{}).
+		WithIndex("pubkey", idxBLSPubkey, true)
+	return &BLSKeyBucket{Bucket: b}
+}
+
+func idxBLSPubkey(obj orm.Object) ([]byte, error) {
+	if obj == nil {
+		return nil, errors.Wrap(errors.ErrHuman, "cannot take index of nil")
+	}
+	k, ok := obj.Value().(*BLSKey)
+	if !ok {
+		return nil, errors.Wrap(errors.ErrHuman, "can only take index of BLSKey")
+	}
+	return k.Pubkey, nil
+}
+
+// GetBLSKey returns the BLS12-381 key registered for given validator.
+func (b *BLSKeyBucket) GetBLSKey(db weave.KVStore, validator weave.PubKey) (*BLSKey, error) {
+	obj, err := b.Get(db, validator.Data)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil || obj.Value() == nil {
+		return nil, errors.Wrap(errors.ErrNotFound, "bls key")
+	}
+	k, ok := obj.Value().(*BLSKey)
+	if !ok {
+		return nil, errors.Wrapf(errors.ErrType, "%T", obj.Value())
+	}
+	return k, nil
+}
@@ -11,6 +11,7 @@ import (
 
 func init() {
 	migration.MustRegister(1, &ApplyDiffMsg{}, migration.NoModification)
+	migration.MustRegister(1, &SetBLSKeyMsg{}, migration.NoModification)
 }
 
 var _ weave.Msg = (*ApplyDiffMsg)(nil)
@@ -40,3 +41,21 @@ func (m *ApplyDiffMsg) AsABCI() []abci.ValidatorUpdate {
 
 	return validators
 }
+
+var _ weave.Msg = (*SetBLSKeyMsg)(nil)
+
+// Path implements weave.Msg interface.
+func (*SetBLSKeyMsg) Path() string {
+	return "validators/set_bls_key"
+}
+
+func (m *SetBLSKeyMsg) Validate() error {
+	var errs error
+	errs = errors.AppendField(errs, "Metadata", m.Metadata.Validate())
+	errs = errors.AppendField(errs, "Validator", validateValidatorKey(m.Validator))
+	errs = errors.AppendField(errs, "Pubkey", validateBLSKey(m.Pubkey))
+	if len(m.ProofOfPossession) == 0 {
+		errs = errors.AppendField(errs, "ProofOfPossession", errors.ErrEmpty)
+	}
+	return errs
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/iov-one/weave"
+	"github.com/iov-one/weave/crypto"
 	"github.com/iov-one/weave/errors"
 	"github.com/iov-one/weave/weavetest"
 )
@@ -48,3 +49,72 @@ func TestValidateSetValidatorMsg(t *testing.T) {
 	}
 
 }
+
+func TestValidateSetBLSKeyMsg(t *testing.T) {
+	validator := weave.PubKey{
+		Data: weavetest.NewKey().PublicKey().GetEd25519(),
+		Type: "ed25519",
+	}
+	blsKey := crypto.GenPrivKeyBls12381()
+	proof, err := crypto.SignBLS12381ProofOfPossession(blsKey)
+	if err != nil {
+		t.Fatalf("cannot create proof of possession: %s", err)
+	}
+
+	cases := map[string]struct {
+		Msg     weave.Msg
+		WantErr *errors.Error
+	}{
+		"valid model": {
+			Msg: &SetBLSKeyMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				Validator:         validator,
+				Pubkey:            blsKey.PublicKey().GetBls12381(),
+				ProofOfPossession: proof.GetBls12381(),
+			},
+			WantErr: nil,
+		},
+		"missing metadata": {
+			Msg: &SetBLSKeyMsg{
+				Validator:         validator,
+				Pubkey:            blsKey.PublicKey().GetBls12381(),
+				ProofOfPossession: proof.GetBls12381(),
+			},
+			WantErr: errors.ErrMetadata,
+		},
+		"invalid validator key": {
+			Msg: &SetBLSKeyMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				Validator:         weave.PubKey{Data: []byte{1, 2, 3}, Type: "ed25519"},
+				Pubkey:            blsKey.PublicKey().GetBls12381(),
+				ProofOfPossession: proof.GetBls12381(),
+			},
+			WantErr: errors.ErrType,
+		},
+		"invalid bls key": {
+			Msg: &SetBLSKeyMsg{
+				Metadata:          &weave.Metadata{Schema: 1},
+				Validator:         validator,
+				Pubkey:            []byte{1, 2, 3},
+				ProofOfPossession: proof.GetBls12381(),
+			},
+			WantErr: errors.ErrInput,
+		},
+		"missing proof of possession": {
+			Msg: &SetBLSKeyMsg{
+				Metadata:  &weave.Metadata{Schema: 1},
+				Validator: validator,
+				Pubkey:    blsKey.PublicKey().GetBls12381(),
+			},
+			WantErr: errors.ErrEmpty,
+		},
+	}
+
+	for testName, tc := range cases {
+		t.Run(testName, func(t *testing.T) {
+			if err := tc.Msg.Validate(); !tc.WantErr.Is(err) {
+				t.Fatalf("unexpected validation error: %s", err)
+			}
+		})
+	}
+}